@@ -233,9 +233,11 @@ type Settings struct {
 	CircuitBreakers                              CircuitBreakersSettings `json:"circuitBreakers,omitempty" desc:"Set this to configure the circuit breaker settings for Gloo."`
 	EnableRestEds                                *bool                   `json:"enableRestEds,omitempty" desc:"Whether or not to use rest xds for all EDS by default. Defaults to false."`
 	// NOTE: DevMode is deprecated. See https://docs.solo.io/gloo-edge/latest/operations/debugging_gloo/#debugging-the-control-plane for more details.
-	DevMode       *bool         `json:"devMode,omitempty" desc:"Whether or not to enable dev mode. Defaults to false. Setting to true at install time will expose the gloo dev admin endpoint on port 10010. Not recommended for production. Warning: this value is deprecated as of 1.17 and will be removed in a future release."`
-	SecretOptions SecretOptions `json:"secretOptions,omitempty" desc:"Options for how Gloo Edge should handle secrets."`
-	IPv4Only      *bool         `json:"ipV4Only,omitempty" desc:"Set to true to only use IPv4 when creating gateways in Gateway API mode. Sets listener bind addresses to 0.0.0.0 instead of ::."`
+	DevMode               *bool         `json:"devMode,omitempty" desc:"Whether or not to enable dev mode. Defaults to false. Setting to true at install time will expose the gloo dev admin endpoint on port 10010. Not recommended for production. Warning: this value is deprecated as of 1.17 and will be removed in a future release."`
+	SecretOptions         SecretOptions `json:"secretOptions,omitempty" desc:"Options for how Gloo Edge should handle secrets."`
+	IPv4Only              *bool         `json:"ipV4Only,omitempty" desc:"Set to true to only use IPv4 when creating gateways in Gateway API mode. Sets listener bind addresses to 0.0.0.0 instead of ::."`
+	ControlPlaneTelemetry interface{}   `json:"controlPlaneTelemetry,omitempty" desc:"Configures the OpenTelemetry traces and metrics of the control plane, exported over OTLP/gRPC. Rendered as the controlPlaneTelemetry of the Settings; the otlpEndpoint and insecure fields are also passed to the access logger. See the ControlPlaneTelemetry message of the Settings API for the available fields."`
+	XdsAuthOptions        interface{}   `json:"xdsAuthOptions,omitempty" desc:"Authenticates the clients of the xDS servers as Kubernetes service accounts. Rendered as the gloo.xdsAuthOptions of the Settings. When serviceAccountTokens is set, Gloo is allowed to create TokenReviews and the gateway proxies send a projected service account token, issued for the first of its audiences, to the xDS servers. See the XdsAuthOptions message of the Settings API for the available fields."`
 	*KubeResourceOverride
 }
 
@@ -25,6 +25,14 @@ ClusterRole
 {{- end -}}
 {{- end -}}
 
+{{- /* Renders "true" when the xDS servers authenticate their clients with service account tokens, in which case
+      Gloo reviews the tokens and the gateway proxies send one */ -}}
+{{- define "gloo.xdsServiceAccountTokens" -}}
+{{- if and .Values.settings.xdsAuthOptions (hasKey .Values.settings.xdsAuthOptions "serviceAccountTokens") -}}
+true
+{{- end -}}
+{{- end -}}
+
 {{- define "gloo.image.repository" -}}
 {{- /*
 for fips or fips-distroless variants: add -fips to the image repo (name)
@@ -30,6 +30,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -1000,6 +1001,101 @@ spec:
 				})
 			})
 
+			Context("xds service account tokens", func() {
+				BeforeEach(func() {
+					prepareMakefile(namespace, glootestutils.HelmValues{
+						ValuesArgs: []string{
+							"settings.xdsAuthOptions.serviceAccountTokens.audiences[0]=gloo-xds",
+							"settings.enableRestEds=true",
+						},
+					})
+				})
+
+				It("allows gloo to review tokens", func() {
+					foundRule := false
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "ClusterRole" && resource.GetName() == "gloo-xds-token-reviewer-"+namespace
+					}).ExpectAll(func(clusterRole *unstructured.Unstructured) {
+						clusterRoleObject, err := kuberesource.ConvertUnstructured(clusterRole)
+						Expect(err).NotTo(HaveOccurred())
+						structuredClusterRole, ok := clusterRoleObject.(*rbacv1.ClusterRole)
+						Expect(ok).To(BeTrue())
+						Expect(structuredClusterRole.Rules).To(ConsistOf(rbacv1.PolicyRule{
+							APIGroups: []string{"authentication.k8s.io"},
+							Resources: []string{"tokenreviews"},
+							Verbs:     []string{"create"},
+						}))
+						foundRule = true
+					})
+					Expect(foundRule).To(BeTrue(), "Did not find the token reviewer cluster role")
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "ClusterRoleBinding" && resource.GetName() == "gloo-xds-token-reviewer-binding-"+namespace
+					}).ExpectAll(func(binding *unstructured.Unstructured) {
+						bindingObject, err := kuberesource.ConvertUnstructured(binding)
+						Expect(err).NotTo(HaveOccurred())
+						structuredBinding, ok := bindingObject.(*rbacv1.ClusterRoleBinding)
+						Expect(ok).To(BeTrue())
+						Expect(structuredBinding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Name: "gloo", Namespace: namespace}))
+						Expect(structuredBinding.RoleRef.Name).To(Equal("gloo-xds-token-reviewer-" + namespace))
+					})
+				})
+
+				It("mounts a projected token in the gateway proxy and sends it to the xds servers", func() {
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "Deployment" && resource.GetName() == "gateway-proxy"
+					}).ExpectAll(func(deployment *unstructured.Unstructured) {
+						deploymentObject, err := kuberesource.ConvertUnstructured(deployment)
+						Expect(err).NotTo(HaveOccurred())
+						structuredDeployment, ok := deploymentObject.(*appsv1.Deployment)
+						Expect(ok).To(BeTrue())
+
+						Expect(structuredDeployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+							Name: "xds-token",
+							VolumeSource: corev1.VolumeSource{
+								Projected: &corev1.ProjectedVolumeSource{
+									DefaultMode: pointer.Int32(420),
+									Sources: []corev1.VolumeProjection{{
+										ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+											Audience:          "gloo-xds",
+											ExpirationSeconds: pointer.Int64(3600),
+											Path:              "token",
+										},
+									}},
+								},
+							},
+						}))
+						Expect(structuredDeployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+							Name:      "xds-token",
+							MountPath: "/var/run/secrets/xds",
+							ReadOnly:  true,
+						}))
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "ConfigMap" && resource.GetName() == "gateway-proxy-envoy-config"
+					}).ExpectAll(func(configMap *unstructured.Unstructured) {
+						configMapObject, err := kuberesource.ConvertUnstructured(configMap)
+						Expect(err).NotTo(HaveOccurred())
+						structuredConfigMap, ok := configMapObject.(*corev1.ConfigMap)
+						Expect(ok).To(BeTrue())
+
+						var bootstrap map[string]interface{}
+						Expect(yaml.Unmarshal([]byte(structuredConfigMap.Data["envoy.yaml"]), &bootstrap)).NotTo(HaveOccurred())
+						envoyYaml := structuredConfigMap.Data["envoy.yaml"]
+						// both the xds and the rest xds clusters inject the token
+						Expect(strings.Count(envoyYaml, "envoy.filters.http.credential_injector")).To(Equal(2))
+						Expect(envoyYaml).NotTo(ContainSubstring("        http2_protocol_options: {}\n        upstream_connection_options"))
+						Expect(envoyYaml).To(ContainSubstring("filename: /var/run/secrets/xds/token"))
+					})
+				})
+
+				It("renders the xds auth options in the settings", func() {
+					settings := makeUnstructureFromTemplateFile("fixtures/settings/xds_auth_options.yaml", namespace)
+					testManifest.ExpectUnstructured(settings.GetKind(), settings.GetNamespace(), settings.GetName()).To(BeEquivalentTo(settings))
+				})
+			})
+
 			Context("gloo with linkerd settings", func() {
 				linkerdInjectionLabel := "linkerd.io/inject"
 				It("linkerd injection should always be disabled in job pod templates when linkerd is enabled", func() {
@@ -4488,6 +4584,24 @@ spec:
 						})
 					})
 
+					It("correctly sets the `controlPlaneTelemetry` field in the settings", func() {
+						prepareMakefile(namespace, glootestutils.HelmValues{
+							ValuesArgs: []string{
+								"settings.controlPlaneTelemetry.otlpEndpoint=otel-collector.observability:4317",
+								"settings.controlPlaneTelemetry.insecure=true",
+							},
+						})
+						testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+							return resource.GetKind() == "Settings"
+						}).ExpectAll(func(settings *unstructured.Unstructured) {
+							field := getFieldFromUnstructured(settings, "spec", "controlPlaneTelemetry")
+							Expect(field).To(Equal(map[string]interface{}{
+								"otlpEndpoint": "otel-collector.observability:4317",
+								"insecure":     true,
+							}))
+						})
+					})
+
 					It("correctly sets the gateway validation fields in the settings", func() {
 						settings := makeUnstructureFromTemplateFile("fixtures/settings/gateway_validation.yaml", namespace)
 
@@ -122,6 +122,11 @@ var _ = Describe("RBAC Test", func() {
 								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces"},
 								Verbs:     []string{"get", "list", "watch"},
 							},
+							{
+								APIGroups: []string{"discovery.k8s.io"},
+								Resources: []string{"endpointslices"},
+								Verbs:     []string{"get", "list", "watch"},
+							},
 						},
 						RoleRef: rbacv1.RoleRef{
 							APIGroup: "rbac.authorization.k8s.io",
@@ -7,6 +7,7 @@ import (
 
 	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector"
 	"github.com/solo-io/gloo/pkg/utils/statsutils"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	"go.opencensus.io/tag"
 
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
@@ -78,6 +79,11 @@ func (s *SetupSyncer) Sync(ctx context.Context, snap *v1.SetupSnapshot) error {
 		mSetupsRun,
 	)
 
+	// export the traces and metrics of the component as configured in the settings
+	if err := telemetry.Configure(ctx, settings.GetControlPlaneTelemetry()); err != nil {
+		contextutils.LoggerFrom(ctx).Errorw("failed to configure control plane telemetry", zap.Error(err))
+	}
+
 	err = s.setupFunc(ctx, kube.NewKubeCache(ctx), s.inMemoryCache, settings, s.identity)
 	if err != nil {
 		return err
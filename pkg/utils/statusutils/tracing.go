@@ -0,0 +1,34 @@
+package statusutils
+
+import (
+	"context"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
+)
+
+var _ reporter.StatusReporter = &tracingReporter{}
+
+// tracingReporter wraps the status writes of a StatusReporter in spans
+type tracingReporter struct {
+	reporter.StatusReporter
+	name string
+}
+
+// NewTracingReporter returns a StatusReporter that records a span for every write of the given reporter.
+func NewTracingReporter(name string, statusReporter reporter.StatusReporter) reporter.StatusReporter {
+	return &tracingReporter{StatusReporter: statusReporter, name: name}
+}
+
+func (t *tracingReporter) WriteReports(ctx context.Context, resourceErrs reporter.ResourceReports, subresourceStatuses map[string]*core.Status) error {
+	ctx, span := telemetry.StartSpan(ctx, "gloo.status.WriteReports",
+		attribute.String("gloo.reporter", t.name),
+		attribute.Int("gloo.status.resources", len(resourceErrs)),
+	)
+	err := t.StatusReporter.WriteReports(ctx, resourceErrs, subresourceStatuses)
+	telemetry.EndSpan(span, err)
+	return err
+}
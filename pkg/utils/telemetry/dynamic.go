@@ -0,0 +1,72 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// dynamicSampler delegates to a sampler that can be replaced while spans are being started.
+type dynamicSampler struct {
+	sampler atomic.Pointer[sdktrace.Sampler]
+}
+
+func newDynamicSampler() *dynamicSampler {
+	s := &dynamicSampler{}
+	s.set(sdktrace.NeverSample())
+	return s
+}
+
+func (s *dynamicSampler) set(sampler sdktrace.Sampler) {
+	s.sampler.Store(&sampler)
+}
+
+func (s *dynamicSampler) ShouldSample(params sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return (*s.sampler.Load()).ShouldSample(params)
+}
+
+func (s *dynamicSampler) Description() string {
+	return (*s.sampler.Load()).Description()
+}
+
+// dynamicSpanExporter delegates to a span exporter that can be replaced while spans are being exported.
+// Spans are dropped while there is no exporter.
+type dynamicSpanExporter struct {
+	lock     sync.RWMutex
+	exporter sdktrace.SpanExporter
+}
+
+// set replaces the exporter, shutting down the previous one.
+func (e *dynamicSpanExporter) set(ctx context.Context, exporter sdktrace.SpanExporter) {
+	e.lock.Lock()
+	previous := e.exporter
+	e.exporter = exporter
+	e.lock.Unlock()
+
+	if previous != nil {
+		_ = previous.Shutdown(ctx)
+	}
+}
+
+func (e *dynamicSpanExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	if e.exporter == nil {
+		return nil
+	}
+	return e.exporter.ExportSpans(ctx, spans)
+}
+
+func (e *dynamicSpanExporter) Shutdown(ctx context.Context) error {
+	e.lock.Lock()
+	exporter := e.exporter
+	e.exporter = nil
+	e.lock.Unlock()
+
+	if exporter == nil {
+		return nil
+	}
+	return exporter.Shutdown(ctx)
+}
@@ -0,0 +1,143 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	otelmetricdata "go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// openCensusScope is the instrumentation scope of the metrics recorded with OpenCensus
+var openCensusScope = instrumentation.Scope{Name: "go.opencensus.io"}
+
+// NewOpenCensusProducer returns a producer of the metrics that are recorded with OpenCensus, e.g. through
+// statsutils. The metrics keep the names of their OpenCensus views, so they can be exported over OTLP next
+// to the Prometheus endpoint of the stats server.
+func NewOpenCensusProducer() sdkmetric.Producer {
+	return &openCensusProducer{manager: metricproducer.GlobalManager()}
+}
+
+type openCensusProducer struct {
+	manager *metricproducer.Manager
+}
+
+func (p *openCensusProducer) Produce(ctx context.Context) ([]otelmetricdata.ScopeMetrics, error) {
+	var metrics []otelmetricdata.Metrics
+	for _, producer := range p.manager.GetAll() {
+		for _, ocMetric := range producer.Read() {
+			if metric, ok := convertMetric(ocMetric); ok {
+				metrics = append(metrics, metric)
+			}
+		}
+	}
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+	return []otelmetricdata.ScopeMetrics{{Scope: openCensusScope, Metrics: metrics}}, nil
+}
+
+// convertMetric converts the last point of every time series of an OpenCensus metric.
+// Summaries and gauge distributions have no OpenTelemetry equivalent and are skipped.
+func convertMetric(ocMetric *metricdata.Metric) (otelmetricdata.Metrics, bool) {
+	descriptor := ocMetric.Descriptor
+	metric := otelmetricdata.Metrics{
+		Name:        descriptor.Name,
+		Description: descriptor.Description,
+		Unit:        string(descriptor.Unit),
+	}
+
+	switch descriptor.Type {
+	case metricdata.TypeGaugeInt64:
+		metric.Data = otelmetricdata.Gauge[int64]{DataPoints: numberPoints[int64](ocMetric)}
+	case metricdata.TypeGaugeFloat64:
+		metric.Data = otelmetricdata.Gauge[float64]{DataPoints: numberPoints[float64](ocMetric)}
+	case metricdata.TypeCumulativeInt64:
+		metric.Data = otelmetricdata.Sum[int64]{
+			DataPoints:  numberPoints[int64](ocMetric),
+			Temporality: otelmetricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		}
+	case metricdata.TypeCumulativeFloat64:
+		metric.Data = otelmetricdata.Sum[float64]{
+			DataPoints:  numberPoints[float64](ocMetric),
+			Temporality: otelmetricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		}
+	case metricdata.TypeCumulativeDistribution:
+		metric.Data = otelmetricdata.Histogram[float64]{
+			DataPoints:  histogramPoints(ocMetric),
+			Temporality: otelmetricdata.CumulativeTemporality,
+		}
+	default:
+		return metric, false
+	}
+	return metric, true
+}
+
+func numberPoints[N int64 | float64](ocMetric *metricdata.Metric) []otelmetricdata.DataPoint[N] {
+	var points []otelmetricdata.DataPoint[N]
+	for _, ts := range ocMetric.TimeSeries {
+		if len(ts.Points) == 0 {
+			continue
+		}
+		point := ts.Points[len(ts.Points)-1]
+		value, ok := point.Value.(N)
+		if !ok {
+			continue
+		}
+		points = append(points, otelmetricdata.DataPoint[N]{
+			Attributes: attributes(ocMetric.Descriptor.LabelKeys, ts.LabelValues),
+			StartTime:  ts.StartTime,
+			Time:       point.Time,
+			Value:      value,
+		})
+	}
+	return points
+}
+
+func histogramPoints(ocMetric *metricdata.Metric) []otelmetricdata.HistogramDataPoint[float64] {
+	var points []otelmetricdata.HistogramDataPoint[float64]
+	for _, ts := range ocMetric.TimeSeries {
+		if len(ts.Points) == 0 {
+			continue
+		}
+		point := ts.Points[len(ts.Points)-1]
+		distribution, ok := point.Value.(*metricdata.Distribution)
+		if !ok {
+			continue
+		}
+		var bounds []float64
+		if distribution.BucketOptions != nil {
+			bounds = distribution.BucketOptions.Bounds
+		}
+		counts := make([]uint64, len(distribution.Buckets))
+		for i, bucket := range distribution.Buckets {
+			counts[i] = uint64(bucket.Count)
+		}
+		points = append(points, otelmetricdata.HistogramDataPoint[float64]{
+			Attributes:   attributes(ocMetric.Descriptor.LabelKeys, ts.LabelValues),
+			StartTime:    ts.StartTime,
+			Time:         point.Time,
+			Count:        uint64(distribution.Count),
+			Bounds:       bounds,
+			BucketCounts: counts,
+			Sum:          distribution.Sum,
+		})
+	}
+	return points
+}
+
+func attributes(keys []metricdata.LabelKey, values []metricdata.LabelValue) attribute.Set {
+	kvs := make([]attribute.KeyValue, 0, len(keys))
+	for i, key := range keys {
+		if i >= len(values) || !values[i].Present {
+			continue
+		}
+		kvs = append(kvs, attribute.String(key.Key, values[i].Value))
+	}
+	return attribute.NewSet(kvs...)
+}
@@ -0,0 +1,223 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/solo-io/gloo/pkg/version"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+const (
+	defaultMetricExportInterval = time.Minute
+	shutdownTimeout             = 5 * time.Second
+)
+
+var (
+	globalProvider *Provider
+	globalOnce     sync.Once
+)
+
+// Init sets up the OpenTelemetry provider of the process, named after the component that runs in it.
+// Nothing is exported until the provider is configured from the Settings; only the first call has an effect.
+func Init(serviceName string) *Provider {
+	globalOnce.Do(func() {
+		globalProvider = NewProvider(serviceName)
+		otel.SetTracerProvider(globalProvider.TracerProvider())
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	})
+	return globalProvider
+}
+
+// Configure applies the telemetry settings to the provider of the process, setting it up with the
+// name of the executable if Init was not called.
+func Configure(ctx context.Context, cfg *v1.Settings_ControlPlaneTelemetry) error {
+	return Init(filepath.Base(os.Args[0])).Configure(ctx, cfg)
+}
+
+// Provider holds the trace and metric pipelines of a control plane component.
+// The exporters are replaced when the telemetry settings change, so the tracers handed out
+// before a change keep working.
+type Provider struct {
+	resource       *resource.Resource
+	tracerProvider *sdktrace.TracerProvider
+	sampler        *dynamicSampler
+	spanExporter   *dynamicSpanExporter
+	producer       sdkmetric.Producer
+
+	lock          sync.Mutex
+	configured    bool
+	config        *v1.Settings_ControlPlaneTelemetry
+	meterProvider *sdkmetric.MeterProvider
+}
+
+// NewProvider returns a provider that samples no traces and exports nothing until it is configured.
+func NewProvider(serviceName string) *Provider {
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.Version),
+	)
+	sampler := newDynamicSampler()
+	spanExporter := &dynamicSpanExporter{}
+	return &Provider{
+		resource: res,
+		tracerProvider: sdktrace.NewTracerProvider(
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sampler),
+			sdktrace.WithBatcher(spanExporter),
+		),
+		sampler:      sampler,
+		spanExporter: spanExporter,
+		producer:     NewOpenCensusProducer(),
+	}
+}
+
+// TracerProvider returns the provider of the tracers of the component.
+func (p *Provider) TracerProvider() trace.TracerProvider {
+	return p.tracerProvider
+}
+
+// Configure builds the OTLP exporters of the given settings and swaps them in.
+// It is a no-op if the settings did not change since the last call.
+func (p *Provider) Configure(ctx context.Context, cfg *v1.Settings_ControlPlaneTelemetry) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.configured && p.config.Equal(cfg) {
+		return nil
+	}
+
+	var (
+		spanExporter sdktrace.SpanExporter
+		reader       sdkmetric.Reader
+	)
+	if cfg.GetOtlpEndpoint() != "" {
+		if cfg.GetTracesEnabled() == nil || cfg.GetTracesEnabled().GetValue() {
+			exporter, err := otlptracegrpc.New(ctx, traceOptions(cfg)...)
+			if err != nil {
+				return err
+			}
+			spanExporter = exporter
+		}
+		if cfg.GetMetricsEnabled() == nil || cfg.GetMetricsEnabled().GetValue() {
+			exporter, err := otlpmetricgrpc.New(ctx, metricOptions(cfg)...)
+			if err != nil {
+				if spanExporter != nil {
+					_ = spanExporter.Shutdown(ctx)
+				}
+				return err
+			}
+			reader = sdkmetric.NewPeriodicReader(exporter,
+				sdkmetric.WithInterval(metricExportInterval(cfg)),
+				sdkmetric.WithProducer(p.producer),
+			)
+		}
+	}
+
+	p.setExporters(spanExporter, samplingRatio(cfg), reader)
+	p.configured = true
+	p.config = cfg.Clone().(*v1.Settings_ControlPlaneTelemetry)
+	contextutils.LoggerFrom(ctx).Infof("configured control plane telemetry: endpoint %q, traces %v, metrics %v",
+		cfg.GetOtlpEndpoint(), spanExporter != nil, reader != nil)
+	return nil
+}
+
+// setExporters replaces the span exporter and the metric reader, shutting down the previous ones.
+// Traces are not sampled when there is no span exporter.
+func (p *Provider) setExporters(spanExporter sdktrace.SpanExporter, ratio float64, reader sdkmetric.Reader) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if spanExporter == nil {
+		p.sampler.set(sdktrace.NeverSample())
+	} else {
+		p.sampler.set(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio)))
+	}
+	// flush the spans of the previous exporter before it is replaced
+	_ = p.tracerProvider.ForceFlush(ctx)
+	p.spanExporter.set(ctx, spanExporter)
+
+	if p.meterProvider != nil {
+		_ = p.meterProvider.Shutdown(ctx)
+		p.meterProvider = nil
+	}
+	if reader != nil {
+		p.meterProvider = sdkmetric.NewMeterProvider(
+			sdkmetric.WithResource(p.resource),
+			sdkmetric.WithReader(reader),
+		)
+	}
+}
+
+// Shutdown flushes and stops the exporters.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.meterProvider != nil {
+		if err := p.meterProvider.Shutdown(ctx); err != nil {
+			return err
+		}
+		p.meterProvider = nil
+	}
+	return p.tracerProvider.Shutdown(ctx)
+}
+
+func traceOptions(cfg *v1.Settings_ControlPlaneTelemetry) []otlptracegrpc.Option {
+	var opts []otlptracegrpc.Option
+	if strings.Contains(cfg.GetOtlpEndpoint(), "://") {
+		opts = append(opts, otlptracegrpc.WithEndpointURL(cfg.GetOtlpEndpoint()))
+	} else {
+		opts = append(opts, otlptracegrpc.WithEndpoint(cfg.GetOtlpEndpoint()))
+	}
+	if cfg.GetInsecure() {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	if len(cfg.GetHeaders()) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(cfg.GetHeaders()))
+	}
+	return opts
+}
+
+func metricOptions(cfg *v1.Settings_ControlPlaneTelemetry) []otlpmetricgrpc.Option {
+	var opts []otlpmetricgrpc.Option
+	if strings.Contains(cfg.GetOtlpEndpoint(), "://") {
+		opts = append(opts, otlpmetricgrpc.WithEndpointURL(cfg.GetOtlpEndpoint()))
+	} else {
+		opts = append(opts, otlpmetricgrpc.WithEndpoint(cfg.GetOtlpEndpoint()))
+	}
+	if cfg.GetInsecure() {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+	if len(cfg.GetHeaders()) > 0 {
+		opts = append(opts, otlpmetricgrpc.WithHeaders(cfg.GetHeaders()))
+	}
+	return opts
+}
+
+func samplingRatio(cfg *v1.Settings_ControlPlaneTelemetry) float64 {
+	if cfg.GetSamplingRatio() == nil {
+		return 1
+	}
+	return cfg.GetSamplingRatio().GetValue()
+}
+
+func metricExportInterval(cfg *v1.Settings_ControlPlaneTelemetry) time.Duration {
+	if interval := cfg.GetMetricExportInterval(); interval != nil && interval.AsDuration() > 0 {
+		return interval.AsDuration()
+	}
+	return defaultMetricExportInterval
+}
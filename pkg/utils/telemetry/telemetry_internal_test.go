@@ -0,0 +1,127 @@
+package telemetry
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var _ = Describe("Provider", func() {
+
+	var (
+		ctx      context.Context
+		provider *Provider
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		provider = NewProvider("test")
+		DeferCleanup(func() {
+			_ = provider.Shutdown(ctx)
+		})
+	})
+
+	startSpan := func() {
+		_, span := provider.TracerProvider().Tracer("test").Start(ctx, "span")
+		span.End()
+	}
+
+	It("does not sample traces until it is configured", func() {
+		_, span := provider.TracerProvider().Tracer("test").Start(ctx, "span")
+		defer span.End()
+		Expect(span.IsRecording()).To(BeFalse())
+	})
+
+	It("exports the spans to the current exporter", func() {
+		first := tracetest.NewInMemoryExporter()
+		provider.setExporters(first, 1, nil)
+		startSpan()
+		Expect(provider.tracerProvider.ForceFlush(ctx)).To(Succeed())
+		Expect(first.GetSpans().Snapshots()).To(HaveLen(1))
+
+		second := tracetest.NewInMemoryExporter()
+		provider.setExporters(second, 1, nil)
+		startSpan()
+		Expect(provider.tracerProvider.ForceFlush(ctx)).To(Succeed())
+		Expect(second.GetSpans().Snapshots()).To(HaveLen(1))
+		// the previous exporter was shut down, which resets it
+		Expect(first.GetSpans()).To(BeEmpty())
+
+		provider.setExporters(nil, 1, nil)
+		_, span := provider.TracerProvider().Tracer("test").Start(ctx, "span")
+		defer span.End()
+		Expect(span.IsRecording()).To(BeFalse())
+	})
+
+	It("samples traces from the settings", func() {
+		Expect(provider.Configure(ctx, &v1.Settings_ControlPlaneTelemetry{
+			OtlpEndpoint:   "localhost:4317",
+			Insecure:       true,
+			MetricsEnabled: wrapperspb.Bool(false),
+		})).To(Succeed())
+		_, span := provider.TracerProvider().Tracer("test").Start(ctx, "span")
+		Expect(span.IsRecording()).To(BeTrue())
+
+		Expect(provider.Configure(ctx, &v1.Settings_ControlPlaneTelemetry{
+			OtlpEndpoint:   "localhost:4317",
+			TracesEnabled:  wrapperspb.Bool(false),
+			MetricsEnabled: wrapperspb.Bool(false),
+		})).To(Succeed())
+		_, span = provider.TracerProvider().Tracer("test").Start(ctx, "span")
+		Expect(span.IsRecording()).To(BeFalse())
+		Expect(provider.sampler.Description()).To(Equal(sdktrace.NeverSample().Description()))
+
+		Expect(provider.Configure(ctx, nil)).To(Succeed())
+		Expect(provider.meterProvider).To(BeNil())
+	})
+
+	It("exports the OpenCensus metrics under their names", func() {
+		measure := stats.Int64("gloo.solo.io/telemetry_test/requests", "test requests", stats.UnitDimensionless)
+		key, err := tag.NewKey("proxy")
+		Expect(err).NotTo(HaveOccurred())
+		requestsView := &view.View{
+			Name:        measure.Name(),
+			Measure:     measure,
+			Description: measure.Description(),
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{key},
+		}
+		Expect(view.Register(requestsView)).To(Succeed())
+		DeferCleanup(view.Unregister, requestsView)
+
+		Expect(stats.RecordWithTags(ctx, []tag.Mutator{tag.Insert(key, "gateway-proxy")}, measure.M(3))).To(Succeed())
+
+		reader := sdkmetric.NewManualReader(sdkmetric.WithProducer(provider.producer))
+		meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+		DeferCleanup(meterProvider.Shutdown, ctx)
+		collect := func() []metricdata.DataPoint[int64] {
+			var rm metricdata.ResourceMetrics
+			Expect(reader.Collect(ctx, &rm)).To(Succeed())
+			for _, scope := range rm.ScopeMetrics {
+				for _, metric := range scope.Metrics {
+					if metric.Name == requestsView.Name {
+						return metric.Data.(metricdata.Sum[int64]).DataPoints
+					}
+				}
+			}
+			return nil
+		}
+
+		Eventually(collect).Should(ConsistOf(And(
+			HaveField("Value", BeEquivalentTo(3)),
+			HaveField("Attributes", Equal(attribute.NewSet(attribute.String("proxy", "gateway-proxy")))),
+		)))
+	})
+})
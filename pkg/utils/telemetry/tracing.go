@@ -0,0 +1,35 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/solo-io/gloo"
+
+// Attribute keys shared by the spans of the control plane
+const (
+	ProxyKey     = attribute.Key("gloo.proxy")
+	NodeKey      = attribute.Key("gloo.xds.node")
+	ResourceKind = attribute.Key("gloo.resource.kind")
+	SyncerKey    = attribute.Key("gloo.syncer")
+)
+
+// StartSpan starts a span of the control plane with the tracer provider of the process.
+// The span is a no-op until traces are enabled in the Settings.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the error, if any, on the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
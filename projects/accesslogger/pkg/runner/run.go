@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os/signal"
+	"syscall"
 
 	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
 	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	"github.com/solo-io/gloo/pkg/utils/statsutils"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/healthchecker"
@@ -25,6 +29,8 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
+const accessLoggerComponentName = "access-logger"
+
 func init() {
 	view.Register(ocgrpc.DefaultServerViews...)
 	view.Register(accessLogsRequestsView, accessLogsDownstreamRespTimeView, accessLogsUpstreamRespTimeView)
@@ -69,7 +75,10 @@ var (
 
 func Run() {
 	clientSettings := NewSettings()
-	ctx := contextutils.WithLogger(context.Background(), "access_log")
+	// the server stops on SIGTERM, after which the pending entries of the sinks are flushed
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	ctx = contextutils.WithLogger(ctx, "access_log")
 
 	if clientSettings.DebugPort != 0 {
 		// TODO(yuval-k): we need to start the stats server before calling contextutils
@@ -77,98 +86,33 @@ func Run() {
 		stats.StartStatsServerWithPort(stats.StartupOptions{Port: clientSettings.DebugPort})
 	}
 
+	if err := telemetry.Init(accessLoggerComponentName).Configure(ctx, clientSettings.Telemetry()); err != nil {
+		contextutils.LoggerFrom(ctx).Errorw("failed to configure telemetry", zap.Error(err))
+	}
+
+	callbacks := loggingservice.AlsCallbackList{measureAccessLogs}
+	if clientSettings.ConfigFile == "" {
+		callbacks = append(callbacks, logAccessLogs)
+	} else {
+		cfg, err := sinks.LoadConfig(clientSettings.ConfigFile)
+		if err != nil {
+			panic(err)
+		}
+		accessLogSinks, err := sinks.NewSinks(ctx, cfg)
+		if err != nil {
+			panic(err)
+		}
+		defer func() {
+			if err := accessLogSinks.Close(); err != nil {
+				contextutils.LoggerFrom(ctx).Errorw("failed to flush access log sinks", zap.Error(err))
+			}
+		}()
+		callbacks = append(callbacks, accessLogSinks.Callback())
+	}
+
 	opts := loggingservice.Options{
-		Callbacks: loggingservice.AlsCallbackList{
-			func(ctx context.Context, message *pb.StreamAccessLogsMessage) error {
-				logger := contextutils.LoggerFrom(ctx)
-				switch msg := message.GetLogEntries().(type) {
-				case *pb.StreamAccessLogsMessage_HttpLogs:
-					for _, v := range msg.HttpLogs.GetLogEntry() {
-
-						meta := v.GetCommonProperties().GetMetadata().GetFilterMetadata()
-						// we could put any other kind of data into the transformation metadata, including more
-						// detailed request info or info that gets dropped once translated into envoy config. For
-						// example, virtual service name, virtual service namespace, virtual service base path,
-						// virtual service route (operation path), the request/response body, etc.
-						//
-						// transformations can live at the virtual host, route, and weighted destination level on the
-						// `Proxy`, so users can add very granular information to the transformation filter metadata by
-						// configuring transformations on VirtualServices, RouteTables, and/or UpstreamGroups.
-						//
-						// follow the guide here to create requests with the proper transformation to populate 'pod_name' in the access logs:
-						// https://docs.solo.io/gloo-edge/latest/guides/traffic_management/request_processing/transformations/enrich_access_logs/#update-virtual-service
-						podName := getTransformationValueFromDynamicMetadata("pod_name", meta)
-
-						// we could change the claim to any other jwt claim, such as client_id
-						//
-						// follow the guide here to create requests with a jwt that has the 'iss' claim, to populate issuer in the access logs:
-						// https://docs.solo.io/gloo-edge/latest/guides/security/auth/jwt/access_control/#appendix---use-a-remote-json-web-key-set-jwks-server
-						issuer := getClaimFromJwtInDynamicMetadata("iss", meta)
-
-						statsutils.MeasureOne(
-							ctx,
-							mAccessLogsRequests,
-							tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
-							tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
-							tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
-
-						// this includes the time filters take during the processing of the request and response.
-						downstreamRespTime := v.GetCommonProperties().GetTimeToLastDownstreamTxByte()
-						downstreamRespTimeNs := int64(downstreamRespTime.GetNanos()) + (downstreamRespTime.GetSeconds()*1 ^ 9)
-
-						// if envoy is buffering the request before sending upstream, you want the following
-						upstreamRespTimeNs := lastToFirstNs(v)
-						// otherwise, you want this
-						// upstreamRespTimeNs := firstToFirstNs(v)
-
-						statsutils.Measure(
-							ctx,
-							mAccessLogsDownstreamRespTime,
-							downstreamRespTimeNs,
-							tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
-							tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
-							tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
-
-						statsutils.Measure(
-							ctx,
-							mAccessLogsUpstreamRespTime,
-							upstreamRespTimeNs,
-							tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
-							tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
-							tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
-
-						logger.With(
-							zap.Any("protocol_version", v.GetProtocolVersion()),
-							zap.Any("request_path", v.GetRequest().GetPath()),
-							zap.Any("request_original_path", v.GetRequest().GetOriginalPath()),
-							zap.Any("request_method", v.GetRequest().GetRequestMethod().String()),
-							zap.Any("request_headers", v.GetRequest().GetRequestHeaders()),
-							zap.Any("response_code", v.GetResponse().GetResponseCode().String()),
-							zap.Any("response_headers", v.GetResponse().GetResponseHeaders()),
-							zap.Any("response_trailers", v.GetResponse().GetResponseTrailers()),
-							zap.Any("cluster", v.GetCommonProperties().GetUpstreamCluster()),
-							zap.Any("upstream_remote_address", v.GetCommonProperties().GetUpstreamRemoteAddress()),
-							zap.Any("issuer", issuer),                                     // requires jwt set up and jwt with 'iss' claim to be non-empty
-							zap.Any("pod_name", podName),                                  // requires transformation set up with dynamic metadata (with 'pod_name' key) to be non-empty
-							zap.Any("route_name", v.GetCommonProperties().GetRouteName()), // empty by default, but name can be set on routes in virtual services or route tables
-							zap.Any("start_time", v.GetCommonProperties().GetStartTime()),
-							zap.Any("downstream_resp_time", downstreamRespTimeNs),
-							zap.Any("upstream_resp_time", upstreamRespTimeNs),
-							zap.Any("filter_state_objects", v.GetCommonProperties().GetFilterStateObjects()),
-						).Info("received http request")
-					}
-				case *pb.StreamAccessLogsMessage_TcpLogs:
-					for _, v := range msg.TcpLogs.GetLogEntry() {
-						logger.With(
-							zap.Any("upstream_cluster", v.GetCommonProperties().GetUpstreamCluster()),
-							zap.Any("route_name", v.GetCommonProperties().GetRouteName()),
-						).Info("received tcp request")
-					}
-				}
-				return nil
-			},
-		},
-		Ctx: ctx,
+		Callbacks: callbacks,
+		Ctx:       ctx,
 	}
 	service := loggingservice.NewServer(opts)
 
@@ -182,6 +126,113 @@ func Run() {
 	}
 }
 
+// measureAccessLogs records metrics for every http access log.
+func measureAccessLogs(ctx context.Context, message *pb.StreamAccessLogsMessage) error {
+	httpLogs, ok := message.GetLogEntries().(*pb.StreamAccessLogsMessage_HttpLogs)
+	if !ok {
+		return nil
+	}
+	for _, v := range httpLogs.HttpLogs.GetLogEntry() {
+		statsutils.MeasureOne(
+			ctx,
+			mAccessLogsRequests,
+			tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
+			tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
+			tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
+
+		statsutils.Measure(
+			ctx,
+			mAccessLogsDownstreamRespTime,
+			downstreamRespTimeNs(v),
+			tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
+			tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
+			tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
+
+		statsutils.Measure(
+			ctx,
+			mAccessLogsUpstreamRespTime,
+			upstreamRespTimeNs(v),
+			tag.Insert(responseCodeKey, v.GetResponse().GetResponseCode().String()),
+			tag.Insert(clusterKey, v.GetCommonProperties().GetUpstreamCluster()),
+			tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
+	}
+	return nil
+}
+
+// logAccessLogs logs every access log with the logger of the access logger. It is used when
+// no sinks are configured.
+func logAccessLogs(ctx context.Context, message *pb.StreamAccessLogsMessage) error {
+	logger := contextutils.LoggerFrom(ctx)
+	switch msg := message.GetLogEntries().(type) {
+	case *pb.StreamAccessLogsMessage_HttpLogs:
+		for _, v := range msg.HttpLogs.GetLogEntry() {
+
+			meta := v.GetCommonProperties().GetMetadata().GetFilterMetadata()
+			// we could put any other kind of data into the transformation metadata, including more
+			// detailed request info or info that gets dropped once translated into envoy config. For
+			// example, virtual service name, virtual service namespace, virtual service base path,
+			// virtual service route (operation path), the request/response body, etc.
+			//
+			// transformations can live at the virtual host, route, and weighted destination level on the
+			// `Proxy`, so users can add very granular information to the transformation filter metadata by
+			// configuring transformations on VirtualServices, RouteTables, and/or UpstreamGroups.
+			//
+			// follow the guide here to create requests with the proper transformation to populate 'pod_name' in the access logs:
+			// https://docs.solo.io/gloo-edge/latest/guides/traffic_management/request_processing/transformations/enrich_access_logs/#update-virtual-service
+			//
+			// the same can be achieved without code changes by configuring sinks with a `transformation` field mapping.
+			podName := getTransformationValueFromDynamicMetadata("pod_name", meta)
+
+			// we could change the claim to any other jwt claim, such as client_id
+			//
+			// follow the guide here to create requests with a jwt that has the 'iss' claim, to populate issuer in the access logs:
+			// https://docs.solo.io/gloo-edge/latest/guides/security/auth/jwt/access_control/#appendix---use-a-remote-json-web-key-set-jwks-server
+			issuer := getClaimFromJwtInDynamicMetadata("iss", meta)
+
+			logger.With(
+				zap.Any("protocol_version", v.GetProtocolVersion()),
+				zap.Any("request_path", v.GetRequest().GetPath()),
+				zap.Any("request_original_path", v.GetRequest().GetOriginalPath()),
+				zap.Any("request_method", v.GetRequest().GetRequestMethod().String()),
+				zap.Any("request_headers", v.GetRequest().GetRequestHeaders()),
+				zap.Any("response_code", v.GetResponse().GetResponseCode().String()),
+				zap.Any("response_headers", v.GetResponse().GetResponseHeaders()),
+				zap.Any("response_trailers", v.GetResponse().GetResponseTrailers()),
+				zap.Any("cluster", v.GetCommonProperties().GetUpstreamCluster()),
+				zap.Any("upstream_remote_address", v.GetCommonProperties().GetUpstreamRemoteAddress()),
+				zap.Any("issuer", issuer),                                     // requires jwt set up and jwt with 'iss' claim to be non-empty
+				zap.Any("pod_name", podName),                                  // requires transformation set up with dynamic metadata (with 'pod_name' key) to be non-empty
+				zap.Any("route_name", v.GetCommonProperties().GetRouteName()), // empty by default, but name can be set on routes in virtual services or route tables
+				zap.Any("start_time", v.GetCommonProperties().GetStartTime()),
+				zap.Any("downstream_resp_time", downstreamRespTimeNs(v)),
+				zap.Any("upstream_resp_time", upstreamRespTimeNs(v)),
+				zap.Any("filter_state_objects", v.GetCommonProperties().GetFilterStateObjects()),
+			).Info("received http request")
+		}
+	case *pb.StreamAccessLogsMessage_TcpLogs:
+		for _, v := range msg.TcpLogs.GetLogEntry() {
+			logger.With(
+				zap.Any("upstream_cluster", v.GetCommonProperties().GetUpstreamCluster()),
+				zap.Any("route_name", v.GetCommonProperties().GetRouteName()),
+			).Info("received tcp request")
+		}
+	}
+	return nil
+}
+
+// downstreamRespTimeNs includes the time filters take during the processing of the request and response.
+func downstreamRespTimeNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	downstreamRespTime := entry.GetCommonProperties().GetTimeToLastDownstreamTxByte()
+	return int64(downstreamRespTime.GetNanos()) + (downstreamRespTime.GetSeconds()*1 ^ 9)
+}
+
+func upstreamRespTimeNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	// if envoy is buffering the request before sending upstream, you want the following
+	return lastToFirstNs(entry)
+	// otherwise, you want this
+	// return firstToFirstNs(entry)
+}
+
 func RunWithSettings(ctx context.Context, service *loggingservice.Server, clientSettings Settings) error {
 	err := StartAccessLog(ctx, clientSettings, service)
 	if ctx.Err() != nil {
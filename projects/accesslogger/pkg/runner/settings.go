@@ -2,12 +2,21 @@ package runner
 
 import (
 	"github.com/kelseyhightower/envconfig"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 )
 
 type Settings struct {
 	DebugPort   int    `envconfig:"DEBUG_PORT" default:"9091"`
 	ServerPort  int    `envconfig:"SERVER_PORT" default:"8083"`
 	ServiceName string `envconfig:"SERVICE_NAME" default:"AccessLog"`
+	// ConfigFile is the path to a sinks config. When it is not set, access logs are logged by the access logger itself.
+	ConfigFile string `envconfig:"CONFIG_FILE"`
+	// The access logger does not watch the gloo Settings, so the OTLP collector of the control plane telemetry
+	// is passed through the environment. Nothing is exported when the endpoint is not set.
+	OtlpEndpoint string            `envconfig:"OTLP_ENDPOINT"`
+	OtlpInsecure bool              `envconfig:"OTLP_INSECURE"`
+	OtlpHeaders  map[string]string `envconfig:"OTLP_HEADERS"`
 }
 
 func NewSettings() Settings {
@@ -20,3 +29,12 @@ func NewSettings() Settings {
 
 	return s
 }
+
+// Telemetry returns the control plane telemetry settings of the access logger.
+func (s Settings) Telemetry() *v1.Settings_ControlPlaneTelemetry {
+	return &v1.Settings_ControlPlaneTelemetry{
+		OtlpEndpoint: s.OtlpEndpoint,
+		Insecure:     s.OtlpInsecure,
+		Headers:      s.OtlpHeaders,
+	}
+}
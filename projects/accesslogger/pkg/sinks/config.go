@@ -0,0 +1,222 @@
+package sinks
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultMaxBatchSize  = 100
+	defaultQueueSize     = 1000
+	defaultMaxRetries    = 3
+	defaultFlushInterval = time.Second
+	defaultRetryBackoff  = 500 * time.Millisecond
+	defaultExportTimeout = 10 * time.Second
+)
+
+// Config configures how access logs received by the access logger are processed and where they are written.
+type Config struct {
+	// Fields are extracted from every access log entry, in addition to the default fields.
+	Fields []FieldMapping `json:"fields,omitempty"`
+	// DisableDefaultFields only writes the configured Fields, and not the default request and response fields.
+	DisableDefaultFields bool `json:"disableDefaultFields,omitempty"`
+	// Sinks are the destinations every access log entry is written to.
+	Sinks []SinkConfig `json:"sinks"`
+}
+
+// SinkConfig configures a single sink. Exactly one of Stdout, File, OTLP or Kafka must be set.
+type SinkConfig struct {
+	// Name identifies the sink in logs and metrics. Defaults to the sink type and its index.
+	Name   string            `json:"name,omitempty"`
+	Stdout *StdoutSinkConfig `json:"stdout,omitempty"`
+	File   *FileSinkConfig   `json:"file,omitempty"`
+	OTLP   *OTLPSinkConfig   `json:"otlp,omitempty"`
+	Kafka  *KafkaSinkConfig  `json:"kafka,omitempty"`
+	// Batch configures how entries are batched and retried before they are written to the sink.
+	Batch BatchConfig `json:"batch,omitempty"`
+}
+
+// StdoutSinkConfig writes access log entries to stdout as JSON lines.
+type StdoutSinkConfig struct{}
+
+// FileSinkConfig writes access log entries to a file as JSON lines. The file is rotated once it reaches MaxSizeMB.
+type FileSinkConfig struct {
+	Path string `json:"path"`
+	// MaxSizeMB is the size of the file before it gets rotated. Defaults to 100 megabytes.
+	MaxSizeMB int `json:"maxSizeMb,omitempty"`
+	// MaxBackups is the maximum number of rotated files to retain. Defaults to retaining all of them.
+	MaxBackups int `json:"maxBackups,omitempty"`
+	// MaxAgeDays is the maximum number of days to retain rotated files. Defaults to retaining them forever.
+	MaxAgeDays int `json:"maxAgeDays,omitempty"`
+	// Compress rotated files with gzip.
+	Compress bool `json:"compress,omitempty"`
+}
+
+// OTLPSinkConfig forwards access log entries to an OpenTelemetry collector with the OTLP/gRPC logs protocol.
+type OTLPSinkConfig struct {
+	// Endpoint is the host:port of the OTLP gRPC receiver.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the receiver.
+	Insecure bool `json:"insecure,omitempty"`
+	// Headers are sent as gRPC metadata with every export request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// ServiceName is set as the service.name resource attribute. Defaults to gloo-access-logger.
+	ServiceName string `json:"serviceName,omitempty"`
+	// Timeout of each export request. Defaults to 10s.
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// KafkaSinkConfig produces access log entries to a Kafka topic through a Kafka REST proxy
+// that implements the v2 produce API, such as the Confluent REST Proxy or the Redpanda HTTP Proxy.
+type KafkaSinkConfig struct {
+	// URL of the REST proxy, e.g. http://rest-proxy:8082.
+	URL   string `json:"url"`
+	Topic string `json:"topic"`
+	// KeyField is the name of the entry field used as the record key. Records have no key by default.
+	KeyField string `json:"keyField,omitempty"`
+	// Headers are added to every produce request, e.g. for authentication.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Timeout of each produce request. Defaults to 10s.
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// OverflowPolicy decides what happens to new entries when the queue of a sink is full.
+type OverflowPolicy string
+
+const (
+	// OverflowPolicyBlock applies backpressure: the access log stream from Envoy is paused until the queue has room.
+	OverflowPolicyBlock OverflowPolicy = "block"
+	// OverflowPolicyDrop drops new entries until the queue has room.
+	OverflowPolicyDrop OverflowPolicy = "drop"
+)
+
+// BatchConfig configures the batching and retry pipeline in front of a sink.
+type BatchConfig struct {
+	// MaxSize is the maximum number of entries written to the sink at once. Defaults to 100.
+	MaxSize int `json:"maxSize,omitempty"`
+	// FlushInterval is the maximum time an entry waits for its batch to fill up. Defaults to 1s.
+	FlushInterval metav1.Duration `json:"flushInterval,omitempty"`
+	// QueueSize is the maximum number of entries waiting to be batched. Defaults to 1000.
+	QueueSize int `json:"queueSize,omitempty"`
+	// MaxRetries is the number of times a failed batch is retried before it is dropped. Defaults to 3.
+	MaxRetries *int `json:"maxRetries,omitempty"`
+	// RetryBackoff is the delay before the first retry, doubled for every following retry. Defaults to 500ms.
+	RetryBackoff metav1.Duration `json:"retryBackoff,omitempty"`
+	// Overflow decides what happens when the queue is full. Defaults to block.
+	Overflow OverflowPolicy `json:"overflow,omitempty"`
+}
+
+// LoadConfig reads a YAML or JSON Config from a file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading access logger config %s", path)
+	}
+	var cfg Config
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, errors.Wrapf(err, "parsing access logger config %s", path)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid access logger config %s", path)
+	}
+	return &cfg, nil
+}
+
+// Validate returns an error if the config can not be used.
+func (c *Config) Validate() error {
+	for i, field := range c.Fields {
+		if err := field.validate(); err != nil {
+			return errors.Wrapf(err, "fields[%d]", i)
+		}
+	}
+	if len(c.Sinks) == 0 {
+		return errors.New("at least one sink must be configured")
+	}
+	for i, sink := range c.Sinks {
+		if err := sink.validate(); err != nil {
+			return errors.Wrapf(err, "sinks[%d]", i)
+		}
+	}
+	return nil
+}
+
+func (s SinkConfig) validate() error {
+	set := 0
+	for _, isSet := range []bool{s.Stdout != nil, s.File != nil, s.OTLP != nil, s.Kafka != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("exactly one of stdout, file, otlp or kafka must be set")
+	}
+	switch {
+	case s.File != nil && s.File.Path == "":
+		return errors.New("file.path must be set")
+	case s.OTLP != nil && s.OTLP.Endpoint == "":
+		return errors.New("otlp.endpoint must be set")
+	case s.Kafka != nil && (s.Kafka.URL == "" || s.Kafka.Topic == ""):
+		return errors.New("kafka.url and kafka.topic must be set")
+	}
+	switch s.Batch.Overflow {
+	case "", OverflowPolicyBlock, OverflowPolicyDrop:
+	default:
+		return errors.Errorf("unknown batch.overflow policy %q", s.Batch.Overflow)
+	}
+	return nil
+}
+
+// sinkName returns the name of the sink at the given index.
+func (s SinkConfig) sinkName(index int) string {
+	if s.Name != "" {
+		return s.Name
+	}
+	var sinkType string
+	switch {
+	case s.Stdout != nil:
+		sinkType = "stdout"
+	case s.File != nil:
+		sinkType = "file"
+	case s.OTLP != nil:
+		sinkType = "otlp"
+	case s.Kafka != nil:
+		sinkType = "kafka"
+	}
+	return fmt.Sprintf("%s-%d", sinkType, index)
+}
+
+// withDefaults returns the batch config with defaults set for all the unset fields.
+func (b BatchConfig) withDefaults() BatchConfig {
+	if b.MaxSize <= 0 {
+		b.MaxSize = defaultMaxBatchSize
+	}
+	if b.FlushInterval.Duration <= 0 {
+		b.FlushInterval.Duration = defaultFlushInterval
+	}
+	if b.QueueSize <= 0 {
+		b.QueueSize = defaultQueueSize
+	}
+	if b.MaxRetries == nil {
+		maxRetries := defaultMaxRetries
+		b.MaxRetries = &maxRetries
+	}
+	if b.RetryBackoff.Duration <= 0 {
+		b.RetryBackoff.Duration = defaultRetryBackoff
+	}
+	if b.Overflow == "" {
+		b.Overflow = OverflowPolicyBlock
+	}
+	return b
+}
+
+func timeoutOrDefault(timeout metav1.Duration) time.Duration {
+	if timeout.Duration <= 0 {
+		return defaultExportTimeout
+	}
+	return timeout.Duration
+}
@@ -0,0 +1,59 @@
+package sinks_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+)
+
+var _ = Describe("LoadConfig", func() {
+
+	writeConfig := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+		return path
+	}
+
+	It("loads a valid config", func() {
+		cfg, err := sinks.LoadConfig(writeConfig(`
+fields:
+- name: pod_name
+  source: transformation
+  key: pod_name
+sinks:
+- stdout: {}
+- name: archive
+  file:
+    path: /var/log/access.log
+    maxSizeMb: 10
+  batch:
+    maxSize: 50
+    flushInterval: 5s
+    overflow: drop
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Fields).To(ConsistOf(sinks.FieldMapping{Name: "pod_name", Source: sinks.FieldSourceTransformation, Key: "pod_name"}))
+		Expect(cfg.Sinks).To(HaveLen(2))
+		Expect(cfg.Sinks[1].File.MaxSizeMB).To(Equal(10))
+		Expect(cfg.Sinks[1].Batch.FlushInterval.Seconds()).To(Equal(5.0))
+		Expect(cfg.Sinks[1].Batch.Overflow).To(Equal(sinks.OverflowPolicyDrop))
+	})
+
+	DescribeTable("rejects invalid configs",
+		func(content, expectedErr string) {
+			_, err := sinks.LoadConfig(writeConfig(content))
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("no sinks", `sinks: []`, "at least one sink must be configured"),
+		Entry("unknown fields", `sinks: [{stdout: {}, unknown: true}]`, "unknown field"),
+		Entry("several sink types", `sinks: [{stdout: {}, file: {path: /tmp/log}}]`, "exactly one of"),
+		Entry("missing file path", `sinks: [{file: {}}]`, "file.path must be set"),
+		Entry("unknown overflow policy", `sinks: [{stdout: {}, batch: {overflow: wait}}]`, "unknown batch.overflow policy"),
+		Entry("unknown field source", `{fields: [{name: a, key: b, source: cookie}], sinks: [{stdout: {}}]}`, "unknown source"),
+		Entry("filter metadata without namespace", `{fields: [{name: a, key: b, source: filterMetadata}], sinks: [{stdout: {}}]}`, "namespace must be set"),
+	)
+})
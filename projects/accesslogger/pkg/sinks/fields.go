@@ -0,0 +1,274 @@
+package sinks
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	errors "github.com/rotisserie/eris"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+)
+
+const jwtAuthnFilterName = "envoy.filters.http.jwt_authn"
+
+// Entry is a single access log entry, keyed by field name. Values are JSON-compatible:
+// strings, int64s, float64s, bools, nil, and nested map[string]any and []any values.
+type Entry map[string]any
+
+// FieldSource is where the value of a FieldMapping is read from.
+type FieldSource string
+
+const (
+	// FieldSourceRequestHeader reads a request header. Envoy only sends the request headers
+	// listed in `additionalRequestHeadersToLog` on the access logging service config.
+	FieldSourceRequestHeader FieldSource = "requestHeader"
+	// FieldSourceResponseHeader reads a response header. Envoy only sends the response headers
+	// listed in `additionalResponseHeadersToLog` on the access logging service config.
+	FieldSourceResponseHeader FieldSource = "responseHeader"
+	// FieldSourceFilterMetadata reads a key from the dynamic metadata of the filter named by Namespace.
+	FieldSourceFilterMetadata FieldSource = "filterMetadata"
+	// FieldSourceTransformation reads a key from the dynamic metadata set by transformations,
+	// e.g. the `pod_name` set by the enrich access logs guide.
+	FieldSourceTransformation FieldSource = "transformation"
+	// FieldSourceJwtClaim reads a claim from the payload of a JWT verified by the jwt_authn filter.
+	FieldSourceJwtClaim FieldSource = "jwtClaim"
+	// FieldSourceFilterState reads a filter state object. Envoy only sends the objects
+	// listed in `filterStateObjectsToLog` on the access logging service config.
+	FieldSourceFilterState FieldSource = "filterState"
+)
+
+// FieldMapping adds a field to every access log entry, read from the given source.
+type FieldMapping struct {
+	// Name of the field in the access log entry.
+	Name   string      `json:"name"`
+	Source FieldSource `json:"source"`
+	// Key is the header name, metadata key, claim or filter state object to read.
+	Key string `json:"key"`
+	// Namespace is the filter metadata namespace. Only used by the filterMetadata source.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+func (f FieldMapping) validate() error {
+	if f.Name == "" || f.Key == "" {
+		return errors.New("name and key must be set")
+	}
+	switch f.Source {
+	case FieldSourceRequestHeader, FieldSourceResponseHeader, FieldSourceTransformation,
+		FieldSourceJwtClaim, FieldSourceFilterState:
+	case FieldSourceFilterMetadata:
+		if f.Namespace == "" {
+			return errors.New("namespace must be set for the filterMetadata source")
+		}
+	default:
+		return errors.Errorf("unknown source %q", f.Source)
+	}
+	return nil
+}
+
+// extract returns the value of the field. The request and response are nil for tcp access logs.
+func (f FieldMapping) extract(
+	common *envoy_data_accesslog_v3.AccessLogCommon,
+	request *envoy_data_accesslog_v3.HTTPRequestProperties,
+	response *envoy_data_accesslog_v3.HTTPResponseProperties,
+) (any, bool) {
+	filterMetadata := common.GetMetadata().GetFilterMetadata()
+	switch f.Source {
+	case FieldSourceRequestHeader:
+		value, ok := request.GetRequestHeaders()[f.Key]
+		return value, ok
+	case FieldSourceResponseHeader:
+		value, ok := response.GetResponseHeaders()[f.Key]
+		return value, ok
+	case FieldSourceFilterMetadata:
+		return structField(filterMetadata[f.Namespace], f.Key)
+	case FieldSourceTransformation:
+		return structField(filterMetadata[transformation.FilterName], f.Key)
+	case FieldSourceJwtClaim:
+		return jwtClaim(filterMetadata[jwtAuthnFilterName], f.Key)
+	case FieldSourceFilterState:
+		object, ok := common.GetFilterStateObjects()[f.Key]
+		if !ok {
+			return nil, false
+		}
+		// filter state objects are serialized as an Any; well-known wrappers are unpacked to their value
+		msg, err := object.UnmarshalNew()
+		if err != nil {
+			return nil, false
+		}
+		if value, ok := msg.(interface{ GetValue() string }); ok {
+			return value.GetValue(), true
+		}
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, false
+		}
+		return string(data), true
+	}
+	return nil, false
+}
+
+func structField(s *structpb.Struct, key string) (any, bool) {
+	value, ok := s.GetFields()[key]
+	if !ok {
+		return nil, false
+	}
+	return value.AsInterface(), true
+}
+
+// jwtClaim returns the claim from the first JWT payload that has it. The jwt_authn filter
+// stores every verified payload under the `payload_in_metadata` key of its provider.
+func jwtClaim(payloads *structpb.Struct, claim string) (any, bool) {
+	keys := make([]string, 0, len(payloads.GetFields()))
+	for key := range payloads.GetFields() {
+		keys = append(keys, key)
+	}
+	// sort for a deterministic result when several providers verified the request
+	sort.Strings(keys)
+	for _, key := range keys {
+		if value, ok := structField(payloads.GetFields()[key].GetStructValue(), claim); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// EntryBuilder converts the access logs sent by Envoy into entries.
+type EntryBuilder struct {
+	fields        []FieldMapping
+	defaultFields bool
+}
+
+// NewEntryBuilder returns an EntryBuilder that adds the given fields to every entry.
+func NewEntryBuilder(fields []FieldMapping, defaultFields bool) *EntryBuilder {
+	return &EntryBuilder{
+		fields:        fields,
+		defaultFields: defaultFields,
+	}
+}
+
+// Build returns an entry for every access log in the message.
+func (b *EntryBuilder) Build(message *pb.StreamAccessLogsMessage) []Entry {
+	var entries []Entry
+	switch msg := message.GetLogEntries().(type) {
+	case *pb.StreamAccessLogsMessage_HttpLogs:
+		for _, v := range msg.HttpLogs.GetLogEntry() {
+			entries = append(entries, b.buildHttp(message.GetIdentifier(), v))
+		}
+	case *pb.StreamAccessLogsMessage_TcpLogs:
+		for _, v := range msg.TcpLogs.GetLogEntry() {
+			entries = append(entries, b.buildTcp(message.GetIdentifier(), v))
+		}
+	}
+	return entries
+}
+
+func (b *EntryBuilder) buildHttp(identifier *pb.StreamAccessLogsMessage_Identifier, v *envoy_data_accesslog_v3.HTTPAccessLogEntry) Entry {
+	entry := Entry{}
+	if b.defaultFields {
+		b.addCommonFields(entry, "http", identifier, v.GetCommonProperties())
+		entry["protocol_version"] = v.GetProtocolVersion().String()
+		entry["request_path"] = v.GetRequest().GetPath()
+		entry["request_original_path"] = v.GetRequest().GetOriginalPath()
+		entry["request_method"] = v.GetRequest().GetRequestMethod().String()
+		entry["request_authority"] = v.GetRequest().GetAuthority()
+		entry["request_id"] = v.GetRequest().GetRequestId()
+		entry["user_agent"] = v.GetRequest().GetUserAgent()
+		entry["request_headers"] = stringMap(v.GetRequest().GetRequestHeaders())
+		entry["response_code"] = int64(v.GetResponse().GetResponseCode().GetValue())
+		entry["response_code_details"] = v.GetResponse().GetResponseCodeDetails()
+		entry["response_headers"] = stringMap(v.GetResponse().GetResponseHeaders())
+		entry["response_trailers"] = stringMap(v.GetResponse().GetResponseTrailers())
+		entry["request_body_bytes"] = int64(v.GetRequest().GetRequestBodyBytes())
+		entry["response_body_bytes"] = int64(v.GetResponse().GetResponseBodyBytes())
+	}
+	b.addFields(entry, v.GetCommonProperties(), v.GetRequest(), v.GetResponse())
+	return entry
+}
+
+func (b *EntryBuilder) buildTcp(identifier *pb.StreamAccessLogsMessage_Identifier, v *envoy_data_accesslog_v3.TCPAccessLogEntry) Entry {
+	entry := Entry{}
+	if b.defaultFields {
+		b.addCommonFields(entry, "tcp", identifier, v.GetCommonProperties())
+		entry["received_bytes"] = int64(v.GetConnectionProperties().GetReceivedBytes())
+		entry["sent_bytes"] = int64(v.GetConnectionProperties().GetSentBytes())
+	}
+	b.addFields(entry, v.GetCommonProperties(), nil, nil)
+	return entry
+}
+
+func (b *EntryBuilder) addCommonFields(
+	entry Entry,
+	logType string,
+	identifier *pb.StreamAccessLogsMessage_Identifier,
+	common *envoy_data_accesslog_v3.AccessLogCommon,
+) {
+	entry["type"] = logType
+	entry["log_name"] = identifier.GetLogName()
+	entry["node_id"] = identifier.GetNode().GetId()
+	entry["cluster"] = common.GetUpstreamCluster()
+	entry["route_name"] = common.GetRouteName()
+	entry["downstream_remote_address"] = formatAddress(common.GetDownstreamRemoteAddress())
+	entry["upstream_remote_address"] = formatAddress(common.GetUpstreamRemoteAddress())
+	if common.GetStartTime() != nil {
+		entry["start_time"] = common.GetStartTime().AsTime().Format(time.RFC3339Nano)
+	}
+	// this includes the time filters take during the processing of the request and response.
+	entry["downstream_resp_time"] = durationNs(common.GetTimeToLastDownstreamTxByte())
+	// this excludes the time filters take during the processing of the request and response.
+	entry["upstream_resp_time"] = durationNs(common.GetTimeToFirstUpstreamRxByte()) - durationNs(common.GetTimeToLastUpstreamTxByte())
+}
+
+func (b *EntryBuilder) addFields(
+	entry Entry,
+	common *envoy_data_accesslog_v3.AccessLogCommon,
+	request *envoy_data_accesslog_v3.HTTPRequestProperties,
+	response *envoy_data_accesslog_v3.HTTPResponseProperties,
+) {
+	for _, field := range b.fields {
+		if value, ok := field.extract(common, request, response); ok {
+			entry[field.Name] = value
+		} else {
+			entry[field.Name] = nil
+		}
+	}
+}
+
+func stringMap(m map[string]string) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+func durationNs(d *durationpb.Duration) int64 {
+	if d == nil {
+		return 0
+	}
+	return d.AsDuration().Nanoseconds()
+}
+
+func formatAddress(addr *envoy_config_core_v3.Address) string {
+	switch a := addr.GetAddress().(type) {
+	case *envoy_config_core_v3.Address_SocketAddress:
+		sa := a.SocketAddress
+		if named := sa.GetNamedPort(); named != "" {
+			return net.JoinHostPort(sa.GetAddress(), named)
+		}
+		return net.JoinHostPort(sa.GetAddress(), strconv.FormatUint(uint64(sa.GetPortValue()), 10))
+	case *envoy_config_core_v3.Address_Pipe:
+		return a.Pipe.GetPath()
+	case *envoy_config_core_v3.Address_EnvoyInternalAddress:
+		return fmt.Sprintf("envoy://%s", a.EnvoyInternalAddress.GetServerListenerName())
+	}
+	return ""
+}
@@ -0,0 +1,130 @@
+package sinks_test
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+)
+
+var _ = Describe("EntryBuilder", func() {
+
+	httpMessage := func() *pb.StreamAccessLogsMessage {
+		jwtPayloads, err := structpb.NewStruct(map[string]any{
+			"provider-b": map[string]any{"iss": "issuer-b", "sub": "user"},
+			"provider-a": map[string]any{"iss": "issuer-a"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		transformationMeta, err := structpb.NewStruct(map[string]any{"pod_name": "petstore-1234"})
+		Expect(err).NotTo(HaveOccurred())
+
+		return &pb.StreamAccessLogsMessage{
+			Identifier: &pb.StreamAccessLogsMessage_Identifier{
+				Node:    &envoy_config_core_v3.Node{Id: "gateway-proxy"},
+				LogName: "example",
+			},
+			LogEntries: &pb.StreamAccessLogsMessage_HttpLogs{
+				HttpLogs: &pb.StreamAccessLogsMessage_HTTPAccessLogEntries{
+					LogEntry: []*envoy_data_accesslog_v3.HTTPAccessLogEntry{{
+						ProtocolVersion: envoy_data_accesslog_v3.HTTPAccessLogEntry_HTTP11,
+						CommonProperties: &envoy_data_accesslog_v3.AccessLogCommon{
+							UpstreamCluster: "default-petstore-8080_gloo-system",
+							UpstreamRemoteAddress: &envoy_config_core_v3.Address{
+								Address: &envoy_config_core_v3.Address_SocketAddress{
+									SocketAddress: &envoy_config_core_v3.SocketAddress{
+										Address:       "10.0.0.1",
+										PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{PortValue: 8080},
+									},
+								},
+							},
+							TimeToLastDownstreamTxByte: durationpb.New(1500),
+							TimeToLastUpstreamTxByte:   durationpb.New(200),
+							TimeToFirstUpstreamRxByte:  durationpb.New(1200),
+							Metadata: &envoy_config_core_v3.Metadata{
+								FilterMetadata: map[string]*structpb.Struct{
+									"envoy.filters.http.jwt_authn": jwtPayloads,
+									transformation.FilterName:      transformationMeta,
+								},
+							},
+						},
+						Request: &envoy_data_accesslog_v3.HTTPRequestProperties{
+							RequestMethod:  envoy_config_core_v3.RequestMethod_GET,
+							Path:           "/api/pets",
+							RequestHeaders: map[string]string{"x-tenant": "acme"},
+						},
+						Response: &envoy_data_accesslog_v3.HTTPResponseProperties{
+							ResponseCode: wrapperspb.UInt32(200),
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	It("adds the default fields to http entries", func() {
+		entries := sinks.NewEntryBuilder(nil, true).Build(httpMessage())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0]).To(MatchKeys(IgnoreExtras, Keys{
+			"type":                    Equal("http"),
+			"node_id":                 Equal("gateway-proxy"),
+			"log_name":                Equal("example"),
+			"cluster":                 Equal("default-petstore-8080_gloo-system"),
+			"upstream_remote_address": Equal("10.0.0.1:8080"),
+			"request_method":          Equal("GET"),
+			"request_path":            Equal("/api/pets"),
+			"request_headers":         Equal(map[string]any{"x-tenant": "acme"}),
+			"response_code":           Equal(int64(200)),
+			"downstream_resp_time":    Equal(int64(1500)),
+			"upstream_resp_time":      Equal(int64(1000)),
+		}))
+	})
+
+	It("adds the mapped fields", func() {
+		entries := sinks.NewEntryBuilder([]sinks.FieldMapping{
+			{Name: "pod_name", Source: sinks.FieldSourceTransformation, Key: "pod_name"},
+			{Name: "issuer", Source: sinks.FieldSourceJwtClaim, Key: "iss"},
+			{Name: "subject", Source: sinks.FieldSourceJwtClaim, Key: "sub"},
+			{Name: "tenant", Source: sinks.FieldSourceRequestHeader, Key: "x-tenant"},
+			{Name: "missing", Source: sinks.FieldSourceResponseHeader, Key: "x-missing"},
+		}, false).Build(httpMessage())
+		Expect(entries).To(ConsistOf(sinks.Entry{
+			"pod_name": "petstore-1234",
+			// the claim is read from the first provider by name
+			"issuer":  "issuer-a",
+			"subject": "user",
+			"tenant":  "acme",
+			"missing": nil,
+		}))
+	})
+
+	It("builds tcp entries", func() {
+		entries := sinks.NewEntryBuilder(nil, true).Build(&pb.StreamAccessLogsMessage{
+			LogEntries: &pb.StreamAccessLogsMessage_TcpLogs{
+				TcpLogs: &pb.StreamAccessLogsMessage_TCPAccessLogEntries{
+					LogEntry: []*envoy_data_accesslog_v3.TCPAccessLogEntry{{
+						CommonProperties: &envoy_data_accesslog_v3.AccessLogCommon{UpstreamCluster: "tcp-cluster"},
+						ConnectionProperties: &envoy_data_accesslog_v3.ConnectionProperties{
+							ReceivedBytes: 10,
+							SentBytes:     20,
+						},
+					}},
+				},
+			},
+		})
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0]).To(MatchKeys(IgnoreExtras, Keys{
+			"type":           Equal("tcp"),
+			"cluster":        Equal("tcp-cluster"),
+			"received_bytes": Equal(int64(10)),
+			"sent_bytes":     Equal(int64(20)),
+		}))
+	})
+})
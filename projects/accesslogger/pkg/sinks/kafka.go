@@ -0,0 +1,86 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+)
+
+const kafkaJsonContentType = "application/vnd.kafka.json.v2+json"
+
+var _ Exporter = new(kafkaExporter)
+
+// kafkaExporter produces entries as JSON records with the v2 produce API of a Kafka REST proxy.
+type kafkaExporter struct {
+	client   *http.Client
+	url      string
+	keyField string
+	headers  map[string]string
+}
+
+type kafkaRecord struct {
+	Key   any   `json:"key,omitempty"`
+	Value Entry `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// NewKafkaExporter returns an Exporter that produces entries to a Kafka topic through a REST proxy.
+func NewKafkaExporter(cfg *KafkaSinkConfig) Exporter {
+	return &kafkaExporter{
+		client:   &http.Client{Timeout: timeoutOrDefault(cfg.Timeout)},
+		url:      fmt.Sprintf("%s/topics/%s", strings.TrimSuffix(cfg.URL, "/"), url.PathEscape(cfg.Topic)),
+		keyField: cfg.KeyField,
+		headers:  cfg.Headers,
+	}
+}
+
+func (k *kafkaExporter) Export(ctx context.Context, entries []Entry) error {
+	produce := kafkaProduceRequest{Records: make([]kafkaRecord, 0, len(entries))}
+	for _, entry := range entries {
+		record := kafkaRecord{Value: entry}
+		if k.keyField != "" {
+			record.Key = entry[k.keyField]
+		}
+		produce.Records = append(produce.Records, record)
+	}
+	body, err := json.Marshal(produce)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaJsonContentType)
+	for name, value := range k.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("kafka rest proxy responded with %d: %s", resp.StatusCode, respBody)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func (k *kafkaExporter) Close() error {
+	k.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,71 @@
+package sinks_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+)
+
+var _ = Describe("Kafka exporter", func() {
+
+	var (
+		server   *httptest.Server
+		requests chan *http.Request
+		bodies   chan map[string]any
+		status   int
+	)
+
+	BeforeEach(func() {
+		requests = make(chan *http.Request, 1)
+		bodies = make(chan map[string]any, 1)
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			requests <- r
+			bodies <- body
+			w.WriteHeader(status)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("produces entries as json records to the topic", func() {
+		exporter := sinks.NewKafkaExporter(&sinks.KafkaSinkConfig{
+			URL:      server.URL + "/",
+			Topic:    "access-logs",
+			KeyField: "cluster",
+			Headers:  map[string]string{"Authorization": "Bearer token"},
+		})
+		defer exporter.Close()
+
+		Expect(exporter.Export(context.Background(), []sinks.Entry{{"cluster": "petstore", "response_code": 200}})).To(Succeed())
+
+		req := <-requests
+		Expect(req.URL.Path).To(Equal("/topics/access-logs"))
+		Expect(req.Header.Get("Content-Type")).To(Equal("application/vnd.kafka.json.v2+json"))
+		Expect(req.Header.Get("Authorization")).To(Equal("Bearer token"))
+		Expect(<-bodies).To(Equal(map[string]any{
+			"records": []any{map[string]any{
+				"key":   "petstore",
+				"value": map[string]any{"cluster": "petstore", "response_code": 200.0},
+			}},
+		}))
+	})
+
+	It("returns an error when the proxy rejects the records", func() {
+		status = http.StatusServiceUnavailable
+		exporter := sinks.NewKafkaExporter(&sinks.KafkaSinkConfig{URL: server.URL, Topic: "access-logs"})
+		defer exporter.Close()
+
+		Expect(exporter.Export(context.Background(), []sinks.Entry{{"cluster": "petstore"}})).To(MatchError(ContainSubstring("responded with 503")))
+	})
+})
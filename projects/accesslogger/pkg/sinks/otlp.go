@@ -0,0 +1,165 @@
+package sinks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	defaultOTLPServiceName = "gloo-access-logger"
+	otlpScopeName          = "github.com/solo-io/gloo/projects/accesslogger"
+)
+
+var _ Exporter = new(otlpExporter)
+
+// otlpExporter forwards entries as OTLP log records. Every entry field is a log record attribute.
+type otlpExporter struct {
+	conn     *grpc.ClientConn
+	client   collogspb.LogsServiceClient
+	resource *resourcepb.Resource
+	headers  metadata.MD
+	timeout  time.Duration
+}
+
+// NewOTLPExporter returns an Exporter that forwards entries to an OTLP/gRPC logs receiver.
+// The connection is established lazily, so the receiver does not need to be up yet.
+func NewOTLPExporter(cfg *OTLPSinkConfig) (Exporter, error) {
+	creds := credentials.NewClientTLSFromCert(nil, "")
+	if cfg.Insecure {
+		creds = insecure.NewCredentials()
+	}
+	conn, err := grpc.NewClient(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating otlp client for %s", cfg.Endpoint)
+	}
+	return newOTLPExporter(conn, cfg), nil
+}
+
+func newOTLPExporter(conn *grpc.ClientConn, cfg *OTLPSinkConfig) *otlpExporter {
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = defaultOTLPServiceName
+	}
+	return &otlpExporter{
+		conn:   conn,
+		client: collogspb.NewLogsServiceClient(conn),
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{stringKeyValue("service.name", serviceName)},
+		},
+		headers: metadata.New(cfg.Headers),
+		timeout: timeoutOrDefault(cfg.Timeout),
+	}
+}
+
+func (o *otlpExporter) Export(ctx context.Context, entries []Entry) error {
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(ctx, o.headers), o.timeout)
+	defer cancel()
+
+	now := uint64(time.Now().UnixNano())
+	records := make([]*logspb.LogRecord, 0, len(entries))
+	for _, entry := range entries {
+		records = append(records, toLogRecord(entry, now))
+	}
+	resp, err := o.client.Export(ctx, &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: o.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: otlpScopeName},
+				LogRecords: records,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	// partially rejected records are not retried, as the receiver would reject them again
+	if rejected := resp.GetPartialSuccess().GetRejectedLogRecords(); rejected > 0 {
+		return Permanent(errors.Errorf("otlp receiver rejected %d log records: %s", rejected, resp.GetPartialSuccess().GetErrorMessage()))
+	}
+	return nil
+}
+
+func (o *otlpExporter) Close() error {
+	return o.conn.Close()
+}
+
+// toLogRecord converts an entry into a log record. The record is timestamped with the
+// start time of the request, if the entry has one.
+func toLogRecord(entry Entry, observedTime uint64) *logspb.LogRecord {
+	record := &logspb.LogRecord{
+		ObservedTimeUnixNano: observedTime,
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         "INFO",
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: logMessage(entry)}},
+		Attributes:           toKeyValues(entry),
+	}
+	if startTime, ok := entry["start_time"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, startTime); err == nil {
+			record.TimeUnixNano = uint64(t.UnixNano())
+		}
+	}
+	return record
+}
+
+func logMessage(entry Entry) string {
+	if logType, ok := entry["type"].(string); ok {
+		return fmt.Sprintf("received %s request", logType)
+	}
+	return "received request"
+}
+
+func toKeyValues(m map[string]any) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	kvs := make([]*commonpb.KeyValue, 0, len(keys))
+	for _, key := range keys {
+		kvs = append(kvs, &commonpb.KeyValue{Key: key, Value: toAnyValue(m[key])})
+	}
+	return kvs
+}
+
+func toAnyValue(value any) *commonpb.AnyValue {
+	switch v := value.(type) {
+	case nil:
+		return &commonpb.AnyValue{}
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case int:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case map[string]any:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{Values: toKeyValues(v)}}}
+	case []any:
+		values := make([]*commonpb.AnyValue, 0, len(v))
+		for _, item := range v {
+			values = append(values, toAnyValue(item))
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: values}}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
+
+func stringKeyValue(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
@@ -0,0 +1,190 @@
+package sinks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+	ocstats "go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+
+	"github.com/solo-io/gloo/pkg/utils/statsutils"
+)
+
+var (
+	sinkKey, _ = tag.NewKey("sink")
+
+	mSinkExportedEntries = statsutils.MakeSumCounter("gloo.solo.io/accesslogging/sink_exported_entries", "The number of access log entries written to a sink", sinkKey)
+	mSinkDroppedEntries  = statsutils.MakeSumCounter("gloo.solo.io/accesslogging/sink_dropped_entries", "The number of access log entries dropped because the queue of a sink was full or its retries were exhausted", sinkKey)
+	mSinkFailedExports   = statsutils.MakeSumCounter("gloo.solo.io/accesslogging/sink_failed_exports", "The number of failed attempts to write a batch of access log entries to a sink", sinkKey)
+)
+
+// ErrPipelineClosed is returned when entries are enqueued on a closed Pipeline.
+var ErrPipelineClosed = errors.New("access log pipeline is closed")
+
+// permanentError is returned by an Exporter when retrying the export would fail again
+type permanentError struct {
+	error
+}
+
+func (e permanentError) Unwrap() error {
+	return e.error
+}
+
+// Permanent marks an export error as permanent, so that the batch is dropped instead of retried.
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// Exporter writes batches of access log entries to a destination.
+type Exporter interface {
+	// Export writes the entries. Failed exports are retried with the same entries, unless the error is Permanent.
+	Export(ctx context.Context, entries []Entry) error
+	// Close releases the resources of the exporter. Export is not called after Close.
+	Close() error
+}
+
+// Pipeline queues access log entries and writes them to an Exporter in batches, retrying failed batches
+// with an exponential backoff. When the queue is full, Enqueue either blocks or drops the entries,
+// depending on the OverflowPolicy.
+type Pipeline struct {
+	name     string
+	exporter Exporter
+	config   BatchConfig
+
+	queue chan Entry
+	done  chan struct{}
+
+	// closedLock guards against enqueueing entries while the queue is being closed
+	closedLock sync.RWMutex
+	closed     bool
+}
+
+// NewPipeline starts a Pipeline that writes to the exporter until it is closed.
+// Exports are not cancelled with the context, so the pending entries can still be flushed on Close, but failed
+// exports are no longer retried once the context is done.
+func NewPipeline(ctx context.Context, name string, exporter Exporter, config BatchConfig) *Pipeline {
+	config = config.withDefaults()
+	p := &Pipeline{
+		name:     name,
+		exporter: exporter,
+		config:   config,
+		queue:    make(chan Entry, config.QueueSize),
+		done:     make(chan struct{}),
+	}
+	ctx = contextutils.WithLoggerValues(ctx, "sink", name)
+	go p.run(ctx)
+	return p
+}
+
+// Enqueue adds the entries to the queue. With the block overflow policy, Enqueue waits for room in
+// the queue until the context is done.
+func (p *Pipeline) Enqueue(ctx context.Context, entries ...Entry) error {
+	p.closedLock.RLock()
+	defer p.closedLock.RUnlock()
+	if p.closed {
+		return ErrPipelineClosed
+	}
+
+	for i, entry := range entries {
+		if p.config.Overflow == OverflowPolicyDrop {
+			select {
+			case p.queue <- entry:
+			default:
+				p.measure(ctx, mSinkDroppedEntries, 1)
+			}
+			continue
+		}
+		select {
+		case p.queue <- entry:
+		case <-ctx.Done():
+			p.measure(ctx, mSinkDroppedEntries, int64(len(entries)-i))
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close flushes the queued entries, then closes the exporter.
+func (p *Pipeline) Close() error {
+	p.closedLock.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.closedLock.Unlock()
+
+	<-p.done
+	return p.exporter.Close()
+}
+
+func (p *Pipeline) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.config.FlushInterval.Duration)
+	defer ticker.Stop()
+
+	var batch []Entry
+	for {
+		select {
+		case entry, ok := <-p.queue:
+			if !ok {
+				p.export(ctx, batch)
+				return
+			}
+			batch = append(batch, entry)
+			if len(batch) >= p.config.MaxSize {
+				p.export(ctx, batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			p.export(ctx, batch)
+			batch = nil
+		}
+	}
+}
+
+// export writes the batch, retrying failed attempts. The batch is dropped once the retries are exhausted, when
+// the error is permanent, or when the context is done while waiting for the next attempt.
+func (p *Pipeline) export(ctx context.Context, batch []Entry) {
+	if len(batch) == 0 {
+		return
+	}
+	logger := contextutils.LoggerFrom(ctx)
+	exportCtx := context.WithoutCancel(ctx)
+
+	backoff := p.config.RetryBackoff.Duration
+	for attempt := 0; ; attempt++ {
+		err := p.exporter.Export(exportCtx, batch)
+		if err == nil {
+			p.measure(ctx, mSinkExportedEntries, int64(len(batch)))
+			return
+		}
+		p.measure(ctx, mSinkFailedExports, 1)
+		if attempt >= *p.config.MaxRetries || errors.As(err, new(permanentError)) {
+			p.drop(ctx, batch, err)
+			return
+		}
+		logger.Warnw("failed to write access log entries to sink, retrying", "entries", len(batch), "backoff", backoff, "error", err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			p.drop(ctx, batch, err)
+			return
+		}
+		backoff *= 2
+	}
+}
+
+func (p *Pipeline) drop(ctx context.Context, batch []Entry, err error) {
+	contextutils.LoggerFrom(ctx).Errorw("dropping access log entries, failed to write them to sink", "entries", len(batch), "error", err)
+	p.measure(ctx, mSinkDroppedEntries, int64(len(batch)))
+}
+
+func (p *Pipeline) measure(ctx context.Context, measure *ocstats.Int64Measure, value int64) {
+	statsutils.Measure(ctx, measure, value, tag.Insert(sinkKey, p.name))
+}
@@ -0,0 +1,228 @@
+package sinks_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	errors "github.com/rotisserie/eris"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+)
+
+// fakeExporter records the exported batches, and fails the first `failures` exports.
+type fakeExporter struct {
+	lock     sync.Mutex
+	batches  [][]sinks.Entry
+	failures int
+	// permanent makes the failed exports permanent errors
+	permanent bool
+	attempts  int
+	// block, when set, blocks exports until it is closed
+	block  chan struct{}
+	closed bool
+}
+
+func (f *fakeExporter) Export(_ context.Context, entries []sinks.Entry) error {
+	if f.block != nil {
+		<-f.block
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.attempts++
+	if f.attempts <= f.failures {
+		if f.permanent {
+			return sinks.Permanent(errors.New("export rejected"))
+		}
+		return errors.New("export failed")
+	}
+	f.batches = append(f.batches, entries)
+	return nil
+}
+
+func (f *fakeExporter) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.closed = true
+	return nil
+}
+
+func (f *fakeExporter) exported() []sinks.Entry {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var entries []sinks.Entry
+	for _, batch := range f.batches {
+		entries = append(entries, batch...)
+	}
+	return entries
+}
+
+func (f *fakeExporter) batchSizes() []int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var sizes []int
+	for _, batch := range f.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+func entries(n int) []sinks.Entry {
+	var result []sinks.Entry
+	for i := 0; i < n; i++ {
+		result = append(result, sinks.Entry{"index": i})
+	}
+	return result
+}
+
+var _ = Describe("Pipeline", func() {
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		exporter *fakeExporter
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		exporter = &fakeExporter{}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("exports full batches", func() {
+		p := sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{
+			MaxSize:       2,
+			FlushInterval: metav1.Duration{Duration: time.Hour},
+		})
+		Expect(p.Enqueue(ctx, entries(5)...)).To(Succeed())
+		Eventually(exporter.batchSizes).Should(Equal([]int{2, 2}))
+
+		// the partial batch is flushed on close
+		Expect(p.Close()).To(Succeed())
+		Expect(exporter.batchSizes()).To(Equal([]int{2, 2, 1}))
+		Expect(exporter.exported()).To(Equal(entries(5)))
+		Expect(exporter.closed).To(BeTrue())
+	})
+
+	It("exports partial batches after the flush interval", func() {
+		p := sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{
+			MaxSize:       10,
+			FlushInterval: metav1.Duration{Duration: 10 * time.Millisecond},
+		})
+		defer p.Close()
+		Expect(p.Enqueue(ctx, entries(3)...)).To(Succeed())
+		Eventually(exporter.exported).Should(Equal(entries(3)))
+	})
+
+	It("retries failed exports", func() {
+		exporter.failures = 2
+		p := sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{
+			RetryBackoff: metav1.Duration{Duration: time.Millisecond},
+		})
+		Expect(p.Enqueue(ctx, entries(1)...)).To(Succeed())
+		Expect(p.Close()).To(Succeed())
+		Expect(exporter.attempts).To(Equal(3))
+		Expect(exporter.exported()).To(Equal(entries(1)))
+	})
+
+	It("drops the batch once the retries are exhausted", func() {
+		exporter.failures = 10
+		maxRetries := 1
+		p := sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{
+			MaxRetries:   &maxRetries,
+			RetryBackoff: metav1.Duration{Duration: time.Millisecond},
+		})
+		Expect(p.Enqueue(ctx, entries(1)...)).To(Succeed())
+		Expect(p.Close()).To(Succeed())
+		Expect(exporter.attempts).To(Equal(2))
+		Expect(exporter.exported()).To(BeEmpty())
+	})
+
+	It("does not retry permanent errors", func() {
+		exporter.failures = 1
+		exporter.permanent = true
+		p := sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{
+			RetryBackoff: metav1.Duration{Duration: time.Millisecond},
+		})
+		Expect(p.Enqueue(ctx, entries(1)...)).To(Succeed())
+		Expect(p.Close()).To(Succeed())
+		Expect(exporter.attempts).To(Equal(1))
+		Expect(exporter.exported()).To(BeEmpty())
+	})
+
+	It("stops retrying when the context is done", func() {
+		exporter.failures = 10
+		p := sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{
+			RetryBackoff: metav1.Duration{Duration: time.Hour},
+		})
+		Expect(p.Enqueue(ctx, entries(1)...)).To(Succeed())
+		go func() {
+			defer GinkgoRecover()
+			Eventually(func() int {
+				exporter.lock.Lock()
+				defer exporter.lock.Unlock()
+				return exporter.attempts
+			}).Should(Equal(1))
+			cancel()
+		}()
+
+		closed := make(chan error)
+		go func() {
+			closed <- p.Close()
+		}()
+		Eventually(closed).Should(Receive(BeNil()))
+		Expect(exporter.attempts).To(Equal(1))
+	})
+
+	Context("when the queue is full", func() {
+
+		var p *sinks.Pipeline
+
+		BeforeEach(func() {
+			exporter.block = make(chan struct{})
+		})
+
+		AfterEach(func() {
+			close(exporter.block)
+			Expect(p.Close()).To(Succeed())
+		})
+
+		It("applies backpressure with the block policy", func() {
+			p = sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{
+				MaxSize:   1,
+				QueueSize: 1,
+			})
+			// the first entry is being exported and the second one is queued
+			Expect(p.Enqueue(ctx, entries(2)...)).To(Succeed())
+
+			enqueueCtx, enqueueCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer enqueueCancel()
+			Expect(p.Enqueue(enqueueCtx, entries(1)...)).To(MatchError(context.DeadlineExceeded))
+		})
+
+		It("drops entries with the drop policy", func() {
+			p = sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{
+				MaxSize:   1,
+				QueueSize: 1,
+				Overflow:  sinks.OverflowPolicyDrop,
+			})
+			enqueueCtx, enqueueCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer enqueueCancel()
+			// at most one entry is being exported and one is queued, the rest are dropped without blocking
+			Expect(p.Enqueue(enqueueCtx, entries(10)...)).To(Succeed())
+			Expect(len(exporter.exported())).To(BeNumerically("<=", 2))
+		})
+	})
+
+	It("rejects entries once closed", func() {
+		p := sinks.NewPipeline(ctx, "test", exporter, sinks.BatchConfig{})
+		Expect(p.Close()).To(Succeed())
+		Expect(p.Enqueue(ctx, entries(1)...)).To(MatchError(sinks.ErrPipelineClosed))
+	})
+})
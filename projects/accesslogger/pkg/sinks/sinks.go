@@ -0,0 +1,81 @@
+package sinks
+
+import (
+	"context"
+
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
+)
+
+// Sinks writes the access logs received by the access logger to every configured sink.
+type Sinks struct {
+	builder   *EntryBuilder
+	pipelines []*Pipeline
+}
+
+// NewSinks starts a Pipeline for every sink in the config. The sinks must be closed to flush their pending entries.
+func NewSinks(ctx context.Context, cfg *Config) (*Sinks, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	s := &Sinks{
+		builder: NewEntryBuilder(cfg.Fields, !cfg.DisableDefaultFields),
+	}
+	for i, sinkCfg := range cfg.Sinks {
+		name := sinkCfg.sinkName(i)
+		exporter, err := newExporter(sinkCfg)
+		if err != nil {
+			_ = s.Close()
+			return nil, errors.Wrapf(err, "sink %s", name)
+		}
+		s.pipelines = append(s.pipelines, NewPipeline(ctx, name, exporter, sinkCfg.Batch))
+	}
+	return s, nil
+}
+
+func newExporter(cfg SinkConfig) (Exporter, error) {
+	switch {
+	case cfg.Stdout != nil:
+		return NewStdoutExporter(), nil
+	case cfg.File != nil:
+		return NewFileExporter(cfg.File), nil
+	case cfg.OTLP != nil:
+		return NewOTLPExporter(cfg.OTLP)
+	case cfg.Kafka != nil:
+		return NewKafkaExporter(cfg.Kafka), nil
+	}
+	return nil, errors.New("no sink type set")
+}
+
+// Callback returns the callback that writes the access logs of every message to the sinks.
+// With the block overflow policy, the callback blocks while the queue of a sink is full, which
+// stops reading from the access log stream of the Envoy until the sink catches up.
+func (s *Sinks) Callback() loggingservice.AlsCallback {
+	return func(ctx context.Context, message *pb.StreamAccessLogsMessage) error {
+		entries := s.builder.Build(message)
+		if len(entries) == 0 {
+			return nil
+		}
+		var errs *multierror.Error
+		for _, pipeline := range s.pipelines {
+			if err := pipeline.Enqueue(ctx, entries...); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// Close flushes the pending entries of every sink and closes them.
+func (s *Sinks) Close() error {
+	var errs *multierror.Error
+	for _, pipeline := range s.pipelines {
+		if err := pipeline.Close(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,67 @@
+package sinks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var _ Exporter = new(writerExporter)
+
+// writerExporter writes entries as JSON lines.
+type writerExporter struct {
+	lock   sync.Mutex
+	writer io.Writer
+	closer func() error
+}
+
+// NewStdoutExporter returns an Exporter that writes entries to stdout as JSON lines.
+func NewStdoutExporter() Exporter {
+	return newWriterExporter(os.Stdout, nil)
+}
+
+// NewFileExporter returns an Exporter that writes entries to a rotated file as JSON lines.
+func NewFileExporter(cfg *FileSinkConfig) Exporter {
+	logger := &lumberjack.Logger{
+		Filename:   cfg.Path,
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAgeDays,
+		Compress:   cfg.Compress,
+	}
+	return newWriterExporter(logger, logger.Close)
+}
+
+func newWriterExporter(writer io.Writer, closer func() error) *writerExporter {
+	return &writerExporter{
+		writer: writer,
+		closer: closer,
+	}
+}
+
+func (w *writerExporter) Export(_ context.Context, entries []Entry) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	// buffer the batch so that it is written with as few writes as possible
+	buf := bufio.NewWriter(w.writer)
+	encoder := json.NewEncoder(buf)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return buf.Flush()
+}
+
+func (w *writerExporter) Close() error {
+	if w.closer == nil {
+		return nil
+	}
+	return w.closer()
+}
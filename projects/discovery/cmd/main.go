@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/solo-io/gloo/pkg/utils/setuputils"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	fdssetup "github.com/solo-io/gloo/projects/discovery/pkg/fds/setup"
 	uds "github.com/solo-io/gloo/projects/discovery/pkg/uds/setup"
 	"github.com/solo-io/go-utils/log"
@@ -17,6 +18,7 @@ const (
 func main() {
 	ctx := context.Background()
 	setuputils.SetupLogging(ctx, discoveryComponentName)
+	telemetry.Init(discoveryComponentName)
 
 	stats.ConditionallyStartStatsServer()
 	if err := run(); err != nil {
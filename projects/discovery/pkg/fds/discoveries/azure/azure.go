@@ -0,0 +1,257 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+	azureplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
+)
+
+const (
+	// the admin API of the Functions host lists the functions of a Function App
+	functionsPath = "/admin/functions"
+	keyHeader     = "x-functions-key"
+
+	httpTriggerType = "httpTrigger"
+
+	defaultPollingTime = 30 * time.Second
+	requestTimeout     = 30 * time.Second
+)
+
+var (
+	MissingSecretRefError = errors.New("azure upstream has no secret ref; the master key of the Function App is required to list its functions")
+
+	MissingMasterKeyError = func(secretRef fmt.Stringer) error {
+		return errors.Errorf("azure secret %v has no %s api key", secretRef, azureplugin.MasterKeyName)
+	}
+
+	UnexpectedStatusError = func(status int, body string) error {
+		return errors.Errorf("unexpected status %d listing azure functions: %s", status, body)
+	}
+)
+
+func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
+	return &AzureFunctionDiscoveryFactory{
+		PollingTime: defaultPollingTime,
+	}
+}
+
+// AzureFunctionDiscoveryFactory represents a factory for Azure Functions function discovery.
+type AzureFunctionDiscoveryFactory struct {
+	PollingTime time.Duration
+	// HttpClient is used to call the admin API; defaults to http.DefaultClient
+	HttpClient *http.Client
+	// BaseUrl returns the URL of the Functions host of a Function App; defaults to https://<app>.azurewebsites.net
+	BaseUrl func(spec *glooazure.UpstreamSpec) string
+}
+
+func (f *AzureFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
+	httpClient := f.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	baseUrl := f.BaseUrl
+	if baseUrl == nil {
+		baseUrl = defaultBaseUrl
+	}
+	return &AzureFunctionDiscovery{
+		timeToWait: f.PollingTime,
+		upstream:   u,
+		httpClient: httpClient,
+		baseUrl:    baseUrl,
+	}
+}
+
+func defaultBaseUrl(spec *glooazure.UpstreamSpec) string {
+	return "https://" + azureplugin.GetHostname(spec)
+}
+
+// AzureFunctionDiscovery is a discovery that polls the admin API of a Function App for its HTTP triggered functions.
+type AzureFunctionDiscovery struct {
+	timeToWait time.Duration
+	upstream   *v1.Upstream
+	httpClient *http.Client
+	baseUrl    func(spec *glooazure.UpstreamSpec) string
+}
+
+func (f *AzureFunctionDiscovery) IsFunctional() bool {
+	_, ok := f.upstream.GetUpstreamType().(*v1.Upstream_Azure)
+	return ok
+}
+
+func (f *AzureFunctionDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+	return nil, nil
+}
+
+func (f *AzureFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	err := contextutils.NewExponentialBackoff(contextutils.ExponentialBackoff{}).Backoff(ctx, func(ctx context.Context) error {
+		newFunctions, err := f.DetectFunctionsOnce(ctx, dependencies().Secrets)
+		if err != nil {
+			return err
+		}
+
+		err = updatecb(func(out *v1.Upstream) error {
+			if out == nil {
+				return errors.New("nil upstream")
+			}
+
+			azureSpec, ok := out.GetUpstreamType().(*v1.Upstream_Azure)
+			if !ok {
+				return errors.New("not azure upstream")
+			}
+			azureSpec.Azure.Functions = newFunctions
+			return nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to update upstream")
+		}
+		return nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// only log other errors as we would like to continue forever.
+		contextutils.LoggerFrom(ctx).Warnf("Unable to perform azure function discovery for upstream %s in namespace %s, error: %v",
+			f.upstream.GetMetadata().GetName(),
+			f.upstream.GetMetadata().GetNamespace(),
+			err,
+		)
+	}
+
+	// sleep so we are not hogging; the functions are refreshed on the next call
+	if err := contextutils.Sleep(ctx, f.timeToWait); err != nil {
+		return err
+	}
+	return nil
+}
+
+// functionEnvelope is a function as listed by the admin API of the Functions host
+type functionEnvelope struct {
+	Name       string `json:"name"`
+	IsDisabled bool   `json:"isDisabled"`
+	Config     struct {
+		Disabled bool      `json:"disabled"`
+		Bindings []binding `json:"bindings"`
+	} `json:"config"`
+}
+
+type binding struct {
+	Type      string `json:"type"`
+	Direction string `json:"direction"`
+	AuthLevel string `json:"authLevel"`
+}
+
+// DetectFunctionsOnce lists the enabled HTTP triggered functions of the Function App of the upstream, sorted by name
+func (f *AzureFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, secrets v1.SecretList) ([]*glooazure.UpstreamSpec_FunctionSpec, error) {
+	azureSpec, ok := f.upstream.GetUpstreamType().(*v1.Upstream_Azure)
+	if !ok {
+		return nil, errors.New("not an azure upstream spec")
+	}
+	spec := azureSpec.Azure
+
+	masterKey, err := getMasterKey(spec, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(f.baseUrl(spec), "/")+functionsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(keyHeader, masterKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get list of functions from Azure")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, UnexpectedStatusError(resp.StatusCode, string(body))
+	}
+
+	var envelopes []functionEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&envelopes); err != nil {
+		return nil, errors.Wrap(err, "unable to decode list of functions from Azure")
+	}
+
+	var newFunctions []*glooazure.UpstreamSpec_FunctionSpec
+	for _, envelope := range envelopes {
+		if envelope.IsDisabled || envelope.Config.Disabled {
+			continue
+		}
+		trigger := httpTrigger(envelope.Config.Bindings)
+		if trigger == nil {
+			// only functions with an http trigger can be routed to
+			continue
+		}
+		newFunctions = append(newFunctions, &glooazure.UpstreamSpec_FunctionSpec{
+			FunctionName: envelope.Name,
+			AuthLevel:    authLevel(trigger.AuthLevel),
+		})
+	}
+
+	// sort for idempotency
+	sort.Slice(newFunctions, func(i, j int) bool {
+		return newFunctions[i].GetFunctionName() < newFunctions[j].GetFunctionName()
+	})
+	return newFunctions, nil
+}
+
+func getMasterKey(spec *glooazure.UpstreamSpec, secrets v1.SecretList) (string, error) {
+	if spec.GetSecretRef().GetName() == "" {
+		return "", MissingSecretRefError
+	}
+	secret, err := secrets.Find(spec.GetSecretRef().Strings())
+	if err != nil {
+		return "", errors.Wrapf(err, "azure secrets for ref %v not found", spec.GetSecretRef())
+	}
+	azureSecret, ok := secret.GetKind().(*v1.Secret_Azure)
+	if !ok {
+		return "", errors.Errorf("secret %v is not an Azure secret", secret.GetMetadata().Ref())
+	}
+	masterKey := azureSecret.Azure.GetApiKeys()[azureplugin.MasterKeyName]
+	if masterKey == "" {
+		return "", MissingMasterKeyError(spec.GetSecretRef())
+	}
+	return masterKey, nil
+}
+
+func httpTrigger(bindings []binding) *binding {
+	for i, b := range bindings {
+		if strings.EqualFold(b.Type, httpTriggerType) && !strings.EqualFold(b.Direction, "out") {
+			return &bindings[i]
+		}
+	}
+	return nil
+}
+
+// authLevel converts the auth level of an http trigger, which is "function" when it is not set
+func authLevel(level string) glooazure.UpstreamSpec_FunctionSpec_AuthLevel {
+	switch strings.ToLower(level) {
+	case "anonymous":
+		return glooazure.UpstreamSpec_FunctionSpec_Anonymous
+	case "admin", "system":
+		return glooazure.UpstreamSpec_FunctionSpec_Admin
+	default:
+		return glooazure.UpstreamSpec_FunctionSpec_Function
+	}
+}
@@ -0,0 +1,198 @@
+package azure_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/azure"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const functionsJson = `[
+  {
+    "name": "anonymous-fn",
+    "config": {"bindings": [
+      {"type": "httpTrigger", "direction": "in", "name": "req", "authLevel": "anonymous"},
+      {"type": "http", "direction": "out", "name": "res"}
+    ]}
+  },
+  {
+    "name": "admin-fn",
+    "config": {"bindings": [{"type": "httpTrigger", "direction": "in", "name": "req", "authLevel": "admin"}]}
+  },
+  {
+    "name": "default-fn",
+    "config": {"bindings": [{"type": "httpTrigger", "direction": "in", "name": "req"}]}
+  },
+  {
+    "name": "timer-fn",
+    "config": {"bindings": [{"type": "timerTrigger", "direction": "in", "name": "timer"}]}
+  },
+  {
+    "name": "disabled-fn",
+    "isDisabled": true,
+    "config": {"bindings": [{"type": "httpTrigger", "direction": "in", "name": "req", "authLevel": "function"}]}
+  }
+]`
+
+var _ = Describe("Azure function discovery", func() {
+
+	var (
+		server *httptest.Server
+
+		lock      sync.Mutex
+		functions string
+		keys      []string
+
+		upstream *v1.Upstream
+		secrets  v1.SecretList
+		factory  *azure.AzureFunctionDiscoveryFactory
+	)
+
+	setFunctions := func(list string) {
+		lock.Lock()
+		defer lock.Unlock()
+		functions = list
+	}
+
+	receivedKeys := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string(nil), keys...)
+	}
+
+	BeforeEach(func() {
+		setFunctions(functionsJson)
+		keys = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+			keys = append(keys, r.Header.Get("x-functions-key"))
+			if r.URL.Path != "/admin/functions" || r.Header.Get("x-functions-key") != "master-key" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(functions))
+		}))
+		DeferCleanup(server.Close)
+
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "function-app", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Azure{Azure: &glooazure.UpstreamSpec{
+				FunctionAppName: "my-app",
+				SecretRef:       &core.ResourceRef{Name: "azure", Namespace: "gloo-system"},
+			}},
+		}
+		secrets = v1.SecretList{{
+			Metadata: &core.Metadata{Name: "azure", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Azure{Azure: &v1.AzureSecret{
+				ApiKeys: map[string]string{"_master": "master-key"},
+			}},
+		}}
+		factory = &azure.AzureFunctionDiscoveryFactory{
+			PollingTime: 10 * time.Millisecond,
+			BaseUrl: func(spec *glooazure.UpstreamSpec) string {
+				Expect(spec.GetFunctionAppName()).To(Equal("my-app"))
+				return server.URL
+			},
+		}
+	})
+
+	newDiscovery := func() *azure.AzureFunctionDiscovery {
+		return factory.NewFunctionDiscovery(upstream, fds.AdditionalClients{}).(*azure.AzureFunctionDiscovery)
+	}
+
+	It("is functional for azure upstreams only", func() {
+		Expect(newDiscovery().IsFunctional()).To(BeTrue())
+
+		upstream.UpstreamType = &v1.Upstream_Static{}
+		Expect(newDiscovery().IsFunctional()).To(BeFalse())
+	})
+
+	It("lists the enabled http functions with their auth level, using the master key", func() {
+		functions, err := newDiscovery().DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(Equal([]*glooazure.UpstreamSpec_FunctionSpec{
+			{FunctionName: "admin-fn", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Admin},
+			{FunctionName: "anonymous-fn", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Anonymous},
+			{FunctionName: "default-fn", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Function},
+		}))
+		Expect(receivedKeys()).To(Equal([]string{"master-key"}))
+	})
+
+	It("fails without the master key", func() {
+		secrets[0].GetAzure().ApiKeys = map[string]string{"anonymous-fn": "function-key"}
+		_, err := newDiscovery().DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).To(MatchError(ContainSubstring("has no _master api key")))
+
+		upstream.GetAzure().SecretRef = nil
+		_, err = newDiscovery().DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).To(MatchError(azure.MissingSecretRefError))
+
+		Expect(receivedKeys()).To(BeEmpty())
+	})
+
+	It("fails when the admin API rejects the key", func() {
+		secrets[0].GetAzure().ApiKeys = map[string]string{"_master": "wrong"}
+		_, err := newDiscovery().DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status 401")))
+	})
+
+	It("keeps the functions of the upstream refreshed", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		var (
+			upstreamLock sync.Mutex
+			discovered   *v1.Upstream
+		)
+		updatecb := func(mutator fds.UpstreamMutator) error {
+			upstreamLock.Lock()
+			defer upstreamLock.Unlock()
+			out := upstream.Clone().(*v1.Upstream)
+			if err := mutator(out); err != nil {
+				return err
+			}
+			discovered = out
+			return nil
+		}
+		discoveredFunctions := func() []string {
+			upstreamLock.Lock()
+			defer upstreamLock.Unlock()
+			var names []string
+			for _, function := range discovered.GetAzure().GetFunctions() {
+				names = append(names, function.GetFunctionName())
+			}
+			return names
+		}
+
+		discovery := newDiscovery()
+		go func() {
+			defer GinkgoRecover()
+			// fds calls DetectFunctions in a loop until the context is done
+			for {
+				err := discovery.DetectFunctions(ctx, nil, func() fds.Dependencies {
+					return fds.Dependencies{Secrets: secrets}
+				}, updatecb)
+				if err != nil {
+					Expect(err).To(MatchError(context.Canceled))
+					return
+				}
+			}
+		}()
+
+		Eventually(discoveredFunctions).Should(Equal([]string{"admin-fn", "anonymous-fn", "default-fn"}))
+
+		setFunctions(`[{"name": "new-fn", "config": {"bindings": [{"type": "httpTrigger", "authLevel": "anonymous"}]}}]`)
+		Eventually(discoveredFunctions).Should(Equal([]string{"new-fn"}))
+	})
+})
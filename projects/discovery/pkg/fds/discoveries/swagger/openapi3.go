@@ -0,0 +1,209 @@
+package swagger
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/log"
+	"sigs.k8s.io/yaml"
+
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+)
+
+const jsonContentType = "application/json"
+
+// isOpenAPI3Doc returns true if the JSON or YAML document declares an OpenAPI 3.x version.
+// Swagger 2.0 documents declare their version in the `swagger` field instead.
+func isOpenAPI3Doc(docBytes []byte) bool {
+	jsn, err := yaml.YAMLToJSON(docBytes)
+	if err != nil {
+		return false
+	}
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(jsn, &version); err != nil {
+		return false
+	}
+	return strings.HasPrefix(version.OpenAPI, "3.")
+}
+
+// parseOpenAPI3Doc parses a JSON or YAML OpenAPI 3.x document and resolves its local references,
+// such as the schemas under components/schemas.
+func parseOpenAPI3Doc(docBytes []byte) (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(docBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid openapi 3 doc")
+	}
+	return doc, nil
+}
+
+// createFunctionsForOpenAPI3Path creates a function for every operation of an OpenAPI 3.x path.
+// The base path is taken from the most specific servers list: operation, then path, then document.
+func createFunctionsForOpenAPI3Path(
+	pathFunctions map[string]*transformation_plugins.TransformationTemplate,
+	docServers openapi3.Servers,
+	functionPath string,
+	path *openapi3.PathItem,
+) {
+	for method, operation := range path.Operations() {
+		servers := docServers
+		if len(path.Servers) > 0 {
+			servers = path.Servers
+		}
+		if operation.Servers != nil && len(*operation.Servers) > 0 {
+			servers = *operation.Servers
+		}
+		name, trans := createFunctionForOpenAPI3Operation(method, serversBasePath(servers), functionPath, path.Parameters, operation)
+		pathFunctions[name] = trans
+	}
+}
+
+// createFunctionForOpenAPI3Operation creates a function for an OpenAPI 3.x operation. Parameters defined on the path
+// apply to the operation unless the operation overrides them. The body template is built from the JSON request body.
+func createFunctionForOpenAPI3Operation(
+	method, basePath, functionPath string,
+	pathParams openapi3.Parameters,
+	operation *openapi3.Operation,
+) (string, *transformation_plugins.TransformationTemplate) {
+	params := make(map[string]*openapi3.Parameter)
+	var order []string
+	for _, paramRef := range slices.Concat(pathParams, operation.Parameters) {
+		param := paramRef.Value
+		if param == nil {
+			continue
+		}
+		key := param.In + "/" + param.Name
+		if _, ok := params[key]; !ok {
+			order = append(order, key)
+		}
+		params[key] = param
+	}
+
+	var queryParams, headerParams []string
+	for _, key := range order {
+		param := params[key]
+		// sort parameters by the template they will go into
+		switch param.In {
+		case openapi3.ParameterInQuery:
+			queryParams = append(queryParams, fmt.Sprintf("%v={{default(%v, \"\")}}", param.Name, param.Name))
+		case openapi3.ParameterInHeader:
+			headerParams = append(headerParams, param.Name)
+		case openapi3.ParameterInPath:
+			// nothing to do here, we already get the template
+		case openapi3.ParameterInCookie:
+			log.Warnf("cookie params not currently supported; ignoring")
+		}
+	}
+
+	var body *string
+	if schema := jsonRequestBodySchema(operation); schema != nil {
+		tmp := getOpenAPI3BodyTemplate("", schema, map[*openapi3.Schema]bool{})
+		body = &tmp
+	}
+
+	return buildFunction(method, basePath, functionPath, operation.OperationID, queryParams, headerParams, body)
+}
+
+// jsonRequestBodySchema returns the schema of the JSON request body of the operation, if any.
+func jsonRequestBodySchema(operation *openapi3.Operation) *openapi3.Schema {
+	if operation.RequestBody == nil || operation.RequestBody.Value == nil {
+		return nil
+	}
+	content := operation.RequestBody.Value.Content
+	mediaType := content.Get(jsonContentType)
+	if mediaType == nil {
+		// fall back to structured json types such as application/merge-patch+json
+		var mimes []string
+		for mime := range content {
+			if strings.HasSuffix(mime, "+json") {
+				mimes = append(mimes, mime)
+			}
+		}
+		if len(mimes) == 0 {
+			log.Warnf("request body content types %v are not json; ignoring", content)
+			return nil
+		}
+		sort.Strings(mimes)
+		mediaType = content[mimes[0]]
+	}
+	if mediaType.Schema == nil {
+		return nil
+	}
+	return mediaType.Schema.Value
+}
+
+// getOpenAPI3BodyTemplate builds the body template of an object schema. Each property is read from the
+// request parameter of the same name; properties of nested objects are read from `parent.property`.
+// The properties of allOf schemas are merged, and recursive schemas are only expanded once.
+func getOpenAPI3BodyTemplate(parent string, schema *openapi3.Schema, visited map[*openapi3.Schema]bool) string {
+	visited[schema] = true
+	defer delete(visited, schema)
+
+	var fields []string
+	for key, prop := range openAPI3Properties(schema) {
+		if prop == nil || prop.Value == nil {
+			continue
+		}
+		value := prop.Value
+		paramName := key
+		if parent != "" {
+			paramName = parent + "." + key
+		}
+		var defaultValue string
+		if value.Default != nil {
+			defaultValue = fmt.Sprintf("%v", value.Default)
+		}
+		defaultValue = fmt.Sprintf("\"%v\"", defaultValue)
+
+		switch {
+		case len(openAPI3Properties(value)) > 0 && !visited[value]:
+			fields = append(fields, fmt.Sprintf(`"%v": %v`, key, getOpenAPI3BodyTemplate(paramName, value, visited)))
+		case value.Type.Includes(openapi3.TypeString):
+			// string needs escaping
+			fields = append(fields, fmt.Sprintf(`"%v": "{{ default(%v, %v)}}"`, key, paramName, defaultValue))
+		default:
+			fields = append(fields, fmt.Sprintf(`"%v": {{ default(%v, %v) }}`, key, paramName, defaultValue))
+		}
+	}
+	// idempotency
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i] < fields[j]
+	})
+	return "{" + strings.Join(fields, ",") + "}"
+}
+
+// openAPI3Properties returns the properties of a schema, including those of its allOf schemas.
+func openAPI3Properties(schema *openapi3.Schema) openapi3.Schemas {
+	if len(schema.AllOf) == 0 {
+		return schema.Properties
+	}
+	properties := make(openapi3.Schemas, len(schema.Properties))
+	for _, allOf := range schema.AllOf {
+		if allOf.Value == nil {
+			continue
+		}
+		for key, prop := range openAPI3Properties(allOf.Value) {
+			properties[key] = prop
+		}
+	}
+	for key, prop := range schema.Properties {
+		properties[key] = prop
+	}
+	return properties
+}
+
+// serversBasePath returns the path prefix of the first server, or an empty string if there is none.
+func serversBasePath(servers openapi3.Servers) string {
+	basePath, err := servers.BasePath()
+	if err != nil {
+		log.Warnf("invalid openapi server url; ignoring: %v", err)
+		return ""
+	}
+	return strings.TrimSuffix(basePath, "/")
+}
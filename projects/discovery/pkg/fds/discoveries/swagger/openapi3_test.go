@@ -0,0 +1,133 @@
+package swagger
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const petstoreOpenAPI3 = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+servers:
+- url: https://{host}/api/v3
+  variables:
+    host:
+      default: petstore.example.com
+paths:
+  /pets/{petId}:
+    parameters:
+    - name: petId
+      in: path
+      required: true
+      schema:
+        type: string
+    - name: x-request-id
+      in: header
+      schema:
+        type: string
+    get:
+      operationId: getPet
+      parameters:
+      - name: verbose
+        in: query
+        schema:
+          type: boolean
+    put:
+      operationId: updatePet
+      servers:
+      - url: /admin
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      allOf:
+      - $ref: '#/components/schemas/NewPet'
+      - type: object
+        properties:
+          id:
+            type: integer
+    NewPet:
+      type: object
+      properties:
+        name:
+          type: string
+          default: rex
+        owner:
+          $ref: '#/components/schemas/Owner'
+    Owner:
+      type: object
+      properties:
+        email:
+          type: string
+`
+
+var _ = Describe("OpenAPI 3", func() {
+
+	It("detects the document version", func() {
+		Expect(isOpenAPI3Doc([]byte(petstoreOpenAPI3))).To(BeTrue())
+		Expect(isOpenAPI3Doc([]byte(`{"openapi": "3.1.0"}`))).To(BeTrue())
+		Expect(isOpenAPI3Doc([]byte(`{"swagger": "2.0"}`))).To(BeFalse())
+	})
+
+	It("parses YAML documents", func() {
+		doc, err := parseSwaggerDoc([]byte(petstoreOpenAPI3))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Swagger).To(BeNil())
+		Expect(doc.OpenAPI3).NotTo(BeNil())
+	})
+
+	It("still parses Swagger 2.0 documents", func() {
+		doc, err := parseSwaggerDoc([]byte(`{"swagger": "2.0", "info": {"title": "t", "version": "1"}, "paths": {}}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Swagger).NotTo(BeNil())
+		Expect(doc.OpenAPI3).To(BeNil())
+	})
+
+	Context("functions", func() {
+		var doc *Document
+
+		BeforeEach(func() {
+			var err error
+			doc, err = parseSwaggerDoc([]byte(petstoreOpenAPI3))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates a function for every operation", func() {
+			funcs, err := functionsFromOpenAPI3Spec(doc.OpenAPI3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(funcs).To(HaveLen(2))
+			Expect(funcs).To(HaveKey("getPet"))
+			Expect(funcs).To(HaveKey("updatePet"))
+		})
+
+		It("prefixes paths with the server base path and applies path parameters", func() {
+			funcs, err := functionsFromOpenAPI3Spec(doc.OpenAPI3)
+			Expect(err).NotTo(HaveOccurred())
+
+			getPet := funcs["getPet"]
+			Expect(getPet.GetHeaders()[":path"].GetText()).To(Equal(`/api/v3/pets/{{ default(petId, "") }}?verbose={{default(verbose, "")}}`))
+			Expect(getPet.GetHeaders()).To(HaveKey("x-request-id"))
+			Expect(getPet.GetHeaders()[":method"].GetText()).To(Equal("GET"))
+		})
+
+		It("uses operation servers over document servers", func() {
+			funcs, err := functionsFromOpenAPI3Spec(doc.OpenAPI3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(funcs["updatePet"].GetHeaders()[":path"].GetText()).To(Equal(`/admin/pets/{{ default(petId, "") }}`))
+		})
+
+		It("builds the body template from the request body schema", func() {
+			funcs, err := functionsFromOpenAPI3Spec(doc.OpenAPI3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(funcs["updatePet"].GetBody().GetText()).To(Equal(
+				`{"id": {{ default(id, "") }},"name": "{{ default(name, "rex")}}","owner": {"email": "{{ default(owner.email, "")}}"}}`,
+			))
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-openapi/loads"
 	openapi "github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
@@ -25,11 +26,19 @@ import (
 )
 
 var commonSwaggerURIs = []string{
+	"/openapi.json",
 	"/swagger.json",
 	"/swagger/docs/v1",
 	"/swagger/docs/v2",
 	"/v1/swagger",
 	"/v2/swagger",
+	"/v3/api-docs",
+}
+
+// Document is a parsed Swagger 2.0 or OpenAPI 3.x document. Exactly one of the fields is set.
+type Document struct {
+	Swagger  *openapi.Swagger
+	OpenAPI3 *openapi3.T
 }
 
 // TODO(yuval-k): run this in a back off for a limited amount of time, with high initial retry.
@@ -216,7 +225,46 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromInline(ctx context.Context
 	return f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
 }
 
-func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(_ context.Context, swaggerSpec *openapi.Swagger, _ *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(_ context.Context, doc *Document, _ *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+	var (
+		funcs map[string]*transformation_plugins.TransformationTemplate
+		err   error
+	)
+	if doc.OpenAPI3 != nil {
+		funcs, err = functionsFromOpenAPI3Spec(doc.OpenAPI3)
+	} else {
+		funcs, err = functionsFromSwaggerSpec(doc.Swagger)
+	}
+	if err != nil {
+		return err
+	}
+
+	return updatecb(func(u *v1.Upstream) error {
+		upstreamSpec, ok := u.GetUpstreamType().(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		spec := upstreamSpec.GetServiceSpec()
+		if spec == nil {
+			spec = &plugins.ServiceSpec{}
+		}
+		restSpec, ok := spec.GetPluginType().(*plugins.ServiceSpec_Rest)
+		if !ok {
+			restSpec = &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{},
+			}
+		}
+
+		restSpec.Rest.Transformations = funcs
+		spec.PluginType = restSpec
+
+		upstreamSpec.SetServiceSpec(spec)
+		return nil
+	})
+}
+
+// functionsFromSwaggerSpec creates a function for every operation of a Swagger 2.0 document.
+func functionsFromSwaggerSpec(swaggerSpec *openapi.Swagger) (map[string]*transformation_plugins.TransformationTemplate, error) {
 	var consumesJson bool
 	if len(swaggerSpec.Consumes) == 0 {
 		consumesJson = true
@@ -228,7 +276,7 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(_ context.Context, sw
 		}
 	}
 	if !consumesJson {
-		return errors.Errorf("swagger function discovery uses content type application/json; "+
+		return nil, errors.Errorf("swagger function discovery uses content type application/json; "+
 			"available: %v", swaggerSpec.Consumes)
 	}
 	// TODO: when response transformation is done, look at produces as well
@@ -236,38 +284,31 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(_ context.Context, sw
 	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
 
 	if swaggerSpec.Paths == nil {
-		return errors.Errorf("swagger spec paths was nil: %v", swaggerSpec.Paths)
+		return nil, errors.Errorf("swagger spec paths was nil: %v", swaggerSpec.Paths)
 	}
 
 	for functionPath, pathItem := range swaggerSpec.Paths.Paths {
 		createFunctionsForPath(funcs, swaggerSpec.BasePath, functionPath, pathItem.PathItemProps, swaggerSpec.Definitions)
 	}
 
-	return updatecb(func(u *v1.Upstream) error {
-		upstreamSpec, ok := u.GetUpstreamType().(v1.ServiceSpecMutator)
-		if !ok {
-			return errors.New("not a valid upstream")
-		}
-		spec := upstreamSpec.GetServiceSpec()
-		if spec == nil {
-			spec = &plugins.ServiceSpec{}
-		}
-		restSpec, ok := spec.GetPluginType().(*plugins.ServiceSpec_Rest)
-		if !ok {
-			restSpec = &plugins.ServiceSpec_Rest{
-				Rest: &rest_plugins.ServiceSpec{},
-			}
-		}
+	return funcs, nil
+}
 
-		restSpec.Rest.Transformations = funcs
-		spec.PluginType = restSpec
+// functionsFromOpenAPI3Spec creates a function for every operation of an OpenAPI 3.x document.
+// Request bodies are only templated for JSON content; other content types are passed through.
+func functionsFromOpenAPI3Spec(doc *openapi3.T) (map[string]*transformation_plugins.TransformationTemplate, error) {
+	if doc.Paths == nil || doc.Paths.Len() == 0 {
+		return nil, errors.Errorf("openapi spec has no paths")
+	}
 
-		upstreamSpec.SetServiceSpec(spec)
-		return nil
-	})
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	for functionPath, pathItem := range doc.Paths.Map() {
+		createFunctionsForOpenAPI3Path(funcs, doc.Servers, functionPath, pathItem)
+	}
+	return funcs, nil
 }
 
-func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*openapi.Swagger, error) {
+func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*Document, error) {
 	docBytes, err := LoadFromFileOrHTTP(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "loading swagger doc from url")
@@ -306,7 +347,16 @@ func loadHTTPBytes(ctx context.Context) func(path string) ([]byte, error) {
 	}
 }
 
-func parseSwaggerDoc(docBytes []byte) (*openapi.Swagger, error) {
+// parseSwaggerDoc parses a JSON or YAML Swagger 2.0 or OpenAPI 3.x document.
+func parseSwaggerDoc(docBytes []byte) (*Document, error) {
+	if isOpenAPI3Doc(docBytes) {
+		doc, err := parseOpenAPI3Doc(docBytes)
+		if err != nil {
+			return nil, err
+		}
+		return &Document{OpenAPI3: doc}, nil
+	}
+
 	doc, err := loads.Analyzed(docBytes, "")
 	if err != nil {
 		log.Debugf("parsing doc as json failed, falling back to yaml")
@@ -319,5 +369,5 @@ func parseSwaggerDoc(docBytes []byte) (*openapi.Swagger, error) {
 			return nil, errors.Wrap(err, "invalid swagger doc")
 		}
 	}
-	return doc.Spec(), nil
+	return &Document{Swagger: doc.Spec()}, nil
 }
@@ -0,0 +1,13 @@
+package swagger
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSwagger(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Swagger Suite")
+}
@@ -61,6 +61,12 @@ func createFunctionForOperation(method string, basePath, functionPath string, op
 		}
 	}
 
+	return buildFunction(method, basePath, functionPath, operation.ID, queryParams, headerParams, body)
+}
+
+// buildFunction builds the transformation for a Swagger 2.0 or OpenAPI 3.x operation. The query and header
+// params are taken from the parameters of the same name, and body is the template of the request body, if any.
+func buildFunction(method, basePath, functionPath, operationID string, queryParams, headerParams []string, body *string) (string, *transformation_plugins.TransformationTemplate) {
 	path := swaggerPathToJinjaTemplate(basePath + functionPath)
 	if len(queryParams) > 0 {
 		path += "?" + strings.Join(queryParams, "&")
@@ -72,7 +78,7 @@ func createFunctionForOperation(method string, basePath, functionPath string, op
 		headersTemplate[name] = fmt.Sprintf("{{default(%v, \"\")}}", name)
 	}
 
-	fnName := operation.ID
+	fnName := operationID
 	if fnName == "" {
 		fnName = strings.ToLower(method) + strings.Replace(functionPath, "/", ".", -1)
 	}
@@ -130,6 +130,18 @@ func (m *Gateway) Clone() proto.Message {
 			}
 		}
 
+	case *Gateway_UdpGateway:
+
+		if h, ok := interface{}(m.GetUdpGateway()).(clone.Cloner); ok {
+			target.GatewayType = &Gateway_UdpGateway{
+				UdpGateway: h.Clone().(*UdpGateway),
+			}
+		} else {
+			target.GatewayType = &Gateway_UdpGateway{
+				UdpGateway: proto.Clone(m.GetUdpGateway()).(*UdpGateway),
+			}
+		}
+
 	}
 
 	return target
@@ -165,6 +177,25 @@ func (m *TcpGateway) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpGateway) Clone() proto.Message {
+	var target *UdpGateway
+	if m == nil {
+		return target
+	}
+	target = &UdpGateway{}
+
+	if h, ok := interface{}(m.GetUdpProxy()).(clone.Cloner); ok {
+		target.UdpProxy = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UdpProxyAction)
+	} else {
+		target.UdpProxy = proto.Clone(m.GetUdpProxy()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UdpProxyAction)
+	}
+
+	target.StatPrefix = m.GetStatPrefix()
+
+	return target
+}
+
 // Clone function
 func (m *HybridGateway) Clone() proto.Message {
 	var target *HybridGateway
@@ -166,6 +166,21 @@ func (m *Gateway) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Gateway_UdpGateway:
+		if _, ok := target.GatewayType.(*Gateway_UdpGateway); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpGateway()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpGateway()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpGateway(), target.GetUdpGateway()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.GatewayType != target.GatewayType {
@@ -227,6 +242,44 @@ func (m *TcpGateway) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpGateway)
+	if !ok {
+		that2, ok := that.(UdpGateway)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUdpProxy()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUdpProxy()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUdpProxy(), target.GetUdpProxy()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetStatPrefix(), target.GetStatPrefix()) != 0 {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HybridGateway) Equal(that interface{}) bool {
 	if that == nil {
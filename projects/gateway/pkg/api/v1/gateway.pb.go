@@ -59,12 +59,14 @@ type Gateway struct {
 	// HttpGateway creates a listener with an http_connection_manager
 	// TcpGateway creates a listener with a tcp proxy filter
 	// HybridGateway creates a listener with any number of filter chains that each may have either an http_connection_manager or a tcp proxy filter
+	// UdpGateway creates a UDP listener with a udp proxy filter
 	//
 	// Types that are valid to be assigned to GatewayType:
 	//
 	//	*Gateway_HttpGateway
 	//	*Gateway_TcpGateway
 	//	*Gateway_HybridGateway
+	//	*Gateway_UdpGateway
 	GatewayType isGateway_GatewayType `protobuf_oneof:"GatewayType"`
 	// Names of the [`Proxy`](https://docs.solo.io/gloo-edge/latest/reference/api/github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto.sk/)
 	// resources to generate from this gateway. If other gateways exist which point to the same proxy,
@@ -204,6 +206,15 @@ func (x *Gateway) GetHybridGateway() *HybridGateway {
 	return nil
 }
 
+func (x *Gateway) GetUdpGateway() *UdpGateway {
+	if x != nil {
+		if x, ok := x.GatewayType.(*Gateway_UdpGateway); ok {
+			return x.UdpGateway
+		}
+	}
+	return nil
+}
+
 func (x *Gateway) GetProxyNames() []string {
 	if x != nil {
 		return x.ProxyNames
@@ -234,12 +245,18 @@ type Gateway_HybridGateway struct {
 	HybridGateway *HybridGateway `protobuf:"bytes,11,opt,name=hybrid_gateway,json=hybridGateway,proto3,oneof"`
 }
 
+type Gateway_UdpGateway struct {
+	UdpGateway *UdpGateway `protobuf:"bytes,15,opt,name=udp_gateway,json=udpGateway,proto3,oneof"`
+}
+
 func (*Gateway_HttpGateway) isGateway_GatewayType() {}
 
 func (*Gateway_TcpGateway) isGateway_GatewayType() {}
 
 func (*Gateway_HybridGateway) isGateway_GatewayType() {}
 
+func (*Gateway_UdpGateway) isGateway_GatewayType() {}
+
 type TcpGateway struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// TCP hosts that the gateway can route to
@@ -294,6 +311,60 @@ func (x *TcpGateway) GetOptions() *v1.TcpListenerOptions {
 	return nil
 }
 
+type UdpGateway struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Where datagrams received by the gateway are forwarded to
+	UdpProxy *v1.UdpProxyAction `protobuf:"bytes,1,opt,name=udp_proxy,json=udpProxy,proto3" json:"udp_proxy,omitempty"`
+	// prefix for addressing envoy stats for the udp proxy
+	StatPrefix    string `protobuf:"bytes,2,opt,name=stat_prefix,json=statPrefix,proto3" json:"stat_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UdpGateway) Reset() {
+	*x = UdpGateway{}
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UdpGateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UdpGateway) ProtoMessage() {}
+
+func (x *UdpGateway) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UdpGateway.ProtoReflect.Descriptor instead.
+func (*UdpGateway) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UdpGateway) GetUdpProxy() *v1.UdpProxyAction {
+	if x != nil {
+		return x.UdpProxy
+	}
+	return nil
+}
+
+func (x *UdpGateway) GetStatPrefix() string {
+	if x != nil {
+		return x.StatPrefix
+	}
+	return ""
+}
+
 type HybridGateway struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// MatchedGateways can be used to define both HttpGateways and TcpGateways directly on the Gateway resource.
@@ -315,7 +386,7 @@ type HybridGateway struct {
 
 func (x *HybridGateway) Reset() {
 	*x = HybridGateway{}
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -327,7 +398,7 @@ func (x *HybridGateway) String() string {
 func (*HybridGateway) ProtoMessage() {}
 
 func (x *HybridGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +411,7 @@ func (x *HybridGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HybridGateway.ProtoReflect.Descriptor instead.
 func (*HybridGateway) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{2}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *HybridGateway) GetMatchedGateways() []*MatchedGateway {
@@ -391,7 +462,7 @@ type DelegatedHttpGateway struct {
 
 func (x *DelegatedHttpGateway) Reset() {
 	*x = DelegatedHttpGateway{}
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +474,7 @@ func (x *DelegatedHttpGateway) String() string {
 func (*DelegatedHttpGateway) ProtoMessage() {}
 
 func (x *DelegatedHttpGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +487,7 @@ func (x *DelegatedHttpGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelegatedHttpGateway.ProtoReflect.Descriptor instead.
 func (*DelegatedHttpGateway) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{3}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DelegatedHttpGateway) GetSelectionType() isDelegatedHttpGateway_SelectionType {
@@ -498,7 +569,7 @@ type DelegatedTcpGateway struct {
 
 func (x *DelegatedTcpGateway) Reset() {
 	*x = DelegatedTcpGateway{}
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +581,7 @@ func (x *DelegatedTcpGateway) String() string {
 func (*DelegatedTcpGateway) ProtoMessage() {}
 
 func (x *DelegatedTcpGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +594,7 @@ func (x *DelegatedTcpGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelegatedTcpGateway.ProtoReflect.Descriptor instead.
 func (*DelegatedTcpGateway) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{4}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DelegatedTcpGateway) GetSelectionType() isDelegatedTcpGateway_SelectionType {
@@ -587,7 +658,7 @@ type MatchedGateway struct {
 
 func (x *MatchedGateway) Reset() {
 	*x = MatchedGateway{}
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +670,7 @@ func (x *MatchedGateway) String() string {
 func (*MatchedGateway) ProtoMessage() {}
 
 func (x *MatchedGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +683,7 @@ func (x *MatchedGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchedGateway.ProtoReflect.Descriptor instead.
 func (*MatchedGateway) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{5}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MatchedGateway) GetMatcher() *Matcher {
@@ -683,7 +754,7 @@ type Matcher struct {
 
 func (x *Matcher) Reset() {
 	*x = Matcher{}
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -695,7 +766,7 @@ func (x *Matcher) String() string {
 func (*Matcher) ProtoMessage() {}
 
 func (x *Matcher) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,7 +779,7 @@ func (x *Matcher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Matcher.ProtoReflect.Descriptor instead.
 func (*Matcher) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{6}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Matcher) GetSslConfig() *ssl.SslConfig {
@@ -736,7 +807,7 @@ var File_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto protorefl
 
 const file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc = "" +
 	"\n" +
-	"=github.com/solo-io/gloo/projects/gateway/api/v1/gateway.proto\x12\x0fgateway.solo.io\x1a\x12extproto/ext.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a1github.com/solo-io/solo-kit/api/v1/metadata.proto\x1a/github.com/solo-io/solo-kit/api/v1/status.proto\x1a,github.com/solo-io/solo-kit/api/v1/ref.proto\x1a1github.com/solo-io/solo-kit/api/v1/solo-kit.proto\x1aBgithub.com/solo-io/gloo/projects/gateway/api/v1/http_gateway.proto\x1a8github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto\x1aCgithub.com/solo-io/gloo/projects/gloo/api/v1/listener_options.proto\x1aGgithub.com/solo-io/gloo/projects/gloo/api/v1/tcp_listener_options.proto\x1aNgithub.com/solo-io/gloo/projects/gloo/api/v1/route_configuration_options.proto\x1aBgithub.com/solo-io/gloo/projects/gloo/api/v1/options/hcm/hcm.proto\x1a:github.com/solo-io/gloo/projects/gloo/api/v1/ssl/ssl.proto\x1aKgithub.com/solo-io/gloo/projects/gloo/api/v1/core/selectors/selectors.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/config/core/v3/address.proto\"\xcd\x05\n" +
+	"=github.com/solo-io/gloo/projects/gateway/api/v1/gateway.proto\x12\x0fgateway.solo.io\x1a\x12extproto/ext.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a1github.com/solo-io/solo-kit/api/v1/metadata.proto\x1a/github.com/solo-io/solo-kit/api/v1/status.proto\x1a,github.com/solo-io/solo-kit/api/v1/ref.proto\x1a1github.com/solo-io/solo-kit/api/v1/solo-kit.proto\x1aBgithub.com/solo-io/gloo/projects/gateway/api/v1/http_gateway.proto\x1a8github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto\x1aCgithub.com/solo-io/gloo/projects/gloo/api/v1/listener_options.proto\x1aGgithub.com/solo-io/gloo/projects/gloo/api/v1/tcp_listener_options.proto\x1aNgithub.com/solo-io/gloo/projects/gloo/api/v1/route_configuration_options.proto\x1aBgithub.com/solo-io/gloo/projects/gloo/api/v1/options/hcm/hcm.proto\x1a:github.com/solo-io/gloo/projects/gloo/api/v1/ssl/ssl.proto\x1aKgithub.com/solo-io/gloo/projects/gloo/api/v1/core/selectors/selectors.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/config/core/v3/address.proto\"\x8d\x06\n" +
 	"\aGateway\x12\x10\n" +
 	"\x03ssl\x18\x01 \x01(\bR\x03ssl\x12!\n" +
 	"\fbind_address\x18\x03 \x01(\tR\vbindAddress\x12\x1b\n" +
@@ -749,7 +820,9 @@ const file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc
 	"\vtcp_gateway\x18\n" +
 	" \x01(\v2\x1b.gateway.solo.io.TcpGatewayH\x00R\n" +
 	"tcpGateway\x12G\n" +
-	"\x0ehybrid_gateway\x18\v \x01(\v2\x1e.gateway.solo.io.HybridGatewayH\x00R\rhybridGateway\x12\x1f\n" +
+	"\x0ehybrid_gateway\x18\v \x01(\v2\x1e.gateway.solo.io.HybridGatewayH\x00R\rhybridGateway\x12>\n" +
+	"\vudp_gateway\x18\x0f \x01(\v2\x1b.gateway.solo.io.UdpGatewayH\x00R\n" +
+	"udpGateway\x12\x1f\n" +
 	"\vproxy_names\x18\f \x03(\tR\n" +
 	"proxyNames\x12L\n" +
 	"\rroute_options\x18\r \x01(\v2'.gloo.solo.io.RouteConfigurationOptionsR\frouteOptions:\x12\x82\xf1\x04\x0e\n" +
@@ -758,7 +831,12 @@ const file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc
 	"\n" +
 	"TcpGateway\x122\n" +
 	"\ttcp_hosts\x18\x01 \x03(\v2\x15.gloo.solo.io.TcpHostR\btcpHosts\x12:\n" +
-	"\aoptions\x18\b \x01(\v2 .gloo.solo.io.TcpListenerOptionsR\aoptions\"\x96\x02\n" +
+	"\aoptions\x18\b \x01(\v2 .gloo.solo.io.TcpListenerOptionsR\aoptions\"h\n" +
+	"\n" +
+	"UdpGateway\x129\n" +
+	"\tudp_proxy\x18\x01 \x01(\v2\x1c.gloo.solo.io.UdpProxyActionR\budpProxy\x12\x1f\n" +
+	"\vstat_prefix\x18\x02 \x01(\tR\n" +
+	"statPrefix\"\x96\x02\n" +
 	"\rHybridGateway\x12J\n" +
 	"\x10matched_gateways\x18\x01 \x03(\v2\x1f.gateway.solo.io.MatchedGatewayR\x0fmatchedGateways\x12]\n" +
 	"\x17delegated_http_gateways\x18\x02 \x01(\v2%.gateway.solo.io.DelegatedHttpGatewayR\x15delegatedHttpGateways\x12Z\n" +
@@ -799,59 +877,63 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescG
 	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_goTypes = []any{
 	(*Gateway)(nil),                           // 0: gateway.solo.io.Gateway
 	(*TcpGateway)(nil),                        // 1: gateway.solo.io.TcpGateway
-	(*HybridGateway)(nil),                     // 2: gateway.solo.io.HybridGateway
-	(*DelegatedHttpGateway)(nil),              // 3: gateway.solo.io.DelegatedHttpGateway
-	(*DelegatedTcpGateway)(nil),               // 4: gateway.solo.io.DelegatedTcpGateway
-	(*MatchedGateway)(nil),                    // 5: gateway.solo.io.MatchedGateway
-	(*Matcher)(nil),                           // 6: gateway.solo.io.Matcher
-	(*v1.ListenerOptions)(nil),                // 7: gloo.solo.io.ListenerOptions
-	(*core.NamespacedStatuses)(nil),           // 8: core.solo.io.NamespacedStatuses
-	(*core.Metadata)(nil),                     // 9: core.solo.io.Metadata
-	(*wrapperspb.BoolValue)(nil),              // 10: google.protobuf.BoolValue
-	(*HttpGateway)(nil),                       // 11: gateway.solo.io.HttpGateway
-	(*v1.RouteConfigurationOptions)(nil),      // 12: gloo.solo.io.RouteConfigurationOptions
-	(*v1.TcpHost)(nil),                        // 13: gloo.solo.io.TcpHost
-	(*v1.TcpListenerOptions)(nil),             // 14: gloo.solo.io.TcpListenerOptions
-	(*core.ResourceRef)(nil),                  // 15: core.solo.io.ResourceRef
-	(*selectors.Selector)(nil),                // 16: selectors.core.gloo.solo.io.Selector
-	(*hcm.HttpConnectionManagerSettings)(nil), // 17: hcm.options.gloo.solo.io.HttpConnectionManagerSettings
-	(*ssl.SslConfig)(nil),                     // 18: gloo.solo.io.SslConfig
-	(*v3.CidrRange)(nil),                      // 19: solo.io.envoy.config.core.v3.CidrRange
+	(*UdpGateway)(nil),                        // 2: gateway.solo.io.UdpGateway
+	(*HybridGateway)(nil),                     // 3: gateway.solo.io.HybridGateway
+	(*DelegatedHttpGateway)(nil),              // 4: gateway.solo.io.DelegatedHttpGateway
+	(*DelegatedTcpGateway)(nil),               // 5: gateway.solo.io.DelegatedTcpGateway
+	(*MatchedGateway)(nil),                    // 6: gateway.solo.io.MatchedGateway
+	(*Matcher)(nil),                           // 7: gateway.solo.io.Matcher
+	(*v1.ListenerOptions)(nil),                // 8: gloo.solo.io.ListenerOptions
+	(*core.NamespacedStatuses)(nil),           // 9: core.solo.io.NamespacedStatuses
+	(*core.Metadata)(nil),                     // 10: core.solo.io.Metadata
+	(*wrapperspb.BoolValue)(nil),              // 11: google.protobuf.BoolValue
+	(*HttpGateway)(nil),                       // 12: gateway.solo.io.HttpGateway
+	(*v1.RouteConfigurationOptions)(nil),      // 13: gloo.solo.io.RouteConfigurationOptions
+	(*v1.TcpHost)(nil),                        // 14: gloo.solo.io.TcpHost
+	(*v1.TcpListenerOptions)(nil),             // 15: gloo.solo.io.TcpListenerOptions
+	(*v1.UdpProxyAction)(nil),                 // 16: gloo.solo.io.UdpProxyAction
+	(*core.ResourceRef)(nil),                  // 17: core.solo.io.ResourceRef
+	(*selectors.Selector)(nil),                // 18: selectors.core.gloo.solo.io.Selector
+	(*hcm.HttpConnectionManagerSettings)(nil), // 19: hcm.options.gloo.solo.io.HttpConnectionManagerSettings
+	(*ssl.SslConfig)(nil),                     // 20: gloo.solo.io.SslConfig
+	(*v3.CidrRange)(nil),                      // 21: solo.io.envoy.config.core.v3.CidrRange
 }
 var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_depIdxs = []int32{
-	7,  // 0: gateway.solo.io.Gateway.options:type_name -> gloo.solo.io.ListenerOptions
-	8,  // 1: gateway.solo.io.Gateway.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
-	9,  // 2: gateway.solo.io.Gateway.metadata:type_name -> core.solo.io.Metadata
-	10, // 3: gateway.solo.io.Gateway.use_proxy_proto:type_name -> google.protobuf.BoolValue
-	11, // 4: gateway.solo.io.Gateway.http_gateway:type_name -> gateway.solo.io.HttpGateway
+	8,  // 0: gateway.solo.io.Gateway.options:type_name -> gloo.solo.io.ListenerOptions
+	9,  // 1: gateway.solo.io.Gateway.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
+	10, // 2: gateway.solo.io.Gateway.metadata:type_name -> core.solo.io.Metadata
+	11, // 3: gateway.solo.io.Gateway.use_proxy_proto:type_name -> google.protobuf.BoolValue
+	12, // 4: gateway.solo.io.Gateway.http_gateway:type_name -> gateway.solo.io.HttpGateway
 	1,  // 5: gateway.solo.io.Gateway.tcp_gateway:type_name -> gateway.solo.io.TcpGateway
-	2,  // 6: gateway.solo.io.Gateway.hybrid_gateway:type_name -> gateway.solo.io.HybridGateway
-	12, // 7: gateway.solo.io.Gateway.route_options:type_name -> gloo.solo.io.RouteConfigurationOptions
-	13, // 8: gateway.solo.io.TcpGateway.tcp_hosts:type_name -> gloo.solo.io.TcpHost
-	14, // 9: gateway.solo.io.TcpGateway.options:type_name -> gloo.solo.io.TcpListenerOptions
-	5,  // 10: gateway.solo.io.HybridGateway.matched_gateways:type_name -> gateway.solo.io.MatchedGateway
-	3,  // 11: gateway.solo.io.HybridGateway.delegated_http_gateways:type_name -> gateway.solo.io.DelegatedHttpGateway
-	4,  // 12: gateway.solo.io.HybridGateway.delegated_tcp_gateways:type_name -> gateway.solo.io.DelegatedTcpGateway
-	15, // 13: gateway.solo.io.DelegatedHttpGateway.ref:type_name -> core.solo.io.ResourceRef
-	16, // 14: gateway.solo.io.DelegatedHttpGateway.selector:type_name -> selectors.core.gloo.solo.io.Selector
-	17, // 15: gateway.solo.io.DelegatedHttpGateway.http_connection_manager_settings:type_name -> hcm.options.gloo.solo.io.HttpConnectionManagerSettings
-	18, // 16: gateway.solo.io.DelegatedHttpGateway.ssl_config:type_name -> gloo.solo.io.SslConfig
-	15, // 17: gateway.solo.io.DelegatedTcpGateway.ref:type_name -> core.solo.io.ResourceRef
-	16, // 18: gateway.solo.io.DelegatedTcpGateway.selector:type_name -> selectors.core.gloo.solo.io.Selector
-	6,  // 19: gateway.solo.io.MatchedGateway.matcher:type_name -> gateway.solo.io.Matcher
-	11, // 20: gateway.solo.io.MatchedGateway.http_gateway:type_name -> gateway.solo.io.HttpGateway
-	1,  // 21: gateway.solo.io.MatchedGateway.tcp_gateway:type_name -> gateway.solo.io.TcpGateway
-	18, // 22: gateway.solo.io.Matcher.ssl_config:type_name -> gloo.solo.io.SslConfig
-	19, // 23: gateway.solo.io.Matcher.source_prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	3,  // 6: gateway.solo.io.Gateway.hybrid_gateway:type_name -> gateway.solo.io.HybridGateway
+	2,  // 7: gateway.solo.io.Gateway.udp_gateway:type_name -> gateway.solo.io.UdpGateway
+	13, // 8: gateway.solo.io.Gateway.route_options:type_name -> gloo.solo.io.RouteConfigurationOptions
+	14, // 9: gateway.solo.io.TcpGateway.tcp_hosts:type_name -> gloo.solo.io.TcpHost
+	15, // 10: gateway.solo.io.TcpGateway.options:type_name -> gloo.solo.io.TcpListenerOptions
+	16, // 11: gateway.solo.io.UdpGateway.udp_proxy:type_name -> gloo.solo.io.UdpProxyAction
+	6,  // 12: gateway.solo.io.HybridGateway.matched_gateways:type_name -> gateway.solo.io.MatchedGateway
+	4,  // 13: gateway.solo.io.HybridGateway.delegated_http_gateways:type_name -> gateway.solo.io.DelegatedHttpGateway
+	5,  // 14: gateway.solo.io.HybridGateway.delegated_tcp_gateways:type_name -> gateway.solo.io.DelegatedTcpGateway
+	17, // 15: gateway.solo.io.DelegatedHttpGateway.ref:type_name -> core.solo.io.ResourceRef
+	18, // 16: gateway.solo.io.DelegatedHttpGateway.selector:type_name -> selectors.core.gloo.solo.io.Selector
+	19, // 17: gateway.solo.io.DelegatedHttpGateway.http_connection_manager_settings:type_name -> hcm.options.gloo.solo.io.HttpConnectionManagerSettings
+	20, // 18: gateway.solo.io.DelegatedHttpGateway.ssl_config:type_name -> gloo.solo.io.SslConfig
+	17, // 19: gateway.solo.io.DelegatedTcpGateway.ref:type_name -> core.solo.io.ResourceRef
+	18, // 20: gateway.solo.io.DelegatedTcpGateway.selector:type_name -> selectors.core.gloo.solo.io.Selector
+	7,  // 21: gateway.solo.io.MatchedGateway.matcher:type_name -> gateway.solo.io.Matcher
+	12, // 22: gateway.solo.io.MatchedGateway.http_gateway:type_name -> gateway.solo.io.HttpGateway
+	1,  // 23: gateway.solo.io.MatchedGateway.tcp_gateway:type_name -> gateway.solo.io.TcpGateway
+	20, // 24: gateway.solo.io.Matcher.ssl_config:type_name -> gloo.solo.io.SslConfig
+	21, // 25: gateway.solo.io.Matcher.source_prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() }
@@ -864,16 +946,17 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 		(*Gateway_HttpGateway)(nil),
 		(*Gateway_TcpGateway)(nil),
 		(*Gateway_HybridGateway)(nil),
+		(*Gateway_UdpGateway)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4].OneofWrappers = []any{
 		(*DelegatedHttpGateway_Ref)(nil),
 		(*DelegatedHttpGateway_Selector)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5].OneofWrappers = []any{
 		(*DelegatedTcpGateway_Ref)(nil),
 		(*DelegatedTcpGateway_Selector)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6].OneofWrappers = []any{
 		(*MatchedGateway_HttpGateway)(nil),
 		(*MatchedGateway_TcpGateway)(nil),
 	}
@@ -883,7 +966,7 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -231,6 +231,28 @@ func (m *Gateway) HashUnique(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *Gateway_UdpGateway:
+
+		if h, ok := interface{}(m.GetUdpGateway()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("UdpGateway")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetUdpGateway(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("UdpGateway")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
@@ -305,6 +327,52 @@ func (m *TcpGateway) HashUnique(hasher hash.Hash64) (uint64, error) {
 	return hasher.Sum64(), nil
 }
 
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *UdpGateway) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gateway.solo.io.github.com/solo-io/gloo/projects/gateway/pkg/api/v1.UdpGateway")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetUdpProxy()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("UdpProxy")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetUdpProxy(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("UdpProxy")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte("StatPrefix")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetStatPrefix())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // HashUnique function generates a hash of the object that is unique to the object by
 // hashing field name and value pairs.
 // Replaces Hash due to original hashing implemention only using field values. The omission
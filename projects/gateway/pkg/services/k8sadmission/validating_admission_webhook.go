@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	"github.com/solo-io/gloo/pkg/utils/statsutils"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 
 	"github.com/ghodss/yaml"
 
@@ -27,6 +28,8 @@ import (
 
 	"go.opencensus.io/stats"
 	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 
 	errors "github.com/rotisserie/eris"
 	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
@@ -224,7 +227,9 @@ func NewGatewayValidationHandler(
 }
 
 func (wh *gatewayValidationWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	logger := contextutils.LoggerFrom(wh.ctx)
+	ctx, span := telemetry.StartSpan(wh.ctx, "gloo.validation.AdmissionReview")
+	defer span.End()
+	logger := contextutils.LoggerFrom(ctx)
 
 	logger.Debug("received validation request on webhook")
 
@@ -260,16 +265,17 @@ func (wh *gatewayValidationWebhook) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	if contentType == ApplicationYaml {
 		if err = yaml.Unmarshal(body, &review); err == nil {
-			admissionResponse = wh.makeAdmissionResponse(wh.ctx, &review)
+			admissionResponse = wh.makeAdmissionResponse(ctx, &review)
 		}
 	} else {
 		if _, _, err := deserializer.Decode(body, nil, &review); err == nil {
-			admissionResponse = wh.makeAdmissionResponse(wh.ctx, &review)
+			admissionResponse = wh.makeAdmissionResponse(ctx, &review)
 		}
 	}
 
 	if err != nil {
 		logger.Errorf("Can't decode body: %v", err)
+		span.SetStatus(codes.Error, err.Error())
 		admissionResponse.AdmissionResponse = &v1beta1.AdmissionResponse{
 			Result: &metav1.Status{
 				Message: err.Error(),
@@ -285,6 +291,15 @@ func (wh *gatewayValidationWebhook) ServeHTTP(w http.ResponseWriter, r *http.Req
 		}
 		if review.Request != nil {
 			admissionReview.Response.UID = review.Request.UID
+			span.SetAttributes(
+				attribute.String("k8s.resource.kind", review.Request.Kind.String()),
+				attribute.String("k8s.resource.namespace", review.Request.Namespace),
+				attribute.String("k8s.resource.name", review.Request.Name),
+				attribute.String("k8s.admission.operation", string(review.Request.Operation)),
+			)
+		}
+		if admissionReview.Response != nil {
+			span.SetAttributes(attribute.Bool("k8s.admission.allowed", admissionReview.Response.Allowed))
 		}
 	}
 
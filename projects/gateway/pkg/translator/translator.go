@@ -61,6 +61,7 @@ func NewDefaultTranslator(opts Opts) *GwTranslator {
 	//	- tcpTranslator produces a TcpListener
 	//	- hybridTranslator produces a HybridListener
 	//	- aggregateTranslator produces an AggregateListener
+	//	- udpTranslator produces a UdpListener
 	httpTranslator := &HttpTranslator{
 		VirtualServiceTranslator: virtualServiceTranslator,
 	}
@@ -73,12 +74,14 @@ func NewDefaultTranslator(opts Opts) *GwTranslator {
 		VirtualServiceTranslator: virtualServiceTranslator,
 		TcpTranslator:            tcpTranslator,
 	}
+	udpTranslator := &UdpTranslator{}
 
 	translatorsByName := map[string]ListenerTranslator{
 		HttpTranslatorName:      httpTranslator,
 		TcpTranslatorName:       tcpTranslator,
 		HybridTranslatorName:    hybridTranslator,
 		AggregateTranslatorName: aggregateTranslator,
+		UdpTranslatorName:       udpTranslator,
 	}
 
 	return &GwTranslator{
@@ -164,6 +167,9 @@ func (t *GwTranslator) getListenerTranslatorForGateway(gateway *v1.Gateway) List
 		} else {
 			listenerTranslatorImpl = t.listenerTranslators[HybridTranslatorName]
 		}
+
+	case *v1.Gateway_UdpGateway:
+		listenerTranslatorImpl = t.listenerTranslators[UdpTranslatorName]
 	}
 
 	if listenerTranslatorImpl == nil {
@@ -205,13 +211,17 @@ func makeListener(gateway *v1.Gateway) *gloov1.Listener {
 }
 
 func ListenerName(gateway *v1.Gateway) string {
+	if gateway.GetUdpGateway() != nil {
+		// UDP listeners may share a bind address with a TCP-based listener, so they are named distinctly
+		return fmt.Sprintf("listener-udp-%s-%d", gateway.GetBindAddress(), gateway.GetBindPort())
+	}
 	return fmt.Sprintf("listener-%s-%d", gateway.GetBindAddress(), gateway.GetBindPort())
 }
 
 // validateGateways validates a set of Gateways that will be aggregated on a Proxy
 // and writes errors to the ResourceReports.
 // Gateways must meet the following criteria:
-//  1. All bind addresses are unique
+//  1. All bind addresses are unique (UDP gateways may share a bind address with a TCP-based gateway)
 //  2. All VirtualServices that are referenced by a Gateway are available in the API Snapshot
 func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualServiceList, reports reporter.ResourceReports) {
 	bindAddresses := map[string]v1.GatewayList{}
@@ -219,6 +229,9 @@ func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualService
 	// they are invalid.
 	for _, gw := range gateways {
 		bindAddress := fmt.Sprintf("%s:%d", gw.GetBindAddress(), gw.GetBindPort())
+		if gw.GetUdpGateway() != nil {
+			bindAddress = "udp/" + bindAddress
+		}
 		bindAddresses[bindAddress] = append(bindAddresses[bindAddress], gw)
 
 		var gatewayVirtualServices []*core.ResourceRef
@@ -118,6 +118,25 @@ type KubernetesProxyConfig struct {
 
 	// Used to unset the `runAsUser` values in security contexts.
 	FloatingUserId *bool `json:"floatingUserId,omitempty"`
+
+	// Configuration for a HorizontalPodAutoscaler that scales the proxy deployment.
+	// If autoscaling is enabled, the deployment's replicas are left to the
+	// HorizontalPodAutoscaler.
+	//
+	// +kubebuilder:validation:Optional
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+
+	// Configuration for a PodDisruptionBudget of the proxy pods.
+	//
+	// +kubebuilder:validation:Optional
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
+	// Patches that are applied, in order, to the Kubernetes objects rendered for
+	// the Gateway. Overlays from the GatewayClass' GatewayParameters are applied
+	// before the overlays of the Gateway's GatewayParameters.
+	//
+	// +kubebuilder:validation:Optional
+	Overlays []*ObjectOverlay `json:"overlays,omitempty"`
 }
 
 func (in *KubernetesProxyConfig) GetDeployment() *ProxyDeployment {
@@ -190,6 +209,27 @@ func (in *KubernetesProxyConfig) GetFloatingUserId() *bool {
 	return in.FloatingUserId
 }
 
+func (in *KubernetesProxyConfig) GetAutoscaling() *Autoscaling {
+	if in == nil {
+		return nil
+	}
+	return in.Autoscaling
+}
+
+func (in *KubernetesProxyConfig) GetPodDisruptionBudget() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	return in.PodDisruptionBudget
+}
+
+func (in *KubernetesProxyConfig) GetOverlays() []*ObjectOverlay {
+	if in == nil {
+		return nil
+	}
+	return in.Overlays
+}
+
 // Configuration for the Proxy deployment in Kubernetes.
 type ProxyDeployment struct {
 	// The number of desired pods.
@@ -244,6 +284,14 @@ type EnvoyContainer struct {
 	//
 	// +kubebuilder:validation:Optional
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Wasm modules to mount into the envoy container from ConfigMaps or
+	// Secrets. Wasm filters load a module with `mountedModule: <name>`.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	WasmModules []WasmModule `json:"wasmModules,omitempty"`
 }
 
 func (in *EnvoyContainer) GetBootstrap() *EnvoyBootstrap {
@@ -274,6 +322,13 @@ func (in *EnvoyContainer) GetResources() *corev1.ResourceRequirements {
 	return in.Resources
 }
 
+func (in *EnvoyContainer) GetWasmModules() []WasmModule {
+	if in == nil {
+		return nil
+	}
+	return in.WasmModules
+}
+
 // Configuration for the Envoy proxy instance that is provisioned from a
 // Kubernetes Gateway.
 type EnvoyBootstrap struct {
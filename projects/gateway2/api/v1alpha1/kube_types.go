@@ -1,7 +1,10 @@
 package v1alpha1
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // A container image. See https://kubernetes.io/docs/concepts/containers/images
@@ -398,3 +401,313 @@ func (in *GracefulShutdownSpec) GetSleepTimeSeconds() *int {
 	}
 	return in.SleepTimeSeconds
 }
+
+// Configuration for the HorizontalPodAutoscaler that scales the proxy deployment.
+// See https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/
+// for details.
+//
+// +kubebuilder:validation:XValidation:message="minReplicas must not be greater than maxReplicas",rule="!has(self.minReplicas) || !has(self.maxReplicas) || self.minReplicas <= self.maxReplicas"
+type Autoscaling struct {
+	// Whether to create a HorizontalPodAutoscaler. Defaults to true if autoscaling
+	// is configured, and may be set to false to disable autoscaling that is
+	// configured in the GatewayClass' GatewayParameters.
+	//
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The lower limit for the number of replicas. Defaults to 1.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// The upper limit for the number of replicas. This must be set if autoscaling
+	// is enabled.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// The target average CPU utilization of the pods, as a percentage of the
+	// requested CPU.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// The target average memory utilization of the pods, as a percentage of the
+	// requested memory.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Additional metrics to scale on, e.g. Pods or External metrics served by a
+	// custom metrics API. See
+	// https://kubernetes.io/docs/reference/kubernetes-api/workload-resources/horizontal-pod-autoscaler-v2/#HorizontalPodAutoscalerSpec
+	// for details.
+	//
+	// +kubebuilder:validation:Optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// The scaling behavior of the HorizontalPodAutoscaler, in the up and down
+	// directions.
+	//
+	// +kubebuilder:validation:Optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+func (in *Autoscaling) GetEnabled() *bool {
+	if in == nil {
+		return nil
+	}
+	return in.Enabled
+}
+
+func (in *Autoscaling) GetMinReplicas() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.MinReplicas
+}
+
+func (in *Autoscaling) GetMaxReplicas() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.MaxReplicas
+}
+
+func (in *Autoscaling) GetTargetCPUUtilizationPercentage() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetCPUUtilizationPercentage
+}
+
+func (in *Autoscaling) GetTargetMemoryUtilizationPercentage() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetMemoryUtilizationPercentage
+}
+
+func (in *Autoscaling) GetMetrics() []autoscalingv2.MetricSpec {
+	if in == nil {
+		return nil
+	}
+	return in.Metrics
+}
+
+func (in *Autoscaling) GetBehavior() *autoscalingv2.HorizontalPodAutoscalerBehavior {
+	if in == nil {
+		return nil
+	}
+	return in.Behavior
+}
+
+// Configuration for the PodDisruptionBudget of the proxy pods. See
+// https://kubernetes.io/docs/concepts/workloads/pods/disruptions/#pod-disruption-budgets
+// for details.
+//
+// +kubebuilder:validation:XValidation:message="only one of 'minAvailable' or 'maxUnavailable' may be set",rule="!(has(self.minAvailable) && has(self.maxUnavailable))"
+type PodDisruptionBudget struct {
+	// Whether to create a PodDisruptionBudget. Defaults to true if a
+	// PodDisruptionBudget is configured, and may be set to false to disable the
+	// PodDisruptionBudget that is configured in the GatewayClass' GatewayParameters.
+	//
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The number or percentage of pods that must remain available during a
+	// voluntary disruption.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XIntOrString
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// The number or percentage of pods that may be unavailable during a
+	// voluntary disruption. Defaults to 1 if `minAvailable` is not set.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XIntOrString
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+func (in *PodDisruptionBudget) GetEnabled() *bool {
+	if in == nil {
+		return nil
+	}
+	return in.Enabled
+}
+
+func (in *PodDisruptionBudget) GetMinAvailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MinAvailable
+}
+
+func (in *PodDisruptionBudget) GetMaxUnavailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MaxUnavailable
+}
+
+// The format of an ObjectOverlay patch.
+//
+// +kubebuilder:validation:Enum=StrategicMerge;Merge;JSONPatch
+type OverlayPatchType string
+
+const (
+	// A Kubernetes strategic merge patch, as used by `kubectl patch --type=strategic`.
+	// Only supported for built-in Kubernetes kinds.
+	OverlayPatchTypeStrategicMerge OverlayPatchType = "StrategicMerge"
+	// A JSON merge patch (RFC 7386).
+	OverlayPatchTypeMerge OverlayPatchType = "Merge"
+	// A JSON patch (RFC 6902).
+	OverlayPatchTypeJSONPatch OverlayPatchType = "JSONPatch"
+)
+
+// A patch that is applied to the Kubernetes objects that are rendered for a
+// Gateway. Overlays can be used to set fields that are not modeled by
+// GatewayParameters, e.g.
+//
+//	```yaml
+//	overlays:
+//	- kind: Deployment
+//	  patch:
+//	    spec:
+//	      template:
+//	        spec:
+//	          priorityClassName: system-cluster-critical
+//	```
+//
+// The kind, name and namespace of the patched objects may not be changed.
+type ObjectOverlay struct {
+	// The kind of the rendered objects to patch, e.g. `Deployment` or `Service`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// The name of the rendered object to patch. If omitted, all rendered objects
+	// of the kind are patched.
+	//
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty"`
+
+	// The format of the patch. Defaults to StrategicMerge.
+	//
+	// +kubebuilder:validation:Optional
+	PatchType *OverlayPatchType `json:"patchType,omitempty"`
+
+	// The patch to apply. This is an object for StrategicMerge and Merge patches,
+	// and a list of operations for JSONPatch patches.
+	Patch apiextensionsv1.JSON `json:"patch"`
+}
+
+func (in *ObjectOverlay) GetKind() string {
+	if in == nil {
+		return ""
+	}
+	return in.Kind
+}
+
+func (in *ObjectOverlay) GetName() *string {
+	if in == nil {
+		return nil
+	}
+	return in.Name
+}
+
+func (in *ObjectOverlay) GetPatchType() *OverlayPatchType {
+	if in == nil {
+		return nil
+	}
+	return in.PatchType
+}
+
+func (in *ObjectOverlay) GetPatch() []byte {
+	if in == nil {
+		return nil
+	}
+	return in.Patch.Raw
+}
+
+// A Wasm module that is mounted into the envoy container from a ConfigMap or a
+// Secret. The module is mounted at /etc/envoy/wasm/<name>/module.wasm. Note
+// that ConfigMaps and Secrets are limited to 1MiB; larger modules should be
+// loaded from an image.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of configMap or secret must be set",rule="has(self.configMap) != has(self.secret)"
+type WasmModule struct {
+	// The name of the module, which is referenced by the `mountedModule` of
+	// Wasm filters.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=58
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// The ConfigMap containing the module, which should be stored in its
+	// binaryData.
+	//
+	// +kubebuilder:validation:Optional
+	ConfigMap *WasmModuleRef `json:"configMap,omitempty"`
+
+	// The Secret containing the module.
+	//
+	// +kubebuilder:validation:Optional
+	Secret *WasmModuleRef `json:"secret,omitempty"`
+}
+
+func (in *WasmModule) GetName() string {
+	if in == nil {
+		return ""
+	}
+	return in.Name
+}
+
+func (in *WasmModule) GetConfigMap() *WasmModuleRef {
+	if in == nil {
+		return nil
+	}
+	return in.ConfigMap
+}
+
+func (in *WasmModule) GetSecret() *WasmModuleRef {
+	if in == nil {
+		return nil
+	}
+	return in.Secret
+}
+
+// A reference to the key of a ConfigMap or Secret, in the namespace of the
+// Gateway, which contains a Wasm module.
+type WasmModuleRef struct {
+	// The name of the ConfigMap or Secret.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// The key which contains the module. Defaults to "module.wasm".
+	//
+	// +kubebuilder:validation:Optional
+	Key *string `json:"key,omitempty"`
+}
+
+func (in *WasmModuleRef) GetName() string {
+	if in == nil {
+		return ""
+	}
+	return in.Name
+}
+
+func (in *WasmModuleRef) GetKey() *string {
+	if in == nil {
+		return nil
+	}
+	return in.Key
+}
@@ -83,8 +83,10 @@ func NewBaseGatewayController(ctx context.Context, cfg GatewayConfig) error {
 		controllerBuilder.watchGwClass,
 		controllerBuilder.watchGw,
 		controllerBuilder.watchHttpRoute,
+		controllerBuilder.watchGrpcRoute,
 		controllerBuilder.watchTcpRoute,
 		controllerBuilder.watchTlsRoute,
+		controllerBuilder.watchUdpRoute,
 		controllerBuilder.watchReferenceGrant,
 		controllerBuilder.watchNamespaces,
 		controllerBuilder.watchHttpListenerOptions,
@@ -133,6 +135,13 @@ func (c *controllerBuilder) addIndexes(ctx context.Context) error {
 		errs = append(errs, err)
 	}
 
+	// Conditionally index for GRPCRoute
+	if c.cfg.CRDs.Has(wellknown.GRPCRouteCRDName) {
+		if err := c.cfg.Mgr.GetFieldIndexer().IndexField(ctx, &apiv1.GRPCRoute{}, query.GrpcRouteTargetField, query.IndexerByObjType); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	// Index for ReferenceGrant
 	if err := c.cfg.Mgr.GetFieldIndexer().IndexField(ctx, &apiv1beta1.ReferenceGrant{}, query.ReferenceGrantFromField, query.IndexerByObjType); err != nil {
 		errs = append(errs, err)
@@ -151,6 +160,12 @@ func (c *controllerBuilder) addIndexes(ctx context.Context) error {
 		}
 	}
 
+	if c.cfg.CRDs.Has(wellknown.UDPRouteCRDName) {
+		if err := c.cfg.Mgr.GetFieldIndexer().IndexField(ctx, &apiv1a2.UDPRoute{}, query.UdpRouteTargetField, query.IndexerByObjType); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if c.cfg.CRDs.Has(wellknown.XListenerSetKind) {
 		if err := c.cfg.Mgr.GetFieldIndexer().IndexField(ctx, &apixv1a1.XListenerSet{}, query.ListenerSetTargetField, query.IndexerByObjType); err != nil {
 			errs = append(errs, err)
@@ -382,6 +397,18 @@ func (c *controllerBuilder) watchHttpRoute(_ context.Context) error {
 		Complete(reconcile.Func(c.reconciler.ReconcileHttpRoutes))
 }
 
+func (c *controllerBuilder) watchGrpcRoute(ctx context.Context) error {
+	if !c.cfg.CRDs.Has(wellknown.GRPCRouteCRDName) {
+		log.FromContext(ctx).Info("GRPCRoute CRD not installed; skipping GRPCRoute controller setup")
+		return nil
+	}
+
+	return ctrl.NewControllerManagedBy(c.cfg.Mgr).
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{})).
+		For(&apiv1.GRPCRoute{}).
+		Complete(reconcile.Func(c.reconciler.ReconcileGrpcRoutes))
+}
+
 func (c *controllerBuilder) watchTcpRoute(ctx context.Context) error {
 	if !c.cfg.CRDs.Has(wellknown.TCPRouteCRDName) {
 		log.FromContext(ctx).Info("TCPRoute type not registered in scheme; skipping TCPRoute controller setup")
@@ -408,6 +435,19 @@ func (c *controllerBuilder) watchTlsRoute(ctx context.Context) error {
 		Complete(reconcile.Func(c.reconciler.ReconcileTlsRoutes))
 }
 
+func (c *controllerBuilder) watchUdpRoute(ctx context.Context) error {
+	if !c.cfg.CRDs.Has(wellknown.UDPRouteCRDName) {
+		log.FromContext(ctx).Info("UDPRoute type not registered in scheme; skipping UDPRoute controller setup")
+		return nil
+	}
+
+	// Proceed to set up the controller for UDPRoute
+	return ctrl.NewControllerManagedBy(c.cfg.Mgr).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		For(&apiv1a2.UDPRoute{}).
+		Complete(reconcile.Func(c.reconciler.ReconcileUdpRoutes))
+}
+
 func (c *controllerBuilder) watchReferenceGrant(_ context.Context) error {
 	return ctrl.NewControllerManagedBy(c.cfg.Mgr).
 		WithEventFilter(predicate.GenerationChangedPredicate{}).
@@ -587,6 +627,12 @@ func (r *controllerReconciler) ReconcileHttpRoutes(ctx context.Context, req ctrl
 	return ctrl.Result{}, nil
 }
 
+func (r *controllerReconciler) ReconcileGrpcRoutes(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// TODO: consider finding impacted gateways and queue them
+	r.kick(ctx)
+	return ctrl.Result{}, nil
+}
+
 func (r *controllerReconciler) ReconcileTcpRoutes(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	// TODO: consider finding impacted gateways and queue them
 	// TODO: consider enabling this
@@ -609,6 +655,12 @@ func (r *controllerReconciler) ReconcileTlsRoutes(ctx context.Context, req ctrl.
 	return ctrl.Result{}, nil
 }
 
+func (r *controllerReconciler) ReconcileUdpRoutes(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// TODO: consider finding impacted gateways and queue them
+	r.kick(ctx)
+	return ctrl.Result{}, nil
+}
+
 func (r *controllerReconciler) ReconcileReferenceGrants(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	// reconcile all things?! https://github.com/solo-io/gloo/issues/9997
 	r.kick(ctx)
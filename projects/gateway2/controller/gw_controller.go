@@ -77,6 +77,10 @@ func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if err != nil {
 		return result, err
 	}
+	err = r.deployer.DeleteDisabledObjs(ctx, &gw, objs)
+	if err != nil {
+		return result, err
+	}
 	r.kick(ctx)
 
 	return result, nil
@@ -257,6 +257,16 @@ func (c *ControllerBuilder) Start(ctx context.Context) error {
 		}
 	}
 
+	// the proxies send a service account token to the xds server if it authenticates them with one,
+	// issued for the first audience it accepts
+	var xdsTokenInfo *deployer.XdsServiceAccountTokenInfo
+	if tokens := c.cfg.InitialSettings.Spec.GetGloo().GetXdsAuthOptions().GetServiceAccountTokens(); tokens != nil {
+		xdsTokenInfo = &deployer.XdsServiceAccountTokenInfo{}
+		if audiences := tokens.GetAudiences(); len(audiences) > 0 {
+			xdsTokenInfo.Audience = audiences[0]
+		}
+	}
+
 	// Initialize the set of Gateway API CRDs we care about
 	crds, err := getGatewayCRDs(c.cfg.RestConfig)
 	if err != nil {
@@ -269,10 +279,11 @@ func (c *ControllerBuilder) Start(ctx context.Context) error {
 		ControllerName: wellknown.GatewayControllerName,
 		AutoProvision:  AutoProvision,
 		ControlPlane: deployer.ControlPlaneInfo{
-			XdsHost:         xdsHost,
-			XdsPort:         xdsPort,
-			GlooMtlsEnabled: c.cfg.GlooMtlsEnabled,
-			Namespace:       namespaces.GetPodNamespace(),
+			XdsHost:                xdsHost,
+			XdsPort:                xdsPort,
+			GlooMtlsEnabled:        c.cfg.GlooMtlsEnabled,
+			XdsServiceAccountToken: xdsTokenInfo,
+			Namespace:              namespaces.GetPodNamespace(),
 		},
 		// TODO pass in the settings so that the deloyer can register to it for changes.
 		IstioIntegrationEnabled: integrationEnabled,
@@ -293,6 +304,15 @@ func (c *ControllerBuilder) Start(ctx context.Context) error {
 func getGatewayCRDs(restConfig *rest.Config) (sets.Set[string], error) {
 	crds := wellknown.GatewayStandardCRDs
 
+	grpcRouteExists, err := glooschemes.CRDExists(restConfig, wellknown.GRPCRouteGVK.Group, wellknown.GRPCRouteGVK.Version, wellknown.GRPCRouteKind)
+	if err != nil {
+		return nil, err
+	}
+
+	if grpcRouteExists {
+		crds.Insert(wellknown.GRPCRouteCRDName)
+	}
+
 	tcpRouteExists, err := glooschemes.CRDExists(restConfig, gwv1a2.GroupVersion.Group, gwv1a2.GroupVersion.Version, wellknown.TCPRouteKind)
 	if err != nil {
 		return nil, err
@@ -311,6 +331,15 @@ func getGatewayCRDs(restConfig *rest.Config) (sets.Set[string], error) {
 		crds.Insert(wellknown.TLSRouteCRDName)
 	}
 
+	udpRouteExists, err := glooschemes.CRDExists(restConfig, gwv1a2.GroupVersion.Group, gwv1a2.GroupVersion.Version, wellknown.UDPRouteKind)
+	if err != nil {
+		return nil, err
+	}
+
+	if udpRouteExists {
+		crds.Insert(wellknown.UDPRouteCRDName)
+	}
+
 	xListenerSetExists, err := glooschemes.CRDExists(restConfig, gwxv1a1.GroupVersion.Group, gwxv1a1.GroupVersion.Version, wellknown.XListenerSetKind)
 	if err != nil {
 		return nil, err
@@ -21,15 +21,20 @@ import (
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	api "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -74,10 +79,17 @@ type ControlPlaneInfo struct {
 	// The data in this struct is static, so is a good place to keep track of if mtls is enabled
 	// and a bad place to store the actual mtls secret data
 	GlooMtlsEnabled bool
+	// XdsServiceAccountToken is set if the xDS server authenticates the proxies with service account tokens
+	XdsServiceAccountToken *XdsServiceAccountTokenInfo
 	// We could lookup the pod namespace from the env, but it's cleaner to pass it in
 	Namespace string
 }
 
+type XdsServiceAccountTokenInfo struct {
+	// The audience that the token of the proxies is issued for, which is the API server if empty
+	Audience string
+}
+
 type AwsInfo struct {
 	EnableServiceAccountCredentials bool
 	StsClusterName                  string
@@ -136,7 +148,8 @@ func (d *Deployer) GetGvksToWatch(ctx context.Context) ([]schema.GroupVersionKin
 	// _slightly_ more dynamic way of getting the GVKs. It isn't a perfect solution since if
 	// we add more resources to the helm chart that are gated by a flag, we may forget to
 	// update the values here to enable them.
-	// Currently the only resource that is gated by a flag is the mtls secret.
+	// Currently the resources that are gated by a flag are the mtls secret, the
+	// HorizontalPodAutoscaler and the PodDisruptionBudget.
 
 	emptyGw := &api.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
@@ -161,6 +174,15 @@ func (d *Deployer) GetGvksToWatch(ctx context.Context) ([]schema.GroupVersionKin
 			"glooMtls": map[string]any{
 				"renderSecret": d.inputs.ControlPlane.GlooMtlsEnabled,
 			},
+			// The HPA and PDB may be enabled by any GatewayParameters, so we always watch them
+			"autoscaling": map[string]any{
+				"enabled":     true,
+				"maxReplicas": 1,
+			},
+			"podDisruptionBudget": map[string]any{
+				"enabled":        true,
+				"maxUnavailable": 1,
+			},
 		},
 	}
 
@@ -312,8 +334,9 @@ func (d *Deployer) getValues(ctx context.Context, gw *api.Gateway, gwParam *v1al
 			Xds: &helmXds{
 				// The xds host/port MUST map to the Service definition for the Control Plane
 				// This is the socket address that the Proxy will connect to on startup, to receive xds updates
-				Host: &d.inputs.ControlPlane.XdsHost,
-				Port: &d.inputs.ControlPlane.XdsPort,
+				Host:                &d.inputs.ControlPlane.XdsHost,
+				Port:                &d.inputs.ControlPlane.XdsPort,
+				ServiceAccountToken: getXdsServiceAccountTokenValues(d.inputs.ControlPlane.XdsServiceAccountToken),
 			},
 		},
 	}
@@ -351,6 +374,15 @@ func (d *Deployer) getValues(ctx context.Context, gw *api.Gateway, gwParam *v1al
 	// Only set ReplicaCount when explicitly configured. When nil, the Deployment
 	// template will omit the replicas field, letting K8s or an HPA control scaling.
 	gateway.ReplicaCount = deployConfig.GetReplicas()
+	gateway.Autoscaling, err = getAutoscalingValues(kubeProxyConfig.GetAutoscaling())
+	if err != nil {
+		return nil, err
+	}
+	if gateway.Autoscaling != nil {
+		// the HPA owns the replicas of the deployment
+		gateway.ReplicaCount = nil
+	}
+	gateway.PodDisruptionBudget = getPodDisruptionBudgetValues(kubeProxyConfig.GetPodDisruptionBudget())
 
 	// service values
 	gateway.Service = getServiceValues(svcConfig)
@@ -383,6 +415,7 @@ func (d *Deployer) getValues(ctx context.Context, gw *api.Gateway, gwParam *v1al
 	gateway.Resources = envoyContainerConfig.GetResources()
 	gateway.SecurityContext = envoyContainerConfig.GetSecurityContext()
 	gateway.Image = getImageValues(envoyContainerConfig.GetImage())
+	gateway.WasmModules = getWasmModuleValues(envoyContainerConfig.GetWasmModules())
 
 	// istio values
 	gateway.Istio = getIstioValues(d.inputs.IstioIntegrationEnabled, istioConfig)
@@ -495,6 +528,8 @@ func (d *Deployer) Render(name, ns string, vals map[string]any) ([]client.Object
 //
 // * sets ownerRefs on all generated objects
 //
+// * applies the overlays of the GatewayParameters to the generated objects
+//
 // * returns the objects to be deployed by the caller
 func (d *Deployer) GetObjsToDeploy(ctx context.Context, gw *api.Gateway) ([]client.Object, error) {
 	logger := log.FromContext(ctx)
@@ -539,6 +574,13 @@ func (d *Deployer) GetObjsToDeploy(ctx context.Context, gw *api.Gateway) ([]clie
 		}})
 	}
 
+	if gwParam != nil {
+		objs, err = applyOverlays(d.cli.Scheme(), objs, gwParam.Spec.GetKube().GetOverlays())
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply overlays for gateway %s.%s: %w", gw.GetNamespace(), gw.GetName(), err)
+		}
+	}
+
 	if d.postRenderer != nil {
 		objs, err = d.postRenderer(ctx, objs)
 		if err != nil {
@@ -560,6 +602,56 @@ func (d *Deployer) DeployObjs(ctx context.Context, objs []client.Object) error {
 	return nil
 }
 
+// DeleteDisabledObjs deletes the objects that the deployer only renders when they are enabled, the
+// HorizontalPodAutoscaler and the PodDisruptionBudget, if they are owned by the Gateway but not among the objects
+// to deploy. Otherwise, a stale HorizontalPodAutoscaler keeps scaling the Deployment after autoscaling is disabled.
+func (d *Deployer) DeleteDisabledObjs(ctx context.Context, gw *api.Gateway, objs []client.Object) error {
+	logger := log.FromContext(ctx)
+
+	deployed := map[schema.GroupVersionKind]sets.Set[string]{}
+	for _, obj := range objs {
+		gvk, err := apiutil.GVKForObject(obj, d.cli.Scheme())
+		if err != nil {
+			return err
+		}
+		if deployed[gvk] == nil {
+			deployed[gvk] = sets.New[string]()
+		}
+		deployed[gvk].Insert(client.ObjectKeyFromObject(obj).String())
+	}
+
+	for _, list := range []client.ObjectList{
+		&autoscalingv2.HorizontalPodAutoscalerList{},
+		&policyv1.PodDisruptionBudgetList{},
+	} {
+		if err := d.cli.List(ctx, list, client.InNamespace(gw.GetNamespace())); err != nil {
+			return fmt.Errorf("failed to list objects of gateway %s.%s: %w", gw.GetNamespace(), gw.GetName(), err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok || !metav1.IsControlledBy(obj, gw) {
+				continue
+			}
+			gvk, err := apiutil.GVKForObject(obj, d.cli.Scheme())
+			if err != nil {
+				return err
+			}
+			if deployed[gvk].Has(client.ObjectKeyFromObject(obj).String()) {
+				continue
+			}
+			logger.V(1).Info("deleting disabled object", "kind", gvk.Kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+			if err := d.cli.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to delete object %s %s: %w", gvk.String(), obj.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
+
 func loadFs(filesystem fs.FS) (*chart.Chart, error) {
 	var bufferedFiles []*loader.BufferedFile
 	entries, err := fs.ReadDir(filesystem, ".")
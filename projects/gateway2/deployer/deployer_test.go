@@ -25,10 +25,15 @@ import (
 	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/utils/pointer"
@@ -83,6 +88,28 @@ func (objs *clientObjects) findDeployment(namespace, name string) *appsv1.Deploy
 	return nil
 }
 
+func (objs *clientObjects) findHorizontalPodAutoscaler(namespace, name string) *autoscalingv2.HorizontalPodAutoscaler {
+	for _, obj := range *objs {
+		if hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			if hpa.Name == name && hpa.Namespace == namespace {
+				return hpa
+			}
+		}
+	}
+	return nil
+}
+
+func (objs *clientObjects) findPodDisruptionBudget(namespace, name string) *policyv1.PodDisruptionBudget {
+	for _, obj := range *objs {
+		if pdb, ok := obj.(*policyv1.PodDisruptionBudget); ok {
+			if pdb.Name == name && pdb.Namespace == namespace {
+				return pdb
+			}
+		}
+	}
+	return nil
+}
+
 func (objs *clientObjects) findServiceAccount(namespace, name string) *corev1.ServiceAccount {
 	for _, obj := range *objs {
 		if sa, ok := obj.(*corev1.ServiceAccount); ok {
@@ -460,6 +487,64 @@ var _ = Describe("Deployer", func() {
 		})
 	})
 
+	Context("xds service account tokens", func() {
+
+		getObjs := func(tokenInfo *deployer.XdsServiceAccountTokenInfo) (*appsv1.Deployment, string) {
+			d, err := deployer.NewDeployer(newFakeClientWithObjs(defaultGatewayClass(), defaultGatewayParams()), &deployer.Inputs{
+				ControllerName: wellknown.GatewayControllerName,
+				ControlPlane: deployer.ControlPlaneInfo{
+					XdsHost: "something.cluster.local", XdsPort: 1234,
+					XdsServiceAccountToken: tokenInfo,
+				},
+			}, queries)
+			Expect(err).NotTo(HaveOccurred())
+
+			var objs clientObjects
+			objs, err = d.GetObjsToDeploy(context.Background(), defaultGateway())
+			Expect(err).NotTo(HaveOccurred())
+
+			dep := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+			Expect(dep).ToNot(BeNil())
+			cm := objs.findConfigMap(defaultNamespace, defaultConfigMapName)
+			Expect(cm).ToNot(BeNil())
+			return dep, cm.Data["envoy.yaml"]
+		}
+
+		It("sends a projected service account token to the xds server", func() {
+			dep, envoyYaml := getObjs(&deployer.XdsServiceAccountTokenInfo{Audience: "gloo"})
+
+			Expect(dep.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(
+				corev1.VolumeMount{Name: "xds-token", MountPath: "/var/run/secrets/xds", ReadOnly: true},
+			))
+			Expect(dep.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "xds-token",
+				VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+					DefaultMode: ptr.To(int32(420)),
+					Sources: []corev1.VolumeProjection{{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          "gloo",
+							ExpirationSeconds: ptr.To(int64(3600)),
+							Path:              "token",
+						},
+					}},
+				}},
+			}))
+
+			Expect(envoyYaml).To(ContainSubstring("envoy.filters.http.credential_injector"))
+			Expect(envoyYaml).To(ContainSubstring("filename: /var/run/secrets/xds/token"))
+		})
+
+		It("does not send a token when the xds server does not authenticate proxies with one", func() {
+			dep, envoyYaml := getObjs(nil)
+
+			for _, volume := range dep.Spec.Template.Spec.Volumes {
+				Expect(volume.Name).NotTo(Equal("xds-token"))
+			}
+			Expect(envoyYaml).NotTo(ContainSubstring("credential_injector"))
+			Expect(envoyYaml).NotTo(ContainSubstring("xds_token"))
+		})
+	})
+
 	Context("GetGvksToWatch", func() {
 		var gwc *api.GatewayClass
 
@@ -491,6 +576,8 @@ var _ = Describe("Deployer", func() {
 					wellknownkube.ServiceAccountGVK,
 					wellknownkube.ConfigMapGVK,
 					wellknownkube.SecretGVK,
+					wellknownkube.HorizontalPodAutoscalerGVK,
+					wellknownkube.PodDisruptionBudgetGVK,
 				}),
 			Entry("glooMtls disabled",
 				&deployer.Inputs{
@@ -505,6 +592,8 @@ var _ = Describe("Deployer", func() {
 					wellknownkube.ServiceGVK,
 					wellknownkube.ServiceAccountGVK,
 					wellknownkube.ConfigMapGVK,
+					wellknownkube.HorizontalPodAutoscalerGVK,
+					wellknownkube.PodDisruptionBudgetGVK,
 				},
 			))
 
@@ -1655,6 +1744,207 @@ var _ = Describe("Deployer", func() {
 			}, &expectedOutput{
 				validationFunc: validateGatewayParamsWithTopologySpreadConstraints,
 			}),
+			Entry("autoscaling and pod disruption budget", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGateway(),
+				defaultGwp: gatewayParamsWithKube(wellknown.DefaultGatewayParametersName, &gw2_v1alpha1.KubernetesProxyConfig{
+					Deployment: &gw2_v1alpha1.ProxyDeployment{
+						Replicas: ptr.To(uint32(2)),
+					},
+					Autoscaling: &gw2_v1alpha1.Autoscaling{
+						MinReplicas:                    ptr.To(int32(2)),
+						MaxReplicas:                    ptr.To(int32(5)),
+						TargetCPUUtilizationPercentage: ptr.To(int32(80)),
+						Metrics: []autoscalingv2.MetricSpec{{
+							Type: autoscalingv2.PodsMetricSourceType,
+							Pods: &autoscalingv2.PodsMetricSource{
+								Metric: autoscalingv2.MetricIdentifier{Name: "envoy_http_downstream_rq_active"},
+								Target: autoscalingv2.MetricTarget{
+									Type:         autoscalingv2.AverageValueMetricType,
+									AverageValue: ptr.To(resource.MustParse("100")),
+								},
+							},
+						}},
+					},
+					PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+						MinAvailable: ptr.To(intstr.FromString("50%")),
+					},
+				}),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					dep := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+					Expect(dep).ToNot(BeNil())
+					Expect(dep.Spec.Replicas).To(BeNil(), "replicas should be controlled by the HPA")
+
+					hpa := objs.findHorizontalPodAutoscaler(defaultNamespace, defaultDeploymentName)
+					Expect(hpa).ToNot(BeNil())
+					Expect(hpa.Spec.ScaleTargetRef).To(Equal(autoscalingv2.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       defaultDeploymentName,
+					}))
+					Expect(hpa.Spec.MinReplicas).To(Equal(ptr.To(int32(2))))
+					Expect(hpa.Spec.MaxReplicas).To(Equal(int32(5)))
+					Expect(hpa.Spec.Metrics).To(HaveLen(2))
+					Expect(hpa.Spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceCPU))
+					Expect(hpa.Spec.Metrics[0].Resource.Target).To(Equal(autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: ptr.To(int32(80)),
+					}))
+					Expect(hpa.Spec.Metrics[1].Pods.Metric.Name).To(Equal("envoy_http_downstream_rq_active"))
+					Expect(hpa.Spec.Metrics[1].Pods.Target.AverageValue.String()).To(Equal("100"))
+
+					pdb := objs.findPodDisruptionBudget(defaultNamespace, defaultDeploymentName)
+					Expect(pdb).ToNot(BeNil())
+					Expect(pdb.Spec.MinAvailable).To(Equal(ptr.To(intstr.FromString("50%"))))
+					Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+					Expect(pdb.Spec.Selector.MatchLabels).To(Equal(dep.Spec.Selector.MatchLabels))
+					return nil
+				},
+			}),
+			Entry("autoscaling and pod disruption budget disabled by GatewayParameters override", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGatewayWithGatewayParams(gwpOverrideName),
+				defaultGwp: gatewayParamsWithKube(wellknown.DefaultGatewayParametersName, &gw2_v1alpha1.KubernetesProxyConfig{
+					Autoscaling: &gw2_v1alpha1.Autoscaling{
+						MaxReplicas: ptr.To(int32(5)),
+					},
+					PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{},
+				}),
+				overrideGwp: gatewayParamsWithKube(gwpOverrideName, &gw2_v1alpha1.KubernetesProxyConfig{
+					Deployment: &gw2_v1alpha1.ProxyDeployment{
+						Replicas: ptr.To(uint32(3)),
+					},
+					Autoscaling: &gw2_v1alpha1.Autoscaling{
+						Enabled: ptr.To(false),
+					},
+					PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+						Enabled: ptr.To(false),
+					},
+				}),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					dep := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+					Expect(dep).ToNot(BeNil())
+					Expect(dep.Spec.Replicas).To(Equal(ptr.To(int32(3))))
+					Expect(objs.findHorizontalPodAutoscaler(defaultNamespace, defaultDeploymentName)).To(BeNil())
+					Expect(objs.findPodDisruptionBudget(defaultNamespace, defaultDeploymentName)).To(BeNil())
+					return nil
+				},
+			}),
+			Entry("pod disruption budget defaults to a single unavailable pod", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGateway(),
+				defaultGwp: gatewayParamsWithKube(wellknown.DefaultGatewayParametersName, &gw2_v1alpha1.KubernetesProxyConfig{
+					PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{},
+				}),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					pdb := objs.findPodDisruptionBudget(defaultNamespace, defaultDeploymentName)
+					Expect(pdb).ToNot(BeNil())
+					Expect(pdb.Spec.MinAvailable).To(BeNil())
+					Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt32(1))))
+					return nil
+				},
+			}),
+			Entry("autoscaling without maxReplicas", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGateway(),
+				defaultGwp: gatewayParamsWithKube(wellknown.DefaultGatewayParametersName, &gw2_v1alpha1.KubernetesProxyConfig{
+					Autoscaling: &gw2_v1alpha1.Autoscaling{
+						TargetCPUUtilizationPercentage: ptr.To(int32(80)),
+					},
+				}),
+			}, &expectedOutput{
+				getObjsErr: deployer.AutoscalingMaxReplicasMissingError,
+			}),
+			Entry("wasm modules are mounted into the envoy container", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGateway(),
+				defaultGwp: gatewayParamsWithKube(wellknown.DefaultGatewayParametersName, &gw2_v1alpha1.KubernetesProxyConfig{
+					EnvoyContainer: &gw2_v1alpha1.EnvoyContainer{
+						WasmModules: []gw2_v1alpha1.WasmModule{
+							{Name: "headers", ConfigMap: &gw2_v1alpha1.WasmModuleRef{Name: "headers-filter"}},
+							{Name: "auth", Secret: &gw2_v1alpha1.WasmModuleRef{Name: "auth-filter", Key: ptr.To("auth.wasm")}},
+						},
+					},
+				}),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					dep := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+					Expect(dep).ToNot(BeNil())
+					Expect(dep.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElements(
+						corev1.VolumeMount{Name: "wasm-headers", MountPath: "/etc/envoy/wasm/headers", ReadOnly: true},
+						corev1.VolumeMount{Name: "wasm-auth", MountPath: "/etc/envoy/wasm/auth", ReadOnly: true},
+					))
+					Expect(dep.Spec.Template.Spec.Volumes).To(ContainElements(
+						corev1.Volume{
+							Name: "wasm-headers",
+							VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "headers-filter"},
+								Items:                []corev1.KeyToPath{{Key: "module.wasm", Path: "module.wasm"}},
+							}},
+						},
+						corev1.Volume{
+							Name: "wasm-auth",
+							VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+								SecretName: "auth-filter",
+								Items:      []corev1.KeyToPath{{Key: "auth.wasm", Path: "module.wasm"}},
+							}},
+						},
+					))
+					return nil
+				},
+			}),
+			Entry("overlays are applied in order to matching objects", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGatewayWithGatewayParams(gwpOverrideName),
+				defaultGwp: gatewayParamsWithKube(wellknown.DefaultGatewayParametersName, &gw2_v1alpha1.KubernetesProxyConfig{
+					Overlays: []*gw2_v1alpha1.ObjectOverlay{
+						{
+							Kind:  "Deployment",
+							Patch: overlayPatch(`{"spec":{"template":{"spec":{"priorityClassName":"system-cluster-critical","containers":[{"name":"gloo-gateway","env":[{"name":"FOO","value":"bar"}]}]}}}}`),
+						},
+						{
+							Kind:  "Service",
+							Name:  ptr.To("does-not-exist"),
+							Patch: overlayPatch(`{"metadata":{"labels":{"unexpected":"true"}}}`),
+						},
+					},
+				}),
+				overrideGwp: gatewayParamsWithKube(gwpOverrideName, &gw2_v1alpha1.KubernetesProxyConfig{
+					Overlays: []*gw2_v1alpha1.ObjectOverlay{
+						{
+							Kind:      "Deployment",
+							PatchType: ptr.To(gw2_v1alpha1.OverlayPatchTypeJSONPatch),
+							Patch:     overlayPatch(`[{"op":"replace","path":"/spec/template/spec/priorityClassName","value":"gateway-critical"}]`),
+						},
+						{
+							Kind:      "Service",
+							Name:      ptr.To(defaultServiceName),
+							PatchType: ptr.To(gw2_v1alpha1.OverlayPatchTypeMerge),
+							Patch:     overlayPatch(`{"metadata":{"annotations":{"service.beta.kubernetes.io/aws-load-balancer-type":"nlb"}}}`),
+						},
+					},
+				}),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					dep := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+					Expect(dep).ToNot(BeNil())
+					Expect(dep.Spec.Template.Spec.PriorityClassName).To(Equal("gateway-critical"))
+					// the strategic merge patch merges containers by name, instead of replacing the list
+					Expect(dep.Spec.Template.Spec.Containers).To(HaveLen(1))
+					Expect(dep.Spec.Template.Spec.Containers[0].Image).NotTo(BeEmpty())
+					Expect(dep.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "FOO", Value: "bar"}))
+					Expect(dep.GetOwnerReferences()).To(HaveLen(1))
+
+					svc := objs.findService(defaultNamespace, defaultServiceName)
+					Expect(svc).ToNot(BeNil())
+					Expect(svc.GetAnnotations()).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-type", "nlb"))
+					Expect(svc.GetLabels()).NotTo(HaveKey("unexpected"))
+					return nil
+				},
+			}),
 		)
 	})
 
@@ -1751,6 +2041,114 @@ var _ = Describe("Deployer", func() {
 			Expect(deploymentPorts[1].Name).To(Equal(fmt.Sprintf("1-%s", listenerName)))
 			Expect(deploymentPorts[1].ContainerPort).To(Equal(listenerSetPort))
 		})
+
+		It("exposes udp listener ports with the udp protocol", func() {
+			d, err := deployer.NewDeployer(newFakeClientWithObjs(defaultGatewayClass(), defaultGatewayParams()), &deployer.Inputs{
+				ControllerName: wellknown.GatewayControllerName,
+				Dev:            false,
+				ControlPlane: deployer.ControlPlaneInfo{
+					XdsHost: "something.cluster.local", XdsPort: 1234,
+				},
+			}, queries)
+			Expect(err).NotTo(HaveOccurred())
+
+			gw := &api.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: defaultNamespace,
+					UID:       "1235",
+				},
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Gateway",
+					APIVersion: "gateway.solo.io/v1beta1",
+				},
+				Spec: api.GatewaySpec{
+					GatewayClassName: wellknown.GatewayClassName,
+					Listeners: []api.Listener{
+						{
+							Name:     api.SectionName(listenerName),
+							Port:     api.PortNumber(listenerPort),
+							Protocol: api.TCPProtocolType,
+						},
+						{
+							Name:     "udp",
+							Port:     api.PortNumber(listenerPort),
+							Protocol: api.UDPProtocolType,
+						},
+					},
+				},
+			}
+
+			var objs clientObjects
+			objs, err = d.GetObjsToDeploy(context.Background(), gw)
+			Expect(err).NotTo(HaveOccurred())
+
+			// a udp listener may share its port number with a tcp listener
+			servicePorts := objs.findService(defaultNamespace, proxyName(gw.Name)).Spec.Ports
+			Expect(servicePorts).To(HaveLen(3))
+			Expect(servicePorts[0].Port).To(Equal(listenerPort))
+			Expect(servicePorts[0].Protocol).To(Equal(corev1.ProtocolTCP))
+			Expect(servicePorts[1].Name).To(Equal("udp"))
+			Expect(servicePorts[1].Port).To(Equal(listenerPort))
+			Expect(servicePorts[1].Protocol).To(Equal(corev1.ProtocolUDP))
+
+			deploymentPorts := objs.findDeployment(defaultNamespace, proxyName(gw.Name)).Spec.Template.Spec.Containers[0].Ports
+			Expect(deploymentPorts[1].Name).To(Equal("udp"))
+			Expect(deploymentPorts[1].Protocol).To(Equal(corev1.ProtocolUDP))
+		})
+	})
+
+	Context("disabled objects", func() {
+		It("deletes the hpa and pdb of the gateway that are no longer deployed", func() {
+			gw := &api.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: defaultNamespace,
+					UID:       "1235",
+				},
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Gateway",
+					APIVersion: "gateway.solo.io/v1beta1",
+				},
+			}
+			ownedBy := func(uid string) []metav1.OwnerReference {
+				return []metav1.OwnerReference{{
+					Kind:       gw.Kind,
+					APIVersion: gw.APIVersion,
+					Controller: ptr.To(true),
+					UID:        k8stypes.UID(uid),
+					Name:       gw.Name,
+				}}
+			}
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{
+				Name: proxyName(gw.Name), Namespace: defaultNamespace, OwnerReferences: ownedBy("1235"),
+			}}
+			pdb := &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{
+				Name: proxyName(gw.Name), Namespace: defaultNamespace, OwnerReferences: ownedBy("1235"),
+			}}
+			otherHpa := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{
+				Name: "other", Namespace: defaultNamespace, OwnerReferences: ownedBy("4321"),
+			}}
+			cli := newFakeClientWithObjs(defaultGatewayClass(), defaultGatewayParams(), hpa, pdb, otherHpa)
+			d, err := deployer.NewDeployer(cli, &deployer.Inputs{
+				ControllerName: wellknown.GatewayControllerName,
+				ControlPlane: deployer.ControlPlaneInfo{
+					XdsHost: "something.cluster.local", XdsPort: 1234,
+				},
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			// autoscaling was disabled, while the pdb is still deployed
+			deployed := &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{
+				Name: proxyName(gw.Name), Namespace: defaultNamespace,
+			}}
+			Expect(d.DeleteDisabledObjs(context.Background(), gw, []client.Object{deployed})).To(Succeed())
+
+			err = cli.Get(context.Background(), client.ObjectKeyFromObject(hpa), &autoscalingv2.HorizontalPodAutoscaler{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(cli.Get(context.Background(), client.ObjectKeyFromObject(pdb), &policyv1.PodDisruptionBudget{})).To(Succeed())
+			Expect(cli.Get(context.Background(), client.ObjectKeyFromObject(otherHpa), &autoscalingv2.HorizontalPodAutoscaler{})).To(Succeed())
+		})
 	})
 })
 
@@ -1762,6 +2160,27 @@ func newFakeClientWithObjs(objs ...client.Object) client.Client {
 		Build()
 }
 
+// gatewayParamsWithKube returns GatewayParameters in the "default" namespace with the given kube config
+func gatewayParamsWithKube(name string, kube *gw2_v1alpha1.KubernetesProxyConfig) *gw2_v1alpha1.GatewayParameters {
+	return &gw2_v1alpha1.GatewayParameters{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       gw2_v1alpha1.GatewayParametersKind,
+			APIVersion: gw2_v1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: gw2_v1alpha1.GatewayParametersSpec{
+			Kube: kube,
+		},
+	}
+}
+
+func overlayPatch(patch string) apiextensionsv1.JSON {
+	return apiextensionsv1.JSON{Raw: []byte(patch)}
+}
+
 func defaultSdsContainer() *gw2_v1alpha1.SdsContainer {
 	return &gw2_v1alpha1.SdsContainer{
 		Image: &gw2_v1alpha1.Image{
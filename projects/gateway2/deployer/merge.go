@@ -101,6 +101,10 @@ func deepMergeGatewayParameters(dst, src *v1alpha1.GatewayParameters) *v1alpha1.
 	dstKube.Stats = deepMergeStatsConfig(dstKube.GetStats(), srcKube.GetStats())
 	dstKube.AiExtension = deepMergeAIExtension(dstKube.GetAiExtension(), srcKube.GetAiExtension())
 	dstKube.FloatingUserId = mergePointers(dstKube.GetFloatingUserId(), srcKube.GetFloatingUserId())
+	dstKube.Autoscaling = deepMergeAutoscaling(dstKube.GetAutoscaling(), srcKube.GetAutoscaling())
+	dstKube.PodDisruptionBudget = deepMergePodDisruptionBudget(dstKube.GetPodDisruptionBudget(), srcKube.GetPodDisruptionBudget())
+	// overlays of the Gateway's GatewayParameters are applied after the overlays of the GatewayClass' GatewayParameters
+	dstKube.Overlays = deepMergeSlices(dstKube.GetOverlays(), srcKube.GetOverlays())
 
 	return dst
 }
@@ -582,10 +586,35 @@ func deepMergeEnvoyContainer(dst, src *v1alpha1.EnvoyContainer) *v1alpha1.EnvoyC
 	dst.Image = deepMergeImage(dst.GetImage(), src.GetImage())
 	dst.SecurityContext = deepMergeSecurityContext(dst.GetSecurityContext(), src.GetSecurityContext())
 	dst.Resources = deepMergeResourceRequirements(dst.GetResources(), src.GetResources())
+	dst.WasmModules = deepMergeWasmModules(dst.GetWasmModules(), src.GetWasmModules())
 
 	return dst
 }
 
+// Wasm modules are keyed by name, so a src module replaces the dst module with the same name
+func deepMergeWasmModules(dst, src []v1alpha1.WasmModule) []v1alpha1.WasmModule {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil || len(src) == 0 {
+		return src
+	}
+
+	srcNames := make(map[string]bool, len(src))
+	for _, module := range src {
+		srcNames[module.GetName()] = true
+	}
+	merged := make([]v1alpha1.WasmModule, 0, len(dst)+len(src))
+	for _, module := range dst {
+		if !srcNames[module.GetName()] {
+			merged = append(merged, module)
+		}
+	}
+	return append(merged, src...)
+}
+
 func deepMergeImage(dst, src *v1alpha1.Image) *v1alpha1.Image {
 	// nil src override means just use dst
 	if src == nil {
@@ -709,6 +738,47 @@ func deepMergeDeployment(dst, src *v1alpha1.ProxyDeployment) *v1alpha1.ProxyDepl
 	return dst
 }
 
+func deepMergeAutoscaling(dst, src *v1alpha1.Autoscaling) *v1alpha1.Autoscaling {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.Enabled = mergePointers(dst.GetEnabled(), src.GetEnabled())
+	dst.MinReplicas = mergePointers(dst.GetMinReplicas(), src.GetMinReplicas())
+	dst.MaxReplicas = mergePointers(dst.GetMaxReplicas(), src.GetMaxReplicas())
+	dst.TargetCPUUtilizationPercentage = mergePointers(dst.GetTargetCPUUtilizationPercentage(), src.GetTargetCPUUtilizationPercentage())
+	dst.TargetMemoryUtilizationPercentage = mergePointers(dst.GetTargetMemoryUtilizationPercentage(), src.GetTargetMemoryUtilizationPercentage())
+	dst.Metrics = overrideSlices(dst.GetMetrics(), src.GetMetrics())
+	dst.Behavior = mergePointers(dst.GetBehavior(), src.GetBehavior())
+
+	return dst
+}
+
+func deepMergePodDisruptionBudget(dst, src *v1alpha1.PodDisruptionBudget) *v1alpha1.PodDisruptionBudget {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.Enabled = mergePointers(dst.GetEnabled(), src.GetEnabled())
+	// minAvailable and maxUnavailable are mutually exclusive, so an override of either replaces both
+	if src.GetMinAvailable() != nil || src.GetMaxUnavailable() != nil {
+		dst.MinAvailable = src.GetMinAvailable()
+		dst.MaxUnavailable = src.GetMaxUnavailable()
+	}
+
+	return dst
+}
+
 func deepMergeAIExtension(dst, src *v1alpha1.AiExtension) *v1alpha1.AiExtension {
 	// nil src override means just use dst
 	if src == nil {
@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 	gw2_v1alpha1 "github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 )
 
@@ -252,4 +253,111 @@ var _ = Describe("deepMergeGatewayParameters", func() {
 		out := deepMergeGatewayParameters(dst, src)
 		Expect(out.Spec.Kube.Service.ExternalTrafficPolicy).To(Equal(ptr.To(corev1.ServiceExternalTrafficPolicyCluster)))
 	})
+
+	It("merges autoscaling fields individually", func() {
+		dst := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					Autoscaling: &gw2_v1alpha1.Autoscaling{
+						MinReplicas:                    ptr.To[int32](2),
+						MaxReplicas:                    ptr.To[int32](5),
+						TargetCPUUtilizationPercentage: ptr.To[int32](80),
+					},
+				},
+			},
+		}
+		src := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					Autoscaling: &gw2_v1alpha1.Autoscaling{
+						MaxReplicas: ptr.To[int32](10),
+					},
+				},
+			},
+		}
+
+		out := deepMergeGatewayParameters(dst, src)
+		Expect(out.Spec.Kube.Autoscaling).To(Equal(&gw2_v1alpha1.Autoscaling{
+			MinReplicas:                    ptr.To[int32](2),
+			MaxReplicas:                    ptr.To[int32](10),
+			TargetCPUUtilizationPercentage: ptr.To[int32](80),
+		}))
+	})
+
+	It("replaces the pod disruption budget when either budget is overridden", func() {
+		dst := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+						MinAvailable: ptr.To(intstr.FromInt32(1)),
+					},
+				},
+			},
+		}
+		src := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+						MaxUnavailable: ptr.To(intstr.FromString("25%")),
+					},
+				},
+			},
+		}
+
+		out := deepMergeGatewayParameters(dst, src)
+		Expect(out.Spec.Kube.PodDisruptionBudget.MinAvailable).To(BeNil())
+		Expect(out.Spec.Kube.PodDisruptionBudget.MaxUnavailable).To(Equal(ptr.To(intstr.FromString("25%"))))
+	})
+
+	It("appends overlays", func() {
+		dst := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					Overlays: []*gw2_v1alpha1.ObjectOverlay{{Kind: "Deployment"}},
+				},
+			},
+		}
+		src := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					Overlays: []*gw2_v1alpha1.ObjectOverlay{{Kind: "Service"}},
+				},
+			},
+		}
+
+		out := deepMergeGatewayParameters(dst, src)
+		Expect(out.Spec.Kube.Overlays).To(Equal([]*gw2_v1alpha1.ObjectOverlay{{Kind: "Deployment"}, {Kind: "Service"}}))
+	})
+
+	It("merges wasm modules by name", func() {
+		dst := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					EnvoyContainer: &gw2_v1alpha1.EnvoyContainer{
+						WasmModules: []gw2_v1alpha1.WasmModule{
+							{Name: "auth", ConfigMap: &gw2_v1alpha1.WasmModuleRef{Name: "auth-v1"}},
+							{Name: "headers", ConfigMap: &gw2_v1alpha1.WasmModuleRef{Name: "headers"}},
+						},
+					},
+				},
+			},
+		}
+		src := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					EnvoyContainer: &gw2_v1alpha1.EnvoyContainer{
+						WasmModules: []gw2_v1alpha1.WasmModule{
+							{Name: "auth", Secret: &gw2_v1alpha1.WasmModuleRef{Name: "auth-v2"}},
+						},
+					},
+				},
+			},
+		}
+
+		out := deepMergeGatewayParameters(dst, src)
+		Expect(out.Spec.Kube.EnvoyContainer.WasmModules).To(Equal([]gw2_v1alpha1.WasmModule{
+			{Name: "headers", ConfigMap: &gw2_v1alpha1.WasmModuleRef{Name: "headers"}},
+			{Name: "auth", Secret: &gw2_v1alpha1.WasmModuleRef{Name: "auth-v2"}},
+		}))
+	})
 })
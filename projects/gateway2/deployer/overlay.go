@@ -0,0 +1,115 @@
+package deployer
+
+import (
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	StrategicMergeUnsupportedError = func(kind string) error {
+		return eris.Errorf("strategic merge patches are not supported for %s objects, use a Merge or JSONPatch patch instead", kind)
+	}
+	OverlayChangedIdentityError = func(kind, name string) error {
+		return eris.Errorf("overlay must not change the kind, name or namespace of %s %s", kind, name)
+	}
+)
+
+// applyOverlays patches the rendered objects with each overlay that matches them, in order.
+// Patched objects are returned as the same type they were rendered as.
+func applyOverlays(scheme *runtime.Scheme, objs []client.Object, overlays []*v1alpha1.ObjectOverlay) ([]client.Object, error) {
+	if len(overlays) == 0 {
+		return objs, nil
+	}
+
+	patched := make([]client.Object, 0, len(objs))
+	for _, obj := range objs {
+		for _, overlay := range overlays {
+			if !overlayMatches(overlay, obj) {
+				continue
+			}
+			patchedObj, err := applyOverlay(scheme, obj, overlay)
+			if err != nil {
+				return nil, eris.Wrapf(err, "failed to apply overlay to %s %s", overlay.GetKind(), obj.GetName())
+			}
+			obj = patchedObj
+		}
+		patched = append(patched, obj)
+	}
+	return patched, nil
+}
+
+func overlayMatches(overlay *v1alpha1.ObjectOverlay, obj client.Object) bool {
+	if overlay.GetKind() != obj.GetObjectKind().GroupVersionKind().Kind {
+		return false
+	}
+	return overlay.GetName() == nil || *overlay.GetName() == obj.GetName()
+}
+
+func applyOverlay(scheme *runtime.Scheme, obj client.Object, overlay *v1alpha1.ObjectOverlay) (client.Object, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []byte
+	patchType := v1alpha1.OverlayPatchTypeStrategicMerge
+	if overlay.GetPatchType() != nil {
+		patchType = *overlay.GetPatchType()
+	}
+	switch patchType {
+	case v1alpha1.OverlayPatchTypeStrategicMerge:
+		// strategic merge patches rely on the patch strategies declared on the Go types
+		dataStruct, err := scheme.New(gvk)
+		if err != nil {
+			return nil, StrategicMergeUnsupportedError(gvk.Kind)
+		}
+		result, err = strategicpatch.StrategicMergePatch(original, overlay.GetPatch(), dataStruct)
+		if err != nil {
+			return nil, err
+		}
+	case v1alpha1.OverlayPatchTypeMerge:
+		result, err = jsonpatch.MergePatch(original, overlay.GetPatch())
+		if err != nil {
+			return nil, err
+		}
+	case v1alpha1.OverlayPatchTypeJSONPatch:
+		patch, err := jsonpatch.DecodePatch(overlay.GetPatch())
+		if err != nil {
+			return nil, err
+		}
+		result, err = patch.Apply(original)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, eris.Errorf("unknown patch type %s", patchType)
+	}
+
+	// decode into an empty object, so that fields removed by the patch are not retained
+	var out client.Object = &unstructured.Unstructured{}
+	if _, ok := obj.(*unstructured.Unstructured); !ok {
+		typed, err := scheme.New(gvk)
+		if err != nil {
+			return nil, err
+		}
+		if out, ok = typed.(client.Object); !ok {
+			return nil, eris.Errorf("%s is not a client.Object", gvk.Kind)
+		}
+	}
+	if err := json.Unmarshal(result, out); err != nil {
+		return nil, err
+	}
+
+	if out.GetObjectKind().GroupVersionKind() != gvk || out.GetName() != obj.GetName() || out.GetNamespace() != obj.GetNamespace() {
+		return nil, OverlayChangedIdentityError(gvk.Kind, obj.GetName())
+	}
+	return out, nil
+}
@@ -2,7 +2,9 @@ package deployer
 
 import (
 	"github.com/solo-io/gloo/projects/gateway2/api/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // The top-level helm values used by the deployer.
@@ -19,11 +21,12 @@ type helmGateway struct {
 	FullnameOverride *string `json:"fullnameOverride,omitempty"`
 
 	// deployment/service values
-	ReplicaCount   *uint32          `json:"replicaCount,omitempty"`
-	Autoscaling    *helmAutoscaling `json:"autoscaling,omitempty"`
-	Ports          []helmPort       `json:"ports,omitempty"`
-	Service        *helmService     `json:"service,omitempty"`
-	FloatingUserId *bool            `json:"floatingUserId,omitempty"`
+	ReplicaCount        *uint32                  `json:"replicaCount,omitempty"`
+	Autoscaling         *helmAutoscaling         `json:"autoscaling,omitempty"`
+	PodDisruptionBudget *helmPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	Ports               []helmPort               `json:"ports,omitempty"`
+	Service             *helmService             `json:"service,omitempty"`
+	FloatingUserId      *bool                    `json:"floatingUserId,omitempty"`
 
 	// serviceaccount values
 	ServiceAccount *helmServiceAccount `json:"serviceAccount,omitempty"`
@@ -55,6 +58,7 @@ type helmGateway struct {
 	Image             *helmImage                   `json:"image,omitempty"`
 	Resources         *corev1.ResourceRequirements `json:"resources,omitempty"`
 	SecurityContext   *corev1.SecurityContext      `json:"securityContext,omitempty"`
+	WasmModules       []helmWasmModule             `json:"wasmModules,omitempty"`
 
 	// xds values
 	Xds *helmXds `json:"xds,omitempty"`
@@ -105,16 +109,41 @@ type helmServiceAccount struct {
 // helmXds represents the xds host and port to which envoy will connect
 // to receive xds config updates
 type helmXds struct {
-	Host *string `json:"host,omitempty"`
-	Port *int32  `json:"port,omitempty"`
+	Host                *string                     `json:"host,omitempty"`
+	Port                *int32                      `json:"port,omitempty"`
+	ServiceAccountToken *helmXdsServiceAccountToken `json:"serviceAccountToken,omitempty"`
+}
+
+// helmXdsServiceAccountToken configures the projected service account token that envoy
+// authenticates to the xds server with
+type helmXdsServiceAccountToken struct {
+	Enabled  *bool   `json:"enabled,omitempty"`
+	Audience *string `json:"audience,omitempty"`
 }
 
 type helmAutoscaling struct {
-	Enabled                           *bool   `json:"enabled,omitempty"`
-	MinReplicas                       *uint32 `json:"minReplicas,omitempty"`
-	MaxReplicas                       *uint32 `json:"maxReplicas,omitempty"`
-	TargetCPUUtilizationPercentage    *uint32 `json:"targetCPUUtilizationPercentage,omitempty"`
-	TargetMemoryUtilizationPercentage *uint32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	Enabled                           *bool                                          `json:"enabled,omitempty"`
+	MinReplicas                       *int32                                         `json:"minReplicas,omitempty"`
+	MaxReplicas                       *int32                                         `json:"maxReplicas,omitempty"`
+	TargetCPUUtilizationPercentage    *int32                                         `json:"targetCPUUtilizationPercentage,omitempty"`
+	TargetMemoryUtilizationPercentage *int32                                         `json:"targetMemoryUtilizationPercentage,omitempty"`
+	Metrics                           []autoscalingv2.MetricSpec                     `json:"metrics,omitempty"`
+	Behavior                          *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+type helmPodDisruptionBudget struct {
+	Enabled        *bool               `json:"enabled,omitempty"`
+	MinAvailable   *intstr.IntOrString `json:"minAvailable,omitempty"`
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// helmWasmModule represents a Wasm module mounted into the envoy container
+// from the key of a ConfigMap or Secret
+type helmWasmModule struct {
+	Name       *string `json:"name,omitempty"`
+	ConfigMap  *string `json:"configMap,omitempty"`
+	SecretName *string `json:"secretName,omitempty"`
+	Key        *string `json:"key,omitempty"`
 }
 
 type helmIstio struct {
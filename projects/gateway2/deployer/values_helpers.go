@@ -1,6 +1,7 @@
 package deployer
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"maps"
@@ -14,21 +15,28 @@ import (
 	"github.com/solo-io/gloo/projects/gateway2/ports"
 	"github.com/solo-io/gloo/projects/gateway2/translator/types"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // This file contains helper functions that generate helm values in the format needed
 // by the deployer.
 
+// The key of the ConfigMap or Secret which contains a Wasm module, unless another key is set
+const defaultWasmModuleKey = "module.wasm"
+
 var ComponentLogLevelEmptyError = func(key string, value string) error {
 	return eris.Errorf("an empty key or value was provided in componentLogLevels: key=%s, value=%s", key, value)
 }
 
+var AutoscalingMaxReplicasMissingError = eris.New("autoscaling.maxReplicas must be set when autoscaling is enabled")
+
 // Extract the listener ports from a Gateway and corresponding listener sets. These will be used to populate:
 // 1. the ports exposed on the envoy container
 // 2. the ports exposed on the proxy service
 func getPortsValues(cgw *types.ConsolidatedGateway, gwp *v1alpha1.GatewayParameters) []helmPort {
-	gwPorts := map[uint16]*helmPort{}
+	gwPorts := map[portProtocol]*helmPort{}
 	for i, cl := range cgw.GetConsolidatedListeners() {
 		listener := cl.Listener
 		portName := string(listener.Name)
@@ -36,15 +44,37 @@ func getPortsValues(cgw *types.ConsolidatedGateway, gwp *v1alpha1.GatewayParamet
 			// This ensures a unique name per port even if a GW and LS have listeners with the same name
 			portName = fmt.Sprintf("%d-%s", i, listener.Name)
 		}
-		appendPortValue(gwPorts, uint16(listener.Port), portName, gwp)
+		appendPortValue(gwPorts, uint16(listener.Port), listenerPortProtocol(listener.Protocol), portName, gwp)
 	}
 	finalPorts := make([]helmPort, len(gwPorts))
-	for i, port := range slices.Sorted(maps.Keys(gwPorts)) {
+	for i, port := range slices.SortedFunc(maps.Keys(gwPorts), comparePortProtocol) {
 		finalPorts[i] = *gwPorts[port]
 	}
 	return finalPorts
 }
 
+// portProtocol identifies a port exposed by the proxy. UDP listeners may share a port
+// number with TCP based listeners, so ports are keyed by both number and protocol.
+type portProtocol struct {
+	port     uint16
+	protocol corev1.Protocol
+}
+
+func comparePortProtocol(a, b portProtocol) int {
+	if a.port != b.port {
+		return cmp.Compare(a.port, b.port)
+	}
+	return strings.Compare(string(a.protocol), string(b.protocol))
+}
+
+// listenerPortProtocol returns the transport protocol used by a Gateway listener
+func listenerPortProtocol(protocol gwv1.ProtocolType) corev1.Protocol {
+	if protocol == gwv1.UDPProtocolType {
+		return corev1.ProtocolUDP
+	}
+	return corev1.ProtocolTCP
+}
+
 func sanitizePortName(name string) string {
 	nonAlphanumericRegex := regexp.MustCompile(`[^a-zA-Z0-9-]+`)
 	str := nonAlphanumericRegex.ReplaceAllString(name, "-")
@@ -59,15 +89,16 @@ func sanitizePortName(name string) string {
 	return str
 }
 
-func appendPortValue(gwPorts map[uint16]*helmPort, port uint16, name string, gwp *v1alpha1.GatewayParameters) {
-	// only process this port if we haven't already processed a listener with the same port
-	if _, ok := gwPorts[port]; ok {
+func appendPortValue(gwPorts map[portProtocol]*helmPort, port uint16, transport corev1.Protocol, name string, gwp *v1alpha1.GatewayParameters) {
+	// only process this port if we haven't already processed a listener with the same port and protocol
+	key := portProtocol{port: port, protocol: transport}
+	if _, ok := gwPorts[key]; ok {
 		return
 	}
 
 	targetPort := ports.TranslatePort(port)
 	portName := sanitizePortName(name)
-	protocol := "TCP"
+	protocol := string(transport)
 
 	// Search for static NodePort set from the GatewayParameters spec
 	// If not found the default value of `nil` will not render anything.
@@ -80,7 +111,7 @@ func appendPortValue(gwPorts map[uint16]*helmPort, port uint16, name string, gwp
 		}
 	}
 
-	gwPorts[port] = &helmPort{
+	gwPorts[key] = &helmPort{
 		Port:       &port,
 		TargetPort: &targetPort,
 		Name:       &portName,
@@ -182,6 +213,17 @@ func getAwsValues(awsInfo *AwsInfo) *helmAws {
 	return nil
 }
 
+// Converts XdsServiceAccountTokenInfo (which comes from Settings values) into xds helm values
+func getXdsServiceAccountTokenValues(tokenInfo *XdsServiceAccountTokenInfo) *helmXdsServiceAccountToken {
+	if tokenInfo == nil {
+		return nil
+	}
+	return &helmXdsServiceAccountToken{
+		Enabled:  ptr.To(true),
+		Audience: &tokenInfo.Audience,
+	}
+}
+
 // Get the image values for the envoy container in the proxy deployment.
 func getImageValues(image *v1alpha1.Image) *helmImage {
 	if image == nil {
@@ -201,6 +243,29 @@ func getImageValues(image *v1alpha1.Image) *helmImage {
 	return helmImage
 }
 
+// Get the values for the Wasm modules mounted into the envoy container.
+func getWasmModuleValues(modules []v1alpha1.WasmModule) []helmWasmModule {
+	var helmModules []helmWasmModule
+	for _, module := range modules {
+		helmModule := helmWasmModule{
+			Name: ptr.To(module.GetName()),
+			Key:  ptr.To(defaultWasmModuleKey),
+		}
+		ref := module.GetConfigMap()
+		if ref != nil {
+			helmModule.ConfigMap = ptr.To(ref.GetName())
+		} else {
+			ref = module.GetSecret()
+			helmModule.SecretName = ptr.To(ref.GetName())
+		}
+		if ref.GetKey() != nil {
+			helmModule.Key = ref.GetKey()
+		}
+		helmModules = append(helmModules, helmModule)
+	}
+	return helmModules
+}
+
 // Get the stats values for the envoy listener in the configmap for bootstrap.
 func getStatsValues(statsConfig *v1alpha1.StatsConfig) *helmStatsConfig {
 	if statsConfig == nil {
@@ -214,6 +279,42 @@ func getStatsValues(statsConfig *v1alpha1.StatsConfig) *helmStatsConfig {
 	}
 }
 
+// Get the HorizontalPodAutoscaler values. Autoscaling is enabled if it is configured, unless it is explicitly disabled.
+func getAutoscalingValues(config *v1alpha1.Autoscaling) (*helmAutoscaling, error) {
+	if config == nil || !ptr.Deref(config.GetEnabled(), true) {
+		return nil, nil
+	}
+	if config.GetMaxReplicas() == nil {
+		return nil, AutoscalingMaxReplicasMissingError
+	}
+	return &helmAutoscaling{
+		Enabled:                           ptr.To(true),
+		MinReplicas:                       config.GetMinReplicas(),
+		MaxReplicas:                       config.GetMaxReplicas(),
+		TargetCPUUtilizationPercentage:    config.GetTargetCPUUtilizationPercentage(),
+		TargetMemoryUtilizationPercentage: config.GetTargetMemoryUtilizationPercentage(),
+		Metrics:                           config.GetMetrics(),
+		Behavior:                          config.GetBehavior(),
+	}, nil
+}
+
+// Get the PodDisruptionBudget values. The PodDisruptionBudget is enabled if it is configured, unless it is
+// explicitly disabled, and allows a single pod to be unavailable if no budget is set.
+func getPodDisruptionBudgetValues(config *v1alpha1.PodDisruptionBudget) *helmPodDisruptionBudget {
+	if config == nil || !ptr.Deref(config.GetEnabled(), true) {
+		return nil
+	}
+	pdb := &helmPodDisruptionBudget{
+		Enabled:        ptr.To(true),
+		MinAvailable:   config.GetMinAvailable(),
+		MaxUnavailable: config.GetMaxUnavailable(),
+	}
+	if pdb.MinAvailable == nil && pdb.MaxUnavailable == nil {
+		pdb.MaxUnavailable = ptr.To(intstr.FromInt32(1))
+	}
+	return pdb
+}
+
 // ComponentLogLevelsToString converts the key-value pairs in the map into a string of the
 // format: key1:value1,key2:value2,key3:value3, where the keys are sorted alphabetically.
 // If an empty map is passed in, then an empty string is returned.
@@ -0,0 +1,450 @@
+package proxy_syncer
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gateway2/translator/backendref"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/ptr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// backendTLSPolicyCASecretPrefix prefixes the name of the TLS secrets generated from the CA certificates
+	// of a BackendTLSPolicy. ':' is not valid in a kubernetes name, so these never collide with real secrets.
+	backendTLSPolicyCASecretPrefix = "backendtlspolicy:"
+
+	// maxPolicyAncestors is the maximum number of ancestors allowed in a PolicyStatus
+	maxPolicyAncestors = 16
+)
+
+// BackendTLSPolicyIndex holds the BackendTLSPolicies in the cluster, with their CA certificate refs resolved,
+// indexed by the Service they target.
+type BackendTLSPolicyIndex struct {
+	Policies  krt.Collection[BackendTLSPolicyWrapper]
+	ByService krt.Index[types.NamespacedName, BackendTLSPolicyWrapper]
+	// CASecrets are the gloo TLS secrets holding the CA certificates of each policy
+	CASecrets krt.Collection[RedactedSecret]
+}
+
+// caCertificateRefError is a caCertificateRef of a BackendTLSPolicy that could not be resolved
+type caCertificateRefError struct {
+	Reason  gwv1.PolicyConditionReason
+	Message string
+}
+
+type BackendTLSPolicyWrapper struct {
+	*gwv1.BackendTLSPolicy
+	// CACertificates is the PEM bundle of all the valid caCertificateRefs of the policy
+	CACertificates string
+	// InvalidRefs holds an error for each caCertificateRef that could not be resolved
+	InvalidRefs []caCertificateRefError
+}
+
+func (p BackendTLSPolicyWrapper) ResourceName() string {
+	return krt.Named{Namespace: p.Namespace, Name: p.Name}.ResourceName()
+}
+
+func (p BackendTLSPolicyWrapper) String() string {
+	return p.ResourceName()
+}
+
+var _ krt.Equaler[BackendTLSPolicyWrapper] = new(BackendTLSPolicyWrapper)
+
+func (p BackendTLSPolicyWrapper) Equals(in BackendTLSPolicyWrapper) bool {
+	// status updates are ignored, as we are the ones writing them
+	return p.Generation == in.Generation &&
+		p.CreationTimestamp.Equal(&in.CreationTimestamp) &&
+		equality.Semantic.DeepEqual(p.Spec, in.Spec) &&
+		p.CACertificates == in.CACertificates &&
+		slices.Equal(p.InvalidRefs, in.InvalidRefs)
+}
+
+// hasNoValidCACertificate returns true if the policy can not be used to validate the backend certificate
+func (p BackendTLSPolicyWrapper) hasNoValidCACertificate() bool {
+	return p.Spec.Validation.WellKnownCACertificates == nil && p.CACertificates == ""
+}
+
+// caSecretRef returns the ref of the gloo TLS secret holding the CA certificates of the policy
+func (p BackendTLSPolicyWrapper) caSecretRef() *core.ResourceRef {
+	return &core.ResourceRef{
+		Name:      backendTLSPolicyCASecretPrefix + p.Name,
+		Namespace: p.Namespace,
+	}
+}
+
+func NewBackendTLSPolicyIndex(
+	ctx context.Context,
+	istioClient kube.Client,
+	configMaps krt.Collection[*corev1.ConfigMap],
+	secrets krt.Collection[*corev1.Secret],
+	dbg *krt.DebugHandler,
+) BackendTLSPolicyIndex {
+	withDebug := krt.WithDebugging(dbg)
+
+	rawPolicies := SetupCollectionDynamic[gwv1.BackendTLSPolicy](
+		ctx,
+		istioClient,
+		gwv1.SchemeGroupVersion.WithResource("backendtlspolicies"),
+		krt.WithName("KubeBackendTLSPolicies"), withDebug,
+	)
+	policies := krt.NewCollection(rawPolicies, func(kctx krt.HandlerContext, p *gwv1.BackendTLSPolicy) *BackendTLSPolicyWrapper {
+		return resolveBackendTLSPolicy(kctx, configMaps, secrets, p)
+	}, krt.WithName("BackendTLSPolicies"), withDebug)
+
+	caSecrets := krt.NewCollection(policies, func(kctx krt.HandlerContext, p BackendTLSPolicyWrapper) *RedactedSecret {
+		if p.CACertificates == "" {
+			return nil
+		}
+		ref := p.caSecretRef()
+		return &RedactedSecret{Inner: &gloov1.Secret{
+			Metadata: &core.Metadata{
+				Name:      ref.GetName(),
+				Namespace: ref.GetNamespace(),
+			},
+			Kind: &gloov1.Secret_Tls{
+				Tls: &gloov1.TlsSecret{
+					RootCa: p.CACertificates,
+				},
+			},
+		}}
+	}, krt.WithName("BackendTLSPolicyCASecrets"), withDebug)
+
+	return BackendTLSPolicyIndex{
+		Policies:  policies,
+		ByService: newBackendTLSPolicyIndex(policies),
+		CASecrets: caSecrets,
+	}
+}
+
+func newBackendTLSPolicyIndex(policies krt.Collection[BackendTLSPolicyWrapper]) krt.Index[types.NamespacedName, BackendTLSPolicyWrapper] {
+	return krt.NewIndex(policies, "BackendTLSPolicyIndex", func(p BackendTLSPolicyWrapper) []types.NamespacedName {
+		var keys []types.NamespacedName
+		for _, ref := range p.Spec.TargetRefs {
+			if ref.Group != corev1.GroupName || ref.Kind != wellknown.ServiceKind {
+				continue
+			}
+			key := types.NamespacedName{Namespace: p.Namespace, Name: string(ref.Name)}
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+		return keys
+	})
+}
+
+// resolveBackendTLSPolicy resolves the CA certificates referenced by the policy.
+// Both ConfigMaps and Secrets are expected to hold a PEM bundle under the `ca.crt` key.
+func resolveBackendTLSPolicy(
+	kctx krt.HandlerContext,
+	configMaps krt.Collection[*corev1.ConfigMap],
+	secrets krt.Collection[*corev1.Secret],
+	policy *gwv1.BackendTLSPolicy,
+) *BackendTLSPolicyWrapper {
+	out := &BackendTLSPolicyWrapper{BackendTLSPolicy: policy}
+	var certs []string
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		nn := types.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}
+		var (
+			data  string
+			found bool
+		)
+		switch {
+		case ref.Group == corev1.GroupName && ref.Kind == wellknown.ConfigMapKind:
+			if cm := krt.FetchOne(kctx, configMaps, krt.FilterObjectName(nn)); cm != nil {
+				data, found = (*cm).Data[backendref.CACertificateKey]
+			} else {
+				out.InvalidRefs = append(out.InvalidRefs, caCertificateRefError{
+					Reason:  gwv1.BackendTLSPolicyReasonInvalidCACertificateRef,
+					Message: fmt.Sprintf("ConfigMap %s not found", nn),
+				})
+				continue
+			}
+		case ref.Group == corev1.GroupName && ref.Kind == wellknown.SecretKind:
+			if secret := krt.FetchOne(kctx, secrets, krt.FilterObjectName(nn)); secret != nil {
+				var raw []byte
+				raw, found = (*secret).Data[backendref.CACertificateKey]
+				data = string(raw)
+			} else {
+				out.InvalidRefs = append(out.InvalidRefs, caCertificateRefError{
+					Reason:  gwv1.BackendTLSPolicyReasonInvalidCACertificateRef,
+					Message: fmt.Sprintf("Secret %s not found", nn),
+				})
+				continue
+			}
+		default:
+			out.InvalidRefs = append(out.InvalidRefs, caCertificateRefError{
+				Reason:  gwv1.BackendTLSPolicyReasonInvalidKind,
+				Message: fmt.Sprintf("unsupported caCertificateRef kind %s/%s", ref.Group, ref.Kind),
+			})
+			continue
+		}
+
+		if !found || strings.TrimSpace(data) == "" {
+			out.InvalidRefs = append(out.InvalidRefs, caCertificateRefError{
+				Reason:  gwv1.BackendTLSPolicyReasonInvalidCACertificateRef,
+				Message: fmt.Sprintf("%s %s has no %s key", ref.Kind, nn, backendref.CACertificateKey),
+			})
+			continue
+		}
+		certs = append(certs, strings.TrimSpace(data))
+	}
+	if len(certs) > 0 {
+		out.CACertificates = strings.Join(certs, "\n") + "\n"
+	}
+	return out
+}
+
+// FetchForServicePort returns the BackendTLSPolicy that applies to the given Service port, or nil if there is none.
+// When several policies select the same port, the one that takes precedence is returned.
+func (i BackendTLSPolicyIndex) FetchForServicePort(kctx krt.HandlerContext, svc *corev1.Service, port corev1.ServicePort) *BackendTLSPolicyWrapper {
+	key := types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}
+	candidates := krt.Fetch(kctx, i.Policies, krt.FilterIndex(i.ByService, key))
+	sorted := sortedPoliciesForServicePort(candidates, svc, port)
+	if len(sorted) == 0 {
+		return nil
+	}
+	return &sorted[0]
+}
+
+// sortedPoliciesForServicePort returns the policies selecting the given Service port in order of precedence
+func sortedPoliciesForServicePort(policies []BackendTLSPolicyWrapper, svc *corev1.Service, port corev1.ServicePort) []BackendTLSPolicyWrapper {
+	byPolicy := make(map[*gwv1.BackendTLSPolicy]BackendTLSPolicyWrapper, len(policies))
+	var selected []*gwv1.BackendTLSPolicy
+	for _, p := range policies {
+		if backendref.BackendTLSPolicyTarget(p.BackendTLSPolicy, svc, port) == nil {
+			continue
+		}
+		byPolicy[p.BackendTLSPolicy] = p
+		selected = append(selected, p.BackendTLSPolicy)
+	}
+	backendref.SortBackendTLSPolicies(selected, svc, port)
+
+	out := make([]BackendTLSPolicyWrapper, 0, len(selected))
+	for _, p := range selected {
+		out = append(out, byPolicy[p])
+	}
+	return out
+}
+
+// backendTLSPolicyReport holds the status of every BackendTLSPolicy, keyed by policy
+type backendTLSPolicyReport struct {
+	statuses map[types.NamespacedName]backendTLSPolicyStatus
+}
+
+// backendTLSPolicyStatus is the status of a BackendTLSPolicy for the Gateways whose routes use its target
+type backendTLSPolicyStatus struct {
+	// ancestors are the Gateways with routes to a backend targeted by the policy
+	ancestors []types.NamespacedName
+	// conditions are set for every ancestor, keyed by ancestor
+	conditions map[types.NamespacedName][]metav1.Condition
+}
+
+func (r backendTLSPolicyReport) ResourceName() string {
+	return "backendTLSPolicyReport"
+}
+
+func (r backendTLSPolicyReport) Equals(in backendTLSPolicyReport) bool {
+	return reflect.DeepEqual(r.statuses, in.statuses)
+}
+
+// buildBackendTLSPolicyReport computes the status of every BackendTLSPolicy. The ancestors of a policy are the
+// Gateways that route to a Service port selected by the policy. For each ancestor, the policy is Conflicted if
+// another policy takes precedence for any of those ports.
+func buildBackendTLSPolicyReport(
+	kctx krt.HandlerContext,
+	index BackendTLSPolicyIndex,
+	services krt.Collection[*corev1.Service],
+	proxies []glooProxy,
+) *backendTLSPolicyReport {
+	policies := krt.Fetch(kctx, index.Policies)
+
+	// ancestors holds the ancestor Gateways of each policy, and whether another policy takes precedence for them
+	ancestors := map[types.NamespacedName]map[types.NamespacedName]bool{}
+	for _, p := range policies {
+		ancestors[types.NamespacedName{Namespace: p.Namespace, Name: p.Name}] = map[types.NamespacedName]bool{}
+	}
+
+	for _, proxy := range proxies {
+		for _, dest := range kubeServiceDestinations(proxy.Proxy) {
+			svcNN := types.NamespacedName{Namespace: dest.GetRef().GetNamespace(), Name: dest.GetRef().GetName()}
+			svc := krt.FetchOne(kctx, services, krt.FilterObjectName(svcNN))
+			if svc == nil {
+				continue
+			}
+			idx := slices.IndexFunc((*svc).Spec.Ports, func(p corev1.ServicePort) bool {
+				return uint32(p.Port) == dest.GetPort()
+			})
+			if idx < 0 {
+				continue
+			}
+			candidates := krt.Fetch(kctx, index.Policies, krt.FilterIndex(index.ByService, svcNN))
+			for i, p := range sortedPoliciesForServicePort(candidates, *svc, (*svc).Spec.Ports[idx]) {
+				policyNN := types.NamespacedName{Namespace: p.Namespace, Name: p.Name}
+				conflicted := ancestors[policyNN][proxy.gateway]
+				ancestors[policyNN][proxy.gateway] = conflicted || i > 0
+			}
+		}
+	}
+
+	out := &backendTLSPolicyReport{
+		statuses: make(map[types.NamespacedName]backendTLSPolicyStatus, len(policies)),
+	}
+	for _, p := range policies {
+		policyNN := types.NamespacedName{Namespace: p.Namespace, Name: p.Name}
+		status := backendTLSPolicyStatus{
+			conditions: map[types.NamespacedName][]metav1.Condition{},
+		}
+		for gw, conflicted := range ancestors[policyNN] {
+			status.ancestors = append(status.ancestors, gw)
+			status.conditions[gw] = backendTLSPolicyConditions(p, conflicted)
+		}
+		slices.SortFunc(status.ancestors, func(a, b types.NamespacedName) int {
+			return strings.Compare(a.String(), b.String())
+		})
+		if len(status.ancestors) > maxPolicyAncestors {
+			status.ancestors = status.ancestors[:maxPolicyAncestors]
+		}
+		out.statuses[policyNN] = status
+	}
+	return out
+}
+
+// buildBackendTLSPolicyStatus returns the status of a BackendTLSPolicy with the ancestors written by this controller
+// replaced by the ones in the report. Ancestors written by other controllers are preserved.
+func buildBackendTLSPolicyStatus(existing gwv1.PolicyStatus, report backendTLSPolicyStatus, controllerName string) *gwv1.PolicyStatus {
+	out := &gwv1.PolicyStatus{}
+	previous := map[types.NamespacedName][]metav1.Condition{}
+	for _, ancestor := range existing.Ancestors {
+		if string(ancestor.ControllerName) != controllerName {
+			out.Ancestors = append(out.Ancestors, ancestor)
+			continue
+		}
+		if ancestor.AncestorRef.Namespace != nil {
+			nn := types.NamespacedName{Namespace: string(*ancestor.AncestorRef.Namespace), Name: string(ancestor.AncestorRef.Name)}
+			previous[nn] = ancestor.Conditions
+		}
+	}
+
+	for _, gw := range report.ancestors {
+		if len(out.Ancestors) >= maxPolicyAncestors {
+			break
+		}
+		// keep the existing conditions so that LastTransitionTime is only updated on change
+		conditions := slices.Clone(previous[gw])
+		for _, condition := range report.conditions[gw] {
+			meta.SetStatusCondition(&conditions, condition)
+		}
+		out.Ancestors = append(out.Ancestors, gwv1.PolicyAncestorStatus{
+			AncestorRef: gwv1.ParentReference{
+				Group:     ptr.Of(gwv1.Group(gwv1.GroupName)),
+				Kind:      ptr.Of(gwv1.Kind(wellknown.GatewayKind)),
+				Namespace: ptr.Of(gwv1.Namespace(gw.Namespace)),
+				Name:      gwv1.ObjectName(gw.Name),
+			},
+			ControllerName: gwv1.GatewayController(controllerName),
+			Conditions:     conditions,
+		})
+	}
+	return out
+}
+
+// backendTLSPolicyConditions returns the Accepted and ResolvedRefs conditions of a policy for one of its ancestors.
+// LastTransitionTime is set when the status is written.
+func backendTLSPolicyConditions(p BackendTLSPolicyWrapper, conflicted bool) []metav1.Condition {
+	accepted := metav1.Condition{
+		Type:               string(gwv1.PolicyConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1.PolicyReasonAccepted),
+		Message:            "BackendTLSPolicy accepted",
+		ObservedGeneration: p.Generation,
+	}
+	switch {
+	case p.hasNoValidCACertificate():
+		accepted.Status = metav1.ConditionFalse
+		accepted.Reason = string(gwv1.BackendTLSPolicyReasonNoValidCACertificate)
+		accepted.Message = "none of the caCertificateRefs could be resolved"
+	case conflicted:
+		accepted.Status = metav1.ConditionFalse
+		accepted.Reason = string(gwv1.PolicyReasonConflicted)
+		accepted.Message = "another BackendTLSPolicy takes precedence for the targeted backend"
+	}
+
+	resolvedRefs := metav1.Condition{
+		Type:               string(gwv1.BackendTLSPolicyConditionResolvedRefs),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1.BackendTLSPolicyReasonResolvedRefs),
+		Message:            "all caCertificateRefs resolved",
+		ObservedGeneration: p.Generation,
+	}
+	if len(p.InvalidRefs) > 0 {
+		messages := make([]string, 0, len(p.InvalidRefs))
+		for _, ref := range p.InvalidRefs {
+			messages = append(messages, ref.Message)
+		}
+		resolvedRefs.Status = metav1.ConditionFalse
+		resolvedRefs.Reason = string(p.InvalidRefs[0].Reason)
+		resolvedRefs.Message = strings.Join(messages, "; ")
+	}
+	return []metav1.Condition{accepted, resolvedRefs}
+}
+
+// kubeServiceDestinations returns all the kubernetes Service destinations of the routes of a Proxy
+func kubeServiceDestinations(proxy *gloov1.Proxy) []*gloov1.KubernetesServiceDestination {
+	var out []*gloov1.KubernetesServiceDestination
+	addDestination := func(dest *gloov1.Destination) {
+		if kube := dest.GetKube(); kube != nil {
+			out = append(out, kube)
+		}
+	}
+	addMultiDestination := func(multi *gloov1.MultiDestination) {
+		for _, dest := range multi.GetDestinations() {
+			addDestination(dest.GetDestination())
+		}
+	}
+	addVirtualHost := func(vh *gloov1.VirtualHost) {
+		for _, route := range vh.GetRoutes() {
+			addDestination(route.GetRouteAction().GetSingle())
+			addMultiDestination(route.GetRouteAction().GetMulti())
+		}
+	}
+	addTcpListener := func(tcp *gloov1.TcpListener) {
+		for _, host := range tcp.GetTcpHosts() {
+			addDestination(host.GetDestination().GetSingle())
+			addMultiDestination(host.GetDestination().GetMulti())
+		}
+	}
+
+	for _, listener := range proxy.GetListeners() {
+		for _, vh := range listener.GetHttpListener().GetVirtualHosts() {
+			addVirtualHost(vh)
+		}
+		addTcpListener(listener.GetTcpListener())
+		for _, matched := range listener.GetHybridListener().GetMatchedListeners() {
+			for _, vh := range matched.GetHttpListener().GetVirtualHosts() {
+				addVirtualHost(vh)
+			}
+			addTcpListener(matched.GetTcpListener())
+		}
+		for _, vh := range listener.GetAggregateListener().GetHttpResources().GetVirtualHosts() {
+			addVirtualHost(vh)
+		}
+		for _, matched := range listener.GetAggregateListener().GetTcpListeners() {
+			addTcpListener(matched.GetTcpListener())
+		}
+	}
+	return out
+}
@@ -0,0 +1,204 @@
+package proxy_syncer
+
+import (
+	"testing"
+
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestBackendTLSPolicyConditions(t *testing.T) {
+	policy := &gwv1.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "default", Generation: 2},
+		Spec: gwv1.BackendTLSPolicySpec{
+			Validation: gwv1.BackendTLSPolicyValidation{
+				CACertificateRefs: []gwv1.LocalObjectReference{{Kind: "ConfigMap", Name: "ca"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		policy               BackendTLSPolicyWrapper
+		conflicted           bool
+		expectedAccepted     gwv1.PolicyConditionReason
+		expectedResolvedRefs gwv1.PolicyConditionReason
+	}{
+		{
+			name:                 "Accepted",
+			policy:               BackendTLSPolicyWrapper{BackendTLSPolicy: policy, CACertificates: "ca"},
+			expectedAccepted:     gwv1.PolicyReasonAccepted,
+			expectedResolvedRefs: gwv1.BackendTLSPolicyReasonResolvedRefs,
+		},
+		{
+			name:                 "Conflicted",
+			policy:               BackendTLSPolicyWrapper{BackendTLSPolicy: policy, CACertificates: "ca"},
+			conflicted:           true,
+			expectedAccepted:     gwv1.PolicyReasonConflicted,
+			expectedResolvedRefs: gwv1.BackendTLSPolicyReasonResolvedRefs,
+		},
+		{
+			name: "No valid CA certificate",
+			policy: BackendTLSPolicyWrapper{
+				BackendTLSPolicy: policy,
+				InvalidRefs: []caCertificateRefError{{
+					Reason:  gwv1.BackendTLSPolicyReasonInvalidCACertificateRef,
+					Message: "ConfigMap default/ca not found",
+				}},
+			},
+			expectedAccepted:     gwv1.BackendTLSPolicyReasonNoValidCACertificate,
+			expectedResolvedRefs: gwv1.BackendTLSPolicyReasonInvalidCACertificateRef,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conditions := backendTLSPolicyConditions(test.policy, test.conflicted)
+			accepted := meta.FindStatusCondition(conditions, string(gwv1.PolicyConditionAccepted))
+			if accepted == nil || accepted.Reason != string(test.expectedAccepted) {
+				t.Errorf("expected Accepted reason %s, got %v", test.expectedAccepted, accepted)
+			}
+			if accepted.ObservedGeneration != 2 {
+				t.Errorf("expected observed generation to be set")
+			}
+			resolvedRefs := meta.FindStatusCondition(conditions, string(gwv1.BackendTLSPolicyConditionResolvedRefs))
+			if resolvedRefs == nil || resolvedRefs.Reason != string(test.expectedResolvedRefs) {
+				t.Errorf("expected ResolvedRefs reason %s, got %v", test.expectedResolvedRefs, resolvedRefs)
+			}
+		})
+	}
+}
+
+func TestBuildBackendTLSPolicyStatus(t *testing.T) {
+	otherAncestor := gwv1.PolicyAncestorStatus{
+		AncestorRef:    gwv1.ParentReference{Name: "other-gw"},
+		ControllerName: "example.com/other",
+	}
+	staleAncestor := gwv1.PolicyAncestorStatus{
+		AncestorRef: gwv1.ParentReference{
+			Namespace: ptr.To(gwv1.Namespace("default")),
+			Name:      "stale-gw",
+		},
+		ControllerName: "solo.io/gloo-gateway",
+	}
+	existing := gwv1.PolicyStatus{Ancestors: []gwv1.PolicyAncestorStatus{otherAncestor, staleAncestor}}
+
+	gw := types.NamespacedName{Namespace: "default", Name: "gw"}
+	report := backendTLSPolicyStatus{
+		ancestors: []types.NamespacedName{gw},
+		conditions: map[types.NamespacedName][]metav1.Condition{
+			gw: {{
+				Type:   string(gwv1.PolicyConditionAccepted),
+				Status: metav1.ConditionTrue,
+				Reason: string(gwv1.PolicyReasonAccepted),
+			}},
+		},
+	}
+
+	status := buildBackendTLSPolicyStatus(existing, report, "solo.io/gloo-gateway")
+	if len(status.Ancestors) != 2 {
+		t.Fatalf("expected 2 ancestors, got %d", len(status.Ancestors))
+	}
+	if status.Ancestors[0].ControllerName != otherAncestor.ControllerName {
+		t.Errorf("expected ancestors of other controllers to be preserved")
+	}
+	if status.Ancestors[1].AncestorRef.Name != "gw" || len(status.Ancestors[1].Conditions) != 1 {
+		t.Errorf("expected the stale ancestor to be replaced, got %v", status.Ancestors[1])
+	}
+}
+
+func TestKubeServiceDestinations(t *testing.T) {
+	kube := func(name string) *gloov1.Destination {
+		return &gloov1.Destination{
+			DestinationType: &gloov1.Destination_Kube{
+				Kube: &gloov1.KubernetesServiceDestination{
+					Ref:  &core.ResourceRef{Name: name, Namespace: "default"},
+					Port: 443,
+				},
+			},
+		}
+	}
+	proxy := &gloov1.Proxy{
+		Listeners: []*gloov1.Listener{{
+			ListenerType: &gloov1.Listener_AggregateListener{
+				AggregateListener: &gloov1.AggregateListener{
+					HttpResources: &gloov1.AggregateListener_HttpResources{
+						VirtualHosts: map[string]*gloov1.VirtualHost{
+							"vh": {Routes: []*gloov1.Route{{
+								Action: &gloov1.Route_RouteAction{
+									RouteAction: &gloov1.RouteAction{
+										Destination: &gloov1.RouteAction_Multi{
+											Multi: &gloov1.MultiDestination{
+												Destinations: []*gloov1.WeightedDestination{
+													{Destination: kube("a")},
+													{Destination: kube("b")},
+												},
+											},
+										},
+									},
+								},
+							}}},
+						},
+					},
+					TcpListeners: []*gloov1.MatchedTcpListener{{
+						TcpListener: &gloov1.TcpListener{
+							TcpHosts: []*gloov1.TcpHost{{
+								Destination: &gloov1.TcpHost_TcpAction{
+									Destination: &gloov1.TcpHost_TcpAction_Single{Single: kube("c")},
+								},
+							}},
+						},
+					}},
+				},
+			},
+		}},
+	}
+
+	destinations := kubeServiceDestinations(proxy)
+	if len(destinations) != 3 {
+		t.Fatalf("expected 3 destinations, got %d", len(destinations))
+	}
+	for i, name := range []string{"a", "b", "c"} {
+		if destinations[i].GetRef().GetName() != name {
+			t.Errorf("expected destination %s at index %d, got %s", name, i, destinations[i].GetRef().GetName())
+		}
+	}
+}
+
+func TestApplyBackendTLSPolicyForUpstreamWithoutValidCACertificate(t *testing.T) {
+	policy := &gwv1.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend-tls",
+			Namespace: "default",
+		},
+		Spec: gwv1.BackendTLSPolicySpec{
+			Validation: gwv1.BackendTLSPolicyValidation{
+				CACertificateRefs: []gwv1.LocalObjectReference{{Kind: "ConfigMap", Name: "missing"}},
+				Hostname:          "backend.example.com",
+			},
+		},
+	}
+	wrapper := &BackendTLSPolicyWrapper{
+		BackendTLSPolicy: policy,
+		InvalidRefs: []caCertificateRefError{{
+			Reason:  gwv1.BackendTLSPolicyReasonInvalidCACertificateRef,
+			Message: "ConfigMap default/missing not found",
+		}},
+	}
+	original := &gloov1.Upstream{}
+	u := ApplyBackendTLSPolicyForUpstream(wrapper, original)
+	if u.GetSslConfig() != nil {
+		t.Errorf("expected no ssl config when the CA certificate can not be resolved")
+	}
+
+	conditions := backendTLSPolicyConditions(*wrapper, false)
+	resolvedRefs := meta.FindStatusCondition(conditions, string(gwv1.BackendTLSPolicyConditionResolvedRefs))
+	if resolvedRefs == nil || resolvedRefs.Status != metav1.ConditionFalse {
+		t.Errorf("expected ResolvedRefs to be false, got %v", resolvedRefs)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	"github.com/solo-io/gloo/pkg/utils/statsutils"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
@@ -32,6 +33,8 @@ func (s *ProxyTranslator) buildXdsSnapshot(
 	snap *v1snap.ApiSnapshot,
 ) (cache.Snapshot, reporter.ResourceReports, *validation.ProxyReport) {
 	metaKey := xds.SnapshotCacheKey(proxy)
+	ctx, span := telemetry.StartSpan(ctx, "gloo.gateway2.BuildXdsSnapshot", telemetry.ProxyKey.String(metaKey))
+	defer span.End()
 	ctx = contextutils.WithLogger(ctx, "kube-gateway-xds-snapshot")
 	logger := contextutils.LoggerFrom(ctx).With("proxy", metaKey)
 	logger.Infof("build xds snapshot for proxy %v (%d upstreams, %d endpoints, %d secrets, %d artifacts, %d auth configs, %d rate limit configs)",
@@ -107,7 +110,7 @@ func (s *ProxyTranslator) syncXds(
 	// TODO: this is also may not be needed now that envoy has
 	// a default initial fetch timeout
 	snap.MakeConsistent()
-	s.xdsCache.SetSnapshot(proxyKey, snap)
+	xds.PushSnapshot(ctx, s.xdsCache, proxyKey, snap)
 }
 
 func (s *ProxyTranslator) syncStatus(
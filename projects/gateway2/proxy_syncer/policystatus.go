@@ -0,0 +1,99 @@
+package proxy_syncer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sologatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// policyGVKs are the kinds of the policies whose ancestor status is reported by the status plugins
+var policyGVKs = map[string]schema.GroupVersionKind{
+	sologatewayv1.RouteOptionGVK.Kind:       sologatewayv1.RouteOptionGVK,
+	sologatewayv1.VirtualHostOptionGVK.Kind: sologatewayv1.VirtualHostOptionGVK,
+	sologatewayv1.ListenerOptionGVK.Kind:    sologatewayv1.ListenerOptionGVK,
+}
+
+// The option CRDs hold solo-kit statuses, which are decoded strictly, so the Gateway API ancestor status
+// can not be added to their status as is. Instead, it is held in the details of the solo-kit status
+// reported under the name of the Gateway controller, next to the statuses of the other reporters:
+//
+//	status:
+//	  statuses:
+//	    <controllerName>:
+//	      state: Accepted
+//	      reportedBy: gloo-kube-gateway
+//	      details:
+//	        ancestors: [...]
+const policyAncestorsField = "ancestors"
+
+// readPolicyAncestors returns the ancestor status written by the controller on the policy
+func readPolicyAncestors(policy *unstructured.Unstructured, controllerName string) (gwv1.PolicyStatus, error) {
+	policyStatus := gwv1.PolicyStatus{}
+	ancestors, found, err := unstructured.NestedSlice(policy.Object, "status", "statuses", controllerName, "details", policyAncestorsField)
+	if err != nil || !found {
+		return policyStatus, err
+	}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(map[string]any{policyAncestorsField: ancestors}, &policyStatus)
+	return policyStatus, err
+}
+
+// policyAncestorsPatch returns the merge patch which writes the ancestor status of the policy.
+// The solo-kit state is Warning when the policy is not accepted by any of its ancestors, so that it is surfaced
+// by the tooling which reads the solo-kit statuses, such as glooctl check.
+func policyAncestorsPatch(policyStatus *gwv1.PolicyStatus, controllerName string) ([]byte, error) {
+	state := core.Status_Accepted
+	var reasons []string
+	for _, ancestor := range policyStatus.Ancestors {
+		if string(ancestor.ControllerName) != controllerName {
+			continue
+		}
+		accepted := meta.FindStatusCondition(ancestor.Conditions, string(gwv1.PolicyConditionAccepted))
+		if accepted == nil || accepted.Status == metav1.ConditionTrue {
+			continue
+		}
+		state = core.Status_Warning
+		reasons = append(reasons, fmt.Sprintf("%s %s/%s: %s", ptrDeref(ancestor.AncestorRef.Kind),
+			ptrDeref(ancestor.AncestorRef.Namespace), ancestor.AncestorRef.Name, accepted.Message))
+	}
+
+	var reason any
+	if len(reasons) > 0 {
+		reason = strings.Join(reasons, "; ")
+	}
+	ancestors := policyStatus.Ancestors
+	if ancestors == nil {
+		ancestors = []gwv1.PolicyAncestorStatus{}
+	}
+	return json.Marshal(map[string]any{
+		"status": map[string]any{
+			"statuses": map[string]any{
+				controllerName: map[string]any{
+					"state": state.String(),
+					// a null reason removes the reason of the previous status
+					"reason":     reason,
+					"reportedBy": defaults.KubeGatewayReporter,
+					"details": map[string]any{
+						policyAncestorsField: ancestors,
+					},
+				},
+			},
+		},
+	})
+}
+
+func ptrDeref[T ~string](p *T) string {
+	if p == nil {
+		return ""
+	}
+	return string(*p)
+}
@@ -0,0 +1,90 @@
+package proxy_syncer
+
+import (
+	"encoding/json"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestPolicyAncestorsPatch(t *testing.T) {
+	const controllerName = "solo.io/gloo-gateway"
+	policyStatus := &gwv1.PolicyStatus{
+		Ancestors: []gwv1.PolicyAncestorStatus{
+			{
+				AncestorRef: gwv1.ParentReference{
+					Kind:      ptr.To(gwv1.Kind("HTTPRoute")),
+					Namespace: ptr.To(gwv1.Namespace("default")),
+					Name:      "accepted",
+				},
+				ControllerName: controllerName,
+				Conditions: []metav1.Condition{{
+					Type:   string(gwv1.PolicyConditionAccepted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gwv1.PolicyReasonAccepted),
+				}},
+			},
+			{
+				AncestorRef: gwv1.ParentReference{
+					Kind:      ptr.To(gwv1.Kind("HTTPRoute")),
+					Namespace: ptr.To(gwv1.Namespace("default")),
+					Name:      "conflicted",
+				},
+				ControllerName: controllerName,
+				Conditions: []metav1.Condition{{
+					Type:    string(gwv1.PolicyConditionAccepted),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(gwv1.PolicyReasonConflicted),
+					Message: "conflict",
+				}},
+			},
+		},
+	}
+
+	patch, err := policyAncestorsPatch(policyStatus, controllerName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// apply the patch on a policy holding the status of a solo-kit reporter
+	original := []byte(`{"apiVersion":"gateway.solo.io/v1","kind":"RouteOption","metadata":{"name":"policy","namespace":"default"},` +
+		`"spec":{},"status":{"statuses":{"gloo-system":{"state":"Accepted","reportedBy":"gloo-kube-gateway"}}}}`)
+	patched, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the solo-kit statuses must still be readable, as they are decoded strictly
+	var routeOption solokubev1.RouteOption
+	if err := json.Unmarshal(patched, &routeOption); err != nil {
+		t.Fatal(err)
+	}
+	statuses := routeOption.Status.GetStatuses()
+	if statuses["gloo-system"].GetState() != core.Status_Accepted {
+		t.Errorf("expected the status of the solo-kit reporter to be preserved, got %v", statuses["gloo-system"])
+	}
+	if statuses[controllerName].GetState() != core.Status_Warning {
+		t.Errorf("expected a warning for the conflicted ancestor, got %v", statuses[controllerName])
+	}
+	if statuses[controllerName].GetReason() != "HTTPRoute default/conflicted: conflict" {
+		t.Errorf("unexpected reason %q", statuses[controllerName].GetReason())
+	}
+
+	policy := &unstructured.Unstructured{}
+	if err := policy.UnmarshalJSON(patched); err != nil {
+		t.Fatal(err)
+	}
+	read, err := readPolicyAncestors(policy, controllerName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isPolicyStatusEqual(&read, policyStatus) {
+		t.Errorf("expected the written ancestors to be read back, got %v", read)
+	}
+}
@@ -12,8 +12,10 @@ import (
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
@@ -93,15 +95,21 @@ type ProxySyncer struct {
 	proxyReconcileQueue ggv2utils.AsyncQueue[gloov1.ProxyList]
 
 	statusReport            krt.Singleton[report]
+	backendTLSPolicyReport  krt.Singleton[backendTLSPolicyReport]
 	mostXdsSnapshots        krt.Collection[XdsSnapWrapper]
 	perclientSnapCollection krt.Collection[XdsSnapWrapper]
 	proxiesToReconcile      krt.Singleton[proxyList]
 	proxyTrigger            *krt.RecomputeTrigger
 
 	destRules             DestinationRuleIndex
+	backendTLSPolicies    BackendTLSPolicyIndex
 	translator            setup.TranslatorFactory
 	allowedGatewayClasses sets.Set[string]
 
+	// policyStatuses holds the last ancestor status written for each policy, so that the policies
+	// are only read and patched when their status changes. It is only accessed by the status plugins loop.
+	policyStatuses map[reports.PolicyKey]gwv1.PolicyStatus
+
 	waitForSync []cache.InformerSynced
 }
 
@@ -163,6 +171,7 @@ func NewProxySyncer(
 		// so that they could own krt collections internally.
 		translator:            translator,
 		allowedGatewayClasses: allowedGatewayClasses,
+		policyStatuses:        make(map[reports.PolicyKey]gwv1.PolicyStatus),
 	}
 }
 
@@ -229,6 +238,8 @@ var _ json.Marshaler = RedactedSecret{}
 
 type glooProxy struct {
 	Proxy *gloov1.Proxy
+	// the kube Gateway this proxy was translated from
+	gateway types.NamespacedName
 	// plugins used to generate this proxy
 	pluginRegistry registry.PluginRegistry
 	// the GWAPI reports generated for translation from a GW->Proxy
@@ -239,7 +250,7 @@ type glooProxy struct {
 var _ krt.ResourceNamer = glooProxy{}
 
 func (p glooProxy) Equals(in glooProxy) bool {
-	if !proto.Equal(p.Proxy, in.Proxy) {
+	if !proto.Equal(p.Proxy, in.Proxy) || p.gateway != in.gateway {
 		return false
 	}
 	// NOTE: reportMap holds pointer-valued maps; compare by content, not pointer
@@ -313,7 +324,7 @@ func (s *ProxySyncer) Init(ctx context.Context, dbg *krt.DebugHandler) error {
 			ResourceType: &gloov1.Secret{},
 		},
 	}
-	secrets := krt.NewCollection(k8sSecrets, func(kctx krt.HandlerContext, i *corev1.Secret) *RedactedSecret {
+	kubeSecrets := krt.NewCollection(k8sSecrets, func(kctx krt.HandlerContext, i *corev1.Secret) *RedactedSecret {
 		secret, err := kubeconverters.GlooSecretConverterChain.FromKubeSecret(ctx, legacySecretClient, i)
 		if err != nil {
 			logger.Errorf(
@@ -329,6 +340,13 @@ func (s *ProxySyncer) Init(ctx context.Context, dbg *krt.DebugHandler) error {
 		return &res
 	}, withDebug)
 
+	// BackendTLSPolicy CA certificates are exposed as TLS secrets so the upstreams they apply to can reference them
+	s.backendTLSPolicies = NewBackendTLSPolicyIndex(ctx, s.istioClient, configMaps, k8sSecrets, dbg)
+	secrets := krt.JoinCollection([]krt.Collection[RedactedSecret]{
+		kubeSecrets,
+		s.backendTLSPolicies.CASecrets,
+	}, withDebug, krt.WithName("FinalSecrets"))
+
 	authConfigs := SetupCollectionDynamic[extauthkubev1.AuthConfig](
 		ctx,
 		s.istioClient,
@@ -370,6 +388,9 @@ func (s *ProxySyncer) Init(ctx context.Context, dbg *krt.DebugHandler) error {
 		uss := []krtcollections.UpstreamWrapper{}
 		for _, port := range svc.Spec.Ports {
 			us := kubeupstreams.ServiceToUpstream(ctx, svc, port)
+			if policy := s.backendTLSPolicies.FetchForServicePort(kctx, svc, port); policy != nil {
+				us = ApplyBackendTLSPolicyForUpstream(policy, us)
+			}
 			uss = append(uss, krtcollections.UpstreamWrapper{Inner: us})
 		}
 		return uss
@@ -479,7 +500,20 @@ func (s *ProxySyncer) Init(ctx context.Context, dbg *krt.DebugHandler) error {
 				maps.Copy(p.reportMap.HTTPRoutes[rnn].Parents, rr.Parents)
 			}
 
-			// 4. merge tcproute parentRefs into RouteReports
+			// 4. merge grpcroute parentRefs into RouteReports
+			for rnn, rr := range p.reportMap.GRPCRoutes {
+				// if we haven't encountered this route, just copy it over completely
+				old := merged.GRPCRoutes[rnn]
+				if old == nil {
+					merged.GRPCRoutes[rnn] = rr
+					continue
+				}
+				// else, let's merge our parentRefs into the existing map
+				// obsGen will stay as-is...
+				maps.Copy(old.Parents, rr.Parents)
+			}
+
+			// 5. merge tcproute parentRefs into RouteReports
 			for rnn, rr := range p.reportMap.TCPRoutes {
 				// if we haven't encountered this route, just copy it over completely
 				old := merged.TCPRoutes[rnn]
@@ -492,7 +526,7 @@ func (s *ProxySyncer) Init(ctx context.Context, dbg *krt.DebugHandler) error {
 				maps.Copy(p.reportMap.TCPRoutes[rnn].Parents, rr.Parents)
 			}
 
-			// 5. merge tlsroute parentRefs into RouteReports
+			// 6. merge tlsroute parentRefs into RouteReports
 			for rnn, rr := range p.reportMap.TLSRoutes {
 				// if we haven't encountered this route, just copy it over completely
 				old := merged.TLSRoutes[rnn]
@@ -504,14 +538,34 @@ func (s *ProxySyncer) Init(ctx context.Context, dbg *krt.DebugHandler) error {
 				// obsGen will stay as-is...
 				maps.Copy(p.reportMap.TLSRoutes[rnn].Parents, rr.Parents)
 			}
+
+			// 7. merge udproute parentRefs into RouteReports
+			for rnn, rr := range p.reportMap.UDPRoutes {
+				// if we haven't encountered this route, just copy it over completely
+				old := merged.UDPRoutes[rnn]
+				if old == nil {
+					merged.UDPRoutes[rnn] = rr
+					continue
+				}
+				// else, let's merge our parentRefs into the existing map
+				// obsGen will stay as-is...
+				maps.Copy(old.Parents, rr.Parents)
+			}
 		}
 		return &report{merged}
 	})
 
+	// BackendTLSPolicy status depends on the Gateways routing to the targeted Services, so it is computed from all proxies
+	s.backendTLSPolicyReport = krt.NewSingleton(func(kctx krt.HandlerContext) *backendTLSPolicyReport {
+		proxies := krt.Fetch(kctx, glooProxies)
+		return buildBackendTLSPolicyReport(kctx, s.backendTLSPolicies, services, proxies)
+	})
+
 	s.waitForSync = []cache.InformerSynced{
 		authConfigs.HasSynced,
 		rlConfigs.HasSynced,
 		configMaps.HasSynced,
+		kubeSecrets.HasSynced,
 		secrets.HasSynced,
 		services.HasSynced,
 		inputs.EndpointSlices.HasSynced,
@@ -529,6 +583,8 @@ func (s *ProxySyncer) Init(ctx context.Context, dbg *krt.DebugHandler) error {
 		s.perclientSnapCollection.HasSynced,
 		s.mostXdsSnapshots.HasSynced,
 		s.destRules.Destrules.HasSynced,
+		s.backendTLSPolicies.Policies.HasSynced,
+		s.backendTLSPolicies.CASecrets.HasSynced,
 		s.k8sGwExtensions.KRTExtensions().HasSynced,
 	}
 	return nil
@@ -540,6 +596,7 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 	// latestReport will be constantly updated to contain the merged status report for Kube Gateway status
 	// when timer ticks, we will use the state of the mergedReports at that point in time to sync the status to k8s
 	latestReportQueue := ggv2utils.NewAsyncQueue[reports.ReportMap]()
+	latestBackendTLSPolicyReportQueue := ggv2utils.NewAsyncQueue[backendTLSPolicyReport]()
 	logger.Infof("waiting for cache to sync")
 
 	// wait for krt collections to sync
@@ -565,6 +622,13 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 		latestReportQueue.Enqueue(o.Latest().ReportMap)
 	})
 
+	s.backendTLSPolicyReport.Register(func(o krt.Event[backendTLSPolicyReport]) {
+		if o.Event == controllers.EventDelete {
+			return
+		}
+		latestBackendTLSPolicyReportQueue.Enqueue(o.Latest())
+	})
+
 	// handler to reconcile ProxyList for in-memory proxy client
 	s.proxiesToReconcile.Register(func(o krt.Event[proxyList]) {
 		var l gloov1.ProxyList
@@ -609,7 +673,9 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 				// merge the snapshot plugins into the new plugin registry
 				mergeStatusPlugins(ctx, &pluginRegistry, snapPlugins)
 				// apply the status plugins with all of the latest reports
-				applyStatusPlugins(ctx, proxiesWithReports, &pluginRegistry)
+				policyReports := applyStatusPlugins(ctx, proxiesWithReports, &pluginRegistry)
+				// write the ancestor status of the policies computed by the status plugins
+				s.syncPolicyStatus(ctx, policyReports)
 			}
 		}
 	}()
@@ -660,6 +726,16 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 			s.syncRouteStatus(ctx, latestReport)
 		}
 	}()
+
+	go func() {
+		for {
+			latestReport, err := latestBackendTLSPolicyReportQueue.Dequeue(ctx)
+			if err != nil {
+				return
+			}
+			s.syncBackendTLSPolicyStatus(ctx, latestReport)
+		}
+	}()
 	<-ctx.Done()
 	return nil
 }
@@ -697,6 +773,7 @@ func (s *ProxySyncer) buildProxy(ctx context.Context, gw *gwv1.Gateway) *glooPro
 
 	return &glooProxy{
 		Proxy:          proxy,
+		gateway:        client.ObjectKeyFromObject(gw),
 		pluginRegistry: pluginRegistry,
 		reportMap:      rm,
 	}
@@ -842,16 +919,18 @@ func SetupCollectionDynamic[T any](
 	})
 }
 
+// applyStatusPlugins applies the status plugins and returns the ancestor status of the policies they handle
 func applyStatusPlugins(
 	ctx context.Context,
 	proxiesWithReports []translatorutils.ProxyWithReports,
 	registry *registry.PluginRegistry,
-) {
+) reports.PolicyReportMap {
 	ctx = contextutils.WithLogger(ctx, "k8sGatewayStatusPlugins")
 	logger := contextutils.LoggerFrom(ctx)
 
 	statusCtx := &gwplugins.StatusContext{
 		ProxiesWithReports: proxiesWithReports,
+		PolicyReports:      reports.NewPolicyReportMap(),
 	}
 	for _, plugin := range registry.GetStatusPlugins() {
 		err := plugin.ApplyStatusPlugin(ctx, statusCtx)
@@ -860,6 +939,7 @@ func applyStatusPlugins(
 			continue
 		}
 	}
+	return statusCtx.PolicyReports
 }
 
 func mergeStatusPlugins(
@@ -945,6 +1025,12 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, rm reports.ReportMap)
 				return nil
 			}
 			r.Status.RouteStatus = *status
+		case *gwv1.GRPCRoute:
+			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
+				return nil
+			}
+			r.Status.RouteStatus = *status
 		case *gwv1a2.TCPRoute:
 			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
 			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
@@ -957,6 +1043,12 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, rm reports.ReportMap)
 				return nil
 			}
 			r.Status.RouteStatus = *status
+		case *gwv1a2.UDPRoute:
+			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
+				return nil
+			}
+			r.Status.RouteStatus = *status
 		default:
 			logger.Warnw(fmt.Sprintf("unsupported route type for %s", routeType), "route", route)
 			return nil
@@ -976,6 +1068,16 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, rm reports.ReportMap)
 		}
 	}
 
+	// Sync GRPCRoute statuses
+	for rnn := range rm.GRPCRoutes {
+		err := syncStatusWithRetry(wellknown.GRPCRouteKind, rnn, func() client.Object { return new(gwv1.GRPCRoute) }, func(route client.Object) error {
+			return buildAndUpdateStatus(route, wellknown.GRPCRouteKind)
+		})
+		if err != nil {
+			logger.Errorw("all attempts failed at updating GRPCRoute status", "error", err, "route", rnn)
+		}
+	}
+
 	// Sync TCPRoute statuses
 	for rnn := range rm.TCPRoutes {
 		err := syncStatusWithRetry(wellknown.TCPRouteKind, rnn, func() client.Object { return new(gwv1a2.TCPRoute) }, func(route client.Object) error {
@@ -995,6 +1097,16 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, rm reports.ReportMap)
 			logger.Errorw("all attempts failed at updating TLSRoute status", "error", err, "route", rnn)
 		}
 	}
+
+	// Sync UDPRoute statuses
+	for rnn := range rm.UDPRoutes {
+		err := syncStatusWithRetry(wellknown.UDPRouteKind, rnn, func() client.Object { return new(gwv1a2.UDPRoute) }, func(route client.Object) error {
+			return buildAndUpdateStatus(route, wellknown.UDPRouteKind)
+		})
+		if err != nil {
+			logger.Errorw("all attempts failed at updating UDPRoute status", "error", err, "route", rnn)
+		}
+	}
 }
 
 // syncGatewayStatus will build and update status for all Gateways in a reportMap
@@ -1084,6 +1196,126 @@ func (s *ProxySyncer) syncListenerSetStatus(ctx context.Context, rm reports.Repo
 	logger.Debugf("synced listener sets status for %d listener set in %s", len(rm.ListenerSets), duration.String())
 }
 
+// syncBackendTLSPolicyStatus will build and update status for all BackendTLSPolicies in a backendTLSPolicyReport
+func (s *ProxySyncer) syncBackendTLSPolicyStatus(ctx context.Context, r backendTLSPolicyReport) {
+	ctx = contextutils.WithLogger(ctx, "statusSyncer")
+	logger := contextutils.LoggerFrom(ctx)
+	stopwatch := statsutils.NewTranslatorStopWatch("BackendTLSPolicyStatusSyncer")
+	stopwatch.Start()
+
+	err := retry.Do(func() error {
+		for policynn, policyStatus := range r.statuses {
+			policy := gwv1.BackendTLSPolicy{}
+			err := s.mgr.GetClient().Get(ctx, policynn, &policy)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				logger.Info("error getting backendtlspolicy", err.Error())
+				return err
+			}
+			status := buildBackendTLSPolicyStatus(policy.Status, policyStatus, s.controllerName)
+			if isPolicyStatusEqual(&policy.Status, status) {
+				continue
+			}
+			policy.Status = *status
+			if err := s.mgr.GetClient().Status().Update(ctx, &policy); err != nil {
+				logger.Error(err)
+				return err
+			}
+			logger.Infof("updated backendtlspolicy '%s' status", policynn.String())
+		}
+		return nil
+	},
+		retry.Attempts(5),
+		retry.Delay(100*time.Millisecond),
+		retry.DelayType(retry.BackOffDelay),
+	)
+	if err != nil {
+		logger.Errorw("all attempts failed at updating backendtlspolicy statuses", "error", err)
+	}
+	duration := stopwatch.Stop(ctx)
+	logger.Debugf("synced backendtlspolicy status for %d policies in %s", len(r.statuses), duration.String())
+}
+
+// syncPolicyStatus will build and update the Gateway API ancestor status of all the policies in a PolicyReportMap.
+// The policies also hold the statuses of the solo-kit reporters, so only the status of this controller is patched.
+func (s *ProxySyncer) syncPolicyStatus(ctx context.Context, policyReports reports.PolicyReportMap) {
+	ctx = contextutils.WithLogger(ctx, "statusSyncer")
+	logger := contextutils.LoggerFrom(ctx)
+	stopwatch := statsutils.NewTranslatorStopWatch("PolicyStatusSyncer")
+	stopwatch.Start()
+
+	// forget the policies which are not reported anymore, e.g. deleted ones
+	maps.DeleteFunc(s.policyStatuses, func(policyKey reports.PolicyKey, _ gwv1.PolicyStatus) bool {
+		_, ok := policyReports[policyKey]
+		return !ok
+	})
+
+	err := retry.Do(func() error {
+		for policyKey := range policyReports {
+			gvk, ok := policyGVKs[policyKey.Kind]
+			if !ok {
+				logger.DPanicf("unknown policy kind %s", policyKey.Kind)
+				continue
+			}
+			if lastStatus, ok := s.policyStatuses[policyKey]; ok {
+				status := policyReports.BuildPolicyStatus(ctx, policyKey, lastStatus, s.controllerName)
+				if status == nil || isPolicyStatusEqual(&lastStatus, status) {
+					continue
+				}
+			}
+
+			policy := &unstructured.Unstructured{}
+			policy.SetGroupVersionKind(gvk)
+			err := s.mgr.GetClient().Get(ctx, policyKey.NamespacedName, policy)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				logger.Infof("error getting %s: %s", policyKey.Kind, err.Error())
+				return err
+			}
+
+			existing, err := readPolicyAncestors(policy, s.controllerName)
+			if err != nil {
+				// the invalid ancestors are overwritten
+				logger.Warnf("error reading ancestor status of %s %s: %s", policyKey.Kind, policyKey.NamespacedName, err.Error())
+				existing = gwv1.PolicyStatus{}
+			}
+			status := policyReports.BuildPolicyStatus(ctx, policyKey, existing, s.controllerName)
+			if status == nil {
+				continue
+			}
+			if isPolicyStatusEqual(&existing, status) {
+				s.policyStatuses[policyKey] = *status
+				continue
+			}
+
+			patch, err := policyAncestorsPatch(status, s.controllerName)
+			if err != nil {
+				return err
+			}
+			if err := s.mgr.GetClient().Patch(ctx, policy, client.RawPatch(types.MergePatchType, patch)); err != nil {
+				logger.Error(err)
+				return err
+			}
+			s.policyStatuses[policyKey] = *status
+			logger.Infof("updated %s '%s' ancestor status", policyKey.Kind, policyKey.NamespacedName.String())
+		}
+		return nil
+	},
+		retry.Attempts(5),
+		retry.Delay(100*time.Millisecond),
+		retry.DelayType(retry.BackOffDelay),
+	)
+	if err != nil {
+		logger.Errorw("all attempts failed at updating policy ancestor statuses", "error", err)
+	}
+	duration := stopwatch.Stop(ctx)
+	logger.Debugf("synced policy ancestor status for %d policies in %s", len(policyReports), duration.String())
+}
+
 // reconcileProxies persists the provided proxies by reconciling them with the proxyReconciler.
 // as the Kube GW impl does not support reading Proxies from etcd, the expectation is these prox ies are
 // written and persisted to the in-memory cache.
@@ -1127,6 +1359,10 @@ func isListenerSetStatusEqual(objA, objB *gwxv1a1.ListenerSetStatus) bool {
 	return cmp.Equal(objA, objB, opts)
 }
 
+func isPolicyStatusEqual(objA, objB *gwv1.PolicyStatus) bool {
+	return cmp.Equal(objA, objB, opts)
+}
+
 // isRouteStatusEqual compares two RouteStatus objects directly
 func isRouteStatusEqual(objA, objB *gwv1.RouteStatus) bool {
 	return cmp.Equal(objA, objB, opts)
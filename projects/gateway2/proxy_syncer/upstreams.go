@@ -6,6 +6,7 @@ import (
 
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	"github.com/solo-io/gloo/projects/gateway2/krtcollections"
+	"github.com/solo-io/gloo/projects/gateway2/translator/backendref"
 	ggv2utils "github.com/solo-io/gloo/projects/gateway2/utils"
 	cluster "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/cluster"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -19,6 +20,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/ptr"
@@ -227,3 +229,18 @@ func ApplyDestRulesForUpstream(destrule *DestinationRuleWrapper, u *gloov1.Upstr
 
 	return u, ""
 }
+
+// ApplyBackendTLSPolicyForUpstream configures the upstream to originate TLS as described by the BackendTLSPolicy.
+// The policy replaces any ssl config the upstream already has, e.g. from Service annotations.
+// A policy without a valid CA certificate is not applied, as the backend certificate could not be validated;
+// it is reported as not accepted with unresolved refs instead.
+func ApplyBackendTLSPolicyForUpstream(policy *BackendTLSPolicyWrapper, u *gloov1.Upstream) *gloov1.Upstream {
+	if policy == nil || policy.hasNoValidCACertificate() {
+		return u
+	}
+
+	// do not mutate the original upstream
+	up := proto.Clone(u).(*gloov1.Upstream)
+	up.SslConfig = backendref.BackendTLSPolicyToSslConfig(policy.BackendTLSPolicy, policy.caSecretRef())
+	return up
+}
@@ -10,6 +10,7 @@ import (
 	networkingv1alpha3 "istio.io/api/networking/v1alpha3"
 	networkingclient "istio.io/client-go/pkg/apis/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 func TestApplyDestRulesForUpstream(t *testing.T) {
@@ -59,3 +60,29 @@ func TestApplyDestRulesForUpstream(t *testing.T) {
 	}
 
 }
+
+func TestApplyBackendTLSPolicyForUpstream(t *testing.T) {
+	policy := &gwv1.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend-tls",
+			Namespace: "default",
+		},
+		Spec: gwv1.BackendTLSPolicySpec{
+			Validation: gwv1.BackendTLSPolicyValidation{
+				CACertificateRefs: []gwv1.LocalObjectReference{{Kind: "ConfigMap", Name: "ca"}},
+				Hostname:          "backend.example.com",
+			},
+		},
+	}
+	original := &gloov1.Upstream{}
+	u := ApplyBackendTLSPolicyForUpstream(&BackendTLSPolicyWrapper{BackendTLSPolicy: policy, CACertificates: "ca"}, original)
+	if original.GetSslConfig() != nil {
+		t.Errorf("expected the original upstream not to be mutated")
+	}
+	if u.GetSslConfig().GetSni() != "backend.example.com" {
+		t.Errorf("expected sni to be set")
+	}
+	if u.GetSslConfig().GetSecretRef().GetNamespace() != "default" {
+		t.Errorf("expected the CA secret to be in the policy namespace")
+	}
+}
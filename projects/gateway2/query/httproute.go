@@ -7,6 +7,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -81,19 +82,24 @@ func (r RouteInfo) GetNamespace() string {
 }
 
 // Hostnames returns the hostname overrides if they exist, otherwise it returns
-// the hostnames specified in the HTTPRoute.
+// the hostnames specified in the HTTPRoute or GRPCRoute.
 func (r *RouteInfo) Hostnames() []string {
 	if len(r.HostnameOverrides) > 0 {
 		return r.HostnameOverrides
 	}
 
-	httpRoute, ok := r.Object.(*gwv1.HTTPRoute)
-	if !ok {
+	var hostnames []gwv1.Hostname
+	switch route := r.Object.(type) {
+	case *gwv1.HTTPRoute:
+		hostnames = route.Spec.Hostnames
+	case *gwv1.GRPCRoute:
+		hostnames = route.Spec.Hostnames
+	default:
 		return []string{}
 	}
 
-	strs := make([]string, 0, len(httpRoute.Spec.Hostnames))
-	for _, v := range httpRoute.Spec.Hostnames {
+	strs := make([]string, 0, len(hostnames))
+	for _, v := range hostnames {
 		strs = append(strs, string(v))
 	}
 	return strs
@@ -144,11 +150,15 @@ func (r *gatewayQueries) GetRouteChain(
 	case *gwv1.HTTPRoute:
 		backends = r.resolveRouteBackends(ctx, typedRoute)
 		children = r.getDelegatedChildren(ctx, typedRoute, sets.New[types.NamespacedName]())
+	case *gwv1.GRPCRoute:
+		backends = r.resolveRouteBackends(ctx, typedRoute)
 	case *gwv1a2.TCPRoute:
 		backends = r.resolveRouteBackends(ctx, typedRoute)
 		// TODO (danehans): Should TCPRoute delegation support be added in the future?
 	case *gwv1a2.TLSRoute:
 		backends = r.resolveRouteBackends(ctx, typedRoute)
+	case *gwv1a2.UDPRoute:
+		backends = r.resolveRouteBackends(ctx, typedRoute)
 	default:
 		return nil
 	}
@@ -170,13 +180,16 @@ func (r *gatewayQueries) allowedRoutes(resource client.Object, l *gwv1.Listener)
 	case gwv1.HTTPSProtocolType:
 		fallthrough
 	case gwv1.HTTPProtocolType:
-		allowedKinds = []metav1.GroupKind{{Kind: wellknown.HTTPRouteKind, Group: gwv1.GroupName}}
+		allowedKinds = []metav1.GroupKind{
+			{Kind: wellknown.HTTPRouteKind, Group: gwv1.GroupName},
+			{Kind: wellknown.GRPCRouteKind, Group: gwv1.GroupName},
+		}
 	case gwv1.TLSProtocolType:
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.TLSRouteKind, Group: gwv1a2.GroupName}}
 	case gwv1.TCPProtocolType:
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.TCPRouteKind, Group: gwv1a2.GroupName}}
 	case gwv1.UDPProtocolType:
-		allowedKinds = []metav1.GroupKind{{}}
+		allowedKinds = []metav1.GroupKind{{Kind: wellknown.UDPRouteKind, Group: gwv1a2.GroupName}}
 	default:
 		// allow custom protocols to work
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.HTTPRouteKind, Group: gwv1.GroupName}}
@@ -241,6 +254,14 @@ func (r *gatewayQueries) resolveRouteBackends(ctx context.Context, obj client.Ob
 			}
 			processBackendRefs(refs)
 		}
+	case *gwv1.GRPCRoute:
+		for _, rule := range rt.Spec.Rules {
+			var refs []gwv1.BackendObjectReference
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+			processBackendRefs(refs)
+		}
 	case *gwv1a2.TCPRoute:
 		for _, rule := range rt.Spec.Rules {
 			var refs []gwv1.BackendObjectReference
@@ -257,6 +278,14 @@ func (r *gatewayQueries) resolveRouteBackends(ctx context.Context, obj client.Ob
 			}
 			processBackendRefs(refs)
 		}
+	case *gwv1a2.UDPRoute:
+		for _, rule := range rt.Spec.Rules {
+			var refs []gwv1.BackendObjectReference
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+			processBackendRefs(refs)
+		}
 	default:
 		return out
 	}
@@ -403,7 +432,7 @@ func (r *gatewayQueries) getRoutesForResource(ctx context.Context, resource clie
 	}
 
 	// List of route types to process based on installed CRDs
-	routeListTypes := []client.ObjectList{&gwv1.HTTPRouteList{}}
+	routeListTypes := []client.ObjectList{&gwv1.HTTPRouteList{}, &gwv1.GRPCRouteList{}}
 
 	// Conditionally include TCPRouteList
 	tcpRouteGVK := schema.GroupVersionKind{
@@ -425,6 +454,16 @@ func (r *gatewayQueries) getRoutesForResource(ctx context.Context, resource clie
 		routeListTypes = append(routeListTypes, &gwv1a2.TLSRouteList{})
 	}
 
+	// Conditionally include UDPRouteList
+	udpRouteGVK := schema.GroupVersionKind{
+		Group:   gwv1a2.GroupVersion.Group,
+		Version: gwv1a2.GroupVersion.Version,
+		Kind:    wellknown.UDPRouteKind,
+	}
+	if r.scheme.Recognizes(udpRouteGVK) {
+		routeListTypes = append(routeListTypes, &gwv1a2.UDPRouteList{})
+	}
+
 	var routes []client.Object
 	// If a listenerset, initially populate it with the list of routes attached to the parent gateway
 	if ls, ok := resource.(*gwxv1a1.XListenerSet); ok {
@@ -515,6 +554,11 @@ func fetchRoutes(ctx context.Context, r *gatewayQueries, routeList client.Object
 		if err := listAndAppendRoutes(list, HttpRouteTargetField); err != nil {
 			return fmt.Errorf("failed to list HTTPRoutes: %w", err)
 		}
+	case *gwv1.GRPCRouteList:
+		// The GRPCRoute CRD may not be installed, in which case there are no GRPCRoutes to list
+		if err := listAndAppendRoutes(list, GrpcRouteTargetField); err != nil && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to list GRPCRoutes: %w", err)
+		}
 	case *gwv1a2.TCPRouteList:
 		if err := listAndAppendRoutes(list, TcpRouteTargetField); err != nil {
 			return fmt.Errorf("failed to list TCPRoutes: %w", err)
@@ -523,6 +567,10 @@ func fetchRoutes(ctx context.Context, r *gatewayQueries, routeList client.Object
 		if err := listAndAppendRoutes(list, TlsRouteTargetField); err != nil {
 			return fmt.Errorf("failed to list TLSRoutes: %w", err)
 		}
+	case *gwv1a2.UDPRouteList:
+		if err := listAndAppendRoutes(list, UdpRouteTargetField); err != nil {
+			return fmt.Errorf("failed to list UDPRoutes: %w", err)
+		}
 	default:
 		return fmt.Errorf("unsupported route list type: %T", list)
 	}
@@ -595,7 +643,7 @@ func (r *gatewayQueries) processRoute(ctx context.Context, resource client.Objec
 			}
 			anyListenerMatched = true
 
-			// If the route is an HTTPRoute or TLSRoute, check the hostname intersection
+			// If the route is an HTTPRoute, GRPCRoute or TLSRoute, check the hostname intersection
 			var hostnames []string
 			if routeKind == wellknown.HTTPRouteKind {
 				if hr, ok := route.(*gwv1.HTTPRoute); ok {
@@ -607,6 +655,16 @@ func (r *gatewayQueries) processRoute(ctx context.Context, resource client.Objec
 					anyHostsMatch = true
 				}
 			}
+			if routeKind == wellknown.GRPCRouteKind {
+				if gr, ok := route.(*gwv1.GRPCRoute); ok {
+					var ok bool
+					ok, hostnames = hostnameIntersect(&l, gr.Spec.Hostnames)
+					if !ok {
+						continue
+					}
+					anyHostsMatch = true
+				}
+			}
 			if routeKind == wellknown.TLSRouteKind {
 				if tr, ok := route.(*gwv1a2.TLSRoute); ok {
 					var ok bool
@@ -635,7 +693,7 @@ func (r *gatewayQueries) processRoute(ctx context.Context, resource client.Objec
 				ParentRef: ref,
 				Error:     Error{E: ErrNoMatchingParent, Reason: gwv1.RouteReasonNoMatchingParent},
 			})
-		} else if routeKind == wellknown.HTTPRouteKind && !anyHostsMatch {
+		} else if (routeKind == wellknown.HTTPRouteKind || routeKind == wellknown.GRPCRouteKind) && !anyHostsMatch {
 			ret.RouteErrors = append(ret.RouteErrors, &RouteError{
 				Route:     route,
 				ParentRef: ref,
@@ -670,8 +728,10 @@ func namespacedName(o Namespaced) types.NamespacedName {
 // Supported route list types are:
 //
 //   - HTTPRouteList
+//   - GRPCRouteList
 //   - TCPRouteList
 //   - TLSRouteList
+//   - UDPRouteList
 func getRouteItems(list client.ObjectList) ([]client.Object, error) {
 	switch routes := list.(type) {
 	case *gwv1.HTTPRouteList:
@@ -680,6 +740,12 @@ func getRouteItems(list client.ObjectList) ([]client.Object, error) {
 			objs = append(objs, &routes.Items[i])
 		}
 		return objs, nil
+	case *gwv1.GRPCRouteList:
+		var objs []client.Object
+		for i := range routes.Items {
+			objs = append(objs, &routes.Items[i])
+		}
+		return objs, nil
 	case *gwv1a2.TCPRouteList:
 		var objs []client.Object
 		for i := range routes.Items {
@@ -692,6 +758,12 @@ func getRouteItems(list client.ObjectList) ([]client.Object, error) {
 			objs = append(objs, &routes.Items[i])
 		}
 		return objs, nil
+	case *gwv1a2.UDPRouteList:
+		var objs []client.Object
+		for i := range routes.Items {
+			objs = append(objs, &routes.Items[i])
+		}
+		return objs, nil
 	default:
 		return nil, fmt.Errorf("unsupported route type %T", list)
 	}
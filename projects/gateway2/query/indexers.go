@@ -16,8 +16,10 @@ import (
 const (
 	HttpRouteTargetField            = "http-route-target"
 	HttpRouteDelegatedLabelSelector = "http-route-delegated-label-selector"
+	GrpcRouteTargetField            = "grpc-route-target"
 	TcpRouteTargetField             = "tcp-route-target"
 	TlsRouteTargetField             = "tls-route-target"
+	UdpRouteTargetField             = "udp-route-target"
 	ReferenceGrantFromField         = "ref-grant-from"
 	ListenerSetTargetField          = "listener-set-target"
 )
@@ -27,8 +29,10 @@ func IterateIndices(f func(client.Object, string, client.IndexerFunc) error) err
 	return errors.Join(
 		f(&gwv1.HTTPRoute{}, HttpRouteTargetField, IndexerByObjType),
 		f(&gwv1.HTTPRoute{}, HttpRouteDelegatedLabelSelector, IndexByHTTPRouteDelegationLabelSelector),
+		f(&gwv1.GRPCRoute{}, GrpcRouteTargetField, IndexerByObjType),
 		f(&gwv1a2.TCPRoute{}, TcpRouteTargetField, IndexerByObjType),
 		f(&gwv1a2.TLSRoute{}, TlsRouteTargetField, IndexerByObjType),
+		f(&gwv1a2.UDPRoute{}, UdpRouteTargetField, IndexerByObjType),
 		f(&gwv1b1.ReferenceGrant{}, ReferenceGrantFromField, IndexerByObjType),
 		f(&gwxv1a1.XListenerSet{}, ListenerSetTargetField, IndexerByObjType),
 	)
@@ -55,8 +59,10 @@ func isListenerSet(pRef gwv1.ParentReference) bool {
 // IndexerByObjType indexes objects based on the provided object type. The following object types are supported:
 //
 //   - HTTPRoute
+//   - GRPCRoute
 //   - TCPRoute
 //   - TLSRoute
+//   - UDPRoute
 //   - XListenerSet
 //   - ReferenceGrant
 func IndexerByObjType(obj client.Object) []string {
@@ -65,10 +71,14 @@ func IndexerByObjType(obj client.Object) []string {
 	switch resource := obj.(type) {
 	case *gwv1.HTTPRoute:
 		results = append(results, fetchIndices(resource.Namespace, resource.Spec.ParentRefs)...)
+	case *gwv1.GRPCRoute:
+		results = append(results, fetchIndices(resource.Namespace, resource.Spec.ParentRefs)...)
 	case *gwv1a2.TCPRoute:
 		results = append(results, fetchIndices(resource.Namespace, resource.Spec.ParentRefs)...)
 	case *gwv1a2.TLSRoute:
 		results = append(results, fetchIndices(resource.Namespace, resource.Spec.ParentRefs)...)
+	case *gwv1a2.UDPRoute:
+		results = append(results, fetchIndices(resource.Namespace, resource.Spec.ParentRefs)...)
 	case *gwxv1a1.XListenerSet:
 		if resource.Spec.ParentRef.Group != nil && *resource.Spec.ParentRef.Group != gwv1a2.GroupName {
 			break
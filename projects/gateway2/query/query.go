@@ -251,8 +251,10 @@ func parentRefMatchListener(ref *apiv1.ParentReference, l *apiv1.Listener) bool
 // Supported object types are:
 //
 //   - HTTPRoute
+//   - GRPCRoute
 //   - TCPRoute
 //   - TLSRoute
+//   - UDPRoute
 func getParentRefsForResource(resource client.Object, obj client.Object) []apiv1.ParentReference {
 	var ret []apiv1.ParentReference
 
@@ -263,6 +265,12 @@ func getParentRefsForResource(resource client.Object, obj client.Object) []apiv1
 				ret = append(ret, pRef)
 			}
 		}
+	case *apiv1.GRPCRoute:
+		for _, pRef := range route.Spec.ParentRefs {
+			if isParentRefForResource(&pRef, resource, route.Namespace) {
+				ret = append(ret, pRef)
+			}
+		}
 	case *apiv1alpha2.TCPRoute:
 		for _, pRef := range route.Spec.ParentRefs {
 			if isParentRefForResource(&pRef, resource, route.Namespace) {
@@ -275,6 +283,12 @@ func getParentRefsForResource(resource client.Object, obj client.Object) []apiv1
 				ret = append(ret, pRef)
 			}
 		}
+	case *apiv1alpha2.UDPRoute:
+		for _, pRef := range route.Spec.ParentRefs {
+			if isParentRefForResource(&pRef, resource, route.Namespace) {
+				ret = append(ret, pRef)
+			}
+		}
 	default:
 		// Unsupported route type
 		// TODO (danehans): Should we should capture this as a metric?
@@ -10,11 +10,14 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	apiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	apiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	apiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -816,6 +819,123 @@ var _ = Describe("Query", func() {
 			Expect(backend).To(BeNil())
 		})
 
+		It("should match GRPCRoutes for HTTP Listener", func() {
+			gw := gw()
+			gw.Spec.Listeners = []apiv1.Listener{
+				{
+					Name:     "foo",
+					Protocol: apiv1.HTTPProtocolType,
+				},
+			}
+
+			grpcRoute := grpcRoute("test-grpc-route", gw.Namespace)
+			grpcRoute.Spec.ParentRefs = []apiv1.ParentReference{
+				{
+					Name: apiv1.ObjectName(gw.Name),
+				},
+			}
+
+			fakeClient := builder.WithObjects(grpcRoute).Build()
+			gq := query.NewData(fakeClient, scheme)
+			routes, err := gq.GetRoutesForConsolidatedGateway(context.Background(), &types.ConsolidatedGateway{Gateway: gw})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes.RouteErrors).To(BeEmpty())
+			Expect(routes.GetListenerResult(gw, "foo").Error).NotTo(HaveOccurred())
+			Expect(routes.GetListenerResult(gw, "foo").Routes).To(HaveLen(1))
+			Expect(routes.GetListenerResult(gw, "foo").Routes[0].GetKind()).To(Equal(wellknown.GRPCRouteKind))
+		})
+
+		It("should ignore GRPCRoutes when the GRPCRoute CRD is not installed", func() {
+			gw := gw()
+			gw.Spec.Listeners = []apiv1.Listener{
+				{
+					Name:     "foo",
+					Protocol: apiv1.HTTPProtocolType,
+				},
+			}
+
+			httpRoute := httpRoute()
+			httpRoute.Spec.ParentRefs = []apiv1.ParentReference{
+				{
+					Name: apiv1.ObjectName(gw.Name),
+				},
+			}
+
+			fakeClient := builder.WithObjects(httpRoute).WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if _, ok := list.(*apiv1.GRPCRouteList); ok {
+						return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: apiv1.GroupName, Kind: wellknown.GRPCRouteKind}}
+					}
+					return c.List(ctx, list, opts...)
+				},
+			}).Build()
+			gq := query.NewData(fakeClient, scheme)
+			routes, err := gq.GetRoutesForConsolidatedGateway(context.Background(), &types.ConsolidatedGateway{Gateway: gw})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes.RouteErrors).To(BeEmpty())
+			Expect(routes.GetListenerResult(gw, "foo").Routes).To(HaveLen(1))
+			Expect(routes.GetListenerResult(gw, "foo").Routes[0].GetKind()).To(Equal(wellknown.HTTPRouteKind))
+		})
+
+		It("should error when listener hostnames don't intersect with GRPCRoute", func() {
+			gw := gw()
+			var hostname apiv1.Hostname = "foo.com"
+			gw.Spec.Listeners = []apiv1.Listener{
+				{
+					Name:     "foo",
+					Protocol: apiv1.HTTPProtocolType,
+					Hostname: &hostname,
+				},
+			}
+
+			grpcRoute := grpcRoute("test-grpc-route", gw.Namespace)
+			grpcRoute.Spec.Hostnames = []apiv1.Hostname{"bar.com"}
+			grpcRoute.Spec.ParentRefs = []apiv1.ParentReference{
+				{
+					Name: apiv1.ObjectName(gw.Name),
+				},
+			}
+
+			fakeClient := builder.WithObjects(grpcRoute).Build()
+			gq := query.NewData(fakeClient, scheme)
+			routes, err := gq.GetRoutesForConsolidatedGateway(context.Background(), &types.ConsolidatedGateway{Gateway: gw})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes.RouteErrors).To(HaveLen(1))
+			Expect(routes.RouteErrors[0].Error.E).To(MatchError(query.ErrNoMatchingListenerHostname))
+			Expect(routes.RouteErrors[0].Error.Reason).To(Equal(apiv1.RouteReasonNoMatchingListenerHostname))
+		})
+
+		It("should error when listener does not allow GRPCRoute kind", func() {
+			gw := gw()
+			gw.Spec.Listeners = []apiv1.Listener{
+				{
+					Name:     "foo",
+					Protocol: apiv1.HTTPProtocolType,
+					AllowedRoutes: &apiv1.AllowedRoutes{
+						Kinds: []apiv1.RouteGroupKind{{Kind: wellknown.HTTPRouteKind}},
+					},
+				},
+			}
+
+			grpcRoute := grpcRoute("test-grpc-route", gw.Namespace)
+			grpcRoute.Spec.ParentRefs = []apiv1.ParentReference{
+				{
+					Name: apiv1.ObjectName(gw.Name),
+				},
+			}
+
+			fakeClient := builder.WithObjects(grpcRoute).Build()
+			gq := query.NewData(fakeClient, scheme)
+			routes, err := gq.GetRoutesForConsolidatedGateway(context.Background(), &types.ConsolidatedGateway{Gateway: gw})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes.RouteErrors).To(HaveLen(1))
+			Expect(routes.RouteErrors[0].Error.E).To(MatchError(query.ErrNotAllowedByListeners))
+		})
+
 		It("should match TLSRoutes for Listener", func() {
 			gw := gw()
 			gw.Spec.Listeners = []apiv1.Listener{
@@ -1257,6 +1377,19 @@ func tcpRoute(name, ns string) *apiv1a2.TCPRoute {
 	}
 }
 
+func grpcRoute(name, ns string) *apiv1.GRPCRoute {
+	return &apiv1.GRPCRoute{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       wellknown.GRPCRouteKind,
+			APIVersion: apiv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+	}
+}
+
 func tlsRoute(name, ns string) *apiv1a2.TLSRoute {
 	return &apiv1a2.TLSRoute{
 		TypeMeta: metav1.TypeMeta{
@@ -18,8 +18,10 @@ type ReportMap struct {
 	Gateways     map[types.NamespacedName]*GatewayReport
 	ListenerSets map[types.NamespacedName]*ListenerSetReport
 	HTTPRoutes   map[types.NamespacedName]*RouteReport
+	GRPCRoutes   map[types.NamespacedName]*RouteReport
 	TCPRoutes    map[types.NamespacedName]*RouteReport
 	TLSRoutes    map[types.NamespacedName]*RouteReport
+	UDPRoutes    map[types.NamespacedName]*RouteReport
 }
 
 type GatewayReport struct {
@@ -58,14 +60,18 @@ func NewReportMap() ReportMap {
 	gateways := make(map[types.NamespacedName]*GatewayReport)
 	listenerSets := make(map[types.NamespacedName]*ListenerSetReport)
 	httpRoutes := make(map[types.NamespacedName]*RouteReport)
+	grpcRoutes := make(map[types.NamespacedName]*RouteReport)
 	tcpRoutes := make(map[types.NamespacedName]*RouteReport)
 	tlsRoutes := make(map[types.NamespacedName]*RouteReport)
+	udpRoutes := make(map[types.NamespacedName]*RouteReport)
 	return ReportMap{
 		Gateways:     gateways,
 		ListenerSets: listenerSets,
 		HTTPRoutes:   httpRoutes,
+		GRPCRoutes:   grpcRoutes,
 		TCPRoutes:    tcpRoutes,
 		TLSRoutes:    tlsRoutes,
+		UDPRoutes:    udpRoutes,
 	}
 }
 
@@ -110,17 +116,24 @@ func (r *ReportMap) newListenerSetReport(listenerSet *gwxv1alpha1.XListenerSet)
 // reports are not generated for a route that has been translated. Supported object types are:
 //
 // * HTTPRoute
+// * GRPCRoute
 // * TCPRoute
+// * TLSRoute
+// * UDPRoute
 func (r *ReportMap) route(obj client.Object) *RouteReport {
 	key := client.ObjectKeyFromObject(obj)
 
 	switch obj.(type) {
 	case *gwv1.HTTPRoute:
 		return r.HTTPRoutes[key]
+	case *gwv1.GRPCRoute:
+		return r.GRPCRoutes[key]
 	case *gwv1alpha2.TCPRoute:
 		return r.TCPRoutes[key]
 	case *gwv1alpha2.TLSRoute:
 		return r.TLSRoutes[key]
+	case *gwv1alpha2.UDPRoute:
+		return r.UDPRoutes[key]
 	default:
 		contextutils.LoggerFrom(context.TODO()).Warnf("Unsupported route type: %T", obj)
 		return nil
@@ -137,10 +150,14 @@ func (r *ReportMap) newRouteReport(obj client.Object) *RouteReport {
 	switch obj.(type) {
 	case *gwv1.HTTPRoute:
 		r.HTTPRoutes[key] = rr
+	case *gwv1.GRPCRoute:
+		r.GRPCRoutes[key] = rr
 	case *gwv1alpha2.TCPRoute:
 		r.TCPRoutes[key] = rr
 	case *gwv1alpha2.TLSRoute:
 		r.TLSRoutes[key] = rr
+	case *gwv1alpha2.UDPRoute:
+		r.UDPRoutes[key] = rr
 	default:
 		contextutils.LoggerFrom(context.TODO()).Warnf("Unsupported route type: %T", obj)
 		return nil
@@ -398,3 +415,111 @@ type RouteCondition struct {
 	Reason  gwv1.RouteConditionReason
 	Message string
 }
+
+const (
+	// RouteConditionBackendProtocolNotHTTP2 is set on a GRPCRoute's parent status when one of its backends
+	// is not configured to use HTTP/2, which gRPC requires.
+	RouteConditionBackendProtocolNotHTTP2 gwv1.RouteConditionType = "BackendProtocolNotHTTP2"
+
+	// RouteReasonBackendAppProtocol is used with the BackendProtocolNotHTTP2 condition when the port of a
+	// backend Service neither sets an HTTP/2 appProtocol nor is named for HTTP/2, or when a backend
+	// Upstream does not set useHttp2.
+	RouteReasonBackendAppProtocol gwv1.RouteConditionReason = "BackendAppProtocol"
+
+	// RouteConditionPolicyConflict is set on a route's parent status when an attached policy
+	// and the route rule both configure the same field. The condition is only reported while
+	// a conflict exists.
+	RouteConditionPolicyConflict gwv1.RouteConditionType = "PolicyConflict"
+
+	// RouteReasonRouteOptionOverride is used with the PolicyConflict condition when the value
+	// of an attached RouteOption takes precedence over the route rule.
+	RouteReasonRouteOptionOverride gwv1.RouteConditionReason = "RouteOptionOverride"
+
+	// RouteReasonRouteRuleOverride is used with the PolicyConflict condition when the value
+	// of the route rule takes precedence over an attached RouteOption.
+	RouteReasonRouteRuleOverride gwv1.RouteConditionReason = "RouteRuleOverride"
+
+	// RouteConditionSessionPersistenceIgnored is set on a route's parent status when a rule configures
+	// session persistence but one of its backends does not use a consistent hashing load balancer,
+	// in which case Envoy ignores the hash policy of the route for that backend.
+	RouteConditionSessionPersistenceIgnored gwv1.RouteConditionType = "SessionPersistenceIgnored"
+
+	// RouteReasonNoConsistentHashLoadBalancer is used with the SessionPersistenceIgnored condition
+	// when a backend uses neither the RingHash nor the Maglev load balancer.
+	RouteReasonNoConsistentHashLoadBalancer gwv1.RouteConditionReason = "NoConsistentHashLoadBalancer"
+)
+
+// PolicyReportMap holds the Gateway API style ancestor status of the policies which attach to their
+// targets with targetRefs, such as RouteOptions, VirtualHostOptions and ListenerOptions.
+// It is computed by the StatusPlugins and written by the proxy syncer.
+type PolicyReportMap map[PolicyKey]*PolicyReport
+
+// PolicyKey identifies a policy by its kind, namespace and name
+type PolicyKey struct {
+	Kind string
+	types.NamespacedName
+}
+
+type PolicyReport struct {
+	// Ancestors holds the report of each target of the policy, keyed by targetRef
+	Ancestors          map[PolicyAncestorKey]*PolicyAncestorReport
+	observedGeneration int64
+}
+
+// PolicyAncestorKey identifies the object a policy is reported for, which is one of its targets
+type PolicyAncestorKey struct {
+	ParentRefKey
+	SectionName string
+}
+
+type PolicyAncestorReport struct {
+	Conditions []metav1.Condition
+}
+
+type PolicyCondition struct {
+	Type    gwv1.PolicyConditionType
+	Status  metav1.ConditionStatus
+	Reason  gwv1.PolicyConditionReason
+	Message string
+}
+
+func NewPolicyReportMap() PolicyReportMap {
+	return make(PolicyReportMap)
+}
+
+// Policy returns the PolicyReport of the given policy object, creating it if it does not exist yet
+func (m PolicyReportMap) Policy(kind string, obj client.Object) *PolicyReport {
+	key := PolicyKey{
+		Kind:           kind,
+		NamespacedName: client.ObjectKeyFromObject(obj),
+	}
+	pr, ok := m[key]
+	if !ok {
+		pr = &PolicyReport{
+			Ancestors:          make(map[PolicyAncestorKey]*PolicyAncestorReport),
+			observedGeneration: obj.GetGeneration(),
+		}
+		m[key] = pr
+	}
+	return pr
+}
+
+// Ancestor returns the PolicyAncestorReport of the given ancestor, creating it if it does not exist yet
+func (r *PolicyReport) Ancestor(key PolicyAncestorKey) *PolicyAncestorReport {
+	ar, ok := r.Ancestors[key]
+	if !ok {
+		ar = &PolicyAncestorReport{}
+		r.Ancestors[key] = ar
+	}
+	return ar
+}
+
+func (ar *PolicyAncestorReport) SetCondition(pc PolicyCondition) {
+	condition := metav1.Condition{
+		Type:    string(pc.Type),
+		Status:  pc.Status,
+		Reason:  string(pc.Reason),
+		Message: pc.Message,
+	}
+	meta.SetStatusCondition(&ar.Conditions, condition)
+}
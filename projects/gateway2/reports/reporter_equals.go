@@ -32,8 +32,10 @@ func (r ReportMap) Equals(in ReportMap) bool {
 	return maps.EqualFunc(r.Gateways, in.Gateways, (*GatewayReport).equals) &&
 		maps.EqualFunc(r.ListenerSets, in.ListenerSets, (*ListenerSetReport).equals) &&
 		maps.EqualFunc(r.HTTPRoutes, in.HTTPRoutes, (*RouteReport).equals) &&
+		maps.EqualFunc(r.GRPCRoutes, in.GRPCRoutes, (*RouteReport).equals) &&
 		maps.EqualFunc(r.TCPRoutes, in.TCPRoutes, (*RouteReport).equals) &&
-		maps.EqualFunc(r.TLSRoutes, in.TLSRoutes, (*RouteReport).equals)
+		maps.EqualFunc(r.TLSRoutes, in.TLSRoutes, (*RouteReport).equals) &&
+		maps.EqualFunc(r.UDPRoutes, in.UDPRoutes, (*RouteReport).equals)
 }
 
 func (g *GatewayReport) equals(in *GatewayReport) bool {
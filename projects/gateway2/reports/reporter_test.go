@@ -3,11 +3,13 @@ package reports_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -635,6 +637,133 @@ func parentRouteRef() *gwv1.ParentReference {
 	}
 }
 
+var _ = Describe("PolicyReportMap", func() {
+	const (
+		controllerName = "solo.io/gloo-gateway"
+		policyKind     = "RouteOption"
+	)
+
+	var (
+		policy    *gwv1.HTTPRoute
+		policyKey reports.PolicyKey
+	)
+
+	routeAncestor := func(name string) reports.PolicyAncestorKey {
+		return reports.PolicyAncestorKey{
+			ParentRefKey: reports.ParentRefKey{
+				Group:          gwv1.GroupName,
+				Kind:           "HTTPRoute",
+				NamespacedName: types.NamespacedName{Namespace: "default", Name: name},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		// any object can be reported as a policy
+		policy = &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default", Generation: 3},
+		}
+		policyKey = reports.PolicyKey{Kind: policyKind, NamespacedName: client.ObjectKeyFromObject(policy)}
+	})
+
+	It("returns nil for a policy without report", func() {
+		pm := reports.NewPolicyReportMap()
+		Expect(pm.BuildPolicyStatus(context.Background(), policyKey, gwv1.PolicyStatus{}, controllerName)).To(BeNil())
+	})
+
+	It("builds the sorted ancestors of the policy", func() {
+		pm := reports.NewPolicyReportMap()
+		policyReport := pm.Policy(policyKind, policy)
+		policyReport.Ancestor(routeAncestor("b")).SetCondition(reports.PolicyCondition{
+			Type:   gwv1.PolicyConditionAccepted,
+			Status: metav1.ConditionFalse,
+			Reason: gwv1.PolicyReasonConflicted,
+		})
+		policyReport.Ancestor(routeAncestor("a")).SetCondition(reports.PolicyCondition{
+			Type:   gwv1.PolicyConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.PolicyReasonAccepted,
+		})
+
+		status := pm.BuildPolicyStatus(context.Background(), policyKey, gwv1.PolicyStatus{}, controllerName)
+		Expect(status).NotTo(BeNil())
+		Expect(status.Ancestors).To(HaveLen(2))
+		Expect(status.Ancestors[0].AncestorRef).To(Equal(gwv1.ParentReference{
+			Group:     ptr.To(gwv1.Group(gwv1.GroupName)),
+			Kind:      ptr.To(gwv1.Kind("HTTPRoute")),
+			Namespace: ptr.To(gwv1.Namespace("default")),
+			Name:      "a",
+		}))
+		Expect(string(status.Ancestors[0].ControllerName)).To(Equal(controllerName))
+		accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1.PolicyConditionAccepted))
+		Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+		Expect(accepted.ObservedGeneration).To(Equal(int64(3)))
+		Expect(status.Ancestors[1].AncestorRef.Name).To(Equal(gwv1.ObjectName("b")))
+		conflicted := meta.FindStatusCondition(status.Ancestors[1].Conditions, string(gwv1.PolicyConditionAccepted))
+		Expect(conflicted.Reason).To(Equal(string(gwv1.PolicyReasonConflicted)))
+	})
+
+	It("replaces the ancestors of the controller and preserves the ones of other controllers", func() {
+		pm := reports.NewPolicyReportMap()
+		pm.Policy(policyKind, policy).Ancestor(routeAncestor("a")).SetCondition(reports.PolicyCondition{
+			Type:   gwv1.PolicyConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.PolicyReasonAccepted,
+		})
+
+		oldTime := metav1.NewTime(time.Now().Add(-time.Hour))
+		existing := gwv1.PolicyStatus{
+			Ancestors: []gwv1.PolicyAncestorStatus{
+				{
+					AncestorRef:    gwv1.ParentReference{Name: "other"},
+					ControllerName: "other-controller",
+				},
+				{
+					AncestorRef: gwv1.ParentReference{
+						Group:     ptr.To(gwv1.Group(gwv1.GroupName)),
+						Kind:      ptr.To(gwv1.Kind("HTTPRoute")),
+						Namespace: ptr.To(gwv1.Namespace("default")),
+						Name:      "a",
+					},
+					ControllerName: controllerName,
+					Conditions: []metav1.Condition{{
+						Type:               string(gwv1.PolicyConditionAccepted),
+						Status:             metav1.ConditionTrue,
+						Reason:             string(gwv1.PolicyReasonAccepted),
+						LastTransitionTime: oldTime,
+					}},
+				},
+				{
+					AncestorRef:    gwv1.ParentReference{Name: "stale"},
+					ControllerName: controllerName,
+				},
+			},
+		}
+
+		status := pm.BuildPolicyStatus(context.Background(), policyKey, existing, controllerName)
+		Expect(status.Ancestors).To(HaveLen(2))
+		Expect(status.Ancestors[0].ControllerName).To(Equal(gwv1.GatewayController("other-controller")))
+		Expect(status.Ancestors[1].AncestorRef.Name).To(Equal(gwv1.ObjectName("a")))
+		Expect(status.Ancestors[1].Conditions[0].LastTransitionTime).To(Equal(oldTime))
+	})
+
+	It("limits the number of ancestors", func() {
+		pm := reports.NewPolicyReportMap()
+		policyReport := pm.Policy(policyKind, policy)
+		for i := range 20 {
+			policyReport.Ancestor(routeAncestor(fmt.Sprintf("route-%02d", i))).SetCondition(reports.PolicyCondition{
+				Type:   gwv1.PolicyConditionAccepted,
+				Status: metav1.ConditionTrue,
+				Reason: gwv1.PolicyReasonAccepted,
+			})
+		}
+
+		status := pm.BuildPolicyStatus(context.Background(), policyKey, gwv1.PolicyStatus{}, controllerName)
+		Expect(status.Ancestors).To(HaveLen(16))
+		Expect(status.Ancestors[15].AncestorRef.Name).To(Equal(gwv1.ObjectName("route-15")))
+	})
+})
+
 var _ = Describe("ReportMap.Equals", func() {
 	// buildReportMap mimics one translation pass: it allocates a fresh
 	// ReportMap (with fresh report pointers) and populates a Gateway and an
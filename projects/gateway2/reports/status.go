@@ -1,6 +1,7 @@
 package reports
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"reflect"
@@ -10,6 +11,7 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -152,7 +154,10 @@ func (r *ReportMap) BuildListenerSetStatus(ctx context.Context, ls gwxv1a1.XList
 // nil is returned. Supported object types are:
 //
 // * HTTPRoute
+// * GRPCRoute
 // * TCPRoute
+// * TLSRoute
+// * UDPRoute
 func (r *ReportMap) BuildRouteStatus(ctx context.Context, obj client.Object, cName string) *gwv1.RouteStatus {
 	routeReport := r.route(obj)
 	if routeReport == nil {
@@ -177,6 +182,12 @@ func (r *ReportMap) BuildRouteStatus(ctx context.Context, obj client.Object, cNa
 		if len(parentRefs) == 0 {
 			parentRefs = append(parentRefs, routeReport.parentRefs()...)
 		}
+	case *gwv1.GRPCRoute:
+		existingStatus = route.Status.RouteStatus
+		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
+		if len(parentRefs) == 0 {
+			parentRefs = append(parentRefs, routeReport.parentRefs()...)
+		}
 	case *gwv1a2.TCPRoute:
 		existingStatus = route.Status.RouteStatus
 		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
@@ -189,6 +200,12 @@ func (r *ReportMap) BuildRouteStatus(ctx context.Context, obj client.Object, cNa
 		if len(parentRefs) == 0 {
 			parentRefs = append(parentRefs, routeReport.parentRefs()...)
 		}
+	case *gwv1a2.UDPRoute:
+		existingStatus = route.Status.RouteStatus
+		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
+		if len(parentRefs) == 0 {
+			parentRefs = append(parentRefs, routeReport.parentRefs()...)
+		}
 	default:
 		contextutils.LoggerFrom(ctx).Error(fmt.Errorf("unsupported route type %T", obj), "failed to build route status")
 		return nil
@@ -221,6 +238,11 @@ func (r *ReportMap) BuildRouteStatus(ctx context.Context, obj client.Object, cNa
 		// If there are conditions on the HTTPRoute that are not owned by our reporter, include
 		// them in the final list of conditions to preseve conditions we do not own
 		for _, condition := range currentParentRefConditions {
+			// PolicyConflict is owned by our reporter but only reported while a conflict exists,
+			// so a stale condition must not be carried over once the conflict is resolved.
+			if condition.Type == string(RouteConditionPolicyConflict) {
+				continue
+			}
 			if meta.FindStatusCondition(finalConditions, condition.Type) == nil {
 				meta.SetStatusCondition(&finalConditions, condition)
 			}
@@ -343,3 +365,91 @@ func parentRefReport(routeReport *RouteReport, parentRef *gwv1.ParentReference)
 	}
 	return routeReport.Parents[getParentRefKey(parentRef)]
 }
+
+// maxPolicyAncestors is the maximum number of ancestors allowed in a PolicyStatus
+const maxPolicyAncestors = 16
+
+// BuildPolicyStatus returns the status of the policy with the given key, nil if there is no report for it.
+// The ancestors reported by other controllers are preserved, while the ancestors reported by this controller
+// are replaced by the ones of the report.
+func (m PolicyReportMap) BuildPolicyStatus(ctx context.Context, key PolicyKey, existing gwv1.PolicyStatus, cName string) *gwv1.PolicyStatus {
+	policyReport := m[key]
+	if policyReport == nil {
+		contextutils.LoggerFrom(ctx).Debugf("missing policy report for %s %s", key.Kind, key.NamespacedName)
+		return nil
+	}
+
+	policyStatus := gwv1.PolicyStatus{}
+	var currentAncestors []gwv1.PolicyAncestorStatus
+	for _, ancestor := range existing.Ancestors {
+		if string(ancestor.ControllerName) != cName {
+			policyStatus.Ancestors = append(policyStatus.Ancestors, ancestor)
+			continue
+		}
+		currentAncestors = append(currentAncestors, ancestor)
+	}
+
+	ancestorKeys := make([]PolicyAncestorKey, 0, len(policyReport.Ancestors))
+	for ancestorKey := range policyReport.Ancestors {
+		ancestorKeys = append(ancestorKeys, ancestorKey)
+	}
+	slices.SortFunc(ancestorKeys, comparePolicyAncestorKeys)
+
+	for _, ancestorKey := range ancestorKeys {
+		if len(policyStatus.Ancestors) >= maxPolicyAncestors {
+			contextutils.LoggerFrom(ctx).Debugf("dropping ancestors of %s %s over the limit of %d",
+				key.Kind, key.NamespacedName, maxPolicyAncestors)
+			break
+		}
+
+		ancestorRef := ancestorKey.parentRef()
+		var currentConditions []metav1.Condition
+		if idx := slices.IndexFunc(currentAncestors, func(s gwv1.PolicyAncestorStatus) bool {
+			return reflect.DeepEqual(s.AncestorRef, ancestorRef)
+		}); idx != -1 {
+			currentConditions = currentAncestors[idx].Conditions
+		}
+
+		finalConditions := make([]metav1.Condition, 0, len(policyReport.Ancestors[ancestorKey].Conditions))
+		for _, condition := range policyReport.Ancestors[ancestorKey].Conditions {
+			condition.ObservedGeneration = policyReport.observedGeneration
+
+			// Copy old condition to preserve LastTransitionTime, if it exists
+			if cond := meta.FindStatusCondition(currentConditions, condition.Type); cond != nil {
+				meta.SetStatusCondition(&finalConditions, *cond)
+			}
+			meta.SetStatusCondition(&finalConditions, condition)
+		}
+
+		policyStatus.Ancestors = append(policyStatus.Ancestors, gwv1.PolicyAncestorStatus{
+			AncestorRef:    ancestorRef,
+			ControllerName: gwv1.GatewayController(cName),
+			Conditions:     finalConditions,
+		})
+	}
+
+	return &policyStatus
+}
+
+func (k PolicyAncestorKey) parentRef() gwv1.ParentReference {
+	ref := gwv1.ParentReference{
+		Group:     ptr.To(gwv1.Group(k.Group)),
+		Kind:      ptr.To(gwv1.Kind(k.Kind)),
+		Namespace: ptr.To(gwv1.Namespace(k.Namespace)),
+		Name:      gwv1.ObjectName(k.Name),
+	}
+	if k.SectionName != "" {
+		ref.SectionName = ptr.To(gwv1.SectionName(k.SectionName))
+	}
+	return ref
+}
+
+func comparePolicyAncestorKeys(a, b PolicyAncestorKey) int {
+	return cmp.Or(
+		cmp.Compare(a.Group, b.Group),
+		cmp.Compare(a.Kind, b.Kind),
+		cmp.Compare(a.Namespace, b.Namespace),
+		cmp.Compare(a.Name, b.Name),
+		cmp.Compare(a.SectionName, b.SectionName),
+	)
+}
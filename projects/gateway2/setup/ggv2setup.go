@@ -209,7 +209,7 @@ func NewGenericStatusReporter(client istiokube.Client, r string) reporter.Status
 		r,
 		statusClient,
 	)
-	return &genericStatusReporter{client: client, kubeGwStatusReporter: kubeGwStatusReporter, statusClient: statusClient}
+	return gloostatusutils.NewTracingReporter(r, &genericStatusReporter{client: client, kubeGwStatusReporter: kubeGwStatusReporter, statusClient: statusClient})
 }
 
 // StatusFromReport implements reporter.StatusReporter.
@@ -0,0 +1,114 @@
+package backendref
+
+import (
+	"slices"
+
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// CACertificateKey is the key of the PEM-encoded CA certificate bundle in a ConfigMap or Secret
+	// referenced by a BackendTLSPolicy.
+	CACertificateKey = "ca.crt"
+
+	// SystemCACertificatesFile is the path of the system CA certificate bundle in the proxy image,
+	// used when a BackendTLSPolicy sets wellKnownCACertificates to System.
+	SystemCACertificatesFile = "/etc/ssl/certs/ca-certificates.crt"
+)
+
+// BackendTLSPolicyTarget returns the target ref of the BackendTLSPolicy that selects the given Service port,
+// or nil if the policy does not target it. A target ref without a sectionName selects every port of the Service.
+func BackendTLSPolicyTarget(
+	policy *gwv1.BackendTLSPolicy,
+	svc *corev1.Service,
+	port corev1.ServicePort,
+) *gwv1.LocalPolicyTargetReferenceWithSectionName {
+	if policy.GetNamespace() != svc.GetNamespace() {
+		return nil
+	}
+
+	var target *gwv1.LocalPolicyTargetReferenceWithSectionName
+	for i, ref := range policy.Spec.TargetRefs {
+		if ref.Group != corev1.GroupName || ref.Kind != wellknown.ServiceKind || string(ref.Name) != svc.GetName() {
+			continue
+		}
+		if ref.SectionName == nil {
+			// keep looking for a target ref that selects the port by name
+			target = &policy.Spec.TargetRefs[i]
+			continue
+		}
+		if string(*ref.SectionName) == port.Name {
+			return &policy.Spec.TargetRefs[i]
+		}
+	}
+	return target
+}
+
+// SortBackendTLSPolicies sorts BackendTLSPolicies selecting the same Service port in order of precedence.
+// Policies that select the port by sectionName take precedence over policies selecting the whole Service;
+// the remaining ties are broken by the oldest creation timestamp and then by name, as required by the spec.
+func SortBackendTLSPolicies(policies []*gwv1.BackendTLSPolicy, svc *corev1.Service, port corev1.ServicePort) {
+	slices.SortStableFunc(policies, func(a, b *gwv1.BackendTLSPolicy) int {
+		aSection := BackendTLSPolicyTarget(a, svc, port).SectionName != nil
+		bSection := BackendTLSPolicyTarget(b, svc, port).SectionName != nil
+		if aSection != bSection {
+			if aSection {
+				return -1
+			}
+			return 1
+		}
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			if a.CreationTimestamp.Before(&b.CreationTimestamp) {
+				return -1
+			}
+			return 1
+		}
+		switch {
+		case a.Name < b.Name:
+			return -1
+		case a.Name > b.Name:
+			return 1
+		}
+		return 0
+	})
+}
+
+// BackendTLSPolicyToSslConfig converts a BackendTLSPolicy into the UpstreamSslConfig used to originate TLS
+// to the targeted backend. caSecretRef refers to a TLS secret holding the CA certificates resolved from the
+// policy's caCertificateRefs; it is ignored when the policy uses the well-known system CA certificates.
+func BackendTLSPolicyToSslConfig(policy *gwv1.BackendTLSPolicy, caSecretRef *core.ResourceRef) *ssl.UpstreamSslConfig {
+	validation := policy.Spec.Validation
+	sslConfig := &ssl.UpstreamSslConfig{
+		Sni: string(validation.Hostname),
+	}
+
+	// The hostname is used to authenticate the backend unless subjectAltNames are specified
+	if len(validation.SubjectAltNames) == 0 {
+		sslConfig.VerifySubjectAltName = []string{string(validation.Hostname)}
+	}
+	for _, san := range validation.SubjectAltNames {
+		switch san.Type {
+		case gwv1.HostnameSubjectAltNameType:
+			sslConfig.VerifySubjectAltName = append(sslConfig.VerifySubjectAltName, string(san.Hostname))
+		case gwv1.URISubjectAltNameType:
+			sslConfig.VerifySubjectAltName = append(sslConfig.VerifySubjectAltName, string(san.URI))
+		}
+	}
+
+	if validation.WellKnownCACertificates != nil && *validation.WellKnownCACertificates == gwv1.WellKnownCACertificatesSystem {
+		sslConfig.SslSecrets = &ssl.UpstreamSslConfig_SslFiles{
+			SslFiles: &ssl.SSLFiles{
+				RootCa: SystemCACertificatesFile,
+			},
+		}
+	} else {
+		sslConfig.SslSecrets = &ssl.UpstreamSslConfig_SecretRef{
+			SecretRef: caSecretRef,
+		}
+	}
+	return sslConfig
+}
@@ -0,0 +1,160 @@
+package backendref
+
+import (
+	"testing"
+	"time"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func backendTLSPolicy(name string, created time.Time, sectionName *string) *gwv1.BackendTLSPolicy {
+	ref := gwv1.LocalPolicyTargetReferenceWithSectionName{
+		LocalPolicyTargetReference: gwv1.LocalPolicyTargetReference{
+			Group: corev1.GroupName,
+			Kind:  "Service",
+			Name:  "svc",
+		},
+	}
+	if sectionName != nil {
+		ref.SectionName = ptr.To(gwv1.SectionName(*sectionName))
+	}
+	return &gwv1.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: gwv1.BackendTLSPolicySpec{
+			TargetRefs: []gwv1.LocalPolicyTargetReferenceWithSectionName{ref},
+		},
+	}
+}
+
+func TestBackendTLSPolicyTarget(t *testing.T) {
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"}}
+	https := corev1.ServicePort{Name: "https", Port: 443}
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		policy   *gwv1.BackendTLSPolicy
+		port     corev1.ServicePort
+		expected bool
+	}{
+		{
+			name:     "Whole Service",
+			policy:   backendTLSPolicy("p", now, nil),
+			port:     https,
+			expected: true,
+		},
+		{
+			name:     "Matching sectionName",
+			policy:   backendTLSPolicy("p", now, ptr.To("https")),
+			port:     https,
+			expected: true,
+		},
+		{
+			name:     "Other sectionName",
+			policy:   backendTLSPolicy("p", now, ptr.To("grpc")),
+			port:     https,
+			expected: false,
+		},
+		{
+			name: "Other namespace",
+			policy: func() *gwv1.BackendTLSPolicy {
+				p := backendTLSPolicy("p", now, nil)
+				p.Namespace = "other"
+				return p
+			}(),
+			port:     https,
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := BackendTLSPolicyTarget(test.policy, svc, test.port) != nil
+			if got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestSortBackendTLSPolicies(t *testing.T) {
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"}}
+	port := corev1.ServicePort{Name: "https", Port: 443}
+	now := time.Now()
+
+	policies := []*gwv1.BackendTLSPolicy{
+		backendTLSPolicy("newer", now, nil),
+		backendTLSPolicy("b-older", now.Add(-time.Hour), nil),
+		backendTLSPolicy("a-older", now.Add(-time.Hour), nil),
+		backendTLSPolicy("section", now, ptr.To("https")),
+	}
+	SortBackendTLSPolicies(policies, svc, port)
+
+	expected := []string{"section", "a-older", "b-older", "newer"}
+	for i, name := range expected {
+		if policies[i].Name != name {
+			t.Errorf("expected %s at index %d, got %s", name, i, policies[i].Name)
+		}
+	}
+}
+
+func TestBackendTLSPolicyToSslConfig(t *testing.T) {
+	caSecretRef := &core.ResourceRef{Name: "ca", Namespace: "default"}
+
+	tests := []struct {
+		name       string
+		validation gwv1.BackendTLSPolicyValidation
+		expected   *ssl.UpstreamSslConfig
+	}{
+		{
+			name: "CA certificate refs",
+			validation: gwv1.BackendTLSPolicyValidation{
+				CACertificateRefs: []gwv1.LocalObjectReference{{Kind: "ConfigMap", Name: "ca"}},
+				Hostname:          "backend.example.com",
+			},
+			expected: &ssl.UpstreamSslConfig{
+				Sni:                  "backend.example.com",
+				VerifySubjectAltName: []string{"backend.example.com"},
+				SslSecrets:           &ssl.UpstreamSslConfig_SecretRef{SecretRef: caSecretRef},
+			},
+		},
+		{
+			name: "System CA certificates with subjectAltNames",
+			validation: gwv1.BackendTLSPolicyValidation{
+				WellKnownCACertificates: ptr.To(gwv1.WellKnownCACertificatesSystem),
+				Hostname:                "backend.example.com",
+				SubjectAltNames: []gwv1.SubjectAltName{
+					{Type: gwv1.HostnameSubjectAltNameType, Hostname: "alt.example.com"},
+					{Type: gwv1.URISubjectAltNameType, URI: "spiffe://cluster.local/ns/default/sa/backend"},
+				},
+			},
+			expected: &ssl.UpstreamSslConfig{
+				Sni:                  "backend.example.com",
+				VerifySubjectAltName: []string{"alt.example.com", "spiffe://cluster.local/ns/default/sa/backend"},
+				SslSecrets: &ssl.UpstreamSslConfig_SslFiles{
+					SslFiles: &ssl.SSLFiles{RootCa: SystemCACertificatesFile},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy := &gwv1.BackendTLSPolicy{Spec: gwv1.BackendTLSPolicySpec{Validation: test.validation}}
+			got := BackendTLSPolicyToSslConfig(policy, caSecretRef)
+			if !proto.Equal(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
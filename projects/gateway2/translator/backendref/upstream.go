@@ -0,0 +1,29 @@
+package backendref
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/apis/gloo.solo.io/v1"
+	kubeupstreams "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
+)
+
+// ToUpstream returns the Upstream that a resolved backend is translated to, nil if the backend is neither
+// an Upstream nor a Service port. The Upstream of a Service port holds the configuration of its annotations.
+func ToUpstream(ctx context.Context, obj client.Object, port *gwv1.PortNumber) *gloov1.Upstream {
+	switch backend := obj.(type) {
+	case *v1.Upstream:
+		return &backend.Spec
+	case *corev1.Service:
+		for _, servicePort := range backend.Spec.Ports {
+			if port != nil && servicePort.Port == int32(*port) {
+				return kubeupstreams.ServiceToUpstream(ctx, backend, servicePort)
+			}
+		}
+	}
+	return nil
+}
@@ -96,6 +96,48 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "gw",
 			},
 		}),
+	Entry(
+		"http gateway with timeouts, retries and session persistence",
+		translatorTestCase{
+			inputFile:  "http-with-timeouts-retries",
+			outputFile: "http-with-timeouts-retries-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "gw",
+			},
+		}),
+	Entry(
+		"http gateway with grpc routing",
+		translatorTestCase{
+			inputFile:  "grpc-routing",
+			outputFile: "grpc-routing-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				route := &gwv1.GRPCRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "example-grpc-route",
+						Namespace: "default",
+					},
+				}
+				routeStatus := reportsMap.BuildRouteStatus(context.TODO(), route, "")
+				Expect(routeStatus).NotTo(BeNil())
+				Expect(routeStatus.Parents).To(HaveLen(1))
+				accepted := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+				resolvedRefs := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionResolvedRefs))
+				Expect(resolvedRefs).NotTo(BeNil())
+				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+				Expect(resolvedRefs.Message).To(Equal("services \"missing-svc\" not found"))
+				notHTTP2 := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(reports.RouteConditionBackendProtocolNotHTTP2))
+				Expect(notHTTP2).NotTo(BeNil())
+				Expect(notHTTP2.Status).To(Equal(metav1.ConditionTrue))
+				Expect(notHTTP2.Message).To(Equal("gRPC requires HTTP/2, which backends [default/greeter-v2-svc] are not configured to use"))
+			},
+		}),
 	Entry(
 		"http gateway with lambda destination",
 		translatorTestCase{
@@ -269,6 +311,73 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "example-tcp-gateway",
 			},
 		}),
+	Entry(
+		"udp gateway with basic routing",
+		translatorTestCase{
+			inputFile:  "udp-routing/basic.yaml",
+			outputFile: "udp-routing/basic-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				route := &gwv1a2.UDPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "example-udp-route",
+						Namespace: "default",
+					},
+				}
+				routeStatus := reportsMap.BuildRouteStatus(context.TODO(), route, "")
+				Expect(routeStatus).NotTo(BeNil())
+				Expect(routeStatus.Parents).To(HaveLen(1))
+				accepted := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+			},
+		}),
+	Entry(
+		"udp gateway with multiple routes and backends",
+		translatorTestCase{
+			inputFile:  "udp-routing/multi-route.yaml",
+			outputFile: "udp-routing/multi-route-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				routeStatus := func(name string) *gwv1.RouteStatus {
+					route := &gwv1a2.UDPRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+					}
+					status := reportsMap.BuildRouteStatus(context.TODO(), route, "")
+					Expect(status).NotTo(BeNil())
+					Expect(status.Parents).To(HaveLen(1))
+					return status
+				}
+
+				used := routeStatus("example-udp-route")
+				accepted := meta.FindStatusCondition(used.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+				resolvedRefs := meta.FindStatusCondition(used.Parents[0].Conditions, string(gwv1.RouteConditionResolvedRefs))
+				Expect(resolvedRefs).NotTo(BeNil())
+				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+				partiallyInvalid := meta.FindStatusCondition(used.Parents[0].Conditions, string(gwv1.RouteConditionPartiallyInvalid))
+				Expect(partiallyInvalid).NotTo(BeNil())
+				Expect(partiallyInvalid.Status).To(Equal(metav1.ConditionTrue))
+				Expect(partiallyInvalid.Reason).To(Equal(string(gwv1.RouteReasonUnsupportedValue)))
+				Expect(partiallyInvalid.Message).To(ContainSubstring("1 other backend(s) are ignored"))
+
+				ignored := routeStatus("ignored-udp-route")
+				accepted = meta.FindStatusCondition(ignored.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+				Expect(accepted.Reason).To(Equal(string(gwv1.RouteReasonUnsupportedValue)))
+			},
+		}),
 	Entry(
 		"tls gateway with basic routing",
 		translatorTestCase{
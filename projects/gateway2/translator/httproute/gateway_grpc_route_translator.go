@@ -0,0 +1,257 @@
+package httproute
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/solo-io/go-utils/contextutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/gloo/projects/gateway2/query"
+	"github.com/solo-io/gloo/projects/gateway2/reports"
+	"github.com/solo-io/gloo/projects/gateway2/translator/backendref"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/registry"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+)
+
+// grpcAnySegment matches a single gRPC service or method name in a request path.
+const grpcAnySegment = "[^/]+"
+
+// TranslateGatewayGRPCRouteRules translates the rules of a GRPCRoute into Gloo routes.
+//
+// gRPC requests are HTTP/2 requests with a `/<service>/<method>` path, so each GRPCRouteRule
+// is converted into its HTTPRouteRule equivalent and the route plugins are applied to it
+// in the same way as they are for HTTPRoutes.
+func TranslateGatewayGRPCRouteRules(
+	ctx context.Context,
+	pluginRegistry registry.PluginRegistry,
+	gwListener gwv1.Listener,
+	routeInfo *query.RouteInfo,
+	reporter reports.ParentRefReporter,
+	baseReporter reports.Reporter,
+) []*v1.Route {
+	var finalRoutes []*v1.Route
+
+	// Only GRPCRoute types should be translated.
+	route, ok := routeInfo.Object.(*gwv1.GRPCRoute)
+	if !ok {
+		return finalRoutes
+	}
+
+	hostnames := make([]gwv1.Hostname, len(route.Spec.Hostnames))
+	copy(hostnames, route.Spec.Hostnames)
+
+	for ruleIdx, grpcRule := range route.Spec.Rules {
+		rule := convertGRPCRouteRule(grpcRule, reporter)
+		reportNonHTTP2Backends(ctx, routeInfo, rule, reporter)
+		if len(rule.Matches) == 0 {
+			// from the spec:
+			// If no matches are specified, the implementation MUST match every gRPC request.
+			rule.Matches = []gwv1.HTTPRouteMatch{{}}
+		}
+
+		for idx, match := range rule.Matches {
+			match := match
+			outputRoute := &v1.Route{
+				Matchers: []*matchers.Matcher{translateGlooMatcher(match)},
+				Options:  &v1.RouteOptions{},
+				Name:     routeInfo.UniqueRouteName(ruleIdx, idx),
+			}
+
+			if len(rule.BackendRefs) > 0 {
+				setRouteAction(
+					ctx,
+					routeInfo,
+					rule,
+					outputRoute,
+					reporter,
+					baseReporter,
+					pluginRegistry,
+					gwListener,
+					match,
+					nil,
+					sets.New[types.NamespacedName](),
+					list.New(),
+				)
+			}
+
+			rtCtx := &plugins.RouteContext{
+				Listener:        &gwListener,
+				GRPCRoute:       route,
+				Hostnames:       hostnames,
+				DelegationChain: list.New(),
+				Rule:            &rule,
+				Match:           &match,
+				Reporter:        reporter,
+			}
+			for _, plugin := range pluginRegistry.GetRoutePlugins() {
+				if err := plugin.ApplyRoutePlugin(ctx, rtCtx, outputRoute); err != nil {
+					contextutils.LoggerFrom(ctx).Errorf("error in RoutePlugin: %v", err)
+				}
+			}
+
+			if outputRoute.GetAction() == nil {
+				// gRPC clients map a 503 response to the UNAVAILABLE status code,
+				// which is what the spec requires when a rule has no valid backends.
+				outputRoute.Action = &v1.Route_DirectResponseAction{
+					DirectResponseAction: &v1.DirectResponseAction{
+						Status: http.StatusServiceUnavailable,
+					},
+				}
+			}
+			finalRoutes = append(finalRoutes, outputRoute)
+		}
+	}
+	return finalRoutes
+}
+
+// convertGRPCRouteRule converts a GRPCRouteRule into the equivalent HTTPRouteRule.
+// Route delegation is not supported for GRPCRoutes, so delegating backend refs are dropped
+// and reported on the route's parent status.
+func convertGRPCRouteRule(rule gwv1.GRPCRouteRule, reporter reports.ParentRefReporter) gwv1.HTTPRouteRule {
+	out := gwv1.HTTPRouteRule{
+		Name:               rule.Name,
+		Filters:            convertGRPCRouteFilters(rule.Filters),
+		SessionPersistence: rule.SessionPersistence,
+	}
+	for _, match := range rule.Matches {
+		out.Matches = append(out.Matches, convertGRPCRouteMatch(match))
+	}
+	for _, backendRef := range rule.BackendRefs {
+		if backendref.RefIsDelegatedHTTPRoute(backendRef.BackendObjectReference) {
+			reporter.SetCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionResolvedRefs,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.RouteReasonInvalidKind,
+				Message: fmt.Sprintf("route delegation is not supported for GRPCRoutes: %s", backendref.ToString(backendRef.BackendObjectReference)),
+			})
+			continue
+		}
+		out.BackendRefs = append(out.BackendRefs, gwv1.HTTPBackendRef{
+			BackendRef: backendRef.BackendRef,
+			Filters:    convertGRPCRouteFilters(backendRef.Filters),
+		})
+	}
+	return out
+}
+
+// reportNonHTTP2Backends sets a condition on the route when a backend of the rule is not configured to use HTTP/2.
+// The Upstream of a backend may be shared with HTTPRoutes, so it is not switched to HTTP/2 here: a Service
+// port has to set the `kubernetes.io/h2c` or `grpc` appProtocol and an Upstream has to set useHttp2.
+func reportNonHTTP2Backends(
+	ctx context.Context,
+	routeInfo *query.RouteInfo,
+	rule gwv1.HTTPRouteRule,
+	reporter reports.ParentRefReporter,
+) {
+	var backends []string
+	for _, backendRef := range rule.BackendRefs {
+		obj, err := routeInfo.GetBackendForRef(backendRef.BackendObjectReference)
+		if err != nil {
+			// unresolved backends are reported when the route action is set
+			continue
+		}
+		upstream := backendref.ToUpstream(ctx, obj, backendRef.Port)
+		if upstream != nil && !upstream.GetUseHttp2().GetValue() {
+			backends = append(backends, fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName()))
+		}
+	}
+	if len(backends) == 0 {
+		return
+	}
+
+	reporter.SetCondition(reports.RouteCondition{
+		Type:   reports.RouteConditionBackendProtocolNotHTTP2,
+		Status: metav1.ConditionTrue,
+		Reason: reports.RouteReasonBackendAppProtocol,
+		Message: fmt.Sprintf("gRPC requires HTTP/2, which backends [%s] are not configured to use",
+			strings.Join(backends, ", ")),
+	})
+}
+
+// convertGRPCRouteMatch converts a GRPCRouteMatch into the equivalent HTTPRouteMatch.
+func convertGRPCRouteMatch(match gwv1.GRPCRouteMatch) gwv1.HTTPRouteMatch {
+	out := gwv1.HTTPRouteMatch{
+		Path: grpcMethodToPathMatch(match.Method),
+	}
+	for _, header := range match.Headers {
+		headerMatch := gwv1.HTTPHeaderMatch{
+			Name:  gwv1.HTTPHeaderName(header.Name),
+			Value: header.Value,
+		}
+		if header.Type != nil && *header.Type == gwv1.GRPCHeaderMatchRegularExpression {
+			headerMatch.Type = ptr.To(gwv1.HeaderMatchRegularExpression)
+		}
+		out.Headers = append(out.Headers, headerMatch)
+	}
+	return out
+}
+
+// grpcMethodToPathMatch converts a GRPCMethodMatch into a path match on the `/<service>/<method>`
+// request path. A nil path match is returned when all services and methods should match.
+func grpcMethodToPathMatch(method *gwv1.GRPCMethodMatch) *gwv1.HTTPPathMatch {
+	if method == nil || (method.Service == nil && method.Method == nil) {
+		return nil
+	}
+
+	if method.Type != nil && *method.Type == gwv1.GRPCMethodMatchRegularExpression {
+		service, rpc := grpcAnySegment, grpcAnySegment
+		if method.Service != nil {
+			service = *method.Service
+		}
+		if method.Method != nil {
+			rpc = *method.Method
+		}
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchRegularExpression),
+			Value: ptr.To(fmt.Sprintf("/(%s)/(%s)", service, rpc)),
+		}
+	}
+
+	switch {
+	case method.Service != nil && method.Method != nil:
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchExact),
+			Value: ptr.To(fmt.Sprintf("/%s/%s", *method.Service, *method.Method)),
+		}
+	case method.Service != nil:
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchPathPrefix),
+			Value: ptr.To(fmt.Sprintf("/%s/", *method.Service)),
+		}
+	default:
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchRegularExpression),
+			Value: ptr.To(fmt.Sprintf("/%s/%s", grpcAnySegment, regexp.QuoteMeta(*method.Method))),
+		}
+	}
+}
+
+// convertGRPCRouteFilters converts GRPCRouteFilters into the equivalent HTTPRouteFilters.
+// Every GRPCRouteFilter type has an HTTPRouteFilter counterpart with the same name and config.
+func convertGRPCRouteFilters(filters []gwv1.GRPCRouteFilter) []gwv1.HTTPRouteFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+	out := make([]gwv1.HTTPRouteFilter, 0, len(filters))
+	for _, filter := range filters {
+		out = append(out, gwv1.HTTPRouteFilter{
+			Type:                   gwv1.HTTPRouteFilterType(filter.Type),
+			RequestHeaderModifier:  filter.RequestHeaderModifier,
+			ResponseHeaderModifier: filter.ResponseHeaderModifier,
+			RequestMirror:          filter.RequestMirror,
+			ExtensionRef:           filter.ExtensionRef,
+		})
+	}
+	return out
+}
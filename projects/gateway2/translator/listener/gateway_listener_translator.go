@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/rotisserie/eris"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
@@ -103,6 +105,8 @@ func (ml *MergedListeners) AppendListener(
 		ml.AppendTcpListener(listener, routes, reporter, listenerSet)
 	case gwv1.TLSProtocolType:
 		ml.AppendTlsListener(listener, routes, reporter, listenerSet)
+	case gwv1.UDPProtocolType:
+		ml.AppendUdpListener(listener, routes, reporter, listenerSet)
 	default:
 		return eris.Errorf("unsupported protocol: %v", listener.Protocol)
 	}
@@ -128,7 +132,7 @@ func (ml *MergedListeners) appendHttpListener(
 	finalPort := gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port)))
 
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpRoutes == nil {
 			if lis.httpFilterChain != nil {
 				lis.httpFilterChain.parents = append(lis.httpFilterChain.parents, parent)
 			} else {
@@ -172,7 +176,7 @@ func (ml *MergedListeners) appendHttpsListener(
 
 	listenerName := generateListenerName(listener)
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpRoutes == nil {
 			lis.httpsFilterChains = append(lis.httpsFilterChains, mfc)
 			return
 		}
@@ -232,7 +236,7 @@ func (ml *MergedListeners) AppendTcpListener(
 	finalPort := gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port)))
 
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpRoutes == nil {
 			lis.TcpFilterChains = append(lis.TcpFilterChains, fc)
 			return
 		}
@@ -393,7 +397,7 @@ func (ml *MergedListeners) AppendTlsListener(
 	finalPort := gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port)))
 
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpRoutes == nil {
 			lis.TcpFilterChains = append(lis.TcpFilterChains, fc)
 			return
 		}
@@ -411,6 +415,51 @@ func (ml *MergedListeners) AppendTlsListener(
 	})
 }
 
+func (ml *MergedListeners) AppendUdpListener(
+	listener gwv1.Listener,
+	routeInfos []*query.RouteInfo,
+	reporter reports.ListenerReporter,
+	listenerSet *gwxv1a1.XListenerSet,
+) {
+	var validRouteInfos []*query.RouteInfo
+
+	for _, routeInfo := range routeInfos {
+		uRoute, ok := routeInfo.Object.(*gwv1a2.UDPRoute)
+		if !ok {
+			continue
+		}
+
+		if len(uRoute.Spec.ParentRefs) == 0 {
+			contextutils.LoggerFrom(context.Background()).Warnf(
+				"No parent references found for UDPRoute %s", uRoute.Name,
+			)
+			continue
+		}
+
+		validRouteInfos = append(validRouteInfos, routeInfo)
+	}
+
+	// If no valid routes are found, do not create a listener
+	if len(validRouteInfos) == 0 {
+		contextutils.LoggerFrom(context.Background()).Errorf(
+			"No valid routes found for listener %s", listener.Name,
+		)
+		return
+	}
+
+	// UDP listeners bind their own socket and are never merged with other listeners,
+	// listener validation guarantees there is at most one UDP listener per port.
+	ml.Listeners = append(ml.Listeners, &MergedListener{
+		name:             generateUdpListenerName(listener),
+		gatewayNamespace: ml.GatewayNamespace,
+		port:             gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port))),
+		udpRoutes:        validRouteInfos,
+		listenerReporter: reporter,
+		listener:         listener,
+		listenerSet:      listenerSet,
+	})
+}
+
 func (ml *MergedListeners) translateListeners(
 	ctx context.Context,
 	pluginRegistry registry.PluginRegistry,
@@ -420,6 +469,9 @@ func (ml *MergedListeners) translateListeners(
 	var listeners []*v1.Listener
 	for _, mergedListener := range ml.Listeners {
 		listener := mergedListener.TranslateListener(ctx, pluginRegistry, queries, reporter)
+		if listener == nil {
+			continue
+		}
 
 		// run listener plugins
 		for _, listenerPlugin := range pluginRegistry.GetListenerPlugins() {
@@ -445,6 +497,7 @@ type MergedListener struct {
 	httpFilterChain   *httpFilterChain
 	httpsFilterChains []httpsFilterChain
 	TcpFilterChains   []tcpFilterChain
+	udpRoutes         []*query.RouteInfo
 	listenerReporter  reports.ListenerReporter
 	listener          gwv1.Listener
 	listenerSet       *gwxv1a1.XListenerSet
@@ -458,6 +511,10 @@ func (ml *MergedListener) TranslateListener(
 	queries query.GatewayQueries,
 	reporter reports.Reporter,
 ) *v1.Listener {
+	if ml.udpRoutes != nil {
+		return ml.translateUdpListener(ctx, reporter)
+	}
+
 	var (
 		httpFilterChains    []*v1.AggregateListener_HttpFilterChain
 		mergedVhosts        = map[string]*v1.VirtualHost{}
@@ -531,15 +588,10 @@ func (ml *MergedListener) TranslateListener(
 		}
 	}
 
-	bindAddress := "::"
-	if settingsutil.MaybeFromContext(ctx).GetIpV4Only() {
-		bindAddress = "0.0.0.0"
-	}
-
 	// Create and return the listener with all filter chains and TCP listeners
 	return &v1.Listener{
 		Name:        ml.name,
-		BindAddress: bindAddress,
+		BindAddress: bindAddress(ctx),
 		BindPort:    uint32(ml.port),
 		ListenerType: &v1.Listener_AggregateListener{
 			AggregateListener: &v1.AggregateListener{
@@ -552,24 +604,160 @@ func (ml *MergedListener) TranslateListener(
 			},
 		},
 		// Used for tracing to create service_name
-		OpaqueMetadata: &v1.Listener_MetadataStatic{
-			MetadataStatic: &v1.SourceMetadata{
-				Sources: []*v1.SourceMetadata_SourceRef{
-					{
-						ResourceRef: &core.ResourceRef{
-							Name:      ml.name,
-							Namespace: ml.gatewayNamespace,
-						},
-						ResourceKind: wellknown.GatewayGroup + "/" + wellknown.GatewayKind,
+		OpaqueMetadata: ml.sourceMetadata(),
+		Options:        nil, // Listener options will be added by policy plugins
+		RouteOptions:   nil,
+	}
+}
+
+// translateUdpListener translates a Gateway listener with the UDP protocol into a Gloo UdpListener.
+// Envoy proxies all datagrams received on a UDP listener to a single cluster, so only the first
+// backend of the UDPRoutes which can be resolved is used, and the routes are ordered by creation timestamp and then by
+// namespace and name as in Gateway API conflict resolution. Routes or backends that cannot be served are reported.
+// Returns nil if no backend can be resolved, as a UdpListener requires a destination.
+func (ml *MergedListener) translateUdpListener(
+	ctx context.Context,
+	reporter reports.Reporter,
+) *v1.Listener {
+	udpRoutes := slices.Clone(ml.udpRoutes)
+	sort.SliceStable(udpRoutes, func(i, j int) bool {
+		a, b := udpRoutes[i].Object, udpRoutes[j].Object
+		aTime, bTime := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+		if !aTime.Equal(&bTime) {
+			return aTime.Before(&bTime)
+		}
+		return client.ObjectKeyFromObject(a).String() < client.ObjectKeyFromObject(b).String()
+	})
+
+	var destination *v1.Destination
+	for _, r := range udpRoutes {
+		uRoute := r.Object.(*gwv1a2.UDPRoute)
+		// Collect ParentRefReporters for the UDPRoute
+		parentRefReporters := make([]reports.ParentRefReporter, 0, len(uRoute.Spec.ParentRefs))
+		for _, parentRef := range uRoute.Spec.ParentRefs {
+			parentRefReporters = append(parentRefReporters, reporter.Route(uRoute).ParentRef(&parentRef))
+		}
+		setCondition := func(condition reports.RouteCondition) {
+			for _, parentRefReporter := range parentRefReporters {
+				parentRefReporter.SetCondition(condition)
+			}
+		}
+
+		if destination != nil {
+			// the listener already proxies to the backend of another UDPRoute
+			setCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: fmt.Sprintf("UDP listener %s proxies to a single backend, which is provided by another UDPRoute", ml.name),
+			})
+			continue
+		}
+
+		setCondition(reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.RouteReasonAccepted,
+		})
+		var backendRefs []gwv1.BackendRef
+		for _, rule := range uRoute.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+		var used int
+		destination, used = buildUdpDestination(r, parentRefReporters, ml.listener.Port, backendRefs)
+		if destination != nil && used < len(backendRefs)-1 {
+			setCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionPartiallyInvalid,
+				Status:  metav1.ConditionTrue,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: fmt.Sprintf("UDP listener %s proxies to a single backend, %d other backend(s) are ignored", ml.name, len(backendRefs)-1-used),
+			})
+		}
+	}
+
+	if destination == nil {
+		contextutils.LoggerFrom(ctx).Errorf("No valid backends found for UDP listener %s", ml.name)
+		return nil
+	}
+
+	return &v1.Listener{
+		Name:        ml.name,
+		BindAddress: bindAddress(ctx),
+		BindPort:    uint32(ml.port),
+		ListenerType: &v1.Listener_UdpListener{
+			UdpListener: &v1.UdpListener{
+				UdpProxy: &v1.UdpProxyAction{
+					Destination: destination,
+				},
+			},
+		},
+		OpaqueMetadata: ml.sourceMetadata(),
+	}
+}
+
+// buildUdpDestination returns the Destination of the first backendRef which can be resolved, and its index,
+// reporting the backendRefs which cannot.
+func buildUdpDestination(
+	routeInfo *query.RouteInfo,
+	parentRefReporters []reports.ParentRefReporter,
+	defaultPort gwv1.PortNumber,
+	backendRefs []gwv1.BackendRef,
+) (*v1.Destination, int) {
+	for i, ref := range backendRefs {
+		obj, err := routeInfo.GetBackendForRef(ref.BackendObjectReference)
+		if err == nil && !backendref.RefIsService(ref.BackendObjectReference) {
+			err = query.ErrUnknownBackendKind
+		}
+		if err != nil {
+			for _, parentRefReporter := range parentRefReporters {
+				query.ProcessBackendError(err, parentRefReporter)
+			}
+			continue
+		}
+
+		port := uint32(defaultPort)
+		if ref.Port != nil {
+			port = uint32(*ref.Port)
+		}
+		return &v1.Destination{
+			DestinationType: &v1.Destination_Kube{
+				Kube: &v1.KubernetesServiceDestination{
+					Ref: &core.ResourceRef{
+						Name:      obj.GetName(),
+						Namespace: obj.GetNamespace(),
+					},
+					Port: port,
+				},
+			},
+		}, i
+	}
+	return nil, len(backendRefs)
+}
+
+// sourceMetadata is used for tracing to create service_name
+func (ml *MergedListener) sourceMetadata() *v1.Listener_MetadataStatic {
+	return &v1.Listener_MetadataStatic{
+		MetadataStatic: &v1.SourceMetadata{
+			Sources: []*v1.SourceMetadata_SourceRef{
+				{
+					ResourceRef: &core.ResourceRef{
+						Name:      ml.name,
+						Namespace: ml.gatewayNamespace,
 					},
+					ResourceKind: wellknown.GatewayGroup + "/" + wellknown.GatewayKind,
 				},
 			},
 		},
-		Options:      nil, // Listener options will be added by policy plugins
-		RouteOptions: nil,
 	}
 }
 
+func bindAddress(ctx context.Context) string {
+	if settingsutil.MaybeFromContext(ctx).GetIpV4Only() {
+		return "0.0.0.0"
+	}
+	return "::"
+}
+
 // tcpFilterChain each one represents a Gateway listener that has been merged into a single Gloo Listener
 // (with distinct filter chains). In the case where no Gateway listener merging takes place, every listener
 // will use a Gloo AggregatedListener with one TCP filter chain.
@@ -825,14 +1013,27 @@ func buildRoutesPerHost(
 ) {
 	for _, routeWithHosts := range routes {
 		parentRefReporter := reporter.Route(routeWithHosts.Object).ParentRef(&routeWithHosts.ParentRef)
-		routes := route.TranslateGatewayHTTPRouteRules(
-			ctx,
-			pluginRegistry,
-			gwListener,
-			routeWithHosts,
-			parentRefReporter,
-			reporter,
-		)
+		var routes []*v1.Route
+		switch routeWithHosts.Object.(type) {
+		case *gwv1.GRPCRoute:
+			routes = route.TranslateGatewayGRPCRouteRules(
+				ctx,
+				pluginRegistry,
+				gwListener,
+				routeWithHosts,
+				parentRefReporter,
+				reporter,
+			)
+		default:
+			routes = route.TranslateGatewayHTTPRouteRules(
+				ctx,
+				pluginRegistry,
+				gwListener,
+				routeWithHosts,
+				parentRefReporter,
+				reporter,
+			)
+		}
 
 		if len(routes) == 0 {
 			// TODO report
@@ -940,3 +1141,8 @@ func generateListenerName(listener gwv1.Listener) string {
 	// Add a ~ to make sure the name won't collide with user provided names in other listeners
 	return fmt.Sprintf("listener~%d", listener.Port)
 }
+
+func generateUdpListenerName(listener gwv1.Listener) string {
+	// UDP listeners may share a port with a TCP based listener, so the protocol is included in the name
+	return fmt.Sprintf("listener~udp~%d", listener.Port)
+}
@@ -22,21 +22,30 @@ type portProtocol struct {
 	listeners []types.ConsolidatedListener
 }
 
+// portKey identifies the socket a listener binds to. UDP listeners bind a separate
+// socket, so they may share a port number with TCP based listeners.
+type portKey struct {
+	port gwv1.PortNumber
+	udp  bool
+}
+
 type protocol = string
 type groupName = string
 type routeKind = string
 
 func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
-	// we currently only support HTTPRoute on HTTP and HTTPS protocols
+	// we currently only support HTTPRoute and GRPCRoute on HTTP and HTTPS protocols
 	supportedProtocolToKinds := map[protocol]map[groupName][]routeKind{
 		string(gwv1.HTTPProtocolType): {
 			gwv1.GroupName: []string{
 				wellknown.HTTPRouteKind,
+				wellknown.GRPCRouteKind,
 			},
 		},
 		string(gwv1.HTTPSProtocolType): {
 			gwv1.GroupName: []string{
 				wellknown.HTTPRouteKind,
+				wellknown.GRPCRouteKind,
 			},
 		},
 		string(gwv1.TCPProtocolType): {
@@ -49,6 +58,11 @@ func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
 				wellknown.TLSRouteKind,
 			},
 		},
+		string(gwv1.UDPProtocolType): {
+			gwv1.GroupName: []string{
+				wellknown.UDPRouteKind,
+			},
+		},
 	}
 	return supportedProtocolToKinds
 }
@@ -156,7 +170,7 @@ func validateListeners(consolidatedGateway *types.ConsolidatedGateway, reporter
 
 	validListeners := validateSupportedRoutes(consolidatedGateway.GetConsolidatedListeners(), reporter)
 
-	portListeners := map[gwv1.PortNumber]*portProtocol{}
+	portListeners := map[portKey]*portProtocol{}
 	for _, cl := range validListeners {
 		listener := cl.Listener
 		protocol := listener.Protocol
@@ -164,7 +178,8 @@ func validateListeners(consolidatedGateway *types.ConsolidatedGateway, reporter
 			protocol = NormalizedHTTPSTLSType
 		}
 
-		if existingListener, ok := portListeners[listener.Port]; ok {
+		key := portKey{port: listener.Port, udp: listener.Protocol == gwv1.UDPProtocolType}
+		if existingListener, ok := portListeners[key]; ok {
 			existingListener.protocol[protocol] = true
 			existingListener.listeners = append(existingListener.listeners, cl)
 			//TODO(Law): handle validation that hostname empty for udp/tcp
@@ -189,7 +204,7 @@ func validateListeners(consolidatedGateway *types.ConsolidatedGateway, reporter
 				},
 				listeners: []types.ConsolidatedListener{cl},
 			}
-			portListeners[listener.Port] = &pp
+			portListeners[key] = &pp
 		}
 	}
 
@@ -54,7 +54,7 @@ func (p *plugin) ApplyRoutePlugin(
 	}
 
 	// verify the DR reference is valid and get the DR object from the cluster.
-	dr, err := utils.GetExtensionRefObj[*v1alpha1.DirectResponse](ctx, routeCtx.HTTPRoute, p.gwQueries, filters[0].ExtensionRef)
+	dr, err := utils.GetExtensionRefObj[*v1alpha1.DirectResponse](ctx, routeCtx.RouteObject(), p.gwQueries, filters[0].ExtensionRef)
 	if err != nil {
 		outputRoute.Action = ErrorResponseAction()
 		routeCtx.Reporter.SetCondition(reports.RouteCondition{
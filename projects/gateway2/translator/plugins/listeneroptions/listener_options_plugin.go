@@ -11,9 +11,11 @@ import (
 	sologatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
 	gwquery "github.com/solo-io/gloo/projects/gateway2/query"
+	"github.com/solo-io/gloo/projects/gateway2/reports"
 	"github.com/solo-io/gloo/projects/gateway2/translator/listenerutils"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
 	lisquery "github.com/solo-io/gloo/projects/gateway2/translator/plugins/listeneroptions/query"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
@@ -50,8 +52,10 @@ type legacyStatusCache map[types.NamespacedName]*legacyStatus
 
 type plugin struct {
 	gwQueries                gwquery.GatewayQueries
+	client                   client.Client
 	lisOptQueries            lisquery.ListenerOptionQueries
 	legacyStatusCache        legacyStatusCache
+	listenerAttachments      utils.ListenerAttachments
 	listenerOptionCollection krt.Collection[*solokubev1.ListenerOption]
 	statusReporter           reporter.StatusReporter
 }
@@ -64,8 +68,10 @@ func NewPlugin(
 ) *plugin {
 	return &plugin{
 		gwQueries:                gwQueries,
+		client:                   client,
 		lisOptQueries:            lisquery.NewQuery(client),
 		legacyStatusCache:        make(legacyStatusCache),
+		listenerAttachments:      make(utils.ListenerAttachments),
 		listenerOptionCollection: listenerOptionCollection,
 		statusReporter:           statusReporter,
 	}
@@ -83,7 +89,9 @@ func (p *plugin) ApplyListenerPlugin(
 		return err
 	}
 
+	listenerKey := utils.NewListenerKey(listenerCtx)
 	if len(attachedOptions) == 0 {
+		p.listenerAttachments.Track(listenerKey)
 		return nil
 	}
 
@@ -102,6 +110,7 @@ func (p *plugin) ApplyListenerPlugin(
 
 	nn := client.ObjectKeyFromObject(optionUsed)
 	p.legacyStatusCache[nn] = newLegacyStatus()
+	p.listenerAttachments.Track(listenerKey, optionUsed)
 
 	// set a warning on any unused ListenerOptions
 	for _, opt := range attachedOptions[1:] {
@@ -157,6 +166,7 @@ func (p *plugin) MergeStatusPlugin(ctx context.Context, source any) error {
 
 		p.legacyStatusCache[cacheKey] = destStatus
 	}
+	p.listenerAttachments.Merge(sourceStatusPlugin.listenerAttachments)
 
 	return nil
 }
@@ -220,9 +230,22 @@ func (p *plugin) ApplyStatusPlugin(
 		}
 	}
 
+	p.reportPolicyAncestors(ctx, statusCtx.PolicyReports)
+
 	return multierr.ErrorOrNil()
 }
 
+// reportPolicyAncestors reports the Gateway API ancestor status of every ListenerOption for each of its targets
+func (p *plugin) reportPolicyAncestors(ctx context.Context, policyReports reports.PolicyReportMap) {
+	if policyReports == nil {
+		return
+	}
+	for _, lisOpt := range p.listenerOptionCollection.List() {
+		utils.ReportListenerPolicyAncestors(ctx, p.client, sologatewayv1.ListenerOptionGVK.Kind, lisOpt,
+			lisOpt.Spec.GetTargetRefs(), p.listenerAttachments, policyReports)
+	}
+}
+
 func extractListenerOptionsErrors(
 	proxyReport *validation.ProxyReport,
 ) map[types.NamespacedName][]*validation.HttpListenerReport_Error {
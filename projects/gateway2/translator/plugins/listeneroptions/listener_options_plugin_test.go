@@ -14,6 +14,7 @@ import (
 	sologatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
 	gwquery "github.com/solo-io/gloo/projects/gateway2/query"
+	"github.com/solo-io/gloo/projects/gateway2/reports"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
 	lisoptquery "github.com/solo-io/gloo/projects/gateway2/translator/plugins/listeneroptions/query"
 	"github.com/solo-io/gloo/projects/gateway2/translator/testutils"
@@ -29,7 +30,9 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -278,6 +281,57 @@ var _ = Describe("ListenerOptions Plugin", func() {
 				Expect(status.Reason).To(ContainSubstring("istenerOption 'default/unattached-policy' not attached to Gateway 'default/gw' due to higher priority ListenerOption 'default/policy'"))
 			})
 		})
+
+		When("reporting the ancestor status of the ListenerOptions", func() {
+			It("reports each ListenerOption as accepted, conflicted or with its target not found", func() {
+				initCollections(attachedListenerOptionInternal(), unattachedListenerOptionInternal(), nonAttachedListenerOptionInternal())
+				gw := listenerCtx.Gateway.DeepCopy()
+				gw.Spec.Listeners = []gwv1.Listener{*listenerCtx.GwListener}
+				deps := []client.Object{gw, attachedListenerOption(), unattachedListenerOption(), nonAttachedListenerOption()}
+				fakeClient := testutils.BuildIndexedFakeClient(deps, gwquery.IterateIndices, lisoptquery.IterateIndices)
+				gwQueries := testutils.BuildGatewayQueriesWithClient(fakeClient)
+				plugin := NewPlugin(gwQueries, fakeClient, listenerOptionCollection, statusReporter)
+
+				err := plugin.ApplyListenerPlugin(ctx, listenerCtx, outputListener)
+				Expect(err).ToNot(HaveOccurred())
+
+				statusCtx.PolicyReports = reports.NewPolicyReportMap()
+				err = plugin.ApplyStatusPlugin(ctx, &statusCtx)
+				Expect(err).ToNot(HaveOccurred())
+
+				gwAncestor := reports.PolicyAncestorKey{
+					ParentRefKey: reports.ParentRefKey{
+						Group:          gwv1.GroupName,
+						Kind:           wellknown.GatewayKind,
+						NamespacedName: types.NamespacedName{Namespace: "default", Name: "gw"},
+					},
+				}
+				acceptedCondition := func(policyName string, ancestor reports.PolicyAncestorKey) *metav1.Condition {
+					policyReport := statusCtx.PolicyReports[reports.PolicyKey{
+						Kind:           sologatewayv1.ListenerOptionGVK.Kind,
+						NamespacedName: types.NamespacedName{Namespace: "default", Name: policyName},
+					}]
+					Expect(policyReport).NotTo(BeNil())
+					Expect(policyReport.Ancestors).To(HaveKey(ancestor))
+					return meta.FindStatusCondition(policyReport.Ancestors[ancestor].Conditions, string(gwv1.PolicyConditionAccepted))
+				}
+
+				accepted := acceptedCondition("policy", gwAncestor)
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+				Expect(accepted.Reason).To(Equal(string(gwv1.PolicyReasonAccepted)))
+
+				conflicted := acceptedCondition("unattached-policy", gwAncestor)
+				Expect(conflicted.Status).To(Equal(metav1.ConditionFalse))
+				Expect(conflicted.Reason).To(Equal(string(gwv1.PolicyReasonConflicted)))
+				Expect(conflicted.Message).To(ContainSubstring("default/policy"))
+
+				badGwAncestor := gwAncestor
+				badGwAncestor.Name = "bad-gw"
+				notFound := acceptedCondition("bad-policy", badGwAncestor)
+				Expect(notFound.Status).To(Equal(metav1.ConditionFalse))
+				Expect(notFound.Reason).To(Equal(string(gwv1.PolicyReasonTargetNotFound)))
+			})
+		})
 	})
 })
 
@@ -23,6 +23,9 @@ type RouteContext struct {
 	Listener *gwv1.Listener
 	// top-level HTTPRoute
 	HTTPRoute *gwv1.HTTPRoute
+	// top-level GRPCRoute. When set, Rule and Match hold the HTTP equivalents of the
+	// GRPCRoute rule and match being processed.
+	GRPCRoute *gwv1.GRPCRoute
 	// top-level TCPRoute
 	TCPRoute *gwv1a2.TCPRoute
 	// Hostnames associated with the Route.
@@ -42,6 +45,17 @@ type RouteContext struct {
 	Reporter reports.ParentRefReporter
 }
 
+// RouteObject returns the top-level HTTPRoute or GRPCRoute being processed, or nil if there is none.
+func (r *RouteContext) RouteObject() client.Object {
+	if r.HTTPRoute != nil {
+		return r.HTTPRoute
+	}
+	if r.GRPCRoute != nil {
+		return r.GRPCRoute
+	}
+	return nil
+}
+
 type DelegationCtx struct {
 	Ref types.NamespacedName
 }
@@ -104,6 +118,8 @@ type PostTranslationPlugin interface {
 
 type StatusContext struct {
 	ProxiesWithReports []translatorutils.ProxyWithReports
+	// PolicyReports collects the ancestor status of the policies handled by the plugins, nil if it is not reported
+	PolicyReports reports.PolicyReportMap
 }
 
 // Plugin that recieves proxy reports post-xds translation to handle any status reporting necessary
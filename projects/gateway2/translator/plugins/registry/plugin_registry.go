@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/mirror"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/redirect"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/routeoptions"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/routerule"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/urlrewrite"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/virtualhostoptions"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -100,6 +101,7 @@ func BuildPlugins(
 		headermodifier.NewPlugin(),
 		mirror.NewPlugin(queries),
 		redirect.NewPlugin(),
+		routerule.NewPlugin(queries), // route rule fields need to be applied before attached RouteOptions are merged
 		routeoptions.NewPlugin(queries, client, routeOptionCollection, statusReporter),
 		virtualhostoptions.NewPlugin(queries, client, virtualHostOptionCollection, statusReporter),
 		httplisteneroptions.NewPlugin(queries, client),
@@ -13,7 +13,9 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"istio.io/istio/pkg/kube/krt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -69,10 +71,16 @@ func newLegacyStatus() *legacyStatus {
 // all the errors specifically encountered
 type legacyStatusCache = map[types.NamespacedName]*legacyStatus
 
+// maps each translated HTTPRoute to the RouteOptions applied to it,
+// used to report the ancestor status of the RouteOptions which target HTTPRoutes
+type routeAttachments = map[types.NamespacedName]sets.Set[types.NamespacedName]
+
 type plugin struct {
 	gwQueries             gwquery.GatewayQueries
+	client                client.Client
 	rtOptQueries          rtoptquery.RouteOptionQueries
 	legacyStatusCache     legacyStatusCache
+	routeAttachments      routeAttachments
 	routeOptionCollection krt.Collection[*solokubev1.RouteOption]
 	statusReporter        reporter.StatusReporter
 }
@@ -86,8 +94,10 @@ func NewPlugin(
 	legacyStatusCache := make(legacyStatusCache)
 	return &plugin{
 		gwQueries:             gwQueries,
+		client:                client,
 		rtOptQueries:          rtoptquery.NewQuery(client),
 		legacyStatusCache:     legacyStatusCache,
+		routeAttachments:      make(routeAttachments),
 		routeOptionCollection: routeOptionCollection,
 		statusReporter:        statusReporter,
 	}
@@ -98,15 +108,25 @@ func (p *plugin) ApplyRoutePlugin(
 	routeCtx *plugins.RouteContext,
 	outputRoute *gloov1.Route,
 ) error {
+	// RouteOptions can only be attached to HTTPRoutes
+	if routeCtx.HTTPRoute == nil {
+		return nil
+	}
+
 	// check for RouteOptions applied to the given routeCtx
 	routeOptions, _, sources, err := p.handleAttachment(ctx, routeCtx)
 	if err != nil {
 		return err
 	}
+	p.trackRouteAttachments(client.ObjectKeyFromObject(routeCtx.HTTPRoute), sources)
 	if routeOptions == nil {
 		return nil
 	}
 
+	// Find the fields set by both the route rule and the RouteOption before merging,
+	// as the merge may modify routeOptions in place
+	conflicts := findRouteRuleConflicts(routeCtx.Rule, routeOptions, outputRoute.GetOptions())
+
 	merged, OptionsMergeResult := mergeOptionsForRoute(ctx, routeCtx.HTTPRoute, routeOptions, outputRoute.GetOptions())
 	if OptionsMergeResult == glooutils.OptionsMergedNone {
 		// No existing options merged into 'sources', so set the 'sources' on the outputRoute
@@ -116,6 +136,9 @@ func (p *plugin) ApplyRoutePlugin(
 		routeutils.AppendRouteSources(outputRoute, sources)
 	} // In case OptionsMergedFull, the correct sources are already set on the outputRoute
 
+	// Report any fields set on both the route rule and the attached RouteOption
+	reportRouteRuleConflicts(routeCtx, conflicts, outputRoute.GetOptions(), merged)
+
 	// Set the merged RouteOptions on the outputRoute
 	outputRoute.Options = merged
 
@@ -160,6 +183,113 @@ func mergeOptionsForRoute(
 	return glooutils.MergeRouteOptionsWithOverrides(dst, src, fieldsAllowedToOverride)
 }
 
+// routeRuleConflict describes a RouteOptions field that can also be set by a field of an HTTPRouteRule.
+type routeRuleConflict struct {
+	ruleField   string
+	optionField string
+	setByRule   func(rule *gwv1.HTTPRouteRule) bool
+	getOption   func(opts *gloov1.RouteOptions) proto.Message
+}
+
+var routeRuleConflicts = []routeRuleConflict{
+	{
+		ruleField:   "timeouts.request",
+		optionField: "timeout",
+		setByRule: func(rule *gwv1.HTTPRouteRule) bool {
+			return rule.Timeouts != nil && rule.Timeouts.Request != nil
+		},
+		getOption: func(opts *gloov1.RouteOptions) proto.Message { return opts.GetTimeout() },
+	},
+	{
+		ruleField:   "timeouts.backendRequest/retry",
+		optionField: "retries",
+		setByRule: func(rule *gwv1.HTTPRouteRule) bool {
+			return rule.Retry != nil || (rule.Timeouts != nil && rule.Timeouts.BackendRequest != nil)
+		},
+		getOption: func(opts *gloov1.RouteOptions) proto.Message { return opts.GetRetries() },
+	},
+	{
+		ruleField:   "sessionPersistence",
+		optionField: "lbHash",
+		setByRule: func(rule *gwv1.HTTPRouteRule) bool {
+			return rule.SessionPersistence != nil
+		},
+		getOption: func(opts *gloov1.RouteOptions) proto.Message { return opts.GetLbHash() },
+	},
+}
+
+// findRouteRuleConflicts returns the fields that are set to different values by the route rule
+// and the attached RouteOption.
+func findRouteRuleConflicts(
+	rule *gwv1.HTTPRouteRule,
+	routeOptions *gloov1.RouteOptions,
+	ruleOptions *gloov1.RouteOptions,
+) []routeRuleConflict {
+	if rule == nil {
+		return nil
+	}
+
+	var conflicts []routeRuleConflict
+	for _, conflict := range routeRuleConflicts {
+		if !conflict.setByRule(rule) {
+			continue
+		}
+		option, ruleOption := conflict.getOption(routeOptions), conflict.getOption(ruleOptions)
+		if !option.ProtoReflect().IsValid() || !ruleOption.ProtoReflect().IsValid() {
+			continue
+		}
+		if !proto.Equal(option, ruleOption) {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts
+}
+
+// reportRouteRuleConflicts sets the PolicyConflict condition on the route for the given conflicts.
+// The RouteOption takes precedence, unless the route enables overriding the field with the
+// wellknown.PolicyOverrideAnnotation, in which case the route rule takes precedence.
+func reportRouteRuleConflicts(
+	routeCtx *plugins.RouteContext,
+	conflicts []routeRuleConflict,
+	ruleOptions *gloov1.RouteOptions,
+	merged *gloov1.RouteOptions,
+) {
+	if len(conflicts) == 0 || routeCtx.Reporter == nil {
+		return
+	}
+
+	var overriddenRuleFields, overriddenOptionFields []string
+	for _, conflict := range conflicts {
+		if proto.Equal(conflict.getOption(ruleOptions), conflict.getOption(merged)) {
+			overriddenOptionFields = append(overriddenOptionFields, conflict.optionField)
+		} else {
+			overriddenRuleFields = append(overriddenRuleFields, conflict.ruleField)
+		}
+	}
+
+	rule := "route rule"
+	if routeCtx.Rule.Name != nil {
+		rule = fmt.Sprintf("route rule %q", *routeCtx.Rule.Name)
+	}
+	var messages []string
+	reason := reports.RouteReasonRouteRuleOverride
+	if len(overriddenRuleFields) > 0 {
+		reason = reports.RouteReasonRouteOptionOverride
+		messages = append(messages, fmt.Sprintf("%s fields [%s] are overridden by the attached RouteOption",
+			rule, strings.Join(overriddenRuleFields, ", ")))
+	}
+	if len(overriddenOptionFields) > 0 {
+		messages = append(messages, fmt.Sprintf("RouteOption fields [%s] are overridden by the %s",
+			strings.Join(overriddenOptionFields, ", "), rule))
+	}
+	routeCtx.Reporter.SetCondition(reports.RouteCondition{
+		Type:    reports.RouteConditionPolicyConflict,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: strings.Join(messages, "; "),
+	})
+}
+
 func (p *plugin) InitStatusPlugin(ctx context.Context, statusCtx *plugins.StatusContext) error {
 	for _, proxyWithReport := range statusCtx.ProxiesWithReports {
 		// now that we translate proxies one by one, we can't assume ApplyRoutePlugin is called before ApplyStatusPlugin for all proxies
@@ -198,6 +328,13 @@ func (p *plugin) MergeStatusPlugin(ctx context.Context, source any) error {
 		p.legacyStatusCache[cacheKey] = destStatus
 	}
 
+	for routeKey, applied := range sourceStatusPlugin.routeAttachments {
+		if _, ok := p.routeAttachments[routeKey]; !ok {
+			p.routeAttachments[routeKey] = sets.New[types.NamespacedName]()
+		}
+		p.routeAttachments[routeKey] = p.routeAttachments[routeKey].Union(applied)
+	}
+
 	return nil
 }
 
@@ -269,9 +406,108 @@ func (p *plugin) ApplyStatusPlugin(ctx context.Context, statusCtx *plugins.Statu
 			continue
 		}
 	}
+
+	p.reportPolicyAncestors(ctx, statusCtx.PolicyReports)
+
 	return multierr.ErrorOrNil()
 }
 
+// reportPolicyAncestors sets the Accepted condition of every RouteOption for each of its HTTPRoute targets:
+//
+//   - TargetNotFound when the HTTPRoute does not exist in the namespace of the RouteOption
+//   - Accepted when the RouteOption is applied to the HTTPRoute
+//   - Conflicted when other RouteOptions are applied to the HTTPRoute instead
+//
+// HTTPRoutes which are not translated are not reported.
+func (p *plugin) reportPolicyAncestors(ctx context.Context, policyReports reports.PolicyReportMap) {
+	if policyReports == nil {
+		return
+	}
+	for _, rtOpt := range p.routeOptionCollection.List() {
+		policyReport := policyReports.Policy(sologatewayv1.RouteOptionGVK.Kind, rtOpt)
+		policyKey := client.ObjectKeyFromObject(rtOpt)
+
+		for _, targetRef := range rtOpt.Spec.GetTargetRefs() {
+			if targetRef.GetGroup() != gwv1.GroupName || targetRef.GetKind() != wellknown.HTTPRouteKind {
+				continue
+			}
+			target := types.NamespacedName{Namespace: targetRef.GetNamespace().GetValue(), Name: targetRef.GetName()}
+			if target.Namespace == "" {
+				target.Namespace = rtOpt.GetNamespace()
+			}
+			ancestorKey := reports.PolicyAncestorKey{
+				ParentRefKey: reports.ParentRefKey{
+					Group:          targetRef.GetGroup(),
+					Kind:           targetRef.GetKind(),
+					NamespacedName: target,
+				},
+			}
+
+			if notFound := p.routeTargetNotFound(ctx, rtOpt, target); notFound != "" {
+				policyReport.Ancestor(ancestorKey).SetCondition(reports.PolicyCondition{
+					Type:    gwv1.PolicyConditionAccepted,
+					Status:  metav1.ConditionFalse,
+					Reason:  gwv1.PolicyReasonTargetNotFound,
+					Message: notFound,
+				})
+				continue
+			}
+
+			appliedOptions, translated := p.routeAttachments[target]
+			switch {
+			case !translated:
+				// the HTTPRoute is not translated, e.g. it is not attached to any Gateway, so the RouteOption has no effect on it yet
+				continue
+			case appliedOptions.Has(policyKey) || appliedOptions.Len() == 0:
+				policyReport.Ancestor(ancestorKey).SetCondition(reports.PolicyCondition{
+					Type:    gwv1.PolicyConditionAccepted,
+					Status:  metav1.ConditionTrue,
+					Reason:  gwv1.PolicyReasonAccepted,
+					Message: "RouteOption accepted",
+				})
+			default:
+				policyReport.Ancestor(ancestorKey).SetCondition(reports.PolicyCondition{
+					Type:   gwv1.PolicyConditionAccepted,
+					Status: metav1.ConditionFalse,
+					Reason: gwv1.PolicyReasonConflicted,
+					Message: fmt.Sprintf("RouteOption is not applied to the HTTPRoute due to conflict with extensionRef or older RouteOptions: %s",
+						utils.NamespacedNamesToString(appliedOptions)),
+				})
+			}
+		}
+	}
+}
+
+// routeTargetNotFound returns why the HTTPRoute targeted by the RouteOption is not found,
+// or an empty string if it exists
+func (p *plugin) routeTargetNotFound(ctx context.Context, rtOpt *solokubev1.RouteOption, target types.NamespacedName) string {
+	if target.Namespace != rtOpt.GetNamespace() {
+		return fmt.Sprintf("HTTPRoute %s must be in the namespace of the RouteOption", target)
+	}
+	err := p.client.Get(ctx, target, &gwv1.HTTPRoute{})
+	if apierrors.IsNotFound(err) {
+		return fmt.Sprintf("HTTPRoute %s not found", target)
+	}
+	// the HTTPRoute may exist on other errors, so it is not reported as not found
+	return ""
+}
+
+// tracks the RouteOptions applied to a translated HTTPRoute so we can report their ancestor status
+func (p *plugin) trackRouteAttachments(
+	routeKey types.NamespacedName,
+	sources []*gloov1.SourceMetadata_SourceRef,
+) {
+	if _, ok := p.routeAttachments[routeKey]; !ok {
+		p.routeAttachments[routeKey] = sets.New[types.NamespacedName]()
+	}
+	for _, source := range sources {
+		p.routeAttachments[routeKey].Insert(types.NamespacedName{
+			Namespace: source.GetResourceRef().GetNamespace(),
+			Name:      source.GetResourceRef().GetName(),
+		})
+	}
+}
+
 // tracks the attachment of a RouteOption so we know which RouteOptions to report status for
 func (p *plugin) trackAcceptedRouteOptions(
 	sources []*gloov1.SourceMetadata_SourceRef,
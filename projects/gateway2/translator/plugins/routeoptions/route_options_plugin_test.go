@@ -36,8 +36,10 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -736,6 +738,170 @@ var _ = Describe("RouteOptionsPlugin", func() {
 		})
 	})
 
+	Describe("Reporting the ancestor status of RouteOptions", func() {
+		routeAncestor := func(name string) reports.PolicyAncestorKey {
+			return reports.PolicyAncestorKey{
+				ParentRefKey: reports.ParentRefKey{
+					Group:          gwv1.GroupName,
+					Kind:           wellknown.HTTPRouteKind,
+					NamespacedName: types.NamespacedName{Namespace: "default", Name: name},
+				},
+			}
+		}
+		acceptedCondition := func(policyReports reports.PolicyReportMap, policyName string, ancestor reports.PolicyAncestorKey) *metav1.Condition {
+			policyReport := policyReports[reports.PolicyKey{
+				Kind:           sologatewayv1.RouteOptionGVK.Kind,
+				NamespacedName: types.NamespacedName{Namespace: "default", Name: policyName},
+			}]
+			Expect(policyReport).NotTo(BeNil())
+			Expect(policyReport.Ancestors).To(HaveKey(ancestor))
+			return meta.FindStatusCondition(policyReport.Ancestors[ancestor].Conditions, string(gwv1.PolicyConditionAccepted))
+		}
+
+		It("reports the oldest RouteOption as accepted and the newer one as conflicted", func() {
+			initCollections(attachedInternal(), attachedBeforeInternal())
+			deps := []client.Object{route(), attachedRouteOption(), attachedRouteOptionBefore()}
+			fakeClient := testutils.BuildIndexedFakeClient(deps, gwquery.IterateIndices, rtoptquery.IterateIndices)
+			gwQueries := testutils.BuildGatewayQueriesWithClient(fakeClient)
+			plugin := NewPlugin(gwQueries, fakeClient, routeOptionCollection, statusReporter)
+
+			err := plugin.ApplyRoutePlugin(ctx, &plugins.RouteContext{HTTPRoute: route()}, &v1.Route{Options: &v1.RouteOptions{}})
+			Expect(err).NotTo(HaveOccurred())
+
+			statusCtx := &plugins.StatusContext{PolicyReports: reports.NewPolicyReportMap()}
+			Expect(plugin.ApplyStatusPlugin(ctx, statusCtx)).To(Succeed())
+
+			accepted := acceptedCondition(statusCtx.PolicyReports, "policy-older", routeAncestor("route"))
+			Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+			Expect(accepted.Reason).To(Equal(string(gwv1.PolicyReasonAccepted)))
+
+			conflicted := acceptedCondition(statusCtx.PolicyReports, "policy", routeAncestor("route"))
+			Expect(conflicted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(conflicted.Reason).To(Equal(string(gwv1.PolicyReasonConflicted)))
+			Expect(conflicted.Message).To(ContainSubstring("default/policy-older"))
+		})
+
+		It("reports the RouteOption shadowed by an ExtensionRef filter as conflicted and the missing HTTPRoute as not found", func() {
+			filterInternal := proto.Clone(&routeOption().Spec).(*sologatewayv1.RouteOption)
+			filterInternal.Metadata = &core.Metadata{Name: "filter-policy", Namespace: "default"}
+			initCollections(filterInternal, attachedInternal(), nonAttachedInternal())
+			deps := []client.Object{routeWithFilter(), routeOption(), attachedRouteOption(), nonAttachedRouteOption()}
+			fakeClient := testutils.BuildIndexedFakeClient(deps, gwquery.IterateIndices, rtoptquery.IterateIndices)
+			gwQueries := testutils.BuildGatewayQueriesWithClient(fakeClient)
+			plugin := NewPlugin(gwQueries, fakeClient, routeOptionCollection, statusReporter)
+
+			routeCtx := &plugins.RouteContext{
+				HTTPRoute: routeWithFilter(),
+				Rule:      routeRuleWithExtRef(),
+			}
+			err := plugin.ApplyRoutePlugin(ctx, routeCtx, &v1.Route{Options: &v1.RouteOptions{}})
+			Expect(err).NotTo(HaveOccurred())
+
+			statusCtx := &plugins.StatusContext{PolicyReports: reports.NewPolicyReportMap()}
+			Expect(plugin.ApplyStatusPlugin(ctx, statusCtx)).To(Succeed())
+
+			conflicted := acceptedCondition(statusCtx.PolicyReports, "policy", routeAncestor("route"))
+			Expect(conflicted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(conflicted.Reason).To(Equal(string(gwv1.PolicyReasonConflicted)))
+			Expect(conflicted.Message).To(ContainSubstring("default/filter-policy"))
+
+			notFound := acceptedCondition(statusCtx.PolicyReports, "bad-policy", routeAncestor("bad-route"))
+			Expect(notFound.Status).To(Equal(metav1.ConditionFalse))
+			Expect(notFound.Reason).To(Equal(string(gwv1.PolicyReasonTargetNotFound)))
+			Expect(notFound.Message).To(Equal("HTTPRoute default/bad-route not found"))
+		})
+	})
+
+	Describe("HTTPRoute rule fields AND RouteOptions setting the same field", func() {
+		applyWithRuleTimeout := func(route *gwv1.HTTPRoute) (*v1.Route, *reports.ReportMap) {
+			timeoutRouteOption := routeOption()
+			timeoutRouteOption.Spec.Options.Timeout = durationpb.New(5 * time.Second)
+			deps := []client.Object{timeoutRouteOption}
+			fakeClient := testutils.BuildIndexedFakeClient(deps, gwquery.IterateIndices, rtoptquery.IterateIndices)
+			gwQueries := testutils.BuildGatewayQueriesWithClient(fakeClient)
+			plugin := NewPlugin(gwQueries, fakeClient, routeOptionCollection, statusReporter)
+
+			reportsMap := reports.NewReportMap()
+			reporter := reports.NewReporter(&reportsMap)
+
+			rule := routeRuleWithExtRef()
+			rule.Name = ptr.To(gwv1.SectionName("rule-0"))
+			rule.Timeouts = &gwv1.HTTPRouteTimeouts{
+				Request: ptr.To(gwv1.Duration("1s")),
+			}
+			routeCtx := &plugins.RouteContext{
+				HTTPRoute: route,
+				Rule:      rule,
+				Reporter:  reporter.Route(route).ParentRef(parentRef()),
+			}
+
+			// the timeout set by the route rule plugin
+			outputRoute := &v1.Route{
+				Options: &v1.RouteOptions{
+					Timeout: durationpb.New(time.Second),
+				},
+			}
+			err := plugin.ApplyRoutePlugin(context.Background(), routeCtx, outputRoute)
+			Expect(err).NotTo(HaveOccurred())
+			return outputRoute, &reportsMap
+		}
+
+		It("prefers the RouteOption and reports the conflict", func() {
+			route := routeWithFilter()
+			outputRoute, reportsMap := applyWithRuleTimeout(route)
+
+			Expect(outputRoute.GetOptions().GetTimeout().AsDuration()).To(Equal(5 * time.Second))
+
+			status := reportsMap.BuildRouteStatus(context.Background(), route, "")
+			Expect(status.Parents).To(HaveLen(1))
+			cond := meta.FindStatusCondition(status.Parents[0].Conditions, string(reports.RouteConditionPolicyConflict))
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(string(reports.RouteReasonRouteOptionOverride)))
+			Expect(cond.Message).To(Equal(`route rule "rule-0" fields [timeouts.request] are overridden by the attached RouteOption`))
+		})
+
+		It("prefers the route rule when the route allows overriding the field", func() {
+			route := routeWithFilter()
+			route.Annotations = map[string]string{wellknown.PolicyOverrideAnnotation: "timeout"}
+			outputRoute, reportsMap := applyWithRuleTimeout(route)
+
+			Expect(outputRoute.GetOptions().GetTimeout().AsDuration()).To(Equal(time.Second))
+
+			status := reportsMap.BuildRouteStatus(context.Background(), route, "")
+			Expect(status.Parents).To(HaveLen(1))
+			cond := meta.FindStatusCondition(status.Parents[0].Conditions, string(reports.RouteConditionPolicyConflict))
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal(string(reports.RouteReasonRouteRuleOverride)))
+			Expect(cond.Message).To(Equal(`RouteOption fields [timeout] are overridden by the route rule "rule-0"`))
+		})
+
+		It("does not report a conflict when only the RouteOption sets the field", func() {
+			route := routeWithFilter()
+			reportsMap := reports.NewReportMap()
+			reporter := reports.NewReporter(&reportsMap)
+
+			timeoutRouteOption := routeOption()
+			timeoutRouteOption.Spec.Options.Timeout = durationpb.New(5 * time.Second)
+			fakeClient := testutils.BuildIndexedFakeClient([]client.Object{timeoutRouteOption}, gwquery.IterateIndices, rtoptquery.IterateIndices)
+			plugin := NewPlugin(testutils.BuildGatewayQueriesWithClient(fakeClient), fakeClient, routeOptionCollection, statusReporter)
+
+			routeCtx := &plugins.RouteContext{
+				HTTPRoute: route,
+				Rule:      routeRuleWithExtRef(),
+				Reporter:  reporter.Route(route).ParentRef(parentRef()),
+			}
+			outputRoute := &v1.Route{
+				Options: &v1.RouteOptions{},
+			}
+			Expect(plugin.ApplyRoutePlugin(context.Background(), routeCtx, outputRoute)).To(Succeed())
+
+			status := reportsMap.BuildRouteStatus(context.Background(), route, "")
+			Expect(status.Parents).To(HaveLen(1))
+			Expect(meta.FindStatusCondition(status.Parents[0].Conditions, string(reports.RouteConditionPolicyConflict))).To(BeNil())
+		})
+	})
+
 	Describe("MergeStatusPlugin", func() {
 		var plugin1 *plugin
 		var plugin2 *plugin
@@ -0,0 +1,259 @@
+package routerule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/gloo/projects/gateway2/query"
+	"github.com/solo-io/gloo/projects/gateway2/reports"
+	"github.com/solo-io/gloo/projects/gateway2/translator/backendref"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+)
+
+const (
+	// DefaultSessionName is the cookie or header name used for session persistence
+	// when the rule does not specify a sessionName.
+	DefaultSessionName = "gloo-session"
+
+	// retryOnConnectionErrors are the Envoy retry conditions used for every Gateway API retry,
+	// as the spec expects connection errors to be retried whenever a retry stanza is configured.
+	retryOnConnectionErrors = "connect-failure,refused-stream,reset"
+	// retryOnStatusCodes is the Envoy retry condition that enables retriable_status_codes.
+	retryOnStatusCodes = "retriable-status-codes"
+)
+
+var _ plugins.RoutePlugin = &plugin{}
+
+// plugin translates the Timeouts, Retry and SessionPersistence fields of a route rule
+// into the equivalent RouteOptions. It runs before the RouteOption plugin so that an
+// attached RouteOption is merged on top of the options derived from the rule.
+type plugin struct {
+	queries query.GatewayQueries
+}
+
+func NewPlugin(queries query.GatewayQueries) *plugin {
+	return &plugin{
+		queries,
+	}
+}
+
+func (p *plugin) ApplyRoutePlugin(
+	ctx context.Context,
+	routeCtx *plugins.RouteContext,
+	outputRoute *v1.Route,
+) error {
+	rule := routeCtx.Rule
+	if rule == nil {
+		return nil
+	}
+
+	if outputRoute.GetOptions() == nil {
+		outputRoute.Options = &v1.RouteOptions{}
+	}
+	options := outputRoute.GetOptions()
+
+	if err := applyTimeouts(rule.Timeouts, options); err != nil {
+		return err
+	}
+	if err := applyRetry(rule.Retry, options); err != nil {
+		return err
+	}
+	if err := applySessionPersistence(rule.SessionPersistence, routeCtx.Match, options); err != nil {
+		return err
+	}
+	if rule.SessionPersistence != nil {
+		p.reportIgnoredSessionPersistence(ctx, routeCtx)
+	}
+	return nil
+}
+
+// applyTimeouts maps the request timeout to the route timeout and the backend request
+// timeout to the per-try timeout of the route's retry policy.
+func applyTimeouts(timeouts *gwv1.HTTPRouteTimeouts, options *v1.RouteOptions) error {
+	if timeouts == nil {
+		return nil
+	}
+
+	if timeouts.Request != nil {
+		timeout, err := parseDuration(*timeouts.Request)
+		if err != nil {
+			return errors.Wrap(err, "invalid request timeout")
+		}
+		options.Timeout = timeout
+	}
+
+	if timeouts.BackendRequest != nil {
+		perTryTimeout, err := parseDuration(*timeouts.BackendRequest)
+		if err != nil {
+			return errors.Wrap(err, "invalid backendRequest timeout")
+		}
+		if options.GetRetries() == nil {
+			options.Retries = &retries.RetryPolicy{}
+		}
+		options.GetRetries().PerTryTimeout = perTryTimeout
+	}
+	return nil
+}
+
+// applyRetry maps the retry stanza of a rule onto the route's retry policy.
+// Connection errors are always retried; responses are only retried for the listed codes.
+// Setting attempts to 0 disables retries.
+func applyRetry(retry *gwv1.HTTPRouteRetry, options *v1.RouteOptions) error {
+	if retry == nil {
+		return nil
+	}
+
+	if retry.Attempts != nil && *retry.Attempts == 0 {
+		// Keep the retry policy only if it is needed for the backend request timeout
+		if options.GetRetries().GetPerTryTimeout() == nil {
+			options.Retries = nil
+		}
+		return nil
+	}
+
+	if options.GetRetries() == nil {
+		options.Retries = &retries.RetryPolicy{}
+	}
+	policy := options.GetRetries()
+
+	retryOn := []string{retryOnConnectionErrors}
+	if len(retry.Codes) > 0 {
+		retryOn = append(retryOn, retryOnStatusCodes)
+		policy.RetriableStatusCodes = make([]uint32, 0, len(retry.Codes))
+		for _, code := range retry.Codes {
+			policy.RetriableStatusCodes = append(policy.RetriableStatusCodes, uint32(code))
+		}
+	}
+	policy.RetryOn = strings.Join(retryOn, ",")
+
+	if retry.Attempts != nil {
+		policy.NumRetries = uint32(*retry.Attempts)
+	}
+
+	if retry.Backoff != nil {
+		backoff, err := parseDuration(*retry.Backoff)
+		if err != nil {
+			return errors.Wrap(err, "invalid retry backoff")
+		}
+		policy.RetryBackOff = &retries.RetryBackOff{
+			BaseInterval: backoff,
+		}
+	}
+	return nil
+}
+
+// applySessionPersistence maps session persistence onto a consistent hash policy for the route.
+// Cookie-based persistence hashes on a cookie that Envoy generates when it is missing from the request;
+// header-based persistence hashes on the named request header.
+// Hash policies only take effect for upstreams that use a consistent hashing load balancer
+// (RingHash or Maglev). The idleTimeout field is not supported.
+func applySessionPersistence(
+	sessionPersistence *gwv1.SessionPersistence,
+	match *gwv1.HTTPRouteMatch,
+	options *v1.RouteOptions,
+) error {
+	if sessionPersistence == nil {
+		return nil
+	}
+
+	name := DefaultSessionName
+	if sessionPersistence.SessionName != nil && *sessionPersistence.SessionName != "" {
+		name = *sessionPersistence.SessionName
+	}
+
+	policy := &lbhash.HashPolicy{Terminal: true}
+	if sessionPersistence.Type != nil && *sessionPersistence.Type == gwv1.HeaderBasedSessionPersistence {
+		policy.KeyType = &lbhash.HashPolicy_Header{
+			Header: name,
+		}
+	} else {
+		// A zero TTL makes Envoy generate a session cookie
+		ttl := durationpb.New(0)
+		if sessionPersistence.CookieConfig != nil &&
+			sessionPersistence.CookieConfig.LifetimeType != nil &&
+			*sessionPersistence.CookieConfig.LifetimeType == gwv1.PermanentCookieLifetimeType {
+			if sessionPersistence.AbsoluteTimeout == nil {
+				return errors.New("absoluteTimeout must be set for permanent session persistence cookies")
+			}
+			var err error
+			ttl, err = parseDuration(*sessionPersistence.AbsoluteTimeout)
+			if err != nil {
+				return errors.Wrap(err, "invalid session persistence absoluteTimeout")
+			}
+		}
+		policy.KeyType = &lbhash.HashPolicy_Cookie{
+			Cookie: &lbhash.Cookie{
+				Name: name,
+				Ttl:  ttl,
+				Path: cookiePath(match),
+			},
+		}
+	}
+
+	options.LbHash = &lbhash.RouteActionHashConfig{
+		HashPolicies: []*lbhash.HashPolicy{policy},
+	}
+	return nil
+}
+
+// cookiePath returns the path that session cookies are scoped to. The spec expects the cookie
+// to be scoped to the path of the route match, which is only possible for exact and prefix matches.
+func cookiePath(match *gwv1.HTTPRouteMatch) string {
+	if match == nil || match.Path == nil || match.Path.Value == nil {
+		return "/"
+	}
+	if match.Path.Type != nil && *match.Path.Type == gwv1.PathMatchRegularExpression {
+		return "/"
+	}
+	return *match.Path.Value
+}
+
+// reportIgnoredSessionPersistence sets a condition on the route when a backend of the rule does not use
+// a consistent hashing load balancer, as Envoy ignores the hash policy of the route for such backends.
+func (p *plugin) reportIgnoredSessionPersistence(ctx context.Context, routeCtx *plugins.RouteContext) {
+	var backends []string
+	for _, backendRef := range routeCtx.Rule.BackendRefs {
+		obj, err := p.queries.GetBackendForRef(ctx, p.queries.ObjToFrom(routeCtx.RouteObject()), &backendRef.BackendObjectReference)
+		if err != nil {
+			// unresolved backends are reported by the route translator
+			continue
+		}
+		upstream := backendref.ToUpstream(ctx, obj, backendRef.Port)
+		if upstream == nil {
+			continue
+		}
+		lb := upstream.GetLoadBalancerConfig()
+		if lb.GetRingHash() == nil && lb.GetMaglev() == nil {
+			backends = append(backends, fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName()))
+		}
+	}
+	if len(backends) == 0 {
+		return
+	}
+
+	routeCtx.Reporter.SetCondition(reports.RouteCondition{
+		Type:   reports.RouteConditionSessionPersistenceIgnored,
+		Status: metav1.ConditionTrue,
+		Reason: reports.RouteReasonNoConsistentHashLoadBalancer,
+		Message: fmt.Sprintf("session persistence requires a RingHash or Maglev load balancer, which backends [%s] do not use",
+			strings.Join(backends, ", ")),
+	})
+}
+
+func parseDuration(d gwv1.Duration) (*durationpb.Duration, error) {
+	parsed, err := time.ParseDuration(string(d))
+	if err != nil {
+		return nil, err
+	}
+	return durationpb.New(parsed), nil
+}
@@ -0,0 +1,302 @@
+package routerule_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/solo-io/gloo/projects/gateway2/query/mocks"
+	"github.com/solo-io/gloo/projects/gateway2/reports"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/routerule"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/apis/gloo.solo.io/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+)
+
+var _ = DescribeTable(
+	"RouteRulePlugin",
+	func(rule gwv1.HTTPRouteRule, match *gwv1.HTTPRouteMatch, expectedOptions *v1.RouteOptions) {
+		rtCtx := &plugins.RouteContext{
+			HTTPRoute: &gwv1.HTTPRoute{},
+			Rule:      &rule,
+			Match:     match,
+		}
+		outputRoute := &v1.Route{
+			Options: &v1.RouteOptions{},
+		}
+		err := routerule.NewPlugin(nil).ApplyRoutePlugin(context.Background(), rtCtx, outputRoute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proto.Equal(outputRoute.GetOptions(), expectedOptions)).To(BeTrue(), "got %v", outputRoute.GetOptions())
+	},
+	Entry(
+		"does nothing when no fields are set",
+		gwv1.HTTPRouteRule{},
+		nil,
+		&v1.RouteOptions{},
+	),
+	Entry(
+		"applies request and backend request timeouts",
+		gwv1.HTTPRouteRule{
+			Timeouts: &gwv1.HTTPRouteTimeouts{
+				Request:        ptr.To(gwv1.Duration("10s")),
+				BackendRequest: ptr.To(gwv1.Duration("500ms")),
+			},
+		},
+		nil,
+		&v1.RouteOptions{
+			Timeout: durationpb.New(10 * time.Second),
+			Retries: &retries.RetryPolicy{
+				PerTryTimeout: durationpb.New(500 * time.Millisecond),
+			},
+		},
+	),
+	Entry(
+		"applies retries with status codes and backoff",
+		gwv1.HTTPRouteRule{
+			Timeouts: &gwv1.HTTPRouteTimeouts{
+				BackendRequest: ptr.To(gwv1.Duration("1s")),
+			},
+			Retry: &gwv1.HTTPRouteRetry{
+				Codes:    []gwv1.HTTPRouteRetryStatusCode{502, 503},
+				Attempts: ptr.To(3),
+				Backoff:  ptr.To(gwv1.Duration("100ms")),
+			},
+		},
+		nil,
+		&v1.RouteOptions{
+			Retries: &retries.RetryPolicy{
+				RetryOn:              "connect-failure,refused-stream,reset,retriable-status-codes",
+				RetriableStatusCodes: []uint32{502, 503},
+				NumRetries:           3,
+				PerTryTimeout:        durationpb.New(time.Second),
+				RetryBackOff: &retries.RetryBackOff{
+					BaseInterval: durationpb.New(100 * time.Millisecond),
+				},
+			},
+		},
+	),
+	Entry(
+		"disables retries when attempts is 0",
+		gwv1.HTTPRouteRule{
+			Retry: &gwv1.HTTPRouteRetry{
+				Codes:    []gwv1.HTTPRouteRetryStatusCode{503},
+				Attempts: ptr.To(0),
+			},
+		},
+		nil,
+		&v1.RouteOptions{},
+	),
+	Entry(
+		"applies session cookie persistence scoped to the path match",
+		gwv1.HTTPRouteRule{
+			SessionPersistence: &gwv1.SessionPersistence{
+				SessionName: ptr.To("my-session"),
+			},
+		},
+		&gwv1.HTTPRouteMatch{
+			Path: &gwv1.HTTPPathMatch{
+				Type:  ptr.To(gwv1.PathMatchPathPrefix),
+				Value: ptr.To("/app"),
+			},
+		},
+		&v1.RouteOptions{
+			LbHash: &lbhash.RouteActionHashConfig{
+				HashPolicies: []*lbhash.HashPolicy{{
+					KeyType: &lbhash.HashPolicy_Cookie{
+						Cookie: &lbhash.Cookie{
+							Name: "my-session",
+							Ttl:  durationpb.New(0),
+							Path: "/app",
+						},
+					},
+					Terminal: true,
+				}},
+			},
+		},
+	),
+	Entry(
+		"applies permanent cookie persistence",
+		gwv1.HTTPRouteRule{
+			SessionPersistence: &gwv1.SessionPersistence{
+				Type:            ptr.To(gwv1.CookieBasedSessionPersistence),
+				AbsoluteTimeout: ptr.To(gwv1.Duration("1h")),
+				CookieConfig: &gwv1.CookieConfig{
+					LifetimeType: ptr.To(gwv1.PermanentCookieLifetimeType),
+				},
+			},
+		},
+		nil,
+		&v1.RouteOptions{
+			LbHash: &lbhash.RouteActionHashConfig{
+				HashPolicies: []*lbhash.HashPolicy{{
+					KeyType: &lbhash.HashPolicy_Cookie{
+						Cookie: &lbhash.Cookie{
+							Name: routerule.DefaultSessionName,
+							Ttl:  durationpb.New(time.Hour),
+							Path: "/",
+						},
+					},
+					Terminal: true,
+				}},
+			},
+		},
+	),
+	Entry(
+		"applies header persistence",
+		gwv1.HTTPRouteRule{
+			SessionPersistence: &gwv1.SessionPersistence{
+				Type:        ptr.To(gwv1.HeaderBasedSessionPersistence),
+				SessionName: ptr.To("x-session"),
+			},
+		},
+		nil,
+		&v1.RouteOptions{
+			LbHash: &lbhash.RouteActionHashConfig{
+				HashPolicies: []*lbhash.HashPolicy{{
+					KeyType: &lbhash.HashPolicy_Header{
+						Header: "x-session",
+					},
+					Terminal: true,
+				}},
+			},
+		},
+	),
+)
+
+var _ = Describe("RouteRulePlugin errors", func() {
+	It("errors on a permanent cookie without an absolute timeout", func() {
+		rtCtx := &plugins.RouteContext{
+			HTTPRoute: &gwv1.HTTPRoute{},
+			Rule: &gwv1.HTTPRouteRule{
+				SessionPersistence: &gwv1.SessionPersistence{
+					CookieConfig: &gwv1.CookieConfig{
+						LifetimeType: ptr.To(gwv1.PermanentCookieLifetimeType),
+					},
+				},
+			},
+		}
+		err := routerule.NewPlugin(nil).ApplyRoutePlugin(context.Background(), rtCtx, &v1.Route{})
+		Expect(err).To(MatchError(ContainSubstring("absoluteTimeout must be set")))
+	})
+})
+
+var _ = Describe("RouteRulePlugin session persistence status", func() {
+	var (
+		ctrl       *gomock.Controller
+		queries    *mocks.MockGatewayQueries
+		rt         *gwv1.HTTPRoute
+		reportsMap reports.ReportMap
+		rtCtx      *plugins.RouteContext
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		queries = mocks.NewMockGatewayQueries(ctrl)
+		rt = &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "route",
+				Namespace: "default",
+			},
+		}
+		reportsMap = reports.NewReportMap()
+		rtCtx = &plugins.RouteContext{
+			HTTPRoute: rt,
+			Rule: &gwv1.HTTPRouteRule{
+				SessionPersistence: &gwv1.SessionPersistence{},
+				BackendRefs: []gwv1.HTTPBackendRef{{
+					BackendRef: gwv1.BackendRef{
+						BackendObjectReference: gwv1.BackendObjectReference{
+							Name: "backend",
+							Port: ptr.To(gwv1.PortNumber(8080)),
+						},
+					},
+				}},
+			},
+			Reporter: reports.NewReporter(&reportsMap).Route(rt).ParentRef(&gwv1.ParentReference{
+				Name: "gw",
+			}),
+		}
+		queries.EXPECT().ObjToFrom(rt).Return(nil)
+	})
+
+	sessionPersistenceIgnored := func() *metav1.Condition {
+		status := reportsMap.BuildRouteStatus(context.Background(), rt, "")
+		Expect(status.Parents).To(HaveLen(1))
+		return meta.FindStatusCondition(status.Parents[0].Conditions, string(reports.RouteConditionSessionPersistenceIgnored))
+	}
+
+	It("reports services without a consistent hashing load balancer", func() {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backend",
+				Namespace: "default",
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Port: 8080}},
+			},
+		}
+		queries.EXPECT().GetBackendForRef(context.Background(), gomock.Any(), gomock.Any()).Return(svc, nil)
+
+		err := routerule.NewPlugin(queries).ApplyRoutePlugin(context.Background(), rtCtx, &v1.Route{})
+		Expect(err).NotTo(HaveOccurred())
+
+		condition := sessionPersistenceIgnored()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(BeEquivalentTo(reports.RouteReasonNoConsistentHashLoadBalancer))
+		Expect(condition.Message).To(ContainSubstring("default/backend"))
+	})
+
+	It("does not report services annotated with a ring hash load balancer", func() {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backend",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"gloo.solo.io/upstream_config": `{"loadBalancerConfig": {"ringHash": {}}}`,
+				},
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Port: 8080}},
+			},
+		}
+		queries.EXPECT().GetBackendForRef(context.Background(), gomock.Any(), gomock.Any()).Return(svc, nil)
+
+		err := routerule.NewPlugin(queries).ApplyRoutePlugin(context.Background(), rtCtx, &v1.Route{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessionPersistenceIgnored()).To(BeNil())
+	})
+
+	It("does not report upstreams with a maglev load balancer", func() {
+		us := &gloov1.Upstream{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backend",
+				Namespace: "default",
+			},
+			Spec: v1.Upstream{
+				LoadBalancerConfig: &v1.LoadBalancerConfig{
+					Type: &v1.LoadBalancerConfig_Maglev_{
+						Maglev: &v1.LoadBalancerConfig_Maglev{},
+					},
+				},
+			},
+		}
+		queries.EXPECT().GetBackendForRef(context.Background(), gomock.Any(), gomock.Any()).Return(us, nil)
+
+		err := routerule.NewPlugin(queries).ApplyRoutePlugin(context.Background(), rtCtx, &v1.Route{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessionPersistenceIgnored()).To(BeNil())
+	})
+})
@@ -0,0 +1,13 @@
+package routerule_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRouteRule(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RouteRule Suite")
+}
@@ -101,7 +101,7 @@ var ErrTypesNotEqual = fmt.Errorf("types not equal")
 // A nil error indicates success and `obj` should be usable as normal.
 func GetExtensionRefObj[T client.Object](
 	ctx context.Context,
-	route *gwv1.HTTPRoute,
+	route client.Object,
 	queries query.GatewayQueries,
 	extensionRef *gwv1.LocalObjectReference,
 ) (T, error) {
@@ -0,0 +1,204 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gateway2/reports"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	apixv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+)
+
+// ListenerKey identifies a translated listener by its Gateway, and by its ListenerSet when it belongs to one
+type ListenerKey struct {
+	Gateway     types.NamespacedName
+	ListenerSet types.NamespacedName
+	Listener    string
+}
+
+func NewListenerKey(listenerCtx *plugins.ListenerContext) ListenerKey {
+	key := ListenerKey{
+		Gateway:  client.ObjectKeyFromObject(listenerCtx.Gateway),
+		Listener: string(listenerCtx.GwListener.Name),
+	}
+	if listenerCtx.ListenerSet != nil {
+		key.ListenerSet = client.ObjectKeyFromObject(listenerCtx.ListenerSet)
+	}
+	return key
+}
+
+// ListenerAttachments holds the policies applied to each translated listener.
+// It is used to report the ancestor status of the policies which target Gateways and ListenerSets.
+type ListenerAttachments map[ListenerKey]sets.Set[types.NamespacedName]
+
+// Track records that the listener was translated with the given policies applied to it
+func (a ListenerAttachments) Track(key ListenerKey, applied ...client.Object) {
+	if _, ok := a[key]; !ok {
+		a[key] = sets.New[types.NamespacedName]()
+	}
+	for _, obj := range applied {
+		a[key].Insert(client.ObjectKeyFromObject(obj))
+	}
+}
+
+// Merge adds the attachments of other to the attachments
+func (a ListenerAttachments) Merge(other ListenerAttachments) {
+	for key, applied := range other {
+		if _, ok := a[key]; !ok {
+			a[key] = sets.New[types.NamespacedName]()
+		}
+		a[key] = a[key].Union(applied)
+	}
+}
+
+// ReportListenerPolicyAncestors sets the Accepted condition of the policy for each of its Gateway and ListenerSet targets:
+//
+//   - TargetNotFound when the target, or its listener selected by section name, does not exist
+//   - Accepted when the policy is applied to at least one of the targeted listeners
+//   - Conflicted when other policies of higher priority are applied to all the targeted listeners instead
+//
+// Targets without any translated listener are not reported.
+func ReportListenerPolicyAncestors(
+	ctx context.Context,
+	c client.Client,
+	policyKind string,
+	policy client.Object,
+	targetRefs []*skv2corev1.PolicyTargetReferenceWithSectionName,
+	attachments ListenerAttachments,
+	policyReports reports.PolicyReportMap,
+) {
+	policyReport := policyReports.Policy(policyKind, policy)
+	policyKey := client.ObjectKeyFromObject(policy)
+
+	for _, targetRef := range targetRefs {
+		isGateway := targetRef.GetGroup() == gwv1.GroupName && targetRef.GetKind() == wellknown.GatewayKind
+		isListenerSet := targetRef.GetGroup() == apixv1a1.GroupName && targetRef.GetKind() == wellknown.XListenerSetKind
+		if !isGateway && !isListenerSet {
+			continue
+		}
+
+		target := types.NamespacedName{Namespace: targetRef.GetNamespace().GetValue(), Name: targetRef.GetName()}
+		if target.Namespace == "" {
+			target.Namespace = policy.GetNamespace()
+		}
+		sectionName := targetRef.GetSectionName().GetValue()
+		ancestorKey := reports.PolicyAncestorKey{
+			ParentRefKey: reports.ParentRefKey{
+				Group:          targetRef.GetGroup(),
+				Kind:           targetRef.GetKind(),
+				NamespacedName: target,
+			},
+			SectionName: sectionName,
+		}
+
+		if notFound := listenerTargetNotFound(ctx, c, policyKind, policy, target, isGateway, sectionName); notFound != "" {
+			policyReport.Ancestor(ancestorKey).SetCondition(reports.PolicyCondition{
+				Type:    gwv1.PolicyConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.PolicyReasonTargetNotFound,
+				Message: notFound,
+			})
+			continue
+		}
+
+		translated := false
+		appliedPolicies := sets.New[types.NamespacedName]()
+		for key, applied := range attachments {
+			if isGateway && key.Gateway != target || isListenerSet && key.ListenerSet != target {
+				continue
+			}
+			if sectionName != "" && key.Listener != sectionName {
+				continue
+			}
+			translated = true
+			appliedPolicies = appliedPolicies.Union(applied)
+		}
+
+		switch {
+		case !translated:
+			// the target is not translated, e.g. it is not accepted, so the policy has no effect on it yet
+			continue
+		case appliedPolicies.Has(policyKey) || appliedPolicies.Len() == 0:
+			policyReport.Ancestor(ancestorKey).SetCondition(reports.PolicyCondition{
+				Type:    gwv1.PolicyConditionAccepted,
+				Status:  metav1.ConditionTrue,
+				Reason:  gwv1.PolicyReasonAccepted,
+				Message: fmt.Sprintf("%s accepted", policyKind),
+			})
+		default:
+			policyReport.Ancestor(ancestorKey).SetCondition(reports.PolicyCondition{
+				Type:   gwv1.PolicyConditionAccepted,
+				Status: metav1.ConditionFalse,
+				Reason: gwv1.PolicyReasonConflicted,
+				Message: fmt.Sprintf("%s is not applied to any targeted listener due to conflict with more specific or older %ss: %s",
+					policyKind, policyKind, NamespacedNamesToString(appliedPolicies)),
+			})
+		}
+	}
+}
+
+// listenerTargetNotFound returns why the Gateway or ListenerSet targeted by the policy is not found,
+// or an empty string if it exists
+func listenerTargetNotFound(
+	ctx context.Context,
+	c client.Client,
+	policyKind string,
+	policy client.Object,
+	target types.NamespacedName,
+	isGateway bool,
+	sectionName string,
+) string {
+	kind := wellknown.XListenerSetKind
+	if isGateway {
+		kind = wellknown.GatewayKind
+	}
+	if target.Namespace != policy.GetNamespace() {
+		return fmt.Sprintf("%s %s must be in the namespace of the %s", kind, target, policyKind)
+	}
+
+	var listenerNames []gwv1.SectionName
+	var err error
+	if isGateway {
+		gw := &gwv1.Gateway{}
+		err = c.Get(ctx, target, gw)
+		for _, l := range gw.Spec.Listeners {
+			listenerNames = append(listenerNames, l.Name)
+		}
+	} else {
+		ls := &apixv1a1.XListenerSet{}
+		err = c.Get(ctx, target, ls)
+		for _, l := range ls.Spec.Listeners {
+			listenerNames = append(listenerNames, l.Name)
+		}
+	}
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Sprintf("%s %s not found", kind, target)
+	case err != nil:
+		// the target may exist, so it is not reported as not found
+		return ""
+	case sectionName != "" && !slices.Contains(listenerNames, gwv1.SectionName(sectionName)):
+		return fmt.Sprintf("listener %s not found in %s %s", sectionName, kind, target)
+	}
+	return ""
+}
+
+// NamespacedNamesToString returns the sorted, comma separated names of the given objects
+func NamespacedNamesToString(nns sets.Set[types.NamespacedName]) string {
+	names := make([]string, 0, nns.Len())
+	for nn := range nns {
+		names = append(names, nn.String())
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
@@ -19,6 +19,7 @@ import (
 	gatewaykubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
 	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
 	gwquery "github.com/solo-io/gloo/projects/gateway2/query"
+	"github.com/solo-io/gloo/projects/gateway2/reports"
 	"github.com/solo-io/gloo/projects/gateway2/translator/listenerutils"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/utils"
@@ -39,8 +40,10 @@ var (
 
 type plugin struct {
 	gwQueries                   gwquery.GatewayQueries
+	client                      client.Client
 	vhOptQueries                vhoptquery.VirtualHostOptionQueries
 	classicStatusCache          classicStatusCache // The lifecycle of this cache is that of the plugin with the assumption that plugins are rebuilt on every translation
+	listenerAttachments         utils.ListenerAttachments
 	virtualHostOptionCollection krt.Collection[*gatewaykubev1.VirtualHostOption]
 	statusReporter              reporter.StatusReporter
 }
@@ -88,10 +91,12 @@ func NewPlugin(
 ) *plugin {
 	return &plugin{
 		gwQueries:                   gwQueries,
+		client:                      client,
 		vhOptQueries:                vhoptquery.NewQuery(client),
 		virtualHostOptionCollection: virtualHostOptionCollection,
 		statusReporter:              statusReporter,
 		classicStatusCache:          make(map[types.NamespacedName]*classicStatus),
+		listenerAttachments:         make(utils.ListenerAttachments),
 	}
 }
 
@@ -100,7 +105,12 @@ func (p *plugin) ApplyListenerPlugin(
 	listenerCtx *plugins.ListenerContext,
 	outListener *v1.Listener,
 ) error {
-	// Currently we only create AggregateListeners in k8s gateway translation.
+	// UdpListeners have no virtual hosts to apply options to.
+	if outListener.GetUdpListener() != nil {
+		return nil
+	}
+
+	// Apart from UdpListeners, we only create AggregateListeners in k8s gateway translation.
 	// If that ever changes, we will need to handle other listener types more gracefully here.
 	aggListener := outListener.GetAggregateListener()
 	if aggListener == nil {
@@ -114,7 +124,9 @@ func (p *plugin) ApplyListenerPlugin(
 		return err
 	}
 
+	listenerKey := utils.NewListenerKey(listenerCtx)
 	if len(attachedOptions) == 0 {
+		p.listenerAttachments.Track(listenerKey)
 		return nil
 	}
 
@@ -148,6 +160,7 @@ func (p *plugin) ApplyListenerPlugin(
 		// since we don't have any additional details to append, we just need to make sure the
 		// cache entry exists
 		p.classicStatusCache.getOrCreateEntry(client.ObjectKeyFromObject(opt))
+		p.listenerAttachments.Track(listenerKey, opt)
 	}
 
 	for _, opt := range optionsIgnored {
@@ -209,6 +222,7 @@ func (p *plugin) MergeStatusPlugin(ctx context.Context, source any) error {
 
 		p.classicStatusCache[key] = destStatus
 	}
+	p.listenerAttachments.Merge(sourceStatusPlugin.listenerAttachments)
 
 	return nil
 }
@@ -289,9 +303,23 @@ func (p *plugin) ApplyStatusPlugin(ctx context.Context, statusCtx *plugins.Statu
 		}
 
 	}
+
+	p.reportPolicyAncestors(ctx, statusCtx.PolicyReports)
+
 	return multierr.ErrorOrNil()
 }
 
+// reportPolicyAncestors reports the Gateway API ancestor status of every VirtualHostOption for each of its targets
+func (p *plugin) reportPolicyAncestors(ctx context.Context, policyReports reports.PolicyReportMap) {
+	if policyReports == nil {
+		return
+	}
+	for _, vhOpt := range p.virtualHostOptionCollection.List() {
+		utils.ReportListenerPolicyAncestors(ctx, p.client, sologatewayv1.VirtualHostOptionGVK.Kind, vhOpt,
+			vhOpt.Spec.GetTargetRefs(), p.listenerAttachments, policyReports)
+	}
+}
+
 // given a ProxyReport, extract and aggregate all VirtualHost errors that have VirtualHostOption source metadata
 // and key them by the source VirtualHostOption NamespacedName
 func extractVirtualHostErrors(proxyReport *validation.ProxyReport) map[types.NamespacedName][]*validation.VirtualHostReport_Error {
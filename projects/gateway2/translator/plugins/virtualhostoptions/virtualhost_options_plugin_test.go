@@ -15,6 +15,7 @@ import (
 	gatewaykubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
 	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
 	gwquery "github.com/solo-io/gloo/projects/gateway2/query"
+	"github.com/solo-io/gloo/projects/gateway2/reports"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/utils"
 	vhoptquery "github.com/solo-io/gloo/projects/gateway2/translator/plugins/virtualhostoptions/query"
@@ -27,9 +28,12 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,6 +50,7 @@ var _ = Describe("VirtualHostOptions Plugin", func() {
 			listenerCtx     *plugins.ListenerContext
 			outputListener  *v1.Listener
 			expectedOptions *v1.VirtualHostOptions
+			vhOptionClient  sologatewayv1.VirtualHostOptionClient
 		)
 		BeforeEach(func() {
 			ctx = context.Background()
@@ -86,7 +91,7 @@ var _ = Describe("VirtualHostOptions Plugin", func() {
 				Cache: memory.NewInMemoryResourceCache(),
 			}
 
-			vhOptionClient, _ := sologatewayv1.NewVirtualHostOptionClient(ctx, resourceClientFactory)
+			vhOptionClient, _ = sologatewayv1.NewVirtualHostOptionClient(ctx, resourceClientFactory)
 			vhOptionCollection := krt.NewStatic[*gatewaykubev1.VirtualHostOption](nil, true)
 			statusClient := statusutils.GetStatusClientForNamespace("gloo-system")
 			statusReporter := reporter.NewReporter(defaults.KubeGatewayReporter, statusClient, vhOptionClient.BaseClient())
@@ -201,6 +206,66 @@ var _ = Describe("VirtualHostOptions Plugin", func() {
 			})
 		})
 
+		When("reporting the ancestor status of the VirtualHostOptions", func() {
+			var vhOptionCollection krt.Collection[*solokubev1.VirtualHostOption]
+
+			BeforeEach(func() {
+				gw := listenerCtx.Gateway.DeepCopy()
+				gw.Spec.Listeners = []gwv1.Listener{*listenerCtx.GwListener}
+				missingListener := attachedVirtualHostOptionWithSectionName()
+				missingListener.Name = "missing-listener-policy"
+				missingListener.Spec.GetTargetRefs()[0].SectionName = wrapperspb.String("missing-listener")
+				deps = []client.Object{gw, attachedVirtualHostOption(), missingListener}
+				vhOptionCollection = krt.NewStaticCollection(nil, []*solokubev1.VirtualHostOption{attachedVirtualHostOption(), missingListener})
+			})
+			JustBeforeEach(func() {
+				plugin.virtualHostOptionCollection = vhOptionCollection
+				for _, vhOpt := range vhOptionCollection.List() {
+					spec := proto.Clone(&vhOpt.Spec).(*sologatewayv1.VirtualHostOption)
+					spec.Metadata = &core.Metadata{Name: vhOpt.GetName(), Namespace: vhOpt.GetNamespace()}
+					_, err := vhOptionClient.Write(spec, clients.WriteOpts{})
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
+			It("reports the applied VirtualHostOption as accepted and the missing listener as not found", func() {
+				err := plugin.ApplyListenerPlugin(ctx, listenerCtx, outputListener)
+				Expect(err).NotTo(HaveOccurred())
+
+				statusCtx := &plugins.StatusContext{
+					PolicyReports: reports.NewPolicyReportMap(),
+				}
+				err = plugin.ApplyStatusPlugin(ctx, statusCtx)
+				Expect(err).NotTo(HaveOccurred())
+
+				gwAncestor := reports.PolicyAncestorKey{
+					ParentRefKey: reports.ParentRefKey{
+						Group:          gwv1.GroupName,
+						Kind:           wellknown.GatewayKind,
+						NamespacedName: types.NamespacedName{Namespace: "default", Name: "gw"},
+					},
+				}
+				policyReport := statusCtx.PolicyReports[reports.PolicyKey{
+					Kind:           sologatewayv1.VirtualHostOptionGVK.Kind,
+					NamespacedName: types.NamespacedName{Namespace: "default", Name: "policy"},
+				}]
+				Expect(policyReport.Ancestors).To(HaveKey(gwAncestor))
+				accepted := meta.FindStatusCondition(policyReport.Ancestors[gwAncestor].Conditions, string(gwv1.PolicyConditionAccepted))
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+
+				missingListenerAncestor := gwAncestor
+				missingListenerAncestor.SectionName = "missing-listener"
+				policyReport = statusCtx.PolicyReports[reports.PolicyKey{
+					Kind:           sologatewayv1.VirtualHostOptionGVK.Kind,
+					NamespacedName: types.NamespacedName{Namespace: "default", Name: "missing-listener-policy"},
+				}]
+				Expect(policyReport.Ancestors).To(HaveKey(missingListenerAncestor))
+				notFound := meta.FindStatusCondition(policyReport.Ancestors[missingListenerAncestor].Conditions, string(gwv1.PolicyConditionAccepted))
+				Expect(notFound.Status).To(Equal(metav1.ConditionFalse))
+				Expect(notFound.Reason).To(Equal(string(gwv1.PolicyReasonTargetNotFound)))
+				Expect(notFound.Message).To(Equal("listener missing-listener not found in Gateway default/gw"))
+			})
+		})
+
 		When("There is an error reading the VirtualHostOptions", func() {
 			It("errors out", func() {
 				plugin.ApplyListenerPlugin(ctx, listenerCtx, outputListener)
@@ -22,12 +22,18 @@ const (
 	// Kind string for HTTPRoute resource
 	HTTPRouteKind = "HTTPRoute"
 
+	// Kind string for GRPCRoute resource
+	GRPCRouteKind = "GRPCRoute"
+
 	// Kind string for TCPRoute resource
 	TCPRouteKind = "TCPRoute"
 
 	// Kind string for TLSRoute resource
 	TLSRouteKind = "TLSRoute"
 
+	// Kind string for UDPRoute resource
+	UDPRouteKind = "UDPRoute"
+
 	// Kind string for Gateway resource
 	GatewayKind = "Gateway"
 
@@ -37,6 +43,13 @@ const (
 	// Kind string for ReferenceGrant resource
 	ReferenceGrantKind = "ReferenceGrant"
 
+	// Kind string for BackendTLSPolicy resource
+	BackendTLSPolicyKind = "BackendTLSPolicy"
+
+	// Kind strings for k8s resources that may hold CA certificates
+	ConfigMapKind = "ConfigMap"
+	SecretKind    = "Secret"
+
 	// Kind strings for Gateway API list types
 	HTTPRouteListKind      = "HTTPRouteList"
 	GatewayListKind        = "GatewayList"
@@ -44,8 +57,10 @@ const (
 	ReferenceGrantListKind = "ReferenceGrantList"
 
 	// Gateway API CRD names
-	TCPRouteCRDName = "tcproutes.gateway.networking.k8s.io"
-	TLSRouteCRDName = "tlsroutes.gateway.networking.k8s.io"
+	GRPCRouteCRDName = "grpcroutes.gateway.networking.k8s.io"
+	TCPRouteCRDName  = "tcproutes.gateway.networking.k8s.io"
+	TLSRouteCRDName  = "tlsroutes.gateway.networking.k8s.io"
+	UDPRouteCRDName  = "udproutes.gateway.networking.k8s.io"
 
 	// Kind string for XListenerSet resource
 	XListenerSetKind = "XListenerSet"
@@ -88,6 +103,11 @@ var (
 		Version: apiv1.GroupVersion.Version,
 		Kind:    HTTPRouteListKind,
 	}
+	GRPCRouteGVK = schema.GroupVersionKind{
+		Group:   GatewayGroup,
+		Version: apiv1.GroupVersion.Version,
+		Kind:    GRPCRouteKind,
+	}
 	HTCPRouteListGVK = schema.GroupVersionKind{ // Remove?
 		Group:   GatewayGroup,
 		Version: apiv1alpha2.GroupVersion.Version,
@@ -99,12 +119,12 @@ var (
 		Kind:    ReferenceGrantListKind,
 	}
 
-	// GatewayStandardCRDs defines the set of Gateway API CRDs from the standard release channel.
+	// GatewayStandardCRDs defines the set of Gateway API CRDs from the standard release channel
+	// which are required. GRPCRoute is only used when its CRD is installed.
 	GatewayStandardCRDs = sets.New[string](
 		"gatewayclasses.gateway.networking.k8s.io",
 		"gateways.gateway.networking.k8s.io",
 		"httproutes.gateway.networking.k8s.io",
-		"grpcroutes.gateway.networking.k8s.io",
 		"referencegrants.gateway.networking.k8s.io",
 	)
 
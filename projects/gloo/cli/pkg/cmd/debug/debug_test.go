@@ -1,9 +1,22 @@
 package debug
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gatewaydefaults "github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
 
 	"github.com/solo-io/gloo/pkg/cliutil/testutil"
 	installcmd "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
@@ -61,4 +74,51 @@ var _ = Describe("Debug", func() {
 			Expect(manifests).To(HaveLen(len(cmds)), "Should have written the same number of manifests as commands")
 		})
 	})
+
+	Context("replay", func() {
+
+		var (
+			archiveFile string
+			outputDir   string
+		)
+
+		BeforeEach(func() {
+			dir := GinkgoT().TempDir()
+			archiveFile = filepath.Join(dir, "snapshots.tar.gz")
+			outputDir = filepath.Join(dir, "output")
+
+			snap := &v1snap.ApiSnapshot{
+				Gateways:        gatewayv1.GatewayList{gatewaydefaults.DefaultGateway(defaults.GlooSystem)},
+				VirtualServices: gatewayv1.VirtualServiceList{gatewaydefaults.DefaultVirtualService(defaults.GlooSystem, "default")},
+			}
+			var buf bytes.Buffer
+			Expect(iosnapshot.WriteSnapshotArchive(&buf, &iosnapshot.SnapshotArchive{
+				Settings: &gloov1.Settings{},
+				Records: []*iosnapshot.SnapshotRecord{
+					{Timestamp: time.Unix(0, 0), Reason: iosnapshot.InitialSnapshotReason, Snapshot: snap},
+					{Timestamp: time.Unix(1, 0), Reason: iosnapshot.NoChangesReason, Snapshot: snap},
+				},
+			})).NotTo(HaveOccurred())
+			Expect(os.WriteFile(archiveFile, buf.Bytes(), 0644)).NotTo(HaveOccurred())
+		})
+
+		It("prints a summary of each snapshot, and writes the translated xDS", func() {
+			var out bytes.Buffer
+			err := ReplaySnapshots(context.Background(), archiveFile, options.DebugReplay{Snapshot: -1, OutputDir: outputDir}, &out)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(out.String()).To(Equal(`snapshot 0 (1970-01-01T00:00:00Z): initial snapshot
+  proxy gloo-system.gateway-proxy (new): 1 listeners, 1 routes, 0 clusters, 0 endpoints, 0 errors, 0 warnings
+snapshot 1 (1970-01-01T00:00:01Z): resync without changes
+  proxy gloo-system.gateway-proxy (unchanged): 1 listeners, 1 routes, 0 clusters, 0 endpoints, 0 errors, 0 warnings
+`))
+			Expect(filepath.Join(outputDir, "0", "gloo-system.gateway-proxy.json")).To(BeAnExistingFile())
+			Expect(filepath.Join(outputDir, "1", "gloo-system.gateway-proxy.json")).To(BeAnExistingFile())
+		})
+
+		It("returns an error if the snapshot does not exist", func() {
+			err := ReplaySnapshots(context.Background(), archiveFile, options.DebugReplay{Snapshot: 2}, io.Discard)
+			Expect(err).To(MatchError(ContainSubstring("snapshot 2 does not exist")))
+		})
+	})
 })
@@ -0,0 +1,137 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot/replay"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func DebugReplayCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.DEBUG_REPLAY_COMMAND.Use,
+		Short: constants.DEBUG_REPLAY_COMMAND.Short,
+		Long:  constants.DEBUG_REPLAY_COMMAND.Long,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return ReplaySnapshots(opts.Top.Ctx, args[0], opts.Debug.Replay, os.Stdout)
+		},
+	}
+
+	pflags := cmd.Flags()
+	pflags.IntVar(&opts.Debug.Replay.Snapshot, "snapshot", -1, "index of the snapshot in the archive to replay. If negative, all snapshots are replayed")
+	pflags.StringVar(&opts.Debug.Replay.OutputDir, "output-dir", "", "directory to write the translated xDS snapshot of each proxy to, as <output-dir>/<snapshot>/<proxy>.json")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// ReplaySnapshots translates the snapshots in the archive file, and prints a summary of each translation to w
+func ReplaySnapshots(ctx context.Context, archiveFile string, replayOpts options.DebugReplay, w io.Writer) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// Translation logs at info level for every resource, which hides the summary
+	originalLogLevel := contextutils.GetLogLevel()
+	contextutils.SetLogLevel(zap.ErrorLevel)
+	defer contextutils.SetLogLevel(originalLogLevel)
+
+	f, err := os.Open(archiveFile)
+	if err != nil {
+		return eris.Wrapf(err, "opening snapshot archive")
+	}
+	defer f.Close()
+	archive, err := iosnapshot.ReadSnapshotArchive(f)
+	if err != nil {
+		return err
+	}
+
+	var results []*replay.Result
+	if replayOpts.Snapshot >= 0 {
+		if replayOpts.Snapshot >= len(archive.Records) {
+			return eris.Errorf("snapshot %d does not exist, the archive contains %d snapshots", replayOpts.Snapshot, len(archive.Records))
+		}
+		result, err := replay.NewReplayer(archive.Settings).Replay(ctx, replayOpts.Snapshot, archive.Records[replayOpts.Snapshot])
+		if err != nil {
+			return err
+		}
+		results = append(results, result)
+	} else {
+		results, err = replay.ReplayArchive(ctx, archive)
+		if err != nil {
+			return err
+		}
+	}
+
+	previousXds := map[string]*iosnapshot.XdsNodeSnapshot{}
+	for _, result := range results {
+		printReplayResult(w, result, previousXds)
+		if replayOpts.OutputDir != "" {
+			if err := writeReplayResult(replayOpts.OutputDir, result); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// printReplayResult prints a summary of the result. Proxies are marked as changed if their xDS snapshot differs from
+// the previous result, which helps to find the snapshot that introduced a change.
+func printReplayResult(w io.Writer, result *replay.Result, previousXds map[string]*iosnapshot.XdsNodeSnapshot) {
+	fmt.Fprintf(w, "snapshot %d (%s): %s\n", result.Index, result.Timestamp.UTC().Format(time.RFC3339), result.Reason)
+	if len(result.Proxies) == 0 {
+		fmt.Fprintln(w, "  no proxies")
+	}
+	for _, proxy := range result.Proxies {
+		change := "unchanged"
+		if previous, ok := previousXds[proxy.Name]; !ok {
+			change = "new"
+		} else if !reflect.DeepEqual(previous, proxy.Xds) {
+			change = "changed"
+		}
+		previousXds[proxy.Name] = proxy.Xds
+
+		fmt.Fprintf(w, "  proxy %s (%s): %d listeners, %d routes, %d clusters, %d endpoints, %d errors, %d warnings\n",
+			proxy.Name, change,
+			len(proxy.Xds.Listeners.Resources), len(proxy.Xds.Routes.Resources),
+			len(proxy.Xds.Clusters.Resources), len(proxy.Xds.Endpoints.Resources),
+			len(proxy.Errors), len(proxy.Warnings))
+		for _, err := range proxy.Errors {
+			fmt.Fprintf(w, "    error: %s\n", err)
+		}
+		for _, warning := range proxy.Warnings {
+			fmt.Fprintf(w, "    warning: %s\n", warning)
+		}
+	}
+}
+
+func writeReplayResult(outputDir string, result *replay.Result) error {
+	dir := filepath.Join(outputDir, fmt.Sprintf("%d", result.Index))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, proxy := range result.Proxies {
+		data, err := json.MarshalIndent(proxy, "", "  ")
+		if err != nil {
+			return err
+		}
+		// proxy names are <namespace>.<name>, which are valid file names
+		if err := os.WriteFile(filepath.Join(dir, proxy.Name+".json"), data, filePermissions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -61,6 +61,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 
 	cmd.AddCommand(DebugLogCmd(opts))
 	cmd.AddCommand(DebugYamlCmd(opts))
+	cmd.AddCommand(DebugReplayCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
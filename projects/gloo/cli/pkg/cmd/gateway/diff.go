@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/go-utils/threadsafe"
+	"github.com/spf13/cobra"
+
+	"github.com/solo-io/gloo/pkg/utils/envoyutils/admincli"
+	"github.com/solo-io/gloo/pkg/utils/kubeutils/kubectl"
+	"github.com/solo-io/gloo/pkg/utils/kubeutils/portforward"
+	"github.com/solo-io/gloo/pkg/utils/requestutils/curl"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/admin"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+)
+
+func diffCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "compare the xDS config served by Gloo with the config loaded by one of the proxy instances",
+		Long: "Fetches the xDS snapshot that Gloo serves to the proxy from the Gloo admin server, and the config dump of the proxy, " +
+			"and prints the listeners, routes, clusters and endpoints that differ between them, with their versions and the details " +
+			"of any update the proxy rejected (NACKed). The command fails if the proxy is not in sync with Gloo.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return diffProxyConfig(opts, cmd.OutOrStdout())
+		},
+	}
+	pflags := cmd.Flags()
+	pflags.StringVar(&opts.Proxy.NodeId, "node-id", "", "the xDS cache key of the proxy (defaults to <namespace>~<name>, the role of Edge proxies)")
+	pflags.BoolVar(&opts.Proxy.ConfigDumpEDS, "include-eds", true, "compare endpoints, which requires the proxy to dump its EDS config")
+	pflags.BoolVar(&opts.Proxy.ShowInSync, "show-in-sync", false, "also list the resources that are in sync")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func diffProxyConfig(opts *options.Options, w io.Writer) error {
+	nodeId := opts.Proxy.NodeId
+	if nodeId == "" {
+		nodeId = fmt.Sprintf("%s~%s", opts.Metadata.GetNamespace(), opts.Proxy.Name)
+	}
+
+	served, err := getServedXdsSnapshot(opts, nodeId)
+	if err != nil {
+		return err
+	}
+
+	adminCli, shutdownFunc, err := admincli.NewPortForwardedClient(opts.Top.Ctx, kubectl.NewCli().WithKubeContext(opts.Top.KubeContext), opts.Proxy.Name, opts.Metadata.GetNamespace())
+	if err != nil {
+		return err
+	}
+	defer shutdownFunc()
+
+	configParams := map[string]string{}
+	if opts.Proxy.ConfigDumpEDS {
+		configParams["include_eds"] = "on"
+	}
+	configDump, err := adminCli.GetConfigDump(opts.Top.Ctx, configParams)
+	if err != nil {
+		return eris.Wrapf(err, "getting config dump of proxy %s", opts.Proxy.Name)
+	}
+
+	diff, err := xdsinspection.DiffConfig(served, configDump)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Comparing the config served by Gloo to node %s with the config loaded by proxy %s\n", nodeId, opts.Proxy.Name)
+	diff.Print(w, opts.Proxy.ShowInSync)
+	if !diff.InSync() {
+		return eris.Errorf("proxy %s is not in sync with the config served by Gloo", opts.Proxy.Name)
+	}
+	return nil
+}
+
+// getServedXdsSnapshot returns the xDS snapshot that Gloo serves to the node, from the Gloo admin server
+func getServedXdsSnapshot(opts *options.Options, nodeId string) (*iosnapshot.XdsNodeSnapshot, error) {
+	glooDeploymentName, err := helpers.GetGlooDeploymentName(opts.Top.Ctx, opts.Metadata.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	portForwarder, err := kubectl.NewCli().WithKubeContext(opts.Top.KubeContext).StartPortForward(opts.Top.Ctx,
+		portforward.WithDeployment(glooDeploymentName, opts.Metadata.GetNamespace()),
+		portforward.WithRemotePort(admin.AdminPort))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		portForwarder.Close()
+		portForwarder.WaitForStop()
+	}()
+
+	var out threadsafe.Buffer
+	err = admincli.NewClient().
+		WithCurlOptions(curl.WithHostPort(portForwarder.Address())).
+		Command(opts.Top.Ctx,
+			curl.WithPath("snapshots/xds"),
+			curl.WithQueryParameters(map[string]string{"node": nodeId}),
+		).
+		WithStdout(&out).
+		Run().
+		Cause()
+	if err != nil {
+		return nil, eris.Wrapf(err, "getting xds snapshot of node %s from the gloo admin server", nodeId)
+	}
+	return xdsinspection.ParseXdsNodeSnapshot(out.Bytes())
+}
@@ -28,6 +28,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd.AddCommand(writeSnapshotCmd(opts))
 	cmd.AddCommand(logsCmd(opts))
 	cmd.AddCommand(servedConfigCmd(opts))
+	cmd.AddCommand(diffCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -0,0 +1,85 @@
+package get
+
+import (
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/spf13/cobra"
+)
+
+func KubeGateway(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.KUBE_GATEWAY_COMMAND.Use,
+		Aliases: constants.KUBE_GATEWAY_COMMAND.Aliases,
+		Short:   "read a Kubernetes Gateway API Gateway or list Gateways in a namespace",
+		Long: "Lists the listeners of each Gateway, including the listeners of the ListenerSets it allows, " +
+			"with the routes attached to each listener and the ListenerOptions and VirtualHostOptions which apply to it.\n\n" +
+			"usage: glooctl get gateway [NAME] [--namespace=namespace] [-o FORMAT]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gateways, err := common.GetKubeGateways(common.GetName(args, opts), opts)
+			if err != nil {
+				return err
+			}
+			return printers.PrintGateways(gateways, opts.Top.Output)
+		},
+	}
+	return cmd
+}
+
+func HTTPRoute(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.HTTP_ROUTE_COMMAND.Use,
+		Aliases: constants.HTTP_ROUTE_COMMAND.Aliases,
+		Short:   "read an HTTPRoute or list HTTPRoutes in a namespace",
+		Long: "Lists the parents of each HTTPRoute, whether the route was accepted by each of them, " +
+			"and the RouteOptions which apply to each rule of the route.\n\n" +
+			"usage: glooctl get httproute [NAME] [--namespace=namespace] [-o FORMAT]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			routes, err := common.GetRoutes(common.GetName(args, opts), []string{wellknown.HTTPRouteKind}, opts)
+			if err != nil {
+				return err
+			}
+			return printers.PrintXRoutes(routes, opts.Top.Output)
+		},
+	}
+	return cmd
+}
+
+func TCPRoute(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.TCP_ROUTE_COMMAND.Use,
+		Aliases: constants.TCP_ROUTE_COMMAND.Aliases,
+		Short:   "read a TCPRoute or list TCPRoutes in a namespace",
+		Long: "Lists the parents of each TCPRoute, and whether the route was accepted by each of them.\n\n" +
+			"usage: glooctl get tcproute [NAME] [--namespace=namespace] [-o FORMAT]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			routes, err := common.GetRoutes(common.GetName(args, opts), []string{wellknown.TCPRouteKind}, opts)
+			if err != nil {
+				return err
+			}
+			return printers.PrintXRoutes(routes, opts.Top.Output)
+		},
+	}
+	return cmd
+}
+
+func ListenerSet(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.LISTENER_SET_COMMAND.Use,
+		Aliases: constants.LISTENER_SET_COMMAND.Aliases,
+		Short:   "read a ListenerSet or list ListenerSets in a namespace",
+		Long: "Lists the parent Gateway of each ListenerSet, whether the Gateway allows it, and its listeners " +
+			"with the routes and policies attached to them.\n\n" +
+			"usage: glooctl get listenerset [NAME] [--namespace=namespace] [-o FORMAT]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			listenerSets, err := common.GetListenerSets(common.GetName(args, opts), opts)
+			if err != nil {
+				return err
+			}
+			return printers.PrintListenerSets(listenerSets, opts.Top.Output)
+		},
+	}
+	return cmd
+}
@@ -60,6 +60,10 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd.AddCommand(UpstreamGroup(opts))
 	cmd.AddCommand(AuthConfig(opts))
 	cmd.AddCommand(RateLimitConfig(opts))
+	cmd.AddCommand(KubeGateway(opts))
+	cmd.AddCommand(HTTPRoute(opts))
+	cmd.AddCommand(TCPRoute(opts))
+	cmd.AddCommand(ListenerSet(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -95,6 +95,8 @@ type Proxy struct {
 	FollowLogs       bool
 	DebugLogs        bool
 	ConfigDumpEDS    bool
+	NodeId           string
+	ShowInSync       bool
 }
 
 type Upgrade struct {
@@ -503,4 +505,12 @@ type ValidateLicense struct {
 type Debug struct {
 	Directory  string
 	Namespaces []string
+	Replay     DebugReplay
+}
+
+type DebugReplay struct {
+	// Snapshot is the index of the snapshot in the archive to replay. All snapshots are replayed if it is negative.
+	Snapshot int
+	// OutputDir is the directory to write the translated xDS snapshots to. Nothing is written if it is empty.
+	OutputDir string
 }
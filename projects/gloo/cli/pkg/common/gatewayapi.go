@@ -0,0 +1,80 @@
+package common
+
+import (
+	"github.com/solo-io/gloo/pkg/schemes"
+	"github.com/solo-io/gloo/pkg/utils/kubeutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/gatewayapi"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetGatewayAPIViews reads the Kubernetes Gateway API resources of the cluster, and resolves them with the
+// same queries as the Gloo controller.
+// The resources of every namespace are read, as routes and policies may attach to Gateways across namespaces.
+func GetGatewayAPIViews(opts *options.Options) (*gatewayapi.Views, error) {
+	cfg, err := kubeutils.GetRestConfigWithKubeContext(opts.Top.KubeContext)
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := client.New(cfg, client.Options{Scheme: schemes.GatewayScheme()})
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := gatewayapi.LoadSnapshot(opts.Top.Ctx, kubeClient)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Resolve(opts.Top.Ctx)
+}
+
+func GetKubeGateways(name string, opts *options.Options) ([]*gatewayapi.GatewayView, error) {
+	views, err := GetGatewayAPIViews(opts)
+	if err != nil {
+		return nil, err
+	}
+	return filterViews(views.Gateways, func(gw *gatewayapi.GatewayView) gatewayapi.ObjectRef { return gw.ObjectRef }, name, opts), nil
+}
+
+// GetRoutes returns the routes of the given kinds
+func GetRoutes(name string, kinds []string, opts *options.Options) ([]*gatewayapi.RouteView, error) {
+	views, err := GetGatewayAPIViews(opts)
+	if err != nil {
+		return nil, err
+	}
+	var routes []*gatewayapi.RouteView
+	for _, route := range views.Routes {
+		for _, kind := range kinds {
+			if route.Kind == kind {
+				routes = append(routes, route)
+			}
+		}
+	}
+	return filterViews(routes, func(route *gatewayapi.RouteView) gatewayapi.ObjectRef { return route.ObjectRef }, name, opts), nil
+}
+
+func GetListenerSets(name string, opts *options.Options) ([]*gatewayapi.ListenerSetView, error) {
+	views, err := GetGatewayAPIViews(opts)
+	if err != nil {
+		return nil, err
+	}
+	return filterViews(views.ListenerSets, func(ls *gatewayapi.ListenerSetView) gatewayapi.ObjectRef { return ls.ObjectRef }, name, opts), nil
+}
+
+// filterViews returns the views in the namespace of the options, with the given name if it is set
+func filterViews[T any](views []T, ref func(T) gatewayapi.ObjectRef, name string, opts *options.Options) []T {
+	var filtered []T
+	for _, view := range views {
+		r := ref(view)
+		if r.Namespace != opts.Metadata.GetNamespace() {
+			continue
+		}
+		if name != "" && r.Name != name {
+			continue
+		}
+		filtered = append(filtered, view)
+	}
+	if name != "" {
+		opts.Metadata.Name = name
+	}
+	return filtered
+}
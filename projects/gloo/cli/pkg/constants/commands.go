@@ -55,6 +55,26 @@ var (
 		Aliases: []string{"rlc", "ratelimitconfigs"},
 	}
 
+	KUBE_GATEWAY_COMMAND = cobra.Command{
+		Use:     "gateway",
+		Aliases: []string{"gw", "gateways"},
+	}
+
+	HTTP_ROUTE_COMMAND = cobra.Command{
+		Use:     "httproute",
+		Aliases: []string{"hr", "httproutes"},
+	}
+
+	TCP_ROUTE_COMMAND = cobra.Command{
+		Use:     "tcproute",
+		Aliases: []string{"tcproutes"},
+	}
+
+	LISTENER_SET_COMMAND = cobra.Command{
+		Use:     "listenerset",
+		Aliases: []string{"ls", "listenersets", "xlistenerset", "xlistenersets"},
+	}
+
 	ADD_COMMAND = cobra.Command{
 		Use:     "add",
 		Aliases: []string{"a"},
@@ -103,6 +123,15 @@ var (
 		Short: "Print YAML representing the current Gloo state of a Kubernetes cluster (top level \"debug\" command is preferred)",
 	}
 
+	DEBUG_REPLAY_COMMAND = cobra.Command{
+		Use:   "replay ARCHIVE",
+		Short: "Replay recorded input snapshots through the Gloo Edge translators",
+		Long: "Translates the input snapshots in an archive exported from the Gloo admin server " +
+			"(/snapshots/history/archive) offline, using the same translators as the Gloo controller. " +
+			"This is useful to reproduce translation errors, and to find the snapshot which changed the translated xDS config. " +
+			"The Gloo controller only records snapshots if the SNAPSHOT_HISTORY_SIZE environment variable is set.",
+	}
+
 	DELETE_COMMAND = cobra.Command{
 		Use:     "delete",
 		Aliases: []string{"d"},
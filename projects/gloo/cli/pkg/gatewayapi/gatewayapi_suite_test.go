@@ -0,0 +1,13 @@
+package gatewayapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGatewayAPI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Gateway API Suite")
+}
@@ -0,0 +1,95 @@
+package gatewayapi
+
+import (
+	"context"
+
+	"github.com/solo-io/gloo/pkg/schemes"
+	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
+	"github.com/solo-io/gloo/projects/gateway2/query"
+	lisoptquery "github.com/solo-io/gloo/projects/gateway2/translator/plugins/listeneroptions/query"
+	rtoptquery "github.com/solo-io/gloo/projects/gateway2/translator/plugins/routeoptions/query"
+	vhoptquery "github.com/solo-io/gloo/projects/gateway2/translator/plugins/virtualhostoptions/query"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+)
+
+// Snapshot holds the Kubernetes Gateway API resources of a cluster, and the policies which attach to them.
+// The resources are served by an in-memory client with the same field indexes as the Gloo controller,
+// so that they are resolved by the same gateway2 queries that the controller translates them with.
+type Snapshot struct {
+	client             client.Client
+	queries            query.GatewayQueries
+	routeOptionQueries rtoptquery.RouteOptionQueries
+	vhOptionQueries    vhoptquery.VirtualHostOptionQueries
+	lisOptionQueries   lisoptquery.ListenerOptionQueries
+}
+
+// the resources which are read into the Snapshot
+func snapshotLists() []client.ObjectList {
+	return []client.ObjectList{
+		&corev1.NamespaceList{},
+		&gwv1.GatewayList{},
+		&gwv1.HTTPRouteList{},
+		&gwv1.GRPCRouteList{},
+		&gwv1a2.TCPRouteList{},
+		&gwv1a2.TLSRouteList{},
+		&gwv1a2.UDPRouteList{},
+		&gwxv1a1.XListenerSetList{},
+		&gwv1b1.ReferenceGrantList{},
+		&solokubev1.RouteOptionList{},
+		&solokubev1.VirtualHostOptionList{},
+		&solokubev1.ListenerOptionList{},
+	}
+}
+
+// LoadSnapshot reads the resources of every namespace into a Snapshot.
+// Resources whose CRDs are not installed in the cluster are skipped.
+func LoadSnapshot(ctx context.Context, reader client.Reader) (*Snapshot, error) {
+	var objs []client.Object
+	for _, list := range snapshotLists() {
+		if err := reader.List(ctx, list); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			obj := item.(client.Object)
+			obj.SetManagedFields(nil)
+			objs = append(objs, obj)
+		}
+	}
+	return NewSnapshot(objs), nil
+}
+
+// NewSnapshot returns a Snapshot of the given resources
+func NewSnapshot(objs []client.Object) *Snapshot {
+	builder := fake.NewClientBuilder().WithScheme(schemes.GatewayScheme())
+	indexer := func(obj client.Object, field string, indexerFunc client.IndexerFunc) error {
+		builder.WithIndex(obj, field, indexerFunc)
+		return nil
+	}
+	_ = query.IterateIndices(indexer)
+	_ = rtoptquery.IterateIndices(indexer)
+	_ = vhoptquery.IterateIndices(indexer)
+	_ = lisoptquery.IterateIndices(indexer)
+	c := builder.WithObjects(objs...).Build()
+
+	return &Snapshot{
+		client:             c,
+		queries:            query.NewData(c, schemes.GatewayScheme()),
+		routeOptionQueries: rtoptquery.NewQuery(c),
+		vhOptionQueries:    vhoptquery.NewQuery(c),
+		lisOptionQueries:   lisoptquery.NewQuery(c),
+	}
+}
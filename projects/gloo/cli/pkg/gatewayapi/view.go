@@ -0,0 +1,465 @@
+package gatewayapi
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	sologatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
+	"github.com/solo-io/gloo/projects/gateway2/query"
+	"github.com/solo-io/gloo/projects/gateway2/translator/types"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+)
+
+// ObjectRef identifies a resource
+type ObjectRef struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+func (r ObjectRef) String() string {
+	return fmt.Sprintf("%s %s.%s", r.Kind, r.Namespace, r.Name)
+}
+
+func compareRefs(a, b ObjectRef) int {
+	return cmp.Or(
+		cmp.Compare(a.Namespace, b.Namespace),
+		cmp.Compare(a.Name, b.Name),
+		cmp.Compare(a.Kind, b.Kind),
+	)
+}
+
+// PolicyView is a policy which is attached to a listener or a route
+type PolicyView struct {
+	ObjectRef
+	// Applied is false if the policy is attached, but is overridden by policies with a higher priority
+	Applied bool `json:"applied"`
+}
+
+// GatewayView is a Gateway, with the listeners of the Gateway and of the ListenerSets it allows
+type GatewayView struct {
+	ObjectRef
+	GatewayClass        string          `json:"gatewayClass"`
+	Listeners           []*ListenerView `json:"listeners,omitempty"`
+	AllowedListenerSets []ObjectRef     `json:"allowedListenerSets,omitempty"`
+	DeniedListenerSets  []ObjectRef     `json:"deniedListenerSets,omitempty"`
+}
+
+// ListenerView is a listener of a Gateway or ListenerSet, with the routes and policies attached to it
+type ListenerView struct {
+	Name           string        `json:"name"`
+	Parent         ObjectRef     `json:"parent"`
+	Protocol       string        `json:"protocol"`
+	Port           int32         `json:"port"`
+	Hostname       string        `json:"hostname,omitempty"`
+	AttachedRoutes []ObjectRef   `json:"attachedRoutes,omitempty"`
+	Policies       []*PolicyView `json:"policies,omitempty"`
+	Error          string        `json:"error,omitempty"`
+}
+
+// RouteView is an xRoute, with the parents it is attached to and the policies applied to its rules
+type RouteView struct {
+	ObjectRef
+	Hostnames []string           `json:"hostnames,omitempty"`
+	Parents   []*RouteParentView `json:"parents,omitempty"`
+	Rules     []*RuleView        `json:"rules,omitempty"`
+}
+
+// RouteParentView is the outcome of a parentRef of a route, or of the delegation of a route by a parent HTTPRoute
+type RouteParentView struct {
+	Parent      ObjectRef `json:"parent"`
+	SectionName string    `json:"sectionName,omitempty"`
+	// the listeners the route is attached to
+	Listeners []string `json:"listeners,omitempty"`
+	Accepted  bool     `json:"accepted"`
+	// the reason the route was not accepted
+	Reason string `json:"reason,omitempty"`
+}
+
+// RuleView is a rule of an HTTPRoute, with the RouteOptions which apply to it
+type RuleView struct {
+	Index    int           `json:"index"`
+	Name     string        `json:"name,omitempty"`
+	Policies []*PolicyView `json:"policies,omitempty"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// ListenerSetView is a ListenerSet, with its parent Gateway and its listeners
+type ListenerSetView struct {
+	ObjectRef
+	Gateway ObjectRef `json:"gateway"`
+	// Accepted is true if the ListenerSet is allowed by its parent Gateway
+	Accepted  bool            `json:"accepted"`
+	Listeners []*ListenerView `json:"listeners,omitempty"`
+}
+
+// Views are the resources of a Snapshot, as resolved by the Gloo controller
+type Views struct {
+	Gateways     []*GatewayView
+	Routes       []*RouteView
+	ListenerSets []*ListenerSetView
+}
+
+// Resolve resolves the listeners, routes and policies of every Gateway of the Snapshot.
+// Views are sorted by namespace and name.
+func (s *Snapshot) Resolve(ctx context.Context) (*Views, error) {
+	var gateways gwv1.GatewayList
+	if err := s.client.List(ctx, &gateways); err != nil {
+		return nil, err
+	}
+	routes, err := s.listRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	listenerSets, err := s.listListenerSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	views := &Views{}
+	for i := range gateways.Items {
+		gw := &gateways.Items[i]
+		gwView, err := s.resolveGateway(ctx, gw, routes, listenerSets)
+		if err != nil {
+			return nil, err
+		}
+		views.Gateways = append(views.Gateways, gwView)
+	}
+
+	for _, route := range routes {
+		views.Routes = append(views.Routes, route.view)
+	}
+	for _, ls := range listenerSets {
+		views.ListenerSets = append(views.ListenerSets, ls)
+	}
+
+	slices.SortFunc(views.Gateways, func(a, b *GatewayView) int { return compareRefs(a.ObjectRef, b.ObjectRef) })
+	slices.SortFunc(views.Routes, func(a, b *RouteView) int { return compareRefs(a.ObjectRef, b.ObjectRef) })
+	slices.SortFunc(views.ListenerSets, func(a, b *ListenerSetView) int { return compareRefs(a.ObjectRef, b.ObjectRef) })
+	return views, nil
+}
+
+type routeEntry struct {
+	view *RouteView
+}
+
+func (s *Snapshot) listRoutes(ctx context.Context) (map[ObjectRef]*routeEntry, error) {
+	routes := map[ObjectRef]*routeEntry{}
+	for _, list := range []client.ObjectList{
+		&gwv1.HTTPRouteList{},
+		&gwv1.GRPCRouteList{},
+		&gwv1a2.TCPRouteList{},
+		&gwv1a2.TLSRouteList{},
+		&gwv1a2.UDPRouteList{},
+	} {
+		if err := s.client.List(ctx, list); err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			obj := item.(client.Object)
+			ref := s.refForObject(obj)
+			view := &RouteView{ObjectRef: ref}
+			switch route := obj.(type) {
+			case *gwv1.HTTPRoute:
+				view.Hostnames = hostnames(route.Spec.Hostnames)
+				view.Rules = s.resolveRules(ctx, route)
+			case *gwv1.GRPCRoute:
+				view.Hostnames = hostnames(route.Spec.Hostnames)
+			case *gwv1a2.TLSRoute:
+				view.Hostnames = hostnames(route.Spec.Hostnames)
+			}
+			routes[ref] = &routeEntry{view: view}
+		}
+	}
+	return routes, nil
+}
+
+func (s *Snapshot) listListenerSets(ctx context.Context) (map[ObjectRef]*ListenerSetView, error) {
+	var list gwxv1a1.XListenerSetList
+	if err := s.client.List(ctx, &list); err != nil {
+		return nil, err
+	}
+	listenerSets := map[ObjectRef]*ListenerSetView{}
+	for i := range list.Items {
+		ls := &list.Items[i]
+		gwNamespace := ls.GetNamespace()
+		if ls.Spec.ParentRef.Namespace != nil && *ls.Spec.ParentRef.Namespace != "" {
+			gwNamespace = string(*ls.Spec.ParentRef.Namespace)
+		}
+		ref := s.refForObject(ls)
+		listenerSets[ref] = &ListenerSetView{
+			ObjectRef: ref,
+			Gateway: ObjectRef{
+				Kind:      wellknown.GatewayKind,
+				Namespace: gwNamespace,
+				Name:      string(ls.Spec.ParentRef.Name),
+			},
+		}
+	}
+	return listenerSets, nil
+}
+
+// resolveRules returns the RouteOptions which apply to each rule of the route, merged in the same way as the
+// RouteOption plugin of the translator
+func (s *Snapshot) resolveRules(ctx context.Context, route *gwv1.HTTPRoute) []*RuleView {
+	var rules []*RuleView
+	for i := range route.Spec.Rules {
+		rule := &route.Spec.Rules[i]
+		ruleView := &RuleView{Index: i}
+		if rule.Name != nil {
+			ruleView.Name = string(*rule.Name)
+		}
+
+		_, sources, err := s.routeOptionQueries.GetRouteOptionForRouteRule(ctx, client.ObjectKeyFromObject(route), rule, s.queries)
+		if err != nil {
+			ruleView.Error = err.Error()
+		}
+		for _, source := range sources {
+			ruleView.Policies = append(ruleView.Policies, &PolicyView{
+				ObjectRef: ObjectRef{
+					Kind:      source.GetResourceKind(),
+					Namespace: source.GetResourceRef().GetNamespace(),
+					Name:      source.GetResourceRef().GetName(),
+				},
+				Applied: true,
+			})
+		}
+		rules = append(rules, ruleView)
+	}
+	return rules
+}
+
+func (s *Snapshot) resolveGateway(
+	ctx context.Context,
+	gw *gwv1.Gateway,
+	routes map[ObjectRef]*routeEntry,
+	listenerSets map[ObjectRef]*ListenerSetView,
+) (*GatewayView, error) {
+	cgw, err := s.queries.ConsolidateGateway(ctx, gw)
+	if err != nil {
+		return nil, err
+	}
+	routesForGw, err := s.queries.GetRoutesForConsolidatedGateway(ctx, cgw)
+	if err != nil {
+		return nil, err
+	}
+
+	gwView := &GatewayView{
+		ObjectRef:    s.refForObject(gw),
+		GatewayClass: string(gw.Spec.GatewayClassName),
+	}
+	for _, ls := range cgw.AllowedListenerSets {
+		ref := s.refForObject(ls)
+		gwView.AllowedListenerSets = append(gwView.AllowedListenerSets, ref)
+		if lsView, ok := listenerSets[ref]; ok {
+			lsView.Accepted = true
+		}
+	}
+	for _, ls := range cgw.DeniedListenerSets {
+		gwView.DeniedListenerSets = append(gwView.DeniedListenerSets, s.refForObject(ls))
+	}
+
+	for _, cl := range cgw.GetConsolidatedListeners() {
+		listenerView, err := s.resolveListener(ctx, cl, routesForGw, routes)
+		if err != nil {
+			return nil, err
+		}
+		if cl.ListenerSet == nil {
+			gwView.Listeners = append(gwView.Listeners, listenerView)
+		} else if lsView, ok := listenerSets[s.refForObject(cl.ListenerSet)]; ok {
+			lsView.Listeners = append(lsView.Listeners, listenerView)
+		}
+	}
+
+	for _, routeErr := range routesForGw.RouteErrors {
+		entry, ok := routes[s.refForObject(routeErr.Route)]
+		if !ok {
+			continue
+		}
+		parentView := entry.parent(parentRefToObjectRef(routeErr.ParentRef, routeErr.Route.GetNamespace()), routeErr.ParentRef.SectionName)
+		parentView.Reason = string(routeErr.Error.Reason)
+	}
+
+	return gwView, nil
+}
+
+func (s *Snapshot) resolveListener(
+	ctx context.Context,
+	cl types.ConsolidatedListener,
+	routesForGw *query.RoutesForGwResult,
+	routes map[ObjectRef]*routeEntry,
+) (*ListenerView, error) {
+	listenerView := &ListenerView{
+		Name:     string(cl.Listener.Name),
+		Parent:   s.refForObject(cl.GetParent()),
+		Protocol: string(cl.Listener.Protocol),
+		Port:     int32(cl.Listener.Port),
+	}
+	if cl.Listener.Hostname != nil {
+		listenerView.Hostname = string(*cl.Listener.Hostname)
+	}
+
+	if result := routesForGw.GetListenerResult(cl.GetParent(), string(cl.Listener.Name)); result != nil {
+		if result.Error != nil {
+			listenerView.Error = result.Error.Error()
+		}
+		for _, routeInfo := range result.Routes {
+			ref := s.refForObject(routeInfo.Object)
+			listenerView.AttachedRoutes = append(listenerView.AttachedRoutes, ref)
+			if entry, ok := routes[ref]; ok {
+				parentView := entry.parent(listenerView.Parent, routeInfo.ParentRef.SectionName)
+				parentView.Accepted = true
+				parentView.Listeners = append(parentView.Listeners, listenerView.Name)
+			}
+			s.resolveDelegation(routeInfo, routes, map[ObjectRef]bool{ref: true})
+		}
+	}
+
+	// the highest priority ListenerOption is applied, see the ListenerOption plugin of the translator
+	listenerOptions, err := s.lisOptionQueries.GetAttachedListenerOptions(ctx, cl.Listener, cl.Gateway, cl.ListenerSet)
+	if err != nil {
+		return nil, err
+	}
+	for i, opt := range listenerOptions {
+		listenerView.Policies = append(listenerView.Policies, &PolicyView{
+			ObjectRef: policyRef(sologatewayv1.ListenerOptionGVK.Kind, opt),
+			Applied:   i == 0,
+		})
+	}
+
+	// VirtualHostOptions are merged in order of priority, see the VirtualHostOption plugin of the translator
+	if cl.Listener.Protocol == gwv1.HTTPProtocolType || cl.Listener.Protocol == gwv1.HTTPSProtocolType {
+		vhOptions, err := s.vhOptionQueries.GetVirtualHostOptionsForListener(ctx, cl.Listener, cl.Gateway, cl.ListenerSet)
+		if err != nil {
+			return nil, err
+		}
+		listenerView.Policies = append(listenerView.Policies, mergeVirtualHostOptions(vhOptions)...)
+	}
+
+	return listenerView, nil
+}
+
+func mergeVirtualHostOptions(vhOptions []*solokubev1.VirtualHostOption) []*PolicyView {
+	if len(vhOptions) == 0 {
+		return nil
+	}
+	merged, _ := vhOptions[0].Spec.GetOptions().Clone().(*gloov1.VirtualHostOptions)
+	policies := []*PolicyView{{
+		ObjectRef: policyRef(sologatewayv1.VirtualHostOptionGVK.Kind, vhOptions[0]),
+		Applied:   true,
+	}}
+	for _, opt := range vhOptions[1:] {
+		var used bool
+		merged, used = glooutils.ShallowMergeVirtualHostOptions(merged, opt.Spec.GetOptions())
+		policies = append(policies, &PolicyView{
+			ObjectRef: policyRef(sologatewayv1.VirtualHostOptionGVK.Kind, opt),
+			Applied:   used,
+		})
+	}
+	return policies
+}
+
+// resolveDelegation records the HTTPRoutes which are delegated to by the route as children of the route
+func (s *Snapshot) resolveDelegation(routeInfo *query.RouteInfo, routes map[ObjectRef]*routeEntry, visited map[ObjectRef]bool) {
+	route, ok := routeInfo.Object.(*gwv1.HTTPRoute)
+	if !ok {
+		return
+	}
+	parentRef := s.refForObject(route)
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			children, err := routeInfo.GetChildrenForRef(backendRef.BackendObjectReference)
+			if err != nil {
+				continue
+			}
+			for _, child := range children {
+				childRef := s.refForObject(child.Object)
+				if visited[childRef] {
+					continue
+				}
+				if entry, ok := routes[childRef]; ok {
+					entry.parent(parentRef, nil).Accepted = true
+				}
+				visited[childRef] = true
+				s.resolveDelegation(child, routes, visited)
+				delete(visited, childRef)
+			}
+		}
+	}
+}
+
+// parent returns the view of the given parent of the route, adding it if needed
+func (e *routeEntry) parent(parent ObjectRef, sectionName *gwv1.SectionName) *RouteParentView {
+	var section string
+	if sectionName != nil {
+		section = string(*sectionName)
+	}
+	for _, parentView := range e.view.Parents {
+		if parentView.Parent == parent && parentView.SectionName == section {
+			return parentView
+		}
+	}
+	parentView := &RouteParentView{Parent: parent, SectionName: section}
+	e.view.Parents = append(e.view.Parents, parentView)
+	return parentView
+}
+
+func (s *Snapshot) refForObject(obj client.Object) ObjectRef {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		if gvk, err := apiutil.GVKForObject(obj, s.client.Scheme()); err == nil {
+			kind = gvk.Kind
+		}
+	}
+	return ObjectRef{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+}
+
+func policyRef(kind string, obj client.Object) ObjectRef {
+	return ObjectRef{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+}
+
+func parentRefToObjectRef(parentRef gwv1.ParentReference, routeNamespace string) ObjectRef {
+	ref := ObjectRef{
+		Kind:      wellknown.GatewayKind,
+		Namespace: routeNamespace,
+		Name:      string(parentRef.Name),
+	}
+	if parentRef.Kind != nil {
+		ref.Kind = string(*parentRef.Kind)
+	}
+	if parentRef.Namespace != nil && *parentRef.Namespace != "" {
+		ref.Namespace = string(*parentRef.Namespace)
+	}
+	return ref
+}
+
+func hostnames(in []gwv1.Hostname) []string {
+	var out []string
+	for _, h := range in {
+		out = append(out, string(h))
+	}
+	return out
+}
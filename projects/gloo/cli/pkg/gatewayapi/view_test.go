@@ -0,0 +1,163 @@
+package gatewayapi_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	sologatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/gatewayapi"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+var _ = Describe("Resolve", func() {
+
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	ref := func(kind, name string) gatewayapi.ObjectRef {
+		return gatewayapi.ObjectRef{Kind: kind, Namespace: "default", Name: name}
+	}
+
+	gateway := func() *gwv1.Gateway {
+		return &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+			Spec: gwv1.GatewaySpec{
+				GatewayClassName: "gloo-gateway",
+				Listeners: []gwv1.Listener{{
+					Name:     "http",
+					Protocol: gwv1.HTTPProtocolType,
+					Port:     8080,
+				}},
+			},
+		}
+	}
+
+	httpRoute := func(name string, sectionName *gwv1.SectionName) *gwv1.HTTPRoute {
+		return &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: gwv1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{
+					ParentRefs: []gwv1.ParentReference{{Name: "gw", SectionName: sectionName}},
+				},
+				Hostnames: []gwv1.Hostname{"example.com"},
+				Rules:     []gwv1.HTTPRouteRule{{}},
+			},
+		}
+	}
+
+	virtualHostOption := func(name string, created time.Time, options *v1.VirtualHostOptions) *solokubev1.VirtualHostOption {
+		return &solokubev1.VirtualHostOption{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", CreationTimestamp: metav1.NewTime(created)},
+			Spec: sologatewayv1.VirtualHostOption{
+				TargetRefs: []*corev1.PolicyTargetReferenceWithSectionName{{
+					Group: gwv1.GroupVersion.Group,
+					Kind:  wellknown.GatewayKind,
+					Name:  "gw",
+				}},
+				Options: options,
+			},
+		}
+	}
+
+	It("resolves attached routes and the policies which apply", func() {
+		now := time.Now()
+		missingSection := gwv1.SectionName("missing")
+		objs := []client.Object{
+			gateway(),
+			httpRoute("route", nil),
+			httpRoute("detached", &missingSection),
+			&solokubev1.RouteOption{
+				ObjectMeta: metav1.ObjectMeta{Name: "route-opt", Namespace: "default", CreationTimestamp: metav1.NewTime(now)},
+				Spec: sologatewayv1.RouteOption{
+					TargetRefs: []*corev1.PolicyTargetReference{{
+						Group:     gwv1.GroupVersion.Group,
+						Kind:      wellknown.HTTPRouteKind,
+						Name:      "route",
+						Namespace: wrapperspb.String("default"),
+					}},
+					Options: &v1.RouteOptions{PrefixRewrite: wrapperspb.String("/")},
+				},
+			},
+			&solokubev1.ListenerOption{
+				ObjectMeta: metav1.ObjectMeta{Name: "listener-opt", Namespace: "default", CreationTimestamp: metav1.NewTime(now)},
+				Spec: sologatewayv1.ListenerOption{
+					TargetRefs: []*corev1.PolicyTargetReferenceWithSectionName{{
+						Group: gwv1.GroupVersion.Group,
+						Kind:  wellknown.GatewayKind,
+						Name:  "gw",
+					}},
+					Options: &v1.ListenerOptions{},
+				},
+			},
+			virtualHostOption("vh-cors", now.Add(-time.Hour), &v1.VirtualHostOptions{Cors: &cors.CorsPolicy{AllowOrigin: []string{"a.com"}}}),
+			virtualHostOption("vh-retries", now, &v1.VirtualHostOptions{Retries: &retries.RetryPolicy{NumRetries: 3}}),
+			// overridden by vh-cors, which is older
+			virtualHostOption("vh-cors-override", now, &v1.VirtualHostOptions{Cors: &cors.CorsPolicy{AllowOrigin: []string{"b.com"}}}),
+		}
+
+		views, err := gatewayapi.NewSnapshot(objs).Resolve(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(views.Gateways).To(HaveLen(1))
+		gw := views.Gateways[0]
+		Expect(gw.ObjectRef).To(Equal(ref(wellknown.GatewayKind, "gw")))
+		Expect(gw.GatewayClass).To(Equal("gloo-gateway"))
+		Expect(gw.Listeners).To(HaveLen(1))
+		listener := gw.Listeners[0]
+		Expect(listener.Name).To(Equal("http"))
+		Expect(listener.Port).To(Equal(int32(8080)))
+		Expect(listener.AttachedRoutes).To(ConsistOf(ref(wellknown.HTTPRouteKind, "route")))
+		Expect(listener.Policies).To(ConsistOf(
+			&gatewayapi.PolicyView{ObjectRef: ref(sologatewayv1.ListenerOptionGVK.Kind, "listener-opt"), Applied: true},
+			&gatewayapi.PolicyView{ObjectRef: ref(sologatewayv1.VirtualHostOptionGVK.Kind, "vh-cors"), Applied: true},
+			&gatewayapi.PolicyView{ObjectRef: ref(sologatewayv1.VirtualHostOptionGVK.Kind, "vh-retries"), Applied: true},
+			&gatewayapi.PolicyView{ObjectRef: ref(sologatewayv1.VirtualHostOptionGVK.Kind, "vh-cors-override"), Applied: false},
+		))
+
+		Expect(views.Routes).To(HaveLen(2))
+		detached, route := views.Routes[0], views.Routes[1]
+
+		Expect(route.ObjectRef).To(Equal(ref(wellknown.HTTPRouteKind, "route")))
+		Expect(route.Hostnames).To(ConsistOf("example.com"))
+		Expect(route.Parents).To(ConsistOf(&gatewayapi.RouteParentView{
+			Parent:    ref(wellknown.GatewayKind, "gw"),
+			Listeners: []string{"http"},
+			Accepted:  true,
+		}))
+		Expect(route.Rules).To(HaveLen(1))
+		Expect(route.Rules[0].Policies).To(ConsistOf(
+			&gatewayapi.PolicyView{ObjectRef: ref(sologatewayv1.RouteOptionGVK.Kind, "route-opt"), Applied: true},
+		))
+
+		Expect(detached.ObjectRef).To(Equal(ref(wellknown.HTTPRouteKind, "detached")))
+		Expect(detached.Parents).To(HaveLen(1))
+		Expect(detached.Parents[0].Accepted).To(BeFalse())
+		Expect(detached.Parents[0].SectionName).To(Equal("missing"))
+		Expect(detached.Parents[0].Reason).To(Equal(string(gwv1.RouteReasonNoMatchingParent)))
+		Expect(detached.Rules[0].Policies).To(BeEmpty())
+	})
+
+	It("resolves nothing from an empty snapshot", func() {
+		views, err := gatewayapi.NewSnapshot(nil).Resolve(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(views.Gateways).To(BeEmpty())
+		Expect(views.Routes).To(BeEmpty())
+		Expect(views.ListenerSets).To(BeEmpty())
+	})
+})
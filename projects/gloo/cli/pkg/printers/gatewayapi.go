@@ -0,0 +1,194 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"github.com/olekukonko/tablewriter"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/gatewayapi"
+)
+
+func PrintGateways(gateways []*gatewayapi.GatewayView, outputType OutputType) error {
+	return printGatewayAPIViews(gateways, outputType, os.Stdout, func(w io.Writer) {
+		GatewayTable(gateways, w)
+	})
+}
+
+func PrintXRoutes(routes []*gatewayapi.RouteView, outputType OutputType) error {
+	return printGatewayAPIViews(routes, outputType, os.Stdout, func(w io.Writer) {
+		XRouteTable(routes, w)
+	})
+}
+
+func PrintListenerSets(listenerSets []*gatewayapi.ListenerSetView, outputType OutputType) error {
+	return printGatewayAPIViews(listenerSets, outputType, os.Stdout, func(w io.Writer) {
+		ListenerSetTable(listenerSets, w)
+	})
+}
+
+// the views are not protos, so they are printed with the json tags of the view types
+func printGatewayAPIViews(views interface{}, outputType OutputType, w io.Writer, table func(io.Writer)) error {
+	switch outputType {
+	case YAML, KUBE_YAML:
+		raw, err := yaml.Marshal(views)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(raw)
+		return err
+	case JSON:
+		raw, err := json.MarshalIndent(views, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(raw))
+		return err
+	default:
+		table(w)
+		return nil
+	}
+}
+
+// GatewayTable prints gateways using tables to io.Writer, with a row for each listener
+func GatewayTable(gateways []*gatewayapi.GatewayView, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Gateway", "Namespace", "Class", "Listener", "Attached Routes", "Policies"})
+	// each value is printed on its own row, so values are not wrapped
+	table.SetAutoWrapText(false)
+
+	for _, gw := range gateways {
+		rows := listenerRows(gw.Listeners)
+		if len(rows) == 0 {
+			rows = [][]string{{"", "", ""}}
+		}
+		for i, row := range rows {
+			if i == 0 {
+				table.Append(append([]string{gw.Name, gw.Namespace, gw.GatewayClass}, row...))
+			} else {
+				table.Append(append([]string{"", "", ""}, row...))
+			}
+		}
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+// XRouteTable prints Gateway API routes using tables to io.Writer
+func XRouteTable(routes []*gatewayapi.RouteView, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Route", "Namespace", "Hostnames", "Parents", "Policies"})
+	table.SetAutoWrapText(false)
+
+	for _, route := range routes {
+		var parents, policies []string
+		for _, parent := range route.Parents {
+			parents = append(parents, routeParentDescription(parent))
+		}
+		for _, rule := range route.Rules {
+			name := strconv.Itoa(rule.Index)
+			if rule.Name != "" {
+				name = rule.Name
+			}
+			for _, policy := range appliedPolicies(rule.Policies) {
+				policies = append(policies, fmt.Sprintf("rule %s: %s", name, policy))
+			}
+			if rule.Error != "" {
+				policies = append(policies, fmt.Sprintf("rule %s: error: %s", name, rule.Error))
+			}
+		}
+
+		rows := max(len(route.Hostnames), len(parents), len(policies), 1)
+		for i := 0; i < rows; i++ {
+			var name, namespace string
+			if i == 0 {
+				name, namespace = route.Name, route.Namespace
+			}
+			table.Append([]string{name, namespace, lineAt(route.Hostnames, i), lineAt(parents, i), lineAt(policies, i)})
+		}
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+// ListenerSetTable prints listener sets using tables to io.Writer, with a row for each listener
+func ListenerSetTable(listenerSets []*gatewayapi.ListenerSetView, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"ListenerSet", "Namespace", "Gateway", "Accepted", "Listener", "Attached Routes", "Policies"})
+	table.SetAutoWrapText(false)
+
+	for _, ls := range listenerSets {
+		rows := listenerRows(ls.Listeners)
+		if len(rows) == 0 {
+			rows = [][]string{{"", "", ""}}
+		}
+		gateway := fmt.Sprintf("%s.%s", ls.Gateway.Namespace, ls.Gateway.Name)
+		for i, row := range rows {
+			if i == 0 {
+				table.Append(append([]string{ls.Name, ls.Namespace, gateway, strconv.FormatBool(ls.Accepted)}, row...))
+			} else {
+				table.Append(append([]string{"", "", "", ""}, row...))
+			}
+		}
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+// listenerRows returns the listener, attached routes and policies columns of the listeners
+func listenerRows(listeners []*gatewayapi.ListenerView) [][]string {
+	var rows [][]string
+	for _, listener := range listeners {
+		listenerLines := []string{fmt.Sprintf("%s %s:%d", listener.Name, listener.Protocol, listener.Port)}
+		if listener.Hostname != "" {
+			listenerLines = append(listenerLines, listener.Hostname)
+		}
+		if listener.Error != "" {
+			listenerLines = append(listenerLines, "error: "+listener.Error)
+		}
+		var routes []string
+		for _, route := range listener.AttachedRoutes {
+			routes = append(routes, route.String())
+		}
+		policies := appliedPolicies(listener.Policies)
+
+		for i := 0; i < max(len(listenerLines), len(routes), len(policies)); i++ {
+			rows = append(rows, []string{lineAt(listenerLines, i), lineAt(routes, i), lineAt(policies, i)})
+		}
+	}
+	return rows
+}
+
+func appliedPolicies(policies []*gatewayapi.PolicyView) []string {
+	var out []string
+	for _, policy := range policies {
+		if policy.Applied {
+			out = append(out, policy.String())
+		}
+	}
+	return out
+}
+
+func routeParentDescription(parent *gatewayapi.RouteParentView) string {
+	description := parent.Parent.String()
+	if parent.SectionName != "" {
+		description += "/" + parent.SectionName
+	}
+	if !parent.Accepted {
+		return fmt.Sprintf("%s (%s)", description, parent.Reason)
+	}
+	return description
+}
+
+func lineAt(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package printers
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/gatewayapi"
+)
+
+var _ = Describe("Gateway API", func() {
+
+	gwRef := gatewayapi.ObjectRef{Kind: "Gateway", Namespace: "default", Name: "gw"}
+	routeRef := gatewayapi.ObjectRef{Kind: "HTTPRoute", Namespace: "default", Name: "route"}
+
+	listener := func() *gatewayapi.ListenerView {
+		return &gatewayapi.ListenerView{
+			Name:           "http",
+			Parent:         gwRef,
+			Protocol:       "HTTP",
+			Port:           8080,
+			AttachedRoutes: []gatewayapi.ObjectRef{routeRef},
+			Policies: []*gatewayapi.PolicyView{
+				{ObjectRef: gatewayapi.ObjectRef{Kind: "VirtualHostOption", Namespace: "default", Name: "applied"}, Applied: true},
+				{ObjectRef: gatewayapi.ObjectRef{Kind: "VirtualHostOption", Namespace: "default", Name: "overridden"}},
+			},
+		}
+	}
+
+	It("prints the listeners of gateways with the policies which apply", func() {
+		gw := &gatewayapi.GatewayView{ObjectRef: gwRef, GatewayClass: "gloo-gateway", Listeners: []*gatewayapi.ListenerView{listener()}}
+
+		var out bytes.Buffer
+		GatewayTable([]*gatewayapi.GatewayView{gw}, &out)
+		Expect(out.String()).To(ContainSubstring("http HTTP:8080"))
+		Expect(out.String()).To(ContainSubstring("HTTPRoute default.route"))
+		Expect(out.String()).To(ContainSubstring("VirtualHostOption default.applied"))
+		Expect(out.String()).NotTo(ContainSubstring("overridden"))
+	})
+
+	It("prints the parents of routes and why they were not accepted", func() {
+		route := &gatewayapi.RouteView{
+			ObjectRef: routeRef,
+			Hostnames: []string{"example.com"},
+			Parents: []*gatewayapi.RouteParentView{
+				{Parent: gwRef, Listeners: []string{"http"}, Accepted: true},
+				{Parent: gwRef, SectionName: "missing", Reason: "NoMatchingParent"},
+			},
+			Rules: []*gatewayapi.RuleView{{
+				Index:    0,
+				Policies: []*gatewayapi.PolicyView{{ObjectRef: gatewayapi.ObjectRef{Kind: "RouteOption", Namespace: "default", Name: "opt"}, Applied: true}},
+			}},
+		}
+
+		var out bytes.Buffer
+		XRouteTable([]*gatewayapi.RouteView{route}, &out)
+		Expect(out.String()).To(ContainSubstring("example.com"))
+		Expect(out.String()).To(ContainSubstring("Gateway default.gw/missing (NoMatchingParent)"))
+		Expect(out.String()).To(ContainSubstring("rule 0: RouteOption default.opt"))
+	})
+
+	It("prints the gateway of listener sets", func() {
+		ls := &gatewayapi.ListenerSetView{
+			ObjectRef: gatewayapi.ObjectRef{Kind: "XListenerSet", Namespace: "default", Name: "ls"},
+			Gateway:   gwRef,
+			Accepted:  true,
+			Listeners: []*gatewayapi.ListenerView{listener()},
+		}
+
+		var out bytes.Buffer
+		ListenerSetTable([]*gatewayapi.ListenerSetView{ls}, &out)
+		Expect(out.String()).To(ContainSubstring("default.gw"))
+		Expect(out.String()).To(ContainSubstring("true"))
+		Expect(out.String()).To(ContainSubstring("http HTTP:8080"))
+	})
+
+	It("prints views as yaml and json", func() {
+		routes := []*gatewayapi.RouteView{{ObjectRef: routeRef}}
+
+		var out bytes.Buffer
+		Expect(printGatewayAPIViews(routes, YAML, &out, nil)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("kind: HTTPRoute"))
+
+		out.Reset()
+		Expect(printGatewayAPIViews(routes, JSON, &out, nil)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring(`"kind": "HTTPRoute"`))
+	})
+})
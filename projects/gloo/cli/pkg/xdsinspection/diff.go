@@ -0,0 +1,468 @@
+package xdsinspection
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/rotisserie/eris"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+)
+
+// ResourceType is the type of an xDS resource
+type ResourceType string
+
+const (
+	ListenerType    ResourceType = "Listener"
+	RouteType       ResourceType = "RouteConfiguration"
+	ClusterType     ResourceType = "Cluster"
+	EndpointsType   ResourceType = "ClusterLoadAssignment"
+	diffIndentation              = "    "
+)
+
+// DiffStatus describes how a resource served by Gloo compares to the resource loaded by Envoy
+type DiffStatus string
+
+const (
+	// InSync resources are loaded by Envoy as they are served by Gloo
+	InSync DiffStatus = "in sync"
+	// Changed resources are loaded by Envoy, but differ from the resource served by Gloo
+	Changed DiffStatus = "changed"
+	// Missing resources are served by Gloo, but not loaded by Envoy
+	Missing DiffStatus = "missing"
+	// Extra resources are loaded by Envoy, but no longer served by Gloo
+	Extra DiffStatus = "extra"
+)
+
+// FieldDiff is a field that differs between the served and the loaded resource.
+// Values are JSON encoded, and empty if the field is not set.
+type FieldDiff struct {
+	Path   string
+	Served string
+	Loaded string
+}
+
+// UpdateFailure is the last update of a resource that Envoy rejected (NACKed)
+type UpdateFailure struct {
+	Version     string
+	Details     string
+	LastAttempt string
+}
+
+// ResourceDiff compares a single resource served by Gloo with the resource loaded by Envoy
+type ResourceDiff struct {
+	Type          ResourceType
+	Name          string
+	Status        DiffStatus
+	ServedVersion string
+	LoadedVersion string
+	Fields        []FieldDiff
+	// Failure is set if Envoy rejected an update of the resource
+	Failure *UpdateFailure
+}
+
+// ProxyDiff compares the xDS snapshot served by Gloo to a proxy with the config loaded by the proxy
+type ProxyDiff struct {
+	// Resources are sorted by type, then name
+	Resources []ResourceDiff
+	// IncludesEndpoints is false if the Envoy config dump did not include EDS
+	IncludesEndpoints bool
+}
+
+// InSync returns true if Envoy loaded every resource served by Gloo, and did not reject any update
+func (d *ProxyDiff) InSync() bool {
+	for _, r := range d.Resources {
+		if r.Status != InSync || r.Failure != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// loadedResource is a resource in the Envoy config dump
+type loadedResource struct {
+	version string
+	message proto.Message
+	failure *UpdateFailure
+}
+
+// ParseXdsNodeSnapshot parses the response of the /snapshots/xds?node=<node> endpoint of the Gloo admin server
+func ParseXdsNodeSnapshot(data []byte) (*iosnapshot.XdsNodeSnapshot, error) {
+	var response struct {
+		Data  *iosnapshot.XdsNodeSnapshot `json:"data"`
+		Error string                      `json:"error"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, eris.Wrapf(err, "parsing xds snapshot")
+	}
+	if response.Error != "" {
+		return nil, eris.Errorf("getting xds snapshot: %s", response.Error)
+	}
+	if response.Data == nil {
+		return nil, eris.New("xds snapshot is empty")
+	}
+	return response.Data, nil
+}
+
+// DiffConfig compares the xDS snapshot served by Gloo with the config dump of the Envoy it was served to.
+// Endpoints are only compared if the config dump includes EDS.
+func DiffConfig(served *iosnapshot.XdsNodeSnapshot, configDump *adminv3.ConfigDump) (*ProxyDiff, error) {
+	loaded, includesEndpoints, err := loadedResources(configDump)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &ProxyDiff{IncludesEndpoints: includesEndpoints}
+	for _, resourceType := range []struct {
+		typ       ResourceType
+		resources iosnapshot.XdsResources
+		newMsg    func() proto.Message
+	}{
+		{ListenerType, served.Listeners, func() proto.Message { return &envoylistener.Listener{} }},
+		{RouteType, served.Routes, func() proto.Message { return &envoy_config_route_v3.RouteConfiguration{} }},
+		{ClusterType, served.Clusters, func() proto.Message { return &envoycluster.Cluster{} }},
+		{EndpointsType, served.Endpoints, func() proto.Message { return &envoyendpoint.ClusterLoadAssignment{} }},
+	} {
+		if resourceType.typ == EndpointsType && !includesEndpoints {
+			continue
+		}
+		typeDiffs, err := diffResources(resourceType.typ, resourceType.resources, loaded[resourceType.typ], resourceType.newMsg)
+		if err != nil {
+			return nil, err
+		}
+		diff.Resources = append(diff.Resources, typeDiffs...)
+	}
+	return diff, nil
+}
+
+func diffResources(
+	typ ResourceType,
+	served iosnapshot.XdsResources,
+	loaded map[string]loadedResource,
+	newMsg func() proto.Message,
+) ([]ResourceDiff, error) {
+	names := map[string]bool{}
+	for name := range served.Resources {
+		names[name] = true
+	}
+	for name := range loaded {
+		names[name] = true
+	}
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	var diffs []ResourceDiff
+	for _, name := range sortedNames {
+		loadedResource, isLoaded := loaded[name]
+		diff := ResourceDiff{
+			Type:          typ,
+			Name:          name,
+			LoadedVersion: loadedResource.version,
+			Failure:       loadedResource.failure,
+		}
+		servedJson, isServed := served.Resources[name]
+		if isServed {
+			diff.ServedVersion = served.Version
+		}
+
+		switch {
+		case !isServed:
+			diff.Status = Extra
+		case !isLoaded || loadedResource.message == nil:
+			diff.Status = Missing
+		default:
+			servedMsg := newMsg()
+			if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(servedJson, servedMsg); err != nil {
+				return nil, eris.Wrapf(err, "parsing served %s %s", typ, name)
+			}
+			fields, err := diffMessages(servedMsg, loadedResource.message)
+			if err != nil {
+				return nil, eris.Wrapf(err, "comparing %s %s", typ, name)
+			}
+			diff.Fields = fields
+			diff.Status = InSync
+			if len(fields) > 0 {
+				diff.Status = Changed
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+// loadedResources indexes the dynamic resources of the config dump by type and name
+func loadedResources(configDump *adminv3.ConfigDump) (map[ResourceType]map[string]loadedResource, bool, error) {
+	loaded := map[ResourceType]map[string]loadedResource{
+		ListenerType:  {},
+		RouteType:     {},
+		ClusterType:   {},
+		EndpointsType: {},
+	}
+	includesEndpoints := false
+	addLoaded := func(typ ResourceType, name string, resource loadedResource) {
+		if name == "" {
+			// neither a loaded nor a rejected resource identifies this entry, so there is nothing to compare it to
+			return
+		}
+		loaded[typ][name] = resource
+	}
+
+	for _, config := range configDump.GetConfigs() {
+		msg, err := config.UnmarshalNew()
+		if err != nil {
+			// the config dump includes sections that are not relevant to xDS, and may not be known to this client
+			continue
+		}
+		switch dump := msg.(type) {
+		case *adminv3.ListenersConfigDump:
+			for _, l := range dump.GetDynamicListeners() {
+				state := l.GetActiveState()
+				if state == nil {
+					state = l.GetWarmingState()
+				}
+				_, resource, err := newLoadedResource(state.GetVersionInfo(), state.GetListener(), l.GetErrorState())
+				if err != nil {
+					return nil, false, err
+				}
+				addLoaded(ListenerType, l.GetName(), resource)
+			}
+		case *adminv3.RoutesConfigDump:
+			for _, r := range dump.GetDynamicRouteConfigs() {
+				name, resource, err := newLoadedResource(r.GetVersionInfo(), r.GetRouteConfig(), r.GetErrorState())
+				if err != nil {
+					return nil, false, err
+				}
+				addLoaded(RouteType, name, resource)
+			}
+		case *adminv3.ClustersConfigDump:
+			clusters := append(dump.GetDynamicActiveClusters(), dump.GetDynamicWarmingClusters()...)
+			for _, c := range clusters {
+				name, resource, err := newLoadedResource(c.GetVersionInfo(), c.GetCluster(), c.GetErrorState())
+				if err != nil {
+					return nil, false, err
+				}
+				addLoaded(ClusterType, name, resource)
+			}
+		case *adminv3.EndpointsConfigDump:
+			includesEndpoints = true
+			for _, e := range dump.GetDynamicEndpointConfigs() {
+				name, resource, err := newLoadedResource(e.GetVersionInfo(), e.GetEndpointConfig(), e.GetErrorState())
+				if err != nil {
+					return nil, false, err
+				}
+				addLoaded(EndpointsType, name, resource)
+			}
+		}
+	}
+	return loaded, includesEndpoints, nil
+}
+
+// newLoadedResource parses a dynamic resource of the config dump and returns it with its name. The name is the one
+// of the loaded resource, or of the rejected resource if Envoy NACKed the resource before loading any version of it.
+func newLoadedResource(version string, resource *anypb.Any, errorState *adminv3.UpdateFailureState) (string, loadedResource, error) {
+	loaded := loadedResource{version: version}
+	var name string
+	if resource != nil {
+		msg, err := resource.UnmarshalNew()
+		if err != nil {
+			return "", loadedResource{}, eris.Wrapf(err, "parsing %s from config dump", resource.GetTypeUrl())
+		}
+		loaded.message = msg
+		name = resourceName(msg)
+	}
+	if name == "" && errorState.GetFailedConfiguration() != nil {
+		failed, err := errorState.GetFailedConfiguration().UnmarshalNew()
+		if err != nil {
+			return "", loadedResource{}, eris.Wrapf(err, "parsing rejected %s from config dump", errorState.GetFailedConfiguration().GetTypeUrl())
+		}
+		name = resourceName(failed)
+	}
+	if errorState != nil {
+		loaded.failure = &UpdateFailure{
+			Version: errorState.GetVersionInfo(),
+			Details: errorState.GetDetails(),
+		}
+		if errorState.GetLastUpdateAttempt() != nil {
+			loaded.failure.LastAttempt = errorState.GetLastUpdateAttempt().AsTime().String()
+		}
+	}
+	return name, loaded, nil
+}
+
+func resourceName(msg proto.Message) string {
+	switch m := msg.(type) {
+	case *envoy_config_route_v3.RouteConfiguration:
+		return m.GetName()
+	case *envoycluster.Cluster:
+		return m.GetName()
+	case *envoyendpoint.ClusterLoadAssignment:
+		return m.GetClusterName()
+	}
+	return ""
+}
+
+// diffMessages returns the fields that differ between the messages. Messages are compared in their
+// JSON representation, with the proto field names that Envoy uses in its config dump.
+func diffMessages(served, loaded proto.Message) ([]FieldDiff, error) {
+	if proto.Equal(served, loaded) {
+		return nil, nil
+	}
+	servedTree, err := jsonTree(served)
+	if err != nil {
+		return nil, err
+	}
+	loadedTree, err := jsonTree(loaded)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []FieldDiff
+	diffTrees("", servedTree, loadedTree, &diffs)
+	return diffs, nil
+}
+
+func jsonTree(msg proto.Message) (any, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var tree any
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+func diffTrees(path string, served, loaded any, diffs *[]FieldDiff) {
+	servedMap, servedIsMap := served.(map[string]any)
+	loadedMap, loadedIsMap := loaded.(map[string]any)
+	if servedIsMap && loadedIsMap {
+		keys := map[string]bool{}
+		for k := range servedMap {
+			keys[k] = true
+		}
+		for k := range loadedMap {
+			keys[k] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+		for _, k := range sortedKeys {
+			diffTrees(joinPath(path, k), servedMap[k], loadedMap[k], diffs)
+		}
+		return
+	}
+
+	servedList, servedIsList := served.([]any)
+	loadedList, loadedIsList := loaded.([]any)
+	if servedIsList && loadedIsList {
+		for i := 0; i < len(servedList) || i < len(loadedList); i++ {
+			var servedItem, loadedItem any
+			if i < len(servedList) {
+				servedItem = servedList[i]
+			}
+			if i < len(loadedList) {
+				loadedItem = loadedList[i]
+			}
+			diffTrees(fmt.Sprintf("%s[%d]", path, i), servedItem, loadedItem, diffs)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(served, loaded) {
+		*diffs = append(*diffs, FieldDiff{
+			Path:   path,
+			Served: jsonValue(served),
+			Loaded: jsonValue(loaded),
+		})
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func jsonValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// Print writes a human readable summary of the diff. In sync resources are only listed if verbose is set.
+func (d *ProxyDiff) Print(w io.Writer, verbose bool) {
+	for _, typ := range []ResourceType{ListenerType, RouteType, ClusterType, EndpointsType} {
+		if typ == EndpointsType && !d.IncludesEndpoints {
+			fmt.Fprintf(w, "%ss: not compared, the config dump does not include endpoints\n", typ)
+			continue
+		}
+		counts := map[DiffStatus]int{}
+		var lines []string
+		for _, r := range d.Resources {
+			if r.Type != typ {
+				continue
+			}
+			counts[r.Status]++
+			if r.Status == InSync && r.Failure == nil && !verbose {
+				continue
+			}
+			lines = append(lines, r.lines()...)
+		}
+		fmt.Fprintf(w, "%ss: %d in sync, %d changed, %d missing, %d extra\n",
+			typ, counts[InSync], counts[Changed], counts[Missing], counts[Extra])
+		for _, line := range lines {
+			fmt.Fprintf(w, "%s%s\n", diffIndentation, line)
+		}
+	}
+}
+
+func (r ResourceDiff) lines() []string {
+	marker := map[DiffStatus]string{InSync: "=", Changed: "~", Missing: "-", Extra: "+"}[r.Status]
+	lines := []string{fmt.Sprintf("%s %s (%s, served version %s, loaded version %s)",
+		marker, r.Name, r.Status, versionOrNone(r.ServedVersion), versionOrNone(r.LoadedVersion))}
+	for _, field := range r.Fields {
+		lines = append(lines, fmt.Sprintf("%s%s: %s -> %s", diffIndentation, field.Path, valueOrUnset(field.Served), valueOrUnset(field.Loaded)))
+	}
+	if r.Failure != nil {
+		lines = append(lines, fmt.Sprintf("%sNACKED version %s at %s: %s",
+			diffIndentation, versionOrNone(r.Failure.Version), valueOrUnset(r.Failure.LastAttempt), strings.TrimSpace(r.Failure.Details)))
+	}
+	return lines
+}
+
+func versionOrNone(version string) string {
+	if version == "" {
+		return "<none>"
+	}
+	return version
+}
+
+func valueOrUnset(value string) string {
+	if value == "" {
+		return "<unset>"
+	}
+	return value
+}
@@ -0,0 +1,227 @@
+package xdsinspection_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	. "github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+)
+
+var _ = Describe("DiffConfig", func() {
+
+	var (
+		served *iosnapshot.XdsNodeSnapshot
+	)
+
+	mustJson := func(msg proto.Message) json.RawMessage {
+		b, err := protojson.Marshal(msg)
+		Expect(err).NotTo(HaveOccurred())
+		return b
+	}
+
+	mustAny := func(msg proto.Message) *anypb.Any {
+		a, err := anypb.New(msg)
+		Expect(err).NotTo(HaveOccurred())
+		return a
+	}
+
+	newCluster := func(name string, connectTimeout time.Duration) *envoycluster.Cluster {
+		return &envoycluster.Cluster{
+			Name:           name,
+			ConnectTimeout: durationpb.New(connectTimeout),
+		}
+	}
+
+	newListener := func(name string, continueOnTimeout bool) *envoylistener.Listener {
+		return &envoylistener.Listener{
+			Name:                             name,
+			StatPrefix:                       name,
+			ContinueOnListenerFiltersTimeout: continueOnTimeout,
+		}
+	}
+
+	clustersDump := func(clusters ...*adminv3.ClustersConfigDump_DynamicCluster) *adminv3.ConfigDump {
+		return &adminv3.ConfigDump{
+			Configs: []*anypb.Any{
+				mustAny(&adminv3.ClustersConfigDump{DynamicActiveClusters: clusters}),
+			},
+		}
+	}
+
+	dynamicCluster := func(version string, cluster *envoycluster.Cluster) *adminv3.ClustersConfigDump_DynamicCluster {
+		return &adminv3.ClustersConfigDump_DynamicCluster{
+			VersionInfo: version,
+			Cluster:     mustAny(cluster),
+		}
+	}
+
+	BeforeEach(func() {
+		served = &iosnapshot.XdsNodeSnapshot{
+			Clusters: iosnapshot.XdsResources{
+				Version: "1",
+				Resources: map[string]json.RawMessage{
+					"cluster-a": mustJson(newCluster("cluster-a", time.Second)),
+				},
+			},
+		}
+	})
+
+	It("reports resources loaded as served as in sync", func() {
+		diff, err := DiffConfig(served, clustersDump(dynamicCluster("1", newCluster("cluster-a", time.Second))))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.InSync()).To(BeTrue())
+		Expect(diff.IncludesEndpoints).To(BeFalse())
+		Expect(diff.Resources).To(ConsistOf(ResourceDiff{
+			Type:          ClusterType,
+			Name:          "cluster-a",
+			Status:        InSync,
+			ServedVersion: "1",
+			LoadedVersion: "1",
+		}))
+	})
+
+	It("reports the paths of fields that differ", func() {
+		diff, err := DiffConfig(served, clustersDump(dynamicCluster("0", newCluster("cluster-a", 5*time.Second))))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.InSync()).To(BeFalse())
+		Expect(diff.Resources).To(HaveLen(1))
+		Expect(diff.Resources[0].Status).To(Equal(Changed))
+		Expect(diff.Resources[0].Fields).To(ConsistOf(FieldDiff{
+			Path:   "connect_timeout",
+			Served: `"1s"`,
+			Loaded: `"5s"`,
+		}))
+	})
+
+	It("reports missing and extra resources", func() {
+		diff, err := DiffConfig(served, clustersDump(dynamicCluster("1", newCluster("cluster-b", time.Second))))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.InSync()).To(BeFalse())
+		Expect(diff.Resources).To(HaveLen(2))
+		Expect(diff.Resources[0].Name).To(Equal("cluster-a"))
+		Expect(diff.Resources[0].Status).To(Equal(Missing))
+		Expect(diff.Resources[1].Name).To(Equal("cluster-b"))
+		Expect(diff.Resources[1].Status).To(Equal(Extra))
+	})
+
+	It("reports updates rejected by envoy", func() {
+		loaded := dynamicCluster("1", newCluster("cluster-a", time.Second))
+		loaded.ErrorState = &adminv3.UpdateFailureState{
+			VersionInfo:       "2",
+			Details:           "invalid cluster",
+			LastUpdateAttempt: timestamppb.New(time.Unix(0, 0)),
+		}
+		diff, err := DiffConfig(served, clustersDump(loaded))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.InSync()).To(BeFalse())
+		Expect(diff.Resources).To(HaveLen(1))
+		Expect(diff.Resources[0].Status).To(Equal(InSync))
+		Expect(diff.Resources[0].Failure).NotTo(BeNil())
+		Expect(diff.Resources[0].Failure.Version).To(Equal("2"))
+		Expect(diff.Resources[0].Failure.Details).To(Equal("invalid cluster"))
+
+		var out bytes.Buffer
+		diff.Print(&out, false)
+		Expect(out.String()).To(ContainSubstring("invalid cluster"))
+	})
+
+	It("reports rejected resources that were never loaded by the name of the rejected resource", func() {
+		served.Clusters.Resources["cluster-b"] = mustJson(newCluster("cluster-b", time.Second))
+		rejected := func(cluster *envoycluster.Cluster) *adminv3.ClustersConfigDump_DynamicCluster {
+			return &adminv3.ClustersConfigDump_DynamicCluster{
+				ErrorState: &adminv3.UpdateFailureState{
+					FailedConfiguration: mustAny(cluster),
+					VersionInfo:         "1",
+					Details:             "invalid cluster " + cluster.GetName(),
+				},
+			}
+		}
+		diff, err := DiffConfig(served, clustersDump(
+			rejected(newCluster("cluster-a", time.Second)),
+			rejected(newCluster("cluster-b", time.Second)),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.Resources).To(HaveLen(2))
+		for i, name := range []string{"cluster-a", "cluster-b"} {
+			Expect(diff.Resources[i].Name).To(Equal(name))
+			Expect(diff.Resources[i].Status).To(Equal(Missing))
+			Expect(diff.Resources[i].Failure).NotTo(BeNil())
+			Expect(diff.Resources[i].Failure.Details).To(Equal("invalid cluster " + name))
+		}
+	})
+
+	It("compares listeners by name", func() {
+		served.Listeners = iosnapshot.XdsResources{
+			Version: "1",
+			Resources: map[string]json.RawMessage{
+				"listener": mustJson(newListener("listener", true)),
+			},
+		}
+		configDump := clustersDump(dynamicCluster("1", newCluster("cluster-a", time.Second)))
+		configDump.Configs = append(configDump.Configs, mustAny(&adminv3.ListenersConfigDump{
+			DynamicListeners: []*adminv3.ListenersConfigDump_DynamicListener{{
+				Name: "listener",
+				WarmingState: &adminv3.ListenersConfigDump_DynamicListenerState{
+					VersionInfo: "1",
+					Listener:    mustAny(newListener("listener", false)),
+				},
+			}},
+		}))
+
+		diff, err := DiffConfig(served, configDump)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.Resources).To(HaveLen(2))
+		Expect(diff.Resources[0].Type).To(Equal(ListenerType))
+		Expect(diff.Resources[0].Status).To(Equal(Changed))
+		Expect(diff.Resources[0].Fields).To(ConsistOf(FieldDiff{
+			Path:   "continue_on_listener_filters_timeout",
+			Served: "true",
+		}))
+	})
+
+	It("does not compare endpoints if the config dump does not include them", func() {
+		served.Endpoints = iosnapshot.XdsResources{
+			Version:   "1",
+			Resources: map[string]json.RawMessage{"cluster-a": json.RawMessage(`{"clusterName":"cluster-a"}`)},
+		}
+		diff, err := DiffConfig(served, clustersDump(dynamicCluster("1", newCluster("cluster-a", time.Second))))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.IncludesEndpoints).To(BeFalse())
+		Expect(diff.InSync()).To(BeTrue())
+	})
+})
+
+var _ = Describe("ParseXdsNodeSnapshot", func() {
+
+	It("returns the error of the response", func() {
+		_, err := ParseXdsNodeSnapshot([]byte(`{"error":"no snapshot for node"}`))
+		Expect(err).To(MatchError(ContainSubstring("no snapshot for node")))
+	})
+
+	It("parses the snapshot", func() {
+		snap, err := ParseXdsNodeSnapshot([]byte(`{"data":{"clusters":{"version":"1","resources":{"a":{"name":"a"}}}}}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snap.Clusters.Version).To(Equal("1"))
+		Expect(snap.Clusters.Resources).To(HaveKey("a"))
+	})
+})
@@ -0,0 +1,13 @@
+package xdsinspection_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestXdsInspection(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "XdsInspection Suite")
+}
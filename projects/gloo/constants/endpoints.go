@@ -0,0 +1,11 @@
+package constants
+
+const (
+	// EndpointZoneAnnotation is set on discovered endpoints to the zone they run in.
+	// Endpoints are grouped by zone into Envoy localities, for zone aware load balancing.
+	EndpointZoneAnnotation = "topology.kubernetes.io/zone"
+	// EndpointHealthStatusAnnotation is set on discovered endpoints to override their Envoy health status.
+	EndpointHealthStatusAnnotation = "gloo.solo.io/health-status"
+	// EndpointHealthStatusDraining marks an endpoint that is terminating, but still serving its in-flight requests.
+	EndpointHealthStatusDraining = "draining"
+)
@@ -280,6 +280,12 @@ func (m *LocalJwks) Clone() proto.Message {
 
 	target.Key = m.GetKey()
 
+	if h, ok := interface{}(m.GetSecretRef()).(clone.Cloner); ok {
+		target.SecretRef = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.SecretRef = proto.Clone(m.GetSecretRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
 	return target
 }
 
@@ -474,6 +474,16 @@ func (m *LocalJwks) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetSecretRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSecretRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSecretRef(), target.GetSecretRef()) {
+			return false
+		}
+	}
+
 	return true
 }
 
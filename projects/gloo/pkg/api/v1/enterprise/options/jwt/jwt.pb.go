@@ -656,7 +656,10 @@ func (x *RemoteJwks) GetAsyncFetch() *v3.JwksAsyncFetch {
 type LocalJwks struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Inline key. this can be json web key, key-set or PEM format.
-	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// A reference to a Secret containing the key, used if `key` is not set.
+	// The key is read from the `jwks` entry of an opaque Kubernetes Secret, and can be in any format supported by `key`.
+	SecretRef     *core.ResourceRef `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -698,6 +701,13 @@ func (x *LocalJwks) GetKey() string {
 	return ""
 }
 
+func (x *LocalJwks) GetSecretRef() *core.ResourceRef {
+	if x != nil {
+		return x.SecretRef
+	}
+	return nil
+}
+
 // Describes the location of a JWT token
 type TokenSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -924,9 +934,11 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_jwt_j
 	"\fupstream_ref\x18\x02 \x01(\v2\x19.core.solo.io.ResourceRefR\vupstreamRef\x12@\n" +
 	"\x0ecache_duration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rcacheDuration\x12c\n" +
 	"\vasync_fetch\x18\x03 \x01(\v2B.solo.io.envoy.extensions.filters.http.jwt_authn.v3.JwksAsyncFetchR\n" +
-	"asyncFetch\"\x1d\n" +
+	"asyncFetch\"W\n" +
 	"\tLocalJwks\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\"\xbe\x01\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
+	"\n" +
+	"secret_ref\x18\x02 \x01(\v2\x19.core.solo.io.ResourceRefR\tsecretRef\"\xbe\x01\n" +
 	"\vTokenSource\x12L\n" +
 	"\aheaders\x18\x01 \x03(\v22.jwt.options.gloo.solo.io.TokenSource.HeaderSourceR\aheaders\x12!\n" +
 	"\fquery_params\x18\x02 \x03(\tR\vqueryParams\x1a>\n" +
@@ -990,13 +1002,14 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_jwt_jwt
 	15, // 14: jwt.options.gloo.solo.io.RemoteJwks.upstream_ref:type_name -> core.solo.io.ResourceRef
 	16, // 15: jwt.options.gloo.solo.io.RemoteJwks.cache_duration:type_name -> google.protobuf.Duration
 	17, // 16: jwt.options.gloo.solo.io.RemoteJwks.async_fetch:type_name -> solo.io.envoy.extensions.filters.http.jwt_authn.v3.JwksAsyncFetch
-	13, // 17: jwt.options.gloo.solo.io.TokenSource.headers:type_name -> jwt.options.gloo.solo.io.TokenSource.HeaderSource
-	6,  // 18: jwt.options.gloo.solo.io.VhostExtension.ProvidersEntry.value:type_name -> jwt.options.gloo.solo.io.Provider
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	15, // 17: jwt.options.gloo.solo.io.LocalJwks.secret_ref:type_name -> core.solo.io.ResourceRef
+	13, // 18: jwt.options.gloo.solo.io.TokenSource.headers:type_name -> jwt.options.gloo.solo.io.TokenSource.HeaderSource
+	6,  // 19: jwt.options.gloo.solo.io.VhostExtension.ProvidersEntry.value:type_name -> jwt.options.gloo.solo.io.Provider
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() {
@@ -12,6 +12,8 @@ import (
 
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 )
 
 // ensure the imports are used
@@ -61,6 +63,10 @@ func (m *ExtensionSettings) Clone() proto.Message {
 		}
 	}
 
+	target.Action = m.GetAction()
+
+	target.Shadow = m.GetShadow()
+
 	return target
 }
 
@@ -110,6 +116,28 @@ func (m *Principal) Clone() proto.Message {
 		target.JwtPrincipal = proto.Clone(m.GetJwtPrincipal()).(*JWTPrincipal)
 	}
 
+	if m.GetSourceIps() != nil {
+		target.SourceIps = make([]string, len(m.GetSourceIps()))
+		for idx, v := range m.GetSourceIps() {
+
+			target.SourceIps[idx] = v
+
+		}
+	}
+
+	if m.GetHeaders() != nil {
+		target.Headers = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetHeaders()))
+		for idx, v := range m.GetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Headers[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.Headers[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
 	return target
 }
 
@@ -95,6 +95,14 @@ func (m *ExtensionSettings) Equal(that interface{}) bool {
 
 	}
 
+	if m.GetAction() != target.GetAction() {
+		return false
+	}
+
+	if m.GetShadow() != target.GetShadow() {
+		return false
+	}
+
 	return true
 }
 
@@ -184,6 +192,34 @@ func (m *Principal) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetSourceIps()) != len(target.GetSourceIps()) {
+		return false
+	}
+	for idx, v := range m.GetSourceIps() {
+
+		if strings.Compare(v, target.GetSourceIps()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
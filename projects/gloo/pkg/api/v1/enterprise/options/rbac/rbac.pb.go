@@ -11,6 +11,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -23,6 +24,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The action to take when a request matches one of the policies.
+type ExtensionSettings_Action int32
+
+const (
+	// Allow requests that match a policy, and deny all others.
+	ExtensionSettings_ALLOW ExtensionSettings_Action = 0
+	// Deny requests that match a policy, and allow all others.
+	ExtensionSettings_DENY ExtensionSettings_Action = 1
+)
+
+// Enum value maps for ExtensionSettings_Action.
+var (
+	ExtensionSettings_Action_name = map[int32]string{
+		0: "ALLOW",
+		1: "DENY",
+	}
+	ExtensionSettings_Action_value = map[string]int32{
+		"ALLOW": 0,
+		"DENY":  1,
+	}
+)
+
+func (x ExtensionSettings_Action) Enum() *ExtensionSettings_Action {
+	p := new(ExtensionSettings_Action)
+	*p = x
+	return p
+}
+
+func (x ExtensionSettings_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExtensionSettings_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_enumTypes[0].Descriptor()
+}
+
+func (ExtensionSettings_Action) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_enumTypes[0]
+}
+
+func (x ExtensionSettings_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExtensionSettings_Action.Descriptor instead.
+func (ExtensionSettings_Action) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // Used to specify how claims should be matched to the value.
 type JWTPrincipal_ClaimMatcher int32
 
@@ -64,11 +114,11 @@ func (x JWTPrincipal_ClaimMatcher) String() string {
 }
 
 func (JWTPrincipal_ClaimMatcher) Descriptor() protoreflect.EnumDescriptor {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_enumTypes[0].Descriptor()
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_enumTypes[1].Descriptor()
 }
 
 func (JWTPrincipal_ClaimMatcher) Type() protoreflect.EnumType {
-	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_enumTypes[0]
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_enumTypes[1]
 }
 
 func (x JWTPrincipal_ClaimMatcher) Number() protoreflect.EnumNumber {
@@ -133,7 +183,14 @@ type ExtensionSettings struct {
 	// If provided on a route, all route settings override any vhost settings
 	Disable bool `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
 	// Named policies to apply.
-	Policies      map[string]*Policy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Policies map[string]*Policy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The action to take when a request matches one of the policies. Defaults to ALLOW.
+	Action ExtensionSettings_Action `protobuf:"varint,3,opt,name=action,proto3,enum=rbac.options.gloo.solo.io.ExtensionSettings_Action" json:"action,omitempty"`
+	// Evaluate the policies without enforcing them (default false). The result of the evaluation is recorded in the
+	// `shadow_allowed` and `shadow_denied` stats of the RBAC filter, and in the `shadow_effective_policy_id` and
+	// `shadow_engine_result` dynamic metadata of the `envoy.filters.http.rbac` filter (`envoy.filters.network.rbac`
+	// on TCP listeners), which can be written to access logs.
+	Shadow        bool `protobuf:"varint,4,opt,name=shadow,proto3" json:"shadow,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,6 +239,20 @@ func (x *ExtensionSettings) GetPolicies() map[string]*Policy {
 	return nil
 }
 
+func (x *ExtensionSettings) GetAction() ExtensionSettings_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ExtensionSettings_ALLOW
+}
+
+func (x *ExtensionSettings) GetShadow() bool {
+	if x != nil {
+		return x.Shadow
+	}
+	return false
+}
+
 type Policy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Principals in this policy.
@@ -249,9 +320,16 @@ func (x *Policy) GetNestedClaimDelimiter() string {
 }
 
 // An RBAC principal - the identity entity (usually a user or a service account).
+// All of the fields set on a principal must match. A principal without any fields set matches any request.
 type Principal struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtPrincipal  *JWTPrincipal          `protobuf:"bytes,1,opt,name=jwt_principal,json=jwtPrincipal,proto3" json:"jwt_principal,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	JwtPrincipal *JWTPrincipal          `protobuf:"bytes,1,opt,name=jwt_principal,json=jwtPrincipal,proto3" json:"jwt_principal,omitempty"`
+	// CIDR ranges (e.g. `10.0.0.0/8` or `192.168.1.1/32`) matched against the address of the downstream client.
+	// Matches if any of the ranges contain the address. On HTTP listeners, the address is the one determined by
+	// the HTTP connection manager, which may come from the `x-forwarded-for` header.
+	SourceIps []string `protobuf:"bytes,2,rep,name=source_ips,json=sourceIps,proto3" json:"source_ips,omitempty"`
+	// Headers that must be present on the request. Not supported on TCP listeners.
+	Headers       []*matchers.HeaderMatcher `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -293,6 +371,20 @@ func (x *Principal) GetJwtPrincipal() *JWTPrincipal {
 	return nil
 }
 
+func (x *Principal) GetSourceIps() []string {
+	if x != nil {
+		return x.SourceIps
+	}
+	return nil
+}
+
+func (x *Principal) GetHeaders() []*matchers.HeaderMatcher {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
 // A JWT principal. To use this, JWT option MUST be enabled.
 type JWTPrincipal struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -420,23 +512,31 @@ var File_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rb
 
 const file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_rawDesc = "" +
 	"\n" +
-	"Ogithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/rbac/rbac.proto\x12\x19rbac.options.gloo.solo.io\x1a\x12extproto/ext.proto\"-\n" +
+	"Ogithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/rbac/rbac.proto\x12\x19rbac.options.gloo.solo.io\x1a\x12extproto/ext.proto\x1aIgithub.com/solo-io/gloo/projects/gloo/api/v1/core/matchers/matchers.proto\"-\n" +
 	"\bSettings\x12!\n" +
-	"\frequire_rbac\x18\x01 \x01(\bR\vrequireRbac\"\xe5\x01\n" +
+	"\frequire_rbac\x18\x01 \x01(\bR\vrequireRbac\"\xe9\x02\n" +
 	"\x11ExtensionSettings\x12\x18\n" +
 	"\adisable\x18\x01 \x01(\bR\adisable\x12V\n" +
-	"\bpolicies\x18\x02 \x03(\v2:.rbac.options.gloo.solo.io.ExtensionSettings.PoliciesEntryR\bpolicies\x1a^\n" +
+	"\bpolicies\x18\x02 \x03(\v2:.rbac.options.gloo.solo.io.ExtensionSettings.PoliciesEntryR\bpolicies\x12K\n" +
+	"\x06action\x18\x03 \x01(\x0e23.rbac.options.gloo.solo.io.ExtensionSettings.ActionR\x06action\x12\x16\n" +
+	"\x06shadow\x18\x04 \x01(\bR\x06shadow\x1a^\n" +
 	"\rPoliciesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
-	"\x05value\x18\x02 \x01(\v2!.rbac.options.gloo.solo.io.PolicyR\x05value:\x028\x01\"\xce\x01\n" +
+	"\x05value\x18\x02 \x01(\v2!.rbac.options.gloo.solo.io.PolicyR\x05value:\x028\x01\"\x1d\n" +
+	"\x06Action\x12\t\n" +
+	"\x05ALLOW\x10\x00\x12\b\n" +
+	"\x04DENY\x10\x01\"\xce\x01\n" +
 	"\x06Policy\x12D\n" +
 	"\n" +
 	"principals\x18\x01 \x03(\v2$.rbac.options.gloo.solo.io.PrincipalR\n" +
 	"principals\x12H\n" +
 	"\vpermissions\x18\x02 \x01(\v2&.rbac.options.gloo.solo.io.PermissionsR\vpermissions\x124\n" +
-	"\x16nested_claim_delimiter\x18\x03 \x01(\tR\x14nestedClaimDelimiter\"Y\n" +
+	"\x16nested_claim_delimiter\x18\x03 \x01(\tR\x14nestedClaimDelimiter\"\xbd\x01\n" +
 	"\tPrincipal\x12L\n" +
-	"\rjwt_principal\x18\x01 \x01(\v2'.rbac.options.gloo.solo.io.JWTPrincipalR\fjwtPrincipal\"\xd5\x02\n" +
+	"\rjwt_principal\x18\x01 \x01(\v2'.rbac.options.gloo.solo.io.JWTPrincipalR\fjwtPrincipal\x12\x1d\n" +
+	"\n" +
+	"source_ips\x18\x02 \x03(\tR\tsourceIps\x12C\n" +
+	"\aheaders\x18\x03 \x03(\v2).matchers.core.gloo.solo.io.HeaderMatcherR\aheaders\"\xd5\x02\n" +
 	"\fJWTPrincipal\x12K\n" +
 	"\x06claims\x18\x01 \x03(\v23.rbac.options.gloo.solo.io.JWTPrincipal.ClaimsEntryR\x06claims\x12\x1a\n" +
 	"\bprovider\x18\x02 \x01(\tR\bprovider\x12N\n" +
@@ -466,32 +566,36 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_r
 	return file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_goTypes = []any{
-	(JWTPrincipal_ClaimMatcher)(0), // 0: rbac.options.gloo.solo.io.JWTPrincipal.ClaimMatcher
-	(*Settings)(nil),               // 1: rbac.options.gloo.solo.io.Settings
-	(*ExtensionSettings)(nil),      // 2: rbac.options.gloo.solo.io.ExtensionSettings
-	(*Policy)(nil),                 // 3: rbac.options.gloo.solo.io.Policy
-	(*Principal)(nil),              // 4: rbac.options.gloo.solo.io.Principal
-	(*JWTPrincipal)(nil),           // 5: rbac.options.gloo.solo.io.JWTPrincipal
-	(*Permissions)(nil),            // 6: rbac.options.gloo.solo.io.Permissions
-	nil,                            // 7: rbac.options.gloo.solo.io.ExtensionSettings.PoliciesEntry
-	nil,                            // 8: rbac.options.gloo.solo.io.JWTPrincipal.ClaimsEntry
+	(ExtensionSettings_Action)(0),  // 0: rbac.options.gloo.solo.io.ExtensionSettings.Action
+	(JWTPrincipal_ClaimMatcher)(0), // 1: rbac.options.gloo.solo.io.JWTPrincipal.ClaimMatcher
+	(*Settings)(nil),               // 2: rbac.options.gloo.solo.io.Settings
+	(*ExtensionSettings)(nil),      // 3: rbac.options.gloo.solo.io.ExtensionSettings
+	(*Policy)(nil),                 // 4: rbac.options.gloo.solo.io.Policy
+	(*Principal)(nil),              // 5: rbac.options.gloo.solo.io.Principal
+	(*JWTPrincipal)(nil),           // 6: rbac.options.gloo.solo.io.JWTPrincipal
+	(*Permissions)(nil),            // 7: rbac.options.gloo.solo.io.Permissions
+	nil,                            // 8: rbac.options.gloo.solo.io.ExtensionSettings.PoliciesEntry
+	nil,                            // 9: rbac.options.gloo.solo.io.JWTPrincipal.ClaimsEntry
+	(*matchers.HeaderMatcher)(nil), // 10: matchers.core.gloo.solo.io.HeaderMatcher
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_depIdxs = []int32{
-	7, // 0: rbac.options.gloo.solo.io.ExtensionSettings.policies:type_name -> rbac.options.gloo.solo.io.ExtensionSettings.PoliciesEntry
-	4, // 1: rbac.options.gloo.solo.io.Policy.principals:type_name -> rbac.options.gloo.solo.io.Principal
-	6, // 2: rbac.options.gloo.solo.io.Policy.permissions:type_name -> rbac.options.gloo.solo.io.Permissions
-	5, // 3: rbac.options.gloo.solo.io.Principal.jwt_principal:type_name -> rbac.options.gloo.solo.io.JWTPrincipal
-	8, // 4: rbac.options.gloo.solo.io.JWTPrincipal.claims:type_name -> rbac.options.gloo.solo.io.JWTPrincipal.ClaimsEntry
-	0, // 5: rbac.options.gloo.solo.io.JWTPrincipal.matcher:type_name -> rbac.options.gloo.solo.io.JWTPrincipal.ClaimMatcher
-	3, // 6: rbac.options.gloo.solo.io.ExtensionSettings.PoliciesEntry.value:type_name -> rbac.options.gloo.solo.io.Policy
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	8,  // 0: rbac.options.gloo.solo.io.ExtensionSettings.policies:type_name -> rbac.options.gloo.solo.io.ExtensionSettings.PoliciesEntry
+	0,  // 1: rbac.options.gloo.solo.io.ExtensionSettings.action:type_name -> rbac.options.gloo.solo.io.ExtensionSettings.Action
+	5,  // 2: rbac.options.gloo.solo.io.Policy.principals:type_name -> rbac.options.gloo.solo.io.Principal
+	7,  // 3: rbac.options.gloo.solo.io.Policy.permissions:type_name -> rbac.options.gloo.solo.io.Permissions
+	6,  // 4: rbac.options.gloo.solo.io.Principal.jwt_principal:type_name -> rbac.options.gloo.solo.io.JWTPrincipal
+	10, // 5: rbac.options.gloo.solo.io.Principal.headers:type_name -> matchers.core.gloo.solo.io.HeaderMatcher
+	9,  // 6: rbac.options.gloo.solo.io.JWTPrincipal.claims:type_name -> rbac.options.gloo.solo.io.JWTPrincipal.ClaimsEntry
+	1,  // 7: rbac.options.gloo.solo.io.JWTPrincipal.matcher:type_name -> rbac.options.gloo.solo.io.JWTPrincipal.ClaimMatcher
+	4,  // 8: rbac.options.gloo.solo.io.ExtensionSettings.PoliciesEntry.value:type_name -> rbac.options.gloo.solo.io.Policy
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() {
@@ -506,7 +610,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_r
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rbac_rbac_proto_rawDesc)),
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
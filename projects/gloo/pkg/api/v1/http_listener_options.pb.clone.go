@@ -35,6 +35,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_waf "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_connection_limit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_dynamic_forward_proxy "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
@@ -51,6 +53,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quic"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_router "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/router"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
@@ -229,6 +233,18 @@ func (m *HttpListenerOptions) Clone() proto.Message {
 		target.HeaderValidationSettings = proto.Clone(m.GetHeaderValidationSettings()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_header_validation.HeaderValidationSettings)
 	}
 
+	if h, ok := interface{}(m.GetQuic()).(clone.Cloner); ok {
+		target.Quic = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic.QuicSettings)
+	} else {
+		target.Quic = proto.Clone(m.GetQuic()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_quic.QuicSettings)
+	}
+
+	if h, ok := interface{}(m.GetCompression()).(clone.Cloner); ok {
+		target.Compression = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionSettings)
+	} else {
+		target.Compression = proto.Clone(m.GetCompression()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionSettings)
+	}
+
 	switch m.ExtProcEarlyConfig.(type) {
 
 	case *HttpListenerOptions_DisableExtProcEarly:
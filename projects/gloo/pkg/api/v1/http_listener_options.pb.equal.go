@@ -296,6 +296,26 @@ func (m *HttpListenerOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetQuic()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetQuic()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetQuic(), target.GetQuic()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCompression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompression(), target.GetCompression()) {
+			return false
+		}
+	}
+
 	switch m.ExtProcEarlyConfig.(type) {
 
 	case *HttpListenerOptions_DisableExtProcEarly:
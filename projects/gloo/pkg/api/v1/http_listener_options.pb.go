@@ -22,6 +22,7 @@ import (
 	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	stateful_session "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/stateful_session"
 	waf "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
+	compression "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
 	connection_limit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
 	dynamic_forward_proxy "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
 	grpc_json "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
@@ -30,6 +31,7 @@ import (
 	header_validation "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/header_validation"
 	healthcheck "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/healthcheck"
 	local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	quic "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quic"
 	router "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/router"
 	tap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
 	wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
@@ -66,7 +68,7 @@ type HttpListenerOptions struct {
 	Waf *waf.Settings `protobuf:"bytes,5,opt,name=waf,proto3" json:"waf,omitempty"`
 	// Enterprise-only: Config for data loss prevention
 	Dlp *dlp.FilterConfig `protobuf:"bytes,6,opt,name=dlp,proto3" json:"dlp,omitempty"`
-	// Enterprise-only: WASM related configuration [experimental!]
+	// WASM filters to add to the filter chain of the listener [experimental!]
 	Wasm *wasm.PluginSource `protobuf:"bytes,7,opt,name=wasm,proto3" json:"wasm,omitempty"`
 	// Enterprise-only: External auth related settings
 	Extauth *v1.Settings `protobuf:"bytes,10,opt,name=extauth,proto3" json:"extauth,omitempty"`
@@ -98,11 +100,9 @@ type HttpListenerOptions struct {
 	// Example:
 	// ```
 	// gzip:
-	//
-	//	contentType:
-	//	- "application/json"
-	//	compressionLevel: BEST
-	//
+	//  contentType:
+	//  - "application/json"
+	//  compressionLevel: BEST
 	// ```
 	Gzip *v2.Gzip `protobuf:"bytes,8,opt,name=gzip,proto3" json:"gzip,omitempty"`
 	// Enterprise-only: Proxy latency
@@ -151,8 +151,14 @@ type HttpListenerOptions struct {
 	// determine whether requests should be rejected based on the contents of
 	// the header.
 	HeaderValidationSettings *header_validation.HeaderValidationSettings `protobuf:"bytes,36,opt,name=header_validation_settings,json=headerValidationSettings,proto3" json:"header_validation_settings,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// Enable HTTP/3 (QUIC) for downstream connections on this listener.
+	// Only applies to listeners with ssl configurations.
+	Quic *quic.QuicSettings `protobuf:"bytes,41,opt,name=quic,proto3" json:"quic,omitempty"`
+	// Compress responses with gzip, brotli or zstd, and decompress requests.
+	// Supersedes the `gzip` option, which cannot configure a gzip compressor in addition to this option.
+	Compression   *compression.CompressionSettings `protobuf:"bytes,42,opt,name=compression,proto3" json:"compression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HttpListenerOptions) Reset() {
@@ -436,6 +442,20 @@ func (x *HttpListenerOptions) GetHeaderValidationSettings() *header_validation.H
 	return nil
 }
 
+func (x *HttpListenerOptions) GetQuic() *quic.QuicSettings {
+	if x != nil {
+		return x.Quic
+	}
+	return nil
+}
+
+func (x *HttpListenerOptions) GetCompression() *compression.CompressionSettings {
+	if x != nil {
+		return x.Compression
+	}
+	return nil
+}
+
 type isHttpListenerOptions_ExtProcEarlyConfig interface {
 	isHttpListenerOptions_ExtProcEarlyConfig()
 }
@@ -503,7 +523,7 @@ var File_github_com_solo_io_gloo_projects_gloo_api_v1_http_listener_options_prot
 
 const file_github_com_solo_io_gloo_projects_gloo_api_v1_http_listener_options_proto_rawDesc = "" +
 	"\n" +
-	"Hgithub.com/solo-io/gloo/projects/gloo/api/v1/http_listener_options.proto\x12\fgloo.solo.io\x1a\x12extproto/ext.proto\x1aLgithub.com/solo-io/gloo/projects/gloo/api/v1/options/grpc_web/grpc_web.proto\x1aBgithub.com/solo-io/gloo/projects/gloo/api/v1/options/hcm/hcm.proto\x1aRgithub.com/solo-io/gloo/projects/gloo/api/v1/options/healthcheck/healthcheck.proto\x1a=github.com/solo-io/gloo/projects/gloo/api/v1/extensions.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/waf/waf.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/dlp/dlp.proto\x1aDgithub.com/solo-io/gloo/projects/gloo/api/v1/options/wasm/wasm.proto\x1aXgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extauth/v1/extauth.proto\x1aYgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/ratelimit/ratelimit.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/caching/caching.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extproc/extproc.proto\x1a^github.com/solo-io/gloo/projects/gloo/api/external/envoy/config/filter/http/gzip/v2/gzip.proto\x1acgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/proxylatency/proxylatency.proto\x1aggithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/buffer/v3/buffer.proto\x1acgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/csrf/v3/csrf.proto\x1aNgithub.com/solo-io/gloo/projects/gloo/api/v1/options/grpc_json/grpc_json.proto\x1afgithub.com/solo-io/gloo/projects/gloo/api/v1/options/dynamic_forward_proxy/dynamic_forward_proxy.proto\x1a\\github.com/solo-io/gloo/projects/gloo/api/v1/options/connection_limit/connection_limit.proto\x1aZgithub.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto\x1aHgithub.com/solo-io/gloo/projects/gloo/api/v1/options/router/router.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/tap/tap.proto\x1aggithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/stateful_session/stateful_session.proto\x1a^github.com/solo-io/gloo/projects/gloo/api/v1/options/header_validation/header_validation.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x96\x14\n" +
+	"Hgithub.com/solo-io/gloo/projects/gloo/api/v1/http_listener_options.proto\x12\fgloo.solo.io\x1a\x12extproto/ext.proto\x1aLgithub.com/solo-io/gloo/projects/gloo/api/v1/options/grpc_web/grpc_web.proto\x1aBgithub.com/solo-io/gloo/projects/gloo/api/v1/options/hcm/hcm.proto\x1aRgithub.com/solo-io/gloo/projects/gloo/api/v1/options/healthcheck/healthcheck.proto\x1a=github.com/solo-io/gloo/projects/gloo/api/v1/extensions.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/waf/waf.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/dlp/dlp.proto\x1aDgithub.com/solo-io/gloo/projects/gloo/api/v1/options/wasm/wasm.proto\x1aXgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extauth/v1/extauth.proto\x1aYgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/ratelimit/ratelimit.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/caching/caching.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extproc/extproc.proto\x1a^github.com/solo-io/gloo/projects/gloo/api/external/envoy/config/filter/http/gzip/v2/gzip.proto\x1acgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/proxylatency/proxylatency.proto\x1aggithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/buffer/v3/buffer.proto\x1acgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/csrf/v3/csrf.proto\x1aNgithub.com/solo-io/gloo/projects/gloo/api/v1/options/grpc_json/grpc_json.proto\x1afgithub.com/solo-io/gloo/projects/gloo/api/v1/options/dynamic_forward_proxy/dynamic_forward_proxy.proto\x1a\\github.com/solo-io/gloo/projects/gloo/api/v1/options/connection_limit/connection_limit.proto\x1aZgithub.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto\x1aHgithub.com/solo-io/gloo/projects/gloo/api/v1/options/router/router.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/tap/tap.proto\x1aggithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/stateful_session/stateful_session.proto\x1a^github.com/solo-io/gloo/projects/gloo/api/v1/options/header_validation/header_validation.proto\x1aDgithub.com/solo-io/gloo/projects/gloo/api/v1/options/quic/quic.proto\x1aRgithub.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xac\x15\n" +
 	"\x13HttpListenerOptions\x12A\n" +
 	"\bgrpc_web\x18\x01 \x01(\v2&.grpc_web.options.gloo.solo.io.GrpcWebR\agrpcWeb\x12\x80\x01\n" +
 	" http_connection_manager_settings\x18\x02 \x01(\v27.hcm.options.gloo.solo.io.HttpConnectionManagerSettingsR\x1dhttpConnectionManagerSettings\x12P\n" +
@@ -538,7 +558,9 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_http_listener_options_pr
 	"\x06router\x18\x12 \x01(\v2\x14.gloo.solo.io.RouterR\x06router\x12/\n" +
 	"\x03tap\x18\" \x01(\v2\x1d.tap.options.gloo.solo.io.TapR\x03tap\x12a\n" +
 	"\x10stateful_session\x18# \x01(\v26.stateful_session.options.gloo.solo.io.StatefulSessionR\x0fstatefulSession\x12~\n" +
-	"\x1aheader_validation_settings\x18$ \x01(\v2@.header_validation.options.gloo.solo.io.HeaderValidationSettingsR\x18headerValidationSettingsB\x17\n" +
+	"\x1aheader_validation_settings\x18$ \x01(\v2@.header_validation.options.gloo.solo.io.HeaderValidationSettingsR\x18headerValidationSettings\x12;\n" +
+	"\x04quic\x18) \x01(\v2'.quic.options.gloo.solo.io.QuicSettingsR\x04quic\x12W\n" +
+	"\vcompression\x18* \x01(\v25.compression.options.gloo.solo.io.CompressionSettingsR\vcompressionB\x17\n" +
 	"\x15ext_proc_early_configB\x11\n" +
 	"\x0fext_proc_configB\x16\n" +
 	"\x14ext_proc_late_configB>\xb8\xf5\x04\x01\xc0\xf5\x04\x01\xd0\xf5\x04\x01Z0github.com/solo-io/gloo/projects/gloo/pkg/api/v1b\x06proto3"
@@ -583,6 +605,8 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_http_listener_options_prot
 	(*tap.Tap)(nil),                                    // 23: tap.options.gloo.solo.io.Tap
 	(*stateful_session.StatefulSession)(nil),           // 24: stateful_session.options.gloo.solo.io.StatefulSession
 	(*header_validation.HeaderValidationSettings)(nil), // 25: header_validation.options.gloo.solo.io.HeaderValidationSettings
+	(*quic.QuicSettings)(nil),                          // 26: quic.options.gloo.solo.io.QuicSettings
+	(*compression.CompressionSettings)(nil),            // 27: compression.options.gloo.solo.io.CompressionSettings
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_http_listener_options_proto_depIdxs = []int32{
 	1,  // 0: gloo.solo.io.HttpListenerOptions.grpc_web:type_name -> grpc_web.options.gloo.solo.io.GrpcWeb
@@ -616,11 +640,13 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_http_listener_options_prot
 	23, // 28: gloo.solo.io.HttpListenerOptions.tap:type_name -> tap.options.gloo.solo.io.Tap
 	24, // 29: gloo.solo.io.HttpListenerOptions.stateful_session:type_name -> stateful_session.options.gloo.solo.io.StatefulSession
 	25, // 30: gloo.solo.io.HttpListenerOptions.header_validation_settings:type_name -> header_validation.options.gloo.solo.io.HeaderValidationSettings
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	26, // 31: gloo.solo.io.HttpListenerOptions.quic:type_name -> quic.options.gloo.solo.io.QuicSettings
+	27, // 32: gloo.solo.io.HttpListenerOptions.compression:type_name -> compression.options.gloo.solo.io.CompressionSettings
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_http_listener_options_proto_init() }
@@ -543,6 +543,46 @@ func (m *HttpListenerOptions) HashUnique(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetQuic()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Quic")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetQuic(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Quic")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetCompression()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Compression")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCompression(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Compression")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	switch m.ExtProcEarlyConfig.(type) {
 
 	case *HttpListenerOptions_DisableExtProcEarly:
@@ -2,7 +2,9 @@ package wellknown
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 )
 
 var (
@@ -12,4 +14,7 @@ var (
 	ServiceAccountGVK = corev1.SchemeGroupVersion.WithKind("ServiceAccount")
 
 	DeploymentGVK = appsv1.SchemeGroupVersion.WithKind("Deployment")
+
+	HorizontalPodAutoscalerGVK = autoscalingv2.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler")
+	PodDisruptionBudgetGVK     = policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget")
 )
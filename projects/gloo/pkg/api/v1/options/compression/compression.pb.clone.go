@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto
+
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	google_golang_org_protobuf_types_known_wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *CompressionSettings) Clone() proto.Message {
+	var target *CompressionSettings
+	if m == nil {
+		return target
+	}
+	target = &CompressionSettings{}
+
+	if m.GetCompressors() != nil {
+		target.Compressors = make([]*Compressor, len(m.GetCompressors()))
+		for idx, v := range m.GetCompressors() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Compressors[idx] = h.Clone().(*Compressor)
+			} else {
+				target.Compressors[idx] = proto.Clone(v).(*Compressor)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetRequestDecompression()).(clone.Cloner); ok {
+		target.RequestDecompression = h.Clone().(*RequestDecompression)
+	} else {
+		target.RequestDecompression = proto.Clone(m.GetRequestDecompression()).(*RequestDecompression)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Compressor) Clone() proto.Message {
+	var target *Compressor
+	if m == nil {
+		return target
+	}
+	target = &Compressor{}
+
+	if h, ok := interface{}(m.GetContentLength()).(clone.Cloner); ok {
+		target.ContentLength = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.ContentLength = proto.Clone(m.GetContentLength()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	if m.GetContentType() != nil {
+		target.ContentType = make([]string, len(m.GetContentType()))
+		for idx, v := range m.GetContentType() {
+
+			target.ContentType[idx] = v
+
+		}
+	}
+
+	target.DisableOnEtagHeader = m.GetDisableOnEtagHeader()
+
+	target.RemoveAcceptEncodingHeader = m.GetRemoveAcceptEncodingHeader()
+
+	switch m.Algorithm.(type) {
+
+	case *Compressor_Gzip:
+
+		if h, ok := interface{}(m.GetGzip()).(clone.Cloner); ok {
+			target.Algorithm = &Compressor_Gzip{
+				Gzip: h.Clone().(*Gzip),
+			}
+		} else {
+			target.Algorithm = &Compressor_Gzip{
+				Gzip: proto.Clone(m.GetGzip()).(*Gzip),
+			}
+		}
+
+	case *Compressor_Brotli:
+
+		if h, ok := interface{}(m.GetBrotli()).(clone.Cloner); ok {
+			target.Algorithm = &Compressor_Brotli{
+				Brotli: h.Clone().(*Brotli),
+			}
+		} else {
+			target.Algorithm = &Compressor_Brotli{
+				Brotli: proto.Clone(m.GetBrotli()).(*Brotli),
+			}
+		}
+
+	case *Compressor_Zstd:
+
+		if h, ok := interface{}(m.GetZstd()).(clone.Cloner); ok {
+			target.Algorithm = &Compressor_Zstd{
+				Zstd: h.Clone().(*Zstd),
+			}
+		} else {
+			target.Algorithm = &Compressor_Zstd{
+				Zstd: proto.Clone(m.GetZstd()).(*Zstd),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Gzip) Clone() proto.Message {
+	var target *Gzip
+	if m == nil {
+		return target
+	}
+	target = &Gzip{}
+
+	if h, ok := interface{}(m.GetMemoryLevel()).(clone.Cloner); ok {
+		target.MemoryLevel = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.MemoryLevel = proto.Clone(m.GetMemoryLevel()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	target.CompressionLevel = m.GetCompressionLevel()
+
+	target.CompressionStrategy = m.GetCompressionStrategy()
+
+	if h, ok := interface{}(m.GetWindowBits()).(clone.Cloner); ok {
+		target.WindowBits = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.WindowBits = proto.Clone(m.GetWindowBits()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Brotli) Clone() proto.Message {
+	var target *Brotli
+	if m == nil {
+		return target
+	}
+	target = &Brotli{}
+
+	if h, ok := interface{}(m.GetQuality()).(clone.Cloner); ok {
+		target.Quality = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.Quality = proto.Clone(m.GetQuality()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	target.EncoderMode = m.GetEncoderMode()
+
+	if h, ok := interface{}(m.GetWindowBits()).(clone.Cloner); ok {
+		target.WindowBits = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.WindowBits = proto.Clone(m.GetWindowBits()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetInputBlockBits()).(clone.Cloner); ok {
+		target.InputBlockBits = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.InputBlockBits = proto.Clone(m.GetInputBlockBits()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	target.DisableLiteralContextModeling = m.GetDisableLiteralContextModeling()
+
+	return target
+}
+
+// Clone function
+func (m *Zstd) Clone() proto.Message {
+	var target *Zstd
+	if m == nil {
+		return target
+	}
+	target = &Zstd{}
+
+	if h, ok := interface{}(m.GetCompressionLevel()).(clone.Cloner); ok {
+		target.CompressionLevel = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.CompressionLevel = proto.Clone(m.GetCompressionLevel()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	target.EnableChecksum = m.GetEnableChecksum()
+
+	target.Strategy = m.GetStrategy()
+
+	return target
+}
+
+// Clone function
+func (m *RequestDecompression) Clone() proto.Message {
+	var target *RequestDecompression
+	if m == nil {
+		return target
+	}
+	target = &RequestDecompression{}
+
+	if m.GetAlgorithms() != nil {
+		target.Algorithms = make([]RequestDecompression_Algorithm, len(m.GetAlgorithms()))
+		for idx, v := range m.GetAlgorithms() {
+
+			target.Algorithms[idx] = v
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *CompressionPerRoute) Clone() proto.Message {
+	var target *CompressionPerRoute
+	if m == nil {
+		return target
+	}
+	target = &CompressionPerRoute{}
+
+	target.Disable = m.GetDisable()
+
+	return target
+}
@@ -0,0 +1,898 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto
+
+package compression
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	v2 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/filter/http/gzip/v2"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Brotli_EncoderMode int32
+
+const (
+	// The encoder chooses the mode.
+	Brotli_DEFAULT Brotli_EncoderMode = 0
+	// The input has no known characteristics.
+	Brotli_GENERIC Brotli_EncoderMode = 1
+	// The input is UTF-8 text.
+	Brotli_TEXT Brotli_EncoderMode = 2
+	// The input is a WOFF 2.0 font.
+	Brotli_FONT Brotli_EncoderMode = 3
+)
+
+// Enum value maps for Brotli_EncoderMode.
+var (
+	Brotli_EncoderMode_name = map[int32]string{
+		0: "DEFAULT",
+		1: "GENERIC",
+		2: "TEXT",
+		3: "FONT",
+	}
+	Brotli_EncoderMode_value = map[string]int32{
+		"DEFAULT": 0,
+		"GENERIC": 1,
+		"TEXT":    2,
+		"FONT":    3,
+	}
+)
+
+func (x Brotli_EncoderMode) Enum() *Brotli_EncoderMode {
+	p := new(Brotli_EncoderMode)
+	*p = x
+	return p
+}
+
+func (x Brotli_EncoderMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Brotli_EncoderMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[0].Descriptor()
+}
+
+func (Brotli_EncoderMode) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[0]
+}
+
+func (x Brotli_EncoderMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Brotli_EncoderMode.Descriptor instead.
+func (Brotli_EncoderMode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type Zstd_Strategy int32
+
+const (
+	Zstd_DEFAULT  Zstd_Strategy = 0
+	Zstd_FAST     Zstd_Strategy = 1
+	Zstd_DFAST    Zstd_Strategy = 2
+	Zstd_GREEDY   Zstd_Strategy = 3
+	Zstd_LAZY     Zstd_Strategy = 4
+	Zstd_LAZY2    Zstd_Strategy = 5
+	Zstd_BTLAZY2  Zstd_Strategy = 6
+	Zstd_BTOPT    Zstd_Strategy = 7
+	Zstd_BTULTRA  Zstd_Strategy = 8
+	Zstd_BTULTRA2 Zstd_Strategy = 9
+)
+
+// Enum value maps for Zstd_Strategy.
+var (
+	Zstd_Strategy_name = map[int32]string{
+		0: "DEFAULT",
+		1: "FAST",
+		2: "DFAST",
+		3: "GREEDY",
+		4: "LAZY",
+		5: "LAZY2",
+		6: "BTLAZY2",
+		7: "BTOPT",
+		8: "BTULTRA",
+		9: "BTULTRA2",
+	}
+	Zstd_Strategy_value = map[string]int32{
+		"DEFAULT":  0,
+		"FAST":     1,
+		"DFAST":    2,
+		"GREEDY":   3,
+		"LAZY":     4,
+		"LAZY2":    5,
+		"BTLAZY2":  6,
+		"BTOPT":    7,
+		"BTULTRA":  8,
+		"BTULTRA2": 9,
+	}
+)
+
+func (x Zstd_Strategy) Enum() *Zstd_Strategy {
+	p := new(Zstd_Strategy)
+	*p = x
+	return p
+}
+
+func (x Zstd_Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Zstd_Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[1].Descriptor()
+}
+
+func (Zstd_Strategy) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[1]
+}
+
+func (x Zstd_Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Zstd_Strategy.Descriptor instead.
+func (Zstd_Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type RequestDecompression_Algorithm int32
+
+const (
+	RequestDecompression_GZIP   RequestDecompression_Algorithm = 0
+	RequestDecompression_BROTLI RequestDecompression_Algorithm = 1
+	RequestDecompression_ZSTD   RequestDecompression_Algorithm = 2
+)
+
+// Enum value maps for RequestDecompression_Algorithm.
+var (
+	RequestDecompression_Algorithm_name = map[int32]string{
+		0: "GZIP",
+		1: "BROTLI",
+		2: "ZSTD",
+	}
+	RequestDecompression_Algorithm_value = map[string]int32{
+		"GZIP":   0,
+		"BROTLI": 1,
+		"ZSTD":   2,
+	}
+)
+
+func (x RequestDecompression_Algorithm) Enum() *RequestDecompression_Algorithm {
+	p := new(RequestDecompression_Algorithm)
+	*p = x
+	return p
+}
+
+func (x RequestDecompression_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestDecompression_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[2].Descriptor()
+}
+
+func (RequestDecompression_Algorithm) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[2]
+}
+
+func (x RequestDecompression_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestDecompression_Algorithm.Descriptor instead.
+func (RequestDecompression_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// Compresses responses and decompresses requests on an http listener.
+// This supersedes the `gzip` option of the listener, which is still supported, but cannot be combined with a gzip
+// compressor configured here.
+// Example:
+// ```
+// compression:
+//
+//	compressors:
+//	- brotli:
+//	    quality: 5
+//	- zstd: {}
+//	- gzip:
+//	    compressionLevel: BEST
+//	requestDecompression:
+//	  algorithms:
+//	  - GZIP
+//
+// ```
+// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/compressor_filter
+type CompressionSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The compressors used for responses, in order of priority.
+	// The algorithm is negotiated with the `accept-encoding` header of the request: the compressor with the highest
+	// quality value wins, and the first compressor in this list wins between algorithms with the same quality value.
+	Compressors []*Compressor `protobuf:"bytes,1,rep,name=compressors,proto3" json:"compressors,omitempty"`
+	// Decompress the bodies of requests before they are processed by the other filters and forwarded upstream.
+	RequestDecompression *RequestDecompression `protobuf:"bytes,2,opt,name=request_decompression,json=requestDecompression,proto3" json:"request_decompression,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CompressionSettings) Reset() {
+	*x = CompressionSettings{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompressionSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressionSettings) ProtoMessage() {}
+
+func (x *CompressionSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressionSettings.ProtoReflect.Descriptor instead.
+func (*CompressionSettings) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompressionSettings) GetCompressors() []*Compressor {
+	if x != nil {
+		return x.Compressors
+	}
+	return nil
+}
+
+func (x *CompressionSettings) GetRequestDecompression() *RequestDecompression {
+	if x != nil {
+		return x.RequestDecompression
+	}
+	return nil
+}
+
+// A compressor for responses.
+type Compressor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The compression algorithm, and its settings.
+	//
+	// Types that are valid to be assigned to Algorithm:
+	//
+	//	*Compressor_Gzip
+	//	*Compressor_Brotli
+	//	*Compressor_Zstd
+	Algorithm isCompressor_Algorithm `protobuf_oneof:"algorithm"`
+	// Minimum response length, in bytes, which will trigger compression. The default value is 30.
+	ContentLength *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=content_length,json=contentLength,proto3" json:"content_length,omitempty"`
+	// Set of strings that allows specifying which mime-types yield compression; e.g.,
+	// application/json, text/html, etc. When this field is not defined, compression will be applied
+	// to the following mime-types: "application/javascript", "application/json",
+	// "application/xhtml+xml", "image/svg+xml", "text/css", "text/html", "text/plain", "text/xml".
+	ContentType []string `protobuf:"bytes,5,rep,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// If true, disables compression when the response contains an etag header. When it is false, the
+	// filter will preserve weak etags and remove the ones that require strong validation.
+	DisableOnEtagHeader bool `protobuf:"varint,6,opt,name=disable_on_etag_header,json=disableOnEtagHeader,proto3" json:"disable_on_etag_header,omitempty"`
+	// If true, removes accept-encoding from the request headers before dispatching it to the upstream
+	// so that responses do not get compressed before reaching the filter.
+	RemoveAcceptEncodingHeader bool `protobuf:"varint,7,opt,name=remove_accept_encoding_header,json=removeAcceptEncodingHeader,proto3" json:"remove_accept_encoding_header,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *Compressor) Reset() {
+	*x = Compressor{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Compressor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compressor) ProtoMessage() {}
+
+func (x *Compressor) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compressor.ProtoReflect.Descriptor instead.
+func (*Compressor) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Compressor) GetAlgorithm() isCompressor_Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *Compressor) GetGzip() *Gzip {
+	if x != nil {
+		if x, ok := x.Algorithm.(*Compressor_Gzip); ok {
+			return x.Gzip
+		}
+	}
+	return nil
+}
+
+func (x *Compressor) GetBrotli() *Brotli {
+	if x != nil {
+		if x, ok := x.Algorithm.(*Compressor_Brotli); ok {
+			return x.Brotli
+		}
+	}
+	return nil
+}
+
+func (x *Compressor) GetZstd() *Zstd {
+	if x != nil {
+		if x, ok := x.Algorithm.(*Compressor_Zstd); ok {
+			return x.Zstd
+		}
+	}
+	return nil
+}
+
+func (x *Compressor) GetContentLength() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.ContentLength
+	}
+	return nil
+}
+
+func (x *Compressor) GetContentType() []string {
+	if x != nil {
+		return x.ContentType
+	}
+	return nil
+}
+
+func (x *Compressor) GetDisableOnEtagHeader() bool {
+	if x != nil {
+		return x.DisableOnEtagHeader
+	}
+	return false
+}
+
+func (x *Compressor) GetRemoveAcceptEncodingHeader() bool {
+	if x != nil {
+		return x.RemoveAcceptEncodingHeader
+	}
+	return false
+}
+
+type isCompressor_Algorithm interface {
+	isCompressor_Algorithm()
+}
+
+type Compressor_Gzip struct {
+	// Compress responses with gzip (`content-encoding: gzip`).
+	Gzip *Gzip `protobuf:"bytes,1,opt,name=gzip,proto3,oneof"`
+}
+
+type Compressor_Brotli struct {
+	// Compress responses with brotli (`content-encoding: br`).
+	Brotli *Brotli `protobuf:"bytes,2,opt,name=brotli,proto3,oneof"`
+}
+
+type Compressor_Zstd struct {
+	// Compress responses with zstd (`content-encoding: zstd`).
+	Zstd *Zstd `protobuf:"bytes,3,opt,name=zstd,proto3,oneof"`
+}
+
+func (*Compressor_Gzip) isCompressor_Algorithm() {}
+
+func (*Compressor_Brotli) isCompressor_Algorithm() {}
+
+func (*Compressor_Zstd) isCompressor_Algorithm() {}
+
+// Settings of the gzip compressor.
+type Gzip struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Value from 1 to 9 that controls the amount of internal memory used by zlib. Higher values
+	// use more memory, but are faster and produce better compression results. The default value is 5.
+	MemoryLevel *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=memory_level,json=memoryLevel,proto3" json:"memory_level,omitempty"`
+	// A value used for selecting the zlib compression level. "BEST" provides higher compression at the cost of
+	// higher latency, "SPEED" provides lower compression with minimum impact on response time.
+	// "DEFAULT" provides an optimal result between speed and compression.
+	CompressionLevel v2.Gzip_CompressionLevel_Enum `protobuf:"varint,2,opt,name=compression_level,json=compressionLevel,proto3,enum=solo.io.envoy.config.filter.http.gzip.v2.Gzip_CompressionLevel_Enum" json:"compression_level,omitempty"`
+	// A value used for selecting the zlib compression strategy which is directly related to the
+	// characteristics of the content. Most of the time "DEFAULT" will be the best choice.
+	CompressionStrategy v2.Gzip_CompressionStrategy `protobuf:"varint,3,opt,name=compression_strategy,json=compressionStrategy,proto3,enum=solo.io.envoy.config.filter.http.gzip.v2.Gzip_CompressionStrategy" json:"compression_strategy,omitempty"`
+	// Value from 9 to 15 that represents the base two logarithmic of the compressor's window size.
+	// Larger window results in better compression at the expense of memory usage. The default is 12
+	// which will produce a 4096 bytes window.
+	WindowBits    *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=window_bits,json=windowBits,proto3" json:"window_bits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Gzip) Reset() {
+	*x = Gzip{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gzip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gzip) ProtoMessage() {}
+
+func (x *Gzip) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gzip.ProtoReflect.Descriptor instead.
+func (*Gzip) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Gzip) GetMemoryLevel() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MemoryLevel
+	}
+	return nil
+}
+
+func (x *Gzip) GetCompressionLevel() v2.Gzip_CompressionLevel_Enum {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return v2.Gzip_CompressionLevel_Enum(0)
+}
+
+func (x *Gzip) GetCompressionStrategy() v2.Gzip_CompressionStrategy {
+	if x != nil {
+		return x.CompressionStrategy
+	}
+	return v2.Gzip_CompressionStrategy(0)
+}
+
+func (x *Gzip) GetWindowBits() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.WindowBits
+	}
+	return nil
+}
+
+// Settings of the brotli compressor.
+type Brotli struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Value from 0 to 11 that controls the compression quality. Higher values produce better compression
+	// at the cost of speed. The default value is 3.
+	Quality *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=quality,proto3" json:"quality,omitempty"`
+	// A hint of the type of the content, which allows the encoder to compress it better.
+	EncoderMode Brotli_EncoderMode `protobuf:"varint,2,opt,name=encoder_mode,json=encoderMode,proto3,enum=compression.options.gloo.solo.io.Brotli_EncoderMode" json:"encoder_mode,omitempty"`
+	// Value from 10 to 24 that represents the base two logarithmic of the compressor's window size.
+	// Larger window results in better compression at the expense of memory usage. The default is 18.
+	WindowBits *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=window_bits,json=windowBits,proto3" json:"window_bits,omitempty"`
+	// Value from 16 to 24 that represents the base two logarithmic of the compressor's input block size.
+	// Larger input block results in better compression at the expense of memory usage. The default is 24.
+	InputBlockBits *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=input_block_bits,json=inputBlockBits,proto3" json:"input_block_bits,omitempty"`
+	// If true, disables literal context modeling, which speeds up compression of data which is not text,
+	// at the cost of the compression ratio.
+	DisableLiteralContextModeling bool `protobuf:"varint,5,opt,name=disable_literal_context_modeling,json=disableLiteralContextModeling,proto3" json:"disable_literal_context_modeling,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *Brotli) Reset() {
+	*x = Brotli{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Brotli) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Brotli) ProtoMessage() {}
+
+func (x *Brotli) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Brotli.ProtoReflect.Descriptor instead.
+func (*Brotli) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Brotli) GetQuality() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Quality
+	}
+	return nil
+}
+
+func (x *Brotli) GetEncoderMode() Brotli_EncoderMode {
+	if x != nil {
+		return x.EncoderMode
+	}
+	return Brotli_DEFAULT
+}
+
+func (x *Brotli) GetWindowBits() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.WindowBits
+	}
+	return nil
+}
+
+func (x *Brotli) GetInputBlockBits() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.InputBlockBits
+	}
+	return nil
+}
+
+func (x *Brotli) GetDisableLiteralContextModeling() bool {
+	if x != nil {
+		return x.DisableLiteralContextModeling
+	}
+	return false
+}
+
+// Settings of the zstd compressor.
+type Zstd struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Value from 1 to 22 that controls the compression level. Higher values produce better compression
+	// at the cost of speed. The default value is 3.
+	CompressionLevel *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// If true, a 32-bit checksum of the content is written at the end of each frame.
+	EnableChecksum bool `protobuf:"varint,2,opt,name=enable_checksum,json=enableChecksum,proto3" json:"enable_checksum,omitempty"`
+	// The compression strategy, from the fastest to the strongest. The default strategy is chosen by the
+	// compression level.
+	Strategy      Zstd_Strategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=compression.options.gloo.solo.io.Zstd_Strategy" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Zstd) Reset() {
+	*x = Zstd{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Zstd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zstd) ProtoMessage() {}
+
+func (x *Zstd) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zstd.ProtoReflect.Descriptor instead.
+func (*Zstd) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Zstd) GetCompressionLevel() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return nil
+}
+
+func (x *Zstd) GetEnableChecksum() bool {
+	if x != nil {
+		return x.EnableChecksum
+	}
+	return false
+}
+
+func (x *Zstd) GetStrategy() Zstd_Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return Zstd_DEFAULT
+}
+
+// Decompresses the bodies of requests.
+type RequestDecompression struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The algorithms which request bodies are decompressed with, based on their `content-encoding` header.
+	Algorithms    []RequestDecompression_Algorithm `protobuf:"varint,1,rep,packed,name=algorithms,proto3,enum=compression.options.gloo.solo.io.RequestDecompression_Algorithm" json:"algorithms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDecompression) Reset() {
+	*x = RequestDecompression{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDecompression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDecompression) ProtoMessage() {}
+
+func (x *RequestDecompression) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDecompression.ProtoReflect.Descriptor instead.
+func (*RequestDecompression) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequestDecompression) GetAlgorithms() []RequestDecompression_Algorithm {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+// Compression settings of a route.
+type CompressionPerRoute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If true, responses of the route are not compressed by any of the compressors of the listener, including the
+	// compressor configured by its `gzip` option.
+	Disable       bool `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompressionPerRoute) Reset() {
+	*x = CompressionPerRoute{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompressionPerRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressionPerRoute) ProtoMessage() {}
+
+func (x *CompressionPerRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressionPerRoute.ProtoReflect.Descriptor instead.
+func (*CompressionPerRoute) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CompressionPerRoute) GetDisable() bool {
+	if x != nil {
+		return x.Disable
+	}
+	return false
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto protoreflect.FileDescriptor
+
+const file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc = "" +
+	"\n" +
+	"Rgithub.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto\x12 compression.options.gloo.solo.io\x1a\x1egoogle/protobuf/wrappers.proto\x1a^github.com/solo-io/gloo/projects/gloo/api/external/envoy/config/filter/http/gzip/v2/gzip.proto\x1a\x17validate/validate.proto\x1a\x12extproto/ext.proto\"\xd2\x01\n" +
+	"\x13CompressionSettings\x12N\n" +
+	"\vcompressors\x18\x01 \x03(\v2,.compression.options.gloo.solo.io.CompressorR\vcompressors\x12k\n" +
+	"\x15request_decompression\x18\x02 \x01(\v26.compression.options.gloo.solo.io.RequestDecompressionR\x14requestDecompression\"\xd1\x03\n" +
+	"\n" +
+	"Compressor\x12<\n" +
+	"\x04gzip\x18\x01 \x01(\v2&.compression.options.gloo.solo.io.GzipH\x00R\x04gzip\x12B\n" +
+	"\x06brotli\x18\x02 \x01(\v2(.compression.options.gloo.solo.io.BrotliH\x00R\x06brotli\x12<\n" +
+	"\x04zstd\x18\x03 \x01(\v2&.compression.options.gloo.solo.io.ZstdH\x00R\x04zstd\x12L\n" +
+	"\x0econtent_length\x18\x04 \x01(\v2\x1c.google.protobuf.UInt32ValueB\a\xfaB\x04*\x02(\x1eR\rcontentLength\x12+\n" +
+	"\fcontent_type\x18\x05 \x03(\tB\b\xfaB\x05\x92\x01\x02\x102R\vcontentType\x123\n" +
+	"\x16disable_on_etag_header\x18\x06 \x01(\bR\x13disableOnEtagHeader\x12A\n" +
+	"\x1dremove_accept_encoding_header\x18\a \x01(\bR\x1aremoveAcceptEncodingHeaderB\x10\n" +
+	"\talgorithm\x12\x03\xf8B\x01\"\x86\x03\n" +
+	"\x04Gzip\x12J\n" +
+	"\fmemory_level\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueB\t\xfaB\x06*\x04\x18\t(\x01R\vmemoryLevel\x12q\n" +
+	"\x11compression_level\x18\x02 \x01(\x0e2D.solo.io.envoy.config.filter.http.gzip.v2.Gzip.CompressionLevel.EnumR\x10compressionLevel\x12u\n" +
+	"\x14compression_strategy\x18\x03 \x01(\x0e2B.solo.io.envoy.config.filter.http.gzip.v2.Gzip.CompressionStrategyR\x13compressionStrategy\x12H\n" +
+	"\vwindow_bits\x18\x04 \x01(\v2\x1c.google.protobuf.UInt32ValueB\t\xfaB\x06*\x04\x18\x0f(\tR\n" +
+	"windowBits\"\xc5\x03\n" +
+	"\x06Brotli\x12?\n" +
+	"\aquality\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueB\a\xfaB\x04*\x02\x18\vR\aquality\x12W\n" +
+	"\fencoder_mode\x18\x02 \x01(\x0e24.compression.options.gloo.solo.io.Brotli.EncoderModeR\vencoderMode\x12H\n" +
+	"\vwindow_bits\x18\x03 \x01(\v2\x1c.google.protobuf.UInt32ValueB\t\xfaB\x06*\x04\x18\x18(\n" +
+	"R\n" +
+	"windowBits\x12Q\n" +
+	"\x10input_block_bits\x18\x04 \x01(\v2\x1c.google.protobuf.UInt32ValueB\t\xfaB\x06*\x04\x18\x18(\x10R\x0einputBlockBits\x12G\n" +
+	" disable_literal_context_modeling\x18\x05 \x01(\bR\x1ddisableLiteralContextModeling\";\n" +
+	"\vEncoderMode\x12\v\n" +
+	"\aDEFAULT\x10\x00\x12\v\n" +
+	"\aGENERIC\x10\x01\x12\b\n" +
+	"\x04TEXT\x10\x02\x12\b\n" +
+	"\x04FONT\x10\x03\"\xd5\x02\n" +
+	"\x04Zstd\x12T\n" +
+	"\x11compression_level\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueB\t\xfaB\x06*\x04\x18\x16(\x01R\x10compressionLevel\x12'\n" +
+	"\x0fenable_checksum\x18\x02 \x01(\bR\x0eenableChecksum\x12K\n" +
+	"\bstrategy\x18\x03 \x01(\x0e2/.compression.options.gloo.solo.io.Zstd.StrategyR\bstrategy\"\x80\x01\n" +
+	"\bStrategy\x12\v\n" +
+	"\aDEFAULT\x10\x00\x12\b\n" +
+	"\x04FAST\x10\x01\x12\t\n" +
+	"\x05DFAST\x10\x02\x12\n" +
+	"\n" +
+	"\x06GREEDY\x10\x03\x12\b\n" +
+	"\x04LAZY\x10\x04\x12\t\n" +
+	"\x05LAZY2\x10\x05\x12\v\n" +
+	"\aBTLAZY2\x10\x06\x12\t\n" +
+	"\x05BTOPT\x10\a\x12\v\n" +
+	"\aBTULTRA\x10\b\x12\f\n" +
+	"\bBTULTRA2\x10\t\"\xb1\x01\n" +
+	"\x14RequestDecompression\x12l\n" +
+	"\n" +
+	"algorithms\x18\x01 \x03(\x0e2@.compression.options.gloo.solo.io.RequestDecompression.AlgorithmB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x18\x01R\n" +
+	"algorithms\"+\n" +
+	"\tAlgorithm\x12\b\n" +
+	"\x04GZIP\x10\x00\x12\n" +
+	"\n" +
+	"\x06BROTLI\x10\x01\x12\b\n" +
+	"\x04ZSTD\x10\x02\"/\n" +
+	"\x13CompressionPerRoute\x12\x18\n" +
+	"\adisable\x18\x01 \x01(\bR\adisableBR\xb8\xf5\x04\x01\xc0\xf5\x04\x01\xd0\xf5\x04\x01ZDgithub.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compressionb\x06proto3"
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData []byte
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc)))
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes = []any{
+	(Brotli_EncoderMode)(0),             // 0: compression.options.gloo.solo.io.Brotli.EncoderMode
+	(Zstd_Strategy)(0),                  // 1: compression.options.gloo.solo.io.Zstd.Strategy
+	(RequestDecompression_Algorithm)(0), // 2: compression.options.gloo.solo.io.RequestDecompression.Algorithm
+	(*CompressionSettings)(nil),         // 3: compression.options.gloo.solo.io.CompressionSettings
+	(*Compressor)(nil),                  // 4: compression.options.gloo.solo.io.Compressor
+	(*Gzip)(nil),                        // 5: compression.options.gloo.solo.io.Gzip
+	(*Brotli)(nil),                      // 6: compression.options.gloo.solo.io.Brotli
+	(*Zstd)(nil),                        // 7: compression.options.gloo.solo.io.Zstd
+	(*RequestDecompression)(nil),        // 8: compression.options.gloo.solo.io.RequestDecompression
+	(*CompressionPerRoute)(nil),         // 9: compression.options.gloo.solo.io.CompressionPerRoute
+	(*wrapperspb.UInt32Value)(nil),      // 10: google.protobuf.UInt32Value
+	(v2.Gzip_CompressionLevel_Enum)(0),  // 11: solo.io.envoy.config.filter.http.gzip.v2.Gzip.CompressionLevel.Enum
+	(v2.Gzip_CompressionStrategy)(0),    // 12: solo.io.envoy.config.filter.http.gzip.v2.Gzip.CompressionStrategy
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs = []int32{
+	4,  // 0: compression.options.gloo.solo.io.CompressionSettings.compressors:type_name -> compression.options.gloo.solo.io.Compressor
+	8,  // 1: compression.options.gloo.solo.io.CompressionSettings.request_decompression:type_name -> compression.options.gloo.solo.io.RequestDecompression
+	5,  // 2: compression.options.gloo.solo.io.Compressor.gzip:type_name -> compression.options.gloo.solo.io.Gzip
+	6,  // 3: compression.options.gloo.solo.io.Compressor.brotli:type_name -> compression.options.gloo.solo.io.Brotli
+	7,  // 4: compression.options.gloo.solo.io.Compressor.zstd:type_name -> compression.options.gloo.solo.io.Zstd
+	10, // 5: compression.options.gloo.solo.io.Compressor.content_length:type_name -> google.protobuf.UInt32Value
+	10, // 6: compression.options.gloo.solo.io.Gzip.memory_level:type_name -> google.protobuf.UInt32Value
+	11, // 7: compression.options.gloo.solo.io.Gzip.compression_level:type_name -> solo.io.envoy.config.filter.http.gzip.v2.Gzip.CompressionLevel.Enum
+	12, // 8: compression.options.gloo.solo.io.Gzip.compression_strategy:type_name -> solo.io.envoy.config.filter.http.gzip.v2.Gzip.CompressionStrategy
+	10, // 9: compression.options.gloo.solo.io.Gzip.window_bits:type_name -> google.protobuf.UInt32Value
+	10, // 10: compression.options.gloo.solo.io.Brotli.quality:type_name -> google.protobuf.UInt32Value
+	0,  // 11: compression.options.gloo.solo.io.Brotli.encoder_mode:type_name -> compression.options.gloo.solo.io.Brotli.EncoderMode
+	10, // 12: compression.options.gloo.solo.io.Brotli.window_bits:type_name -> google.protobuf.UInt32Value
+	10, // 13: compression.options.gloo.solo.io.Brotli.input_block_bits:type_name -> google.protobuf.UInt32Value
+	10, // 14: compression.options.gloo.solo.io.Zstd.compression_level:type_name -> google.protobuf.UInt32Value
+	1,  // 15: compression.options.gloo.solo.io.Zstd.strategy:type_name -> compression.options.gloo.solo.io.Zstd.Strategy
+	2,  // 16: compression.options.gloo.solo.io.RequestDecompression.algorithms:type_name -> compression.options.gloo.solo.io.RequestDecompression.Algorithm
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_init()
+}
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto != nil {
+		return
+	}
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1].OneofWrappers = []any{
+		(*Compressor_Gzip)(nil),
+		(*Compressor_Brotli)(nil),
+		(*Compressor_Zstd)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs = nil
+}
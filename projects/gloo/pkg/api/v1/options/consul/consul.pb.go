@@ -7,14 +7,13 @@
 package consul
 
 import (
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-
 	options "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -58,6 +57,9 @@ type UpstreamSpec struct {
 	// QueryOptions are the query options to use for all Consul queries.
 	QueryOptions *QueryOptions `protobuf:"bytes,10,opt,name=query_options,json=queryOptions,proto3" json:"query_options,omitempty"`
 	// Is this consul service connect enabled.
+	// If true, Gloo routes to the Connect sidecar proxies of the service over mTLS, using the Connect identity
+	// configured by `consulDiscovery.connectServiceName` in the settings.
+	// Cannot be used with `sslConfig`.
 	ConnectEnabled bool `protobuf:"varint,4,opt,name=connect_enabled,json=connectEnabled,proto3" json:"connect_enabled,omitempty"`
 	// The data centers in which the service instance represented by this upstream is registered.
 	DataCenters   []string `protobuf:"bytes,5,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
@@ -0,0 +1,71 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/quic/quic.proto
+
+package quic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	google_golang_org_protobuf_types_known_durationpb "google.golang.org/protobuf/types/known/durationpb"
+
+	google_golang_org_protobuf_types_known_wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *QuicSettings) Clone() proto.Message {
+	var target *QuicSettings
+	if m == nil {
+		return target
+	}
+	target = &QuicSettings{}
+
+	if h, ok := interface{}(m.GetPort()).(clone.Cloner); ok {
+		target.Port = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.Port = proto.Clone(m.GetPort()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetAdvertisedPort()).(clone.Cloner); ok {
+		target.AdvertisedPort = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.AdvertisedPort = proto.Clone(m.GetAdvertisedPort()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetAltSvcMaxAge()).(clone.Cloner); ok {
+		target.AltSvcMaxAge = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.AltSvcMaxAge = proto.Clone(m.GetAltSvcMaxAge()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetMaxConcurrentStreams()).(clone.Cloner); ok {
+		target.MaxConcurrentStreams = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	} else {
+		target.MaxConcurrentStreams = proto.Clone(m.GetMaxConcurrentStreams()).(*google_golang_org_protobuf_types_known_wrapperspb.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(clone.Cloner); ok {
+		target.IdleTimeout = h.Clone().(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	} else {
+		target.IdleTimeout = proto.Clone(m.GetIdleTimeout()).(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	}
+
+	return target
+}
@@ -0,0 +1,100 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/quic/quic.proto
+
+package quic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *QuicSettings) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*QuicSettings)
+	if !ok {
+		that2, ok := that.(QuicSettings)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPort()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPort()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPort(), target.GetPort()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdvertisedPort()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAdvertisedPort()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAdvertisedPort(), target.GetAdvertisedPort()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAltSvcMaxAge()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAltSvcMaxAge()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAltSvcMaxAge(), target.GetAltSvcMaxAge()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxConcurrentStreams()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxConcurrentStreams()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxConcurrentStreams(), target.GetMaxConcurrentStreams()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIdleTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIdleTimeout(), target.GetIdleTimeout()) {
+			return false
+		}
+	}
+
+	return true
+}
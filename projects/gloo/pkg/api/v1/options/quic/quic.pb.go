@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/quic/quic.proto
+
+package quic
+
+import (
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enables HTTP/3 (QUIC) for downstream connections on an http listener.
+// Gloo creates an additional UDP listener on the bind address of the http listener, which terminates QUIC
+// using the same TLS configuration, and advertises HTTP/3 to clients via the `alt-svc` response header.
+// HTTP/3 requires TLS, so these settings have no effect on listeners without ssl configurations.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/http3
+type QuicSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UDP port to accept QUIC connections on.
+	// Defaults to the bind port of the listener.
+	Port *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	// The port advertised to clients in the `alt-svc` header.
+	// Set this when clients reach the proxy on a different port than it binds to, for example through a Kubernetes Service.
+	// Defaults to the QUIC port.
+	AdvertisedPort *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=advertised_port,json=advertisedPort,proto3" json:"advertised_port,omitempty"`
+	// The number of seconds clients may cache the `alt-svc` advertisement for.
+	// Defaults to 86400 (24 hours).
+	AltSvcMaxAge *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=alt_svc_max_age,json=altSvcMaxAge,proto3" json:"alt_svc_max_age,omitempty"`
+	// Maximum number of concurrent streams per QUIC connection.
+	// Defaults to 100.
+	MaxConcurrentStreams *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// The idle timeout for QUIC connections.
+	// Defaults to 300s.
+	IdleTimeout   *durationpb.Duration `protobuf:"bytes,5,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuicSettings) Reset() {
+	*x = QuicSettings{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuicSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuicSettings) ProtoMessage() {}
+
+func (x *QuicSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuicSettings.ProtoReflect.Descriptor instead.
+func (*QuicSettings) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuicSettings) GetPort() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *QuicSettings) GetAdvertisedPort() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.AdvertisedPort
+	}
+	return nil
+}
+
+func (x *QuicSettings) GetAltSvcMaxAge() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.AltSvcMaxAge
+	}
+	return nil
+}
+
+func (x *QuicSettings) GetMaxConcurrentStreams() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxConcurrentStreams
+	}
+	return nil
+}
+
+func (x *QuicSettings) GetIdleTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto protoreflect.FileDescriptor
+
+const file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDesc = "" +
+	"\n" +
+	"Dgithub.com/solo-io/gloo/projects/gloo/api/v1/options/quic/quic.proto\x12\x19quic.options.gloo.solo.io\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x12extproto/ext.proto\"\xde\x02\n" +
+	"\fQuicSettings\x120\n" +
+	"\x04port\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueR\x04port\x12E\n" +
+	"\x0fadvertised_port\x18\x02 \x01(\v2\x1c.google.protobuf.UInt32ValueR\x0eadvertisedPort\x12C\n" +
+	"\x0falt_svc_max_age\x18\x03 \x01(\v2\x1c.google.protobuf.UInt32ValueR\faltSvcMaxAge\x12R\n" +
+	"\x16max_concurrent_streams\x18\x04 \x01(\v2\x1c.google.protobuf.UInt32ValueR\x14maxConcurrentStreams\x12<\n" +
+	"\fidle_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vidleTimeoutBK\xb8\xf5\x04\x01\xc0\xf5\x04\x01\xd0\xf5\x04\x01Z=github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quicb\x06proto3"
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDescData []byte
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDesc)))
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_goTypes = []any{
+	(*QuicSettings)(nil),           // 0: quic.options.gloo.solo.io.QuicSettings
+	(*wrapperspb.UInt32Value)(nil), // 1: google.protobuf.UInt32Value
+	(*durationpb.Duration)(nil),    // 2: google.protobuf.Duration
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_depIdxs = []int32{
+	1, // 0: quic.options.gloo.solo.io.QuicSettings.port:type_name -> google.protobuf.UInt32Value
+	1, // 1: quic.options.gloo.solo.io.QuicSettings.advertised_port:type_name -> google.protobuf.UInt32Value
+	1, // 2: quic.options.gloo.solo.io.QuicSettings.alt_svc_max_age:type_name -> google.protobuf.UInt32Value
+	1, // 3: quic.options.gloo.solo.io.QuicSettings.max_concurrent_streams:type_name -> google.protobuf.UInt32Value
+	2, // 4: quic.options.gloo.solo.io.QuicSettings.idle_timeout:type_name -> google.protobuf.Duration
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_init() }
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_quic_quic_proto_depIdxs = nil
+}
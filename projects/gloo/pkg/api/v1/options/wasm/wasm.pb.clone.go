@@ -59,6 +59,8 @@ func (m *WasmFilter) Clone() proto.Message {
 	}
 	target = &WasmFilter{}
 
+	target.Sha256 = m.GetSha256()
+
 	if h, ok := interface{}(m.GetConfig()).(clone.Cloner); ok {
 		target.Config = h.Clone().(*google_golang_org_protobuf_types_known_anypb.Any)
 	} else {
@@ -77,6 +79,25 @@ func (m *WasmFilter) Clone() proto.Message {
 
 	target.VmType = m.GetVmType()
 
+	target.VmId = m.GetVmId()
+
+	if h, ok := interface{}(m.GetVmConfig()).(clone.Cloner); ok {
+		target.VmConfig = h.Clone().(*google_golang_org_protobuf_types_known_anypb.Any)
+	} else {
+		target.VmConfig = proto.Clone(m.GetVmConfig()).(*google_golang_org_protobuf_types_known_anypb.Any)
+	}
+
+	if m.GetEnvironmentVariables() != nil {
+		target.EnvironmentVariables = make(map[string]string, len(m.GetEnvironmentVariables()))
+		for k, v := range m.GetEnvironmentVariables() {
+
+			target.EnvironmentVariables[k] = v
+
+		}
+	}
+
+	target.AllowPrecompiled = m.GetAllowPrecompiled()
+
 	target.FailOpen = m.GetFailOpen()
 
 	switch m.Src.(type) {
@@ -93,6 +114,12 @@ func (m *WasmFilter) Clone() proto.Message {
 			FilePath: m.GetFilePath(),
 		}
 
+	case *WasmFilter_MountedModule:
+
+		target.Src = &WasmFilter_MountedModule{
+			MountedModule: m.GetMountedModule(),
+		}
+
 	}
 
 	return target
@@ -87,6 +87,10 @@ func (m *WasmFilter) Equal(that interface{}) bool {
 		return false
 	}
 
+	if strings.Compare(m.GetSha256(), target.GetSha256()) != 0 {
+		return false
+	}
+
 	if h, ok := interface{}(m.GetConfig()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetConfig()) {
 			return false
@@ -119,6 +123,35 @@ func (m *WasmFilter) Equal(that interface{}) bool {
 		return false
 	}
 
+	if strings.Compare(m.GetVmId(), target.GetVmId()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetVmConfig()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetVmConfig()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetVmConfig(), target.GetVmConfig()) {
+			return false
+		}
+	}
+
+	if len(m.GetEnvironmentVariables()) != len(target.GetEnvironmentVariables()) {
+		return false
+	}
+	for k, v := range m.GetEnvironmentVariables() {
+
+		if strings.Compare(v, target.GetEnvironmentVariables()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if m.GetAllowPrecompiled() != target.GetAllowPrecompiled() {
+		return false
+	}
+
 	if m.GetFailOpen() != target.GetFailOpen() {
 		return false
 	}
@@ -143,6 +176,15 @@ func (m *WasmFilter) Equal(that interface{}) bool {
 			return false
 		}
 
+	case *WasmFilter_MountedModule:
+		if _, ok := target.Src.(*WasmFilter_MountedModule); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetMountedModule(), target.GetMountedModule()) != 0 {
+			return false
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.Src != target.Src {
@@ -244,7 +244,12 @@ type WasmFilter struct {
 	//
 	//	*WasmFilter_Image
 	//	*WasmFilter_FilePath
+	//	*WasmFilter_MountedModule
 	Src isWasmFilter_Src `protobuf_oneof:"src"`
+	// the sha256 of the module, as a hex string. The module fetched from the image must match this hash,
+	// which pins the filter to a module even if the tag of the image is moved.
+	// Only supported for modules loaded from an image.
+	Sha256 string `protobuf:"bytes,11,opt,name=sha256,proto3" json:"sha256,omitempty"`
 	// Filter/service configuration used to configure or reconfigure a plugin
 	// (proxy_on_configuration).
 	// `google.protobuf.Struct` is serialized as JSON before
@@ -260,6 +265,15 @@ type WasmFilter struct {
 	RootId string `protobuf:"bytes,6,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
 	// selected VM type
 	VmType WasmFilter_VmType `protobuf:"varint,7,opt,name=vm_type,json=vmType,proto3,enum=wasm.options.gloo.solo.io.WasmFilter_VmType" json:"vm_type,omitempty"`
+	// the id of the VM which runs the filter. Filters with the same vm_id and module share a VM.
+	VmId string `protobuf:"bytes,12,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	// VM configuration used when the VM starts (proxy_on_vm_start).
+	// Serialized in the same way as `config`.
+	VmConfig *anypb.Any `protobuf:"bytes,13,opt,name=vm_config,json=vmConfig,proto3" json:"vm_config,omitempty"`
+	// environment variables exposed to the module through the WASI `environ_get` call
+	EnvironmentVariables map[string]string `protobuf:"bytes,14,rep,name=environment_variables,json=environmentVariables,proto3" json:"environment_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// when true, precompiled machine code found in the module is used instead of compiling it; defaults to false
+	AllowPrecompiled bool `protobuf:"varint,15,opt,name=allow_precompiled,json=allowPrecompiled,proto3" json:"allow_precompiled,omitempty"`
 	// when true, bypass the filter if there is a fatal error on the VM; defaults to false
 	FailOpen      bool `protobuf:"varint,9,opt,name=fail_open,json=failOpen,proto3" json:"fail_open,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -321,6 +335,22 @@ func (x *WasmFilter) GetFilePath() string {
 	return ""
 }
 
+func (x *WasmFilter) GetMountedModule() string {
+	if x != nil {
+		if x, ok := x.Src.(*WasmFilter_MountedModule); ok {
+			return x.MountedModule
+		}
+	}
+	return ""
+}
+
+func (x *WasmFilter) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
 func (x *WasmFilter) GetConfig() *anypb.Any {
 	if x != nil {
 		return x.Config
@@ -356,6 +386,34 @@ func (x *WasmFilter) GetVmType() WasmFilter_VmType {
 	return WasmFilter_V8
 }
 
+func (x *WasmFilter) GetVmId() string {
+	if x != nil {
+		return x.VmId
+	}
+	return ""
+}
+
+func (x *WasmFilter) GetVmConfig() *anypb.Any {
+	if x != nil {
+		return x.VmConfig
+	}
+	return nil
+}
+
+func (x *WasmFilter) GetEnvironmentVariables() map[string]string {
+	if x != nil {
+		return x.EnvironmentVariables
+	}
+	return nil
+}
+
+func (x *WasmFilter) GetAllowPrecompiled() bool {
+	if x != nil {
+		return x.AllowPrecompiled
+	}
+	return false
+}
+
 func (x *WasmFilter) GetFailOpen() bool {
 	if x != nil {
 		return x.FailOpen
@@ -377,10 +435,18 @@ type WasmFilter_FilePath struct {
 	FilePath string `protobuf:"bytes,8,opt,name=file_path,json=filePath,proto3,oneof"`
 }
 
+type WasmFilter_MountedModule struct {
+	// name of a module mounted into the proxy from a ConfigMap or Secret by the `wasmModules` of the
+	// GatewayParameters of the Gateway. Only supported on Kubernetes Gateway API proxies.
+	MountedModule string `protobuf:"bytes,10,opt,name=mounted_module,json=mountedModule,proto3,oneof"`
+}
+
 func (*WasmFilter_Image) isWasmFilter_Src() {}
 
 func (*WasmFilter_FilePath) isWasmFilter_Src() {}
 
+func (*WasmFilter_MountedModule) isWasmFilter_Src() {}
+
 type FilterStage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// stage of the filter chain in which the selected filter should be added
@@ -441,17 +507,27 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_
 	"\n" +
 	"Dgithub.com/solo-io/gloo/projects/gloo/api/v1/options/wasm/wasm.proto\x12\x19wasm.options.gloo.solo.io\x1a\x12extproto/ext.proto\x1a\x19google/protobuf/any.proto\"O\n" +
 	"\fPluginSource\x12?\n" +
-	"\afilters\x18\x01 \x03(\v2%.wasm.options.gloo.solo.io.WasmFilterR\afilters\"\xf0\x02\n" +
+	"\afilters\x18\x01 \x03(\v2%.wasm.options.gloo.solo.io.WasmFilterR\afilters\"\xe5\x05\n" +
 	"\n" +
 	"WasmFilter\x12\x16\n" +
 	"\x05image\x18\x02 \x01(\tH\x00R\x05image\x12\x1d\n" +
-	"\tfile_path\x18\b \x01(\tH\x00R\bfilePath\x12,\n" +
+	"\tfile_path\x18\b \x01(\tH\x00R\bfilePath\x12'\n" +
+	"\x0emounted_module\x18\n" +
+	" \x01(\tH\x00R\rmountedModule\x12\x16\n" +
+	"\x06sha256\x18\v \x01(\tR\x06sha256\x12,\n" +
 	"\x06config\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\x06config\x12I\n" +
 	"\ffilter_stage\x18\x04 \x01(\v2&.wasm.options.gloo.solo.io.FilterStageR\vfilterStage\x12\x12\n" +
 	"\x04name\x18\x05 \x01(\tR\x04name\x12\x17\n" +
 	"\aroot_id\x18\x06 \x01(\tR\x06rootId\x12E\n" +
-	"\avm_type\x18\a \x01(\x0e2,.wasm.options.gloo.solo.io.WasmFilter.VmTypeR\x06vmType\x12\x1b\n" +
-	"\tfail_open\x18\t \x01(\bR\bfailOpen\"\x1a\n" +
+	"\avm_type\x18\a \x01(\x0e2,.wasm.options.gloo.solo.io.WasmFilter.VmTypeR\x06vmType\x12\x13\n" +
+	"\x05vm_id\x18\f \x01(\tR\x04vmId\x121\n" +
+	"\tvm_config\x18\r \x01(\v2\x14.google.protobuf.AnyR\bvmConfig\x12t\n" +
+	"\x15environment_variables\x18\x0e \x03(\v2?.wasm.options.gloo.solo.io.WasmFilter.EnvironmentVariablesEntryR\x14environmentVariables\x12+\n" +
+	"\x11allow_precompiled\x18\x0f \x01(\bR\x10allowPrecompiled\x12\x1b\n" +
+	"\tfail_open\x18\t \x01(\bR\bfailOpen\x1aG\n" +
+	"\x19EnvironmentVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1a\n" +
 	"\x06VmType\x12\x06\n" +
 	"\x02V8\x10\x00\x12\b\n" +
 	"\x04WAVM\x10\x01B\x05\n" +
@@ -493,7 +569,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_r
 }
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_goTypes = []any{
 	(WasmFilter_VmType)(0),     // 0: wasm.options.gloo.solo.io.WasmFilter.VmType
 	(FilterStage_Stage)(0),     // 1: wasm.options.gloo.solo.io.FilterStage.Stage
@@ -501,20 +577,23 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_go
 	(*PluginSource)(nil),       // 3: wasm.options.gloo.solo.io.PluginSource
 	(*WasmFilter)(nil),         // 4: wasm.options.gloo.solo.io.WasmFilter
 	(*FilterStage)(nil),        // 5: wasm.options.gloo.solo.io.FilterStage
-	(*anypb.Any)(nil),          // 6: google.protobuf.Any
+	nil,                        // 6: wasm.options.gloo.solo.io.WasmFilter.EnvironmentVariablesEntry
+	(*anypb.Any)(nil),          // 7: google.protobuf.Any
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_depIdxs = []int32{
 	4, // 0: wasm.options.gloo.solo.io.PluginSource.filters:type_name -> wasm.options.gloo.solo.io.WasmFilter
-	6, // 1: wasm.options.gloo.solo.io.WasmFilter.config:type_name -> google.protobuf.Any
+	7, // 1: wasm.options.gloo.solo.io.WasmFilter.config:type_name -> google.protobuf.Any
 	5, // 2: wasm.options.gloo.solo.io.WasmFilter.filter_stage:type_name -> wasm.options.gloo.solo.io.FilterStage
 	0, // 3: wasm.options.gloo.solo.io.WasmFilter.vm_type:type_name -> wasm.options.gloo.solo.io.WasmFilter.VmType
-	1, // 4: wasm.options.gloo.solo.io.FilterStage.stage:type_name -> wasm.options.gloo.solo.io.FilterStage.Stage
-	2, // 5: wasm.options.gloo.solo.io.FilterStage.predicate:type_name -> wasm.options.gloo.solo.io.FilterStage.Predicate
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7, // 4: wasm.options.gloo.solo.io.WasmFilter.vm_config:type_name -> google.protobuf.Any
+	6, // 5: wasm.options.gloo.solo.io.WasmFilter.environment_variables:type_name -> wasm.options.gloo.solo.io.WasmFilter.EnvironmentVariablesEntry
+	1, // 6: wasm.options.gloo.solo.io.FilterStage.stage:type_name -> wasm.options.gloo.solo.io.FilterStage.Stage
+	2, // 7: wasm.options.gloo.solo.io.FilterStage.predicate:type_name -> wasm.options.gloo.solo.io.FilterStage.Predicate
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_init() }
@@ -525,6 +604,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_i
 	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[1].OneofWrappers = []any{
 		(*WasmFilter_Image)(nil),
 		(*WasmFilter_FilePath)(nil),
+		(*WasmFilter_MountedModule)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -532,7 +612,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_i
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -86,6 +86,10 @@ func (m *WasmFilter) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if _, err = hasher.Write([]byte(m.GetSha256())); err != nil {
+		return 0, err
+	}
+
 	if h, ok := interface{}(m.GetConfig()).(safe_hasher.SafeHasher); ok {
 		if _, err = hasher.Write([]byte("Config")); err != nil {
 			return 0, err
@@ -139,6 +143,58 @@ func (m *WasmFilter) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if _, err = hasher.Write([]byte(m.GetVmId())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetVmConfig()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("VmConfig")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetVmConfig(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("VmConfig")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	{
+		var result uint64
+		innerHash := fnv.New64()
+		for k, v := range m.GetEnvironmentVariables() {
+			innerHash.Reset()
+
+			if _, err = innerHash.Write([]byte(v)); err != nil {
+				return 0, err
+			}
+
+			if _, err = innerHash.Write([]byte(k)); err != nil {
+				return 0, err
+			}
+
+			result = result ^ innerHash.Sum64()
+		}
+		err = binary.Write(hasher, binary.LittleEndian, result)
+		if err != nil {
+			return 0, err
+		}
+
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetAllowPrecompiled())
+	if err != nil {
+		return 0, err
+	}
+
 	err = binary.Write(hasher, binary.LittleEndian, m.GetFailOpen())
 	if err != nil {
 		return 0, err
@@ -158,6 +214,12 @@ func (m *WasmFilter) Hash(hasher hash.Hash64) (uint64, error) {
 			return 0, err
 		}
 
+	case *WasmFilter_MountedModule:
+
+		if _, err = hasher.Write([]byte(m.GetMountedModule())); err != nil {
+			return 0, err
+		}
+
 	}
 
 	return hasher.Sum64(), nil
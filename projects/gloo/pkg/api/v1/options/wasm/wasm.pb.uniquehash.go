@@ -92,6 +92,13 @@ func (m *WasmFilter) HashUnique(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if _, err = hasher.Write([]byte("Sha256")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetSha256())); err != nil {
+		return 0, err
+	}
+
 	if h, ok := interface{}(m.GetConfig()).(safe_hasher.SafeHasher); ok {
 		if _, err = hasher.Write([]byte("Config")); err != nil {
 			return 0, err
@@ -154,6 +161,70 @@ func (m *WasmFilter) HashUnique(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if _, err = hasher.Write([]byte("VmId")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetVmId())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetVmConfig()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("VmConfig")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetVmConfig(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("VmConfig")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	{
+		var result uint64
+		innerHash := fnv.New64()
+		for k, v := range m.GetEnvironmentVariables() {
+			innerHash.Reset()
+
+			if _, err = innerHash.Write([]byte("v")); err != nil {
+				return 0, err
+			}
+			if _, err = innerHash.Write([]byte(v)); err != nil {
+				return 0, err
+			}
+
+			if _, err = innerHash.Write([]byte("k")); err != nil {
+				return 0, err
+			}
+			if _, err = innerHash.Write([]byte(k)); err != nil {
+				return 0, err
+			}
+
+			result = result ^ innerHash.Sum64()
+		}
+		err = binary.Write(hasher, binary.LittleEndian, result)
+		if err != nil {
+			return 0, err
+		}
+
+	}
+
+	if _, err = hasher.Write([]byte("AllowPrecompiled")); err != nil {
+		return 0, err
+	}
+	err = binary.Write(hasher, binary.LittleEndian, m.GetAllowPrecompiled())
+	if err != nil {
+		return 0, err
+	}
+
 	if _, err = hasher.Write([]byte("FailOpen")); err != nil {
 		return 0, err
 	}
@@ -182,6 +253,15 @@ func (m *WasmFilter) HashUnique(hasher hash.Hash64) (uint64, error) {
 			return 0, err
 		}
 
+	case *WasmFilter_MountedModule:
+
+		if _, err = hasher.Write([]byte("MountedModule")); err != nil {
+			return 0, err
+		}
+		if _, err = hasher.Write([]byte(m.GetMountedModule())); err != nil {
+			return 0, err
+		}
+
 	}
 
 	return hasher.Sum64(), nil
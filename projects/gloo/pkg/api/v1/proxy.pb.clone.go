@@ -29,6 +29,8 @@ import (
 
 	google_golang_org_protobuf_types_known_anypb "google.golang.org/protobuf/types/known/anypb"
 
+	google_golang_org_protobuf_types_known_durationpb "google.golang.org/protobuf/types/known/durationpb"
+
 	google_golang_org_protobuf_types_known_emptypb "google.golang.org/protobuf/types/known/emptypb"
 
 	google_golang_org_protobuf_types_known_structpb "google.golang.org/protobuf/types/known/structpb"
@@ -180,6 +182,18 @@ func (m *Listener) Clone() proto.Message {
 			}
 		}
 
+	case *Listener_UdpListener:
+
+		if h, ok := interface{}(m.GetUdpListener()).(clone.Cloner); ok {
+			target.ListenerType = &Listener_UdpListener{
+				UdpListener: h.Clone().(*UdpListener),
+			}
+		} else {
+			target.ListenerType = &Listener_UdpListener{
+				UdpListener: proto.Clone(m.GetUdpListener()).(*UdpListener),
+			}
+		}
+
 	}
 
 	switch m.OpaqueMetadata.(type) {
@@ -283,6 +297,54 @@ func (m *TcpHost) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpListener) Clone() proto.Message {
+	var target *UdpListener
+	if m == nil {
+		return target
+	}
+	target = &UdpListener{}
+
+	if h, ok := interface{}(m.GetUdpProxy()).(clone.Cloner); ok {
+		target.UdpProxy = h.Clone().(*UdpProxyAction)
+	} else {
+		target.UdpProxy = proto.Clone(m.GetUdpProxy()).(*UdpProxyAction)
+	}
+
+	target.StatPrefix = m.GetStatPrefix()
+
+	return target
+}
+
+// Clone function
+func (m *UdpProxyAction) Clone() proto.Message {
+	var target *UdpProxyAction
+	if m == nil {
+		return target
+	}
+	target = &UdpProxyAction{}
+
+	if h, ok := interface{}(m.GetDestination()).(clone.Cloner); ok {
+		target.Destination = h.Clone().(*Destination)
+	} else {
+		target.Destination = proto.Clone(m.GetDestination()).(*Destination)
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(clone.Cloner); ok {
+		target.IdleTimeout = h.Clone().(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	} else {
+		target.IdleTimeout = proto.Clone(m.GetIdleTimeout()).(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	}
+
+	if h, ok := interface{}(m.GetUsePerPacketLoadBalancing()).(clone.Cloner); ok {
+		target.UsePerPacketLoadBalancing = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	} else {
+		target.UsePerPacketLoadBalancing = proto.Clone(m.GetUsePerPacketLoadBalancing()).(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *HttpListener) Clone() proto.Message {
 	var target *HttpListener
@@ -232,6 +232,21 @@ func (m *Listener) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Listener_UdpListener:
+		if _, ok := target.ListenerType.(*Listener_UdpListener); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpListener()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpListener()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpListener(), target.GetUdpListener()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.ListenerType != target.ListenerType {
@@ -401,6 +416,98 @@ func (m *TcpHost) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListener)
+	if !ok {
+		that2, ok := that.(UdpListener)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUdpProxy()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUdpProxy()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUdpProxy(), target.GetUdpProxy()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetStatPrefix(), target.GetStatPrefix()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *UdpProxyAction) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpProxyAction)
+	if !ok {
+		that2, ok := that.(UdpProxyAction)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetDestination()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDestination()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDestination(), target.GetDestination()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIdleTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIdleTimeout(), target.GetIdleTimeout()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetUsePerPacketLoadBalancing()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUsePerPacketLoadBalancing()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUsePerPacketLoadBalancing(), target.GetUsePerPacketLoadBalancing()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HttpListener) Equal(that interface{}) bool {
 	if that == nil {
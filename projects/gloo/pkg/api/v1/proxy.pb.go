@@ -22,6 +22,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
@@ -91,7 +92,7 @@ func (x RedirectAction_RedirectResponseCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RedirectAction_RedirectResponseCode.Descriptor instead.
 func (RedirectAction_RedirectResponseCode) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{19, 0}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{21, 0}
 }
 
 // A Proxy is a container for the entire set of configuration that will to be applied to one or more Proxy instances.
@@ -193,7 +194,7 @@ type Listener struct {
 	// the port to bind on
 	// ports numbers must be unique for listeners within a proxy
 	BindPort uint32 `protobuf:"varint,3,opt,name=bind_port,json=bindPort,proto3" json:"bind_port,omitempty"`
-	// Listeners can listen for HTTP, TCP (unsupported), and UDP (unsupported) connections
+	// Listeners can listen for HTTP, TCP, and UDP connections
 	//
 	// Types that are valid to be assigned to ListenerType:
 	//
@@ -201,6 +202,7 @@ type Listener struct {
 	//	*Listener_TcpListener
 	//	*Listener_HybridListener
 	//	*Listener_AggregateListener
+	//	*Listener_UdpListener
 	ListenerType isListener_ListenerType `protobuf_oneof:"ListenerType"`
 	// SSL Config is optional for the listener. If provided, the listener will serve TLS for connections on this port.
 	// Multiple SslConfigs are supported for the purpose of SNI. Be aware that the SNI domain provided in the SSL Config.
@@ -319,6 +321,15 @@ func (x *Listener) GetAggregateListener() *AggregateListener {
 	return nil
 }
 
+func (x *Listener) GetUdpListener() *UdpListener {
+	if x != nil {
+		if x, ok := x.ListenerType.(*Listener_UdpListener); ok {
+			return x.UdpListener
+		}
+	}
+	return nil
+}
+
 func (x *Listener) GetSslConfigurations() []*ssl.SslConfig {
 	if x != nil {
 		return x.SslConfigurations
@@ -398,6 +409,11 @@ type Listener_AggregateListener struct {
 	AggregateListener *AggregateListener `protobuf:"bytes,13,opt,name=aggregate_listener,json=aggregateListener,proto3,oneof"`
 }
 
+type Listener_UdpListener struct {
+	// contains configuration options for proxying UDP datagrams
+	UdpListener *UdpListener `protobuf:"bytes,14,opt,name=udp_listener,json=udpListener,proto3,oneof"`
+}
+
 func (*Listener_HttpListener) isListener_ListenerType() {}
 
 func (*Listener_TcpListener) isListener_ListenerType() {}
@@ -406,6 +422,8 @@ func (*Listener_HybridListener) isListener_ListenerType() {}
 
 func (*Listener_AggregateListener) isListener_ListenerType() {}
 
+func (*Listener_UdpListener) isListener_ListenerType() {}
+
 type isListener_OpaqueMetadata interface {
 	isListener_OpaqueMetadata()
 }
@@ -566,6 +584,130 @@ func (x *TcpHost) GetDestination() *TcpHost_TcpAction {
 	return nil
 }
 
+// Use this listener to proxy UDP datagrams (e.g. DNS or game traffic) to an upstream.
+// A UdpListener binds a UDP socket, so it may share its bind port with a TCP-based listener on the same proxy.
+type UdpListener struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Where datagrams received on this listener are forwarded to
+	UdpProxy *UdpProxyAction `protobuf:"bytes,1,opt,name=udp_proxy,json=udpProxy,proto3" json:"udp_proxy,omitempty"`
+	// prefix for addressing envoy stats for the udp proxy
+	StatPrefix    string `protobuf:"bytes,2,opt,name=stat_prefix,json=statPrefix,proto3" json:"stat_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UdpListener) Reset() {
+	*x = UdpListener{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UdpListener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UdpListener) ProtoMessage() {}
+
+func (x *UdpListener) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UdpListener.ProtoReflect.Descriptor instead.
+func (*UdpListener) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UdpListener) GetUdpProxy() *UdpProxyAction {
+	if x != nil {
+		return x.UdpProxy
+	}
+	return nil
+}
+
+func (x *UdpListener) GetStatPrefix() string {
+	if x != nil {
+		return x.StatPrefix
+	}
+	return ""
+}
+
+// Configuration for the Envoy UDP proxy listener filter
+// https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/udp/udp_proxy/v3/udp_proxy.proto
+type UdpProxyAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The destination datagrams are forwarded to.
+	// Note: the destination spec and subsets are not supported in this context and will be ignored.
+	Destination *Destination `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// The idle timeout for sessions. Idle is defined as no datagrams between the downstream client and the upstream.
+	// Defaults to 60s if not set.
+	IdleTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	// If set to true, an upstream host is selected for every datagram rather than once per session.
+	// This is useful for stateless protocols such as DNS.
+	UsePerPacketLoadBalancing *wrapperspb.BoolValue `protobuf:"bytes,3,opt,name=use_per_packet_load_balancing,json=usePerPacketLoadBalancing,proto3" json:"use_per_packet_load_balancing,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *UdpProxyAction) Reset() {
+	*x = UdpProxyAction{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UdpProxyAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UdpProxyAction) ProtoMessage() {}
+
+func (x *UdpProxyAction) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UdpProxyAction.ProtoReflect.Descriptor instead.
+func (*UdpProxyAction) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UdpProxyAction) GetDestination() *Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *UdpProxyAction) GetIdleTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+func (x *UdpProxyAction) GetUsePerPacketLoadBalancing() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.UsePerPacketLoadBalancing
+	}
+	return nil
+}
+
 // Use this listener to configure proxy behavior for any HTTP-level features including defining routes (via virtual services).
 // HttpListeners also contain optional configuration that applies globally across all virtual hosts on the listener.
 // Some traffic policies can be configured to work both on the listener and virtual host level (e.g., the rate limit feature)
@@ -597,7 +739,7 @@ type HttpListener struct {
 
 func (x *HttpListener) Reset() {
 	*x = HttpListener{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[4]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +751,7 @@ func (x *HttpListener) String() string {
 func (*HttpListener) ProtoMessage() {}
 
 func (x *HttpListener) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[4]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +764,7 @@ func (x *HttpListener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpListener.ProtoReflect.Descriptor instead.
 func (*HttpListener) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{4}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HttpListener) GetVirtualHosts() []*VirtualHost {
@@ -717,7 +859,7 @@ type HybridListener struct {
 
 func (x *HybridListener) Reset() {
 	*x = HybridListener{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[5]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -729,7 +871,7 @@ func (x *HybridListener) String() string {
 func (*HybridListener) ProtoMessage() {}
 
 func (x *HybridListener) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[5]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +884,7 @@ func (x *HybridListener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HybridListener.ProtoReflect.Descriptor instead.
 func (*HybridListener) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{5}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HybridListener) GetMatchedListeners() []*MatchedListener {
@@ -774,7 +916,7 @@ type MatchedListener struct {
 
 func (x *MatchedListener) Reset() {
 	*x = MatchedListener{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[6]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -786,7 +928,7 @@ func (x *MatchedListener) String() string {
 func (*MatchedListener) ProtoMessage() {}
 
 func (x *MatchedListener) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[6]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,7 +941,7 @@ func (x *MatchedListener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchedListener.ProtoReflect.Descriptor instead.
 func (*MatchedListener) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{6}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MatchedListener) GetMatcher() *Matcher {
@@ -870,7 +1012,7 @@ type MatchedTcpListener struct {
 
 func (x *MatchedTcpListener) Reset() {
 	*x = MatchedTcpListener{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[7]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -882,7 +1024,7 @@ func (x *MatchedTcpListener) String() string {
 func (*MatchedTcpListener) ProtoMessage() {}
 
 func (x *MatchedTcpListener) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[7]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,7 +1037,7 @@ func (x *MatchedTcpListener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchedTcpListener.ProtoReflect.Descriptor instead.
 func (*MatchedTcpListener) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{7}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MatchedTcpListener) GetMatcher() *Matcher {
@@ -936,7 +1078,7 @@ type Matcher struct {
 
 func (x *Matcher) Reset() {
 	*x = Matcher{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[8]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -948,7 +1090,7 @@ func (x *Matcher) String() string {
 func (*Matcher) ProtoMessage() {}
 
 func (x *Matcher) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[8]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,7 +1103,7 @@ func (x *Matcher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Matcher.ProtoReflect.Descriptor instead.
 func (*Matcher) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{8}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Matcher) GetSslConfig() *ssl.SslConfig {
@@ -1014,7 +1156,7 @@ type AggregateListener struct {
 
 func (x *AggregateListener) Reset() {
 	*x = AggregateListener{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[9]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1026,7 +1168,7 @@ func (x *AggregateListener) String() string {
 func (*AggregateListener) ProtoMessage() {}
 
 func (x *AggregateListener) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[9]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,7 +1181,7 @@ func (x *AggregateListener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregateListener.ProtoReflect.Descriptor instead.
 func (*AggregateListener) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{9}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AggregateListener) GetHttpResources() *AggregateListener_HttpResources {
@@ -1096,7 +1238,7 @@ type VirtualHost struct {
 
 func (x *VirtualHost) Reset() {
 	*x = VirtualHost{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[10]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1108,7 +1250,7 @@ func (x *VirtualHost) String() string {
 func (*VirtualHost) ProtoMessage() {}
 
 func (x *VirtualHost) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[10]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,7 +1263,7 @@ func (x *VirtualHost) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VirtualHost.ProtoReflect.Descriptor instead.
 func (*VirtualHost) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{10}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *VirtualHost) GetName() string {
@@ -1231,7 +1373,7 @@ type Route struct {
 
 func (x *Route) Reset() {
 	*x = Route{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[11]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1243,7 +1385,7 @@ func (x *Route) String() string {
 func (*Route) ProtoMessage() {}
 
 func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[11]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1398,7 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Route.ProtoReflect.Descriptor instead.
 func (*Route) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{11}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Route) GetMatchers() []*matchers.Matcher {
@@ -1408,7 +1550,7 @@ type RouteAction struct {
 
 func (x *RouteAction) Reset() {
 	*x = RouteAction{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[12]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1420,7 +1562,7 @@ func (x *RouteAction) String() string {
 func (*RouteAction) ProtoMessage() {}
 
 func (x *RouteAction) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[12]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1433,7 +1575,7 @@ func (x *RouteAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteAction.ProtoReflect.Descriptor instead.
 func (*RouteAction) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{12}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RouteAction) GetDestination() isRouteAction_Destination {
@@ -1558,7 +1700,7 @@ type Destination struct {
 
 func (x *Destination) Reset() {
 	*x = Destination{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[13]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1570,7 +1712,7 @@ func (x *Destination) String() string {
 func (*Destination) ProtoMessage() {}
 
 func (x *Destination) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[13]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +1725,7 @@ func (x *Destination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Destination.ProtoReflect.Descriptor instead.
 func (*Destination) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{13}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Destination) GetDestinationType() isDestination_DestinationType {
@@ -1672,7 +1814,7 @@ type KubernetesServiceDestination struct {
 
 func (x *KubernetesServiceDestination) Reset() {
 	*x = KubernetesServiceDestination{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[14]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1684,7 +1826,7 @@ func (x *KubernetesServiceDestination) String() string {
 func (*KubernetesServiceDestination) ProtoMessage() {}
 
 func (x *KubernetesServiceDestination) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[14]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,7 +1839,7 @@ func (x *KubernetesServiceDestination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesServiceDestination.ProtoReflect.Descriptor instead.
 func (*KubernetesServiceDestination) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{14}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *KubernetesServiceDestination) GetRef() *core.ResourceRef {
@@ -1734,7 +1876,7 @@ type ConsulServiceDestination struct {
 
 func (x *ConsulServiceDestination) Reset() {
 	*x = ConsulServiceDestination{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[15]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1746,7 +1888,7 @@ func (x *ConsulServiceDestination) String() string {
 func (*ConsulServiceDestination) ProtoMessage() {}
 
 func (x *ConsulServiceDestination) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[15]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,7 +1901,7 @@ func (x *ConsulServiceDestination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsulServiceDestination.ProtoReflect.Descriptor instead.
 func (*ConsulServiceDestination) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{15}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ConsulServiceDestination) GetServiceName() string {
@@ -1798,7 +1940,7 @@ type UpstreamGroup struct {
 
 func (x *UpstreamGroup) Reset() {
 	*x = UpstreamGroup{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[16]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1810,7 +1952,7 @@ func (x *UpstreamGroup) String() string {
 func (*UpstreamGroup) ProtoMessage() {}
 
 func (x *UpstreamGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[16]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1823,7 +1965,7 @@ func (x *UpstreamGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpstreamGroup.ProtoReflect.Descriptor instead.
 func (*UpstreamGroup) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{16}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpstreamGroup) GetDestinations() []*WeightedDestination {
@@ -1860,7 +2002,7 @@ type MultiDestination struct {
 
 func (x *MultiDestination) Reset() {
 	*x = MultiDestination{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[17]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1872,7 +2014,7 @@ func (x *MultiDestination) String() string {
 func (*MultiDestination) ProtoMessage() {}
 
 func (x *MultiDestination) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[17]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1885,7 +2027,7 @@ func (x *MultiDestination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiDestination.ProtoReflect.Descriptor instead.
 func (*MultiDestination) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{17}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MultiDestination) GetDestinations() []*WeightedDestination {
@@ -1913,7 +2055,7 @@ type WeightedDestination struct {
 
 func (x *WeightedDestination) Reset() {
 	*x = WeightedDestination{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[18]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1925,7 +2067,7 @@ func (x *WeightedDestination) String() string {
 func (*WeightedDestination) ProtoMessage() {}
 
 func (x *WeightedDestination) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[18]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1938,7 +2080,7 @@ func (x *WeightedDestination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeightedDestination.ProtoReflect.Descriptor instead.
 func (*WeightedDestination) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{18}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *WeightedDestination) GetDestination() *Destination {
@@ -1989,7 +2131,7 @@ type RedirectAction struct {
 
 func (x *RedirectAction) Reset() {
 	*x = RedirectAction{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[19]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2001,7 +2143,7 @@ func (x *RedirectAction) String() string {
 func (*RedirectAction) ProtoMessage() {}
 
 func (x *RedirectAction) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[19]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,7 +2156,7 @@ func (x *RedirectAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RedirectAction.ProtoReflect.Descriptor instead.
 func (*RedirectAction) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{19}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RedirectAction) GetHostRedirect() string {
@@ -2160,7 +2302,7 @@ type DirectResponseAction struct {
 
 func (x *DirectResponseAction) Reset() {
 	*x = DirectResponseAction{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[20]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2172,7 +2314,7 @@ func (x *DirectResponseAction) String() string {
 func (*DirectResponseAction) ProtoMessage() {}
 
 func (x *DirectResponseAction) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[20]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2185,7 +2327,7 @@ func (x *DirectResponseAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectResponseAction.ProtoReflect.Descriptor instead.
 func (*DirectResponseAction) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{20}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DirectResponseAction) GetStatus() uint32 {
@@ -2216,7 +2358,7 @@ type SourceMetadata struct {
 
 func (x *SourceMetadata) Reset() {
 	*x = SourceMetadata{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[21]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2228,7 +2370,7 @@ func (x *SourceMetadata) String() string {
 func (*SourceMetadata) ProtoMessage() {}
 
 func (x *SourceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[21]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2241,7 +2383,7 @@ func (x *SourceMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceMetadata.ProtoReflect.Descriptor instead.
 func (*SourceMetadata) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{21}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SourceMetadata) GetSources() []*SourceMetadata_SourceRef {
@@ -2267,7 +2409,7 @@ type CustomEnvoyFilter struct {
 
 func (x *CustomEnvoyFilter) Reset() {
 	*x = CustomEnvoyFilter{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[22]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2279,7 +2421,7 @@ func (x *CustomEnvoyFilter) String() string {
 func (*CustomEnvoyFilter) ProtoMessage() {}
 
 func (x *CustomEnvoyFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[22]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2292,7 +2434,7 @@ func (x *CustomEnvoyFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomEnvoyFilter.ProtoReflect.Descriptor instead.
 func (*CustomEnvoyFilter) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{22}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CustomEnvoyFilter) GetFilterStage() *filters.FilterStage {
@@ -2333,7 +2475,7 @@ type TcpHost_TcpAction struct {
 
 func (x *TcpHost_TcpAction) Reset() {
 	*x = TcpHost_TcpAction{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[23]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2345,7 +2487,7 @@ func (x *TcpHost_TcpAction) String() string {
 func (*TcpHost_TcpAction) ProtoMessage() {}
 
 func (x *TcpHost_TcpAction) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[23]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2450,7 +2592,7 @@ type AggregateListener_HttpResources struct {
 
 func (x *AggregateListener_HttpResources) Reset() {
 	*x = AggregateListener_HttpResources{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[24]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2462,7 +2604,7 @@ func (x *AggregateListener_HttpResources) String() string {
 func (*AggregateListener_HttpResources) ProtoMessage() {}
 
 func (x *AggregateListener_HttpResources) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[24]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2475,7 +2617,7 @@ func (x *AggregateListener_HttpResources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregateListener_HttpResources.ProtoReflect.Descriptor instead.
 func (*AggregateListener_HttpResources) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{9, 0}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *AggregateListener_HttpResources) GetVirtualHosts() map[string]*VirtualHost {
@@ -2512,7 +2654,7 @@ type AggregateListener_HttpFilterChain struct {
 
 func (x *AggregateListener_HttpFilterChain) Reset() {
 	*x = AggregateListener_HttpFilterChain{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[25]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2524,7 +2666,7 @@ func (x *AggregateListener_HttpFilterChain) String() string {
 func (*AggregateListener_HttpFilterChain) ProtoMessage() {}
 
 func (x *AggregateListener_HttpFilterChain) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[25]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,7 +2679,7 @@ func (x *AggregateListener_HttpFilterChain) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AggregateListener_HttpFilterChain.ProtoReflect.Descriptor instead.
 func (*AggregateListener_HttpFilterChain) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{9, 1}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{11, 1}
 }
 
 func (x *AggregateListener_HttpFilterChain) GetMatcher() *Matcher {
@@ -2589,7 +2731,7 @@ type SourceMetadata_SourceRef struct {
 
 func (x *SourceMetadata_SourceRef) Reset() {
 	*x = SourceMetadata_SourceRef{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[28]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2601,7 +2743,7 @@ func (x *SourceMetadata_SourceRef) String() string {
 func (*SourceMetadata_SourceRef) ProtoMessage() {}
 
 func (x *SourceMetadata_SourceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[28]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +2756,7 @@ func (x *SourceMetadata_SourceRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceMetadata_SourceRef.ProtoReflect.Descriptor instead.
 func (*SourceMetadata_SourceRef) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{21, 0}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *SourceMetadata_SourceRef) GetResourceRef() *core.ResourceRef {
@@ -2642,13 +2784,13 @@ var File_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto protoreflect.F
 
 const file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDesc = "" +
 	"\n" +
-	"8github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto\x12\fgloo.solo.io\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x19google/protobuf/any.proto\x1a\x12extproto/ext.proto\x1a1github.com/solo-io/solo-kit/api/v1/metadata.proto\x1a/github.com/solo-io/solo-kit/api/v1/status.proto\x1a,github.com/solo-io/solo-kit/api/v1/ref.proto\x1a1github.com/solo-io/solo-kit/api/v1/solo-kit.proto\x1aCgithub.com/solo-io/gloo/projects/gloo/api/v1/listener_options.proto\x1aHgithub.com/solo-io/gloo/projects/gloo/api/v1/http_listener_options.proto\x1aGgithub.com/solo-io/gloo/projects/gloo/api/v1/tcp_listener_options.proto\x1aGgithub.com/solo-io/gloo/projects/gloo/api/v1/virtual_host_options.proto\x1a@github.com/solo-io/gloo/projects/gloo/api/v1/route_options.proto\x1aNgithub.com/solo-io/gloo/projects/gloo/api/v1/route_configuration_options.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/weighted_destination_options.proto\x1a:github.com/solo-io/gloo/projects/gloo/api/v1/ssl/ssl.proto\x1a9github.com/solo-io/gloo/projects/gloo/api/v1/subset.proto\x1aCgithub.com/solo-io/gloo/projects/gloo/api/v1/destination_spec.proto\x1aAgithub.com/solo-io/gloo/projects/gloo/api/v1/filters/stages.proto\x1aIgithub.com/solo-io/gloo/projects/gloo/api/v1/core/matchers/matchers.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/config/core/v3/address.proto\x1afgithub.com/solo-io/gloo/projects/gloo/api/v1/options/dynamic_forward_proxy/dynamic_forward_proxy.proto\x1aTgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/type/matcher/v3/regex.proto\"\x8b\x02\n" +
+	"8github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto\x12\fgloo.solo.io\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x12extproto/ext.proto\x1a1github.com/solo-io/solo-kit/api/v1/metadata.proto\x1a/github.com/solo-io/solo-kit/api/v1/status.proto\x1a,github.com/solo-io/solo-kit/api/v1/ref.proto\x1a1github.com/solo-io/solo-kit/api/v1/solo-kit.proto\x1aCgithub.com/solo-io/gloo/projects/gloo/api/v1/listener_options.proto\x1aHgithub.com/solo-io/gloo/projects/gloo/api/v1/http_listener_options.proto\x1aGgithub.com/solo-io/gloo/projects/gloo/api/v1/tcp_listener_options.proto\x1aGgithub.com/solo-io/gloo/projects/gloo/api/v1/virtual_host_options.proto\x1a@github.com/solo-io/gloo/projects/gloo/api/v1/route_options.proto\x1aNgithub.com/solo-io/gloo/projects/gloo/api/v1/route_configuration_options.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/weighted_destination_options.proto\x1a:github.com/solo-io/gloo/projects/gloo/api/v1/ssl/ssl.proto\x1a9github.com/solo-io/gloo/projects/gloo/api/v1/subset.proto\x1aCgithub.com/solo-io/gloo/projects/gloo/api/v1/destination_spec.proto\x1aAgithub.com/solo-io/gloo/projects/gloo/api/v1/filters/stages.proto\x1aIgithub.com/solo-io/gloo/projects/gloo/api/v1/core/matchers/matchers.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/config/core/v3/address.proto\x1afgithub.com/solo-io/gloo/projects/gloo/api/v1/options/dynamic_forward_proxy/dynamic_forward_proxy.proto\x1aTgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/type/matcher/v3/regex.proto\"\x8b\x02\n" +
 	"\x05Proxy\x12&\n" +
 	"\x0ecompressedSpec\x18\x03 \x01(\tR\x0ecompressedSpec\x124\n" +
 	"\tlisteners\x18\x02 \x03(\v2\x16.gloo.solo.io.ListenerR\tlisteners\x12W\n" +
 	"\x13namespaced_statuses\x18\b \x01(\v2 .core.solo.io.NamespacedStatusesB\x04\xb8\xf5\x04\x01R\x12namespacedStatuses\x122\n" +
 	"\bmetadata\x18\a \x01(\v2\x16.core.solo.io.MetadataR\bmetadata:\x11\x82\xf1\x04\r\n" +
-	"\x02px\x12\aproxiesJ\x04\b\x06\x10\a\"\xc2\x06\n" +
+	"\x02px\x12\aproxiesJ\x04\b\x06\x10\a\"\x82\a\n" +
 	"\bListener\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fbind_address\x18\x02 \x01(\tR\vbindAddress\x12\x1b\n" +
@@ -2656,7 +2798,8 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDesc = ""
 	"\rhttp_listener\x18\x04 \x01(\v2\x1a.gloo.solo.io.HttpListenerH\x00R\fhttpListener\x12>\n" +
 	"\ftcp_listener\x18\x05 \x01(\v2\x19.gloo.solo.io.TcpListenerH\x00R\vtcpListener\x12G\n" +
 	"\x0fhybrid_listener\x18\v \x01(\v2\x1c.gloo.solo.io.HybridListenerH\x00R\x0ehybridListener\x12P\n" +
-	"\x12aggregate_listener\x18\r \x01(\v2\x1f.gloo.solo.io.AggregateListenerH\x00R\x11aggregateListener\x12F\n" +
+	"\x12aggregate_listener\x18\r \x01(\v2\x1f.gloo.solo.io.AggregateListenerH\x00R\x11aggregateListener\x12>\n" +
+	"\fudp_listener\x18\x0e \x01(\v2\x19.gloo.solo.io.UdpListenerH\x00R\vudpListener\x12F\n" +
 	"\x12ssl_configurations\x18\x06 \x03(\v2\x17.gloo.solo.io.SslConfigR\x11sslConfigurations\x12F\n" +
 	"\x0fuse_proxy_proto\x18\a \x01(\v2\x1a.google.protobuf.BoolValueB\x02\x18\x01R\ruseProxyProto\x127\n" +
 	"\aoptions\x18\b \x01(\v2\x1d.gloo.solo.io.ListenerOptionsR\aoptions\x12;\n" +
@@ -2682,7 +2825,15 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDesc = ""
 	"\x05multi\x18\x02 \x01(\v2\x1e.gloo.solo.io.MultiDestinationH\x00R\x05multi\x12B\n" +
 	"\x0eupstream_group\x18\x03 \x01(\v2\x19.core.solo.io.ResourceRefH\x00R\rupstreamGroup\x12Q\n" +
 	"\x18forward_sni_cluster_name\x18\x04 \x01(\v2\x16.google.protobuf.EmptyH\x00R\x15forwardSniClusterNameB\r\n" +
-	"\vdestinationJ\x04\b\x02\x10\x03\"\xf3\x03\n" +
+	"\vdestinationJ\x04\b\x02\x10\x03\"i\n" +
+	"\vUdpListener\x129\n" +
+	"\tudp_proxy\x18\x01 \x01(\v2\x1c.gloo.solo.io.UdpProxyActionR\budpProxy\x12\x1f\n" +
+	"\vstat_prefix\x18\x02 \x01(\tR\n" +
+	"statPrefix\"\xe9\x01\n" +
+	"\x0eUdpProxyAction\x12;\n" +
+	"\vdestination\x18\x01 \x01(\v2\x19.gloo.solo.io.DestinationR\vdestination\x12<\n" +
+	"\fidle_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vidleTimeout\x12\\\n" +
+	"\x1duse_per_packet_load_balancing\x18\x03 \x01(\v2\x1a.google.protobuf.BoolValueR\x19usePerPacketLoadBalancing\"\xf3\x03\n" +
 	"\fHttpListener\x12>\n" +
 	"\rvirtual_hosts\x18\x01 \x03(\v2\x19.gloo.solo.io.VirtualHostR\fvirtualHosts\x12;\n" +
 	"\aoptions\x18\x02 \x01(\v2!.gloo.solo.io.HttpListenerOptionsR\aoptions\x12\x1f\n" +
@@ -2826,152 +2977,160 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDescGZIP()
 }
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_goTypes = []any{
 	(RedirectAction_RedirectResponseCode)(0),  // 0: gloo.solo.io.RedirectAction.RedirectResponseCode
 	(*Proxy)(nil),                             // 1: gloo.solo.io.Proxy
 	(*Listener)(nil),                          // 2: gloo.solo.io.Listener
 	(*TcpListener)(nil),                       // 3: gloo.solo.io.TcpListener
 	(*TcpHost)(nil),                           // 4: gloo.solo.io.TcpHost
-	(*HttpListener)(nil),                      // 5: gloo.solo.io.HttpListener
-	(*HybridListener)(nil),                    // 6: gloo.solo.io.HybridListener
-	(*MatchedListener)(nil),                   // 7: gloo.solo.io.MatchedListener
-	(*MatchedTcpListener)(nil),                // 8: gloo.solo.io.MatchedTcpListener
-	(*Matcher)(nil),                           // 9: gloo.solo.io.Matcher
-	(*AggregateListener)(nil),                 // 10: gloo.solo.io.AggregateListener
-	(*VirtualHost)(nil),                       // 11: gloo.solo.io.VirtualHost
-	(*Route)(nil),                             // 12: gloo.solo.io.Route
-	(*RouteAction)(nil),                       // 13: gloo.solo.io.RouteAction
-	(*Destination)(nil),                       // 14: gloo.solo.io.Destination
-	(*KubernetesServiceDestination)(nil),      // 15: gloo.solo.io.KubernetesServiceDestination
-	(*ConsulServiceDestination)(nil),          // 16: gloo.solo.io.ConsulServiceDestination
-	(*UpstreamGroup)(nil),                     // 17: gloo.solo.io.UpstreamGroup
-	(*MultiDestination)(nil),                  // 18: gloo.solo.io.MultiDestination
-	(*WeightedDestination)(nil),               // 19: gloo.solo.io.WeightedDestination
-	(*RedirectAction)(nil),                    // 20: gloo.solo.io.RedirectAction
-	(*DirectResponseAction)(nil),              // 21: gloo.solo.io.DirectResponseAction
-	(*SourceMetadata)(nil),                    // 22: gloo.solo.io.SourceMetadata
-	(*CustomEnvoyFilter)(nil),                 // 23: gloo.solo.io.CustomEnvoyFilter
-	(*TcpHost_TcpAction)(nil),                 // 24: gloo.solo.io.TcpHost.TcpAction
-	(*AggregateListener_HttpResources)(nil),   // 25: gloo.solo.io.AggregateListener.HttpResources
-	(*AggregateListener_HttpFilterChain)(nil), // 26: gloo.solo.io.AggregateListener.HttpFilterChain
-	nil,                                          // 27: gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry
-	nil,                                          // 28: gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry
-	(*SourceMetadata_SourceRef)(nil),             // 29: gloo.solo.io.SourceMetadata.SourceRef
-	(*core.NamespacedStatuses)(nil),              // 30: core.solo.io.NamespacedStatuses
-	(*core.Metadata)(nil),                        // 31: core.solo.io.Metadata
-	(*ssl.SslConfig)(nil),                        // 32: gloo.solo.io.SslConfig
-	(*wrapperspb.BoolValue)(nil),                 // 33: google.protobuf.BoolValue
-	(*ListenerOptions)(nil),                      // 34: gloo.solo.io.ListenerOptions
-	(*structpb.Struct)(nil),                      // 35: google.protobuf.Struct
-	(*RouteConfigurationOptions)(nil),            // 36: gloo.solo.io.RouteConfigurationOptions
-	(*TcpListenerOptions)(nil),                   // 37: gloo.solo.io.TcpListenerOptions
-	(*HttpListenerOptions)(nil),                  // 38: gloo.solo.io.HttpListenerOptions
-	(*v3.CidrRange)(nil),                         // 39: solo.io.envoy.config.core.v3.CidrRange
-	(*wrapperspb.UInt32Value)(nil),               // 40: google.protobuf.UInt32Value
-	(*VirtualHostOptions)(nil),                   // 41: gloo.solo.io.VirtualHostOptions
-	(*matchers.Matcher)(nil),                     // 42: matchers.core.gloo.solo.io.Matcher
-	(*RouteOptions)(nil),                         // 43: gloo.solo.io.RouteOptions
-	(*core.ResourceRef)(nil),                     // 44: core.solo.io.ResourceRef
-	(*dynamic_forward_proxy.PerRouteConfig)(nil), // 45: dfp.options.gloo.solo.io.PerRouteConfig
-	(*DestinationSpec)(nil),                      // 46: gloo.solo.io.DestinationSpec
-	(*Subset)(nil),                               // 47: gloo.solo.io.Subset
-	(*WeightedDestinationOptions)(nil),           // 48: gloo.solo.io.WeightedDestinationOptions
-	(*v31.RegexMatchAndSubstitute)(nil),          // 49: solo.io.envoy.type.matcher.v3.RegexMatchAndSubstitute
-	(*filters.FilterStage)(nil),                  // 50: filters.gloo.solo.io.FilterStage
-	(*anypb.Any)(nil),                            // 51: google.protobuf.Any
-	(*emptypb.Empty)(nil),                        // 52: google.protobuf.Empty
+	(*UdpListener)(nil),                       // 5: gloo.solo.io.UdpListener
+	(*UdpProxyAction)(nil),                    // 6: gloo.solo.io.UdpProxyAction
+	(*HttpListener)(nil),                      // 7: gloo.solo.io.HttpListener
+	(*HybridListener)(nil),                    // 8: gloo.solo.io.HybridListener
+	(*MatchedListener)(nil),                   // 9: gloo.solo.io.MatchedListener
+	(*MatchedTcpListener)(nil),                // 10: gloo.solo.io.MatchedTcpListener
+	(*Matcher)(nil),                           // 11: gloo.solo.io.Matcher
+	(*AggregateListener)(nil),                 // 12: gloo.solo.io.AggregateListener
+	(*VirtualHost)(nil),                       // 13: gloo.solo.io.VirtualHost
+	(*Route)(nil),                             // 14: gloo.solo.io.Route
+	(*RouteAction)(nil),                       // 15: gloo.solo.io.RouteAction
+	(*Destination)(nil),                       // 16: gloo.solo.io.Destination
+	(*KubernetesServiceDestination)(nil),      // 17: gloo.solo.io.KubernetesServiceDestination
+	(*ConsulServiceDestination)(nil),          // 18: gloo.solo.io.ConsulServiceDestination
+	(*UpstreamGroup)(nil),                     // 19: gloo.solo.io.UpstreamGroup
+	(*MultiDestination)(nil),                  // 20: gloo.solo.io.MultiDestination
+	(*WeightedDestination)(nil),               // 21: gloo.solo.io.WeightedDestination
+	(*RedirectAction)(nil),                    // 22: gloo.solo.io.RedirectAction
+	(*DirectResponseAction)(nil),              // 23: gloo.solo.io.DirectResponseAction
+	(*SourceMetadata)(nil),                    // 24: gloo.solo.io.SourceMetadata
+	(*CustomEnvoyFilter)(nil),                 // 25: gloo.solo.io.CustomEnvoyFilter
+	(*TcpHost_TcpAction)(nil),                 // 26: gloo.solo.io.TcpHost.TcpAction
+	(*AggregateListener_HttpResources)(nil),   // 27: gloo.solo.io.AggregateListener.HttpResources
+	(*AggregateListener_HttpFilterChain)(nil), // 28: gloo.solo.io.AggregateListener.HttpFilterChain
+	nil,                                          // 29: gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry
+	nil,                                          // 30: gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry
+	(*SourceMetadata_SourceRef)(nil),             // 31: gloo.solo.io.SourceMetadata.SourceRef
+	(*core.NamespacedStatuses)(nil),              // 32: core.solo.io.NamespacedStatuses
+	(*core.Metadata)(nil),                        // 33: core.solo.io.Metadata
+	(*ssl.SslConfig)(nil),                        // 34: gloo.solo.io.SslConfig
+	(*wrapperspb.BoolValue)(nil),                 // 35: google.protobuf.BoolValue
+	(*ListenerOptions)(nil),                      // 36: gloo.solo.io.ListenerOptions
+	(*structpb.Struct)(nil),                      // 37: google.protobuf.Struct
+	(*RouteConfigurationOptions)(nil),            // 38: gloo.solo.io.RouteConfigurationOptions
+	(*TcpListenerOptions)(nil),                   // 39: gloo.solo.io.TcpListenerOptions
+	(*durationpb.Duration)(nil),                  // 40: google.protobuf.Duration
+	(*HttpListenerOptions)(nil),                  // 41: gloo.solo.io.HttpListenerOptions
+	(*v3.CidrRange)(nil),                         // 42: solo.io.envoy.config.core.v3.CidrRange
+	(*wrapperspb.UInt32Value)(nil),               // 43: google.protobuf.UInt32Value
+	(*VirtualHostOptions)(nil),                   // 44: gloo.solo.io.VirtualHostOptions
+	(*matchers.Matcher)(nil),                     // 45: matchers.core.gloo.solo.io.Matcher
+	(*RouteOptions)(nil),                         // 46: gloo.solo.io.RouteOptions
+	(*core.ResourceRef)(nil),                     // 47: core.solo.io.ResourceRef
+	(*dynamic_forward_proxy.PerRouteConfig)(nil), // 48: dfp.options.gloo.solo.io.PerRouteConfig
+	(*DestinationSpec)(nil),                      // 49: gloo.solo.io.DestinationSpec
+	(*Subset)(nil),                               // 50: gloo.solo.io.Subset
+	(*WeightedDestinationOptions)(nil),           // 51: gloo.solo.io.WeightedDestinationOptions
+	(*v31.RegexMatchAndSubstitute)(nil),          // 52: solo.io.envoy.type.matcher.v3.RegexMatchAndSubstitute
+	(*filters.FilterStage)(nil),                  // 53: filters.gloo.solo.io.FilterStage
+	(*anypb.Any)(nil),                            // 54: google.protobuf.Any
+	(*emptypb.Empty)(nil),                        // 55: google.protobuf.Empty
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_depIdxs = []int32{
 	2,  // 0: gloo.solo.io.Proxy.listeners:type_name -> gloo.solo.io.Listener
-	30, // 1: gloo.solo.io.Proxy.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
-	31, // 2: gloo.solo.io.Proxy.metadata:type_name -> core.solo.io.Metadata
-	5,  // 3: gloo.solo.io.Listener.http_listener:type_name -> gloo.solo.io.HttpListener
+	32, // 1: gloo.solo.io.Proxy.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
+	33, // 2: gloo.solo.io.Proxy.metadata:type_name -> core.solo.io.Metadata
+	7,  // 3: gloo.solo.io.Listener.http_listener:type_name -> gloo.solo.io.HttpListener
 	3,  // 4: gloo.solo.io.Listener.tcp_listener:type_name -> gloo.solo.io.TcpListener
-	6,  // 5: gloo.solo.io.Listener.hybrid_listener:type_name -> gloo.solo.io.HybridListener
-	10, // 6: gloo.solo.io.Listener.aggregate_listener:type_name -> gloo.solo.io.AggregateListener
-	32, // 7: gloo.solo.io.Listener.ssl_configurations:type_name -> gloo.solo.io.SslConfig
-	33, // 8: gloo.solo.io.Listener.use_proxy_proto:type_name -> google.protobuf.BoolValue
-	34, // 9: gloo.solo.io.Listener.options:type_name -> gloo.solo.io.ListenerOptions
-	35, // 10: gloo.solo.io.Listener.metadata:type_name -> google.protobuf.Struct
-	22, // 11: gloo.solo.io.Listener.metadata_static:type_name -> gloo.solo.io.SourceMetadata
-	36, // 12: gloo.solo.io.Listener.route_options:type_name -> gloo.solo.io.RouteConfigurationOptions
-	4,  // 13: gloo.solo.io.TcpListener.tcp_hosts:type_name -> gloo.solo.io.TcpHost
-	37, // 14: gloo.solo.io.TcpListener.options:type_name -> gloo.solo.io.TcpListenerOptions
-	23, // 15: gloo.solo.io.TcpListener.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	32, // 16: gloo.solo.io.TcpHost.ssl_config:type_name -> gloo.solo.io.SslConfig
-	24, // 17: gloo.solo.io.TcpHost.destination:type_name -> gloo.solo.io.TcpHost.TcpAction
-	11, // 18: gloo.solo.io.HttpListener.virtual_hosts:type_name -> gloo.solo.io.VirtualHost
-	38, // 19: gloo.solo.io.HttpListener.options:type_name -> gloo.solo.io.HttpListenerOptions
-	23, // 20: gloo.solo.io.HttpListener.custom_http_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	23, // 21: gloo.solo.io.HttpListener.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	35, // 22: gloo.solo.io.HttpListener.metadata:type_name -> google.protobuf.Struct
-	22, // 23: gloo.solo.io.HttpListener.metadata_static:type_name -> gloo.solo.io.SourceMetadata
-	7,  // 24: gloo.solo.io.HybridListener.matched_listeners:type_name -> gloo.solo.io.MatchedListener
-	9,  // 25: gloo.solo.io.MatchedListener.matcher:type_name -> gloo.solo.io.Matcher
-	5,  // 26: gloo.solo.io.MatchedListener.http_listener:type_name -> gloo.solo.io.HttpListener
-	3,  // 27: gloo.solo.io.MatchedListener.tcp_listener:type_name -> gloo.solo.io.TcpListener
-	32, // 28: gloo.solo.io.MatchedListener.ssl_configurations:type_name -> gloo.solo.io.SslConfig
-	9,  // 29: gloo.solo.io.MatchedTcpListener.matcher:type_name -> gloo.solo.io.Matcher
-	3,  // 30: gloo.solo.io.MatchedTcpListener.tcp_listener:type_name -> gloo.solo.io.TcpListener
-	32, // 31: gloo.solo.io.Matcher.ssl_config:type_name -> gloo.solo.io.SslConfig
-	39, // 32: gloo.solo.io.Matcher.source_prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
-	39, // 33: gloo.solo.io.Matcher.prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
-	40, // 34: gloo.solo.io.Matcher.destination_port:type_name -> google.protobuf.UInt32Value
-	25, // 35: gloo.solo.io.AggregateListener.http_resources:type_name -> gloo.solo.io.AggregateListener.HttpResources
-	26, // 36: gloo.solo.io.AggregateListener.http_filter_chains:type_name -> gloo.solo.io.AggregateListener.HttpFilterChain
-	8,  // 37: gloo.solo.io.AggregateListener.tcp_listeners:type_name -> gloo.solo.io.MatchedTcpListener
-	12, // 38: gloo.solo.io.VirtualHost.routes:type_name -> gloo.solo.io.Route
-	41, // 39: gloo.solo.io.VirtualHost.options:type_name -> gloo.solo.io.VirtualHostOptions
-	35, // 40: gloo.solo.io.VirtualHost.metadata:type_name -> google.protobuf.Struct
-	22, // 41: gloo.solo.io.VirtualHost.metadata_static:type_name -> gloo.solo.io.SourceMetadata
-	42, // 42: gloo.solo.io.Route.matchers:type_name -> matchers.core.gloo.solo.io.Matcher
-	13, // 43: gloo.solo.io.Route.route_action:type_name -> gloo.solo.io.RouteAction
-	20, // 44: gloo.solo.io.Route.redirect_action:type_name -> gloo.solo.io.RedirectAction
-	21, // 45: gloo.solo.io.Route.direct_response_action:type_name -> gloo.solo.io.DirectResponseAction
-	43, // 46: gloo.solo.io.Route.options:type_name -> gloo.solo.io.RouteOptions
-	35, // 47: gloo.solo.io.Route.metadata:type_name -> google.protobuf.Struct
-	22, // 48: gloo.solo.io.Route.metadata_static:type_name -> gloo.solo.io.SourceMetadata
-	14, // 49: gloo.solo.io.RouteAction.single:type_name -> gloo.solo.io.Destination
-	18, // 50: gloo.solo.io.RouteAction.multi:type_name -> gloo.solo.io.MultiDestination
-	44, // 51: gloo.solo.io.RouteAction.upstream_group:type_name -> core.solo.io.ResourceRef
-	45, // 52: gloo.solo.io.RouteAction.dynamic_forward_proxy:type_name -> dfp.options.gloo.solo.io.PerRouteConfig
-	44, // 53: gloo.solo.io.Destination.upstream:type_name -> core.solo.io.ResourceRef
-	15, // 54: gloo.solo.io.Destination.kube:type_name -> gloo.solo.io.KubernetesServiceDestination
-	16, // 55: gloo.solo.io.Destination.consul:type_name -> gloo.solo.io.ConsulServiceDestination
-	46, // 56: gloo.solo.io.Destination.destination_spec:type_name -> gloo.solo.io.DestinationSpec
-	47, // 57: gloo.solo.io.Destination.subset:type_name -> gloo.solo.io.Subset
-	44, // 58: gloo.solo.io.KubernetesServiceDestination.ref:type_name -> core.solo.io.ResourceRef
-	19, // 59: gloo.solo.io.UpstreamGroup.destinations:type_name -> gloo.solo.io.WeightedDestination
-	30, // 60: gloo.solo.io.UpstreamGroup.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
-	31, // 61: gloo.solo.io.UpstreamGroup.metadata:type_name -> core.solo.io.Metadata
-	19, // 62: gloo.solo.io.MultiDestination.destinations:type_name -> gloo.solo.io.WeightedDestination
-	14, // 63: gloo.solo.io.WeightedDestination.destination:type_name -> gloo.solo.io.Destination
-	40, // 64: gloo.solo.io.WeightedDestination.weight:type_name -> google.protobuf.UInt32Value
-	48, // 65: gloo.solo.io.WeightedDestination.options:type_name -> gloo.solo.io.WeightedDestinationOptions
-	49, // 66: gloo.solo.io.RedirectAction.regex_rewrite:type_name -> solo.io.envoy.type.matcher.v3.RegexMatchAndSubstitute
-	0,  // 67: gloo.solo.io.RedirectAction.response_code:type_name -> gloo.solo.io.RedirectAction.RedirectResponseCode
-	40, // 68: gloo.solo.io.RedirectAction.port_redirect:type_name -> google.protobuf.UInt32Value
-	29, // 69: gloo.solo.io.SourceMetadata.sources:type_name -> gloo.solo.io.SourceMetadata.SourceRef
-	50, // 70: gloo.solo.io.CustomEnvoyFilter.filter_stage:type_name -> filters.gloo.solo.io.FilterStage
-	51, // 71: gloo.solo.io.CustomEnvoyFilter.config:type_name -> google.protobuf.Any
-	14, // 72: gloo.solo.io.TcpHost.TcpAction.single:type_name -> gloo.solo.io.Destination
-	18, // 73: gloo.solo.io.TcpHost.TcpAction.multi:type_name -> gloo.solo.io.MultiDestination
-	44, // 74: gloo.solo.io.TcpHost.TcpAction.upstream_group:type_name -> core.solo.io.ResourceRef
-	52, // 75: gloo.solo.io.TcpHost.TcpAction.forward_sni_cluster_name:type_name -> google.protobuf.Empty
-	27, // 76: gloo.solo.io.AggregateListener.HttpResources.virtual_hosts:type_name -> gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry
-	28, // 77: gloo.solo.io.AggregateListener.HttpResources.http_options:type_name -> gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry
-	9,  // 78: gloo.solo.io.AggregateListener.HttpFilterChain.matcher:type_name -> gloo.solo.io.Matcher
-	23, // 79: gloo.solo.io.AggregateListener.HttpFilterChain.custom_http_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	23, // 80: gloo.solo.io.AggregateListener.HttpFilterChain.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
-	11, // 81: gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry.value:type_name -> gloo.solo.io.VirtualHost
-	38, // 82: gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry.value:type_name -> gloo.solo.io.HttpListenerOptions
-	44, // 83: gloo.solo.io.SourceMetadata.SourceRef.resource_ref:type_name -> core.solo.io.ResourceRef
-	84, // [84:84] is the sub-list for method output_type
-	84, // [84:84] is the sub-list for method input_type
-	84, // [84:84] is the sub-list for extension type_name
-	84, // [84:84] is the sub-list for extension extendee
-	0,  // [0:84] is the sub-list for field type_name
+	8,  // 5: gloo.solo.io.Listener.hybrid_listener:type_name -> gloo.solo.io.HybridListener
+	12, // 6: gloo.solo.io.Listener.aggregate_listener:type_name -> gloo.solo.io.AggregateListener
+	5,  // 7: gloo.solo.io.Listener.udp_listener:type_name -> gloo.solo.io.UdpListener
+	34, // 8: gloo.solo.io.Listener.ssl_configurations:type_name -> gloo.solo.io.SslConfig
+	35, // 9: gloo.solo.io.Listener.use_proxy_proto:type_name -> google.protobuf.BoolValue
+	36, // 10: gloo.solo.io.Listener.options:type_name -> gloo.solo.io.ListenerOptions
+	37, // 11: gloo.solo.io.Listener.metadata:type_name -> google.protobuf.Struct
+	24, // 12: gloo.solo.io.Listener.metadata_static:type_name -> gloo.solo.io.SourceMetadata
+	38, // 13: gloo.solo.io.Listener.route_options:type_name -> gloo.solo.io.RouteConfigurationOptions
+	4,  // 14: gloo.solo.io.TcpListener.tcp_hosts:type_name -> gloo.solo.io.TcpHost
+	39, // 15: gloo.solo.io.TcpListener.options:type_name -> gloo.solo.io.TcpListenerOptions
+	25, // 16: gloo.solo.io.TcpListener.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	34, // 17: gloo.solo.io.TcpHost.ssl_config:type_name -> gloo.solo.io.SslConfig
+	26, // 18: gloo.solo.io.TcpHost.destination:type_name -> gloo.solo.io.TcpHost.TcpAction
+	6,  // 19: gloo.solo.io.UdpListener.udp_proxy:type_name -> gloo.solo.io.UdpProxyAction
+	16, // 20: gloo.solo.io.UdpProxyAction.destination:type_name -> gloo.solo.io.Destination
+	40, // 21: gloo.solo.io.UdpProxyAction.idle_timeout:type_name -> google.protobuf.Duration
+	35, // 22: gloo.solo.io.UdpProxyAction.use_per_packet_load_balancing:type_name -> google.protobuf.BoolValue
+	13, // 23: gloo.solo.io.HttpListener.virtual_hosts:type_name -> gloo.solo.io.VirtualHost
+	41, // 24: gloo.solo.io.HttpListener.options:type_name -> gloo.solo.io.HttpListenerOptions
+	25, // 25: gloo.solo.io.HttpListener.custom_http_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	25, // 26: gloo.solo.io.HttpListener.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	37, // 27: gloo.solo.io.HttpListener.metadata:type_name -> google.protobuf.Struct
+	24, // 28: gloo.solo.io.HttpListener.metadata_static:type_name -> gloo.solo.io.SourceMetadata
+	9,  // 29: gloo.solo.io.HybridListener.matched_listeners:type_name -> gloo.solo.io.MatchedListener
+	11, // 30: gloo.solo.io.MatchedListener.matcher:type_name -> gloo.solo.io.Matcher
+	7,  // 31: gloo.solo.io.MatchedListener.http_listener:type_name -> gloo.solo.io.HttpListener
+	3,  // 32: gloo.solo.io.MatchedListener.tcp_listener:type_name -> gloo.solo.io.TcpListener
+	34, // 33: gloo.solo.io.MatchedListener.ssl_configurations:type_name -> gloo.solo.io.SslConfig
+	11, // 34: gloo.solo.io.MatchedTcpListener.matcher:type_name -> gloo.solo.io.Matcher
+	3,  // 35: gloo.solo.io.MatchedTcpListener.tcp_listener:type_name -> gloo.solo.io.TcpListener
+	34, // 36: gloo.solo.io.Matcher.ssl_config:type_name -> gloo.solo.io.SslConfig
+	42, // 37: gloo.solo.io.Matcher.source_prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
+	42, // 38: gloo.solo.io.Matcher.prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
+	43, // 39: gloo.solo.io.Matcher.destination_port:type_name -> google.protobuf.UInt32Value
+	27, // 40: gloo.solo.io.AggregateListener.http_resources:type_name -> gloo.solo.io.AggregateListener.HttpResources
+	28, // 41: gloo.solo.io.AggregateListener.http_filter_chains:type_name -> gloo.solo.io.AggregateListener.HttpFilterChain
+	10, // 42: gloo.solo.io.AggregateListener.tcp_listeners:type_name -> gloo.solo.io.MatchedTcpListener
+	14, // 43: gloo.solo.io.VirtualHost.routes:type_name -> gloo.solo.io.Route
+	44, // 44: gloo.solo.io.VirtualHost.options:type_name -> gloo.solo.io.VirtualHostOptions
+	37, // 45: gloo.solo.io.VirtualHost.metadata:type_name -> google.protobuf.Struct
+	24, // 46: gloo.solo.io.VirtualHost.metadata_static:type_name -> gloo.solo.io.SourceMetadata
+	45, // 47: gloo.solo.io.Route.matchers:type_name -> matchers.core.gloo.solo.io.Matcher
+	15, // 48: gloo.solo.io.Route.route_action:type_name -> gloo.solo.io.RouteAction
+	22, // 49: gloo.solo.io.Route.redirect_action:type_name -> gloo.solo.io.RedirectAction
+	23, // 50: gloo.solo.io.Route.direct_response_action:type_name -> gloo.solo.io.DirectResponseAction
+	46, // 51: gloo.solo.io.Route.options:type_name -> gloo.solo.io.RouteOptions
+	37, // 52: gloo.solo.io.Route.metadata:type_name -> google.protobuf.Struct
+	24, // 53: gloo.solo.io.Route.metadata_static:type_name -> gloo.solo.io.SourceMetadata
+	16, // 54: gloo.solo.io.RouteAction.single:type_name -> gloo.solo.io.Destination
+	20, // 55: gloo.solo.io.RouteAction.multi:type_name -> gloo.solo.io.MultiDestination
+	47, // 56: gloo.solo.io.RouteAction.upstream_group:type_name -> core.solo.io.ResourceRef
+	48, // 57: gloo.solo.io.RouteAction.dynamic_forward_proxy:type_name -> dfp.options.gloo.solo.io.PerRouteConfig
+	47, // 58: gloo.solo.io.Destination.upstream:type_name -> core.solo.io.ResourceRef
+	17, // 59: gloo.solo.io.Destination.kube:type_name -> gloo.solo.io.KubernetesServiceDestination
+	18, // 60: gloo.solo.io.Destination.consul:type_name -> gloo.solo.io.ConsulServiceDestination
+	49, // 61: gloo.solo.io.Destination.destination_spec:type_name -> gloo.solo.io.DestinationSpec
+	50, // 62: gloo.solo.io.Destination.subset:type_name -> gloo.solo.io.Subset
+	47, // 63: gloo.solo.io.KubernetesServiceDestination.ref:type_name -> core.solo.io.ResourceRef
+	21, // 64: gloo.solo.io.UpstreamGroup.destinations:type_name -> gloo.solo.io.WeightedDestination
+	32, // 65: gloo.solo.io.UpstreamGroup.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
+	33, // 66: gloo.solo.io.UpstreamGroup.metadata:type_name -> core.solo.io.Metadata
+	21, // 67: gloo.solo.io.MultiDestination.destinations:type_name -> gloo.solo.io.WeightedDestination
+	16, // 68: gloo.solo.io.WeightedDestination.destination:type_name -> gloo.solo.io.Destination
+	43, // 69: gloo.solo.io.WeightedDestination.weight:type_name -> google.protobuf.UInt32Value
+	51, // 70: gloo.solo.io.WeightedDestination.options:type_name -> gloo.solo.io.WeightedDestinationOptions
+	52, // 71: gloo.solo.io.RedirectAction.regex_rewrite:type_name -> solo.io.envoy.type.matcher.v3.RegexMatchAndSubstitute
+	0,  // 72: gloo.solo.io.RedirectAction.response_code:type_name -> gloo.solo.io.RedirectAction.RedirectResponseCode
+	43, // 73: gloo.solo.io.RedirectAction.port_redirect:type_name -> google.protobuf.UInt32Value
+	31, // 74: gloo.solo.io.SourceMetadata.sources:type_name -> gloo.solo.io.SourceMetadata.SourceRef
+	53, // 75: gloo.solo.io.CustomEnvoyFilter.filter_stage:type_name -> filters.gloo.solo.io.FilterStage
+	54, // 76: gloo.solo.io.CustomEnvoyFilter.config:type_name -> google.protobuf.Any
+	16, // 77: gloo.solo.io.TcpHost.TcpAction.single:type_name -> gloo.solo.io.Destination
+	20, // 78: gloo.solo.io.TcpHost.TcpAction.multi:type_name -> gloo.solo.io.MultiDestination
+	47, // 79: gloo.solo.io.TcpHost.TcpAction.upstream_group:type_name -> core.solo.io.ResourceRef
+	55, // 80: gloo.solo.io.TcpHost.TcpAction.forward_sni_cluster_name:type_name -> google.protobuf.Empty
+	29, // 81: gloo.solo.io.AggregateListener.HttpResources.virtual_hosts:type_name -> gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry
+	30, // 82: gloo.solo.io.AggregateListener.HttpResources.http_options:type_name -> gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry
+	11, // 83: gloo.solo.io.AggregateListener.HttpFilterChain.matcher:type_name -> gloo.solo.io.Matcher
+	25, // 84: gloo.solo.io.AggregateListener.HttpFilterChain.custom_http_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	25, // 85: gloo.solo.io.AggregateListener.HttpFilterChain.custom_network_filters:type_name -> gloo.solo.io.CustomEnvoyFilter
+	13, // 86: gloo.solo.io.AggregateListener.HttpResources.VirtualHostsEntry.value:type_name -> gloo.solo.io.VirtualHost
+	41, // 87: gloo.solo.io.AggregateListener.HttpResources.HttpOptionsEntry.value:type_name -> gloo.solo.io.HttpListenerOptions
+	47, // 88: gloo.solo.io.SourceMetadata.SourceRef.resource_ref:type_name -> core.solo.io.ResourceRef
+	89, // [89:89] is the sub-list for method output_type
+	89, // [89:89] is the sub-list for method input_type
+	89, // [89:89] is the sub-list for extension type_name
+	89, // [89:89] is the sub-list for extension extendee
+	0,  // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_init() }
@@ -2993,46 +3152,47 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_init() {
 		(*Listener_TcpListener)(nil),
 		(*Listener_HybridListener)(nil),
 		(*Listener_AggregateListener)(nil),
+		(*Listener_UdpListener)(nil),
 		(*Listener_Metadata)(nil),
 		(*Listener_MetadataStatic)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[4].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[6].OneofWrappers = []any{
 		(*HttpListener_Metadata)(nil),
 		(*HttpListener_MetadataStatic)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[6].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[8].OneofWrappers = []any{
 		(*MatchedListener_HttpListener)(nil),
 		(*MatchedListener_TcpListener)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[10].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[12].OneofWrappers = []any{
 		(*VirtualHost_Metadata)(nil),
 		(*VirtualHost_MetadataStatic)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[11].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[13].OneofWrappers = []any{
 		(*Route_RouteAction)(nil),
 		(*Route_RedirectAction)(nil),
 		(*Route_DirectResponseAction)(nil),
 		(*Route_Metadata)(nil),
 		(*Route_MetadataStatic)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[12].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[14].OneofWrappers = []any{
 		(*RouteAction_Single)(nil),
 		(*RouteAction_Multi)(nil),
 		(*RouteAction_UpstreamGroup)(nil),
 		(*RouteAction_ClusterHeader)(nil),
 		(*RouteAction_DynamicForwardProxy)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[13].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[15].OneofWrappers = []any{
 		(*Destination_Upstream)(nil),
 		(*Destination_Kube)(nil),
 		(*Destination_Consul)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[19].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[21].OneofWrappers = []any{
 		(*RedirectAction_PathRedirect)(nil),
 		(*RedirectAction_PrefixRewrite)(nil),
 		(*RedirectAction_RegexRewrite)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[23].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_msgTypes[25].OneofWrappers = []any{
 		(*TcpHost_TcpAction_Single)(nil),
 		(*TcpHost_TcpAction_Multi)(nil),
 		(*TcpHost_TcpAction_UpstreamGroup)(nil),
@@ -3044,7 +3204,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gloo_api_v1_proxy_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   29,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
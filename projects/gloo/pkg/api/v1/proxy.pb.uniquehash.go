@@ -321,6 +321,28 @@ func (m *Listener) HashUnique(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *Listener_UdpListener:
+
+		if h, ok := interface{}(m.GetUdpListener()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("UdpListener")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetUdpListener(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("UdpListener")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	switch m.OpaqueMetadata.(type) {
@@ -506,6 +528,131 @@ func (m *TcpHost) HashUnique(hasher hash.Hash64) (uint64, error) {
 	return hasher.Sum64(), nil
 }
 
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *UdpListener) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.UdpListener")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetUdpProxy()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("UdpProxy")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetUdpProxy(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("UdpProxy")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte("StatPrefix")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetStatPrefix())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *UdpProxyAction) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.UdpProxyAction")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetDestination()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Destination")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetDestination(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Destination")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("IdleTimeout")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetIdleTimeout(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("IdleTimeout")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetUsePerPacketLoadBalancing()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("UsePerPacketLoadBalancing")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetUsePerPacketLoadBalancing(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("UsePerPacketLoadBalancing")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // HashUnique function generates a hash of the object that is unique to the object by
 // hashing field name and value pairs.
 // Replaces Hash due to original hashing implemention only using field values. The omission
@@ -35,6 +35,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_waf "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_cors "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_faultinjection "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
@@ -267,6 +269,12 @@ func (m *RouteOptions) Clone() proto.Message {
 		target.Ai = proto.Clone(m.GetAi()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_ai.RouteSettings)
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(clone.Cloner); ok {
+		target.Compression = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionPerRoute)
+	} else {
+		target.Compression = proto.Clone(m.GetCompression()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionPerRoute)
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
@@ -350,6 +350,16 @@ func (m *RouteOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompression(), target.GetCompression()) {
+			return false
+		}
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
@@ -22,6 +22,7 @@ import (
 	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	rbac "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
 	waf "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
+	compression "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
 	cors "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
 	faultinjection "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
 	headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
@@ -180,7 +181,9 @@ type RouteOptions struct {
 	ExtProcLate *extproc.RouteSettings `protobuf:"bytes,33,opt,name=ext_proc_late,json=extProcLate,proto3" json:"ext_proc_late,omitempty"`
 	// Enterprise-only: Settings to configure ai settings for a route.
 	// These settings will only apply if the backend is an `ai` Upstream.
-	Ai            *ai.RouteSettings `protobuf:"bytes,31,opt,name=ai,proto3" json:"ai,omitempty"`
+	Ai *ai.RouteSettings `protobuf:"bytes,31,opt,name=ai,proto3" json:"ai,omitempty"`
+	// Compression settings for the route, which can disable the compressors of the listener for its responses.
+	Compression   *compression.CompressionPerRoute `protobuf:"bytes,148,opt,name=compression,proto3" json:"compression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -572,6 +575,13 @@ func (x *RouteOptions) GetAi() *ai.RouteSettings {
 	return nil
 }
 
+func (x *RouteOptions) GetCompression() *compression.CompressionPerRoute {
+	if x != nil {
+		return x.Compression
+	}
+	return nil
+}
+
 type isRouteOptions_HostRewriteType interface {
 	isRouteOptions_HostRewriteType()
 }
@@ -802,7 +812,7 @@ var File_github_com_solo_io_gloo_projects_gloo_api_v1_route_options_proto protor
 
 const file_github_com_solo_io_gloo_projects_gloo_api_v1_route_options_proto_rawDesc = "" +
 	"\n" +
-	"@github.com/solo-io/gloo/projects/gloo/api/v1/route_options.proto\x12\fgloo.solo.io\x1a\x12extproto/ext.proto\x1aXgithub.com/solo-io/gloo/projects/gloo/api/v1/options/transformation/transformation.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/options/faultinjection/fault.proto\x1aJgithub.com/solo-io/gloo/projects/gloo/api/v1/options/retries/retries.proto\x1a=github.com/solo-io/gloo/projects/gloo/api/v1/extensions.proto\x1aJgithub.com/solo-io/gloo/projects/gloo/api/v1/options/tracing/tracing.proto\x1aNgithub.com/solo-io/gloo/projects/gloo/api/v1/options/shadowing/shadowing.proto\x1aJgithub.com/solo-io/gloo/projects/gloo/api/v1/options/headers/headers.proto\x1aTgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/type/matcher/v3/regex.proto\x1aDgithub.com/solo-io/gloo/projects/gloo/api/v1/options/cors/cors.proto\x1aHgithub.com/solo-io/gloo/projects/gloo/api/v1/options/lbhash/lbhash.proto\x1a\\github.com/solo-io/gloo/projects/gloo/api/v1/options/protocol_upgrade/protocol_upgrade.proto\x1aYgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/ratelimit/ratelimit.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/waf/waf.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/jwt/jwt.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/rbac/rbac.proto\x1aXgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extauth/v1/extauth.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/dlp/dlp.proto\x1aggithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/buffer/v3/buffer.proto\x1acgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/csrf/v3/csrf.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extproc/extproc.proto\x1aKgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/ai/ai.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xdc\x1e\n" +
+	"@github.com/solo-io/gloo/projects/gloo/api/v1/route_options.proto\x12\fgloo.solo.io\x1a\x12extproto/ext.proto\x1aXgithub.com/solo-io/gloo/projects/gloo/api/v1/options/transformation/transformation.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/options/faultinjection/fault.proto\x1aJgithub.com/solo-io/gloo/projects/gloo/api/v1/options/retries/retries.proto\x1a=github.com/solo-io/gloo/projects/gloo/api/v1/extensions.proto\x1aJgithub.com/solo-io/gloo/projects/gloo/api/v1/options/tracing/tracing.proto\x1aNgithub.com/solo-io/gloo/projects/gloo/api/v1/options/shadowing/shadowing.proto\x1aJgithub.com/solo-io/gloo/projects/gloo/api/v1/options/headers/headers.proto\x1aTgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/type/matcher/v3/regex.proto\x1aDgithub.com/solo-io/gloo/projects/gloo/api/v1/options/cors/cors.proto\x1aHgithub.com/solo-io/gloo/projects/gloo/api/v1/options/lbhash/lbhash.proto\x1a\\github.com/solo-io/gloo/projects/gloo/api/v1/options/protocol_upgrade/protocol_upgrade.proto\x1aYgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/ratelimit/ratelimit.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/waf/waf.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/jwt/jwt.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/rbac/rbac.proto\x1aXgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extauth/v1/extauth.proto\x1aMgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/dlp/dlp.proto\x1aggithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/buffer/v3/buffer.proto\x1acgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/csrf/v3/csrf.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extproc/extproc.proto\x1aKgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/ai/ai.proto\x1aRgithub.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xb6\x1f\n" +
 	"\fRouteOptions\x12b\n" +
 	"\x0ftransformations\x18\x01 \x01(\v24.transformation.options.gloo.solo.io.TransformationsB\x02\x18\x01R\x0ftransformations\x12?\n" +
 	"\x06faults\x18\x02 \x01(\v2'.fault.options.gloo.solo.io.RouteFaultsR\x06faults\x12C\n" +
@@ -849,7 +859,8 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_route_options_proto_rawD
 	"\x0eext_proc_early\x18\" \x01(\v2+.extproc.options.gloo.solo.io.RouteSettingsR\fextProcEarly\x12F\n" +
 	"\bext_proc\x18\x1e \x01(\v2+.extproc.options.gloo.solo.io.RouteSettingsR\aextProc\x12O\n" +
 	"\rext_proc_late\x18! \x01(\v2+.extproc.options.gloo.solo.io.RouteSettingsR\vextProcLate\x126\n" +
-	"\x02ai\x18\x1f \x01(\v2&.ai.options.gloo.solo.io.RouteSettingsR\x02ai\x1aY\n" +
+	"\x02ai\x18\x1f \x01(\v2&.ai.options.gloo.solo.io.RouteSettingsR\x02ai\x12X\n" +
+	"\vcompression\x18\x94\x01 \x01(\v25.compression.options.gloo.solo.io.CompressionPerRouteR\vcompression\x1aY\n" +
 	"\x12EnvoyMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
 	"\x05value\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x05value:\x028\x01\x1a\x88\x02\n" +
@@ -910,7 +921,8 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_route_options_proto_goType
 	(*transformation.TransformationStages)(nil),    // 29: transformation.options.gloo.solo.io.TransformationStages
 	(*extproc.RouteSettings)(nil),                  // 30: extproc.options.gloo.solo.io.RouteSettings
 	(*ai.RouteSettings)(nil),                       // 31: ai.options.gloo.solo.io.RouteSettings
-	(*structpb.Struct)(nil),                        // 32: google.protobuf.Struct
+	(*compression.CompressionPerRoute)(nil),        // 32: compression.options.gloo.solo.io.CompressionPerRoute
+	(*structpb.Struct)(nil),                        // 33: google.protobuf.Struct
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_route_options_proto_depIdxs = []int32{
 	3,  // 0: gloo.solo.io.RouteOptions.transformations:type_name -> transformation.options.gloo.solo.io.Transformations
@@ -954,15 +966,16 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_route_options_proto_depIdx
 	30, // 38: gloo.solo.io.RouteOptions.ext_proc:type_name -> extproc.options.gloo.solo.io.RouteSettings
 	30, // 39: gloo.solo.io.RouteOptions.ext_proc_late:type_name -> extproc.options.gloo.solo.io.RouteSettings
 	31, // 40: gloo.solo.io.RouteOptions.ai:type_name -> ai.options.gloo.solo.io.RouteSettings
-	32, // 41: gloo.solo.io.RouteOptions.EnvoyMetadataEntry.value:type_name -> google.protobuf.Struct
-	6,  // 42: gloo.solo.io.RouteOptions.MaxStreamDuration.max_stream_duration:type_name -> google.protobuf.Duration
-	6,  // 43: gloo.solo.io.RouteOptions.MaxStreamDuration.grpc_timeout_header_max:type_name -> google.protobuf.Duration
-	6,  // 44: gloo.solo.io.RouteOptions.MaxStreamDuration.grpc_timeout_header_offset:type_name -> google.protobuf.Duration
-	45, // [45:45] is the sub-list for method output_type
-	45, // [45:45] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	32, // 41: gloo.solo.io.RouteOptions.compression:type_name -> compression.options.gloo.solo.io.CompressionPerRoute
+	33, // 42: gloo.solo.io.RouteOptions.EnvoyMetadataEntry.value:type_name -> google.protobuf.Struct
+	6,  // 43: gloo.solo.io.RouteOptions.MaxStreamDuration.max_stream_duration:type_name -> google.protobuf.Duration
+	6,  // 44: gloo.solo.io.RouteOptions.MaxStreamDuration.grpc_timeout_header_max:type_name -> google.protobuf.Duration
+	6,  // 45: gloo.solo.io.RouteOptions.MaxStreamDuration.grpc_timeout_header_offset:type_name -> google.protobuf.Duration
+	46, // [46:46] is the sub-list for method output_type
+	46, // [46:46] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_route_options_proto_init() }
@@ -238,6 +238,12 @@ func (m *Settings) Clone() proto.Message {
 
 	target.IpV4Only = m.GetIpV4Only()
 
+	if h, ok := interface{}(m.GetControlPlaneTelemetry()).(clone.Cloner); ok {
+		target.ControlPlaneTelemetry = h.Clone().(*Settings_ControlPlaneTelemetry)
+	} else {
+		target.ControlPlaneTelemetry = proto.Clone(m.GetControlPlaneTelemetry()).(*Settings_ControlPlaneTelemetry)
+	}
+
 	switch m.ConfigSource.(type) {
 
 	case *Settings_KubernetesConfigSource:
@@ -546,6 +552,12 @@ func (m *GlooOptions) Clone() proto.Message {
 		target.EnableAutoWebsocketTransformationPassthrough = proto.Clone(m.GetEnableAutoWebsocketTransformationPassthrough()).(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetXdsAuthOptions()).(clone.Cloner); ok {
+		target.XdsAuthOptions = h.Clone().(*GlooOptions_XdsAuthOptions)
+	} else {
+		target.XdsAuthOptions = proto.Clone(m.GetXdsAuthOptions()).(*GlooOptions_XdsAuthOptions)
+	}
+
 	return target
 }
 
@@ -985,6 +997,8 @@ func (m *Settings_ConsulUpstreamDiscoveryConfiguration) Clone() proto.Message {
 		target.EdsBlockingQueries = proto.Clone(m.GetEdsBlockingQueries()).(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
 	}
 
+	target.ConnectServiceName = m.GetConnectServiceName()
+
 	return target
 }
 
@@ -1035,6 +1049,54 @@ func (m *Settings_ObservabilityOptions) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *Settings_ControlPlaneTelemetry) Clone() proto.Message {
+	var target *Settings_ControlPlaneTelemetry
+	if m == nil {
+		return target
+	}
+	target = &Settings_ControlPlaneTelemetry{}
+
+	target.OtlpEndpoint = m.GetOtlpEndpoint()
+
+	target.Insecure = m.GetInsecure()
+
+	if m.GetHeaders() != nil {
+		target.Headers = make(map[string]string, len(m.GetHeaders()))
+		for k, v := range m.GetHeaders() {
+
+			target.Headers[k] = v
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetTracesEnabled()).(clone.Cloner); ok {
+		target.TracesEnabled = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	} else {
+		target.TracesEnabled = proto.Clone(m.GetTracesEnabled()).(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	}
+
+	if h, ok := interface{}(m.GetMetricsEnabled()).(clone.Cloner); ok {
+		target.MetricsEnabled = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	} else {
+		target.MetricsEnabled = proto.Clone(m.GetMetricsEnabled()).(*google_golang_org_protobuf_types_known_wrapperspb.BoolValue)
+	}
+
+	if h, ok := interface{}(m.GetSamplingRatio()).(clone.Cloner); ok {
+		target.SamplingRatio = h.Clone().(*google_golang_org_protobuf_types_known_wrapperspb.DoubleValue)
+	} else {
+		target.SamplingRatio = proto.Clone(m.GetSamplingRatio()).(*google_golang_org_protobuf_types_known_wrapperspb.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetMetricExportInterval()).(clone.Cloner); ok {
+		target.MetricExportInterval = h.Clone().(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	} else {
+		target.MetricExportInterval = proto.Clone(m.GetMetricExportInterval()).(*google_golang_org_protobuf_types_known_durationpb.Duration)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *Settings_SecretOptions_Source) Clone() proto.Message {
 	var target *Settings_SecretOptions_Source
@@ -1296,6 +1358,127 @@ func (m *GlooOptions_IstioOptions) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *GlooOptions_XdsAuthOptions) Clone() proto.Message {
+	var target *GlooOptions_XdsAuthOptions
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_XdsAuthOptions{}
+
+	if h, ok := interface{}(m.GetMtls()).(clone.Cloner); ok {
+		target.Mtls = h.Clone().(*GlooOptions_XdsAuthOptions_Mtls)
+	} else {
+		target.Mtls = proto.Clone(m.GetMtls()).(*GlooOptions_XdsAuthOptions_Mtls)
+	}
+
+	if h, ok := interface{}(m.GetServiceAccountTokens()).(clone.Cloner); ok {
+		target.ServiceAccountTokens = h.Clone().(*GlooOptions_XdsAuthOptions_ServiceAccountTokens)
+	} else {
+		target.ServiceAccountTokens = proto.Clone(m.GetServiceAccountTokens()).(*GlooOptions_XdsAuthOptions_ServiceAccountTokens)
+	}
+
+	if m.GetProxyBindings() != nil {
+		target.ProxyBindings = make([]*GlooOptions_XdsAuthOptions_ProxyBinding, len(m.GetProxyBindings()))
+		for idx, v := range m.GetProxyBindings() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ProxyBindings[idx] = h.Clone().(*GlooOptions_XdsAuthOptions_ProxyBinding)
+			} else {
+				target.ProxyBindings[idx] = proto.Clone(v).(*GlooOptions_XdsAuthOptions_ProxyBinding)
+			}
+
+		}
+	}
+
+	target.AllowUnboundProxies = m.GetAllowUnboundProxies()
+
+	return target
+}
+
+// Clone function
+func (m *GlooOptions_XdsAuthOptions_Mtls) Clone() proto.Message {
+	var target *GlooOptions_XdsAuthOptions_Mtls
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_XdsAuthOptions_Mtls{}
+
+	target.CertFile = m.GetCertFile()
+
+	target.KeyFile = m.GetKeyFile()
+
+	target.CaFile = m.GetCaFile()
+
+	return target
+}
+
+// Clone function
+func (m *GlooOptions_XdsAuthOptions_ServiceAccountTokens) Clone() proto.Message {
+	var target *GlooOptions_XdsAuthOptions_ServiceAccountTokens
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_XdsAuthOptions_ServiceAccountTokens{}
+
+	if m.GetAudiences() != nil {
+		target.Audiences = make([]string, len(m.GetAudiences()))
+		for idx, v := range m.GetAudiences() {
+
+			target.Audiences[idx] = v
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *GlooOptions_XdsAuthOptions_ProxyBinding) Clone() proto.Message {
+	var target *GlooOptions_XdsAuthOptions_ProxyBinding
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_XdsAuthOptions_ProxyBinding{}
+
+	if m.GetServiceAccounts() != nil {
+		target.ServiceAccounts = make([]*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef, len(m.GetServiceAccounts()))
+		for idx, v := range m.GetServiceAccounts() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ServiceAccounts[idx] = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			} else {
+				target.ServiceAccounts[idx] = proto.Clone(v).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			}
+
+		}
+	}
+
+	switch m.Proxy.(type) {
+
+	case *GlooOptions_XdsAuthOptions_ProxyBinding_Role:
+
+		target.Proxy = &GlooOptions_XdsAuthOptions_ProxyBinding_Role{
+			Role: m.GetRole(),
+		}
+
+	case *GlooOptions_XdsAuthOptions_ProxyBinding_Gateway:
+
+		if h, ok := interface{}(m.GetGateway()).(clone.Cloner); ok {
+			target.Proxy = &GlooOptions_XdsAuthOptions_ProxyBinding_Gateway{
+				Gateway: h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		} else {
+			target.Proxy = &GlooOptions_XdsAuthOptions_ProxyBinding_Gateway{
+				Gateway: proto.Clone(m.GetGateway()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		}
+
+	}
+
+	return target
+}
+
 // Clone function
 func (m *GatewayOptions_ValidationOptions) Clone() proto.Message {
 	var target *GatewayOptions_ValidationOptions
@@ -341,6 +341,16 @@ func (m *Settings) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetControlPlaneTelemetry()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetControlPlaneTelemetry()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetControlPlaneTelemetry(), target.GetControlPlaneTelemetry()) {
+			return false
+		}
+	}
+
 	switch m.ConfigSource.(type) {
 
 	case *Settings_KubernetesConfigSource:
@@ -827,6 +837,16 @@ func (m *GlooOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetXdsAuthOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetXdsAuthOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetXdsAuthOptions(), target.GetXdsAuthOptions()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1674,6 +1694,10 @@ func (m *Settings_ConsulUpstreamDiscoveryConfiguration) Equal(that interface{})
 		}
 	}
 
+	if strings.Compare(m.GetConnectServiceName(), target.GetConnectServiceName()) != 0 {
+		return false
+	}
+
 	return true
 }
 
@@ -1762,6 +1786,89 @@ func (m *Settings_ObservabilityOptions) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *Settings_ControlPlaneTelemetry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Settings_ControlPlaneTelemetry)
+	if !ok {
+		that2, ok := that.(Settings_ControlPlaneTelemetry)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetOtlpEndpoint(), target.GetOtlpEndpoint()) != 0 {
+		return false
+	}
+
+	if m.GetInsecure() != target.GetInsecure() {
+		return false
+	}
+
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for k, v := range m.GetHeaders() {
+
+		if strings.Compare(v, target.GetHeaders()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTracesEnabled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTracesEnabled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTracesEnabled(), target.GetTracesEnabled()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMetricsEnabled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMetricsEnabled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMetricsEnabled(), target.GetMetricsEnabled()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetSamplingRatio()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSamplingRatio()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSamplingRatio(), target.GetSamplingRatio()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMetricExportInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMetricExportInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMetricExportInterval(), target.GetMetricExportInterval()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *Settings_SecretOptions_Source) Equal(that interface{}) bool {
 	if that == nil {
@@ -2230,6 +2337,216 @@ func (m *GlooOptions_IstioOptions) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *GlooOptions_XdsAuthOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_XdsAuthOptions)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsAuthOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMtls()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMtls()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMtls(), target.GetMtls()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetServiceAccountTokens()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetServiceAccountTokens()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetServiceAccountTokens(), target.GetServiceAccountTokens()) {
+			return false
+		}
+	}
+
+	if len(m.GetProxyBindings()) != len(target.GetProxyBindings()) {
+		return false
+	}
+	for idx, v := range m.GetProxyBindings() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetProxyBindings()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetProxyBindings()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetAllowUnboundProxies() != target.GetAllowUnboundProxies() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GlooOptions_XdsAuthOptions_Mtls) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_XdsAuthOptions_Mtls)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsAuthOptions_Mtls)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetCertFile(), target.GetCertFile()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetKeyFile(), target.GetKeyFile()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetCaFile(), target.GetCaFile()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GlooOptions_XdsAuthOptions_ServiceAccountTokens) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_XdsAuthOptions_ServiceAccountTokens)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsAuthOptions_ServiceAccountTokens)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetAudiences()) != len(target.GetAudiences()) {
+		return false
+	}
+	for idx, v := range m.GetAudiences() {
+
+		if strings.Compare(v, target.GetAudiences()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GlooOptions_XdsAuthOptions_ProxyBinding) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_XdsAuthOptions_ProxyBinding)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsAuthOptions_ProxyBinding)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetServiceAccounts()) != len(target.GetServiceAccounts()) {
+		return false
+	}
+	for idx, v := range m.GetServiceAccounts() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetServiceAccounts()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetServiceAccounts()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	switch m.Proxy.(type) {
+
+	case *GlooOptions_XdsAuthOptions_ProxyBinding_Role:
+		if _, ok := target.Proxy.(*GlooOptions_XdsAuthOptions_ProxyBinding_Role); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetRole(), target.GetRole()) != 0 {
+			return false
+		}
+
+	case *GlooOptions_XdsAuthOptions_ProxyBinding_Gateway:
+		if _, ok := target.Proxy.(*GlooOptions_XdsAuthOptions_ProxyBinding_Gateway); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetGateway()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGateway()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetGateway(), target.GetGateway()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Proxy != target.Proxy {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *GatewayOptions_ValidationOptions) Equal(that interface{}) bool {
 	if that == nil {
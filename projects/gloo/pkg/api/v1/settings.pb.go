@@ -7,10 +7,6 @@
 package v1
 
 import (
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-
 	aws "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/aws"
 	circuit_breaker "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/circuit_breaker"
 	caching "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/caching"
@@ -26,6 +22,9 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -229,38 +228,38 @@ type Settings struct {
 	// ```yaml
 	// watchNamespaceSelectors:
 	//   - matchLabels:
-	//     env: prod
-	//     region: us-east1
+	//       env: prod
+	//       region: us-east1
 	//   - matchExpressions:
-	//   - key: app
-	//     operator: In
-	//     values:
-	//   - cassandra
-	//   - spark
-	//
+	//     - key: app
+	//       operator: In
+	//       values:
+	//         - cassandra
+	//         - spark
 	// ```
 	// However, if the match conditions are part of the same same list item, the namespace must match all conditions.
 	// ```yaml
 	// watchNamespaceSelectors:
 	//   - matchLabels:
-	//     env: prod
-	//     region: us-east1
+	//       env: prod
+	//       region: us-east1
 	//     matchExpressions:
-	//   - key: app
-	//     operator: In
-	//     values:
-	//   - cassandra
-	//   - spark
-	//
+	//     - key: app
+	//       operator: In
+	//       values:
+	//         - cassandra
+	//         - spark
 	// ```
 	// Refer to the [Kubernetes selector docs](https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors)
 	// for additional detail on selector semantics.
 	WatchNamespaceSelectors []*LabelSelector `protobuf:"bytes,40,rep,name=watch_namespace_selectors,json=watchNamespaceSelectors,proto3" json:"watch_namespace_selectors,omitempty"`
 	// Set to true to only use ipv4 when creating gateways when running in gateway api mode.
 	// Defaults to false
-	IpV4Only      bool `protobuf:"varint,43,opt,name=ip_v4_only,json=ipV4Only,proto3" json:"ip_v4_only,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	IpV4Only bool `protobuf:"varint,43,opt,name=ip_v4_only,json=ipV4Only,proto3" json:"ip_v4_only,omitempty"`
+	// OpenTelemetry traces and metrics of the control plane.
+	ControlPlaneTelemetry *Settings_ControlPlaneTelemetry `protobuf:"bytes,44,opt,name=control_plane_telemetry,json=controlPlaneTelemetry,proto3" json:"control_plane_telemetry,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Settings) Reset() {
@@ -607,6 +606,13 @@ func (x *Settings) GetIpV4Only() bool {
 	return false
 }
 
+func (x *Settings) GetControlPlaneTelemetry() *Settings_ControlPlaneTelemetry {
+	if x != nil {
+		return x.ControlPlaneTelemetry
+	}
+	return nil
+}
+
 type isSettings_ConfigSource interface {
 	isSettings_ConfigSource()
 }
@@ -938,8 +944,12 @@ type GlooOptions struct {
 	// When enabled, request/response body transformation will be bypassed automatically for websocket request.
 	// Buffering of the body will also be disabled in the mode but header transformation will still be applied.
 	EnableAutoWebsocketTransformationPassthrough *wrapperspb.BoolValue `protobuf:"bytes,19,opt,name=enable_auto_websocket_transformation_passthrough,json=enableAutoWebsocketTransformationPassthrough,proto3" json:"enable_auto_websocket_transformation_passthrough,omitempty"`
-	unknownFields                                protoimpl.UnknownFields
-	sizeCache                                    protoimpl.SizeCache
+	// Authenticate the clients of the xDS servers, and only serve them the configuration of the proxies they are
+	// allowed to request. Requires either `mtls` or `serviceAccountTokens`.
+	// When unset, any client which can reach the servers is served the configuration of the proxy it requests.
+	XdsAuthOptions *GlooOptions_XdsAuthOptions `protobuf:"bytes,20,opt,name=xds_auth_options,json=xdsAuthOptions,proto3" json:"xds_auth_options,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GlooOptions) Reset() {
@@ -1105,6 +1115,13 @@ func (x *GlooOptions) GetEnableAutoWebsocketTransformationPassthrough() *wrapper
 	return nil
 }
 
+func (x *GlooOptions) GetXdsAuthOptions() *GlooOptions_XdsAuthOptions {
+	if x != nil {
+		return x.XdsAuthOptions
+	}
+	return nil
+}
+
 // Default configuration to use for VirtualServices, when not provided by a specific virtual service
 // When these properties are defined on a specific VirtualService, this configuration will be ignored
 type VirtualServiceOptions struct {
@@ -2398,6 +2415,12 @@ type Settings_ConsulUpstreamDiscoveryConfiguration struct {
 	//
 	// Defaults to false.
 	EdsBlockingQueries *wrapperspb.BoolValue `protobuf:"bytes,23,opt,name=eds_blocking_queries,json=edsBlockingQueries,proto3" json:"eds_blocking_queries,omitempty"`
+	// The name of the service whose Consul Connect identity Gloo uses to connect to Connect-enabled upstreams.
+	// Gloo fetches the leaf certificate of this service from the local Consul agent, so the Consul token of Gloo
+	// must have `service:write` permission on it, and the intentions of the upstream services must allow it.
+	// When set, the upstreams discovered for services with a Connect sidecar proxy are Connect-enabled.
+	// Connect-enabled upstreams use the identity of the 'gloo' service when this is not set.
+	ConnectServiceName string `protobuf:"bytes,24,opt,name=connect_service_name,json=connectServiceName,proto3" json:"connect_service_name,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -2488,6 +2511,13 @@ func (x *Settings_ConsulUpstreamDiscoveryConfiguration) GetEdsBlockingQueries()
 	return nil
 }
 
+func (x *Settings_ConsulUpstreamDiscoveryConfiguration) GetConnectServiceName() string {
+	if x != nil {
+		return x.ConnectServiceName
+	}
+	return ""
+}
+
 // Provides overrides for the default configuration parameters used to interact with Kubernetes.
 type Settings_KubernetesConfiguration struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2592,6 +2622,111 @@ func (x *Settings_ObservabilityOptions) GetConfigStatusMetricLabels() map[string
 	return nil
 }
 
+// Configures the OpenTelemetry instrumentation of the control plane components (gloo, discovery and
+// the access logger). Traces and metrics are exported over OTLP/gRPC. Metrics keep being served in the
+// Prometheus format on the `/metrics` endpoint of the stats server, under their existing names.
+type Settings_ControlPlaneTelemetry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The address (host:port) of the OTLP/gRPC collector that traces and metrics are exported to.
+	// Nothing is exported when unset.
+	OtlpEndpoint string `protobuf:"bytes,1,opt,name=otlp_endpoint,json=otlpEndpoint,proto3" json:"otlp_endpoint,omitempty"`
+	// Connect to the collector without TLS.
+	Insecure bool `protobuf:"varint,2,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// Headers sent with every export request, e.g. to authenticate with the collector.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Export traces of the control plane (snapshot emission, translation, validation, status writes and xDS pushes).
+	// Defaults to true when an endpoint is set.
+	TracesEnabled *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=traces_enabled,json=tracesEnabled,proto3" json:"traces_enabled,omitempty"`
+	// Export the metrics of the control plane. Defaults to true when an endpoint is set.
+	MetricsEnabled *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=metrics_enabled,json=metricsEnabled,proto3" json:"metrics_enabled,omitempty"`
+	// The ratio of traces that are sampled, between 0 and 1. Child spans follow the sampling decision
+	// of their parent. Defaults to 1.
+	SamplingRatio *wrapperspb.DoubleValue `protobuf:"bytes,6,opt,name=sampling_ratio,json=samplingRatio,proto3" json:"sampling_ratio,omitempty"`
+	// How often metrics are exported. Defaults to 60s.
+	MetricExportInterval *durationpb.Duration `protobuf:"bytes,7,opt,name=metric_export_interval,json=metricExportInterval,proto3" json:"metric_export_interval,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Settings_ControlPlaneTelemetry) Reset() {
+	*x = Settings_ControlPlaneTelemetry{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings_ControlPlaneTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings_ControlPlaneTelemetry) ProtoMessage() {}
+
+func (x *Settings_ControlPlaneTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings_ControlPlaneTelemetry.ProtoReflect.Descriptor instead.
+func (*Settings_ControlPlaneTelemetry) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDescGZIP(), []int{0, 16}
+}
+
+func (x *Settings_ControlPlaneTelemetry) GetOtlpEndpoint() string {
+	if x != nil {
+		return x.OtlpEndpoint
+	}
+	return ""
+}
+
+func (x *Settings_ControlPlaneTelemetry) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Settings_ControlPlaneTelemetry) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Settings_ControlPlaneTelemetry) GetTracesEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.TracesEnabled
+	}
+	return nil
+}
+
+func (x *Settings_ControlPlaneTelemetry) GetMetricsEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.MetricsEnabled
+	}
+	return nil
+}
+
+func (x *Settings_ControlPlaneTelemetry) GetSamplingRatio() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.SamplingRatio
+	}
+	return nil
+}
+
+func (x *Settings_ControlPlaneTelemetry) GetMetricExportInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MetricExportInterval
+	}
+	return nil
+}
+
 type Settings_SecretOptions_Source struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Determines where Gloo will read/write secrets from/to.
@@ -2608,7 +2743,7 @@ type Settings_SecretOptions_Source struct {
 
 func (x *Settings_SecretOptions_Source) Reset() {
 	*x = Settings_SecretOptions_Source{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[25]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2620,7 +2755,7 @@ func (x *Settings_SecretOptions_Source) String() string {
 func (*Settings_SecretOptions_Source) ProtoMessage() {}
 
 func (x *Settings_SecretOptions_Source) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[25]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2704,7 +2839,7 @@ type Settings_DiscoveryOptions_UdsOptions struct {
 
 func (x *Settings_DiscoveryOptions_UdsOptions) Reset() {
 	*x = Settings_DiscoveryOptions_UdsOptions{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[26]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2716,7 +2851,7 @@ func (x *Settings_DiscoveryOptions_UdsOptions) String() string {
 func (*Settings_DiscoveryOptions_UdsOptions) ProtoMessage() {}
 
 func (x *Settings_DiscoveryOptions_UdsOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[26]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2754,7 +2889,7 @@ type Settings_DiscoveryOptions_FdsOptions struct {
 
 func (x *Settings_DiscoveryOptions_FdsOptions) Reset() {
 	*x = Settings_DiscoveryOptions_FdsOptions{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[27]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2766,7 +2901,7 @@ func (x *Settings_DiscoveryOptions_FdsOptions) String() string {
 func (*Settings_DiscoveryOptions_FdsOptions) ProtoMessage() {}
 
 func (x *Settings_DiscoveryOptions_FdsOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[27]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2794,7 +2929,7 @@ type Settings_ConsulConfiguration_ServiceDiscoveryOptions struct {
 
 func (x *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Reset() {
 	*x = Settings_ConsulConfiguration_ServiceDiscoveryOptions{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[29]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2806,7 +2941,7 @@ func (x *Settings_ConsulConfiguration_ServiceDiscoveryOptions) String() string {
 func (*Settings_ConsulConfiguration_ServiceDiscoveryOptions) ProtoMessage() {}
 
 func (x *Settings_ConsulConfiguration_ServiceDiscoveryOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[29]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2842,7 +2977,7 @@ type Settings_KubernetesConfiguration_RateLimits struct {
 
 func (x *Settings_KubernetesConfiguration_RateLimits) Reset() {
 	*x = Settings_KubernetesConfiguration_RateLimits{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[30]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2854,7 +2989,7 @@ func (x *Settings_KubernetesConfiguration_RateLimits) String() string {
 func (*Settings_KubernetesConfiguration_RateLimits) ProtoMessage() {}
 
 func (x *Settings_KubernetesConfiguration_RateLimits) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[30]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2916,7 +3051,7 @@ type Settings_ObservabilityOptions_GrafanaIntegration struct {
 
 func (x *Settings_ObservabilityOptions_GrafanaIntegration) Reset() {
 	*x = Settings_ObservabilityOptions_GrafanaIntegration{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[31]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2928,7 +3063,7 @@ func (x *Settings_ObservabilityOptions_GrafanaIntegration) String() string {
 func (*Settings_ObservabilityOptions_GrafanaIntegration) ProtoMessage() {}
 
 func (x *Settings_ObservabilityOptions_GrafanaIntegration) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[31]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2980,7 +3115,7 @@ type Settings_ObservabilityOptions_MetricLabels struct {
 
 func (x *Settings_ObservabilityOptions_MetricLabels) Reset() {
 	*x = Settings_ObservabilityOptions_MetricLabels{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[32]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2992,7 +3127,7 @@ func (x *Settings_ObservabilityOptions_MetricLabels) String() string {
 func (*Settings_ObservabilityOptions_MetricLabels) ProtoMessage() {}
 
 func (x *Settings_ObservabilityOptions_MetricLabels) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[32]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3043,7 +3178,7 @@ type GlooOptions_AWSOptions struct {
 
 func (x *GlooOptions_AWSOptions) Reset() {
 	*x = GlooOptions_AWSOptions{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[37]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3055,7 +3190,7 @@ func (x *GlooOptions_AWSOptions) String() string {
 func (*GlooOptions_AWSOptions) ProtoMessage() {}
 
 func (x *GlooOptions_AWSOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[37]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3138,8 +3273,8 @@ type GlooOptions_AWSOptions_ServiceAccountCredentials struct {
 	// https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
 	//
 	// If the following environment values are not present in the gateway-proxy, this option cannot be used.
-	//  1. AWS_WEB_IDENTITY_TOKEN_FILE
-	//  2. AWS_ROLE_ARN
+	//   1. AWS_WEB_IDENTITY_TOKEN_FILE
+	//   2. AWS_ROLE_ARN
 	//
 	// The role which will be assumed by the credentials will be the one specified by AWS_ROLE_ARN, however, this
 	// can also be overwritten in the AWS Upstream spec via the role_arn field
@@ -3182,7 +3317,7 @@ type GlooOptions_InvalidConfigPolicy struct {
 
 func (x *GlooOptions_InvalidConfigPolicy) Reset() {
 	*x = GlooOptions_InvalidConfigPolicy{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[38]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3194,7 +3329,7 @@ func (x *GlooOptions_InvalidConfigPolicy) String() string {
 func (*GlooOptions_InvalidConfigPolicy) ProtoMessage() {}
 
 func (x *GlooOptions_InvalidConfigPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[38]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3253,7 +3388,7 @@ type GlooOptions_IstioOptions struct {
 
 func (x *GlooOptions_IstioOptions) Reset() {
 	*x = GlooOptions_IstioOptions{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[39]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3265,7 +3400,7 @@ func (x *GlooOptions_IstioOptions) String() string {
 func (*GlooOptions_IstioOptions) ProtoMessage() {}
 
 func (x *GlooOptions_IstioOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[39]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3303,6 +3438,295 @@ func (x *GlooOptions_IstioOptions) GetEnableIntegration() *wrapperspb.BoolValue
 	return nil
 }
 
+// Authentication and authorization of the clients of the xDS and REST xDS servers.
+// Clients identify as the Kubernetes service account they run as, either with a client certificate
+// or with a service account token, and are only served the configuration of the proxies bound to that
+// service account.
+type GlooOptions_XdsAuthOptions struct {
+	state                protoimpl.MessageState                           `protogen:"open.v1"`
+	Mtls                 *GlooOptions_XdsAuthOptions_Mtls                 `protobuf:"bytes,1,opt,name=mtls,proto3" json:"mtls,omitempty"`
+	ServiceAccountTokens *GlooOptions_XdsAuthOptions_ServiceAccountTokens `protobuf:"bytes,2,opt,name=service_account_tokens,json=serviceAccountTokens,proto3" json:"service_account_tokens,omitempty"`
+	// The proxies that clients are allowed to request. Requests of a proxy which is not bound to the service
+	// account of the client are denied, logged and counted in the `xds.gloo.solo.io/requests_denied` metric.
+	ProxyBindings []*GlooOptions_XdsAuthOptions_ProxyBinding `protobuf:"bytes,3,rep,name=proxy_bindings,json=proxyBindings,proto3" json:"proxy_bindings,omitempty"`
+	// Serve the proxies which are not bound to any service account to every authenticated client.
+	// Defaults to false, in which case they are not served to any client.
+	AllowUnboundProxies bool `protobuf:"varint,4,opt,name=allow_unbound_proxies,json=allowUnboundProxies,proto3" json:"allow_unbound_proxies,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GlooOptions_XdsAuthOptions) Reset() {
+	*x = GlooOptions_XdsAuthOptions{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlooOptions_XdsAuthOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlooOptions_XdsAuthOptions) ProtoMessage() {}
+
+func (x *GlooOptions_XdsAuthOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlooOptions_XdsAuthOptions.ProtoReflect.Descriptor instead.
+func (*GlooOptions_XdsAuthOptions) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *GlooOptions_XdsAuthOptions) GetMtls() *GlooOptions_XdsAuthOptions_Mtls {
+	if x != nil {
+		return x.Mtls
+	}
+	return nil
+}
+
+func (x *GlooOptions_XdsAuthOptions) GetServiceAccountTokens() *GlooOptions_XdsAuthOptions_ServiceAccountTokens {
+	if x != nil {
+		return x.ServiceAccountTokens
+	}
+	return nil
+}
+
+func (x *GlooOptions_XdsAuthOptions) GetProxyBindings() []*GlooOptions_XdsAuthOptions_ProxyBinding {
+	if x != nil {
+		return x.ProxyBindings
+	}
+	return nil
+}
+
+func (x *GlooOptions_XdsAuthOptions) GetAllowUnboundProxies() bool {
+	if x != nil {
+		return x.AllowUnboundProxies
+	}
+	return false
+}
+
+// Serve xDS over TLS, and require clients to present a certificate signed by a trusted CA.
+// The service account of a client is read from the SPIFFE ID in the URI SAN of its certificate,
+// which must be of the form `spiffe://<trust-domain>/ns/<namespace>/sa/<name>`.
+// The files are read again when they change, so that certificates can be rotated.
+type GlooOptions_XdsAuthOptions_Mtls struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path to the PEM-encoded certificate that the servers present to clients.
+	CertFile string `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	// Path to the PEM-encoded private key of the certificate.
+	KeyFile string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// Path to the PEM-encoded CA certificates that client certificates are verified with.
+	CaFile        string `protobuf:"bytes,3,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlooOptions_XdsAuthOptions_Mtls) Reset() {
+	*x = GlooOptions_XdsAuthOptions_Mtls{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlooOptions_XdsAuthOptions_Mtls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlooOptions_XdsAuthOptions_Mtls) ProtoMessage() {}
+
+func (x *GlooOptions_XdsAuthOptions_Mtls) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlooOptions_XdsAuthOptions_Mtls.ProtoReflect.Descriptor instead.
+func (*GlooOptions_XdsAuthOptions_Mtls) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDescGZIP(), []int{4, 3, 0}
+}
+
+func (x *GlooOptions_XdsAuthOptions_Mtls) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *GlooOptions_XdsAuthOptions_Mtls) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *GlooOptions_XdsAuthOptions_Mtls) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+// Authenticate clients with Kubernetes service account tokens, such as projected tokens, which they send as
+// bearer tokens in the `authorization` gRPC metadata or HTTP header. Tokens are verified with the TokenReview API,
+// so Gloo must be allowed to create TokenReviews. A token takes precedence over a client certificate.
+type GlooOptions_XdsAuthOptions_ServiceAccountTokens struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The audiences that tokens must be issued for, one of which a token must carry.
+	// Defaults to the audiences of the Kubernetes API server.
+	Audiences     []string `protobuf:"bytes,1,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlooOptions_XdsAuthOptions_ServiceAccountTokens) Reset() {
+	*x = GlooOptions_XdsAuthOptions_ServiceAccountTokens{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlooOptions_XdsAuthOptions_ServiceAccountTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlooOptions_XdsAuthOptions_ServiceAccountTokens) ProtoMessage() {}
+
+func (x *GlooOptions_XdsAuthOptions_ServiceAccountTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlooOptions_XdsAuthOptions_ServiceAccountTokens.ProtoReflect.Descriptor instead.
+func (*GlooOptions_XdsAuthOptions_ServiceAccountTokens) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDescGZIP(), []int{4, 3, 1}
+}
+
+func (x *GlooOptions_XdsAuthOptions_ServiceAccountTokens) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+// Binds a proxy to the service accounts that are allowed to request its configuration.
+type GlooOptions_XdsAuthOptions_ProxyBinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Proxy:
+	//
+	//	*GlooOptions_XdsAuthOptions_ProxyBinding_Role
+	//	*GlooOptions_XdsAuthOptions_ProxyBinding_Gateway
+	Proxy isGlooOptions_XdsAuthOptions_ProxyBinding_Proxy `protobuf_oneof:"proxy"`
+	// The service accounts allowed to request the configuration of the proxy.
+	ServiceAccounts []*core.ResourceRef `protobuf:"bytes,3,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GlooOptions_XdsAuthOptions_ProxyBinding) Reset() {
+	*x = GlooOptions_XdsAuthOptions_ProxyBinding{}
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlooOptions_XdsAuthOptions_ProxyBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlooOptions_XdsAuthOptions_ProxyBinding) ProtoMessage() {}
+
+func (x *GlooOptions_XdsAuthOptions_ProxyBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlooOptions_XdsAuthOptions_ProxyBinding.ProtoReflect.Descriptor instead.
+func (*GlooOptions_XdsAuthOptions_ProxyBinding) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDescGZIP(), []int{4, 3, 2}
+}
+
+func (x *GlooOptions_XdsAuthOptions_ProxyBinding) GetProxy() isGlooOptions_XdsAuthOptions_ProxyBinding_Proxy {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *GlooOptions_XdsAuthOptions_ProxyBinding) GetRole() string {
+	if x != nil {
+		if x, ok := x.Proxy.(*GlooOptions_XdsAuthOptions_ProxyBinding_Role); ok {
+			return x.Role
+		}
+	}
+	return ""
+}
+
+func (x *GlooOptions_XdsAuthOptions_ProxyBinding) GetGateway() *core.ResourceRef {
+	if x != nil {
+		if x, ok := x.Proxy.(*GlooOptions_XdsAuthOptions_ProxyBinding_Gateway); ok {
+			return x.Gateway
+		}
+	}
+	return nil
+}
+
+func (x *GlooOptions_XdsAuthOptions_ProxyBinding) GetServiceAccounts() []*core.ResourceRef {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+type isGlooOptions_XdsAuthOptions_ProxyBinding_Proxy interface {
+	isGlooOptions_XdsAuthOptions_ProxyBinding_Proxy()
+}
+
+type GlooOptions_XdsAuthOptions_ProxyBinding_Role struct {
+	// The role of the proxy, which its Envoys send in the `role` field of their node metadata,
+	// e.g. `gloo-system~gateway-proxy`.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3,oneof"`
+}
+
+type GlooOptions_XdsAuthOptions_ProxyBinding_Gateway struct {
+	// A Kubernetes Gateway, whose proxy has the role `gloo-kube-gateway-api~<namespace>~<namespace>-<name>`.
+	Gateway *core.ResourceRef `protobuf:"bytes,2,opt,name=gateway,proto3,oneof"`
+}
+
+func (*GlooOptions_XdsAuthOptions_ProxyBinding_Role) isGlooOptions_XdsAuthOptions_ProxyBinding_Proxy() {
+}
+
+func (*GlooOptions_XdsAuthOptions_ProxyBinding_Gateway) isGlooOptions_XdsAuthOptions_ProxyBinding_Proxy() {
+}
+
 // options for configuring admission control / validation
 type GatewayOptions_ValidationOptions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3379,7 +3803,7 @@ type GatewayOptions_ValidationOptions struct {
 
 func (x *GatewayOptions_ValidationOptions) Reset() {
 	*x = GatewayOptions_ValidationOptions{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[40]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3391,7 +3815,7 @@ func (x *GatewayOptions_ValidationOptions) String() string {
 func (*GatewayOptions_ValidationOptions) ProtoMessage() {}
 
 func (x *GatewayOptions_ValidationOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[40]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3502,7 +3926,7 @@ type GraphqlOptions_SchemaChangeValidationOptions struct {
 
 func (x *GraphqlOptions_SchemaChangeValidationOptions) Reset() {
 	*x = GraphqlOptions_SchemaChangeValidationOptions{}
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[41]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3514,7 +3938,7 @@ func (x *GraphqlOptions_SchemaChangeValidationOptions) String() string {
 func (*GraphqlOptions_SchemaChangeValidationOptions) ProtoMessage() {}
 
 func (x *GraphqlOptions_SchemaChangeValidationOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[41]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3534,7 +3958,7 @@ var File_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto protoreflec
 
 const file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc = "" +
 	"\n" +
-	";github.com/solo-io/gloo/projects/gloo/api/v1/settings.proto\x12\fgloo.solo.io\x1a\x12extproto/ext.proto\x1a1github.com/solo-io/solo-kit/api/v1/metadata.proto\x1a/github.com/solo-io/solo-kit/api/v1/status.proto\x1a1github.com/solo-io/solo-kit/api/v1/solo-kit.proto\x1a,github.com/solo-io/solo-kit/api/v1/ref.proto\x1a=github.com/solo-io/gloo/projects/gloo/api/v1/extensions.proto\x1aYgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/ratelimit/ratelimit.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/caching/caching.proto\x1aXgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extauth/v1/extauth.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extproc/extproc.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/rbac/rbac.proto\x1aRgithub.com/solo-io/gloo/projects/gloo/api/v1/circuit_breaker/circuit_breaker.proto\x1a:github.com/solo-io/gloo/projects/gloo/api/v1/ssl/ssl.proto\x1aTgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/aws/filter.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/options/consul/query_options.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xcd;\n" +
+	";github.com/solo-io/gloo/projects/gloo/api/v1/settings.proto\x12\fgloo.solo.io\x1a\x12extproto/ext.proto\x1a1github.com/solo-io/solo-kit/api/v1/metadata.proto\x1a/github.com/solo-io/solo-kit/api/v1/status.proto\x1a1github.com/solo-io/solo-kit/api/v1/solo-kit.proto\x1a,github.com/solo-io/solo-kit/api/v1/ref.proto\x1a=github.com/solo-io/gloo/projects/gloo/api/v1/extensions.proto\x1aYgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/ratelimit/ratelimit.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/caching/caching.proto\x1aXgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extauth/v1/extauth.proto\x1aUgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/extproc/extproc.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/rbac/rbac.proto\x1aRgithub.com/solo-io/gloo/projects/gloo/api/v1/circuit_breaker/circuit_breaker.proto\x1a:github.com/solo-io/gloo/projects/gloo/api/v1/ssl/ssl.proto\x1aTgithub.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/aws/filter.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/options/consul/query_options.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xef@\n" +
 	"\bSettings\x12/\n" +
 	"\x13discovery_namespace\x18\x01 \x01(\tR\x12discoveryNamespace\x12)\n" +
 	"\x10watch_namespaces\x18\x02 \x03(\tR\x0fwatchNamespaces\x12a\n" +
@@ -3580,7 +4004,8 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc =
 	"\rext_proc_late\x18) \x01(\v2&.extproc.options.gloo.solo.io.SettingsR\vextProcLate\x12W\n" +
 	"\x19watch_namespace_selectors\x18( \x03(\v2\x1b.gloo.solo.io.LabelSelectorR\x17watchNamespaceSelectors\x12\x1c\n" +
 	"\n" +
-	"ip_v4_only\x18+ \x01(\bR\bipV4Only\x1a\xb6\x02\n" +
+	"ip_v4_only\x18+ \x01(\bR\bipV4Only\x12d\n" +
+	"\x17control_plane_telemetry\x18, \x01(\v2,.gloo.solo.io.Settings.ControlPlaneTelemetryR\x15controlPlaneTelemetry\x1a\xb6\x02\n" +
 	"\rSecretOptions\x12E\n" +
 	"\asources\x18\x01 \x03(\v2+.gloo.solo.io.Settings.SecretOptions.SourceR\asources\x1a\xdd\x01\n" +
 	"\x06Source\x12J\n" +
@@ -3681,7 +4106,7 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc =
 	"dnsAddress\x12K\n" +
 	"\x14dns_polling_interval\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\x12dnsPollingInterval\x1a<\n" +
 	"\x17ServiceDiscoveryOptions\x12!\n" +
-	"\fdata_centers\x18\x01 \x03(\tR\vdataCenters\x1a\xfe\x03\n" +
+	"\fdata_centers\x18\x01 \x03(\tR\vdataCenters\x1a\xb0\x04\n" +
 	"$ConsulUpstreamDiscoveryConfiguration\x12$\n" +
 	"\ruseTlsTagging\x18\x10 \x01(\bR\ruseTlsTagging\x12\x1e\n" +
 	"\n" +
@@ -3692,7 +4117,8 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc =
 	"\x0fconsistencyMode\x18\x14 \x01(\x0e23.consul.options.gloo.solo.io.ConsulConsistencyModesR\x0fconsistencyMode\x12N\n" +
 	"\rquery_options\x18\x15 \x01(\v2).consul.options.gloo.solo.io.QueryOptionsR\fqueryOptions\x124\n" +
 	"\x16service_tags_allowlist\x18\x16 \x03(\tR\x14serviceTagsAllowlist\x12L\n" +
-	"\x14eds_blocking_queries\x18\x17 \x01(\v2\x1a.google.protobuf.BoolValueR\x12edsBlockingQueries\x1a\xab\x01\n" +
+	"\x14eds_blocking_queries\x18\x17 \x01(\v2\x1a.google.protobuf.BoolValueR\x12edsBlockingQueries\x120\n" +
+	"\x14connect_service_name\x18\x18 \x01(\tR\x12connectServiceName\x1a\xab\x01\n" +
 	"\x17KubernetesConfiguration\x12Z\n" +
 	"\vrate_limits\x18\x01 \x01(\v29.gloo.solo.io.Settings.KubernetesConfiguration.RateLimitsR\n" +
 	"rateLimits\x1a4\n" +
@@ -3717,7 +4143,18 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc =
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x85\x01\n" +
 	"\x1dConfigStatusMetricLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12N\n" +
-	"\x05value\x18\x02 \x01(\v28.gloo.solo.io.Settings.ObservabilityOptions.MetricLabelsR\x05value:\x028\x01:\x12\x82\xf1\x04\x0e\n" +
+	"\x05value\x18\x02 \x01(\v28.gloo.solo.io.Settings.ObservabilityOptions.MetricLabelsR\x05value:\x028\x01\x1a\x87\x04\n" +
+	"\x15ControlPlaneTelemetry\x12#\n" +
+	"\rotlp_endpoint\x18\x01 \x01(\tR\fotlpEndpoint\x12\x1a\n" +
+	"\binsecure\x18\x02 \x01(\bR\binsecure\x12S\n" +
+	"\aheaders\x18\x03 \x03(\v29.gloo.solo.io.Settings.ControlPlaneTelemetry.HeadersEntryR\aheaders\x12A\n" +
+	"\x0etraces_enabled\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\rtracesEnabled\x12C\n" +
+	"\x0fmetrics_enabled\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x0emetricsEnabled\x12C\n" +
+	"\x0esampling_ratio\x18\x06 \x01(\v2\x1c.google.protobuf.DoubleValueR\rsamplingRatio\x12O\n" +
+	"\x16metric_export_interval\x18\a \x01(\v2\x19.google.protobuf.DurationR\x14metricExportInterval\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:\x12\x82\xf1\x04\x0e\n" +
 	"\x02st\x12\bsettingsB\x0f\n" +
 	"\rconfig_sourceB\x0f\n" +
 	"\rsecret_sourceB\x11\n" +
@@ -3737,7 +4174,7 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc =
 	"\x12global_annotations\x18\x02 \x03(\v24.gloo.solo.io.UpstreamOptions.GlobalAnnotationsEntryR\x11globalAnnotations\x1aD\n" +
 	"\x16GlobalAnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd3\x13\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc0\x19\n" +
 	"\vGlooOptions\x12\"\n" +
 	"\rxds_bind_addr\x18\x01 \x01(\tR\vxdsBindAddr\x120\n" +
 	"\x14validation_bind_addr\x18\x02 \x01(\tR\x12validationBindAddr\x12M\n" +
@@ -3759,7 +4196,8 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc =
 	"(log_transformation_request_response_info\x18\x10 \x01(\v2\x1a.google.protobuf.BoolValueR$logTransformationRequestResponseInfo\x12d\n" +
 	" transformation_escape_characters\x18\x11 \x01(\v2\x1a.google.protobuf.BoolValueR\x1etransformationEscapeCharacters\x12K\n" +
 	"\ristio_options\x18\x12 \x01(\v2&.gloo.solo.io.GlooOptions.IstioOptionsR\fistioOptions\x12\x82\x01\n" +
-	"0enable_auto_websocket_transformation_passthrough\x18\x13 \x01(\v2\x1a.google.protobuf.BoolValueR,enableAutoWebsocketTransformationPassthrough\x1a\x83\x04\n" +
+	"0enable_auto_websocket_transformation_passthrough\x18\x13 \x01(\v2\x1a.google.protobuf.BoolValueR,enableAutoWebsocketTransformationPassthrough\x12R\n" +
+	"\x10xds_auth_options\x18\x14 \x01(\v2(.gloo.solo.io.GlooOptions.XdsAuthOptionsR\x0exdsAuthOptions\x1a\x83\x04\n" +
 	"\n" +
 	"AWSOptions\x12@\n" +
 	"\x1benable_credentials_discovey\x18\x01 \x01(\bH\x00R\x19enableCredentialsDiscovey\x12\x93\x01\n" +
@@ -3775,7 +4213,23 @@ const file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc =
 	"\fIstioOptions\x12U\n" +
 	"\x17append_x_forwarded_host\x18\x01 \x01(\v2\x1a.google.protobuf.BoolValueB\x02\x18\x01R\x14appendXForwardedHost\x12D\n" +
 	"\x10enable_auto_mtls\x18\x02 \x01(\v2\x1a.google.protobuf.BoolValueR\x0eenableAutoMtls\x12I\n" +
-	"\x12enable_integration\x18\x03 \x01(\v2\x1a.google.protobuf.BoolValueR\x11enableIntegration\"S\n" +
+	"\x12enable_integration\x18\x03 \x01(\v2\x1a.google.protobuf.BoolValueR\x11enableIntegration\x1a\x96\x05\n" +
+	"\x0eXdsAuthOptions\x12A\n" +
+	"\x04mtls\x18\x01 \x01(\v2-.gloo.solo.io.GlooOptions.XdsAuthOptions.MtlsR\x04mtls\x12s\n" +
+	"\x16service_account_tokens\x18\x02 \x01(\v2=.gloo.solo.io.GlooOptions.XdsAuthOptions.ServiceAccountTokensR\x14serviceAccountTokens\x12\\\n" +
+	"\x0eproxy_bindings\x18\x03 \x03(\v25.gloo.solo.io.GlooOptions.XdsAuthOptions.ProxyBindingR\rproxyBindings\x122\n" +
+	"\x15allow_unbound_proxies\x18\x04 \x01(\bR\x13allowUnboundProxies\x1aW\n" +
+	"\x04Mtls\x12\x1b\n" +
+	"\tcert_file\x18\x01 \x01(\tR\bcertFile\x12\x19\n" +
+	"\bkey_file\x18\x02 \x01(\tR\akeyFile\x12\x17\n" +
+	"\aca_file\x18\x03 \x01(\tR\x06caFile\x1a4\n" +
+	"\x14ServiceAccountTokens\x12\x1c\n" +
+	"\taudiences\x18\x01 \x03(\tR\taudiences\x1a\xaa\x01\n" +
+	"\fProxyBinding\x12\x14\n" +
+	"\x04role\x18\x01 \x01(\tH\x00R\x04role\x125\n" +
+	"\agateway\x18\x02 \x01(\v2\x19.core.solo.io.ResourceRefH\x00R\agateway\x12D\n" +
+	"\x10service_accounts\x18\x03 \x03(\v2\x19.core.solo.io.ResourceRefR\x0fserviceAccountsB\a\n" +
+	"\x05proxy\"S\n" +
 	"\x15VirtualServiceOptions\x12:\n" +
 	"\vone_way_tls\x18\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\toneWayTls\"\xdb\r\n" +
 	"\x0eGatewayOptions\x124\n" +
@@ -3823,7 +4277,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDescGZI
 }
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_goTypes = []any{
 	(Settings_DiscoveryOptions_FdsMode)(0),                // 0: gloo.solo.io.Settings.DiscoveryOptions.FdsMode
 	(*Settings)(nil),                                      // 1: gloo.solo.io.Settings
@@ -3851,42 +4305,49 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_goTypes = [
 	(*Settings_KubernetesConfiguration)(nil),              // 23: gloo.solo.io.Settings.KubernetesConfiguration
 	nil,                                                   // 24: gloo.solo.io.Settings.NamedExtauthEntry
 	(*Settings_ObservabilityOptions)(nil),                 // 25: gloo.solo.io.Settings.ObservabilityOptions
-	(*Settings_SecretOptions_Source)(nil),                 // 26: gloo.solo.io.Settings.SecretOptions.Source
-	(*Settings_DiscoveryOptions_UdsOptions)(nil),          // 27: gloo.solo.io.Settings.DiscoveryOptions.UdsOptions
-	(*Settings_DiscoveryOptions_FdsOptions)(nil),          // 28: gloo.solo.io.Settings.DiscoveryOptions.FdsOptions
-	nil, // 29: gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.WatchLabelsEntry
-	(*Settings_ConsulConfiguration_ServiceDiscoveryOptions)(nil), // 30: gloo.solo.io.Settings.ConsulConfiguration.ServiceDiscoveryOptions
-	(*Settings_KubernetesConfiguration_RateLimits)(nil),          // 31: gloo.solo.io.Settings.KubernetesConfiguration.RateLimits
-	(*Settings_ObservabilityOptions_GrafanaIntegration)(nil),     // 32: gloo.solo.io.Settings.ObservabilityOptions.GrafanaIntegration
-	(*Settings_ObservabilityOptions_MetricLabels)(nil),           // 33: gloo.solo.io.Settings.ObservabilityOptions.MetricLabels
-	nil,                                      // 34: gloo.solo.io.Settings.ObservabilityOptions.ConfigStatusMetricLabelsEntry
-	nil,                                      // 35: gloo.solo.io.Settings.ObservabilityOptions.MetricLabels.LabelToPathEntry
-	nil,                                      // 36: gloo.solo.io.LabelSelector.MatchLabelsEntry
-	nil,                                      // 37: gloo.solo.io.UpstreamOptions.GlobalAnnotationsEntry
-	(*GlooOptions_AWSOptions)(nil),           // 38: gloo.solo.io.GlooOptions.AWSOptions
-	(*GlooOptions_InvalidConfigPolicy)(nil),  // 39: gloo.solo.io.GlooOptions.InvalidConfigPolicy
-	(*GlooOptions_IstioOptions)(nil),         // 40: gloo.solo.io.GlooOptions.IstioOptions
-	(*GatewayOptions_ValidationOptions)(nil), // 41: gloo.solo.io.GatewayOptions.ValidationOptions
-	(*GraphqlOptions_SchemaChangeValidationOptions)(nil),  // 42: gloo.solo.io.GraphqlOptions.SchemaChangeValidationOptions
-	(*durationpb.Duration)(nil),                           // 43: google.protobuf.Duration
-	(*Extensions)(nil),                                    // 44: gloo.solo.io.Extensions
-	(*ratelimit.ServiceSettings)(nil),                     // 45: ratelimit.options.gloo.solo.io.ServiceSettings
-	(*ratelimit.Settings)(nil),                            // 46: ratelimit.options.gloo.solo.io.Settings
-	(*rbac.Settings)(nil),                                 // 47: rbac.options.gloo.solo.io.Settings
-	(*v1.Settings)(nil),                                   // 48: enterprise.gloo.solo.io.Settings
-	(*caching.Settings)(nil),                              // 49: caching.options.gloo.solo.io.Settings
-	(*core.Metadata)(nil),                                 // 50: core.solo.io.Metadata
-	(*core.NamespacedStatuses)(nil),                       // 51: core.solo.io.NamespacedStatuses
-	(*extproc.Settings)(nil),                              // 52: extproc.options.gloo.solo.io.Settings
-	(*ssl.SslParameters)(nil),                             // 53: gloo.solo.io.SslParameters
-	(*circuit_breaker.CircuitBreakerConfig)(nil),          // 54: gloo.solo.io.CircuitBreakerConfig
-	(*wrapperspb.BoolValue)(nil),                          // 55: google.protobuf.BoolValue
-	(*wrapperspb.UInt32Value)(nil),                        // 56: google.protobuf.UInt32Value
-	(*core.ResourceRef)(nil),                              // 57: core.solo.io.ResourceRef
-	(consul.ConsulConsistencyModes)(0),                    // 58: consul.options.gloo.solo.io.ConsulConsistencyModes
-	(*consul.QueryOptions)(nil),                           // 59: consul.options.gloo.solo.io.QueryOptions
-	(*aws.AWSLambdaConfig_ServiceAccountCredentials)(nil), // 60: envoy.config.filter.http.aws_lambda.v2.AWSLambdaConfig.ServiceAccountCredentials
-	(*wrapperspb.Int32Value)(nil),                         // 61: google.protobuf.Int32Value
+	(*Settings_ControlPlaneTelemetry)(nil),                // 26: gloo.solo.io.Settings.ControlPlaneTelemetry
+	(*Settings_SecretOptions_Source)(nil),                 // 27: gloo.solo.io.Settings.SecretOptions.Source
+	(*Settings_DiscoveryOptions_UdsOptions)(nil),          // 28: gloo.solo.io.Settings.DiscoveryOptions.UdsOptions
+	(*Settings_DiscoveryOptions_FdsOptions)(nil),          // 29: gloo.solo.io.Settings.DiscoveryOptions.FdsOptions
+	nil, // 30: gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.WatchLabelsEntry
+	(*Settings_ConsulConfiguration_ServiceDiscoveryOptions)(nil), // 31: gloo.solo.io.Settings.ConsulConfiguration.ServiceDiscoveryOptions
+	(*Settings_KubernetesConfiguration_RateLimits)(nil),          // 32: gloo.solo.io.Settings.KubernetesConfiguration.RateLimits
+	(*Settings_ObservabilityOptions_GrafanaIntegration)(nil),     // 33: gloo.solo.io.Settings.ObservabilityOptions.GrafanaIntegration
+	(*Settings_ObservabilityOptions_MetricLabels)(nil),           // 34: gloo.solo.io.Settings.ObservabilityOptions.MetricLabels
+	nil,                                     // 35: gloo.solo.io.Settings.ObservabilityOptions.ConfigStatusMetricLabelsEntry
+	nil,                                     // 36: gloo.solo.io.Settings.ObservabilityOptions.MetricLabels.LabelToPathEntry
+	nil,                                     // 37: gloo.solo.io.Settings.ControlPlaneTelemetry.HeadersEntry
+	nil,                                     // 38: gloo.solo.io.LabelSelector.MatchLabelsEntry
+	nil,                                     // 39: gloo.solo.io.UpstreamOptions.GlobalAnnotationsEntry
+	(*GlooOptions_AWSOptions)(nil),          // 40: gloo.solo.io.GlooOptions.AWSOptions
+	(*GlooOptions_InvalidConfigPolicy)(nil), // 41: gloo.solo.io.GlooOptions.InvalidConfigPolicy
+	(*GlooOptions_IstioOptions)(nil),        // 42: gloo.solo.io.GlooOptions.IstioOptions
+	(*GlooOptions_XdsAuthOptions)(nil),      // 43: gloo.solo.io.GlooOptions.XdsAuthOptions
+	(*GlooOptions_XdsAuthOptions_Mtls)(nil), // 44: gloo.solo.io.GlooOptions.XdsAuthOptions.Mtls
+	(*GlooOptions_XdsAuthOptions_ServiceAccountTokens)(nil), // 45: gloo.solo.io.GlooOptions.XdsAuthOptions.ServiceAccountTokens
+	(*GlooOptions_XdsAuthOptions_ProxyBinding)(nil),         // 46: gloo.solo.io.GlooOptions.XdsAuthOptions.ProxyBinding
+	(*GatewayOptions_ValidationOptions)(nil),                // 47: gloo.solo.io.GatewayOptions.ValidationOptions
+	(*GraphqlOptions_SchemaChangeValidationOptions)(nil),    // 48: gloo.solo.io.GraphqlOptions.SchemaChangeValidationOptions
+	(*durationpb.Duration)(nil),                             // 49: google.protobuf.Duration
+	(*Extensions)(nil),                                      // 50: gloo.solo.io.Extensions
+	(*ratelimit.ServiceSettings)(nil),                       // 51: ratelimit.options.gloo.solo.io.ServiceSettings
+	(*ratelimit.Settings)(nil),                              // 52: ratelimit.options.gloo.solo.io.Settings
+	(*rbac.Settings)(nil),                                   // 53: rbac.options.gloo.solo.io.Settings
+	(*v1.Settings)(nil),                                     // 54: enterprise.gloo.solo.io.Settings
+	(*caching.Settings)(nil),                                // 55: caching.options.gloo.solo.io.Settings
+	(*core.Metadata)(nil),                                   // 56: core.solo.io.Metadata
+	(*core.NamespacedStatuses)(nil),                         // 57: core.solo.io.NamespacedStatuses
+	(*extproc.Settings)(nil),                                // 58: extproc.options.gloo.solo.io.Settings
+	(*ssl.SslParameters)(nil),                               // 59: gloo.solo.io.SslParameters
+	(*circuit_breaker.CircuitBreakerConfig)(nil),            // 60: gloo.solo.io.CircuitBreakerConfig
+	(*wrapperspb.BoolValue)(nil),                            // 61: google.protobuf.BoolValue
+	(*wrapperspb.UInt32Value)(nil),                          // 62: google.protobuf.UInt32Value
+	(*core.ResourceRef)(nil),                                // 63: core.solo.io.ResourceRef
+	(consul.ConsulConsistencyModes)(0),                      // 64: consul.options.gloo.solo.io.ConsulConsistencyModes
+	(*consul.QueryOptions)(nil),                             // 65: consul.options.gloo.solo.io.QueryOptions
+	(*wrapperspb.DoubleValue)(nil),                          // 66: google.protobuf.DoubleValue
+	(*aws.AWSLambdaConfig_ServiceAccountCredentials)(nil),   // 67: envoy.config.filter.http.aws_lambda.v2.AWSLambdaConfig.ServiceAccountCredentials
+	(*wrapperspb.Int32Value)(nil),                           // 68: google.protobuf.Int32Value
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_depIdxs = []int32{
 	11,  // 0: gloo.solo.io.Settings.kubernetes_config_source:type_name -> gloo.solo.io.Settings.KubernetesCrds
@@ -3899,7 +4360,7 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_depIdxs = [
 	17,  // 7: gloo.solo.io.Settings.kubernetes_artifact_source:type_name -> gloo.solo.io.Settings.KubernetesConfigmaps
 	18,  // 8: gloo.solo.io.Settings.directory_artifact_source:type_name -> gloo.solo.io.Settings.Directory
 	16,  // 9: gloo.solo.io.Settings.consul_kv_artifact_source:type_name -> gloo.solo.io.Settings.ConsulKv
-	43,  // 10: gloo.solo.io.Settings.refresh_rate:type_name -> google.protobuf.Duration
+	49,  // 10: gloo.solo.io.Settings.refresh_rate:type_name -> google.protobuf.Duration
 	19,  // 11: gloo.solo.io.Settings.knative:type_name -> gloo.solo.io.Settings.KnativeOptions
 	20,  // 12: gloo.solo.io.Settings.discovery:type_name -> gloo.solo.io.Settings.DiscoveryOptions
 	5,   // 13: gloo.solo.io.Settings.gloo:type_name -> gloo.solo.io.GlooOptions
@@ -3907,95 +4368,107 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_depIdxs = [
 	21,  // 15: gloo.solo.io.Settings.consul:type_name -> gloo.solo.io.Settings.ConsulConfiguration
 	22,  // 16: gloo.solo.io.Settings.consulDiscovery:type_name -> gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration
 	23,  // 17: gloo.solo.io.Settings.kubernetes:type_name -> gloo.solo.io.Settings.KubernetesConfiguration
-	44,  // 18: gloo.solo.io.Settings.extensions:type_name -> gloo.solo.io.Extensions
-	45,  // 19: gloo.solo.io.Settings.ratelimit:type_name -> ratelimit.options.gloo.solo.io.ServiceSettings
-	46,  // 20: gloo.solo.io.Settings.ratelimit_server:type_name -> ratelimit.options.gloo.solo.io.Settings
-	47,  // 21: gloo.solo.io.Settings.rbac:type_name -> rbac.options.gloo.solo.io.Settings
-	48,  // 22: gloo.solo.io.Settings.extauth:type_name -> enterprise.gloo.solo.io.Settings
+	50,  // 18: gloo.solo.io.Settings.extensions:type_name -> gloo.solo.io.Extensions
+	51,  // 19: gloo.solo.io.Settings.ratelimit:type_name -> ratelimit.options.gloo.solo.io.ServiceSettings
+	52,  // 20: gloo.solo.io.Settings.ratelimit_server:type_name -> ratelimit.options.gloo.solo.io.Settings
+	53,  // 21: gloo.solo.io.Settings.rbac:type_name -> rbac.options.gloo.solo.io.Settings
+	54,  // 22: gloo.solo.io.Settings.extauth:type_name -> enterprise.gloo.solo.io.Settings
 	24,  // 23: gloo.solo.io.Settings.named_extauth:type_name -> gloo.solo.io.Settings.NamedExtauthEntry
-	49,  // 24: gloo.solo.io.Settings.caching_server:type_name -> caching.options.gloo.solo.io.Settings
-	50,  // 25: gloo.solo.io.Settings.metadata:type_name -> core.solo.io.Metadata
-	51,  // 26: gloo.solo.io.Settings.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
+	55,  // 24: gloo.solo.io.Settings.caching_server:type_name -> caching.options.gloo.solo.io.Settings
+	56,  // 25: gloo.solo.io.Settings.metadata:type_name -> core.solo.io.Metadata
+	57,  // 26: gloo.solo.io.Settings.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
 	25,  // 27: gloo.solo.io.Settings.observabilityOptions:type_name -> gloo.solo.io.Settings.ObservabilityOptions
 	4,   // 28: gloo.solo.io.Settings.upstreamOptions:type_name -> gloo.solo.io.UpstreamOptions
 	8,   // 29: gloo.solo.io.Settings.console_options:type_name -> gloo.solo.io.ConsoleOptions
-	52,  // 30: gloo.solo.io.Settings.ext_proc_early:type_name -> extproc.options.gloo.solo.io.Settings
-	52,  // 31: gloo.solo.io.Settings.ext_proc:type_name -> extproc.options.gloo.solo.io.Settings
-	52,  // 32: gloo.solo.io.Settings.ext_proc_late:type_name -> extproc.options.gloo.solo.io.Settings
+	58,  // 30: gloo.solo.io.Settings.ext_proc_early:type_name -> extproc.options.gloo.solo.io.Settings
+	58,  // 31: gloo.solo.io.Settings.ext_proc:type_name -> extproc.options.gloo.solo.io.Settings
+	58,  // 32: gloo.solo.io.Settings.ext_proc_late:type_name -> extproc.options.gloo.solo.io.Settings
 	2,   // 33: gloo.solo.io.Settings.watch_namespace_selectors:type_name -> gloo.solo.io.LabelSelector
-	36,  // 34: gloo.solo.io.LabelSelector.match_labels:type_name -> gloo.solo.io.LabelSelector.MatchLabelsEntry
-	3,   // 35: gloo.solo.io.LabelSelector.match_expressions:type_name -> gloo.solo.io.LabelSelectorRequirement
-	53,  // 36: gloo.solo.io.UpstreamOptions.ssl_parameters:type_name -> gloo.solo.io.SslParameters
-	37,  // 37: gloo.solo.io.UpstreamOptions.global_annotations:type_name -> gloo.solo.io.UpstreamOptions.GlobalAnnotationsEntry
-	54,  // 38: gloo.solo.io.GlooOptions.circuit_breakers:type_name -> gloo.solo.io.CircuitBreakerConfig
-	43,  // 39: gloo.solo.io.GlooOptions.endpoints_warming_timeout:type_name -> google.protobuf.Duration
-	38,  // 40: gloo.solo.io.GlooOptions.aws_options:type_name -> gloo.solo.io.GlooOptions.AWSOptions
-	39,  // 41: gloo.solo.io.GlooOptions.invalid_config_policy:type_name -> gloo.solo.io.GlooOptions.InvalidConfigPolicy
-	55,  // 42: gloo.solo.io.GlooOptions.disable_grpc_web:type_name -> google.protobuf.BoolValue
-	55,  // 43: gloo.solo.io.GlooOptions.disable_proxy_garbage_collection:type_name -> google.protobuf.BoolValue
-	56,  // 44: gloo.solo.io.GlooOptions.regex_max_program_size:type_name -> google.protobuf.UInt32Value
-	55,  // 45: gloo.solo.io.GlooOptions.enable_rest_eds:type_name -> google.protobuf.BoolValue
-	43,  // 46: gloo.solo.io.GlooOptions.failover_upstream_dns_polling_interval:type_name -> google.protobuf.Duration
-	55,  // 47: gloo.solo.io.GlooOptions.remove_unused_filters:type_name -> google.protobuf.BoolValue
-	55,  // 48: gloo.solo.io.GlooOptions.log_transformation_request_response_info:type_name -> google.protobuf.BoolValue
-	55,  // 49: gloo.solo.io.GlooOptions.transformation_escape_characters:type_name -> google.protobuf.BoolValue
-	40,  // 50: gloo.solo.io.GlooOptions.istio_options:type_name -> gloo.solo.io.GlooOptions.IstioOptions
-	55,  // 51: gloo.solo.io.GlooOptions.enable_auto_websocket_transformation_passthrough:type_name -> google.protobuf.BoolValue
-	55,  // 52: gloo.solo.io.VirtualServiceOptions.one_way_tls:type_name -> google.protobuf.BoolValue
-	41,  // 53: gloo.solo.io.GatewayOptions.validation:type_name -> gloo.solo.io.GatewayOptions.ValidationOptions
-	6,   // 54: gloo.solo.io.GatewayOptions.virtual_service_options:type_name -> gloo.solo.io.VirtualServiceOptions
-	55,  // 55: gloo.solo.io.GatewayOptions.persist_proxy_spec:type_name -> google.protobuf.BoolValue
-	55,  // 56: gloo.solo.io.GatewayOptions.enable_gateway_controller:type_name -> google.protobuf.BoolValue
-	55,  // 57: gloo.solo.io.GatewayOptions.isolate_virtual_hosts_by_ssl_config:type_name -> google.protobuf.BoolValue
-	55,  // 58: gloo.solo.io.GatewayOptions.translate_empty_gateways:type_name -> google.protobuf.BoolValue
-	26,  // 59: gloo.solo.io.Settings.SecretOptions.sources:type_name -> gloo.solo.io.Settings.SecretOptions.Source
-	55,  // 60: gloo.solo.io.Settings.VaultSecrets.insecure:type_name -> google.protobuf.BoolValue
-	15,  // 61: gloo.solo.io.Settings.VaultSecrets.tls_config:type_name -> gloo.solo.io.Settings.VaultTlsConfig
-	14,  // 62: gloo.solo.io.Settings.VaultSecrets.aws:type_name -> gloo.solo.io.Settings.VaultAwsAuth
-	55,  // 63: gloo.solo.io.Settings.VaultTlsConfig.insecure:type_name -> google.protobuf.BoolValue
-	0,   // 64: gloo.solo.io.Settings.DiscoveryOptions.fds_mode:type_name -> gloo.solo.io.Settings.DiscoveryOptions.FdsMode
-	27,  // 65: gloo.solo.io.Settings.DiscoveryOptions.uds_options:type_name -> gloo.solo.io.Settings.DiscoveryOptions.UdsOptions
-	28,  // 66: gloo.solo.io.Settings.DiscoveryOptions.fds_options:type_name -> gloo.solo.io.Settings.DiscoveryOptions.FdsOptions
-	55,  // 67: gloo.solo.io.Settings.ConsulConfiguration.insecure_skip_verify:type_name -> google.protobuf.BoolValue
-	43,  // 68: gloo.solo.io.Settings.ConsulConfiguration.wait_time:type_name -> google.protobuf.Duration
-	30,  // 69: gloo.solo.io.Settings.ConsulConfiguration.service_discovery:type_name -> gloo.solo.io.Settings.ConsulConfiguration.ServiceDiscoveryOptions
-	43,  // 70: gloo.solo.io.Settings.ConsulConfiguration.dns_polling_interval:type_name -> google.protobuf.Duration
-	57,  // 71: gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration.rootCa:type_name -> core.solo.io.ResourceRef
-	58,  // 72: gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration.consistencyMode:type_name -> consul.options.gloo.solo.io.ConsulConsistencyModes
-	59,  // 73: gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration.query_options:type_name -> consul.options.gloo.solo.io.QueryOptions
-	55,  // 74: gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration.eds_blocking_queries:type_name -> google.protobuf.BoolValue
-	31,  // 75: gloo.solo.io.Settings.KubernetesConfiguration.rate_limits:type_name -> gloo.solo.io.Settings.KubernetesConfiguration.RateLimits
-	48,  // 76: gloo.solo.io.Settings.NamedExtauthEntry.value:type_name -> enterprise.gloo.solo.io.Settings
-	32,  // 77: gloo.solo.io.Settings.ObservabilityOptions.grafanaIntegration:type_name -> gloo.solo.io.Settings.ObservabilityOptions.GrafanaIntegration
-	34,  // 78: gloo.solo.io.Settings.ObservabilityOptions.configStatusMetricLabels:type_name -> gloo.solo.io.Settings.ObservabilityOptions.ConfigStatusMetricLabelsEntry
-	12,  // 79: gloo.solo.io.Settings.SecretOptions.Source.kubernetes:type_name -> gloo.solo.io.Settings.KubernetesSecrets
-	13,  // 80: gloo.solo.io.Settings.SecretOptions.Source.vault:type_name -> gloo.solo.io.Settings.VaultSecrets
-	18,  // 81: gloo.solo.io.Settings.SecretOptions.Source.directory:type_name -> gloo.solo.io.Settings.Directory
-	55,  // 82: gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.enabled:type_name -> google.protobuf.BoolValue
-	29,  // 83: gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.watch_labels:type_name -> gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.WatchLabelsEntry
-	56,  // 84: gloo.solo.io.Settings.ObservabilityOptions.GrafanaIntegration.default_dashboard_folder_id:type_name -> google.protobuf.UInt32Value
-	35,  // 85: gloo.solo.io.Settings.ObservabilityOptions.MetricLabels.labelToPath:type_name -> gloo.solo.io.Settings.ObservabilityOptions.MetricLabels.LabelToPathEntry
-	33,  // 86: gloo.solo.io.Settings.ObservabilityOptions.ConfigStatusMetricLabelsEntry.value:type_name -> gloo.solo.io.Settings.ObservabilityOptions.MetricLabels
-	60,  // 87: gloo.solo.io.GlooOptions.AWSOptions.service_account_credentials:type_name -> envoy.config.filter.http.aws_lambda.v2.AWSLambdaConfig.ServiceAccountCredentials
-	55,  // 88: gloo.solo.io.GlooOptions.AWSOptions.propagate_original_routing:type_name -> google.protobuf.BoolValue
-	43,  // 89: gloo.solo.io.GlooOptions.AWSOptions.credential_refresh_delay:type_name -> google.protobuf.Duration
-	55,  // 90: gloo.solo.io.GlooOptions.AWSOptions.fallback_to_first_function:type_name -> google.protobuf.BoolValue
-	55,  // 91: gloo.solo.io.GlooOptions.IstioOptions.append_x_forwarded_host:type_name -> google.protobuf.BoolValue
-	55,  // 92: gloo.solo.io.GlooOptions.IstioOptions.enable_auto_mtls:type_name -> google.protobuf.BoolValue
-	55,  // 93: gloo.solo.io.GlooOptions.IstioOptions.enable_integration:type_name -> google.protobuf.BoolValue
-	55,  // 94: gloo.solo.io.GatewayOptions.ValidationOptions.always_accept:type_name -> google.protobuf.BoolValue
-	55,  // 95: gloo.solo.io.GatewayOptions.ValidationOptions.allow_warnings:type_name -> google.protobuf.BoolValue
-	55,  // 96: gloo.solo.io.GatewayOptions.ValidationOptions.warn_route_short_circuiting:type_name -> google.protobuf.BoolValue
-	55,  // 97: gloo.solo.io.GatewayOptions.ValidationOptions.disable_transformation_validation:type_name -> google.protobuf.BoolValue
-	61,  // 98: gloo.solo.io.GatewayOptions.ValidationOptions.validation_server_grpc_max_size_bytes:type_name -> google.protobuf.Int32Value
-	55,  // 99: gloo.solo.io.GatewayOptions.ValidationOptions.server_enabled:type_name -> google.protobuf.BoolValue
-	55,  // 100: gloo.solo.io.GatewayOptions.ValidationOptions.warn_missing_tls_secret:type_name -> google.protobuf.BoolValue
-	55,  // 101: gloo.solo.io.GatewayOptions.ValidationOptions.full_envoy_validation:type_name -> google.protobuf.BoolValue
-	102, // [102:102] is the sub-list for method output_type
-	102, // [102:102] is the sub-list for method input_type
-	102, // [102:102] is the sub-list for extension type_name
-	102, // [102:102] is the sub-list for extension extendee
-	0,   // [0:102] is the sub-list for field type_name
+	26,  // 34: gloo.solo.io.Settings.control_plane_telemetry:type_name -> gloo.solo.io.Settings.ControlPlaneTelemetry
+	38,  // 35: gloo.solo.io.LabelSelector.match_labels:type_name -> gloo.solo.io.LabelSelector.MatchLabelsEntry
+	3,   // 36: gloo.solo.io.LabelSelector.match_expressions:type_name -> gloo.solo.io.LabelSelectorRequirement
+	59,  // 37: gloo.solo.io.UpstreamOptions.ssl_parameters:type_name -> gloo.solo.io.SslParameters
+	39,  // 38: gloo.solo.io.UpstreamOptions.global_annotations:type_name -> gloo.solo.io.UpstreamOptions.GlobalAnnotationsEntry
+	60,  // 39: gloo.solo.io.GlooOptions.circuit_breakers:type_name -> gloo.solo.io.CircuitBreakerConfig
+	49,  // 40: gloo.solo.io.GlooOptions.endpoints_warming_timeout:type_name -> google.protobuf.Duration
+	40,  // 41: gloo.solo.io.GlooOptions.aws_options:type_name -> gloo.solo.io.GlooOptions.AWSOptions
+	41,  // 42: gloo.solo.io.GlooOptions.invalid_config_policy:type_name -> gloo.solo.io.GlooOptions.InvalidConfigPolicy
+	61,  // 43: gloo.solo.io.GlooOptions.disable_grpc_web:type_name -> google.protobuf.BoolValue
+	61,  // 44: gloo.solo.io.GlooOptions.disable_proxy_garbage_collection:type_name -> google.protobuf.BoolValue
+	62,  // 45: gloo.solo.io.GlooOptions.regex_max_program_size:type_name -> google.protobuf.UInt32Value
+	61,  // 46: gloo.solo.io.GlooOptions.enable_rest_eds:type_name -> google.protobuf.BoolValue
+	49,  // 47: gloo.solo.io.GlooOptions.failover_upstream_dns_polling_interval:type_name -> google.protobuf.Duration
+	61,  // 48: gloo.solo.io.GlooOptions.remove_unused_filters:type_name -> google.protobuf.BoolValue
+	61,  // 49: gloo.solo.io.GlooOptions.log_transformation_request_response_info:type_name -> google.protobuf.BoolValue
+	61,  // 50: gloo.solo.io.GlooOptions.transformation_escape_characters:type_name -> google.protobuf.BoolValue
+	42,  // 51: gloo.solo.io.GlooOptions.istio_options:type_name -> gloo.solo.io.GlooOptions.IstioOptions
+	61,  // 52: gloo.solo.io.GlooOptions.enable_auto_websocket_transformation_passthrough:type_name -> google.protobuf.BoolValue
+	43,  // 53: gloo.solo.io.GlooOptions.xds_auth_options:type_name -> gloo.solo.io.GlooOptions.XdsAuthOptions
+	61,  // 54: gloo.solo.io.VirtualServiceOptions.one_way_tls:type_name -> google.protobuf.BoolValue
+	47,  // 55: gloo.solo.io.GatewayOptions.validation:type_name -> gloo.solo.io.GatewayOptions.ValidationOptions
+	6,   // 56: gloo.solo.io.GatewayOptions.virtual_service_options:type_name -> gloo.solo.io.VirtualServiceOptions
+	61,  // 57: gloo.solo.io.GatewayOptions.persist_proxy_spec:type_name -> google.protobuf.BoolValue
+	61,  // 58: gloo.solo.io.GatewayOptions.enable_gateway_controller:type_name -> google.protobuf.BoolValue
+	61,  // 59: gloo.solo.io.GatewayOptions.isolate_virtual_hosts_by_ssl_config:type_name -> google.protobuf.BoolValue
+	61,  // 60: gloo.solo.io.GatewayOptions.translate_empty_gateways:type_name -> google.protobuf.BoolValue
+	27,  // 61: gloo.solo.io.Settings.SecretOptions.sources:type_name -> gloo.solo.io.Settings.SecretOptions.Source
+	61,  // 62: gloo.solo.io.Settings.VaultSecrets.insecure:type_name -> google.protobuf.BoolValue
+	15,  // 63: gloo.solo.io.Settings.VaultSecrets.tls_config:type_name -> gloo.solo.io.Settings.VaultTlsConfig
+	14,  // 64: gloo.solo.io.Settings.VaultSecrets.aws:type_name -> gloo.solo.io.Settings.VaultAwsAuth
+	61,  // 65: gloo.solo.io.Settings.VaultTlsConfig.insecure:type_name -> google.protobuf.BoolValue
+	0,   // 66: gloo.solo.io.Settings.DiscoveryOptions.fds_mode:type_name -> gloo.solo.io.Settings.DiscoveryOptions.FdsMode
+	28,  // 67: gloo.solo.io.Settings.DiscoveryOptions.uds_options:type_name -> gloo.solo.io.Settings.DiscoveryOptions.UdsOptions
+	29,  // 68: gloo.solo.io.Settings.DiscoveryOptions.fds_options:type_name -> gloo.solo.io.Settings.DiscoveryOptions.FdsOptions
+	61,  // 69: gloo.solo.io.Settings.ConsulConfiguration.insecure_skip_verify:type_name -> google.protobuf.BoolValue
+	49,  // 70: gloo.solo.io.Settings.ConsulConfiguration.wait_time:type_name -> google.protobuf.Duration
+	31,  // 71: gloo.solo.io.Settings.ConsulConfiguration.service_discovery:type_name -> gloo.solo.io.Settings.ConsulConfiguration.ServiceDiscoveryOptions
+	49,  // 72: gloo.solo.io.Settings.ConsulConfiguration.dns_polling_interval:type_name -> google.protobuf.Duration
+	63,  // 73: gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration.rootCa:type_name -> core.solo.io.ResourceRef
+	64,  // 74: gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration.consistencyMode:type_name -> consul.options.gloo.solo.io.ConsulConsistencyModes
+	65,  // 75: gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration.query_options:type_name -> consul.options.gloo.solo.io.QueryOptions
+	61,  // 76: gloo.solo.io.Settings.ConsulUpstreamDiscoveryConfiguration.eds_blocking_queries:type_name -> google.protobuf.BoolValue
+	32,  // 77: gloo.solo.io.Settings.KubernetesConfiguration.rate_limits:type_name -> gloo.solo.io.Settings.KubernetesConfiguration.RateLimits
+	54,  // 78: gloo.solo.io.Settings.NamedExtauthEntry.value:type_name -> enterprise.gloo.solo.io.Settings
+	33,  // 79: gloo.solo.io.Settings.ObservabilityOptions.grafanaIntegration:type_name -> gloo.solo.io.Settings.ObservabilityOptions.GrafanaIntegration
+	35,  // 80: gloo.solo.io.Settings.ObservabilityOptions.configStatusMetricLabels:type_name -> gloo.solo.io.Settings.ObservabilityOptions.ConfigStatusMetricLabelsEntry
+	37,  // 81: gloo.solo.io.Settings.ControlPlaneTelemetry.headers:type_name -> gloo.solo.io.Settings.ControlPlaneTelemetry.HeadersEntry
+	61,  // 82: gloo.solo.io.Settings.ControlPlaneTelemetry.traces_enabled:type_name -> google.protobuf.BoolValue
+	61,  // 83: gloo.solo.io.Settings.ControlPlaneTelemetry.metrics_enabled:type_name -> google.protobuf.BoolValue
+	66,  // 84: gloo.solo.io.Settings.ControlPlaneTelemetry.sampling_ratio:type_name -> google.protobuf.DoubleValue
+	49,  // 85: gloo.solo.io.Settings.ControlPlaneTelemetry.metric_export_interval:type_name -> google.protobuf.Duration
+	12,  // 86: gloo.solo.io.Settings.SecretOptions.Source.kubernetes:type_name -> gloo.solo.io.Settings.KubernetesSecrets
+	13,  // 87: gloo.solo.io.Settings.SecretOptions.Source.vault:type_name -> gloo.solo.io.Settings.VaultSecrets
+	18,  // 88: gloo.solo.io.Settings.SecretOptions.Source.directory:type_name -> gloo.solo.io.Settings.Directory
+	61,  // 89: gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.enabled:type_name -> google.protobuf.BoolValue
+	30,  // 90: gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.watch_labels:type_name -> gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.WatchLabelsEntry
+	62,  // 91: gloo.solo.io.Settings.ObservabilityOptions.GrafanaIntegration.default_dashboard_folder_id:type_name -> google.protobuf.UInt32Value
+	36,  // 92: gloo.solo.io.Settings.ObservabilityOptions.MetricLabels.labelToPath:type_name -> gloo.solo.io.Settings.ObservabilityOptions.MetricLabels.LabelToPathEntry
+	34,  // 93: gloo.solo.io.Settings.ObservabilityOptions.ConfigStatusMetricLabelsEntry.value:type_name -> gloo.solo.io.Settings.ObservabilityOptions.MetricLabels
+	67,  // 94: gloo.solo.io.GlooOptions.AWSOptions.service_account_credentials:type_name -> envoy.config.filter.http.aws_lambda.v2.AWSLambdaConfig.ServiceAccountCredentials
+	61,  // 95: gloo.solo.io.GlooOptions.AWSOptions.propagate_original_routing:type_name -> google.protobuf.BoolValue
+	49,  // 96: gloo.solo.io.GlooOptions.AWSOptions.credential_refresh_delay:type_name -> google.protobuf.Duration
+	61,  // 97: gloo.solo.io.GlooOptions.AWSOptions.fallback_to_first_function:type_name -> google.protobuf.BoolValue
+	61,  // 98: gloo.solo.io.GlooOptions.IstioOptions.append_x_forwarded_host:type_name -> google.protobuf.BoolValue
+	61,  // 99: gloo.solo.io.GlooOptions.IstioOptions.enable_auto_mtls:type_name -> google.protobuf.BoolValue
+	61,  // 100: gloo.solo.io.GlooOptions.IstioOptions.enable_integration:type_name -> google.protobuf.BoolValue
+	44,  // 101: gloo.solo.io.GlooOptions.XdsAuthOptions.mtls:type_name -> gloo.solo.io.GlooOptions.XdsAuthOptions.Mtls
+	45,  // 102: gloo.solo.io.GlooOptions.XdsAuthOptions.service_account_tokens:type_name -> gloo.solo.io.GlooOptions.XdsAuthOptions.ServiceAccountTokens
+	46,  // 103: gloo.solo.io.GlooOptions.XdsAuthOptions.proxy_bindings:type_name -> gloo.solo.io.GlooOptions.XdsAuthOptions.ProxyBinding
+	63,  // 104: gloo.solo.io.GlooOptions.XdsAuthOptions.ProxyBinding.gateway:type_name -> core.solo.io.ResourceRef
+	63,  // 105: gloo.solo.io.GlooOptions.XdsAuthOptions.ProxyBinding.service_accounts:type_name -> core.solo.io.ResourceRef
+	61,  // 106: gloo.solo.io.GatewayOptions.ValidationOptions.always_accept:type_name -> google.protobuf.BoolValue
+	61,  // 107: gloo.solo.io.GatewayOptions.ValidationOptions.allow_warnings:type_name -> google.protobuf.BoolValue
+	61,  // 108: gloo.solo.io.GatewayOptions.ValidationOptions.warn_route_short_circuiting:type_name -> google.protobuf.BoolValue
+	61,  // 109: gloo.solo.io.GatewayOptions.ValidationOptions.disable_transformation_validation:type_name -> google.protobuf.BoolValue
+	68,  // 110: gloo.solo.io.GatewayOptions.ValidationOptions.validation_server_grpc_max_size_bytes:type_name -> google.protobuf.Int32Value
+	61,  // 111: gloo.solo.io.GatewayOptions.ValidationOptions.server_enabled:type_name -> google.protobuf.BoolValue
+	61,  // 112: gloo.solo.io.GatewayOptions.ValidationOptions.warn_missing_tls_secret:type_name -> google.protobuf.BoolValue
+	61,  // 113: gloo.solo.io.GatewayOptions.ValidationOptions.full_envoy_validation:type_name -> google.protobuf.BoolValue
+	114, // [114:114] is the sub-list for method output_type
+	114, // [114:114] is the sub-list for method input_type
+	114, // [114:114] is the sub-list for extension type_name
+	114, // [114:114] is the sub-list for extension extendee
+	0,   // [0:114] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_init() }
@@ -4019,22 +4492,26 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_init() {
 		(*Settings_VaultSecrets_AccessToken)(nil),
 		(*Settings_VaultSecrets_Aws)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[25].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[26].OneofWrappers = []any{
 		(*Settings_SecretOptions_Source_Kubernetes)(nil),
 		(*Settings_SecretOptions_Source_Vault)(nil),
 		(*Settings_SecretOptions_Source_Directory)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[37].OneofWrappers = []any{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[39].OneofWrappers = []any{
 		(*GlooOptions_AWSOptions_EnableCredentialsDiscovey)(nil),
 		(*GlooOptions_AWSOptions_ServiceAccountCredentials)(nil),
 	}
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_msgTypes[45].OneofWrappers = []any{
+		(*GlooOptions_XdsAuthOptions_ProxyBinding_Role)(nil),
+		(*GlooOptions_XdsAuthOptions_ProxyBinding_Gateway)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc), len(file_github_com_solo_io_gloo_projects_gloo_api_v1_settings_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   42,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
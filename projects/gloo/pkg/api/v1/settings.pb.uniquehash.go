@@ -607,6 +607,26 @@ func (m *Settings) HashUnique(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if h, ok := interface{}(m.GetControlPlaneTelemetry()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ControlPlaneTelemetry")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetControlPlaneTelemetry(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ControlPlaneTelemetry")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	switch m.ConfigSource.(type) {
 
 	case *Settings_KubernetesConfigSource:
@@ -1348,6 +1368,26 @@ func (m *GlooOptions) HashUnique(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetXdsAuthOptions()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("XdsAuthOptions")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetXdsAuthOptions(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("XdsAuthOptions")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -2470,6 +2510,13 @@ func (m *Settings_ConsulUpstreamDiscoveryConfiguration) HashUnique(hasher hash.H
 		}
 	}
 
+	if _, err = hasher.Write([]byte("ConnectServiceName")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetConnectServiceName())); err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -2593,6 +2640,149 @@ func (m *Settings_ObservabilityOptions) HashUnique(hasher hash.Hash64) (uint64,
 	return hasher.Sum64(), nil
 }
 
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *Settings_ControlPlaneTelemetry) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.Settings_ControlPlaneTelemetry")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("OtlpEndpoint")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetOtlpEndpoint())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("Insecure")); err != nil {
+		return 0, err
+	}
+	err = binary.Write(hasher, binary.LittleEndian, m.GetInsecure())
+	if err != nil {
+		return 0, err
+	}
+
+	{
+		var result uint64
+		innerHash := fnv.New64()
+		for k, v := range m.GetHeaders() {
+			innerHash.Reset()
+
+			if _, err = innerHash.Write([]byte("v")); err != nil {
+				return 0, err
+			}
+			if _, err = innerHash.Write([]byte(v)); err != nil {
+				return 0, err
+			}
+
+			if _, err = innerHash.Write([]byte("k")); err != nil {
+				return 0, err
+			}
+			if _, err = innerHash.Write([]byte(k)); err != nil {
+				return 0, err
+			}
+
+			result = result ^ innerHash.Sum64()
+		}
+		err = binary.Write(hasher, binary.LittleEndian, result)
+		if err != nil {
+			return 0, err
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTracesEnabled()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("TracesEnabled")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetTracesEnabled(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("TracesEnabled")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetMetricsEnabled()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MetricsEnabled")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMetricsEnabled(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MetricsEnabled")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetSamplingRatio()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("SamplingRatio")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetSamplingRatio(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("SamplingRatio")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetMetricExportInterval()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MetricExportInterval")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMetricExportInterval(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MetricExportInterval")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // HashUnique function generates a hash of the object that is unique to the object by
 // hashing field name and value pairs.
 // Replaces Hash due to original hashing implemention only using field values. The omission
@@ -3177,6 +3367,263 @@ func (m *GlooOptions_IstioOptions) HashUnique(hasher hash.Hash64) (uint64, error
 	return hasher.Sum64(), nil
 }
 
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *GlooOptions_XdsAuthOptions) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GlooOptions_XdsAuthOptions")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetMtls()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Mtls")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMtls(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Mtls")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetServiceAccountTokens()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ServiceAccountTokens")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetServiceAccountTokens(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ServiceAccountTokens")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte("ProxyBindings")); err != nil {
+		return 0, err
+	}
+	for i, v := range m.GetProxyBindings() {
+		if _, err = hasher.Write([]byte(strconv.Itoa(i))); err != nil {
+			return 0, err
+		}
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("v")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("v")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	if _, err = hasher.Write([]byte("AllowUnboundProxies")); err != nil {
+		return 0, err
+	}
+	err = binary.Write(hasher, binary.LittleEndian, m.GetAllowUnboundProxies())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *GlooOptions_XdsAuthOptions_Mtls) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GlooOptions_XdsAuthOptions_Mtls")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("CertFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetCertFile())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("KeyFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetKeyFile())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("CaFile")); err != nil {
+		return 0, err
+	}
+	if _, err = hasher.Write([]byte(m.GetCaFile())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *GlooOptions_XdsAuthOptions_ServiceAccountTokens) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GlooOptions_XdsAuthOptions_ServiceAccountTokens")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("Audiences")); err != nil {
+		return 0, err
+	}
+	for i, v := range m.GetAudiences() {
+		if _, err = hasher.Write([]byte(strconv.Itoa(i))); err != nil {
+			return 0, err
+		}
+
+		if _, err = hasher.Write([]byte("v")); err != nil {
+			return 0, err
+		}
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// HashUnique function generates a hash of the object that is unique to the object by
+// hashing field name and value pairs.
+// Replaces Hash due to original hashing implemention only using field values. The omission
+// of the field name in the hash calculation can lead to hash collisions.
+func (m *GlooOptions_XdsAuthOptions_ProxyBinding) HashUnique(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GlooOptions_XdsAuthOptions_ProxyBinding")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte("ServiceAccounts")); err != nil {
+		return 0, err
+	}
+	for i, v := range m.GetServiceAccounts() {
+		if _, err = hasher.Write([]byte(strconv.Itoa(i))); err != nil {
+			return 0, err
+		}
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("v")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("v")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	switch m.Proxy.(type) {
+
+	case *GlooOptions_XdsAuthOptions_ProxyBinding_Role:
+
+		if _, err = hasher.Write([]byte("Role")); err != nil {
+			return 0, err
+		}
+		if _, err = hasher.Write([]byte(m.GetRole())); err != nil {
+			return 0, err
+		}
+
+	case *GlooOptions_XdsAuthOptions_ProxyBinding_Gateway:
+
+		if h, ok := interface{}(m.GetGateway()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Gateway")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetGateway(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Gateway")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // HashUnique function generates a hash of the object that is unique to the object by
 // hashing field name and value pairs.
 // Replaces Hash due to original hashing implemention only using field values. The omission
@@ -76,5 +76,15 @@ func (m *TcpListenerOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetRbac()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRbac()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRbac(), target.GetRbac()) {
+			return false
+		}
+	}
+
 	return true
 }
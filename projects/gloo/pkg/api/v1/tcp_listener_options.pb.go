@@ -11,6 +11,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	rbac "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
 	connection_limit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
 	local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
 	tcp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
@@ -35,8 +36,11 @@ type TcpListenerOptions struct {
 	// LocalRatelimit can be used to rate limit the connections per gateway at the L4 layer.
 	// It uses envoy's own local rate limit filter to do so, without the need for an external rate limit server to be set up.
 	LocalRatelimit *local_ratelimit.TokenBucket `protobuf:"bytes,5,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Rbac applies role based access control to the connections of the listener with Envoy's network RBAC filter.
+	// Only principals matching source IPs, and policies without permissions, are supported on TCP listeners.
+	Rbac          *rbac.ExtensionSettings `protobuf:"bytes,6,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TcpListenerOptions) Reset() {
@@ -90,15 +94,23 @@ func (x *TcpListenerOptions) GetLocalRatelimit() *local_ratelimit.TokenBucket {
 	return nil
 }
 
+func (x *TcpListenerOptions) GetRbac() *rbac.ExtensionSettings {
+	if x != nil {
+		return x.Rbac
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_tcp_listener_options_proto protoreflect.FileDescriptor
 
 const file_github_com_solo_io_gloo_projects_gloo_api_v1_tcp_listener_options_proto_rawDesc = "" +
 	"\n" +
-	"Ggithub.com/solo-io/gloo/projects/gloo/api/v1/tcp_listener_options.proto\x12\fgloo.solo.io\x1a\x12extproto/ext.proto\x1aBgithub.com/solo-io/gloo/projects/gloo/api/v1/options/tcp/tcp.proto\x1a\\github.com/solo-io/gloo/projects/gloo/api/v1/options/connection_limit/connection_limit.proto\x1aZgithub.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto\"\xad\x02\n" +
+	"Ggithub.com/solo-io/gloo/projects/gloo/api/v1/tcp_listener_options.proto\x12\fgloo.solo.io\x1a\x12extproto/ext.proto\x1aBgithub.com/solo-io/gloo/projects/gloo/api/v1/options/tcp/tcp.proto\x1a\\github.com/solo-io/gloo/projects/gloo/api/v1/options/connection_limit/connection_limit.proto\x1aZgithub.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto\x1aOgithub.com/solo-io/gloo/projects/gloo/api/v1/enterprise/options/rbac/rbac.proto\"\xef\x02\n" +
 	"\x12TcpListenerOptions\x12X\n" +
 	"\x12tcp_proxy_settings\x18\x03 \x01(\v2*.tcp.options.gloo.solo.io.TcpProxySettingsR\x10tcpProxySettings\x12a\n" +
 	"\x10connection_limit\x18\x04 \x01(\v26.connection_limit.options.gloo.solo.io.ConnectionLimitR\x0fconnectionLimit\x12Z\n" +
-	"\x0flocal_ratelimit\x18\x05 \x01(\v21.local_ratelimit.options.gloo.solo.io.TokenBucketR\x0elocalRatelimitB>\xb8\xf5\x04\x01\xc0\xf5\x04\x01\xd0\xf5\x04\x01Z0github.com/solo-io/gloo/projects/gloo/pkg/api/v1b\x06proto3"
+	"\x0flocal_ratelimit\x18\x05 \x01(\v21.local_ratelimit.options.gloo.solo.io.TokenBucketR\x0elocalRatelimit\x12@\n" +
+	"\x04rbac\x18\x06 \x01(\v2,.rbac.options.gloo.solo.io.ExtensionSettingsR\x04rbacB>\xb8\xf5\x04\x01\xc0\xf5\x04\x01\xd0\xf5\x04\x01Z0github.com/solo-io/gloo/projects/gloo/pkg/api/v1b\x06proto3"
 
 var (
 	file_github_com_solo_io_gloo_projects_gloo_api_v1_tcp_listener_options_proto_rawDescOnce sync.Once
@@ -118,16 +130,18 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_tcp_listener_options_proto
 	(*tcp.TcpProxySettings)(nil),             // 1: tcp.options.gloo.solo.io.TcpProxySettings
 	(*connection_limit.ConnectionLimit)(nil), // 2: connection_limit.options.gloo.solo.io.ConnectionLimit
 	(*local_ratelimit.TokenBucket)(nil),      // 3: local_ratelimit.options.gloo.solo.io.TokenBucket
+	(*rbac.ExtensionSettings)(nil),           // 4: rbac.options.gloo.solo.io.ExtensionSettings
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_tcp_listener_options_proto_depIdxs = []int32{
 	1, // 0: gloo.solo.io.TcpListenerOptions.tcp_proxy_settings:type_name -> tcp.options.gloo.solo.io.TcpProxySettings
 	2, // 1: gloo.solo.io.TcpListenerOptions.connection_limit:type_name -> connection_limit.options.gloo.solo.io.ConnectionLimit
 	3, // 2: gloo.solo.io.TcpListenerOptions.local_ratelimit:type_name -> local_ratelimit.options.gloo.solo.io.TokenBucket
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: gloo.solo.io.TcpListenerOptions.rbac:type_name -> rbac.options.gloo.solo.io.ExtensionSettings
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_tcp_listener_options_proto_init() }
@@ -102,5 +102,25 @@ func (m *TcpListenerOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetRbac()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Rbac")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetRbac(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Rbac")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
@@ -24,8 +24,11 @@ import (
 	"github.com/solo-io/gloo/projects/gateway2/deployer"
 	ggv2utils "github.com/solo-io/gloo/projects/gateway2/utils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds/xdsauth"
 	xdsserver "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
 )
 
@@ -110,6 +113,9 @@ type Opts struct {
 	GlooGateway         GlooGateway
 	ProxyReconcileQueue ggv2utils.AsyncQueue[v1.ProxyList]
 	KrtDebugger         *krt.DebugHandler
+
+	// WasmModules holds the Wasm modules fetched from images, which the REST xDS server serves to the proxies
+	WasmModules *wasm.ModuleCache
 }
 
 type IstioValues struct {
@@ -132,8 +138,12 @@ type Consul struct {
 
 type ControlPlane struct {
 	*GrpcService
-	SnapshotCache cache.SnapshotCache
-	XDSServer     server.Server
+	SnapshotCache  cache.SnapshotCache
+	XDSServer      server.Server
+	DeltaXDSServer xds.DeltaServer
+
+	// XdsAuthorizer authorizes the clients of the xDS and REST xDS servers, and is nil if any client is served
+	XdsAuthorizer *xdsauth.Authorizer
 
 	Kube KubernetesControlPlaneConfig
 }
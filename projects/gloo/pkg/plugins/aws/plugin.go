@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"slices"
 	"unicode/utf8"
 
 	"github.com/hashicorp/go-multierror"
@@ -38,8 +39,14 @@ var (
 	_ plugins.UpstreamPlugin   = new(Plugin)
 	_ plugins.RoutePlugin      = new(Plugin)
 	_ plugins.HttpFilterPlugin = new(Plugin)
+
+	_ plugins.UpstreamStatePlugin = new(Plugin)
+	_ plugins.RouteStatePlugin    = new(Plugin)
 )
 
+// the flag of the route state which is set when a route requires the transformation filter
+const transformationFilterFlag = "transformation-filter"
+
 const (
 	ExtensionName                 = "aws_lambda"
 	FilterName                    = "io.solo.aws_lambda"
@@ -164,6 +171,32 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+// UpstreamState returns the spec of the upstream if it is an AWS upstream
+func (p *Plugin) UpstreamState(in *v1.Upstream) interface{} {
+	if spec, ok := p.recordedUpstreams[in.GetMetadata().Ref().Key()]; ok {
+		return spec
+	}
+	return nil
+}
+
+func (p *Plugin) SetUpstreamState(in *v1.Upstream, state interface{}) {
+	if spec, ok := state.(*aws.UpstreamSpec); ok {
+		p.recordedUpstreams[in.GetMetadata().Ref().Key()] = spec
+	}
+}
+
+// RouteState returns whether a route required the transformation filter, which is added to every listener
+func (p *Plugin) RouteState(_ *v1.HttpListener) []string {
+	if p.requiresTransformationFilter {
+		return []string{transformationFilterFlag}
+	}
+	return nil
+}
+
+func (p *Plugin) SetRouteState(_ *v1.HttpListener, flags []string) {
+	p.requiresTransformationFilter = slices.Contains(flags, transformationFilterFlag)
+}
+
 func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	err := pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, FilterName,
 		func(spec *v1.Destination) (proto.Message, error) {
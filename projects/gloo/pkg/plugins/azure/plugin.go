@@ -27,18 +27,29 @@ var (
 	_ plugins.Plugin         = new(plugin)
 	_ plugins.RoutePlugin    = new(plugin)
 	_ plugins.UpstreamPlugin = new(plugin)
+
+	_ plugins.UpstreamStatePlugin = new(plugin)
 )
 
 const (
 	ExtensionName = "azure"
-	masterKeyName = "_master"
+	// MasterKeyName is the name of the API key of the Function App host in Azure secrets
+	MasterKeyName = "_master"
 )
 
 type plugin struct {
 	settings          *v1.Settings
 	recordedUpstreams map[string]*azure.UpstreamSpec
 	apiKeys           map[string]string
-	ctx               context.Context
+	// the api keys read from the secret of each upstream, which are the apiKeys once the upstream is processed
+	upstreamApiKeys map[string]map[string]string
+	ctx             context.Context
+}
+
+// upstreamState is the state recorded while processing an upstream
+type upstreamState struct {
+	spec    *azure.UpstreamSpec
+	apiKeys map[string]string
 }
 
 func NewPlugin() plugins.Plugin {
@@ -54,6 +65,7 @@ func (p *plugin) Init(params plugins.InitParams) {
 	p.ctx = params.Ctx
 	p.recordedUpstreams = make(map[string]*azure.UpstreamSpec)
 	p.apiKeys = make(map[string]string)
+	p.upstreamApiKeys = make(map[string]map[string]string)
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
@@ -103,11 +115,37 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 			return errors.Errorf("secret %v is not an Azure secret", secrets.GetMetadata().Ref())
 		}
 		p.apiKeys = azureSecrets.Azure.GetApiKeys()
+		p.upstreamApiKeys[in.GetMetadata().Ref().Key()] = p.apiKeys
 	}
 
 	return nil
 }
 
+func (p *plugin) UpstreamState(in *v1.Upstream) any {
+	key := in.GetMetadata().Ref().Key()
+	spec, ok := p.recordedUpstreams[key]
+	if !ok {
+		return nil
+	}
+	return &upstreamState{
+		spec:    spec,
+		apiKeys: p.upstreamApiKeys[key],
+	}
+}
+
+func (p *plugin) SetUpstreamState(in *v1.Upstream, state any) {
+	recorded, ok := state.(*upstreamState)
+	if !ok {
+		return
+	}
+	key := in.GetMetadata().Ref().Key()
+	p.recordedUpstreams[key] = recorded.spec
+	if recorded.apiKeys != nil {
+		p.apiKeys = recorded.apiKeys
+		p.upstreamApiKeys[key] = recorded.apiKeys
+	}
+}
+
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName,
 		func(spec *v1.Destination) (proto.Message, error) {
@@ -190,9 +228,9 @@ func getPathParameters(functionSpec *azure.UpstreamSpec_FunctionSpec, apiKeys ma
 		// TODO(talnordan): Consider whether using the "function" authentication level should require
 		// using a function key and not a master key. This is a product decision. From the technical
 		// point of view, a master key does satisfy the "function" authentication level.
-		keyNames = []string{functionSpec.GetFunctionName(), masterKeyName}
+		keyNames = []string{functionSpec.GetFunctionName(), MasterKeyName}
 	case azure.UpstreamSpec_FunctionSpec_Admin:
-		keyNames = []string{masterKeyName}
+		keyNames = []string{MasterKeyName}
 	}
 
 	apiKey, err := getApiKey(apiKeys, keyNames)
@@ -0,0 +1,287 @@
+package compression
+
+import (
+	"fmt"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoybrotlicompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoybrotlidecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/decompressor/v3"
+	envoygzipdecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/decompressor/v3"
+	envoyzstdcompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	envoyzstddecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/decompressor/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoydecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	v2 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/filter/http/gzip/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gzip"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
+)
+
+const (
+	ExtensionName = "compression"
+
+	CompressorFilterPrefix   = "envoy.filters.http.compressor."
+	DecompressorFilterPrefix = "envoy.filters.http.decompressor."
+
+	GzipAlgorithm   = "gzip"
+	BrotliAlgorithm = "brotli"
+	ZstdAlgorithm   = "zstd"
+)
+
+var (
+	// GzipConflictError is returned when both the `gzip` and the `compression` options of a listener configure a gzip compressor
+	GzipConflictError = eris.New("a gzip compressor cannot be configured by both the gzip and the compression options of a listener")
+
+	DuplicateCompressorError = func(algorithm string) error {
+		return eris.Errorf("the %s compressor is configured more than once", algorithm)
+	}
+
+	DuplicateDecompressorError = func(algorithm string) error {
+		return eris.Errorf("request decompression with %s is configured more than once", algorithm)
+	}
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+// HttpFilters returns a decompressor filter for each request decompression algorithm, followed by a compressor filter
+// for each compressor, in order of priority.
+// The filters are placed before any other filter, so that request bodies are decompressed before they are read by other
+// filters, and responses are compressed after they have been processed by other filters (filters are executed in the
+// reverse order on the response path). They are also placed before the compressor of the `gzip` option, so that the
+// compressors of this option are preferred when the q-values of the accept-encoding header are equal.
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	settings := listener.GetOptions().GetCompression()
+	if settings == nil {
+		return nil, nil
+	}
+
+	var filters []plugins.StagedHttpFilter
+	add := func(name string, config proto.Message) error {
+		filter, err := plugins.NewStagedFilter(name, config, plugins.DuringStage(plugins.FaultStage))
+		if err != nil {
+			return eris.Wrapf(err, "generating %s filter config", name)
+		}
+		filters = append(filters, filter)
+		return nil
+	}
+
+	decompressors := map[compression.RequestDecompression_Algorithm]bool{}
+	for _, algorithm := range settings.GetRequestDecompression().GetAlgorithms() {
+		name := decompressorAlgorithmName(algorithm)
+		if decompressors[algorithm] {
+			return nil, DuplicateDecompressorError(name)
+		}
+		decompressors[algorithm] = true
+
+		envoyDecompressor, err := translateDecompressor(algorithm)
+		if err != nil {
+			return nil, eris.Wrapf(err, "converting %s decompressor config", name)
+		}
+		if err := add(DecompressorFilterPrefix+name, envoyDecompressor); err != nil {
+			return nil, err
+		}
+	}
+
+	compressors := map[string]bool{}
+	for _, compressor := range settings.GetCompressors() {
+		algorithm := compressorAlgorithm(compressor)
+		if compressors[algorithm] {
+			return nil, DuplicateCompressorError(algorithm)
+		}
+		compressors[algorithm] = true
+		if algorithm == GzipAlgorithm && listener.GetOptions().GetGzip() != nil {
+			return nil, GzipConflictError
+		}
+
+		envoyCompressor, err := translateCompressor(compressor)
+		if err != nil {
+			return nil, eris.Wrapf(err, "converting %s compressor config", algorithm)
+		}
+		if err := add(CompressorFilterPrefix+algorithm, envoyCompressor); err != nil {
+			return nil, err
+		}
+	}
+
+	// order the filters before the other filters of the fault stage, see the gzip plugin
+	for i := range filters {
+		filters[i].Stage = plugins.RelativeToStage(plugins.FaultStage, i-len(filters)-1)
+	}
+
+	return filters, nil
+}
+
+// ProcessRoute disables every compressor of the listener for the route, including the compressor of the `gzip` option
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	if !in.GetOptions().GetCompression().GetDisable() {
+		return nil
+	}
+
+	disabled := &envoycompressor.CompressorPerRoute{
+		Override: &envoycompressor.CompressorPerRoute_Disabled{
+			Disabled: true,
+		},
+	}
+	for _, name := range compressorFilterNames(params.HttpListener) {
+		if err := pluginutils.SetRoutePerFilterConfig(out, name, disabled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compressorFilterNames returns the names of the compressor filters of the listener
+func compressorFilterNames(listener *v1.HttpListener) []string {
+	var names []string
+	if listener.GetOptions().GetGzip() != nil {
+		names = append(names, gzip.CompressorFilterName)
+	}
+	for _, compressor := range listener.GetOptions().GetCompression().GetCompressors() {
+		if algorithm := compressorAlgorithm(compressor); algorithm != "" {
+			names = append(names, CompressorFilterPrefix+algorithm)
+		}
+	}
+	return names
+}
+
+func compressorAlgorithm(compressor *compression.Compressor) string {
+	switch compressor.GetAlgorithm().(type) {
+	case *compression.Compressor_Gzip:
+		return GzipAlgorithm
+	case *compression.Compressor_Brotli:
+		return BrotliAlgorithm
+	case *compression.Compressor_Zstd:
+		return ZstdAlgorithm
+	}
+	return ""
+}
+
+func decompressorAlgorithmName(algorithm compression.RequestDecompression_Algorithm) string {
+	switch algorithm {
+	case compression.RequestDecompression_GZIP:
+		return GzipAlgorithm
+	case compression.RequestDecompression_BROTLI:
+		return BrotliAlgorithm
+	case compression.RequestDecompression_ZSTD:
+		return ZstdAlgorithm
+	}
+	return algorithm.String()
+}
+
+func translateCompressor(compressor *compression.Compressor) (*envoycompressor.Compressor, error) {
+	var library proto.Message
+	switch algorithm := compressor.GetAlgorithm().(type) {
+	case *compression.Compressor_Gzip:
+		envoyGzip, err := gzip.GlooToEnvoyGzip(&v2.Gzip{
+			MemoryLevel:         algorithm.Gzip.GetMemoryLevel(),
+			CompressionLevel:    algorithm.Gzip.GetCompressionLevel(),
+			CompressionStrategy: algorithm.Gzip.GetCompressionStrategy(),
+			WindowBits:          algorithm.Gzip.GetWindowBits(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		library = envoyGzip
+	case *compression.Compressor_Brotli:
+		library = &envoybrotlicompressor.Brotli{
+			Quality:                       algorithm.Brotli.GetQuality(),
+			EncoderMode:                   envoybrotlicompressor.Brotli_EncoderMode(algorithm.Brotli.GetEncoderMode()),
+			WindowBits:                    algorithm.Brotli.GetWindowBits(),
+			InputBlockBits:                algorithm.Brotli.GetInputBlockBits(),
+			DisableLiteralContextModeling: algorithm.Brotli.GetDisableLiteralContextModeling(),
+		}
+	case *compression.Compressor_Zstd:
+		library = &envoyzstdcompressor.Zstd{
+			CompressionLevel: algorithm.Zstd.GetCompressionLevel(),
+			EnableChecksum:   algorithm.Zstd.GetEnableChecksum(),
+			Strategy:         envoyzstdcompressor.Zstd_Strategy(algorithm.Zstd.GetStrategy()),
+		}
+	default:
+		return nil, eris.New("compressor must set one of gzip, brotli or zstd")
+	}
+
+	algorithm := compressorAlgorithm(compressor)
+	libraryConfig, err := typedExtensionConfig(fmt.Sprintf("envoy.compression.%s.compressor", algorithm), library)
+	if err != nil {
+		return nil, err
+	}
+	envoyCompressor := &envoycompressor.Compressor{
+		CompressorLibrary: libraryConfig,
+		ResponseDirectionConfig: &envoycompressor.Compressor_ResponseDirectionConfig{
+			CommonConfig: &envoycompressor.Compressor_CommonDirectionConfig{
+				MinContentLength: compressor.GetContentLength(),
+				ContentType:      compressor.GetContentType(),
+			},
+			DisableOnEtagHeader:        compressor.GetDisableOnEtagHeader(),
+			RemoveAcceptEncodingHeader: compressor.GetRemoveAcceptEncodingHeader(),
+		},
+	}
+	return envoyCompressor, envoyCompressor.Validate()
+}
+
+// translateDecompressor returns a decompressor which only decompresses requests
+func translateDecompressor(algorithm compression.RequestDecompression_Algorithm) (*envoydecompressor.Decompressor, error) {
+	var library proto.Message
+	switch algorithm {
+	case compression.RequestDecompression_GZIP:
+		library = &envoygzipdecompressor.Gzip{}
+	case compression.RequestDecompression_BROTLI:
+		library = &envoybrotlidecompressor.Brotli{}
+	case compression.RequestDecompression_ZSTD:
+		library = &envoyzstddecompressor.Zstd{}
+	default:
+		return nil, eris.Errorf("invalid decompression algorithm %v", algorithm)
+	}
+
+	name := decompressorAlgorithmName(algorithm)
+	libraryConfig, err := typedExtensionConfig(fmt.Sprintf("envoy.compression.%s.decompressor", name), library)
+	if err != nil {
+		return nil, err
+	}
+	envoyDecompressor := &envoydecompressor.Decompressor{
+		DecompressorLibrary: libraryConfig,
+		ResponseDirectionConfig: &envoydecompressor.Decompressor_ResponseDirectionConfig{
+			CommonConfig: &envoydecompressor.Decompressor_CommonDirectionConfig{
+				Enabled: &envoy_config_core_v3.RuntimeFeatureFlag{
+					DefaultValue: wrapperspb.Bool(false),
+					RuntimeKey:   fmt.Sprintf("decompressor.%s.response_direction_enabled", name),
+				},
+			},
+		},
+	}
+	return envoyDecompressor, envoyDecompressor.Validate()
+}
+
+func typedExtensionConfig(name string, config proto.Message) (*envoy_config_core_v3.TypedExtensionConfig, error) {
+	typedConfig, err := utils.MessageToAny(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TypedExtensionConfig{
+		Name:        name,
+		TypedConfig: typedConfig,
+	}, nil
+}
@@ -0,0 +1,225 @@
+package compression_test
+
+import (
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoybrotlicompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoygzipcompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoyzstdcompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	envoyzstddecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/decompressor/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoydecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v2 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/filter/http/gzip/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gzip"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		settings *compression.CompressionSettings
+		listener *v1.HttpListener
+	)
+
+	BeforeEach(func() {
+		settings = &compression.CompressionSettings{
+			Compressors: []*compression.Compressor{
+				{
+					Algorithm: &compression.Compressor_Brotli{
+						Brotli: &compression.Brotli{
+							Quality:     &wrappers.UInt32Value{Value: 5},
+							EncoderMode: compression.Brotli_TEXT,
+						},
+					},
+					ContentLength: &wrappers.UInt32Value{Value: 100},
+					ContentType:   []string{"application/json"},
+				},
+				{
+					Algorithm: &compression.Compressor_Zstd{
+						Zstd: &compression.Zstd{
+							CompressionLevel: &wrappers.UInt32Value{Value: 7},
+							Strategy:         compression.Zstd_BTLAZY2,
+						},
+					},
+					DisableOnEtagHeader: true,
+				},
+				{
+					Algorithm: &compression.Compressor_Gzip{
+						Gzip: &compression.Gzip{
+							CompressionLevel: v2.Gzip_CompressionLevel_BEST,
+						},
+					},
+				},
+			},
+			RequestDecompression: &compression.RequestDecompression{
+				Algorithms: []compression.RequestDecompression_Algorithm{compression.RequestDecompression_ZSTD},
+			},
+		}
+		listener = &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Compression: settings,
+			},
+		}
+	})
+
+	filterNames := func(filters []plugins.StagedHttpFilter) []string {
+		var names []string
+		for _, filter := range filters {
+			names = append(names, filter.Filter.GetName())
+		}
+		return names
+	}
+
+	It("does nothing without compression settings", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("adds the decompressors and then the compressors in order of priority", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filterNames(filters)).To(Equal([]string{
+			DecompressorFilterPrefix + ZstdAlgorithm,
+			CompressorFilterPrefix + BrotliAlgorithm,
+			CompressorFilterPrefix + ZstdAlgorithm,
+			CompressorFilterPrefix + GzipAlgorithm,
+		}))
+
+		By("ordering the filters before the compressor of the gzip option")
+		gzipStage := plugins.DuringStage(plugins.FaultStage)
+		for i, filter := range filters {
+			Expect(plugins.FilterStageComparison(filter.Stage, gzipStage)).To(Equal(-1))
+			if i > 0 {
+				Expect(plugins.FilterStageComparison(filters[i-1].Stage, filter.Stage)).To(Equal(-1))
+			}
+		}
+	})
+
+	It("translates the compressor settings", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+
+		var brotliCompressor envoycompressor.Compressor
+		Expect(filters[1].Filter.GetTypedConfig().UnmarshalTo(&brotliCompressor)).To(Succeed())
+		Expect(brotliCompressor.GetCompressorLibrary().GetName()).To(Equal("envoy.compression.brotli.compressor"))
+		Expect(brotliCompressor.GetResponseDirectionConfig().GetCommonConfig().GetMinContentLength().GetValue()).To(Equal(uint32(100)))
+		Expect(brotliCompressor.GetResponseDirectionConfig().GetCommonConfig().GetContentType()).To(ConsistOf("application/json"))
+		var brotli envoybrotlicompressor.Brotli
+		Expect(brotliCompressor.GetCompressorLibrary().GetTypedConfig().UnmarshalTo(&brotli)).To(Succeed())
+		Expect(brotli.GetQuality().GetValue()).To(Equal(uint32(5)))
+		Expect(brotli.GetEncoderMode()).To(Equal(envoybrotlicompressor.Brotli_TEXT))
+
+		var zstdCompressor envoycompressor.Compressor
+		Expect(filters[2].Filter.GetTypedConfig().UnmarshalTo(&zstdCompressor)).To(Succeed())
+		Expect(zstdCompressor.GetResponseDirectionConfig().GetDisableOnEtagHeader()).To(BeTrue())
+		var zstd envoyzstdcompressor.Zstd
+		Expect(zstdCompressor.GetCompressorLibrary().GetTypedConfig().UnmarshalTo(&zstd)).To(Succeed())
+		Expect(zstd.GetCompressionLevel().GetValue()).To(Equal(uint32(7)))
+		Expect(zstd.GetStrategy()).To(Equal(envoyzstdcompressor.Zstd_BTLAZY2))
+
+		var gzipCompressor envoycompressor.Compressor
+		Expect(filters[3].Filter.GetTypedConfig().UnmarshalTo(&gzipCompressor)).To(Succeed())
+		Expect(gzipCompressor.GetCompressorLibrary().GetName()).To(Equal(gzip.GzipLibrary))
+		var gzipLibrary envoygzipcompressor.Gzip
+		Expect(gzipCompressor.GetCompressorLibrary().GetTypedConfig().UnmarshalTo(&gzipLibrary)).To(Succeed())
+		Expect(gzipLibrary.GetCompressionLevel()).To(Equal(envoygzipcompressor.Gzip_BEST_COMPRESSION))
+	})
+
+	It("translates request decompression so that only requests are decompressed", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+
+		var decompressor envoydecompressor.Decompressor
+		Expect(filters[0].Filter.GetTypedConfig().UnmarshalTo(&decompressor)).To(Succeed())
+		Expect(decompressor.GetDecompressorLibrary().GetName()).To(Equal("envoy.compression.zstd.decompressor"))
+		Expect(decompressor.GetDecompressorLibrary().GetTypedConfig().MessageIs(&envoyzstddecompressor.Zstd{})).To(BeTrue())
+		Expect(decompressor.GetRequestDirectionConfig()).To(BeNil())
+		Expect(decompressor.GetResponseDirectionConfig().GetCommonConfig().GetEnabled().GetDefaultValue().GetValue()).To(BeFalse())
+	})
+
+	It("errors when gzip is configured by both the gzip and the compression options", func() {
+		listener.GetOptions().Gzip = &v2.Gzip{}
+		_, err := NewPlugin().HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(MatchError(GzipConflictError))
+	})
+
+	It("allows the gzip option alongside other compressors", func() {
+		settings.Compressors = settings.GetCompressors()[:2]
+		listener.GetOptions().Gzip = &v2.Gzip{}
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(3))
+	})
+
+	It("errors on duplicate algorithms", func() {
+		settings.Compressors = append(settings.GetCompressors(), &compression.Compressor{
+			Algorithm: &compression.Compressor_Zstd{Zstd: &compression.Zstd{}},
+		})
+		_, err := NewPlugin().HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(MatchError(DuplicateCompressorError(ZstdAlgorithm)))
+
+		settings.Compressors = settings.GetCompressors()[:3]
+		settings.GetRequestDecompression().Algorithms = []compression.RequestDecompression_Algorithm{
+			compression.RequestDecompression_GZIP,
+			compression.RequestDecompression_GZIP,
+		}
+		_, err = NewPlugin().HttpFilters(plugins.Params{}, listener)
+		Expect(err).To(MatchError(DuplicateDecompressorError(GzipAlgorithm)))
+	})
+
+	Context("route", func() {
+
+		var (
+			params plugins.RouteParams
+		)
+
+		BeforeEach(func() {
+			listener.GetOptions().Gzip = &v2.Gzip{}
+			settings.Compressors = settings.GetCompressors()[:2]
+			params = plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					HttpListener: listener,
+				},
+			}
+		})
+
+		It("disables every compressor of the listener", func() {
+			out := &envoy_config_route_v3.Route{}
+			err := NewPlugin().ProcessRoute(params, &v1.Route{
+				Options: &v1.RouteOptions{
+					Compression: &compression.CompressionPerRoute{Disable: true},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			disabled, err := utils.MessageToAny(&envoycompressor.CompressorPerRoute{
+				Override: &envoycompressor.CompressorPerRoute_Disabled{Disabled: true},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTypedPerFilterConfig()).To(HaveLen(3))
+			for _, name := range []string{
+				gzip.CompressorFilterName,
+				CompressorFilterPrefix + BrotliAlgorithm,
+				CompressorFilterPrefix + ZstdAlgorithm,
+			} {
+				Expect(out.GetTypedPerFilterConfig()).To(HaveKey(name))
+				Expect(out.GetTypedPerFilterConfig()[name]).To(matchers.MatchProto(disabled))
+			}
+		})
+
+		It("does nothing when compression is not disabled", func() {
+			out := &envoy_config_route_v3.Route{}
+			err := NewPlugin().ProcessRoute(params, &v1.Route{}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package consul
+
+import (
+	"context"
+	"regexp"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+// DefaultConnectServiceName is the name of the service whose Connect identity Gloo uses when none is configured
+const DefaultConnectServiceName = "gloo"
+
+var (
+	ConnectWithSslConfigError = func(upstreamName string) error {
+		return eris.Errorf("upstream %s is Connect-enabled, so it cannot have an sslConfig", upstreamName)
+	}
+)
+
+func (p *plugin) connectServiceName() string {
+	if name := p.consulUpstreamDiscoverySettings.GetConnectServiceName(); name != "" {
+		return name
+	}
+	return DefaultConnectServiceName
+}
+
+// connectCertificates returns the Connect certificates of Gloo, which are fetched once per translation
+func (p *plugin) connectCertificates(ctx context.Context) (*consul.ConnectCertificates, error) {
+	p.connectCertsOnce.Do(func() {
+		p.connectCerts, p.connectCertsErr = consul.FetchConnectCertificates(ctx, p.client, p.connectServiceName())
+	})
+	return p.connectCerts, p.connectCertsErr
+}
+
+// ExternalStateVersion returns the version of the Connect certificates, which are fetched from Consul rather than being
+// part of the snapshot, if an upstream is Connect-enabled. Translations are not memoized if the certificates cannot be fetched.
+func (p *plugin) ExternalStateVersion(params plugins.Params, _ *v1.Proxy) (string, bool) {
+	for _, upstream := range params.Snapshot.Upstreams {
+		if !upstream.GetConsul().GetConnectEnabled() {
+			continue
+		}
+		certs, err := p.connectCertificates(params.Ctx)
+		if err != nil {
+			return "", false
+		}
+		return certs.Version(), true
+	}
+	return "", true
+}
+
+// connectTransportSocket returns the transport socket of the cluster of a Connect-enabled upstream.
+// Gloo presents its Connect leaf certificate to the sidecar proxies of the service, and only accepts their
+// certificate if it carries the SPIFFE id of the service.
+func connectTransportSocket(certs *consul.ConnectCertificates, serviceName string) (*envoy_config_core_v3.TransportSocket, error) {
+	tlsContext := &tlsv3.UpstreamTlsContext{
+		CommonTlsContext: &tlsv3.CommonTlsContext{
+			TlsCertificates: []*tlsv3.TlsCertificate{{
+				CertificateChain: inlineDataSource(certs.CertificateChain),
+				PrivateKey:       inlineDataSource(certs.PrivateKey),
+			}},
+			ValidationContextType: &tlsv3.CommonTlsContext_ValidationContext{
+				ValidationContext: &tlsv3.CertificateValidationContext{
+					TrustedCa: inlineDataSource(certs.RootCertificates),
+					MatchTypedSubjectAltNames: []*tlsv3.SubjectAltNameMatcher{{
+						SanType: tlsv3.SubjectAltNameMatcher_URI,
+						Matcher: &envoy_type_matcher_v3.StringMatcher{
+							MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+								SafeRegex: &envoy_type_matcher_v3.RegexMatcher{
+									Regex: connectServiceSpiffeIdRegex(certs.TrustDomain, serviceName),
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+	typedConfig, err := utils.MessageToAny(tlsContext)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}, nil
+}
+
+// connectServiceSpiffeIdRegex matches the SPIFFE ids Consul issues to the instances of a service, in any namespace
+// and data center, i.e. spiffe://<trust domain>[/ap/<partition>]/ns/<namespace>/dc/<data center>/svc/<service>
+func connectServiceSpiffeIdRegex(trustDomain, serviceName string) string {
+	return "^spiffe://" + regexp.QuoteMeta(trustDomain) + "(/ap/[^/]+)?/ns/[^/]+/dc/[^/]+/svc/" + regexp.QuoteMeta(serviceName) + "$"
+}
+
+func inlineDataSource(value string) *envoy_config_core_v3.DataSource {
+	return &envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: value},
+	}
+}
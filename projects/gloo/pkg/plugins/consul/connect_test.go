@@ -0,0 +1,226 @@
+package consul
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
+	. "github.com/solo-io/gloo/test/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("Consul Connect", func() {
+
+	const (
+		svcName     = "web"
+		trustDomain = "11111111-2222-3333-4444-555555555555.consul"
+	)
+
+	var (
+		ctrl              *gomock.Controller
+		consulWatcherMock *mock_consul.MockConsulWatcher
+
+		roots = &consulapi.CARootList{
+			TrustDomain: trustDomain,
+			Roots: []*consulapi.CARoot{
+				{ID: "root-2", RootCertPEM: "root-cert-2\n"},
+				{ID: "root-1", RootCertPEM: "root-cert-1\n", Active: true},
+			},
+		}
+		leaf = &consulapi.LeafCert{
+			SerialNumber:  "0a:0b",
+			CertPEM:       "leaf-cert",
+			PrivateKeyPEM: "leaf-key",
+			Service:       DefaultConnectServiceName,
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	connectUpstream := func() *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: &core.Metadata{Name: "consul-svc:" + svcName},
+			UpstreamType: &v1.Upstream_Consul{
+				Consul: &consulplugin.UpstreamSpec{
+					ServiceName:    svcName,
+					DataCenters:    []string{"dc1"},
+					ConnectEnabled: true,
+				},
+			},
+		}
+	}
+
+	Describe("ProcessUpstream", func() {
+
+		var plug *plugin
+
+		BeforeEach(func() {
+			plug = NewPlugin(consulWatcherMock, nil, nil)
+			plug.Init(plugins.InitParams{Ctx: context.Background(), Settings: &v1.Settings{}})
+		})
+
+		processUpstream := func(us *v1.Upstream) (*envoy_config_cluster_v3.Cluster, error) {
+			out := &envoy_config_cluster_v3.Cluster{}
+			err := plug.ProcessUpstream(plugins.Params{Ctx: context.Background()}, us, out)
+			return out, err
+		}
+
+		It("does not set a transport socket on upstreams which are not Connect-enabled", func() {
+			us := connectUpstream()
+			us.GetConsul().ConnectEnabled = false
+
+			out, err := processUpstream(us)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTransportSocket()).To(BeNil())
+		})
+
+		It("sets an mTLS transport socket with the Connect certificates of Gloo", func() {
+			consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).Return(roots, nil, nil).Times(1)
+			consulWatcherMock.EXPECT().ConnectCALeaf(DefaultConnectServiceName, gomock.Any()).Return(leaf, nil, nil).Times(1)
+
+			out, err := processUpstream(connectUpstream())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetEdsClusterConfig()).NotTo(BeNil())
+			Expect(out.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketTls))
+
+			tlsContext := &tlsv3.UpstreamTlsContext{}
+			Expect(out.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext)).To(Succeed())
+			commonTlsContext := tlsContext.GetCommonTlsContext()
+			Expect(commonTlsContext.GetTlsCertificates()).To(HaveLen(1))
+			Expect(commonTlsContext.GetTlsCertificates()[0].GetCertificateChain().GetInlineString()).To(Equal("leaf-cert"))
+			Expect(commonTlsContext.GetTlsCertificates()[0].GetPrivateKey().GetInlineString()).To(Equal("leaf-key"))
+
+			validationContext := commonTlsContext.GetValidationContext()
+			Expect(validationContext.GetTrustedCa().GetInlineString()).To(Equal("root-cert-2\nroot-cert-1"))
+			Expect(validationContext.GetMatchTypedSubjectAltNames()).To(HaveLen(1))
+			sanMatcher := validationContext.GetMatchTypedSubjectAltNames()[0]
+			Expect(sanMatcher.GetSanType()).To(Equal(tlsv3.SubjectAltNameMatcher_URI))
+
+			sanRegex := regexp.MustCompile(sanMatcher.GetMatcher().GetSafeRegex().GetRegex())
+			Expect(sanRegex.MatchString("spiffe://" + trustDomain + "/ns/default/dc/dc1/svc/web")).To(BeTrue())
+			Expect(sanRegex.MatchString("spiffe://" + trustDomain + "/ap/part/ns/default/dc/dc1/svc/web")).To(BeTrue())
+			Expect(sanRegex.MatchString("spiffe://" + trustDomain + "/ns/default/dc/dc1/svc/web2")).To(BeFalse())
+			Expect(sanRegex.MatchString("spiffe://other.consul/ns/default/dc/dc1/svc/web")).To(BeFalse())
+		})
+
+		It("fetches the certificates of the configured service once per translation", func() {
+			plug.Init(plugins.InitParams{Ctx: context.Background(), Settings: &v1.Settings{
+				ConsulDiscovery: &v1.Settings_ConsulUpstreamDiscoveryConfiguration{ConnectServiceName: "edge"},
+			}})
+			consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).Return(roots, nil, nil).Times(1)
+			consulWatcherMock.EXPECT().ConnectCALeaf("edge", gomock.Any()).Return(leaf, nil, nil).Times(1)
+
+			for i := 0; i < 2; i++ {
+				_, err := processUpstream(connectUpstream())
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("reports an error when the certificates cannot be fetched", func() {
+			consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).Return(nil, nil, eris.New("agent unavailable")).Times(1)
+
+			_, err := processUpstream(connectUpstream())
+			Expect(err).To(MatchError(ContainSubstring("agent unavailable")))
+		})
+
+		It("reports an error when the upstream also has an sslConfig", func() {
+			us := connectUpstream()
+			us.SslConfig = &ssl.UpstreamSslConfig{Sni: svcName}
+
+			_, err := processUpstream(us)
+			Expect(err).To(MatchError(ConnectWithSslConfigError(us.GetMetadata().GetName())))
+		})
+	})
+
+	Describe("WatchEndpoints", func() {
+
+		var (
+			ctx                 context.Context
+			cancel              context.CancelFunc
+			serviceMetaProducer chan []*consul.ServiceMeta
+			errorProducer       chan error
+			certsProducer       chan *consul.ConnectCertificates
+			certsErrorProducer  chan error
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			serviceMetaProducer = make(chan []*consul.ServiceMeta)
+			errorProducer = make(chan error)
+			certsProducer = make(chan *consul.ConnectCertificates)
+			certsErrorProducer = make(chan error)
+
+			consulWatcherMock.EXPECT().DataCenters().Return([]string{"dc1"}, nil).Times(1)
+			consulWatcherMock.EXPECT().WatchServices(gomock.Any(), []string{"dc1"}, gomock.Any(), gomock.Any()).Return(serviceMetaProducer, errorProducer).Times(1)
+			consulWatcherMock.EXPECT().WatchConnectCertificates(gomock.Any(), DefaultConnectServiceName).Return(certsProducer, certsErrorProducer).Times(1)
+			consulWatcherMock.EXPECT().Service(svcName, "", gomock.Any()).Return([]*consulapi.CatalogService{
+				createTestService("1.1.1.1", "dc1", svcName, svcName, nil, 8080, 100),
+			}, &consulapi.QueryMeta{LastIndex: 1}, nil).AnyTimes()
+			consulWatcherMock.EXPECT().Connect(svcName, "", gomock.Any()).Return([]*consulapi.CatalogService{
+				createTestService("2.2.2.2", "dc1", svcName+consul.ConnectProxySuffix, svcName+consul.ConnectProxySuffix, nil, 21000, 100),
+			}, &consulapi.QueryMeta{LastIndex: 1}, nil).AnyTimes()
+		})
+
+		AfterEach(func() {
+			cancel()
+			close(serviceMetaProducer)
+			close(errorProducer)
+			close(certsProducer)
+			close(certsErrorProducer)
+		})
+
+		It("resolves Connect-enabled upstreams to the sidecar proxies, and republishes them when the certificates rotate", func() {
+			eds := NewPlugin(consulWatcherMock, nil, durationpb.New(time.Hour))
+			endpointsChan, errorChan, err := eds.WatchEndpoints(defaults.GlooSystem, v1.UpstreamList{connectUpstream()}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			serviceMetaProducer <- []*consul.ServiceMeta{{Name: svcName, DataCenters: []string{"dc1"}, ConnectEnabled: true}}
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetAddress()).To(Equal("2.2.2.2"))
+			Expect(endpoints[0].GetPort()).To(BeEquivalentTo(21000))
+			Expect(endpoints[0].GetUpstreams()).To(ConsistOf(connectUpstream().GetMetadata().Ref()))
+			Expect(endpoints[0].GetMetadata().GetAnnotations()).To(HaveKeyWithValue(connectCertificatesAnnotation, ""))
+
+			certs := &consul.ConnectCertificates{SerialNumber: "0a:0b", RootIds: []string{"root-1"}}
+			certsProducer <- certs
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetMetadata().GetAnnotations()).To(HaveKeyWithValue(connectCertificatesAnnotation, certs.Version()))
+
+			// the same certificates do not change the endpoints
+			certsProducer <- certs
+			Consistently(endpointsChan, 100*time.Millisecond).ShouldNot(Receive())
+
+			cancel()
+			Eventually(endpointsChan, DefaultEventuallyTimeout, DefaultEventuallyPollingInterval).Should(BeClosed())
+			Eventually(errorChan, DefaultEventuallyTimeout, DefaultEventuallyPollingInterval).Should(BeClosed())
+		})
+	})
+})
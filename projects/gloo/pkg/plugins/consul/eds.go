@@ -45,6 +45,34 @@ type dataCenterServiceEndpointsTuple struct {
 	endpoints           []*consulapi.CatalogService
 }
 
+// connectServiceSuffix is appended to the name of a service to track its Connect-enabled upstreams, whose endpoints
+// are the Connect sidecar proxies of the service, apart from its other upstreams.
+// Consul service names cannot contain a slash, so the result never collides with the name of a service.
+const connectServiceSuffix = "/connect"
+
+// connectCertificatesAnnotation is set on the endpoints of Connect-enabled upstreams to the version of the Connect
+// certificates of Gloo, so that the rotation of the certificates triggers a new translation.
+const connectCertificatesAnnotation = "consul.gloo.solo.io/connect-certificates"
+
+func connectTrackingKey(serviceName string) string {
+	return serviceName + connectServiceSuffix
+}
+
+func isConnectTrackingKey(key string) bool {
+	return strings.HasSuffix(key, connectServiceSuffix)
+}
+
+// toConnectSpecs tracks the Connect endpoints of the given service under its Connect tracking key
+func toConnectSpecs(serviceName string, specs []*consulapi.CatalogService) []*consulapi.CatalogService {
+	connectSpecs := make([]*consulapi.CatalogService, 0, len(specs))
+	for _, spec := range specs {
+		connectSpec := *spec
+		connectSpec.ServiceName = connectTrackingKey(serviceName)
+		connectSpecs = append(connectSpecs, &connectSpec)
+	}
+	return connectSpecs
+}
+
 // Starts a watch on the Consul service metadata endpoint for all the services associated with the tracked upstreams.
 // Whenever it detects an update to said services, it fetches the complete specs for the tracked services,
 // converts them to endpoints, and sends the result on the returned channel.
@@ -52,11 +80,17 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 
 	// Filter out non-consul upstreams
 	trackedServiceToUpstreams := make(map[string][]*v1.Upstream)
+	hasConnectUpstreams := false
 	for _, us := range upstreamsToTrack {
 		if consulUsSpec := us.GetConsul(); consulUsSpec != nil {
+			key := consulUsSpec.GetServiceName()
+			if consulUsSpec.GetConnectEnabled() {
+				key = connectTrackingKey(key)
+				hasConnectUpstreams = true
+			}
 			// discovery generates one upstream for every Consul service name;
 			// this should only happen if users define duplicate upstreams for a consul service name.
-			trackedServiceToUpstreams[consulUsSpec.GetServiceName()] = append(trackedServiceToUpstreams[consulUsSpec.GetServiceName()], us)
+			trackedServiceToUpstreams[key] = append(trackedServiceToUpstreams[key], us)
 		}
 	}
 
@@ -80,6 +114,19 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 		errutils.AggregateErrs(opts.Ctx, errChan, servicesWatchErrChan, "consul eds")
 	}()
 
+	// the Connect certificates are only watched when there are Connect-enabled upstreams;
+	// receiving from the nil channel otherwise blocks forever
+	var connectCertsChan <-chan *consul.ConnectCertificates
+	if hasConnectUpstreams {
+		var connectCertsErrChan <-chan error
+		connectCertsChan, connectCertsErrChan = p.client.WatchConnectCertificates(opts.Ctx, p.connectServiceName())
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errutils.AggregateErrs(opts.Ctx, errChan, connectCertsErrChan, "consul connect certificates")
+		}()
+	}
+
 	allEndpointsListChan := make(chan v1.EndpointList)
 	wg.Add(1)
 	go func() {
@@ -91,6 +138,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 
 		var previousSpecs []*consulapi.CatalogService
 		var previousHash uint64
+		var connectCertsVersion string
 
 		publishEndpoints := func(endpoints v1.EndpointList) bool {
 			if opts.Ctx.Err() != nil {
@@ -138,7 +186,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 					previousSpecs = specs
 
 					// Build new endpoints from specs and publish if ctx is not cancelled
-					endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams)
+					endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams, connectCertsVersion)
 					currentHash := hashutils.MustHash(endpoints)
 					if previousHash == currentHash {
 						continue
@@ -155,46 +203,53 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 
 				// construct a set of the present services by datacenter
 				dcToCurrentSvcs := map[string]sets.String{}
-				for _, meta := range serviceMeta {
-					for _, dc := range meta.DataCenters {
-						// add to set of datacenter/svc pairs present
-						if _, ok := dcToCurrentSvcs[dc]; !ok {
-							dcToCurrentSvcs[dc] = sets.NewString()
-						}
-						dcToCurrentSvcs[dc].Insert(meta.Name)
-
-						// additionally, if not already a watch for this, create a watch
-						if _, ok := dcEndpointWatches[dc]; !ok {
-							dcEndpointWatches[dc] = svcEndpointWatches{}
-						}
-						if _, ok := dcEndpointWatches[dc][meta.Name]; ok {
-							// watch already exists, don't recreate
-							continue
-						}
-						// watch does not exist, create it
-						ctx, newCancel := context.WithCancel(opts.Ctx)
-						dcEndpointWatches[dc][meta.Name] = &epWatchTuple{
-							endpoints: nil, // intentionally nil until we get the first update
-							cancel:    newCancel,
-						}
+				startWatch := func(dcName, svcName string, connect bool) {
+					key := svcName
+					if connect {
+						key = connectTrackingKey(svcName)
+					}
+					// add to set of datacenter/svc pairs present
+					if _, ok := dcToCurrentSvcs[dcName]; !ok {
+						dcToCurrentSvcs[dcName] = sets.NewString()
+					}
+					dcToCurrentSvcs[dcName].Insert(key)
 
-						// Copy before passing to goroutines!
-						dcName := dc
-						svcName := meta.Name
+					// additionally, if not already a watch for this, create a watch
+					if _, ok := dcEndpointWatches[dcName]; !ok {
+						dcEndpointWatches[dcName] = svcEndpointWatches{}
+					}
+					if _, ok := dcEndpointWatches[dcName][key]; ok {
+						// watch already exists, don't recreate
+						return
+					}
+					// watch does not exist, create it
+					ctx, newCancel := context.WithCancel(opts.Ctx)
+					dcEndpointWatches[dcName][key] = &epWatchTuple{
+						endpoints: nil, // intentionally nil until we get the first update
+						cancel:    newCancel,
+					}
 
-						endpointsChan, epErrChan := p.watchEndpointsInDataCenter(ctx, dcName, svcName, p.consulUpstreamDiscoverySettings.GetConsistencyMode(), p.consulUpstreamDiscoverySettings.GetQueryOptions())
+					endpointsChan, epErrChan := p.watchEndpointsInDataCenter(ctx, dcName, svcName, connect, p.consulUpstreamDiscoverySettings.GetConsistencyMode(), p.consulUpstreamDiscoverySettings.GetQueryOptions())
 
-						// Collect endpoints
-						eg.Go(func() error {
-							aggregateEndpoints(ctx, allEndpointsChan, endpointsChan)
-							return nil
-						})
+					// Collect endpoints
+					eg.Go(func() error {
+						aggregateEndpoints(ctx, allEndpointsChan, endpointsChan)
+						return nil
+					})
 
-						// Collect errors
-						eg.Go(func() error {
-							errutils.AggregateErrs(ctx, errChan, epErrChan, fmt.Sprintf("data center: %s, service: %s", dcName, svcName))
-							return nil
-						})
+					// Collect errors
+					eg.Go(func() error {
+						errutils.AggregateErrs(ctx, errChan, epErrChan, fmt.Sprintf("data center: %s, service: %s", dcName, key))
+						return nil
+					})
+				}
+				for _, meta := range serviceMeta {
+					for _, dc := range meta.DataCenters {
+						startWatch(dc, meta.Name, false)
+						// the sidecar proxies of the service are only watched for Connect-enabled upstreams
+						if _, ok := trackedServiceToUpstreams[connectTrackingKey(meta.Name)]; ok {
+							startWatch(dc, meta.Name, true)
+						}
 					}
 				}
 
@@ -263,7 +318,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 				previousSpecs = specs
 
 				// Build new endpoints from specs and publish if ctx is not cancelled
-				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams)
+				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams, connectCertsVersion)
 				currentHash := hashutils.MustHash(endpoints)
 				if previousHash == currentHash {
 					continue
@@ -281,7 +336,28 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 				}
 
 				// Poll to ensure any DNS updates get picked up in endpoints for EDS
-				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, previousSpecs, trackedServiceToUpstreams)
+				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, previousSpecs, trackedServiceToUpstreams, connectCertsVersion)
+				currentHash := hashutils.MustHash(endpoints)
+				if previousHash == currentHash {
+					continue
+				}
+				previousHash = currentHash
+				if !publishEndpoints(endpoints) {
+					return
+				}
+
+			case connectCerts, ok := <-connectCertsChan:
+				if !ok {
+					return
+				}
+				connectCertsVersion = connectCerts.Version()
+				// as with DNS polling, wait for the first specs to avoid marking EDS as ready too early
+				if len(previousSpecs) == 0 {
+					continue
+				}
+
+				// Republish the endpoints of the Connect-enabled upstreams with the version of the rotated certificates
+				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, previousSpecs, trackedServiceToUpstreams, connectCertsVersion)
 				currentHash := hashutils.MustHash(endpoints)
 				if previousHash == currentHash {
 					continue
@@ -304,7 +380,8 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 }
 
 // Honors the contract of Watch functions to open with an initial read.
-func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svcName string, cm glooConsul.ConsulConsistencyModes, queryOpts *glooConsul.QueryOptions) (<-chan *dataCenterServiceEndpointsTuple, <-chan error) {
+// When connect is true, the endpoints are the Connect sidecar proxies of the service, tracked under its Connect tracking key.
+func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svcName string, connect bool, cm glooConsul.ConsulConsistencyModes, queryOpts *glooConsul.QueryOptions) (<-chan *dataCenterServiceEndpointsTuple, <-chan error) {
 	endpointsChan := make(chan *dataCenterServiceEndpointsTuple)
 	errsChan := make(chan error)
 
@@ -343,7 +420,12 @@ func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svc
 							return nil
 						}
 
-						endpoints, queryMeta, err = p.client.Service(svcName, "", queryOpts.WithContext(ctx))
+						if connect {
+							endpoints, queryMeta, err = p.client.Connect(svcName, "", queryOpts.WithContext(ctx))
+							endpoints = toConnectSpecs(svcName, endpoints)
+						} else {
+							endpoints, queryMeta, err = p.client.Service(svcName, "", queryOpts.WithContext(ctx))
+						}
 						return err
 					},
 					retry.Attempts(6),
@@ -371,6 +453,9 @@ func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svc
 					service:    svcName,
 					endpoints:  endpoints,
 				}
+				if connect {
+					tuple.service = connectTrackingKey(svcName)
+				}
 
 				// Update the last index
 				if queryMeta.LastIndex < lastIndex {
@@ -382,7 +467,11 @@ func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svc
 					lastIndex = queryMeta.LastIndex
 				}
 
-				endpointsChan <- tuple
+				select {
+				case endpointsChan <- tuple:
+				case <-ctx.Done():
+					return
+				}
 			}
 		}
 	}(dataCenter)
@@ -417,34 +506,7 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 	// Get complete service information for every dataCenter:service tuple in separate goroutines
 	var eg errgroup.Group
 	for _, service := range serviceMeta {
-		var cm glooConsul.ConsulConsistencyModes
-		var queryOptions *glooConsul.QueryOptions
-		if upstreams, ok := serviceToUpstream[service.Name]; len(upstreams) > 0 && ok {
-			cm = upstreams[0].GetConsul().GetConsistencyMode()
-			queryOptions = upstreams[0].GetConsul().GetQueryOptions()
-		}
-		// we take the most consistent mode found on any upstream for a service for correctness
-		for _, consulUpstream := range serviceToUpstream[service.Name] {
-			// prefer earlier more restrictive query type (i.e. consistent > default > stale)
-			switch consulUpstream.GetConsul().GetConsistencyMode() {
-			case glooConsul.ConsulConsistencyModes_ConsistentMode:
-				cm = glooConsul.ConsulConsistencyModes_ConsistentMode
-			case glooConsul.ConsulConsistencyModes_DefaultMode:
-				if cm != glooConsul.ConsulConsistencyModes_ConsistentMode {
-					cm = glooConsul.ConsulConsistencyModes_DefaultMode
-				}
-			case glooConsul.ConsulConsistencyModes_StaleMode:
-				if cm != glooConsul.ConsulConsistencyModes_ConsistentMode && cm != glooConsul.ConsulConsistencyModes_DefaultMode {
-					cm = glooConsul.ConsulConsistencyModes_StaleMode
-				}
-			}
-			if queryOptions := consulUpstream.GetConsul().GetQueryOptions(); queryOptions != nil {
-				// if any upstream can't use cache, disable for all
-				if useCache := queryOptions.GetUseCache(); useCache != nil && !useCache.GetValue() {
-					queryOptions.UseCache = useCache
-				}
-			}
-		}
+		cm, queryOptions := upstreamsQueryOptions(serviceToUpstream[service.Name])
 		for _, dataCenter := range service.DataCenters {
 			// Copy iterator variables before passing them to goroutines!
 			svc := service
@@ -467,6 +529,31 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 				return nil
 			})
 		}
+
+		// the sidecar proxies of the service are only queried for Connect-enabled upstreams
+		connectUpstreams := serviceToUpstream[connectTrackingKey(service.Name)]
+		if len(connectUpstreams) == 0 {
+			continue
+		}
+		connectCm, connectQueryOptions := upstreamsQueryOptions(connectUpstreams)
+		for _, dataCenter := range service.DataCenters {
+			svc := service
+			dcName := dataCenter
+
+			eg.Go(func() error {
+				queryOpts := consul.NewConsulCatalogServiceQueryOptions(dcName, connectCm, connectQueryOptions)
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				services, _, err := client.Connect(svc.Name, "", queryOpts.WithContext(ctx))
+				if err != nil {
+					return err
+				}
+				specs.Add(toConnectSpecs(svc.Name, services))
+
+				return nil
+			})
+		}
 	}
 
 	// Wait for all requests to complete, an error to occur, or for the underlying context to be cancelled.
@@ -483,6 +570,40 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 	return specs.Get()
 }
 
+// upstreamsQueryOptions returns the consistency mode and query options to use to query the endpoints of the given
+// upstreams of a service
+func upstreamsQueryOptions(upstreams []*v1.Upstream) (glooConsul.ConsulConsistencyModes, *glooConsul.QueryOptions) {
+	var cm glooConsul.ConsulConsistencyModes
+	var queryOptions *glooConsul.QueryOptions
+	if len(upstreams) > 0 {
+		cm = upstreams[0].GetConsul().GetConsistencyMode()
+		queryOptions = upstreams[0].GetConsul().GetQueryOptions()
+	}
+	// we take the most consistent mode found on any upstream for a service for correctness
+	for _, consulUpstream := range upstreams {
+		// prefer earlier more restrictive query type (i.e. consistent > default > stale)
+		switch consulUpstream.GetConsul().GetConsistencyMode() {
+		case glooConsul.ConsulConsistencyModes_ConsistentMode:
+			cm = glooConsul.ConsulConsistencyModes_ConsistentMode
+		case glooConsul.ConsulConsistencyModes_DefaultMode:
+			if cm != glooConsul.ConsulConsistencyModes_ConsistentMode {
+				cm = glooConsul.ConsulConsistencyModes_DefaultMode
+			}
+		case glooConsul.ConsulConsistencyModes_StaleMode:
+			if cm != glooConsul.ConsulConsistencyModes_ConsistentMode && cm != glooConsul.ConsulConsistencyModes_DefaultMode {
+				cm = glooConsul.ConsulConsistencyModes_StaleMode
+			}
+		}
+		if queryOptions := consulUpstream.GetConsul().GetQueryOptions(); queryOptions != nil {
+			// if any upstream can't use cache, disable for all
+			if useCache := queryOptions.GetUseCache(); useCache != nil && !useCache.GetValue() {
+				queryOptions.UseCache = useCache
+			}
+		}
+	}
+	return cm, queryOptions
+}
+
 // build gloo endpoints out of consul catalog services and gloo upstreams
 // trackedServiceToUpstreams is a map from consul service names to a list of gloo upstreams associated with it.
 // Each spec is a grouping of serviceInstances (aka endpoints) associated with a single consul service on one datacenter.
@@ -490,12 +611,14 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 // using getIpAddresses(), each of which will be labeled to reflect which of its tags/datacenters are associated with that endpoint.
 // This awkward labeling is needed because our constructed endpoints are made on a per datacenter basis, but gloo
 // upstreams are not divided this way, so we have to divide them ourselves with metadata.
+// The endpoints of Connect-enabled upstreams are annotated with connectCertsVersion.
 func buildEndpointsFromSpecs(
 	ctx context.Context,
 	writeNamespace string,
 	resolver DnsResolver,
 	specs []*consulapi.CatalogService,
 	trackedServiceToUpstreams map[string][]*v1.Upstream,
+	connectCertsVersion string,
 ) v1.EndpointList {
 	var endpoints v1.EndpointList
 	for _, spec := range specs {
@@ -503,6 +626,11 @@ func buildEndpointsFromSpecs(
 			if eps, err := buildEndpoints(ctx, writeNamespace, resolver, spec, upstreams); err != nil {
 				contextutils.LoggerFrom(ctx).Warnf("consul eds plugin encountered error resolving DNS for consul service %v", spec, err)
 			} else {
+				if isConnectTrackingKey(spec.ServiceName) {
+					for _, ep := range eps {
+						ep.GetMetadata().Annotations = map[string]string{connectCertificatesAnnotation: connectCertsVersion}
+					}
+				}
 				endpoints = append(endpoints, eps...)
 			}
 		}
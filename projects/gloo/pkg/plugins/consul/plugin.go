@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/solo-io/go-utils/contextutils"
@@ -26,6 +27,8 @@ var (
 	_ discovery.DiscoveryPlugin = new(plugin)
 	_ plugins.UpstreamPlugin    = new(plugin)
 	_ plugins.RouteActionPlugin = new(plugin)
+
+	_ plugins.ExternalStatePlugin = new(plugin)
 )
 
 const (
@@ -49,6 +52,11 @@ type plugin struct {
 	dnsPollingInterval              time.Duration
 	consulUpstreamDiscoverySettings *v1.Settings_ConsulUpstreamDiscoveryConfiguration
 	settings                        *v1.Settings
+
+	// the Connect certificates of Gloo, fetched by the first Connect-enabled upstream of a translation
+	connectCertsOnce sync.Once
+	connectCerts     *consul.ConnectCertificates
+	connectCertsErr  error
 }
 
 func NewPlugin(client consul.ConsulWatcher, resolver DnsResolver, dnsPollingInterval *durationpb.Duration) *plugin {
@@ -125,6 +133,8 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 
 func (p *plugin) Init(params plugins.InitParams) {
 	p.settings = params.Settings
+	p.connectCertsOnce = sync.Once{}
+	p.connectCerts, p.connectCertsErr = nil, nil
 	p.consulUpstreamDiscoverySettings = params.Settings.GetConsulDiscovery()
 	if p.consulUpstreamDiscoverySettings == nil {
 		p.consulUpstreamDiscoverySettings = &v1.Settings_ConsulUpstreamDiscoveryConfiguration{UseTlsTagging: false}
@@ -148,7 +158,7 @@ func (p *plugin) Init(params plugins.InitParams) {
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
-	_, ok := in.GetUpstreamType().(*v1.Upstream_Consul)
+	consulSpec, ok := in.GetUpstreamType().(*v1.Upstream_Consul)
 	if !ok {
 		return nil
 	}
@@ -156,7 +166,20 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	// consul upstreams use EDS
 	xds.SetEdsOnCluster(out, p.settings)
 
-	return nil
+	if !consulSpec.Consul.GetConnectEnabled() {
+		return nil
+	}
+
+	// the endpoints of Connect-enabled upstreams are the sidecar proxies of the service, which require mTLS
+	if in.GetSslConfig() != nil {
+		return ConnectWithSslConfigError(in.GetMetadata().GetName())
+	}
+	certs, err := p.connectCertificates(params.Ctx)
+	if err != nil {
+		return err
+	}
+	out.TransportSocket, err = connectTransportSocket(certs, consulSpec.Consul.GetServiceName())
+	return err
 }
 
 // make sure t1 is a subset of t2
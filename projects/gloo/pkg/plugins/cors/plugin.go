@@ -31,6 +31,8 @@ var (
 	_ plugins.HttpFilterPlugin  = new(plugin)
 	_ plugins.RoutePlugin       = new(plugin)
 	_ plugins.VirtualHostPlugin = new(plugin)
+
+	_ plugins.RouteStatePlugin = new(plugin)
 )
 
 const (
@@ -188,6 +190,14 @@ func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 	return []plugins.StagedHttpFilter{plugins.MustNewStagedFilter(wellknown.CORS, &envoy_config_cors_v3.Cors{}, pluginStage)}, nil
 }
 
+func (p *plugin) RouteState(listener *v1.HttpListener) []string {
+	return pluginutils.ListenerRouteState(p.filterRequiredForListener, listener, pluginutils.FilterRequiredFlag)
+}
+
+func (p *plugin) SetRouteState(listener *v1.HttpListener, flags []string) {
+	pluginutils.SetListenerRouteState(p.filterRequiredForListener, listener, flags, pluginutils.FilterRequiredFlag)
+}
+
 // convert allowOrigin and allowOriginRegex options to a deduplicated slice of strings
 func convertAllowOriginToSlice(corsPolicy *cors.CorsPolicy) []string {
 	exists := struct{}{}
@@ -23,6 +23,8 @@ var (
 	_ plugins.WeightedDestinationPlugin = new(plugin)
 	_ plugins.VirtualHostPlugin         = new(plugin)
 	_ plugins.RoutePlugin               = new(plugin)
+
+	_ plugins.RouteStatePlugin = new(plugin)
 )
 
 const (
@@ -72,6 +74,14 @@ func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plu
 	return []plugins.StagedHttpFilter{csrfFilter}, nil
 }
 
+func (p *plugin) RouteState(listener *v1.HttpListener) []string {
+	return pluginutils.ListenerRouteState(p.filterRequiredForListener, listener, pluginutils.FilterRequiredFlag)
+}
+
+func (p *plugin) SetRouteState(listener *v1.HttpListener, flags []string) {
+	pluginutils.SetListenerRouteState(p.filterRequiredForListener, listener, flags, pluginutils.FilterRequiredFlag)
+}
+
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route.Route) error {
 	csrfPolicy := in.GetOptions().GetCsrf()
 	if csrfPolicy == nil {
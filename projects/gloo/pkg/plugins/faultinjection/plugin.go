@@ -19,6 +19,8 @@ var (
 	_ plugins.Plugin           = new(plugin)
 	_ plugins.HttpFilterPlugin = new(plugin)
 	_ plugins.RoutePlugin      = new(plugin)
+
+	_ plugins.RouteStatePlugin = new(plugin)
 )
 
 // https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/fault_filter
@@ -56,6 +58,14 @@ func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 	return []plugins.StagedHttpFilter{plugins.MustNewStagedFilter(wellknown.Fault, &envoyhttpfault.HTTPFault{}, pluginStage)}, nil
 }
 
+func (p *plugin) RouteState(listener *v1.HttpListener) []string {
+	return pluginutils.ListenerRouteState(p.filterRequiredForListener, listener, pluginutils.FilterRequiredFlag)
+}
+
+func (p *plugin) SetRouteState(listener *v1.HttpListener, flags []string) {
+	pluginutils.SetListenerRouteState(p.filterRequiredForListener, listener, flags, pluginutils.FilterRequiredFlag)
+}
+
 // ProcessRoute will add the desired fault parameters on each given route.
 // There is no higher level configuration of the fault filter so this is where
 // actual functional configuration takes place.
@@ -36,6 +36,8 @@ var (
 	_ plugins.UpstreamPlugin   = new(plugin)
 	_ plugins.RoutePlugin      = new(plugin)
 	_ plugins.HttpFilterPlugin = new(plugin)
+
+	_ plugins.UpstreamStatePlugin = new(plugin)
 )
 
 const (
@@ -47,6 +49,13 @@ var pluginStage = plugins.BeforeStage(plugins.OutAuthStage)
 type plugin struct {
 	recordedUpstreams map[string]*v1.Upstream
 	upstreamServices  []ServicesAndDescriptor
+	// the index of the services of each upstream in upstreamServices
+	upstreamServicesIndex map[string]int
+}
+
+// upstreamState is the state recorded while processing an upstream
+type upstreamState struct {
+	services *ServicesAndDescriptor
 }
 
 type ServicesAndDescriptor struct {
@@ -56,7 +65,8 @@ type ServicesAndDescriptor struct {
 
 func NewPlugin() *plugin {
 	return &plugin{
-		recordedUpstreams: make(map[string]*v1.Upstream),
+		recordedUpstreams:     make(map[string]*v1.Upstream),
+		upstreamServicesIndex: make(map[string]int),
 	}
 }
 
@@ -67,6 +77,7 @@ func (p *plugin) Name() string {
 func (p *plugin) Init(params plugins.InitParams) {
 	p.recordedUpstreams = make(map[string]*v1.Upstream)
 	p.upstreamServices = nil
+	p.upstreamServicesIndex = make(map[string]int)
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
@@ -81,7 +92,7 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	// If the upstream uses the new API we should record that it exists for use in `ProcessRoute` but not make any changes
 	_, ok = upstreamType.GetServiceSpec().GetPluginType().(*glooplugins.ServiceSpec_GrpcJsonTranscoder)
 	if ok {
-		p.recordedUpstreams[in.GetMetadata().Ref().Key()] = in
+		p.recordUpstream(in, nil)
 		return nil
 	}
 	grpcWrapper, ok := upstreamType.GetServiceSpec().GetPluginType().(*glooplugins.ServiceSpec_Grpc)
@@ -116,8 +127,7 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 
 	addWellKnownProtos(descriptors)
 
-	p.recordedUpstreams[in.GetMetadata().Ref().Key()] = in
-	p.upstreamServices = append(p.upstreamServices, ServicesAndDescriptor{
+	p.recordUpstream(in, &ServicesAndDescriptor{
 		Descriptors: descriptors,
 		Spec:        grpcSpec,
 	})
@@ -126,6 +136,34 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+func (p *plugin) recordUpstream(in *v1.Upstream, services *ServicesAndDescriptor) {
+	key := in.GetMetadata().Ref().Key()
+	p.recordedUpstreams[key] = in
+	if services != nil {
+		p.upstreamServicesIndex[key] = len(p.upstreamServices)
+		p.upstreamServices = append(p.upstreamServices, *services)
+	}
+}
+
+func (p *plugin) UpstreamState(in *v1.Upstream) any {
+	key := in.GetMetadata().Ref().Key()
+	if _, ok := p.recordedUpstreams[key]; !ok {
+		return nil
+	}
+	state := &upstreamState{}
+	if i, ok := p.upstreamServicesIndex[key]; ok {
+		services := p.upstreamServices[i]
+		state.services = &services
+	}
+	return state
+}
+
+func (p *plugin) SetUpstreamState(in *v1.Upstream, state any) {
+	if state, ok := state.(*upstreamState); ok {
+		p.recordUpstream(in, state.services)
+	}
+}
+
 func genFullServiceName(packageName, serviceName string) string {
 	if packageName == "" {
 		return serviceName
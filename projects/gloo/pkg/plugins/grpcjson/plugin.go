@@ -3,6 +3,7 @@ package grpcjson
 import (
 	"context"
 	"encoding/base64"
+	"slices"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -113,6 +114,38 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	}
 	return nil
 }
+
+func (p *plugin) UpstreamState(in *v1.Upstream) interface{} {
+	if filter, ok := p.upstreamFilters[in.GetMetadata().Ref().Key()]; ok {
+		return filter
+	}
+	return nil
+}
+
+func (p *plugin) SetUpstreamState(in *v1.Upstream, state interface{}) {
+	if filter, ok := state.(plugins.StagedHttpFilter); ok {
+		p.upstreamFilters[in.GetMetadata().Ref().Key()] = filter
+	}
+}
+
+// the route state flag which is set when a route of the listener is transcoded with the filter of its upstream
+const affectedListenerFlag = "affected-listener"
+
+func (p *plugin) RouteState(listener *v1.HttpListener) []string {
+	if _, ok := p.affectedListeners[listener]; ok {
+		return []string{affectedListenerFlag}
+	}
+	return nil
+}
+
+func (p *plugin) SetRouteState(listener *v1.HttpListener, flags []string) {
+	if slices.Contains(flags, affectedListenerFlag) {
+		p.affectedListeners[listener] = 1
+	} else {
+		delete(p.affectedListeners, listener)
+	}
+}
+
 func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
 	grpcJsonConf := listener.GetOptions().GetGrpcJsonTranscoder()
 	if grpcJsonConf != nil {
@@ -64,7 +64,7 @@ func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plu
 }
 
 func glooToEnvoyCompressor(gzip *v2.Gzip) (*envoycompressor.Compressor, error) {
-	envoyGzip, err := glooToEnvoyGzip(gzip)
+	envoyGzip, err := GlooToEnvoyGzip(gzip)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +90,8 @@ func glooToEnvoyCompressor(gzip *v2.Gzip) (*envoycompressor.Compressor, error) {
 	return envoyCompressor, envoyCompressor.Validate()
 }
 
-func glooToEnvoyGzip(gzip *v2.Gzip) (*envoygzip.Gzip, error) {
+// GlooToEnvoyGzip converts the gzip settings of the gzip option to the settings of the envoy gzip compressor library
+func GlooToEnvoyGzip(gzip *v2.Gzip) (*envoygzip.Gzip, error) {
 	envoyGzip := &envoygzip.Gzip{}
 
 	if gzip.GetMemoryLevel() != nil {
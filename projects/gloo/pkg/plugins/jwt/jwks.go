@@ -0,0 +1,122 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+var InvalidKeyError = eris.New("local jwks key must be a json web key, a json web key set or PEM encoded public keys")
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC keys
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// toJwks converts a local key into the JSON web key set expected by the jwt_authn filter.
+// The key may be a JSON web key set, a single JSON web key, or one or more PEM encoded public keys.
+func toJwks(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "-----BEGIN") {
+		return pemToJwks(key)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(key), &fields); err != nil {
+		return "", InvalidKeyError
+	}
+	if _, ok := fields["keys"]; ok {
+		return key, nil
+	}
+	if _, ok := fields["kty"]; ok {
+		// wrap a single key in a key set
+		return `{"keys":[` + key + `]}`, nil
+	}
+	return "", InvalidKeyError
+}
+
+func pemToJwks(key string) (string, error) {
+	var jwks jsonWebKeySet
+	rest := []byte(key)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		publicKey, err := parsePublicKey(block)
+		if err != nil {
+			return "", err
+		}
+		jwk, err := toJsonWebKey(publicKey)
+		if err != nil {
+			return "", err
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	if len(jwks.Keys) == 0 {
+		return "", InvalidKeyError
+	}
+
+	out, err := json.Marshal(jwks)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func parsePublicKey(block *pem.Block) (any, error) {
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	default:
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	}
+}
+
+func toJsonWebKey(publicKey any) (jsonWebKey, error) {
+	switch typed := publicKey.(type) {
+	case *rsa.PublicKey:
+		return jsonWebKey{
+			Kty: "RSA",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(typed.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(typed.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		byteLen := (typed.Curve.Params().BitSize + 7) / 8
+		return jsonWebKey{
+			Kty: "EC",
+			Use: "sig",
+			Crv: typed.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(typed.X.FillBytes(make([]byte, byteLen))),
+			Y:   base64.RawURLEncoding.EncodeToString(typed.Y.FillBytes(make([]byte, byteLen))),
+		}, nil
+	default:
+		return jsonWebKey{}, eris.Errorf("unsupported public key type %T", publicKey)
+	}
+}
@@ -0,0 +1,422 @@
+package jwt
+
+import (
+	"fmt"
+	"sort"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "jwt"
+
+	// FilterName is the name of the jwt_authn filter which verifies JWTs after the external authentication service
+	FilterName = "envoy.filters.http.jwt_authn"
+	// BeforeExtAuthFilterName is the name of the jwt_authn filter which verifies JWTs before the external authentication service
+	BeforeExtAuthFilterName = FilterName + ".before_ext_auth"
+
+	// JwksSecretKey is the key of the entry in a Secret holding a local JWKS
+	JwksSecretKey = "jwks"
+
+	// the timeout of requests fetching a remote JWKS
+	remoteJwksTimeout = 5
+)
+
+var (
+	// the jwt_authn filters wrap the ext_authz filter, which runs during the AuthNStage
+	beforeExtAuthStage = plugins.BeforeStage(plugins.AuthNStage)
+	afterExtAuthStage  = plugins.AfterStage(plugins.AuthNStage)
+
+	NoJwksError = func(provider string) error {
+		return eris.Errorf("no jwks was specified for jwt provider %s", provider)
+	}
+	UnsupportedClaimAppendError = func(provider, claim string) error {
+		return eris.Errorf("jwt provider %s appends claim %s to a header, which is not supported", provider, claim)
+	}
+)
+
+// stage identifies which of the two jwt_authn filters a configuration applies to
+type stage struct {
+	filterName  string
+	filterStage plugins.HTTPFilterStage
+}
+
+var (
+	beforeExtAuth = stage{filterName: BeforeExtAuthFilterName, filterStage: beforeExtAuthStage}
+	afterExtAuth  = stage{filterName: FilterName, filterStage: afterExtAuthStage}
+)
+
+// The plugin translates the jwt options of VirtualHosts and Routes into the Envoy jwt_authn filter.
+// Providers configured on a VirtualHost or Route are added to the filter under a requirement named after that
+// VirtualHost or Route, and the VirtualHost or Route selects its requirement with per-filter config.
+// Requests to VirtualHosts without jwt options are not verified.
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var filters []plugins.StagedHttpFilter
+	for _, s := range []stage{beforeExtAuth, afterExtAuth} {
+		filterConfig, err := buildFilterConfig(params, listener, s)
+		if err != nil {
+			return nil, err
+		}
+		if filterConfig == nil {
+			continue
+		}
+		filter, err := plugins.NewStagedFilter(s.filterName, filterConfig, s.filterStage)
+		if err != nil {
+			return nil, eris.Wrap(err, "generating filter config")
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	_ plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	for _, s := range []stage{beforeExtAuth, afterExtAuth} {
+		if vhostExtensionForStage(in, s) == nil {
+			continue
+		}
+		perRouteConfig := &envoyjwt.PerRouteConfig{
+			RequirementSpecifier: &envoyjwt.PerRouteConfig_RequirementName{
+				RequirementName: virtualHostRequirementName(in),
+			},
+		}
+		if err := pluginutils.SetVhostPerFilterConfig(out, s.filterName, perRouteConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	for _, s := range []stage{beforeExtAuth, afterExtAuth} {
+		var perRouteConfig *envoyjwt.PerRouteConfig
+		if routeExtensionForStage(in, s).GetDisable() {
+			perRouteConfig = &envoyjwt.PerRouteConfig{
+				RequirementSpecifier: &envoyjwt.PerRouteConfig_Disabled{Disabled: true},
+			}
+		} else if providers := routeProvidersForStage(in, s); providers != nil {
+			requirementName, err := routeRequirementName(params.VirtualHost, providers)
+			if err != nil {
+				return err
+			}
+			perRouteConfig = &envoyjwt.PerRouteConfig{
+				RequirementSpecifier: &envoyjwt.PerRouteConfig_RequirementName{
+					RequirementName: requirementName,
+				},
+			}
+		}
+		if perRouteConfig == nil {
+			continue
+		}
+		if err := pluginutils.SetRoutePerFilterConfig(out, s.filterName, perRouteConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildFilterConfig returns the configuration of the jwt_authn filter for a stage, with the providers and requirements
+// of every VirtualHost and Route on the listener. Returns nil if no jwt options apply to the stage.
+func buildFilterConfig(params plugins.Params, listener *v1.HttpListener, s stage) (*envoyjwt.JwtAuthentication, error) {
+	filterConfig := &envoyjwt.JwtAuthentication{
+		Providers:      map[string]*envoyjwt.JwtProvider{},
+		RequirementMap: map[string]*envoyjwt.JwtRequirement{},
+	}
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if vhostExtension := vhostExtensionForStage(virtualHost, s); vhostExtension != nil {
+			if err := addRequirement(params, filterConfig, virtualHostRequirementName(virtualHost), vhostExtension); err != nil {
+				return nil, err
+			}
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			providers := routeProvidersForStage(route, s)
+			if providers == nil {
+				continue
+			}
+			requirementName, err := routeRequirementName(virtualHost, providers)
+			if err != nil {
+				return nil, err
+			}
+			if err := addRequirement(params, filterConfig, requirementName, providers); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(filterConfig.GetRequirementMap()) == 0 {
+		return nil, nil
+	}
+	return filterConfig, nil
+}
+
+// addRequirement adds the providers of a jwt extension to the filter config, along with a requirement that
+// a JWT is verified by any of them.
+func addRequirement(
+	params plugins.Params,
+	filterConfig *envoyjwt.JwtAuthentication,
+	requirementName string,
+	extension *jwt.VhostExtension,
+) error {
+	var providerNames []string
+	for name := range extension.GetProviders() {
+		providerNames = append(providerNames, name)
+	}
+	sort.Strings(providerNames)
+
+	var requirements []*envoyjwt.JwtRequirement
+	for _, name := range providerNames {
+		envoyProviderName := requirementName + "_" + name
+		provider, err := translateProvider(params, name, extension.GetProviders()[name])
+		if err != nil {
+			return err
+		}
+		filterConfig.GetProviders()[envoyProviderName] = provider
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: envoyProviderName},
+		})
+	}
+
+	switch validationPolicy(extension) {
+	case jwt.VhostExtension_ALLOW_MISSING:
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_AllowMissing{},
+		})
+	case jwt.VhostExtension_ALLOW_MISSING_OR_FAILED:
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_AllowMissingOrFailed{},
+		})
+	}
+
+	switch len(requirements) {
+	case 0:
+		// a requirement with no providers cannot be satisfied, so requests are rejected
+		filterConfig.GetRequirementMap()[requirementName] = &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+				RequiresAny: &envoyjwt.JwtRequirementOrList{},
+			},
+		}
+	case 1:
+		filterConfig.GetRequirementMap()[requirementName] = requirements[0]
+	default:
+		filterConfig.GetRequirementMap()[requirementName] = &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+				RequiresAny: &envoyjwt.JwtRequirementOrList{Requirements: requirements},
+			},
+		}
+	}
+	return nil
+}
+
+func validationPolicy(extension *jwt.VhostExtension) jwt.VhostExtension_ValidationPolicy {
+	//nolint:staticcheck // respect the deprecated field until it is removed
+	if extension.GetAllowMissingOrFailedJwt() {
+		return jwt.VhostExtension_ALLOW_MISSING_OR_FAILED
+	}
+	return extension.GetValidationPolicy()
+}
+
+func translateProvider(params plugins.Params, name string, provider *jwt.Provider) (*envoyjwt.JwtProvider, error) {
+	envoyProvider := &envoyjwt.JwtProvider{
+		Issuer:                 provider.GetIssuer(),
+		Audiences:              provider.GetAudiences(),
+		Forward:                provider.GetKeepToken(),
+		FromParams:             provider.GetTokenSource().GetQueryParams(),
+		ClockSkewSeconds:       provider.GetClockSkewSeconds().GetValue(),
+		FailedStatusInMetadata: provider.GetAttachFailedStatusToMetadata(),
+		// the payload is written to dynamic metadata under the name of the provider, where the rbac plugin matches claims
+		PayloadInMetadata: name,
+	}
+	for _, header := range provider.GetTokenSource().GetHeaders() {
+		envoyProvider.FromHeaders = append(envoyProvider.GetFromHeaders(), &envoyjwt.JwtHeader{
+			Name:        header.GetHeader(),
+			ValuePrefix: header.GetPrefix(),
+		})
+	}
+
+	for _, claimToHeader := range provider.GetClaimsToHeaders() {
+		if claimToHeader.GetAppend() {
+			return nil, UnsupportedClaimAppendError(name, claimToHeader.GetClaim())
+		}
+		envoyProvider.ClaimToHeaders = append(envoyProvider.GetClaimToHeaders(), &envoyjwt.JwtClaimToHeader{
+			ClaimName:  claimToHeader.GetClaim(),
+			HeaderName: claimToHeader.GetHeader(),
+		})
+	}
+	// headers may be used to make routing decisions, so the route must be recomputed once they are added
+	envoyProvider.ClearRouteCache = len(envoyProvider.GetClaimToHeaders()) > 0
+
+	switch jwks := provider.GetJwks().GetJwks().(type) {
+	case *jwt.Jwks_Remote:
+		remoteJwks, err := translateRemoteJwks(params, jwks.Remote)
+		if err != nil {
+			return nil, err
+		}
+		envoyProvider.JwksSourceSpecifier = &envoyjwt.JwtProvider_RemoteJwks{RemoteJwks: remoteJwks}
+	case *jwt.Jwks_Local:
+		localJwks, err := translateLocalJwks(params, jwks.Local)
+		if err != nil {
+			return nil, err
+		}
+		envoyProvider.JwksSourceSpecifier = &envoyjwt.JwtProvider_LocalJwks{
+			LocalJwks: &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: localJwks},
+			},
+		}
+	default:
+		return nil, NoJwksError(name)
+	}
+
+	return envoyProvider, nil
+}
+
+func translateRemoteJwks(params plugins.Params, remoteJwks *jwt.RemoteJwks) (*envoyjwt.RemoteJwks, error) {
+	upstreamRef := remoteJwks.GetUpstreamRef()
+	if _, err := params.Snapshot.Upstreams.Find(upstreamRef.Strings()); err != nil {
+		return nil, pluginutils.NewUpstreamNotFoundErr(upstreamRef)
+	}
+
+	envoyRemoteJwks := &envoyjwt.RemoteJwks{
+		HttpUri: &envoy_config_core_v3.HttpUri{
+			Uri: remoteJwks.GetUrl(),
+			HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+				Cluster: translator.UpstreamToClusterName(upstreamRef),
+			},
+			Timeout: &durationpb.Duration{Seconds: remoteJwksTimeout},
+		},
+		CacheDuration: remoteJwks.GetCacheDuration(),
+	}
+	if asyncFetch := remoteJwks.GetAsyncFetch(); asyncFetch != nil {
+		envoyRemoteJwks.AsyncFetch = &envoyjwt.JwksAsyncFetch{
+			FastListener: asyncFetch.GetFastListener(),
+		}
+	}
+	return envoyRemoteJwks, nil
+}
+
+// translateLocalJwks returns the JWKS of a local key, which is either inline or read from a Secret.
+func translateLocalJwks(params plugins.Params, localJwks *jwt.LocalJwks) (string, error) {
+	key := localJwks.GetKey()
+	if key == "" && localJwks.GetSecretRef() != nil {
+		secretRef := localJwks.GetSecretRef()
+		secret, err := params.Snapshot.Secrets.Find(secretRef.Strings())
+		if err != nil {
+			return "", eris.Wrapf(err, "finding jwks secret %s", secretRef.Key())
+		}
+		var ok bool
+		key, ok = secret.GetHeader().GetHeaders()[JwksSecretKey]
+		if !ok {
+			return "", eris.Errorf("secret %s does not contain a %s entry", secretRef.Key(), JwksSecretKey)
+		}
+	}
+	return toJwks(key)
+}
+
+// virtualHostRequirementName is the name of the requirement for the providers of a VirtualHost
+func virtualHostRequirementName(virtualHost *v1.VirtualHost) string {
+	return virtualHost.GetName()
+}
+
+// routeRequirementName is the name of the requirement for the providers of a Route.
+// Routes are not guaranteed to be named, so the name is derived from the providers.
+func routeRequirementName(virtualHost *v1.VirtualHost, providers *jwt.VhostExtension) (string, error) {
+	hash, err := providers.HashUnique(nil)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s_route_%d", virtualHost.GetName(), hash), nil
+}
+
+// ProviderNames returns the sorted names of the providers which may verify the JWT of a request to a Route on a
+// VirtualHost. The Route is optional.
+func ProviderNames(virtualHost *v1.VirtualHost, route *v1.Route) []string {
+	names := map[string]struct{}{}
+	for _, s := range []stage{beforeExtAuth, afterExtAuth} {
+		for name := range vhostExtensionForStage(virtualHost, s).GetProviders() {
+			names[name] = struct{}{}
+		}
+		if route == nil {
+			continue
+		}
+		for name := range routeProvidersForStage(route, s).GetProviders() {
+			names[name] = struct{}{}
+		}
+	}
+
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+	return sortedNames
+}
+
+func vhostExtensionForStage(virtualHost *v1.VirtualHost, s stage) *jwt.VhostExtension {
+	options := virtualHost.GetOptions()
+	switch s {
+	case beforeExtAuth:
+		return options.GetJwtStaged().GetBeforeExtAuth()
+	case afterExtAuth:
+		if options.GetJwtStaged() != nil {
+			return options.GetJwtStaged().GetAfterExtAuth()
+		}
+		//nolint:staticcheck // the deprecated jwt option runs after ext auth
+		return options.GetJwt()
+	}
+	return nil
+}
+
+func routeExtensionForStage(route *v1.Route, s stage) *jwt.RouteExtension {
+	options := route.GetOptions()
+	switch s {
+	case beforeExtAuth:
+		return options.GetJwtStaged().GetBeforeExtAuth()
+	case afterExtAuth:
+		if options.GetJwtStaged() != nil {
+			return options.GetJwtStaged().GetAfterExtAuth()
+		}
+		//nolint:staticcheck // the deprecated jwt option runs after ext auth
+		return options.GetJwt()
+	}
+	return nil
+}
+
+func routeProvidersForStage(route *v1.Route, s stage) *jwt.VhostExtension {
+	switch s {
+	case beforeExtAuth:
+		return route.GetOptions().GetJwtProvidersStaged().GetBeforeExtAuth()
+	case afterExtAuth:
+		return route.GetOptions().GetJwtProvidersStaged().GetAfterExtAuth()
+	}
+	return nil
+}
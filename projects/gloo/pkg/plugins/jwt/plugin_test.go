@@ -0,0 +1,331 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const jwksKey = `{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`
+
+var _ = Describe("jwt plugin", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		p           plugins.Plugin
+		params      plugins.Params
+		upstream    *v1.Upstream
+		secret      *v1.Secret
+		virtualHost *v1.VirtualHost
+		route       *v1.Route
+	)
+
+	localProvider := func(key string) *jwt.Provider {
+		return &jwt.Provider{
+			Issuer:    "issuer",
+			Audiences: []string{"audience"},
+			Jwks: &jwt.Jwks{
+				Jwks: &jwt.Jwks_Local{Local: &jwt.LocalJwks{Key: key}},
+			},
+		}
+	}
+
+	filterConfig := func(filters []plugins.StagedHttpFilter, name string) *envoyjwt.JwtAuthentication {
+		for _, filter := range filters {
+			if filter.Filter.GetName() != name {
+				continue
+			}
+			msg, err := utils.AnyToMessage(filter.Filter.GetTypedConfig())
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return msg.(*envoyjwt.JwtAuthentication)
+		}
+		Fail("no filter named " + name)
+		return nil
+	}
+
+	perFilterConfig := func(typedPerFilterConfig map[string]*anypb.Any, name string) *envoyjwt.PerRouteConfig {
+		msg, err := utils.AnyToMessage(typedPerFilterConfig[name])
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return msg.(*envoyjwt.PerRouteConfig)
+	}
+
+	httpFilters := func() ([]plugins.StagedHttpFilter, error) {
+		listener := &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{virtualHost}}
+		return p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "jwks-server", Namespace: "gloo-system"},
+		}
+		secret = &v1.Secret{
+			Metadata: &core.Metadata{Name: "jwks", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Header{
+				Header: &v1.HeaderSecret{Headers: map[string]string{JwksSecretKey: jwksKey}},
+			},
+		}
+		params = plugins.Params{
+			Ctx: ctx,
+			Snapshot: &v1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{upstream},
+				Secrets:   v1.SecretList{secret},
+			},
+		}
+
+		route = &v1.Route{Name: "route"}
+		virtualHost = &v1.VirtualHost{
+			Name:    "vhost",
+			Domains: []string{"*"},
+			Routes:  []*v1.Route{route},
+			Options: &v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_JwtStaged{
+					JwtStaged: &jwt.JwtStagedVhostExtension{
+						AfterExtAuth: &jwt.VhostExtension{
+							Providers: map[string]*jwt.Provider{
+								"local": localProvider(jwksKey),
+							},
+						},
+					},
+				},
+			},
+		}
+
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Ctx: ctx})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("does not add filters when jwt is not configured", func() {
+		virtualHost.Options = nil
+		filters, err := httpFilters()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("translates virtual host providers with a local jwks", func() {
+		filters, err := httpFilters()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthNStage)))
+
+		cfg := filterConfig(filters, FilterName)
+		Expect(cfg.GetProviders()).To(HaveKey("vhost_local"))
+		provider := cfg.GetProviders()["vhost_local"]
+		Expect(provider.GetIssuer()).To(Equal("issuer"))
+		Expect(provider.GetAudiences()).To(ConsistOf("audience"))
+		Expect(provider.GetLocalJwks().GetInlineString()).To(Equal(jwksKey))
+		Expect(provider.GetPayloadInMetadata()).To(Equal("local"))
+		Expect(cfg.GetRequirementMap()).To(HaveKeyWithValue("vhost", &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "vhost_local"},
+		}))
+
+		out := &envoy_config_route_v3.VirtualHost{}
+		err = p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, virtualHost, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(perFilterConfig(out.GetTypedPerFilterConfig(), FilterName).GetRequirementName()).To(Equal("vhost"))
+	})
+
+	It("allows missing jwts with the validation policy", func() {
+		virtualHost.GetOptions().GetJwtStaged().GetAfterExtAuth().ValidationPolicy = jwt.VhostExtension_ALLOW_MISSING
+
+		filters, err := httpFilters()
+		Expect(err).NotTo(HaveOccurred())
+		requiresAny := filterConfig(filters, FilterName).GetRequirementMap()["vhost"].GetRequiresAny().GetRequirements()
+		Expect(requiresAny).To(HaveLen(2))
+		Expect(requiresAny[1].GetAllowMissing()).NotTo(BeNil())
+	})
+
+	It("converts a PEM encoded public key into a jwks", func() {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		key := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+		virtualHost.GetOptions().GetJwtStaged().GetAfterExtAuth().Providers["local"] = localProvider(key)
+
+		filters, err := httpFilters()
+		Expect(err).NotTo(HaveOccurred())
+		jwks := filterConfig(filters, FilterName).GetProviders()["vhost_local"].GetLocalJwks().GetInlineString()
+
+		var keySet struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		Expect(json.Unmarshal([]byte(jwks), &keySet)).To(Succeed())
+		Expect(keySet.Keys).To(HaveLen(1))
+		Expect(keySet.Keys[0]).To(HaveKeyWithValue("kty", "RSA"))
+		Expect(keySet.Keys[0]).To(HaveKeyWithValue("e", "AQAB"))
+	})
+
+	It("reads a local jwks from a secret", func() {
+		virtualHost.GetOptions().GetJwtStaged().GetAfterExtAuth().Providers["local"].Jwks = &jwt.Jwks{
+			Jwks: &jwt.Jwks_Local{Local: &jwt.LocalJwks{SecretRef: secret.GetMetadata().Ref()}},
+		}
+
+		filters, err := httpFilters()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filterConfig(filters, FilterName).GetProviders()["vhost_local"].GetLocalJwks().GetInlineString()).To(Equal(jwksKey))
+	})
+
+	It("errors if the jwks secret does not exist", func() {
+		virtualHost.GetOptions().GetJwtStaged().GetAfterExtAuth().Providers["local"].Jwks = &jwt.Jwks{
+			Jwks: &jwt.Jwks_Local{Local: &jwt.LocalJwks{SecretRef: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"}}},
+		}
+
+		_, err := httpFilters()
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("remote jwks", func() {
+
+		BeforeEach(func() {
+			virtualHost.GetOptions().GetJwtStaged().GetAfterExtAuth().Providers["local"].Jwks = &jwt.Jwks{
+				Jwks: &jwt.Jwks_Remote{
+					Remote: &jwt.RemoteJwks{
+						Url:           "https://jwks.example.com/keys",
+						UpstreamRef:   upstream.GetMetadata().Ref(),
+						CacheDuration: &durationpb.Duration{Seconds: 300},
+					},
+				},
+			}
+		})
+
+		It("fetches the jwks from the upstream", func() {
+			filters, err := httpFilters()
+			Expect(err).NotTo(HaveOccurred())
+
+			remoteJwks := filterConfig(filters, FilterName).GetProviders()["vhost_local"].GetRemoteJwks()
+			Expect(remoteJwks.GetHttpUri().GetUri()).To(Equal("https://jwks.example.com/keys"))
+			Expect(remoteJwks.GetHttpUri().GetCluster()).To(Equal(translator.UpstreamToClusterName(upstream.GetMetadata().Ref())))
+			Expect(remoteJwks.GetCacheDuration().GetSeconds()).To(Equal(int64(300)))
+		})
+
+		It("errors if the upstream does not exist", func() {
+			params.Snapshot.Upstreams = nil
+
+			_, err := httpFilters()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("jwks-server"))
+		})
+	})
+
+	Context("claims to headers", func() {
+
+		It("copies claims into headers and clears the route cache", func() {
+			virtualHost.GetOptions().GetJwtStaged().GetAfterExtAuth().Providers["local"].ClaimsToHeaders = []*jwt.ClaimToHeader{{
+				Claim:  "sub",
+				Header: "x-sub",
+			}}
+
+			filters, err := httpFilters()
+			Expect(err).NotTo(HaveOccurred())
+			provider := filterConfig(filters, FilterName).GetProviders()["vhost_local"]
+			Expect(provider.GetClaimToHeaders()).To(ConsistOf(&envoyjwt.JwtClaimToHeader{
+				ClaimName:  "sub",
+				HeaderName: "x-sub",
+			}))
+			Expect(provider.GetClearRouteCache()).To(BeTrue())
+		})
+
+		It("errors if claims are appended to headers", func() {
+			virtualHost.GetOptions().GetJwtStaged().GetAfterExtAuth().Providers["local"].ClaimsToHeaders = []*jwt.ClaimToHeader{{
+				Claim:  "sub",
+				Header: "x-sub",
+				Append: true,
+			}}
+
+			_, err := httpFilters()
+			Expect(err).To(MatchError(UnsupportedClaimAppendError("local", "sub")))
+		})
+	})
+
+	Context("routes", func() {
+
+		processRoute := func() *envoy_config_route_v3.Route {
+			out := &envoy_config_route_v3.Route{}
+			routeParams := plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{Params: params},
+				VirtualHost:       virtualHost,
+			}
+			err := p.(plugins.RoutePlugin).ProcessRoute(routeParams, route, out)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return out
+		}
+
+		It("disables verification on a route", func() {
+			route.Options = &v1.RouteOptions{
+				JwtConfig: &v1.RouteOptions_JwtStaged{
+					JwtStaged: &jwt.JwtStagedRouteExtension{
+						AfterExtAuth: &jwt.RouteExtension{Disable: true},
+					},
+				},
+			}
+
+			out := processRoute()
+			Expect(perFilterConfig(out.GetTypedPerFilterConfig(), FilterName).GetDisabled()).To(BeTrue())
+		})
+
+		It("uses the providers of a route", func() {
+			route.Options = &v1.RouteOptions{
+				JwtConfig: &v1.RouteOptions_JwtProvidersStaged{
+					JwtProvidersStaged: &jwt.JwtStagedRouteProvidersExtension{
+						BeforeExtAuth: &jwt.VhostExtension{
+							Providers: map[string]*jwt.Provider{
+								"route": localProvider(jwksKey),
+							},
+						},
+					},
+				},
+			}
+
+			filters, err := httpFilters()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(2))
+
+			cfg := filterConfig(filters, BeforeExtAuthFilterName)
+			Expect(cfg.GetRequirementMap()).To(HaveLen(1))
+			Expect(filterConfig(filters, FilterName).GetRequirementMap()).To(HaveLen(1))
+
+			out := processRoute()
+			requirementName := perFilterConfig(out.GetTypedPerFilterConfig(), BeforeExtAuthFilterName).GetRequirementName()
+			Expect(cfg.GetRequirementMap()).To(HaveKey(requirementName))
+			Expect(cfg.GetProviders()).To(HaveKey(requirementName + "_route"))
+			Expect(out.GetTypedPerFilterConfig()).NotTo(HaveKey(FilterName))
+		})
+	})
+
+	It("runs the before ext auth filter before ext auth", func() {
+		jwtStaged := virtualHost.GetOptions().GetJwtStaged()
+		jwtStaged.BeforeExtAuth, jwtStaged.AfterExtAuth = jwtStaged.GetAfterExtAuth(), nil
+
+		filters, err := httpFilters()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Filter.GetName()).To(Equal(BeforeExtAuthFilterName))
+		Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.AuthNStage)))
+	})
+})
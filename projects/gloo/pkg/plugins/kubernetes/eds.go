@@ -11,6 +11,7 @@ import (
 
 	errors "github.com/rotisserie/eris"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -161,7 +162,7 @@ func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []str
 }
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
-	var endpointList []*corev1.Endpoints
+	var endpointSliceList []*discoveryv1.EndpointSlice
 	var serviceList []*corev1.Service
 	var podList []*corev1.Pod
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
@@ -184,14 +185,14 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		podList = append(podList, pods...)
 
-		endpoints, err := c.kubeShareFactory.EndpointsLister(ns).List(labels.SelectorFromSet(opts.Selector))
+		endpointSlices, err := c.kubeShareFactory.EndpointSlicesLister(ns).List(labels.SelectorFromSet(opts.Selector))
 		if err != nil {
 			return nil, err
 		}
-		endpointList = append(endpointList, endpoints...)
+		endpointSliceList = append(endpointSliceList, endpointSlices...)
 	}
 
-	eps, warns, errsToLog := FilterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams)
+	eps, warns, errsToLog := FilterEndpoints(ctx, writeNamespace, endpointSliceList, serviceList, podList, c.upstreams)
 
 	warnsToLog = append(warnsToLog, warns...)
 
@@ -323,13 +324,19 @@ func getServiceFromUpstreamSpec(spec *kubeplugin.UpstreamSpec, services []*corev
 	return nil
 }
 
-// FilterEndpoints computes the endpoints for Gloo from the given Kubernetes endpoints, services, and Gloo upstreams.
+// endpointInfo is the state of an endpoint address that is not part of its identity.
+type endpointInfo struct {
+	zone     string
+	draining bool
+}
+
+// FilterEndpoints computes the endpoints for Gloo from the given Kubernetes endpoint slices, services, and Gloo upstreams.
 // It is exported to provide an injection point into our existing EDS solution for the Gloo K8s Gateway integration.
 // It returns the endpoints, warnings, and errors.
 func FilterEndpoints(
 	_ context.Context, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
 	writeNamespace string,
-	kubeEndpoints []*corev1.Endpoints,
+	endpointSlices []*discoveryv1.EndpointSlice,
 	services []*corev1.Service,
 	pods []*corev1.Pod,
 	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
@@ -338,7 +345,7 @@ func FilterEndpoints(
 
 	return computeGlooEndpoints(
 		writeNamespace,
-		kubeEndpoints,
+		endpointSlices,
 		services,
 		podLabelSource,
 		upstreams,
@@ -347,7 +354,7 @@ func FilterEndpoints(
 
 func computeGlooEndpoints(
 	writeNamespace string,
-	kubeEndpoints []*corev1.Endpoints,
+	endpointSlices []*discoveryv1.EndpointSlice,
 	services []*corev1.Service,
 	podLabelSource PodLabelSource,
 	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
@@ -356,6 +363,7 @@ func computeGlooEndpoints(
 
 	var warnsToLog, errorsToLog []string
 	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	endpointsInfo := make(map[Epkey]endpointInfo)
 
 	istioInjectionEnabled, warnings := isIstioInjectionEnabled()
 	if len(warnings) > 0 {
@@ -390,73 +398,135 @@ func computeGlooEndpoints(
 			continue
 		}
 
-		// find each matching endpoint
-		for _, eps := range kubeEndpoints {
-			if eps.Namespace != spec.GetServiceNamespace() || eps.Name != spec.GetServiceName() {
+		// find each matching endpoint slice
+		addressType := primaryAddressType(svc)
+		for _, endpointSlice := range endpointSlices {
+			if endpointSlice.Namespace != spec.GetServiceNamespace() || endpointSlice.Labels[discoveryv1.LabelServiceName] != spec.GetServiceName() {
 				continue
 			}
-			for _, subset := range eps.Subsets {
-				port := findFirstPortInEndpointSubsets(subset, singlePortService, kubeServicePort)
-				if port == 0 {
-					warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpoint %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName(), subset))
-					continue
-				}
-
-				warnings := processSubsetAddresses(subset, spec, podLabelSource, usRef, port, endpointsMap, isHeadlessSvc)
-				warnsToLog = append(warnsToLog, warnings...)
+			// dual-stack services have a slice per ip family, only use the primary family like the Endpoints API did
+			if endpointSlice.AddressType != addressType {
+				continue
 			}
+			port := findPortInEndpointSlice(endpointSlice, singlePortService, kubeServicePort)
+			if port == 0 {
+				warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpointslice %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName(), endpointSlice.Name))
+				continue
+			}
+
+			warnings := processEndpointSlice(endpointSlice, spec, podLabelSource, usRef, port, endpointsMap, endpointsInfo, isHeadlessSvc)
+			warnsToLog = append(warnsToLog, warnings...)
 		}
 	}
 
-	endpoints = generateFilteredEndpointList(endpointsMap, services, podLabelSource, writeNamespace, endpoints, istioInjectionEnabled)
+	endpoints = generateFilteredEndpointList(endpointsMap, endpointsInfo, services, podLabelSource, writeNamespace, endpoints, istioInjectionEnabled)
 
 	return endpoints, warnsToLog, errorsToLog
 }
 
-func processSubsetAddresses(subset corev1.EndpointSubset, spec *kubeplugin.UpstreamSpec, pods PodLabelSource, usRef *core.ResourceRef, port uint32, endpointsMap map[Epkey][]*core.ResourceRef, isHeadlessService bool) []string {
+// primaryAddressType returns the endpoint slice address type of the primary ip family of the service.
+func primaryAddressType(svc *corev1.Service) discoveryv1.AddressType {
+	if len(svc.Spec.IPFamilies) > 0 && svc.Spec.IPFamilies[0] == corev1.IPv6Protocol {
+		return discoveryv1.AddressTypeIPv6
+	}
+	return discoveryv1.AddressTypeIPv4
+}
+
+// endpointConditions returns whether an endpoint should be added, and if so whether it is draining.
+// Ready endpoints are added. Terminating endpoints that are still serving are added as draining,
+// so that Envoy stops sending them new requests but lets their in-flight requests complete.
+func endpointConditions(conditions discoveryv1.EndpointConditions) (bool, bool) {
+	// a nil ready condition should be interpreted as ready
+	if conditions.Ready == nil || *conditions.Ready {
+		return true, false
+	}
+	serving := conditions.Serving != nil && *conditions.Serving
+	terminating := conditions.Terminating != nil && *conditions.Terminating
+	if serving && terminating {
+		return true, true
+	}
+	return false, false
+}
+
+func processEndpointSlice(
+	endpointSlice *discoveryv1.EndpointSlice,
+	spec *kubeplugin.UpstreamSpec,
+	pods PodLabelSource,
+	usRef *core.ResourceRef,
+	port uint32,
+	endpointsMap map[Epkey][]*core.ResourceRef,
+	endpointsInfo map[Epkey]endpointInfo,
+	isHeadlessService bool,
+) []string {
 	var warnings []string
-	for _, addr := range subset.Addresses {
+	for _, endpoint := range endpointSlice.Endpoints {
+		ok, draining := endpointConditions(endpoint.Conditions)
+		if !ok {
+			continue
+		}
+
 		var podName, podNamespace string
-		targetRef := addr.TargetRef
+		targetRef := endpoint.TargetRef
 		if targetRef != nil {
 			if targetRef.Kind == "Pod" {
 				podName = targetRef.Name
 				podNamespace = targetRef.Namespace
 			}
 		}
-		if len(spec.GetSelector()) != 0 {
-			// determine whether labels for the owner of this ip (pod) matches the spec
-			podLabels, err := pods.GetLabelsForIp(addr.IP, podName, podNamespace)
-			if err != nil {
-				// pod not found for IP? what's that about?
-				warnings = append(warnings, fmt.Sprintf("error for upstream %v service %v: %v", usRef.Key(), spec.GetServiceName(), err))
-				continue
+
+		info := endpointInfo{draining: draining}
+		if endpoint.Zone != nil {
+			info.zone = *endpoint.Zone
+		}
+
+		for _, addr := range endpoint.Addresses {
+			if len(spec.GetSelector()) != 0 {
+				// determine whether labels for the owner of this ip (pod) matches the spec
+				podLabels, err := pods.GetLabelsForIp(addr, podName, podNamespace)
+				if err != nil {
+					// pod not found for IP? what's that about?
+					warnings = append(warnings, fmt.Sprintf("error for upstream %v service %v: %v", usRef.Key(), spec.GetServiceName(), err))
+					continue
+				}
+				if !labels.SelectorFromSet(spec.GetSelector()).Matches(labels.Set(podLabels)) {
+					continue
+				}
 			}
-			if !labels.SelectorFromSet(spec.GetSelector()).Matches(labels.Set(podLabels)) {
+			// pod hasn't been assigned address yet
+			if addr == "" {
 				continue
 			}
-			// pod hasn't been assigned address yet
-			if addr.IP == "" {
+			key := Epkey{addr, port, podName, podNamespace, usRef, isHeadlessService}
+			if existing, found := endpointsInfo[key]; found {
+				// the same address can briefly be in several slices of a service; prefer the ready one
+				if !existing.draining || info.draining {
+					continue
+				}
+				endpointsInfo[key] = info
 				continue
 			}
+			endpointsInfo[key] = info
+			copyRef := *usRef
+			endpointsMap[key] = append(endpointsMap[key], &copyRef)
 		}
-		key := Epkey{addr.IP, port, podName, podNamespace, usRef, isHeadlessService}
-		copyRef := *usRef
-		endpointsMap[key] = append(endpointsMap[key], &copyRef)
 	}
 	return warnings
 }
 
-func findFirstPortInEndpointSubsets(subset corev1.EndpointSubset, singlePortService bool, kubeServicePort *corev1.ServicePort) uint32 {
+// findPortInEndpointSlice returns the port of the endpoint slice that matches the service port, or 0 if there is none.
+func findPortInEndpointSlice(endpointSlice *discoveryv1.EndpointSlice, singlePortService bool, kubeServicePort *corev1.ServicePort) uint32 {
 	var port uint32
-	for _, p := range subset.Ports {
+	for _, p := range endpointSlice.Ports {
+		if p.Port == nil {
+			continue
+		}
 		// if the endpoint port is not named, it implies that
 		// the kube service only has a single unnamed port as well.
 		switch {
 		case singlePortService:
-			port = uint32(p.Port)
-		case p.Name == kubeServicePort.Name:
-			port = uint32(p.Port)
+			port = uint32(*p.Port)
+		case p.Name != nil && *p.Name == kubeServicePort.Name:
+			port = uint32(*p.Port)
 			break
 		}
 	}
@@ -465,6 +535,7 @@ func findFirstPortInEndpointSubsets(subset corev1.EndpointSubset, singlePortServ
 
 func generateFilteredEndpointList(
 	endpointsMap map[Epkey][]*core.ResourceRef,
+	endpointsInfo map[Epkey]endpointInfo,
 	services []*corev1.Service,
 	pods PodLabelSource,
 	writeNamespace string,
@@ -493,10 +564,10 @@ func generateFilteredEndpointList(
 		if istioIntegrationEnabled && !addr.IsHeadless {
 			// Istio integration requires assigning endpoints the Kub service VIP rather than pod address
 			service, _ := getServiceForHostname(addr.Address, addr.Name, addr.Namespace, services)
-			ep = createEndpoint(writeNamespace, endpointName, refs, service.Spec.ClusterIP, addr.Port, service.GetObjectMeta().GetLabels()) // TODO: labels may be nil
+			ep = createEndpoint(writeNamespace, endpointName, refs, service.Spec.ClusterIP, addr.Port, service.GetObjectMeta().GetLabels(), nil) // TODO: labels may be nil
 		} else {
 			podLabels, _ := pods.GetLabelsForIp(addr.Address, addr.Name, addr.Namespace)
-			ep = createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, podLabels)
+			ep = createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, podLabels, endpointAnnotations(endpointsInfo[addr]))
 		}
 		endpoints = append(endpoints, ep)
 	}
@@ -508,20 +579,35 @@ func generateFilteredEndpointList(
 	return endpoints
 }
 
-func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, labels map[string]string) *v1.Endpoint {
+func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, labels, annotations map[string]string) *v1.Endpoint {
 	return &v1.Endpoint{
 		Metadata: &core.Metadata{
-			Namespace: namespace,
-			Name:      name,
-			Labels:    labels,
+			Namespace:   namespace,
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
 		},
 		Upstreams: upstreams,
 		Address:   address,
 		Port:      port,
-		// TODO: add locality info
 	}
 }
 
+// endpointAnnotations returns the annotations that carry the zone and health of an endpoint to the translator.
+func endpointAnnotations(info endpointInfo) map[string]string {
+	annotations := map[string]string{}
+	if info.zone != "" {
+		annotations[constants.EndpointZoneAnnotation] = info.zone
+	}
+	if info.draining {
+		annotations[constants.EndpointHealthStatusAnnotation] = constants.EndpointHealthStatusDraining
+	}
+	if len(annotations) == 0 {
+		return nil
+	}
+	return annotations
+}
+
 func getServiceForHostname(hostname string, serviceName, serviceNamespace string, services []*corev1.Service) (*corev1.Service, error) {
 
 	for _, service := range services {
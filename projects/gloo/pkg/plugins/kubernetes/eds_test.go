@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -9,8 +10,10 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/constants"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -113,30 +116,28 @@ var _ = Describe("Eds", func() {
 
 			endpoints, warnsToLog, errorsToLog := FilterEndpoints(ctx, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
 				writeNamespace,
-				[]*corev1.Endpoints{
+				[]*discoveryv1.EndpointSlice{
 					{
 						ObjectMeta: metav1.ObjectMeta{
-							Name:      "bar",
+							Name:      "bar-abcde",
 							Namespace: "foo",
+							Labels:    map[string]string{discoveryv1.LabelServiceName: "bar"},
 						},
-						Subsets: []corev1.EndpointSubset{
+						AddressType: discoveryv1.AddressTypeIPv4,
+						Ports: []discoveryv1.EndpointPort{
 							{
-								Ports: []corev1.EndpointPort{
-									{
-										Port:     9080,
-										Name:     "http",
-										Protocol: "TCP",
-									},
-								},
-								Addresses: []corev1.EndpointAddress{
-									{
-										IP: "10.244.0.14",
-										TargetRef: &corev1.ObjectReference{
-											Kind:      "Pod",
-											Name:      "bar-7d4d7c7b4b-4z5zv",
-											Namespace: "foo",
-										},
-									},
+								Port:     ptr.To[int32](9080),
+								Name:     ptr.To("http"),
+								Protocol: ptr.To(corev1.ProtocolTCP),
+							},
+						},
+						Endpoints: []discoveryv1.Endpoint{
+							{
+								Addresses: []string{"10.244.0.14"},
+								TargetRef: &corev1.ObjectReference{
+									Kind:      "Pod",
+									Name:      "bar-7d4d7c7b4b-4z5zv",
+									Namespace: "foo",
 								},
 							},
 						},
@@ -190,4 +191,126 @@ var _ = Describe("Eds", func() {
 		})
 	})
 
+	Context("EndpointSlices", func() {
+
+		var (
+			writeNamespace = "gloo-system"
+			up             *v1.Upstream
+			svc            *corev1.Service
+		)
+
+		endpointSlice := func(name string, addressType discoveryv1.AddressType, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+			return &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "foo",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "bar"},
+				},
+				AddressType: addressType,
+				Ports:       []discoveryv1.EndpointPort{{Port: ptr.To[int32](8080)}},
+				Endpoints:   endpoints,
+			}
+		}
+
+		filterEndpoints := func(endpointSlices ...*discoveryv1.EndpointSlice) v1.EndpointList {
+			endpoints, _, errorsToLog := FilterEndpoints(ctx, writeNamespace, endpointSlices, []*corev1.Service{svc}, nil,
+				map[*core.ResourceRef]*kubeplugin.UpstreamSpec{up.GetMetadata().Ref(): up.GetKube()})
+			Expect(errorsToLog).To(BeEmpty())
+			return endpoints
+		}
+
+		endpointsByAddress := func(endpoints v1.EndpointList) map[string]*v1.Endpoint {
+			byAddress := map[string]*v1.Endpoint{}
+			for _, ep := range endpoints {
+				byAddress[ep.GetAddress()] = ep
+			}
+			return byAddress
+		}
+
+		BeforeEach(func() {
+			up = v1.NewUpstream(writeNamespace, "foo-bar-8080")
+			up.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubev1.UpstreamSpec{
+					ServiceName:      "bar",
+					ServiceNamespace: "foo",
+					ServicePort:      8080,
+				},
+			}
+			svc = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 8080}},
+				},
+			}
+		})
+
+		It("adds the endpoints of every slice of the service", func() {
+			// the Endpoints API truncates services at 1000 addresses, endpoint slices do not
+			var slices []*discoveryv1.EndpointSlice
+			for i := 0; i < 3; i++ {
+				var endpoints []discoveryv1.Endpoint
+				for j := 0; j < 500; j++ {
+					endpoints = append(endpoints, discoveryv1.Endpoint{Addresses: []string{fmt.Sprintf("10.%d.%d.%d", i, j/250, j%250)}})
+				}
+				slices = append(slices, endpointSlice(fmt.Sprintf("bar-%d", i), discoveryv1.AddressTypeIPv4, endpoints...))
+			}
+			Expect(filterEndpoints(slices...)).To(HaveLen(1500))
+		})
+
+		It("respects the endpoint conditions", func() {
+			endpoints := endpointsByAddress(filterEndpoints(endpointSlice("bar-abcde", discoveryv1.AddressTypeIPv4,
+				discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}},
+				discoveryv1.Endpoint{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+				discoveryv1.Endpoint{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+				discoveryv1.Endpoint{Addresses: []string{"10.0.0.4"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false), Serving: ptr.To(true), Terminating: ptr.To(true)}},
+				discoveryv1.Endpoint{Addresses: []string{"10.0.0.5"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false), Serving: ptr.To(false), Terminating: ptr.To(true)}},
+			)))
+
+			Expect(endpoints).To(HaveLen(3))
+			Expect(endpoints).To(HaveKey("10.0.0.1"))
+			Expect(endpoints).To(HaveKey("10.0.0.2"))
+			Expect(endpoints["10.0.0.2"].GetMetadata().GetAnnotations()).To(BeEmpty())
+			Expect(endpoints).To(HaveKey("10.0.0.4"))
+			Expect(endpoints["10.0.0.4"].GetMetadata().GetAnnotations()).To(HaveKeyWithValue(constants.EndpointHealthStatusAnnotation, constants.EndpointHealthStatusDraining))
+		})
+
+		It("prefers the ready endpoint when an address is in several slices", func() {
+			endpoints := filterEndpoints(
+				endpointSlice("bar-old", discoveryv1.AddressTypeIPv4,
+					discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false), Serving: ptr.To(true), Terminating: ptr.To(true)}}),
+				endpointSlice("bar-new", discoveryv1.AddressTypeIPv4,
+					discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}}),
+			)
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetUpstreams()).To(HaveLen(1))
+			Expect(endpoints[0].GetMetadata().GetAnnotations()).To(BeEmpty())
+		})
+
+		It("keeps the zone", func() {
+			endpoints := filterEndpoints(endpointSlice("bar-abcde", discoveryv1.AddressTypeIPv4,
+				discoveryv1.Endpoint{
+					Addresses: []string{"10.0.0.1"},
+					Zone:      ptr.To("us-east-1a"),
+				},
+			))
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].GetMetadata().GetAnnotations()).To(Equal(map[string]string{
+				constants.EndpointZoneAnnotation: "us-east-1a",
+			}))
+		})
+
+		It("only uses the slices of the primary ip family of the service", func() {
+			ipv4 := endpointSlice("bar-ipv4", discoveryv1.AddressTypeIPv4, discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}})
+			ipv6 := endpointSlice("bar-ipv6", discoveryv1.AddressTypeIPv6, discoveryv1.Endpoint{Addresses: []string{"fd00::1"}})
+			fqdn := endpointSlice("bar-fqdn", discoveryv1.AddressTypeFQDN, discoveryv1.Endpoint{Addresses: []string{"bar.example.com"}})
+
+			Expect(endpointsByAddress(filterEndpoints(ipv4, ipv6, fqdn))).To(HaveKey("10.0.0.1"))
+			Expect(filterEndpoints(ipv4, ipv6, fqdn)).To(HaveLen(1))
+
+			svc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}
+			Expect(endpointsByAddress(filterEndpoints(ipv4, ipv6, fqdn))).To(HaveKey("fd00::1"))
+			Expect(filterEndpoints(ipv4, ipv6, fqdn)).To(HaveLen(1))
+		})
+	})
+
 })
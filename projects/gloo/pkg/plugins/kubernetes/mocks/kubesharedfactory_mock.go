@@ -13,7 +13,7 @@ import (
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
-	v1 "k8s.io/client-go/listers/core/v1"
+	v1 "k8s.io/client-go/listers/discovery/v1"
 )
 
 // MockKubePluginSharedFactory is a mock of KubePluginSharedFactory interface.
@@ -40,18 +40,18 @@ func (m *MockKubePluginSharedFactory) EXPECT() *MockKubePluginSharedFactoryMockR
 	return m.recorder
 }
 
-// EndpointsLister mocks base method.
-func (m *MockKubePluginSharedFactory) EndpointsLister(ns string) v1.EndpointsLister {
+// EndpointSlicesLister mocks base method.
+func (m *MockKubePluginSharedFactory) EndpointSlicesLister(ns string) v1.EndpointSliceLister {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EndpointsLister", ns)
-	ret0, _ := ret[0].(v1.EndpointsLister)
+	ret := m.ctrl.Call(m, "EndpointSlicesLister", ns)
+	ret0, _ := ret[0].(v1.EndpointSliceLister)
 	return ret0
 }
 
-// EndpointsLister indicates an expected call of EndpointsLister.
-func (mr *MockKubePluginSharedFactoryMockRecorder) EndpointsLister(ns any) *gomock.Call {
+// EndpointSlicesLister indicates an expected call of EndpointSlicesLister.
+func (mr *MockKubePluginSharedFactoryMockRecorder) EndpointSlicesLister(ns any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointsLister", reflect.TypeOf((*MockKubePluginSharedFactory)(nil).EndpointsLister), ns)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointSlicesLister", reflect.TypeOf((*MockKubePluginSharedFactory)(nil).EndpointSlicesLister), ns)
 }
 
 // Subscribe mocks base method.
@@ -6,6 +6,7 @@ import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 )
 
 var (
@@ -14,6 +15,8 @@ var (
 	_ plugins.HttpFilterPlugin    = new(plugin)
 	_ plugins.VirtualHostPlugin   = new(plugin)
 	_ plugins.RoutePlugin         = new(plugin)
+
+	_ plugins.RouteStatePlugin = new(plugin)
 )
 
 const (
@@ -116,3 +119,11 @@ func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 		stagedRateLimitFilter,
 	}, nil
 }
+
+func (p *plugin) RouteState(listener *v1.HttpListener) []string {
+	return pluginutils.ListenerRouteState(p.filterRequiredForListener, listener, pluginutils.FilterRequiredFlag)
+}
+
+func (p *plugin) SetRouteState(listener *v1.HttpListener, flags []string) {
+	pluginutils.SetListenerRouteState(p.filterRequiredForListener, listener, flags, pluginutils.FilterRequiredFlag)
+}
@@ -72,6 +72,8 @@ type VirtualHostParams struct {
 type RouteParams struct {
 	VirtualHostParams
 	VirtualHost *v1.VirtualHost
+	// RouteIndex is the position of the route in the routes of the virtual host
+	RouteIndex int
 }
 
 type RouteActionParams struct {
@@ -206,6 +208,44 @@ type UpstreamGeneratedResourcesPlugin interface {
 	) ([]*envoy_config_cluster_v3.Cluster, []*envoy_config_listener_v3.Listener, error)
 }
 
+/*
+	Memoization Plugins
+*/
+
+// UpstreamStatePlugin is implemented by the UpstreamPlugins which record state while processing an upstream, that they
+// later use to process routes or to compute the filters of listeners.
+// Translators which memoize the cluster of an upstream keep the state recorded for the upstream alongside it, and
+// hand it back to the plugin when they reuse the cluster, in place of calling ProcessUpstream.
+type UpstreamStatePlugin interface {
+	UpstreamPlugin
+	// UpstreamState returns the state recorded while processing the upstream, or nil if there is none
+	UpstreamState(in *v1.Upstream) any
+	// SetUpstreamState records the state of the upstream, as returned by UpstreamState
+	SetUpstreamState(in *v1.Upstream, state any)
+}
+
+// RouteStatePlugin is implemented by the plugins which record state while processing the virtual hosts and routes of
+// an HttpListener, that they later use to compute its filters. The state is a set of flags.
+// Translators which memoize the translation of a virtual host keep the flags set while processing it alongside it,
+// and set them again when they reuse the translation, in place of calling the plugin.
+type RouteStatePlugin interface {
+	Plugin
+	// RouteState returns the flags which are set for the listener
+	RouteState(listener *v1.HttpListener) []string
+	// SetRouteState replaces the flags which are set for the listener
+	SetRouteState(listener *v1.HttpListener, flags []string)
+}
+
+// ExternalStatePlugin is implemented by the plugins whose translation depends on state which is not part of the
+// API snapshot, such as certificates or modules fetched from remote sources.
+// Translators only reuse a memoized translation for as long as the version of that state is unchanged.
+type ExternalStatePlugin interface {
+	Plugin
+	// ExternalStateVersion returns the version of the state the proxy is translated with,
+	// and false if the translation of the proxy must not be memoized.
+	ExternalStateVersion(params Params, proxy *v1.Proxy) (string, bool)
+}
+
 // A PluginRegistry is used to provide Plugins to relevant translators
 // Historically, all plugins were passed around as an argument, and each translator
 // would iterate over all plugins, and only apply the relevant ones.
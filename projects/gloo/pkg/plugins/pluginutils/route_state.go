@@ -0,0 +1,28 @@
+package pluginutils
+
+import (
+	"slices"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// FilterRequiredFlag is the route state flag of the plugins which only add their filter to the listeners that have
+// a virtual host or a route which uses it
+const FilterRequiredFlag = "filter-required"
+
+// ListenerRouteState returns the flag of a plugins.RouteStatePlugin which records the listeners in a set
+func ListenerRouteState(listeners map[*v1.HttpListener]struct{}, listener *v1.HttpListener, flag string) []string {
+	if _, ok := listeners[listener]; ok {
+		return []string{flag}
+	}
+	return nil
+}
+
+// SetListenerRouteState sets the flag of a plugins.RouteStatePlugin which records the listeners in a set
+func SetListenerRouteState(listeners map[*v1.HttpListener]struct{}, listener *v1.HttpListener, flags []string, flag string) {
+	if slices.Contains(flags, flag) {
+		listeners[listener] = struct{}{}
+	} else {
+		delete(listeners, listener)
+	}
+}
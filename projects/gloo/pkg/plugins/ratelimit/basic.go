@@ -0,0 +1,135 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// The `ratelimitBasic` option (IngressRateLimit) limits the requests of each authorized user and of each anonymous
+// client address. It is translated to rate limit actions which are sent to the rate limit server in the custom domain,
+// and to the descriptors the rate limit server evaluates them with. Both are generated here so that they always agree.
+const (
+	// requests carrying this header, which is set by the auth server, are limited by `authorizedLimits`,
+	// using its value as the identity of the user
+	UserIdHeader = "x-user-id"
+
+	BasicGenericKey          = "generic_key"
+	BasicHeaderMatchKey      = "header_match"
+	BasicUserIdKey           = "userid"
+	BasicRemoteAddressKey    = "remote_address"
+	BasicAuthorizedValue     = "authorized"
+	BasicAnonymousValue      = "anonymous"
+	basicVirtualHostRouteIdx = -1
+)
+
+// BasicVirtualHostKey identifies the `ratelimitBasic` option of a virtual host
+func BasicVirtualHostKey(proxy *core.ResourceRef, listenerName, virtualHostName string) string {
+	return BasicRouteKey(proxy, listenerName, virtualHostName, basicVirtualHostRouteIdx)
+}
+
+// BasicRouteKey identifies the `ratelimitBasic` option of the route at the given index of a virtual host
+func BasicRouteKey(proxy *core.ResourceRef, listenerName, virtualHostName string, routeIdx int) string {
+	key := fmt.Sprintf("basic:%s.%s:%s:%s", proxy.GetNamespace(), proxy.GetName(), listenerName, virtualHostName)
+	if routeIdx != basicVirtualHostRouteIdx {
+		key = fmt.Sprintf("%s:route-%d", key, routeIdx)
+	}
+	return key
+}
+
+// GenerateBasicRateLimits returns the rate limit actions of a `ratelimitBasic` option identified by key
+func GenerateBasicRateLimits(key string, limits *ratelimit.IngressRateLimit, stage uint32) []*envoy_config_route_v3.RateLimit {
+	userIdPresent := []*envoy_config_route_v3.HeaderMatcher{{
+		Name:                 UserIdHeader,
+		HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+	}}
+	genericKey := &envoy_config_route_v3.RateLimit_Action{
+		ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+			GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{DescriptorValue: key},
+		},
+	}
+
+	var rateLimits []*envoy_config_route_v3.RateLimit
+	if limits.GetAuthorizedLimits() != nil {
+		rateLimits = append(rateLimits, &envoy_config_route_v3.RateLimit{
+			Stage: &wrappers.UInt32Value{Value: stage},
+			Actions: []*envoy_config_route_v3.RateLimit_Action{
+				genericKey,
+				{
+					ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_HeaderValueMatch_{
+						HeaderValueMatch: &envoy_config_route_v3.RateLimit_Action_HeaderValueMatch{
+							DescriptorValue: BasicAuthorizedValue,
+							ExpectMatch:     &wrappers.BoolValue{Value: true},
+							Headers:         userIdPresent,
+						},
+					},
+				},
+				{
+					ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{
+						RequestHeaders: &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+							HeaderName:    UserIdHeader,
+							DescriptorKey: BasicUserIdKey,
+						},
+					},
+				},
+			},
+		})
+	}
+	if limits.GetAnonymousLimits() != nil {
+		rateLimits = append(rateLimits, &envoy_config_route_v3.RateLimit{
+			Stage: &wrappers.UInt32Value{Value: stage},
+			Actions: []*envoy_config_route_v3.RateLimit_Action{
+				genericKey,
+				{
+					ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_HeaderValueMatch_{
+						HeaderValueMatch: &envoy_config_route_v3.RateLimit_Action_HeaderValueMatch{
+							DescriptorValue: BasicAnonymousValue,
+							ExpectMatch:     &wrappers.BoolValue{Value: false},
+							Headers:         userIdPresent,
+						},
+					},
+				},
+				{
+					ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RemoteAddress_{
+						RemoteAddress: &envoy_config_route_v3.RateLimit_Action_RemoteAddress{},
+					},
+				},
+			},
+		})
+	}
+	return rateLimits
+}
+
+// GenerateBasicDescriptor returns the descriptor the rate limit server evaluates the actions generated by
+// GenerateBasicRateLimits with
+func GenerateBasicDescriptor(key string, limits *ratelimit.IngressRateLimit) *solo_rl.Descriptor {
+	descriptor := &solo_rl.Descriptor{
+		Key:   BasicGenericKey,
+		Value: key,
+	}
+	if authorized := limits.GetAuthorizedLimits(); authorized != nil {
+		descriptor.Descriptors = append(descriptor.GetDescriptors(), &solo_rl.Descriptor{
+			Key:   BasicHeaderMatchKey,
+			Value: BasicAuthorizedValue,
+			Descriptors: []*solo_rl.Descriptor{{
+				Key:       BasicUserIdKey,
+				RateLimit: authorized,
+			}},
+		})
+	}
+	if anonymous := limits.GetAnonymousLimits(); anonymous != nil {
+		descriptor.Descriptors = append(descriptor.GetDescriptors(), &solo_rl.Descriptor{
+			Key:   BasicHeaderMatchKey,
+			Value: BasicAnonymousValue,
+			Descriptors: []*solo_rl.Descriptor{{
+				Key:       BasicRemoteAddressKey,
+				RateLimit: anonymous,
+			}},
+		})
+	}
+	return descriptor
+}
@@ -0,0 +1,136 @@
+package ratelimit_test
+
+import (
+	"context"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	ratelimitpb "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Basic rate limits", func() {
+
+	var (
+		basic         *ratelimitpb.IngressRateLimit
+		proxy         *gloov1.Proxy
+		virtualHost   *gloov1.VirtualHost
+		vhostParams   plugins.VirtualHostParams
+		rlPlugin      plugins.RoutePlugin
+		expectedVhKey string
+	)
+
+	BeforeEach(func() {
+		basic = &ratelimitpb.IngressRateLimit{
+			AuthorizedLimits: &solo_rl.RateLimit{Unit: solo_rl.RateLimit_MINUTE, RequestsPerUnit: 100},
+			AnonymousLimits:  &solo_rl.RateLimit{Unit: solo_rl.RateLimit_SECOND, RequestsPerUnit: 2},
+		}
+		virtualHost = &gloov1.VirtualHost{
+			Name: "gloo-system_default",
+			Routes: []*gloov1.Route{
+				{Action: &gloov1.Route_DirectResponseAction{}},
+				{Options: &gloov1.RouteOptions{RatelimitBasic: basic}},
+				{Options: &gloov1.RouteOptions{RatelimitBasic: basic}},
+			},
+			Options: &gloov1.VirtualHostOptions{RatelimitBasic: basic},
+		}
+		listener := &gloov1.Listener{
+			Name:         "listener-::-8080",
+			ListenerType: &gloov1.Listener_HttpListener{HttpListener: &gloov1.HttpListener{VirtualHosts: []*gloov1.VirtualHost{virtualHost}}},
+		}
+		proxy = &gloov1.Proxy{
+			Metadata:  &core.Metadata{Name: "gateway-proxy", Namespace: "gloo-system"},
+			Listeners: []*gloov1.Listener{listener},
+		}
+		vhostParams = plugins.VirtualHostParams{
+			Params:       plugins.Params{Ctx: context.Background()},
+			Proxy:        proxy,
+			Listener:     listener,
+			HttpListener: listener.GetHttpListener(),
+		}
+		expectedVhKey = "basic:gloo-system.gateway-proxy:listener-::-8080:gloo-system_default"
+
+		p := NewPlugin()
+		p.Init(plugins.InitParams{Settings: &gloov1.Settings{}})
+		rlPlugin = p
+	})
+
+	It("generates the rate limit actions of virtual hosts", func() {
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := rlPlugin.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.GetRateLimits()).To(HaveLen(2))
+		authorized := out.GetRateLimits()[0]
+		Expect(authorized.GetStage().GetValue()).To(Equal(CustomStage))
+		Expect(authorized.GetActions()).To(HaveLen(3))
+		Expect(authorized.GetActions()[0].GetGenericKey().GetDescriptorValue()).To(Equal(expectedVhKey))
+		Expect(authorized.GetActions()[1].GetHeaderValueMatch().GetDescriptorValue()).To(Equal(BasicAuthorizedValue))
+		Expect(authorized.GetActions()[1].GetHeaderValueMatch().GetExpectMatch().GetValue()).To(BeTrue())
+		Expect(authorized.GetActions()[2].GetRequestHeaders().GetHeaderName()).To(Equal(UserIdHeader))
+		Expect(authorized.GetActions()[2].GetRequestHeaders().GetDescriptorKey()).To(Equal(BasicUserIdKey))
+
+		anonymous := out.GetRateLimits()[1]
+		Expect(anonymous.GetActions()).To(HaveLen(3))
+		Expect(anonymous.GetActions()[0].GetGenericKey().GetDescriptorValue()).To(Equal(expectedVhKey))
+		Expect(anonymous.GetActions()[1].GetHeaderValueMatch().GetDescriptorValue()).To(Equal(BasicAnonymousValue))
+		Expect(anonymous.GetActions()[1].GetHeaderValueMatch().GetExpectMatch().GetValue()).To(BeFalse())
+		Expect(anonymous.GetActions()[2].GetRemoteAddress()).NotTo(BeNil())
+	})
+
+	It("only generates the actions of the configured limits", func() {
+		basic.AuthorizedLimits = nil
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := rlPlugin.(plugins.VirtualHostPlugin).ProcessVirtualHost(vhostParams, virtualHost, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.GetRateLimits()).To(HaveLen(1))
+		Expect(out.GetRateLimits()[0].GetActions()[1].GetHeaderValueMatch().GetDescriptorValue()).To(Equal(BasicAnonymousValue))
+	})
+
+	It("generates the rate limit actions of routes, keyed by their index in the virtual host", func() {
+		out := &envoy_config_route_v3.Route{Action: &envoy_config_route_v3.Route_Route{Route: &envoy_config_route_v3.RouteAction{}}}
+		routeParams := plugins.RouteParams{VirtualHostParams: vhostParams, VirtualHost: virtualHost, RouteIndex: 1}
+		err := rlPlugin.ProcessRoute(routeParams, virtualHost.GetRoutes()[1], out)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.GetRoute().GetRateLimits()).To(HaveLen(2))
+		for _, rl := range out.GetRoute().GetRateLimits() {
+			Expect(rl.GetActions()[0].GetGenericKey().GetDescriptorValue()).To(Equal(expectedVhKey + ":route-1"))
+		}
+	})
+
+	It("does not share the counters of identical routes", func() {
+		out := &envoy_config_route_v3.Route{Action: &envoy_config_route_v3.Route_Route{Route: &envoy_config_route_v3.RouteAction{}}}
+		routeParams := plugins.RouteParams{VirtualHostParams: vhostParams, VirtualHost: virtualHost, RouteIndex: 2}
+		err := rlPlugin.ProcessRoute(routeParams, virtualHost.GetRoutes()[2], out)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, rl := range out.GetRoute().GetRateLimits() {
+			Expect(rl.GetActions()[0].GetGenericKey().GetDescriptorValue()).To(Equal(expectedVhKey + ":route-2"))
+		}
+	})
+
+	It("generates descriptors matching the actions", func() {
+		descriptor := GenerateBasicDescriptor(expectedVhKey, basic)
+		Expect(descriptor.GetKey()).To(Equal(BasicGenericKey))
+		Expect(descriptor.GetValue()).To(Equal(expectedVhKey))
+		Expect(descriptor.GetDescriptors()).To(HaveLen(2))
+
+		authorized := descriptor.GetDescriptors()[0]
+		Expect(authorized.GetKey()).To(Equal(BasicHeaderMatchKey))
+		Expect(authorized.GetValue()).To(Equal(BasicAuthorizedValue))
+		Expect(authorized.GetDescriptors()[0].GetKey()).To(Equal(BasicUserIdKey))
+		Expect(authorized.GetDescriptors()[0].GetRateLimit()).To(Equal(basic.GetAuthorizedLimits()))
+
+		anonymous := descriptor.GetDescriptors()[1]
+		Expect(anonymous.GetValue()).To(Equal(BasicAnonymousValue))
+		Expect(anonymous.GetDescriptors()[0].GetKey()).To(Equal(BasicRemoteAddressKey))
+		Expect(anonymous.GetDescriptors()[0].GetRateLimit()).To(Equal(basic.GetAnonymousLimits()))
+	})
+})
@@ -71,31 +71,47 @@ func (p *plugin) ProcessVirtualHost(
 	in *v1.VirtualHost,
 	out *envoy_config_route_v3.VirtualHost,
 ) error {
+	serverSettings := p.getServerSettingsForListener(params.HttpListener)
+	rateLimitStage := GetRateLimitStageForServerSettings(serverSettings)
+	var err error
 	if newRateLimits := in.GetOptions().GetRatelimit().GetRateLimits(); len(newRateLimits) > 0 {
-		serverSettings := p.getServerSettingsForListener(params.HttpListener)
-		rateLimitStage := GetRateLimitStageForServerSettings(serverSettings)
-		var err error
 		out.RateLimits, err = toEnvoyRateLimits(params.Ctx, newRateLimits, rateLimitStage)
-		return err
 	}
-	return nil
+	if basic := in.GetOptions().GetRatelimitBasic(); basic != nil {
+		key := BasicVirtualHostKey(params.Proxy.GetMetadata().Ref(), params.Listener.GetName(), in.GetName())
+		out.RateLimits = append(out.GetRateLimits(), GenerateBasicRateLimits(key, basic, rateLimitStage)...)
+	}
+	return err
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
-	if rateLimits := in.GetOptions().GetRatelimit(); rateLimits != nil {
-		if ra := out.GetRoute(); ra != nil {
-			serverSettings := p.getServerSettingsForListener(params.HttpListener)
-			rateLimitStage := GetRateLimitStageForServerSettings(serverSettings)
-			var err error
-			ra.RateLimits, err = toEnvoyRateLimits(params.Ctx, rateLimits.GetRateLimits(), rateLimitStage)
-			ra.IncludeVhRateLimits = &wrappers.BoolValue{Value: rateLimits.GetIncludeVhRateLimits()}
-			return err
-		} else {
+	rateLimits := in.GetOptions().GetRatelimit()
+	basic := in.GetOptions().GetRatelimitBasic()
+	ra := out.GetRoute()
+	if ra == nil {
+		if rateLimits != nil {
 			// TODO(yuval-k): maybe return nil here instead and just log a warning?
 			return fmt.Errorf("cannot apply rate limits without a route action")
 		}
+		// like the other options of route actions, basic rate limits do not apply to redirects and direct responses
+		return nil
+	}
+	if rateLimits == nil && basic == nil {
+		return nil
+	}
+
+	serverSettings := p.getServerSettingsForListener(params.HttpListener)
+	rateLimitStage := GetRateLimitStageForServerSettings(serverSettings)
+	var err error
+	if rateLimits != nil {
+		ra.RateLimits, err = toEnvoyRateLimits(params.Ctx, rateLimits.GetRateLimits(), rateLimitStage)
+		ra.IncludeVhRateLimits = &wrappers.BoolValue{Value: rateLimits.GetIncludeVhRateLimits()}
+	}
+	if basic != nil {
+		key := BasicRouteKey(params.Proxy.GetMetadata().Ref(), params.Listener.GetName(), params.VirtualHost.GetName(), params.RouteIndex)
+		ra.RateLimits = append(ra.GetRateLimits(), GenerateBasicRateLimits(key, basic, rateLimitStage)...)
 	}
-	return nil
+	return err
 }
 
 func (p *plugin) getServerSettingsForListener(listener *v1.HttpListener) *ratelimit.Settings {
@@ -0,0 +1,178 @@
+package rbac
+
+import (
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoynetworkrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin              = new(plugin)
+	_ plugins.HttpFilterPlugin    = new(plugin)
+	_ plugins.NetworkFilterPlugin = new(plugin)
+	_ plugins.VirtualHostPlugin   = new(plugin)
+	_ plugins.RoutePlugin         = new(plugin)
+)
+
+const (
+	ExtensionName           = "rbac"
+	FilterName              = "envoy.filters.http.rbac"
+	NetworkFilterName       = "envoy.filters.network.rbac"
+	NetworkFilterStatPrefix = "network_rbac"
+)
+
+var (
+	// RBAC runs after the JWT of the request is verified, so that its claims can be matched
+	filterStage = plugins.DuringStage(plugins.AuthZStage)
+	// For the network filter, connections are rejected before they are counted by the connection limit filter
+	networkFilterStage = plugins.DuringStage(plugins.AuthZStage)
+)
+
+// The plugin translates the rbac options of VirtualHosts and Routes into per-route configuration of the Envoy
+// RBAC http filter, and the rbac options of TcpListeners into the Envoy RBAC network filter.
+type plugin struct {
+	requireRbac bool
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.requireRbac = params.Settings.GetRbac().GetRequireRbac()
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if !p.requireRbac && !isRbacConfiguredOnListener(listener) {
+		return nil, nil
+	}
+
+	filterConfig := &envoyrbac.RBAC{}
+	if p.requireRbac {
+		// allow rules without policies deny every request which is not covered by the config of a VirtualHost or Route
+		filterConfig.Rules = &envoy_config_rbac_v3.RBAC{Action: envoy_config_rbac_v3.RBAC_ALLOW}
+	}
+
+	filter, err := plugins.NewStagedFilter(FilterName, filterConfig, filterStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *plugin) NetworkFiltersHTTP(_ plugins.Params, _ *v1.HttpListener) ([]plugins.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+func (p *plugin) NetworkFiltersTCP(params plugins.Params, listener *v1.TcpListener) ([]plugins.StagedNetworkFilter, error) {
+	settings := listener.GetOptions().GetRbac()
+	if settings == nil || settings.GetDisable() {
+		return nil, nil
+	}
+
+	rules, err := translateRules(params.Ctx, settings, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	filterConfig := &envoynetworkrbac.RBAC{StatPrefix: NetworkFilterStatPrefix}
+	if settings.GetShadow() {
+		filterConfig.ShadowRules = rules
+	} else {
+		filterConfig.Rules = rules
+	}
+
+	marshalledConf, err := utils.MessageToAny(filterConfig)
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedNetworkFilter{
+		{
+			Filter: &envoy_config_listener_v3.Filter{
+				Name: NetworkFilterName,
+				ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{
+					TypedConfig: marshalledConf,
+				},
+			},
+			Stage: networkFilterStage,
+		},
+	}, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	settings := in.GetOptions().GetRbac()
+	if settings == nil {
+		return nil
+	}
+	perRouteConfig, err := translatePerRouteConfig(params.Params, settings, jwt.ProviderNames(in, nil))
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	settings := in.GetOptions().GetRbac()
+	if settings == nil {
+		return nil
+	}
+	perRouteConfig, err := translatePerRouteConfig(params.Params, settings, jwt.ProviderNames(params.VirtualHost, in))
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+// translatePerRouteConfig returns the RBAC config which replaces the config of the filter for a VirtualHost or Route.
+func translatePerRouteConfig(
+	params plugins.Params,
+	settings *rbac.ExtensionSettings,
+	jwtProviders []string,
+) (*envoyrbac.RBACPerRoute, error) {
+	if settings.GetDisable() {
+		// the filter is disabled for VirtualHosts and Routes without an RBAC config
+		return &envoyrbac.RBACPerRoute{}, nil
+	}
+
+	rules, err := translateRules(params.Ctx, settings, jwtProviders, false)
+	if err != nil {
+		return nil, err
+	}
+	filterConfig := &envoyrbac.RBAC{}
+	if settings.GetShadow() {
+		filterConfig.ShadowRules = rules
+	} else {
+		filterConfig.Rules = rules
+	}
+	return &envoyrbac.RBACPerRoute{Rbac: filterConfig}, nil
+}
+
+func isRbacConfiguredOnListener(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if virtualHost.GetOptions().GetRbac() != nil {
+			return true
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetRbac() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,323 @@
+package rbac_test
+
+import (
+	"context"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoynetworkrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("rbac plugin", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		p           plugins.Plugin
+		params      plugins.Params
+		settings    *rbac.ExtensionSettings
+		virtualHost *v1.VirtualHost
+		route       *v1.Route
+	)
+
+	processVirtualHost := func() *envoy_config_route_v3.VirtualHost {
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, virtualHost, out)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return out
+	}
+
+	perRouteRules := func(out *envoy_config_route_v3.VirtualHost) *envoyrbac.RBACPerRoute {
+		msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return msg.(*envoyrbac.RBACPerRoute)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		params = plugins.Params{Ctx: ctx}
+
+		settings = &rbac.ExtensionSettings{
+			Policies: map[string]*rbac.Policy{
+				"admins": {
+					Principals: []*rbac.Principal{{
+						SourceIps: []string{"10.0.0.0/8"},
+						Headers:   []*matchers.HeaderMatcher{{Name: "x-admin", Value: "true"}},
+					}},
+					Permissions: &rbac.Permissions{
+						PathPrefix: "/admin",
+						Methods:    []string{"GET", "POST"},
+					},
+				},
+			},
+		}
+		route = &v1.Route{Name: "route"}
+		virtualHost = &v1.VirtualHost{
+			Name:    "vhost",
+			Domains: []string{"*"},
+			Routes:  []*v1.Route{route},
+			Options: &v1.VirtualHostOptions{Rbac: settings},
+		}
+
+		p = NewPlugin()
+		p.Init(plugins.InitParams{Ctx: ctx, Settings: &v1.Settings{}})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("http filter", func() {
+
+		It("does not add the filter when rbac is not configured", func() {
+			virtualHost.Options = nil
+			filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{virtualHost}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("adds a filter without rules when rbac is configured", func() {
+			filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{virtualHost}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].Filter.GetName()).To(Equal(FilterName))
+			Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.AuthZStage)))
+
+			msg, err := utils.AnyToMessage(filters[0].Filter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.(*envoyrbac.RBAC).GetRules()).To(BeNil())
+		})
+
+		It("denies all requests by default when rbac is required", func() {
+			p.Init(plugins.InitParams{Ctx: ctx, Settings: &v1.Settings{Rbac: &rbac.Settings{RequireRbac: true}}})
+			virtualHost.Options = nil
+
+			filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{virtualHost}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+
+			msg, err := utils.AnyToMessage(filters[0].Filter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			rules := msg.(*envoyrbac.RBAC).GetRules()
+			Expect(rules.GetAction()).To(Equal(envoy_config_rbac_v3.RBAC_ALLOW))
+			Expect(rules.GetPolicies()).To(BeEmpty())
+		})
+	})
+
+	Context("virtual hosts", func() {
+
+		It("translates source ip, header, path and method policies", func() {
+			rules := perRouteRules(processVirtualHost()).GetRbac().GetRules()
+			Expect(rules.GetAction()).To(Equal(envoy_config_rbac_v3.RBAC_ALLOW))
+			Expect(rules.GetPolicies()).To(HaveKey("admins"))
+
+			policy := rules.GetPolicies()["admins"]
+			Expect(policy.GetPrincipals()).To(HaveLen(1))
+			ids := policy.GetPrincipals()[0].GetAndIds().GetIds()
+			Expect(ids).To(HaveLen(2))
+			Expect(ids[0].GetRemoteIp()).To(Equal(&envoy_config_core_v3.CidrRange{
+				AddressPrefix: "10.0.0.0",
+				PrefixLen:     wrapperspb.UInt32(8),
+			}))
+			Expect(ids[1].GetHeader().GetName()).To(Equal("x-admin"))
+			Expect(ids[1].GetHeader().GetExactMatch()).To(Equal("true"))
+
+			Expect(policy.GetPermissions()).To(HaveLen(1))
+			permissions := policy.GetPermissions()[0].GetAndRules().GetRules()
+			Expect(permissions).To(HaveLen(2))
+			Expect(permissions[0].GetUrlPath().GetPath().GetPrefix()).To(Equal("/admin"))
+			Expect(permissions[1].GetOrRules().GetRules()).To(HaveLen(2))
+			Expect(permissions[1].GetOrRules().GetRules()[0].GetHeader().GetName()).To(Equal(":method"))
+		})
+
+		It("matches any request for policies without principals or permissions", func() {
+			settings.Policies = map[string]*rbac.Policy{"all": {}}
+
+			policy := perRouteRules(processVirtualHost()).GetRbac().GetRules().GetPolicies()["all"]
+			Expect(policy.GetPrincipals()[0].GetAny()).To(BeTrue())
+			Expect(policy.GetPermissions()[0].GetAny()).To(BeTrue())
+		})
+
+		It("denies matching requests with the deny action", func() {
+			settings.Action = rbac.ExtensionSettings_DENY
+
+			rules := perRouteRules(processVirtualHost()).GetRbac().GetRules()
+			Expect(rules.GetAction()).To(Equal(envoy_config_rbac_v3.RBAC_DENY))
+		})
+
+		It("only audits policies in shadow mode", func() {
+			settings.Shadow = true
+
+			perRoute := perRouteRules(processVirtualHost())
+			Expect(perRoute.GetRbac().GetRules()).To(BeNil())
+			Expect(perRoute.GetRbac().GetShadowRules().GetPolicies()).To(HaveKey("admins"))
+		})
+
+		It("disables the filter", func() {
+			settings.Disable = true
+
+			Expect(perRouteRules(processVirtualHost()).GetRbac()).To(BeNil())
+		})
+
+		It("errors on invalid source ips", func() {
+			settings.GetPolicies()["admins"].GetPrincipals()[0].SourceIps = []string{"not-an-ip"}
+
+			out := &envoy_config_route_v3.VirtualHost{}
+			err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, virtualHost, out)
+			Expect(err).To(MatchError(InvalidSourceIpError("admins", "not-an-ip")))
+		})
+	})
+
+	Context("jwt principals", func() {
+
+		BeforeEach(func() {
+			virtualHost.GetOptions().JwtConfig = &v1.VirtualHostOptions_JwtStaged{
+				JwtStaged: &jwt.JwtStagedVhostExtension{
+					AfterExtAuth: &jwt.VhostExtension{
+						Providers: map[string]*jwt.Provider{"okta": {}},
+					},
+				},
+			}
+			settings.Policies = map[string]*rbac.Policy{
+				"users": {
+					NestedClaimDelimiter: ".",
+					Principals: []*rbac.Principal{{
+						JwtPrincipal: &rbac.JWTPrincipal{
+							Claims: map[string]string{"org.team": "gloo"},
+						},
+					}},
+				},
+			}
+		})
+
+		It("matches claims in the metadata of the jwt providers", func() {
+			principal := perRouteRules(processVirtualHost()).GetRbac().GetRules().GetPolicies()["users"].GetPrincipals()[0]
+
+			metadata := principal.GetMetadata()
+			Expect(metadata.GetFilter()).To(Equal("envoy.filters.http.jwt_authn"))
+			var path []string
+			for _, segment := range metadata.GetPath() {
+				path = append(path, segment.GetKey())
+			}
+			Expect(path).To(Equal([]string{"okta", "org", "team"}))
+			Expect(metadata.GetValue().GetStringMatch().GetExact()).To(Equal("gloo"))
+		})
+
+		It("matches list claims", func() {
+			settings.GetPolicies()["users"].GetPrincipals()[0].GetJwtPrincipal().Matcher = rbac.JWTPrincipal_LIST_CONTAINS
+
+			principal := perRouteRules(processVirtualHost()).GetRbac().GetRules().GetPolicies()["users"].GetPrincipals()[0]
+			Expect(principal.GetMetadata().GetValue().GetListMatch().GetOneOf()).To(Equal(&envoy_type_matcher_v3.ValueMatcher{
+				MatchPattern: &envoy_type_matcher_v3.ValueMatcher_StringMatch{
+					StringMatch: &envoy_type_matcher_v3.StringMatcher{
+						MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: "gloo"},
+					},
+				},
+			}))
+		})
+
+		It("errors when no jwt provider is configured", func() {
+			virtualHost.GetOptions().JwtConfig = nil
+
+			out := &envoy_config_route_v3.VirtualHost{}
+			err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, virtualHost, out)
+			Expect(err).To(MatchError(NoJwtProviderError("users")))
+		})
+	})
+
+	Context("routes", func() {
+
+		It("overrides the virtual host config on a route", func() {
+			route.Options = &v1.RouteOptions{Rbac: &rbac.ExtensionSettings{Disable: true}}
+
+			out := &envoy_config_route_v3.Route{}
+			routeParams := plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{Params: params},
+				VirtualHost:       virtualHost,
+			}
+			err := p.(plugins.RoutePlugin).ProcessRoute(routeParams, route, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.(*envoyrbac.RBACPerRoute).GetRbac()).To(BeNil())
+		})
+	})
+
+	Context("tcp listeners", func() {
+
+		var tcpListener *v1.TcpListener
+
+		BeforeEach(func() {
+			tcpListener = &v1.TcpListener{
+				Options: &v1.TcpListenerOptions{
+					Rbac: &rbac.ExtensionSettings{
+						Policies: map[string]*rbac.Policy{
+							"internal": {
+								Principals: []*rbac.Principal{{SourceIps: []string{"192.168.1.1", "10.0.0.0/8"}}},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("adds a network rbac filter", func() {
+			filters, err := p.(plugins.NetworkFilterPlugin).NetworkFiltersTCP(params, tcpListener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].Filter.GetName()).To(Equal(NetworkFilterName))
+
+			msg, err := utils.AnyToMessage(filters[0].Filter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			filterConfig := msg.(*envoynetworkrbac.RBAC)
+			Expect(filterConfig.GetStatPrefix()).To(Equal(NetworkFilterStatPrefix))
+
+			ids := filterConfig.GetRules().GetPolicies()["internal"].GetPrincipals()[0].GetOrIds().GetIds()
+			Expect(ids).To(HaveLen(2))
+			Expect(ids[0].GetDirectRemoteIp()).To(Equal(&envoy_config_core_v3.CidrRange{
+				AddressPrefix: "192.168.1.1",
+				PrefixLen:     wrapperspb.UInt32(32),
+			}))
+			Expect(ids[1].GetDirectRemoteIp().GetAddressPrefix()).To(Equal("10.0.0.0"))
+		})
+
+		It("uses shadow rules in shadow mode", func() {
+			tcpListener.GetOptions().GetRbac().Shadow = true
+
+			filters, err := p.(plugins.NetworkFilterPlugin).NetworkFiltersTCP(params, tcpListener)
+			Expect(err).NotTo(HaveOccurred())
+			msg, err := utils.AnyToMessage(filters[0].Filter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.(*envoynetworkrbac.RBAC).GetRules()).To(BeNil())
+			Expect(msg.(*envoynetworkrbac.RBAC).GetShadowRules().GetPolicies()).To(HaveKey("internal"))
+		})
+
+		It("errors on http only fields", func() {
+			tcpListener.GetOptions().GetRbac().GetPolicies()["internal"].Permissions = &rbac.Permissions{PathPrefix: "/"}
+
+			_, err := p.(plugins.NetworkFilterPlugin).NetworkFiltersTCP(params, tcpListener)
+			Expect(err).To(MatchError(UnsupportedOnTcpError("internal", "path_prefix")))
+		})
+
+		It("does not add a filter without rbac options", func() {
+			filters, err := p.(plugins.NetworkFilterPlugin).NetworkFiltersTCP(params, &v1.TcpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,432 @@
+package rbac
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const methodHeader = ":method"
+
+var (
+	NoJwtProviderError = func(policy string) error {
+		return eris.Errorf("policy %s has a jwt principal, but no jwt providers are configured", policy)
+	}
+	InvalidSourceIpError = func(policy, sourceIp string) error {
+		return eris.Errorf("policy %s has an invalid source ip %s", policy, sourceIp)
+	}
+	InvalidBooleanClaimError = func(policy, claim, value string) error {
+		return eris.Errorf("policy %s matches claim %s with the invalid boolean %s", policy, claim, value)
+	}
+	UnsupportedOnTcpError = func(policy, field string) error {
+		return eris.Errorf("policy %s uses %s, which is not supported on tcp listeners", policy, field)
+	}
+)
+
+// translateRules translates the policies of the rbac options into Envoy RBAC rules. Requests to a VirtualHost or Route
+// may be verified by any of the given jwt providers. Only source ips are supported in policies of tcp listeners.
+func translateRules(
+	ctx context.Context,
+	settings *rbac.ExtensionSettings,
+	jwtProviders []string,
+	tcp bool,
+) (*envoy_config_rbac_v3.RBAC, error) {
+	rules := &envoy_config_rbac_v3.RBAC{
+		Action:   envoy_config_rbac_v3.RBAC_ALLOW,
+		Policies: map[string]*envoy_config_rbac_v3.Policy{},
+	}
+	if settings.GetAction() == rbac.ExtensionSettings_DENY {
+		rules.Action = envoy_config_rbac_v3.RBAC_DENY
+	}
+
+	policyNames := make([]string, 0, len(settings.GetPolicies()))
+	for name := range settings.GetPolicies() {
+		policyNames = append(policyNames, name)
+	}
+	sort.Strings(policyNames)
+
+	for _, name := range policyNames {
+		policy, err := translatePolicy(ctx, name, settings.GetPolicies()[name], jwtProviders, tcp)
+		if err != nil {
+			return nil, err
+		}
+		rules.GetPolicies()[name] = policy
+	}
+	return rules, nil
+}
+
+func translatePolicy(
+	ctx context.Context,
+	name string,
+	policy *rbac.Policy,
+	jwtProviders []string,
+	tcp bool,
+) (*envoy_config_rbac_v3.Policy, error) {
+	permission, err := translatePermissions(name, policy.GetPermissions(), tcp)
+	if err != nil {
+		return nil, err
+	}
+
+	var principals []*envoy_config_rbac_v3.Principal
+	for _, principal := range policy.GetPrincipals() {
+		envoyPrincipal, err := translatePrincipal(ctx, name, policy, principal, jwtProviders, tcp)
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, envoyPrincipal)
+	}
+	if len(principals) == 0 {
+		principals = append(principals, anyPrincipal())
+	}
+
+	return &envoy_config_rbac_v3.Policy{
+		Permissions: []*envoy_config_rbac_v3.Permission{permission},
+		Principals:  principals,
+	}, nil
+}
+
+// translatePermissions returns a permission which matches requests satisfying all of the permissions
+func translatePermissions(policyName string, permissions *rbac.Permissions, tcp bool) (*envoy_config_rbac_v3.Permission, error) {
+	var rules []*envoy_config_rbac_v3.Permission
+
+	if prefix := permissions.GetPathPrefix(); prefix != "" {
+		if tcp {
+			return nil, UnsupportedOnTcpError(policyName, "path_prefix")
+		}
+		rules = append(rules, &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_UrlPath{
+				UrlPath: &envoy_type_matcher_v3.PathMatcher{
+					Rule: &envoy_type_matcher_v3.PathMatcher_Path{
+						Path: &envoy_type_matcher_v3.StringMatcher{
+							MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: prefix},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	if methods := permissions.GetMethods(); len(methods) > 0 {
+		if tcp {
+			return nil, UnsupportedOnTcpError(policyName, "methods")
+		}
+		var methodRules []*envoy_config_rbac_v3.Permission
+		for _, method := range methods {
+			methodRules = append(methodRules, &envoy_config_rbac_v3.Permission{
+				Rule: &envoy_config_rbac_v3.Permission_Header{
+					Header: &envoy_config_route_v3.HeaderMatcher{
+						Name: methodHeader,
+						HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+							ExactMatch: method,
+						},
+					},
+				},
+			})
+		}
+		rules = append(rules, orPermissions(methodRules))
+	}
+
+	switch len(rules) {
+	case 0:
+		return &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_Any{Any: true},
+		}, nil
+	case 1:
+		return rules[0], nil
+	default:
+		return &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_AndRules{
+				AndRules: &envoy_config_rbac_v3.Permission_Set{Rules: rules},
+			},
+		}, nil
+	}
+}
+
+// translatePrincipal returns a principal which matches requests satisfying all of the fields of the principal
+func translatePrincipal(
+	ctx context.Context,
+	policyName string,
+	policy *rbac.Policy,
+	principal *rbac.Principal,
+	jwtProviders []string,
+	tcp bool,
+) (*envoy_config_rbac_v3.Principal, error) {
+	var ids []*envoy_config_rbac_v3.Principal
+
+	if jwtPrincipal := principal.GetJwtPrincipal(); jwtPrincipal != nil {
+		if tcp {
+			return nil, UnsupportedOnTcpError(policyName, "jwt_principal")
+		}
+		id, err := translateJwtPrincipal(ctx, policyName, policy.GetNestedClaimDelimiter(), jwtPrincipal, jwtProviders)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if sourceIps := principal.GetSourceIps(); len(sourceIps) > 0 {
+		var sourceIpIds []*envoy_config_rbac_v3.Principal
+		for _, sourceIp := range sourceIps {
+			cidrRange, err := toCidrRange(sourceIp)
+			if err != nil {
+				return nil, InvalidSourceIpError(policyName, sourceIp)
+			}
+			if tcp {
+				sourceIpIds = append(sourceIpIds, &envoy_config_rbac_v3.Principal{
+					Identifier: &envoy_config_rbac_v3.Principal_DirectRemoteIp{DirectRemoteIp: cidrRange},
+				})
+			} else {
+				sourceIpIds = append(sourceIpIds, &envoy_config_rbac_v3.Principal{
+					Identifier: &envoy_config_rbac_v3.Principal_RemoteIp{RemoteIp: cidrRange},
+				})
+			}
+		}
+		ids = append(ids, orPrincipals(sourceIpIds))
+	}
+
+	if headers := principal.GetHeaders(); len(headers) > 0 {
+		if tcp {
+			return nil, UnsupportedOnTcpError(policyName, "headers")
+		}
+		for _, header := range envoyHeaderMatcher(ctx, headers) {
+			ids = append(ids, &envoy_config_rbac_v3.Principal{
+				Identifier: &envoy_config_rbac_v3.Principal_Header{Header: header},
+			})
+		}
+	}
+
+	return andPrincipals(ids), nil
+}
+
+// translateJwtPrincipal returns a principal which matches the claims of a JWT verified by one of the providers.
+// The jwt plugin writes the payload of verified JWTs to dynamic metadata under the name of the provider.
+func translateJwtPrincipal(
+	ctx context.Context,
+	policyName string,
+	nestedClaimDelimiter string,
+	jwtPrincipal *rbac.JWTPrincipal,
+	jwtProviders []string,
+) (*envoy_config_rbac_v3.Principal, error) {
+	providers := jwtProviders
+	if provider := jwtPrincipal.GetProvider(); provider != "" {
+		providers = []string{provider}
+	}
+	if len(providers) == 0 {
+		return nil, NoJwtProviderError(policyName)
+	}
+
+	claims := make([]string, 0, len(jwtPrincipal.GetClaims()))
+	for claim := range jwtPrincipal.GetClaims() {
+		claims = append(claims, claim)
+	}
+	sort.Strings(claims)
+
+	var providerIds []*envoy_config_rbac_v3.Principal
+	for _, provider := range providers {
+		if len(claims) == 0 {
+			// any JWT verified by the provider matches
+			providerIds = append(providerIds, metadataPrincipal([]string{provider}, &envoy_type_matcher_v3.ValueMatcher{
+				MatchPattern: &envoy_type_matcher_v3.ValueMatcher_PresentMatch{PresentMatch: true},
+			}))
+			continue
+		}
+
+		var claimIds []*envoy_config_rbac_v3.Principal
+		for _, claim := range claims {
+			value, err := claimValueMatcher(ctx, policyName, claim, jwtPrincipal.GetClaims()[claim], jwtPrincipal.GetMatcher())
+			if err != nil {
+				return nil, err
+			}
+			path := []string{provider}
+			if nestedClaimDelimiter != "" {
+				path = append(path, strings.Split(claim, nestedClaimDelimiter)...)
+			} else {
+				path = append(path, claim)
+			}
+			claimIds = append(claimIds, metadataPrincipal(path, value))
+		}
+		providerIds = append(providerIds, andPrincipals(claimIds))
+	}
+	return orPrincipals(providerIds), nil
+}
+
+func claimValueMatcher(
+	ctx context.Context,
+	policyName, claim, value string,
+	matcher rbac.JWTPrincipal_ClaimMatcher,
+) (*envoy_type_matcher_v3.ValueMatcher, error) {
+	switch matcher {
+	case rbac.JWTPrincipal_BOOLEAN:
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, InvalidBooleanClaimError(policyName, claim, value)
+		}
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_BoolMatch{BoolMatch: boolValue},
+		}, nil
+	case rbac.JWTPrincipal_LIST_CONTAINS:
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_ListMatch{
+				ListMatch: &envoy_type_matcher_v3.ListMatcher{
+					MatchPattern: &envoy_type_matcher_v3.ListMatcher_OneOf{
+						OneOf: exactValueMatcher(value),
+					},
+				},
+			},
+		}, nil
+	case rbac.JWTPrincipal_REGEX_MATCH:
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+						SafeRegex: regexutils.NewRegex(ctx, value),
+					},
+				},
+			},
+		}, nil
+	default:
+		return exactValueMatcher(value), nil
+	}
+}
+
+func exactValueMatcher(value string) *envoy_type_matcher_v3.ValueMatcher {
+	return &envoy_type_matcher_v3.ValueMatcher{
+		MatchPattern: &envoy_type_matcher_v3.ValueMatcher_StringMatch{
+			StringMatch: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: value},
+			},
+		},
+	}
+}
+
+func metadataPrincipal(path []string, value *envoy_type_matcher_v3.ValueMatcher) *envoy_config_rbac_v3.Principal {
+	var segments []*envoy_type_matcher_v3.MetadataMatcher_PathSegment
+	for _, key := range path {
+		segments = append(segments, &envoy_type_matcher_v3.MetadataMatcher_PathSegment{
+			Segment: &envoy_type_matcher_v3.MetadataMatcher_PathSegment_Key{Key: key},
+		})
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_Metadata{
+			Metadata: &envoy_type_matcher_v3.MetadataMatcher{
+				Filter: jwt.FilterName,
+				Path:   segments,
+				Value:  value,
+			},
+		},
+	}
+}
+
+// toCidrRange parses a CIDR range, or a single address which is treated as a range containing only that address
+func toCidrRange(sourceIp string) (*envoy_config_core_v3.CidrRange, error) {
+	if !strings.Contains(sourceIp, "/") {
+		ip := net.ParseIP(sourceIp)
+		if ip == nil {
+			return nil, eris.Errorf("invalid ip %s", sourceIp)
+		}
+		prefixLen := uint32(net.IPv6len * 8)
+		if ip.To4() != nil {
+			prefixLen = net.IPv4len * 8
+		}
+		return &envoy_config_core_v3.CidrRange{
+			AddressPrefix: sourceIp,
+			PrefixLen:     wrapperspb.UInt32(prefixLen),
+		}, nil
+	}
+
+	ip, ipNet, err := net.ParseCIDR(sourceIp)
+	if err != nil {
+		return nil, err
+	}
+	prefixLen, _ := ipNet.Mask.Size()
+	return &envoy_config_core_v3.CidrRange{
+		AddressPrefix: ip.String(),
+		PrefixLen:     wrapperspb.UInt32(uint32(prefixLen)),
+	}, nil
+}
+
+func envoyHeaderMatcher(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
+	var out []*envoy_config_route_v3.HeaderMatcher
+	for _, matcher := range in {
+		envoyMatch := &envoy_config_route_v3.HeaderMatcher{
+			Name: matcher.GetName(),
+		}
+		if matcher.GetValue() == "" {
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		} else {
+			if matcher.GetRegex() {
+				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+					SafeRegexMatch: regexutils.NewRegex(ctx, matcher.GetValue()),
+				}
+			} else {
+				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+					ExactMatch: matcher.GetValue(),
+				}
+			}
+		}
+
+		if matcher.GetInvertMatch() {
+			envoyMatch.InvertMatch = true
+		}
+		out = append(out, envoyMatch)
+	}
+	return out
+}
+
+func anyPrincipal() *envoy_config_rbac_v3.Principal {
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_Any{Any: true},
+	}
+}
+
+func andPrincipals(ids []*envoy_config_rbac_v3.Principal) *envoy_config_rbac_v3.Principal {
+	switch len(ids) {
+	case 0:
+		return anyPrincipal()
+	case 1:
+		return ids[0]
+	default:
+		return &envoy_config_rbac_v3.Principal{
+			Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+				AndIds: &envoy_config_rbac_v3.Principal_Set{Ids: ids},
+			},
+		}
+	}
+}
+
+func orPrincipals(ids []*envoy_config_rbac_v3.Principal) *envoy_config_rbac_v3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_OrIds{
+			OrIds: &envoy_config_rbac_v3.Principal_Set{Ids: ids},
+		},
+	}
+}
+
+func orPermissions(rules []*envoy_config_rbac_v3.Permission) *envoy_config_rbac_v3.Permission {
+	if len(rules) == 1 {
+		return rules[0]
+	}
+	return &envoy_config_rbac_v3.Permission{
+		Rule: &envoy_config_rbac_v3.Permission_OrRules{
+			OrRules: &envoy_config_rbac_v3.Permission_Set{Rules: rules},
+		},
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/buffer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/compression"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/connection_limit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
@@ -34,6 +35,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio_automtls"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio_integration"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/linkerd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/listener"
@@ -44,6 +46,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/protocoloptions"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/proxyprotocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
@@ -55,6 +58,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tunneling"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamconn"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/virtualhost"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
@@ -77,6 +81,9 @@ type PluginOpts struct {
 	// If plugins run in GGv2/KRT, then we will have these collections.
 	// it is currently used for the k8s plugin. in the future plugins may own their own collections.
 	SvcCollection krt.Collection[*corev1.Service]
+	// Holds the Wasm modules fetched from images, which the REST xDS server serves to the proxies.
+	// Wasm filters which load their module from an image are rejected if it is nil.
+	WasmModules *wasm.ModuleCache
 }
 
 func FromBootstrap(opts bootstrap.Opts) PluginOpts {
@@ -87,6 +94,7 @@ func FromBootstrap(opts bootstrap.Opts) PluginOpts {
 		Consul:                  opts.Consul,
 		KubeClient:              opts.KubeClient,
 		KubeCoreCache:           opts.KubeCoreCache,
+		WasmModules:             opts.WasmModules,
 	}
 }
 
@@ -135,8 +143,12 @@ func Plugins(opts PluginOpts) []plugins.Plugin {
 		headers.NewPlugin(),
 		healthcheck.NewPlugin(),
 		extauth.NewPlugin(),
+		jwt.NewPlugin(),
+		rbac.NewPlugin(),
+		wasm.NewPlugin(opts.WasmModules),
 		ratelimit.NewPlugin(),
 		gzip.NewPlugin(),
+		compression.NewPlugin(),
 		buffer.NewPlugin(),
 		csrf.NewPlugin(),
 		listener.NewPlugin(),
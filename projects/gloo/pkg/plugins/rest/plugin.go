@@ -20,6 +20,8 @@ var (
 	_ plugins.Plugin         = new(plugin)
 	_ plugins.UpstreamPlugin = new(plugin)
 	_ plugins.RoutePlugin    = new(plugin)
+
+	_ plugins.UpstreamStatePlugin = new(plugin)
 )
 
 const (
@@ -73,6 +75,19 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, _ *envo
 	return nil
 }
 
+func (p *plugin) UpstreamState(in *v1.Upstream) any {
+	if restServiceSpec, ok := p.recordedUpstreams[in.GetMetadata().Ref().Key()]; ok {
+		return restServiceSpec
+	}
+	return nil
+}
+
+func (p *plugin) SetUpstreamState(in *v1.Upstream, state any) {
+	if restServiceSpec, ok := state.(*glooplugins.ServiceSpec_Rest); ok {
+		p.recordedUpstreams[in.GetMetadata().Ref().Key()] = restServiceSpec
+	}
+}
+
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, transformation.FilterName,
 		func(spec *v1.Destination) (proto.Message, error) {
@@ -3,6 +3,7 @@ package transformation
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
@@ -35,6 +36,8 @@ var (
 	_ plugins.RoutePlugin               = new(Plugin)
 	_ plugins.HttpFilterPlugin          = new(Plugin)
 	_ plugins.UpstreamHttpFilterPlugin  = new(Plugin)
+
+	_ plugins.RouteStatePlugin = new(Plugin)
 )
 
 const (
@@ -195,6 +198,30 @@ func (p *Plugin) ProcessWeightedDestination(
 	return pluginutils.ModifyWeightedClusterPerFilterConfig(out, FilterName, mergeFunc(envoyTransformation))
 }
 
+const (
+	// the route state flag which is set when a route requires the upstream filter
+	upstreamFilterRequiredFlag = "upstream-filter-required"
+	// the route state flag which is set when a route requires early transformations
+	earlyTransformationFlag = "early-transformation"
+)
+
+// RouteState returns the flags of the filters that the routes of the listener require.
+// Early transformations are required on every listener once a route requires them.
+func (p *Plugin) RouteState(listener *v1.HttpListener) []string {
+	flags := pluginutils.ListenerRouteState(p.filterRequiredForListener, listener, pluginutils.FilterRequiredFlag)
+	flags = append(flags, pluginutils.ListenerRouteState(p.upstreamFilterRequiredForListener, listener, upstreamFilterRequiredFlag)...)
+	if p.RequireEarlyTransformation {
+		flags = append(flags, earlyTransformationFlag)
+	}
+	return flags
+}
+
+func (p *Plugin) SetRouteState(listener *v1.HttpListener, flags []string) {
+	pluginutils.SetListenerRouteState(p.filterRequiredForListener, listener, flags, pluginutils.FilterRequiredFlag)
+	pluginutils.SetListenerRouteState(p.upstreamFilterRequiredForListener, listener, flags, upstreamFilterRequiredFlag)
+	p.RequireEarlyTransformation = slices.Contains(flags, earlyTransformationFlag)
+}
+
 // HttpFilters emits the desired set of filters. Either 0, 1 or
 // if earlytransformation is needed then 2 staged filters
 func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
@@ -0,0 +1,296 @@
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const (
+	// Modules of images referenced by a tag are fetched again after the refresh interval, in case the tag moved.
+	// Modules pinned by their sha256 are never fetched again.
+	DefaultRefreshInterval = 5 * time.Minute
+	// Images which could not be fetched are fetched again after the retry interval.
+	DefaultRetryInterval = 30 * time.Second
+	// Modules which are not used by any filter for this long are removed from the cache
+	DefaultUnusedTimeout = time.Hour
+	// The largest module which is accepted, as modules are held in memory by the control plane
+	MaxModuleSize = 32 * 1024 * 1024
+
+	// ModulesPath is the path under which the modules of the cache are served by their sha256
+	ModulesPath = "/wasm/modules/"
+
+	fetchTimeout = 15 * time.Second
+)
+
+var (
+	// the magic number and version which start every binary Wasm module
+	moduleHeader = []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}
+
+	InvalidModuleError = func(image string) error {
+		return eris.Errorf("image %s does not contain a valid wasm module", image)
+	}
+	ModuleTooLargeError = func(image string, size int) error {
+		return eris.Errorf("the wasm module of image %s is %d bytes, which exceeds the limit of %d bytes", image, size, MaxModuleSize)
+	}
+	Sha256MismatchError = func(image, expected, actual string) error {
+		return eris.Errorf("the wasm module of image %s has sha256 %s, but %s is required", image, actual, expected)
+	}
+	FetchError = func(err error, image string) error {
+		return eris.Wrapf(err, "fetching wasm module from image %s", image)
+	}
+	ModulePendingError = func(image string) error {
+		return eris.Errorf("the wasm module of image %s is being fetched", image)
+	}
+)
+
+// FetchFunc fetches the Wasm module contained in an image
+type FetchFunc func(ctx context.Context, image string) ([]byte, error)
+
+// ModuleCache holds the modules fetched from images, so that they are not fetched on every translation.
+// Modules are validated and checked against the sha256 they are pinned to before they are cached.
+// Images are fetched in the background, so that translations never wait for a registry: the version of the cache
+// changes and a signal is sent on Updates whenever the outcome of a fetch changes the modules that Get returns.
+type ModuleCache struct {
+	fetch           FetchFunc
+	refreshInterval time.Duration
+	retryInterval   time.Duration
+	unusedTimeout   time.Duration
+
+	updates chan struct{}
+
+	lock    sync.Mutex
+	modules map[string]*cachedModule
+	version uint64
+}
+
+type cachedModule struct {
+	module    []byte
+	sha256    string
+	fetchedAt time.Time
+	usedAt    time.Time
+
+	// the sha256 that the module is being fetched for, while it is
+	fetching *string
+	// the outcome of the last fetch, if it failed, and the sha256 it was made for
+	err         error
+	errSha256   string
+	attemptedAt time.Time
+}
+
+func NewModuleCache(fetch FetchFunc) *ModuleCache {
+	return &ModuleCache{
+		fetch:           fetch,
+		refreshInterval: DefaultRefreshInterval,
+		retryInterval:   DefaultRetryInterval,
+		unusedTimeout:   DefaultUnusedTimeout,
+		updates:         make(chan struct{}, 1),
+		modules:         map[string]*cachedModule{},
+	}
+}
+
+// Get returns the sha256 of the module of the image, which is served by the cache. If pinnedSha256 is not empty,
+// the module must have this hash.
+// Get never fetches the image itself: if the module is not cached yet, it starts fetching it in the background and
+// returns a ModulePendingError, or the error of the previous fetch if it failed. If the image cannot be fetched again
+// after the refresh interval, the cached module continues to be used.
+func (c *ModuleCache) Get(ctx context.Context, image, pinnedSha256 string) (string, error) {
+	pinnedSha256 = strings.TrimPrefix(strings.ToLower(pinnedSha256), "sha256:")
+	now := time.Now()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.removeUnused(now)
+
+	cached, ok := c.modules[image]
+	if !ok {
+		cached = &cachedModule{}
+		c.modules[image] = cached
+	}
+	cached.usedAt = now
+
+	if cached.module != nil && (pinnedSha256 == "" || cached.sha256 == pinnedSha256) {
+		if pinnedSha256 == "" && now.Sub(cached.fetchedAt) >= c.refreshInterval && now.Sub(cached.attemptedAt) >= c.retryInterval {
+			c.startFetch(ctx, image, cached, pinnedSha256, now)
+		}
+		return cached.sha256, nil
+	}
+
+	if cached.err != nil && cached.errSha256 == pinnedSha256 {
+		if now.Sub(cached.attemptedAt) >= c.retryInterval {
+			c.startFetch(ctx, image, cached, pinnedSha256, now)
+		}
+		return "", cached.err
+	}
+	c.startFetch(ctx, image, cached, pinnedSha256, now)
+	return "", ModulePendingError(image)
+}
+
+// startFetch fetches the module of the image in the background, unless it is already being fetched for the same sha256
+func (c *ModuleCache) startFetch(ctx context.Context, image string, cached *cachedModule, pinnedSha256 string, now time.Time) {
+	if cached.fetching != nil && *cached.fetching == pinnedSha256 {
+		return
+	}
+	cached.fetching = &pinnedSha256
+	cached.attemptedAt = now
+	// the fetch outlives the translation which started it
+	go c.fetchInBackground(context.WithoutCancel(ctx), image, pinnedSha256)
+}
+
+func (c *ModuleCache) fetchInBackground(ctx context.Context, image, pinnedSha256 string) {
+	fetched, err := c.fetchModule(ctx, image)
+	if err == nil && pinnedSha256 != "" && fetched.sha256 != pinnedSha256 {
+		err = Sha256MismatchError(image, pinnedSha256, fetched.sha256)
+	}
+	now := time.Now()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	cached, ok := c.modules[image]
+	if !ok {
+		// the module was removed while it was fetched
+		return
+	}
+	if cached.fetching != nil && *cached.fetching == pinnedSha256 {
+		cached.fetching = nil
+	}
+	cached.attemptedAt = now
+
+	if err != nil {
+		if cached.module != nil && pinnedSha256 == "" {
+			contextutils.LoggerFrom(ctx).Warnf("using cached wasm module of image %s: %v", image, err)
+			return
+		}
+		changed := cached.err == nil || cached.err.Error() != err.Error() || cached.errSha256 != pinnedSha256
+		cached.err, cached.errSha256 = err, pinnedSha256
+		if changed {
+			c.updated()
+		}
+		return
+	}
+
+	changed := cached.module == nil || cached.sha256 != fetched.sha256 || cached.err != nil
+	cached.module, cached.sha256, cached.fetchedAt = fetched.module, fetched.sha256, now
+	cached.err, cached.errSha256 = nil, ""
+	if changed {
+		c.updated()
+	}
+}
+
+// updated bumps the version of the cache and signals the update, the lock must be held
+func (c *ModuleCache) updated() {
+	c.version++
+	select {
+	case c.updates <- struct{}{}:
+	default:
+		// an update is already pending
+	}
+}
+
+// Version returns the version of the cache, which changes whenever Get may return a different result for an image
+func (c *ModuleCache) Version() uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.version
+}
+
+// Updates receives a signal when the version of the cache changes, so that the proxies can be translated again.
+// Signals are coalesced while they are not received.
+func (c *ModuleCache) Updates() <-chan struct{} {
+	return c.updates
+}
+
+// Module returns the cached module which has the given sha256
+func (c *ModuleCache) Module(sha256 string) ([]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, cached := range c.modules {
+		if cached.module != nil && cached.sha256 == sha256 {
+			return cached.module, true
+		}
+	}
+	return nil, false
+}
+
+// HttpHandler serves the modules of the cache under ModulesPath, by their sha256.
+func (c *ModuleCache) HttpHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		module, ok := c.Module(strings.TrimPrefix(r.URL.Path, ModulesPath))
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/wasm")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(module))
+	})
+}
+
+func (c *ModuleCache) fetchModule(ctx context.Context, image string) (*cachedModule, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	module, err := c.fetch(ctx, image)
+	if err != nil {
+		return nil, FetchError(err, image)
+	}
+	if err := ValidateModule(image, module); err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(module)
+	return &cachedModule{
+		module: module,
+		sha256: hex.EncodeToString(hash[:]),
+	}, nil
+}
+
+// MarkUsed records that the modules of the images are used by a translation which reuses the filters of a previous
+// one, and so does not Get them, so that the modules are not removed from the cache while they are served.
+func (c *ModuleCache) MarkUsed(images ...string) {
+	now := time.Now()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, image := range images {
+		if cached, ok := c.modules[image]; ok {
+			cached.usedAt = now
+		}
+	}
+}
+
+// removeUnused removes the modules which have not been used for the unused timeout, the lock must be held.
+// The version changes if a module is removed, so that no proxy keeps being served a filter referencing it.
+func (c *ModuleCache) removeUnused(now time.Time) {
+	removed := false
+	for image, cached := range c.modules {
+		if now.Sub(cached.usedAt) > c.unusedTimeout {
+			delete(c.modules, image)
+			removed = removed || cached.module != nil
+		}
+	}
+	if removed {
+		c.updated()
+	}
+}
+
+// ValidateModule checks that the module of the image is a binary Wasm module which is not too large to hold in memory
+func ValidateModule(image string, module []byte) error {
+	if len(module) > MaxModuleSize {
+		return ModuleTooLargeError(image, len(module))
+	}
+	if !bytes.HasPrefix(module, moduleHeader) {
+		return InvalidModuleError(image)
+	}
+	return nil
+}
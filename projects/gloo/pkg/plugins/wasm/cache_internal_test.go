@@ -0,0 +1,60 @@
+package wasm
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ModuleCache unused modules", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		cache  *ModuleCache
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		module := append([]byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}, []byte("module")...)
+		cache = NewModuleCache(func(_ context.Context, _ string) ([]byte, error) {
+			return module, nil
+		})
+		cache.unusedTimeout = 50 * time.Millisecond
+
+		Eventually(func() error {
+			_, err := cache.Get(ctx, "registry/filter:v1", "")
+			return err
+		}).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("keeps the modules which are marked used", func() {
+		version := cache.Version()
+		Consistently(func() bool {
+			cache.MarkUsed("registry/filter:v1")
+			cache.lock.Lock()
+			defer cache.lock.Unlock()
+			cache.removeUnused(time.Now())
+			_, ok := cache.modules["registry/filter:v1"]
+			return ok
+		}, 200*time.Millisecond, 10*time.Millisecond).Should(BeTrue())
+		Expect(cache.Version()).To(Equal(version))
+	})
+
+	It("changes the version when it removes a module", func() {
+		version := cache.Version()
+		cache.lock.Lock()
+		cache.removeUnused(time.Now().Add(time.Second))
+		_, ok := cache.modules["registry/filter:v1"]
+		cache.lock.Unlock()
+
+		Expect(ok).To(BeFalse())
+		Expect(cache.Version()).To(BeNumerically(">", version))
+	})
+})
@@ -0,0 +1,160 @@
+package wasm_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+)
+
+var _ = Describe("ModuleCache", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		// guards module, fetches and fetchErr, which are read by the background fetches
+		lock     sync.Mutex
+		module   []byte
+		fetches  int
+		fetchErr error
+		cache    *ModuleCache
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		module = append([]byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}, []byte("module")...)
+		fetches = 0
+		fetchErr = nil
+		cache = NewModuleCache(func(_ context.Context, _ string) ([]byte, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			fetches++
+			return module, fetchErr
+		})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	setModule := func(m []byte) {
+		lock.Lock()
+		defer lock.Unlock()
+		module = m
+	}
+
+	moduleSha256 := func() string {
+		lock.Lock()
+		defer lock.Unlock()
+		hash := sha256.Sum256(module)
+		return hex.EncodeToString(hash[:])
+	}
+
+	// get returns the sha256 of the module of the image once it is fetched
+	get := func(image, pinnedSha256 string) (string, error) {
+		var (
+			out string
+			err error
+		)
+		EventuallyWithOffset(1, func() bool {
+			out, err = cache.Get(ctx, image, pinnedSha256)
+			return err == nil || err.Error() != ModulePendingError(image).Error()
+		}).Should(BeTrue())
+		return out, err
+	}
+
+	It("fetches a module in the background, once, and serves it from the cache", func() {
+		_, err := cache.Get(ctx, "registry/filter:v1", "")
+		Expect(err).To(MatchError(ModulePendingError("registry/filter:v1")))
+		Eventually(cache.Updates()).Should(Receive())
+
+		for i := 0; i < 2; i++ {
+			out, err := cache.Get(ctx, "registry/filter:v1", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(moduleSha256()))
+		}
+		served, ok := cache.Module(moduleSha256())
+		Expect(ok).To(BeTrue())
+		Expect(served).To(Equal(module))
+		lock.Lock()
+		defer lock.Unlock()
+		Expect(fetches).To(Equal(1))
+	})
+
+	It("changes its version when a module is fetched", func() {
+		version := cache.Version()
+		_, err := get("registry/filter:v1", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache.Version()).NotTo(Equal(version))
+	})
+
+	It("accepts a module matching the pinned sha256", func() {
+		out, err := get("registry/filter:v1", "sha256:"+moduleSha256())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(moduleSha256()))
+	})
+
+	It("rejects a module which does not match the pinned sha256", func() {
+		wrongSha256 := hex.EncodeToString(make([]byte, sha256.Size))
+		_, err := get("registry/filter:v1", wrongSha256)
+		Expect(err).To(MatchError(Sha256MismatchError("registry/filter:v1", wrongSha256, moduleSha256())))
+	})
+
+	It("rejects content which is not a wasm module", func() {
+		setModule([]byte("not a module"))
+		_, err := get("registry/filter:v1", "")
+		Expect(err).To(MatchError(InvalidModuleError("registry/filter:v1")))
+	})
+
+	It("returns fetch errors for images which are not cached", func() {
+		lock.Lock()
+		fetchErr = eris.New("unauthorized")
+		lock.Unlock()
+		_, err := get("registry/filter:v1", "")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("fetching wasm module from image registry/filter:v1: unauthorized"))
+	})
+
+	It("fetches the module again when the pinned sha256 changes", func() {
+		_, err := get("registry/filter:v1", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		setModule(append(module, []byte("v2")...))
+		out, err := get("registry/filter:v1", moduleSha256())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(moduleSha256()))
+		lock.Lock()
+		defer lock.Unlock()
+		Expect(fetches).To(Equal(2))
+	})
+
+	It("serves the modules by their sha256", func() {
+		_, err := get("registry/filter:v1", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		server := httptest.NewServer(cache.HttpHandler())
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + ModulesPath + moduleSha256())
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(Equal(module))
+
+		missing, err := http.Get(server.URL + ModulesPath + hex.EncodeToString(make([]byte, sha256.Size)))
+		Expect(err).NotTo(HaveOccurred())
+		defer missing.Body.Close()
+		Expect(missing.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
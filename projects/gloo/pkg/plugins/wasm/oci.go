@@ -0,0 +1,168 @@
+package wasm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rotisserie/eris"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+const (
+	// media type of the layer of OCI artifacts which only contain a Wasm module
+	wasmLayerMediaType = "application/vnd.module.wasm.content.layer.v1+wasm"
+	// media type of the layer of the images built by wasme
+	soloWasmLayerMediaType = "application/vnd.io.solo.wasm.code.v1+wasm"
+
+	dockerManifestListMediaType = "application/vnd.docker.distribution.manifest.list.v2+json"
+	dockerLayerMediaType        = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+
+	dockerHubRegistry     = "docker.io"
+	dockerHubRegistryHost = "registry-1.docker.io"
+)
+
+var (
+	NoModuleLayerError = func(image string) error {
+		return eris.Errorf("image %s does not have a layer containing a wasm module", image)
+	}
+	NoModuleFileError = func(image string) error {
+		return eris.Errorf("the layers of image %s do not contain a .wasm file", image)
+	}
+)
+
+// ImageFetcher fetches Wasm modules from OCI registries. Both OCI artifacts with a single Wasm layer, and
+// container images with a layer containing a .wasm file (such as the `plugin.wasm` of Istio Wasm images) are
+// supported. Registries are accessed anonymously.
+type ImageFetcher struct {
+	// connect to registries over HTTP instead of HTTPS
+	PlainHTTP bool
+}
+
+func (f *ImageFetcher) Fetch(ctx context.Context, image string) ([]byte, error) {
+	repo, err := remote.NewRepository(image)
+	if err != nil {
+		return nil, err
+	}
+	if repo.Reference.Registry == dockerHubRegistry {
+		repo.Reference.Registry = dockerHubRegistryHost
+	}
+	repo.PlainHTTP = f.PlainHTTP
+
+	reference := repo.Reference.Reference
+	if reference == "" {
+		reference = "latest"
+	}
+	manifest, err := fetchManifest(ctx, repo, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	layer, ok := moduleLayer(manifest)
+	if !ok {
+		return nil, NoModuleLayerError(image)
+	}
+	blob, err := fetchBlob(ctx, repo, layer)
+	if err != nil {
+		return nil, err
+	}
+
+	switch layer.MediaType {
+	case wasmLayerMediaType, soloWasmLayerMediaType:
+		return blob, nil
+	default:
+		return moduleFromTarGz(image, blob)
+	}
+}
+
+// fetchManifest fetches the image manifest of the reference. For an index, the first manifest is used, as Wasm
+// modules do not depend on the platform.
+func fetchManifest(ctx context.Context, repo *remote.Repository, reference string) (*ocispec.Manifest, error) {
+	desc, rc, err := repo.FetchReference(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := content.ReadAll(rc, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	switch desc.MediaType {
+	case ocispec.MediaTypeImageIndex, dockerManifestListMediaType:
+		var index ocispec.Index
+		if err := json.Unmarshal(data, &index); err != nil {
+			return nil, err
+		}
+		if len(index.Manifests) == 0 {
+			return nil, eris.Errorf("the image index %s does not contain any manifests", reference)
+		}
+		return fetchManifest(ctx, repo, index.Manifests[0].Digest.String())
+	default:
+		var manifest ocispec.Manifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, err
+		}
+		return &manifest, nil
+	}
+}
+
+func fetchBlob(ctx context.Context, repo *remote.Repository, desc ocispec.Descriptor) ([]byte, error) {
+	if desc.Size > MaxModuleSize {
+		return nil, eris.Errorf("layer %s is %d bytes, which exceeds the limit of %d bytes", desc.Digest, desc.Size, MaxModuleSize)
+	}
+	rc, err := repo.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return content.ReadAll(rc, desc)
+}
+
+// moduleLayer returns the layer containing the module, preferring layers which only contain a Wasm module
+func moduleLayer(manifest *ocispec.Manifest) (ocispec.Descriptor, bool) {
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == wasmLayerMediaType || layer.MediaType == soloWasmLayerMediaType {
+			return layer, true
+		}
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == ocispec.MediaTypeImageLayerGzip || layer.MediaType == dockerLayerMediaType {
+			return layer, true
+		}
+	}
+	return ocispec.Descriptor{}, false
+}
+
+// moduleFromTarGz returns the first .wasm file of a gzipped tar layer
+func moduleFromTarGz(image string, blob []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(blob))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, NoModuleFileError(image)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg || !strings.HasSuffix(header.Name, ".wasm") {
+			continue
+		}
+		if header.Size > MaxModuleSize {
+			return nil, ModuleTooLargeError(image, int(header.Size))
+		}
+		return io.ReadAll(tr)
+	}
+}
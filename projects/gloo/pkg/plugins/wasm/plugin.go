@@ -0,0 +1,263 @@
+package wasm
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoywasmfilter "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoywasm "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+
+	_ plugins.ExternalStatePlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "wasm"
+	FilterName    = "envoy.filters.http.wasm"
+
+	V8Runtime   = "envoy.wasm.runtime.v8"
+	WavmRuntime = "envoy.wasm.runtime.wavm"
+
+	// MountedModulesDir is the directory of the envoy container in which the Kubernetes Gateway deployer mounts
+	// the Wasm modules of GatewayParameters. Each module is mounted at <MountedModulesDir>/<name>/<MountedModuleFile>.
+	MountedModulesDir = "/etc/envoy/wasm"
+	MountedModuleFile = "module.wasm"
+)
+
+var (
+	NoModuleSourceError = func(filter string) error {
+		return eris.Errorf("wasm filter %s must set one of image, filePath or mountedModule", filter)
+	}
+	Sha256UnsupportedError = func(filter string) error {
+		return eris.Errorf("wasm filter %s sets sha256, which is only supported for modules loaded from an image", filter)
+	}
+	InvalidMountedModuleError = func(filter, module string) error {
+		return eris.Errorf("wasm filter %s references the invalid mounted module name %s", filter, module)
+	}
+	ImageUnsupportedError = func(filter string) error {
+		return eris.Errorf("wasm filter %s loads its module from an image, which is not supported for the proxies "+
+			"of Kubernetes Gateways, whose modules must be mounted with GatewayParameters", filter)
+	}
+)
+
+// The plugin adds the Wasm filters of HttpListeners to the filter chain, at the stage selected by each filter.
+// Modules are loaded by Envoy from a local file, from a module mounted by GatewayParameters, or are fetched from
+// an OCI image by the control plane and served to Envoy from the module cache, through the REST xDS server.
+type plugin struct {
+	modules *ModuleCache
+}
+
+// NewPlugin creates the wasm plugin. The module cache should outlive the plugin, so that images are not fetched
+// on every translation, and must be served by the REST xDS server of the proxies. Without a cache, filters which load
+// their module from an image are rejected.
+func NewPlugin(modules *ModuleCache) *plugin {
+	return &plugin{modules: modules}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var filters []plugins.StagedHttpFilter
+	for _, wasmFilter := range listener.GetOptions().GetWasm().GetFilters() {
+		filterConfig, err := p.translateFilter(params.Ctx, wasmFilter)
+		if err != nil {
+			return nil, err
+		}
+
+		stagedFilter, err := plugins.NewStagedFilter(FilterName, filterConfig, filterStage(wasmFilter.GetFilterStage()))
+		if err != nil {
+			return nil, eris.Wrap(err, "generating filter config")
+		}
+		filters = append(filters, stagedFilter)
+	}
+	return filters, nil
+}
+
+// ExternalStateVersion returns the version of the module cache for the proxies with filters which load their module
+// from an image, since the modules are fetched into the cache rather than being part of the snapshot.
+// It is called for every translation of the proxy, including the ones which reuse a memoized translation,
+// so it marks the modules of the proxy as used.
+func (p *plugin) ExternalStateVersion(_ plugins.Params, proxy *v1.Proxy) (string, bool) {
+	var images []string
+	for _, options := range httpListenerOptions(proxy) {
+		for _, filter := range options.GetWasm().GetFilters() {
+			if filter.GetImage() != "" {
+				images = append(images, filter.GetImage())
+			}
+		}
+	}
+	if len(images) == 0 || p.modules == nil {
+		return "", true
+	}
+	p.modules.MarkUsed(images...)
+	return strconv.FormatUint(p.modules.Version(), 10), true
+}
+
+// httpListenerOptions returns the options of the HttpListeners of the proxy, whatever the type of their listener
+func httpListenerOptions(proxy *v1.Proxy) []*v1.HttpListenerOptions {
+	var options []*v1.HttpListenerOptions
+	for _, listener := range proxy.GetListeners() {
+		switch listenerType := listener.GetListenerType().(type) {
+		case *v1.Listener_HttpListener:
+			options = append(options, listenerType.HttpListener.GetOptions())
+		case *v1.Listener_HybridListener:
+			for _, matched := range listenerType.HybridListener.GetMatchedListeners() {
+				if httpListener := matched.GetHttpListener(); httpListener != nil {
+					options = append(options, httpListener.GetOptions())
+				}
+			}
+		case *v1.Listener_AggregateListener:
+			for _, httpOptions := range listenerType.AggregateListener.GetHttpResources().GetHttpOptions() {
+				options = append(options, httpOptions)
+			}
+		}
+	}
+	return options
+}
+
+func (p *plugin) translateFilter(ctx context.Context, filter *wasm.WasmFilter) (*envoywasmfilter.Wasm, error) {
+	code, err := p.moduleSource(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	vmConfig := &envoywasm.VmConfig{
+		VmId:             filter.GetVmId(),
+		Runtime:          runtime(filter.GetVmType()),
+		Code:             code,
+		Configuration:    filter.GetVmConfig(),
+		AllowPrecompiled: filter.GetAllowPrecompiled(),
+	}
+	if len(filter.GetEnvironmentVariables()) > 0 {
+		vmConfig.EnvironmentVariables = &envoywasm.EnvironmentVariables{
+			KeyValues: filter.GetEnvironmentVariables(),
+		}
+	}
+
+	failurePolicy := envoywasm.FailurePolicy_FAIL_CLOSED
+	if filter.GetFailOpen() {
+		failurePolicy = envoywasm.FailurePolicy_FAIL_OPEN
+	}
+
+	return &envoywasmfilter.Wasm{
+		Config: &envoywasm.PluginConfig{
+			Name:          filter.GetName(),
+			RootId:        filter.GetRootId(),
+			Vm:            &envoywasm.PluginConfig_VmConfig{VmConfig: vmConfig},
+			Configuration: filter.GetConfig(),
+			FailurePolicy: failurePolicy,
+		},
+	}, nil
+}
+
+// moduleSource returns the source from which Envoy loads the module of the filter
+func (p *plugin) moduleSource(ctx context.Context, filter *wasm.WasmFilter) (*envoy_config_core_v3.AsyncDataSource, error) {
+	if filter.GetSha256() != "" && filter.GetImage() == "" {
+		return nil, Sha256UnsupportedError(filter.GetName())
+	}
+
+	var dataSource *envoy_config_core_v3.DataSource
+	switch src := filter.GetSrc().(type) {
+	case *wasm.WasmFilter_Image:
+		if p.modules == nil {
+			return nil, ImageUnsupportedError(filter.GetName())
+		}
+		moduleSha256, err := p.modules.Get(ctx, src.Image, filter.GetSha256())
+		if err != nil {
+			return nil, eris.Wrapf(err, "loading module of wasm filter %s", filter.GetName())
+		}
+		// modules are served by the REST xDS server rather than inlined, since they would make the listeners
+		// exceed the size limits of xDS messages
+		return &envoy_config_core_v3.AsyncDataSource{
+			Specifier: &envoy_config_core_v3.AsyncDataSource_Remote{
+				Remote: &envoy_config_core_v3.RemoteDataSource{
+					HttpUri: &envoy_config_core_v3.HttpUri{
+						Uri: "http://" + defaults.GlooRestXdsName + ModulesPath + moduleSha256,
+						HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+							Cluster: defaults.GlooRestXdsName,
+						},
+						Timeout: durationpb.New(fetchTimeout),
+					},
+					Sha256: moduleSha256,
+				},
+			},
+		}, nil
+	case *wasm.WasmFilter_FilePath:
+		dataSource = &envoy_config_core_v3.DataSource{
+			Specifier: &envoy_config_core_v3.DataSource_Filename{Filename: src.FilePath},
+		}
+	case *wasm.WasmFilter_MountedModule:
+		if errs := validation.IsDNS1123Label(src.MountedModule); len(errs) > 0 {
+			return nil, InvalidMountedModuleError(filter.GetName(), src.MountedModule)
+		}
+		dataSource = &envoy_config_core_v3.DataSource{
+			Specifier: &envoy_config_core_v3.DataSource_Filename{
+				Filename: path.Join(MountedModulesDir, src.MountedModule, MountedModuleFile),
+			},
+		}
+	default:
+		return nil, NoModuleSourceError(filter.GetName())
+	}
+
+	return &envoy_config_core_v3.AsyncDataSource{
+		Specifier: &envoy_config_core_v3.AsyncDataSource_Local{Local: dataSource},
+	}, nil
+}
+
+func runtime(vmType wasm.WasmFilter_VmType) string {
+	if vmType == wasm.WasmFilter_WAVM {
+		return WavmRuntime
+	}
+	return V8Runtime
+}
+
+func filterStage(stage *wasm.FilterStage) plugins.HTTPFilterStage {
+	var wellKnown plugins.WellKnownFilterStage
+	switch stage.GetStage() {
+	case wasm.FilterStage_FaultStage:
+		wellKnown = plugins.FaultStage
+	case wasm.FilterStage_CorsStage:
+		wellKnown = plugins.CorsStage
+	case wasm.FilterStage_WafStage:
+		wellKnown = plugins.WafStage
+	case wasm.FilterStage_AuthNStage:
+		wellKnown = plugins.AuthNStage
+	case wasm.FilterStage_AuthZStage:
+		wellKnown = plugins.AuthZStage
+	case wasm.FilterStage_RateLimitStage:
+		wellKnown = plugins.RateLimitStage
+	case wasm.FilterStage_AcceptedStage:
+		wellKnown = plugins.AcceptedStage
+	case wasm.FilterStage_OutAuthStage:
+		wellKnown = plugins.OutAuthStage
+	case wasm.FilterStage_RouteStage:
+		wellKnown = plugins.RouteStage
+	}
+
+	switch stage.GetPredicate() {
+	case wasm.FilterStage_Before:
+		return plugins.BeforeStage(wellKnown)
+	case wasm.FilterStage_After:
+		return plugins.AfterStage(wellKnown)
+	default:
+		return plugins.DuringStage(wellKnown)
+	}
+}
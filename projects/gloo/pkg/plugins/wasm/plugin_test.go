@@ -0,0 +1,188 @@
+package wasm_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	envoywasmfilter "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoywasm "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("wasm plugin", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		p        plugins.HttpFilterPlugin
+		params   plugins.Params
+		module   []byte
+		filter   *wasm.WasmFilter
+		listener *v1.HttpListener
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		params = plugins.Params{Ctx: ctx}
+
+		module = []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}
+		// the module is fetched in the background, which may outlive the spec
+		fetched := module
+		cache := NewModuleCache(func(_ context.Context, _ string) ([]byte, error) {
+			return fetched, nil
+		})
+		p = NewPlugin(cache)
+		p.Init(plugins.InitParams{Ctx: ctx, Settings: &v1.Settings{}})
+
+		filter = &wasm.WasmFilter{
+			Name:   "add-header",
+			RootId: "add_header_root",
+			Src:    &wasm.WasmFilter_FilePath{FilePath: "/filters/add-header.wasm"},
+		}
+		listener = &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Wasm: &wasm.PluginSource{Filters: []*wasm.WasmFilter{filter}},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	translate := func() ([]plugins.StagedHttpFilter, *envoywasmfilter.Wasm) {
+		filters, err := p.HttpFilters(params, listener)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, filters).To(HaveLen(1))
+		ExpectWithOffset(1, filters[0].Filter.GetName()).To(Equal(FilterName))
+		msg, err := utils.AnyToMessage(filters[0].Filter.GetTypedConfig())
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return filters, msg.(*envoywasmfilter.Wasm)
+	}
+
+	It("does not add filters to listeners without wasm options", func() {
+		filters, err := p.HttpFilters(params, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("translates the plugin and vm configuration", func() {
+		config, err := anypb.New(wrapperspb.String("plugin"))
+		Expect(err).NotTo(HaveOccurred())
+		vmConfig, err := anypb.New(wrapperspb.String("vm"))
+		Expect(err).NotTo(HaveOccurred())
+		filter.Config = config
+		filter.VmConfig = vmConfig
+		filter.VmId = "shared"
+		filter.VmType = wasm.WasmFilter_WAVM
+		filter.EnvironmentVariables = map[string]string{"MODE": "strict"}
+		filter.AllowPrecompiled = true
+		filter.FailOpen = true
+
+		_, out := translate()
+		Expect(out.GetConfig().GetName()).To(Equal("add-header"))
+		Expect(out.GetConfig().GetRootId()).To(Equal("add_header_root"))
+		Expect(out.GetConfig().GetConfiguration()).To(matchers.MatchProto(config))
+		Expect(out.GetConfig().GetFailurePolicy()).To(Equal(envoywasm.FailurePolicy_FAIL_OPEN))
+
+		vm := out.GetConfig().GetVmConfig()
+		Expect(vm.GetVmId()).To(Equal("shared"))
+		Expect(vm.GetRuntime()).To(Equal(WavmRuntime))
+		Expect(vm.GetConfiguration()).To(matchers.MatchProto(vmConfig))
+		Expect(vm.GetEnvironmentVariables().GetKeyValues()).To(Equal(map[string]string{"MODE": "strict"}))
+		Expect(vm.GetAllowPrecompiled()).To(BeTrue())
+		Expect(vm.GetCode().GetLocal().GetFilename()).To(Equal("/filters/add-header.wasm"))
+	})
+
+	It("defaults to the v8 runtime and failing closed", func() {
+		_, out := translate()
+		Expect(out.GetConfig().GetVmConfig().GetRuntime()).To(Equal(V8Runtime))
+		Expect(out.GetConfig().GetFailurePolicy()).To(Equal(envoywasm.FailurePolicy_FAIL_CLOSED))
+	})
+
+	It("places the filter at the configured stage", func() {
+		filter.FilterStage = &wasm.FilterStage{
+			Stage:     wasm.FilterStage_AuthZStage,
+			Predicate: wasm.FilterStage_After,
+		}
+		filters, _ := translate()
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthZStage)))
+	})
+
+	It("loads modules mounted by GatewayParameters", func() {
+		filter.Src = &wasm.WasmFilter_MountedModule{MountedModule: "add-header"}
+		_, out := translate()
+		Expect(out.GetConfig().GetVmConfig().GetCode().GetLocal().GetFilename()).To(Equal("/etc/envoy/wasm/add-header/module.wasm"))
+	})
+
+	It("rejects invalid mounted module names", func() {
+		filter.Src = &wasm.WasmFilter_MountedModule{MountedModule: "../add-header"}
+		_, err := p.HttpFilters(params, listener)
+		Expect(err).To(MatchError(InvalidMountedModuleError("add-header", "../add-header")))
+	})
+
+	It("reports modules fetched from images until they are fetched", func() {
+		filter.Src = &wasm.WasmFilter_Image{Image: "registry/add-header:v1"}
+		_, err := p.HttpFilters(params, listener)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(ModulePendingError("registry/add-header:v1").Error()))
+	})
+
+	It("serves modules fetched from images through the REST xDS server", func() {
+		filter.Src = &wasm.WasmFilter_Image{Image: "registry/add-header:v1"}
+		Eventually(func() error {
+			_, err := p.HttpFilters(params, listener)
+			return err
+		}).ShouldNot(HaveOccurred())
+
+		_, out := translate()
+		hash := sha256.Sum256(module)
+		moduleSha256 := hex.EncodeToString(hash[:])
+		remote := out.GetConfig().GetVmConfig().GetCode().GetRemote()
+		Expect(remote.GetSha256()).To(Equal(moduleSha256))
+		Expect(remote.GetHttpUri().GetCluster()).To(Equal(defaults.GlooRestXdsName))
+		Expect(remote.GetHttpUri().GetUri()).To(HaveSuffix(ModulesPath + moduleSha256))
+	})
+
+	It("rejects modules fetched from images without a module cache", func() {
+		p = NewPlugin(nil)
+		filter.Src = &wasm.WasmFilter_Image{Image: "registry/add-header:v1"}
+		_, err := p.HttpFilters(params, listener)
+		Expect(err).To(MatchError(ImageUnsupportedError("add-header")))
+	})
+
+	It("reports modules which fail validation", func() {
+		p = NewPlugin(NewModuleCache(func(_ context.Context, _ string) ([]byte, error) {
+			return []byte("not a module"), nil
+		}))
+		filter.Src = &wasm.WasmFilter_Image{Image: "registry/add-header:v1"}
+		Eventually(func() error {
+			_, err := p.HttpFilters(params, listener)
+			return err
+		}).Should(MatchError(ContainSubstring(InvalidModuleError("registry/add-header:v1").Error())))
+	})
+
+	It("rejects sha256 pinning for modules which are not loaded from an image", func() {
+		filter.Sha256 = "abc"
+		_, err := p.HttpFilters(params, listener)
+		Expect(err).To(MatchError(Sha256UnsupportedError("add-header")))
+	})
+
+	It("requires a module source", func() {
+		filter.Src = nil
+		_, err := p.HttpFilters(params, listener)
+		Expect(err).To(MatchError(NoModuleSourceError("add-header")))
+	})
+})
@@ -15,6 +15,9 @@ import (
 
 const (
 	AdminPort = 9095
+
+	// SnapshotArchiveFilename is the name of the file served by the /snapshots/history/archive endpoint
+	SnapshotArchiveFilename = "gloo-snapshots.tar.gz"
 )
 
 // ServerHandlers returns the custom handlers for the Admin Server, which will be bound to the http.ServeMux
@@ -49,12 +52,43 @@ func ServerHandlers(ctx context.Context, history iosnapshot.History, dbg *krt.De
 
 		// The xDS Snapshot is intended to return the full in-memory xDS cache that the Control Plane manages
 		// and serves up to running proxies.
+		// If a node is provided (ie /snapshots/xds?node=gloo-system~gateway-proxy), only the snapshot of that node is returned,
+		// with resources in the protojson format, so that it can be compared with the config loaded by the proxy.
 		m.HandleFunc("/snapshots/xds", func(w http.ResponseWriter, r *http.Request) {
+			if node := r.URL.Query().Get("node"); node != "" {
+				respondJson(w, history.GetXdsSnapshotForNode(ctx, node))
+				return
+			}
 			response := history.GetXdsSnapshot(ctx)
 			respondJson(w, response)
 		})
 		profiles["/snapshots/xds"] = "XDS Snapshot"
 
+		// The History lists the input snapshots that the Control Plane recorded, along with the reason for each resync.
+		// Snapshots are only recorded if SNAPSHOT_HISTORY_SIZE is set.
+		m.HandleFunc("/snapshots/history", func(w http.ResponseWriter, r *http.Request) {
+			response := history.GetSnapshotRecords(ctx)
+			respondJson(w, response)
+		})
+		profiles["/snapshots/history"] = "Input Snapshot History"
+
+		// The History Archive is a gzipped tarball of the recorded input snapshots, which can be replayed offline
+		// with `glooctl debug replay`
+		m.HandleFunc("/snapshots/history/archive", func(w http.ResponseWriter, r *http.Request) {
+			response := history.GetSnapshotArchive(ctx)
+			archive, ok := response.Data.(*iosnapshot.SnapshotArchive)
+			if response.Error != nil || !ok {
+				respondJson(w, response)
+				return
+			}
+			w.Header().Set("Content-Type", "application/gzip")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", SnapshotArchiveFilename))
+			if err := iosnapshot.WriteSnapshotArchive(w, archive); err != nil {
+				contextutils.LoggerFrom(ctx).Warnf("failed to write snapshot archive: %v", err)
+			}
+		})
+		profiles["/snapshots/history/archive"] = "Input Snapshot History Archive"
+
 		// if kubeGateway.enabled is false, krt snapshot will be null
 		m.HandleFunc("/snapshots/krt", func(w http.ResponseWriter, r *http.Request) {
 			writeJSON(w, dbg, r)
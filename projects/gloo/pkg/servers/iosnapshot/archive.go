@@ -0,0 +1,201 @@
+package iosnapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+)
+
+// The SnapshotArchive is written as a gzipped tarball, so that individual snapshots can be inspected with standard tools:
+//
+//	settings.json         the Settings, as json
+//	snapshots/0000.json   the oldest recorded snapshot
+//	snapshots/0001.json   ...
+const (
+	archiveSettingsFile  = "settings.json"
+	archiveSnapshotsDir  = "snapshots"
+	archiveFilePerm      = 0o644
+	archiveSnapshotFmt   = "%04d.json"
+	archiveSnapshotsGlob = archiveSnapshotsDir + "/*.json"
+)
+
+// archivedSnapshot is the json representation of a SnapshotRecord
+// Resources are keyed by the name of the field in the ApiSnapshot, and marshalled with jsonpb
+// so that they can be unmarshalled into the same types
+type archivedSnapshot struct {
+	Timestamp time.Time                    `json:"timestamp"`
+	Reason    string                       `json:"reason"`
+	Resources map[string][]json.RawMessage `json:"resources"`
+}
+
+// WriteSnapshotArchive writes the archive as a gzipped tarball
+func WriteSnapshotArchive(w io.Writer, archive *SnapshotArchive) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	settings, err := protoutils.MarshalBytes(archive.Settings)
+	if err != nil {
+		return eris.Wrap(err, "marshalling settings")
+	}
+	if err := writeArchiveFile(tarWriter, archiveSettingsFile, settings); err != nil {
+		return err
+	}
+
+	for i, record := range archive.Records {
+		data, err := marshalSnapshotRecord(record)
+		if err != nil {
+			return eris.Wrapf(err, "marshalling snapshot %d", i)
+		}
+		if err := writeArchiveFile(tarWriter, path.Join(archiveSnapshotsDir, fmt.Sprintf(archiveSnapshotFmt, i)), data); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return eris.Wrap(err, "closing archive")
+	}
+	return gzipWriter.Close()
+}
+
+// ReadSnapshotArchive reads an archive written by WriteSnapshotArchive
+func ReadSnapshotArchive(r io.Reader) (*SnapshotArchive, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, eris.Wrap(err, "reading snapshot archive")
+	}
+	defer gzipReader.Close()
+
+	archive := &SnapshotArchive{}
+	snapshotFiles := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, eris.Wrap(err, "reading snapshot archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, eris.Wrapf(err, "reading %s", header.Name)
+		}
+
+		switch matched, _ := path.Match(archiveSnapshotsGlob, header.Name); {
+		case header.Name == archiveSettingsFile:
+			archive.Settings = &gloov1.Settings{}
+			if err := protoutils.UnmarshalBytes(data, archive.Settings); err != nil {
+				return nil, eris.Wrap(err, "parsing settings")
+			}
+		case matched:
+			snapshotFiles[header.Name] = data
+		}
+	}
+
+	// snapshot files are named by their index, so sorting the names orders them from oldest to newest
+	names := make([]string, 0, len(snapshotFiles))
+	for name := range snapshotFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		record, err := unmarshalSnapshotRecord(snapshotFiles[name])
+		if err != nil {
+			return nil, eris.Wrapf(err, "parsing %s", name)
+		}
+		archive.Records = append(archive.Records, record)
+	}
+
+	if archive.Settings == nil {
+		return nil, eris.Errorf("snapshot archive does not contain %s", archiveSettingsFile)
+	}
+	return archive, nil
+}
+
+func writeArchiveFile(tarWriter *tar.Writer, name string, data []byte) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     archiveFilePerm,
+		Size:     int64(len(data)),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return eris.Wrapf(err, "writing %s", name)
+	}
+	if _, err := tarWriter.Write(data); err != nil {
+		return eris.Wrapf(err, "writing %s", name)
+	}
+	return nil
+}
+
+func marshalSnapshotRecord(record *SnapshotRecord) ([]byte, error) {
+	archived := archivedSnapshot{
+		Timestamp: record.Timestamp,
+		Reason:    record.Reason,
+		Resources: map[string][]json.RawMessage{},
+	}
+	for _, resourceType := range apiSnapshotResourceTypes {
+		list, err := record.Snapshot.GetResourcesList(resourceType.resource)
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range list {
+			data, err := protoutils.MarshalBytes(resource)
+			if err != nil {
+				return nil, eris.Wrapf(err, "marshalling %s %s", resourceType.name, resource.GetMetadata().Ref().Key())
+			}
+			archived.Resources[resourceType.name] = append(archived.Resources[resourceType.name], data)
+		}
+	}
+	return json.MarshalIndent(archived, "", "  ")
+}
+
+func unmarshalSnapshotRecord(data []byte) (*SnapshotRecord, error) {
+	var archived archivedSnapshot
+	if err := json.Unmarshal(data, &archived); err != nil {
+		return nil, err
+	}
+
+	snap := &v1snap.ApiSnapshot{}
+	for _, resourceType := range apiSnapshotResourceTypes {
+		for _, data := range archived.Resources[resourceType.name] {
+			resource := reflect.New(reflect.TypeOf(resourceType.resource).Elem()).Interface().(resources.Resource)
+			if err := protoutils.UnmarshalBytes(data, resource); err != nil {
+				return nil, eris.Wrapf(err, "parsing %s", resourceType.name)
+			}
+			if err := snap.UpsertToResourceList(resource); err != nil {
+				return nil, err
+			}
+		}
+		delete(archived.Resources, resourceType.name)
+	}
+	if len(archived.Resources) > 0 {
+		unknown := make([]string, 0, len(archived.Resources))
+		for name := range archived.Resources {
+			unknown = append(unknown, name)
+		}
+		sort.Strings(unknown)
+		return nil, eris.Errorf("unknown resource types: %s", strings.Join(unknown, ", "))
+	}
+
+	return &SnapshotRecord{
+		Timestamp: archived.Timestamp,
+		Reason:    archived.Reason,
+		Snapshot:  snap,
+	}, nil
+}
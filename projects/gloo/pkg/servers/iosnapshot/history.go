@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/schemes"
@@ -45,6 +47,17 @@ type History interface {
 	// GetXdsSnapshot returns the entire cache of xDS snapshots
 	// NOTE: This contains sensitive data, as it is the exact inputs that used by Envoy
 	GetXdsSnapshot(ctx context.Context) SnapshotResponseData
+
+	// GetXdsSnapshotForNode returns the xDS snapshot served to the node with the given cache key,
+	// with every resource marshalled with protojson
+	// NOTE: This contains sensitive data, as it is the exact inputs that used by Envoy
+	GetXdsSnapshotForNode(ctx context.Context, node string) SnapshotResponseData
+
+	// GetSnapshotRecords returns a summary of each input snapshot that has been recorded, from oldest to newest
+	GetSnapshotRecords(ctx context.Context) SnapshotResponseData
+
+	// GetSnapshotArchive returns a SnapshotArchive of the recorded input snapshots, with sensitive data redacted
+	GetSnapshotArchive(ctx context.Context) SnapshotResponseData
 }
 
 // HistoryFactoryParameters are the inputs used to create a History object
@@ -52,6 +65,8 @@ type HistoryFactoryParameters struct {
 	Settings                    *gloov1.Settings
 	Cache                       cache.SnapshotCache
 	EnableK8sGatewayIntegration bool
+	// MaxRecordedSnapshots is the number of input snapshots to record. If 0, snapshots are not recorded.
+	MaxRecordedSnapshots int
 }
 
 // HistoryFactory is a function that produces a History object
@@ -78,7 +93,7 @@ func GetHistoryFactory() HistoryFactory {
 			gvks = CompleteInputSnapshotGVKs
 		}
 
-		return NewHistory(params.Cache, params.Settings, kubeClient, gvks)
+		return NewHistoryWithRecording(params.Cache, params.Settings, kubeClient, gvks, params.MaxRecordedSnapshots)
 	}
 }
 
@@ -90,15 +105,40 @@ func GetHistoryFactory() HistoryFactory {
 //     resources, Portal resources, or other resources specific to the Kubernetes Gateway integration.
 //     If not set, then only Edge ApiSnapshot resources will be returned from `GetInputSnapshot`.
 func NewHistory(cache cache.SnapshotCache, settings *gloov1.Settings, kubeClient client.Client, kubeGatewayGvks []schema.GroupVersionKind) History {
+	return NewHistoryWithRecording(cache, settings, kubeClient, kubeGatewayGvks, 0)
+}
+
+// NewHistoryWithRecording returns an implementation of the History interface, which additionally
+// records the last `maxRecordedSnapshots` input snapshots, so that they can be exported and replayed offline.
+func NewHistoryWithRecording(
+	cache cache.SnapshotCache,
+	settings *gloov1.Settings,
+	kubeClient client.Client,
+	kubeGatewayGvks []schema.GroupVersionKind,
+	maxRecordedSnapshots int,
+) History {
 	return &historyImpl{
 		latestApiSnapshot:   nil,
 		xdsCache:            cache,
 		settings:            settings,
 		inputSnapshotClient: kubeClient,
 		inputSnapshotGvks:   kubeGatewayGvks,
+		recorder:            newSnapshotRecorder(maxRecordedSnapshots),
+		pendingSnapshots:    make(chan sequencedSnapshot, pendingSnapshotsBufferSize),
 	}
 }
 
+// pendingSnapshotsBufferSize is the number of snapshots that can be waiting to be recorded.
+// Snapshots set while the buffer is full are not recorded.
+const pendingSnapshotsBufferSize = 64
+
+// sequencedSnapshot is an ApiSnapshot set on the History, with its position in the sequence of snapshots
+type sequencedSnapshot struct {
+	sequence  uint64
+	timestamp time.Time
+	snapshot  *v1snap.ApiSnapshot
+}
+
 type historyImpl struct {
 	// TODO:
 	// 	We rely on a mutex to prevent races reading/writing the data for this object
@@ -108,6 +148,16 @@ type historyImpl struct {
 	xdsCache          cache.SnapshotCache
 	settings          *gloov1.Settings
 
+	// sequence orders the snapshots passed to SetApiSnapshot, and latestSequence is the one of latestApiSnapshot
+	sequence       atomic.Uint64
+	latestSequence uint64
+
+	// recorder maintains the most recent input snapshots, which can be exported as a SnapshotArchive
+	recorder *snapshotRecorder
+	// pendingSnapshots are the snapshots waiting to be recorded, in the order they were set
+	pendingSnapshots chan sequencedSnapshot
+	startRecording   sync.Once
+
 	// The InputSnapshot API is really a pass through to the Kubernetes API Server
 	// Below are properties that are used to configure the behavior of GetInputSnapshot
 
@@ -117,16 +167,55 @@ type historyImpl struct {
 
 // SetApiSnapshot sets the latest input ApiSnapshot
 func (h *historyImpl) SetApiSnapshot(latestApiSnapshot *v1snap.ApiSnapshot) {
+	sequence := h.sequence.Add(1)
+
+	if h.recorder.enabled() {
+		// Snapshots are recorded by a single worker, so that they are recorded in the order the Control Plane
+		// produces them, and the reason of each record is computed against the previous one.
+		// The Control Plane never waits for the worker: if it falls behind, the snapshot is not recorded.
+		h.startRecording.Do(func() {
+			go h.recordSnapshots()
+		})
+		select {
+		case h.pendingSnapshots <- sequencedSnapshot{sequence: sequence, timestamp: time.Now(), snapshot: latestApiSnapshot}:
+			return
+		default:
+		}
+	}
+
 	// Setters are called by the running Control Plane, so we perform the update in a goroutine to prevent
 	// any contention/issues, from impacting the runtime of the system
 	go func() {
 		h.Lock()
 		defer h.Unlock()
 
-		h.latestApiSnapshot = latestApiSnapshot
+		h.setLatestApiSnapshot(sequence, latestApiSnapshot)
 	}()
 }
 
+// recordSnapshots records the snapshots sent by SetApiSnapshot, in order
+func (h *historyImpl) recordSnapshots() {
+	for pending := range h.pendingSnapshots {
+		// hashing and cloning the snapshot is the expensive part of recording, so it is done without holding the lock
+		record := h.recorder.prepare(pending.timestamp, pending.snapshot)
+
+		h.Lock()
+		h.setLatestApiSnapshot(pending.sequence, pending.snapshot)
+		h.recorder.record(record)
+		h.Unlock()
+	}
+}
+
+// setLatestApiSnapshot sets the latest ApiSnapshot, unless a more recent one has already been set.
+// It must be called while holding the lock.
+func (h *historyImpl) setLatestApiSnapshot(sequence uint64, latestApiSnapshot *v1snap.ApiSnapshot) {
+	if sequence < h.latestSequence {
+		return
+	}
+	h.latestSequence = sequence
+	h.latestApiSnapshot = latestApiSnapshot
+}
+
 func (h *historyImpl) GetEdgeApiSnapshot(_ context.Context) SnapshotResponseData {
 	snap := h.getRedactedApiSnapshot()
 	return completeSnapshotResponse(snap)
@@ -185,6 +274,55 @@ func (h *historyImpl) GetXdsSnapshot(_ context.Context) SnapshotResponseData {
 	return GetXdsSnapshotDataFromCache(h.xdsCache)
 }
 
+// GetXdsSnapshotForNode returns the xDS snapshot served to the node with the given cache key
+// NOTE: This contains sensitive data, as it is the exact inputs that used by Envoy
+func (h *historyImpl) GetXdsSnapshotForNode(_ context.Context, node string) SnapshotResponseData {
+	return GetXdsNodeSnapshotFromCache(h.xdsCache, node)
+}
+
+// GetSnapshotRecords returns a summary of each input snapshot that has been recorded, from oldest to newest
+func (h *historyImpl) GetSnapshotRecords(_ context.Context) SnapshotResponseData {
+	if !h.recorder.enabled() {
+		return errorSnapshotResponse(eris.Errorf("input snapshots are not recorded, set %s to enable recording", SnapshotHistorySizeEnv))
+	}
+
+	h.RLock()
+	defer h.RUnlock()
+	summaries := make([]SnapshotRecordSummary, 0, len(h.recorder.records))
+	for i, record := range h.recorder.records {
+		summaries = append(summaries, record.Summary(i))
+	}
+	return completeSnapshotResponse(summaries)
+}
+
+// GetSnapshotArchive returns a SnapshotArchive of the recorded input snapshots, with sensitive data redacted
+func (h *historyImpl) GetSnapshotArchive(_ context.Context) SnapshotResponseData {
+	if !h.recorder.enabled() {
+		return errorSnapshotResponse(eris.Errorf("input snapshots are not recorded, set %s to enable recording", SnapshotHistorySizeEnv))
+	}
+
+	h.RLock()
+	records := make([]*SnapshotRecord, 0, len(h.recorder.records))
+	for _, record := range h.recorder.records {
+		// Records are cloned so that redaction does not modify the recorded snapshots
+		clone := record.Snapshot.Clone()
+		records = append(records, &SnapshotRecord{
+			Timestamp: record.Timestamp,
+			Reason:    record.Reason,
+			Snapshot:  &clone,
+		})
+	}
+	h.RUnlock()
+
+	for _, record := range records {
+		redactApiSnapshot(record.Snapshot)
+	}
+	return completeSnapshotResponse(&SnapshotArchive{
+		Settings: h.settings,
+		Records:  records,
+	})
+}
+
 func GetXdsSnapshotDataFromCache(xdsCache cache.SnapshotCache) SnapshotResponseData {
 	cacheKeys := xdsCache.GetStatusKeys()
 	cacheEntries := make(map[string]interface{}, len(cacheKeys))
@@ -233,9 +371,11 @@ func (h *historyImpl) getRedactedApiSnapshot() *v1snap.ApiSnapshot {
 
 // getApiSnapshotSafe gets a clone of the latest ApiSnapshot
 func (h *historyImpl) getApiSnapshotSafe() *v1snap.ApiSnapshot {
+	// The snapshot is replaced, never modified, by the setters, so it is cloned without holding the lock
 	h.RLock()
-	defer h.RUnlock()
-	if h.latestApiSnapshot == nil {
+	latestApiSnapshot := h.latestApiSnapshot
+	h.RUnlock()
+	if latestApiSnapshot == nil {
 		return &v1snap.ApiSnapshot{}
 	}
 
@@ -243,7 +383,7 @@ func (h *historyImpl) getApiSnapshotSafe() *v1snap.ApiSnapshot {
 	// We do this to ensure the following cases:
 	//	1. Modifications to this snapshot, by the admin server, DO NOT impact the Control Plane
 	//	2. Modifications to this snapshot by a single request, DO NOT interfere with other requests
-	clone := h.latestApiSnapshot.Clone()
+	clone := latestApiSnapshot.Clone()
 	return &clone
 }
 
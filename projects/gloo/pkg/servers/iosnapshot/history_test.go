@@ -1,10 +1,17 @@
 package iosnapshot_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	gomegatypes "github.com/onsi/gomega/types"
 	"github.com/solo-io/gloo/pkg/schemes"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/apis/gloo.solo.io/v1"
@@ -731,6 +738,132 @@ var _ = Describe("History", func() {
 
 	})
 
+	Context("GetSnapshotRecords", func() {
+
+		BeforeEach(func() {
+			history = iosnapshot.NewHistoryWithRecording(
+				historyFactorParams.Cache,
+				historyFactorParams.Settings,
+				clientBuilder.Build(), // no objects, because this API doesn't rely on the kube client
+				iosnapshot.CompleteInputSnapshotGVKs,
+				2,
+			)
+		})
+
+		It("returns an error if snapshots are not recorded", func() {
+			history = iosnapshot.NewHistory(
+				historyFactorParams.Cache,
+				historyFactorParams.Settings,
+				nil,
+				iosnapshot.CompleteInputSnapshotGVKs,
+			)
+
+			snapshotResponse := history.GetSnapshotRecords(ctx)
+			Expect(snapshotResponse.Error).To(MatchError(ContainSubstring(iosnapshot.SnapshotHistorySizeEnv)))
+		})
+
+		It("records the most recent snapshots, with the reason for each resync", func() {
+			upstream := &v1.Upstream{Metadata: &core.Metadata{Name: "upstream", Namespace: defaults.GlooSystem}}
+			secret := &v1.Secret{Metadata: &core.Metadata{Name: "secret", Namespace: defaults.GlooSystem}}
+
+			history.SetApiSnapshot(&v1snap.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}})
+			history.SetApiSnapshot(&v1snap.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}, Secrets: v1.SecretList{secret}})
+			history.SetApiSnapshot(&v1snap.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}, Secrets: v1.SecretList{secret}})
+
+			// snapshots are recorded asynchronously, in the order they are set
+			Eventually(func(g Gomega) {
+				summaries := getSnapshotRecords(ctx, history)
+				g.Expect(summaries).To(HaveLen(2), "the oldest snapshot should be evicted")
+				g.Expect(summaries[0].Reason).To(Equal("Secrets changed"))
+				g.Expect(summaries[0].Resources).To(Equal(map[string]int{"Upstreams": 1, "Secrets": 1}))
+				g.Expect(summaries[1].Reason).To(Equal(iosnapshot.NoChangesReason))
+				g.Expect(summaries[1].Timestamp).To(BeTemporally(">=", summaries[0].Timestamp))
+			}).WithPolling(time.Millisecond * 10).WithTimeout(time.Second * 5).Should(Succeed())
+		})
+
+		It("exports an archive of redacted snapshots, which can be read", func() {
+			setSnapshotOnHistory(ctx, history, &v1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{
+					{Metadata: &core.Metadata{Name: "upstream", Namespace: defaults.GlooSystem}},
+				},
+				Secrets: v1.SecretList{
+					{
+						Metadata: &core.Metadata{Name: "secret", Namespace: defaults.GlooSystem},
+						Kind: &v1.Secret_Tls{
+							Tls: &v1.TlsSecret{PrivateKey: "private-key"},
+						},
+					},
+				},
+			})
+
+			snapshotResponse := history.GetSnapshotArchive(ctx)
+			Expect(snapshotResponse.Error).NotTo(HaveOccurred())
+			archive, ok := snapshotResponse.Data.(*iosnapshot.SnapshotArchive)
+			Expect(ok).To(BeTrue())
+
+			var buf bytes.Buffer
+			Expect(iosnapshot.WriteSnapshotArchive(&buf, archive)).NotTo(HaveOccurred())
+			readArchive, err := iosnapshot.ReadSnapshotArchive(&buf)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(readArchive.Settings).To(skmatchers.MatchProto(historyFactorParams.Settings))
+			Expect(readArchive.Records).To(HaveLen(1))
+			Expect(readArchive.Records[0].Reason).To(Equal(iosnapshot.InitialSnapshotReason))
+			Expect(readArchive.Records[0].Timestamp).To(BeTemporally("~", archive.Records[0].Timestamp, time.Millisecond))
+
+			readSnapshot := readArchive.Records[0].Snapshot
+			Expect(readSnapshot.Upstreams).To(HaveLen(1))
+			Expect(readSnapshot.Upstreams[0]).To(skmatchers.MatchProto(archive.Records[0].Snapshot.Upstreams[0]))
+			Expect(readSnapshot.Secrets).To(HaveLen(1))
+			Expect(readSnapshot.Secrets[0].GetKind()).To(BeNil(), "secret data should be redacted")
+		})
+
+	})
+
+	Context("GetXdsSnapshotForNode", func() {
+
+		It("returns the resources of the node, marshalled with protojson", func() {
+			cluster := &envoy_config_cluster_v3.Cluster{
+				Name:           "cluster",
+				ConnectTimeout: durationpb.New(time.Second),
+			}
+			history = iosnapshot.NewHistory(
+				&xds.MockXdsCache{
+					GetSnap: xds.NewSnapshot("v1", nil, []envoycache.Resource{resource.NewEnvoyResource(cluster)}, nil, nil),
+				},
+				historyFactorParams.Settings,
+				clientBuilder.Build(),
+				iosnapshot.CompleteInputSnapshotGVKs,
+			)
+
+			snapshotResponse := history.GetXdsSnapshotForNode(ctx, "gloo-system~gateway-proxy")
+			Expect(snapshotResponse.Error).NotTo(HaveOccurred())
+
+			nodeSnapshot, ok := snapshotResponse.Data.(*iosnapshot.XdsNodeSnapshot)
+			Expect(ok).To(BeTrue())
+			Expect(nodeSnapshot.Clusters.Version).To(Equal("v1"))
+			Expect(nodeSnapshot.Clusters.Resources).To(HaveKey("cluster"))
+
+			var returnedCluster envoy_config_cluster_v3.Cluster
+			Expect(protojson.Unmarshal(nodeSnapshot.Clusters.Resources["cluster"], &returnedCluster)).NotTo(HaveOccurred())
+			Expect(&returnedCluster).To(skmatchers.MatchProto(cluster))
+			Expect(nodeSnapshot.Listeners.Resources).To(BeEmpty())
+		})
+
+		It("returns an error if there is no snapshot for the node", func() {
+			history = iosnapshot.NewHistory(
+				&xds.MockXdsCache{},
+				historyFactorParams.Settings,
+				clientBuilder.Build(),
+				iosnapshot.CompleteInputSnapshotGVKs,
+			)
+
+			snapshotResponse := history.GetXdsSnapshotForNode(ctx, "gloo-system~gateway-proxy")
+			Expect(snapshotResponse.Error).To(MatchError(ContainSubstring("gloo-system~gateway-proxy")))
+		})
+
+	})
+
 })
 
 func getInputSnapshotObjects(ctx context.Context, history iosnapshot.History) []client.Object {
@@ -743,6 +876,16 @@ func getInputSnapshotObjects(ctx context.Context, history iosnapshot.History) []
 	return responseObjects
 }
 
+func getSnapshotRecords(ctx context.Context, history iosnapshot.History) []iosnapshot.SnapshotRecordSummary {
+	snapshotResponse := history.GetSnapshotRecords(ctx)
+	Expect(snapshotResponse.Error).NotTo(HaveOccurred())
+
+	summaries, ok := snapshotResponse.Data.([]iosnapshot.SnapshotRecordSummary)
+	Expect(ok).To(BeTrue())
+
+	return summaries
+}
+
 func getProxySnapshotResources(ctx context.Context, history iosnapshot.History) []crdv1.Resource {
 	snapshotResponse := history.GetProxySnapshot(ctx)
 	Expect(snapshotResponse.Error).NotTo(HaveOccurred())
@@ -0,0 +1,184 @@
+package iosnapshot
+
+import (
+	"strings"
+	"time"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	ratelimitv1alpha1 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+const (
+	// SnapshotHistorySizeEnv is the environment variable that defines the number of input snapshots that
+	// the Control Plane records. Recording is disabled if it is not set, or set to 0.
+	SnapshotHistorySizeEnv = "SNAPSHOT_HISTORY_SIZE"
+
+	// InitialSnapshotReason is the reason recorded for the first snapshot processed by the Control Plane
+	InitialSnapshotReason = "initial snapshot"
+	// NoChangesReason is the reason recorded for a resync where none of the resources changed
+	NoChangesReason = "resync without changes"
+)
+
+// apiSnapshotResourceTypes are the types of resources in an ApiSnapshot, keyed by the name of the field in the ApiSnapshot
+var apiSnapshotResourceTypes = []struct {
+	name     string
+	resource resources.Resource
+}{
+	{"Artifacts", &gloov1.Artifact{}},
+	{"Endpoints", &gloov1.Endpoint{}},
+	{"Proxies", &gloov1.Proxy{}},
+	{"UpstreamGroups", &gloov1.UpstreamGroup{}},
+	{"Secrets", &gloov1.Secret{}},
+	{"Upstreams", &gloov1.Upstream{}},
+	{"AuthConfigs", &extauthv1.AuthConfig{}},
+	{"Ratelimitconfigs", &ratelimitv1alpha1.RateLimitConfig{}},
+	{"VirtualServices", &gatewayv1.VirtualService{}},
+	{"RouteTables", &gatewayv1.RouteTable{}},
+	{"Gateways", &gatewayv1.Gateway{}},
+	{"VirtualHostOptions", &gatewayv1.VirtualHostOption{}},
+	{"RouteOptions", &gatewayv1.RouteOption{}},
+	{"HttpGateways", &gatewayv1.MatchableHttpGateway{}},
+	{"TcpGateways", &gatewayv1.MatchableTcpGateway{}},
+}
+
+// SnapshotRecord is an input snapshot that was processed by the Control Plane
+type SnapshotRecord struct {
+	// Timestamp is the time that the Control Plane started processing the snapshot
+	Timestamp time.Time
+	// Reason describes what triggered the resync, based on the types of resources that changed
+	// since the previously recorded snapshot
+	Reason   string
+	Snapshot *v1snap.ApiSnapshot
+}
+
+// SnapshotRecordSummary describes a SnapshotRecord, without including the resources in the snapshot
+type SnapshotRecordSummary struct {
+	Index     int            `json:"index"`
+	Timestamp time.Time      `json:"timestamp"`
+	Reason    string         `json:"reason"`
+	Resources map[string]int `json:"resources"`
+}
+
+// Summary returns the summary of the record, which is at the provided index in the history
+func (r *SnapshotRecord) Summary(index int) SnapshotRecordSummary {
+	counts := map[string]int{}
+	for _, resourceType := range apiSnapshotResourceTypes {
+		list, _ := r.Snapshot.GetResourcesList(resourceType.resource)
+		if len(list) > 0 {
+			counts[resourceType.name] = len(list)
+		}
+	}
+	return SnapshotRecordSummary{
+		Index:     index,
+		Timestamp: r.Timestamp,
+		Reason:    r.Reason,
+		Resources: counts,
+	}
+}
+
+// SnapshotArchive is the set of input snapshots recorded by the Control Plane, along with the Settings
+// they were processed with. It contains everything needed to replay translation offline.
+type SnapshotArchive struct {
+	Settings *gloov1.Settings
+	// Records are ordered from oldest to newest
+	Records []*SnapshotRecord
+}
+
+// snapshotRecorder maintains a bounded list of the most recent input snapshots
+// It is not safe for concurrent use, and relies on the caller to synchronize access
+type snapshotRecorder struct {
+	maxRecords int
+	records    []*SnapshotRecord
+
+	// lastHashes are the hashes of each type of resource in the most recently recorded snapshot
+	lastHashes map[string]uint64
+}
+
+func newSnapshotRecorder(maxRecords int) *snapshotRecorder {
+	return &snapshotRecorder{
+		maxRecords: maxRecords,
+	}
+}
+
+func (r *snapshotRecorder) enabled() bool {
+	return r.maxRecords > 0
+}
+
+// pendingRecord is a snapshot which has been hashed and cloned, and is ready to be recorded
+type pendingRecord struct {
+	timestamp time.Time
+	hashes    map[string]uint64
+	snapshot  *v1snap.ApiSnapshot
+}
+
+// prepare hashes and clones the snapshot, returning nil if it is not to be recorded.
+// It does not access the records, so it is safe to call without synchronization.
+func (r *snapshotRecorder) prepare(timestamp time.Time, snap *v1snap.ApiSnapshot) *pendingRecord {
+	if !r.enabled() || snap == nil {
+		return nil
+	}
+
+	clone := snap.Clone()
+	return &pendingRecord{
+		timestamp: timestamp,
+		hashes:    hashResourceTypes(snap),
+		snapshot:  &clone,
+	}
+}
+
+// record stores a prepared snapshot, evicting the oldest record if the recorder is full
+func (r *snapshotRecorder) record(pending *pendingRecord) {
+	if pending == nil {
+		return
+	}
+
+	r.records = append(r.records, &SnapshotRecord{
+		Timestamp: pending.timestamp,
+		Reason:    resyncReason(r.lastHashes, pending.hashes),
+		Snapshot:  pending.snapshot,
+	})
+	r.lastHashes = pending.hashes
+
+	if len(r.records) > r.maxRecords {
+		r.records = r.records[len(r.records)-r.maxRecords:]
+	}
+}
+
+// hashResourceTypes returns the hash of each type of resource in the snapshot
+func hashResourceTypes(snap *v1snap.ApiSnapshot) map[string]uint64 {
+	hashes := make(map[string]uint64, len(apiSnapshotResourceTypes))
+	for _, resourceType := range apiSnapshotResourceTypes {
+		list, _ := snap.GetResourcesList(resourceType.resource)
+		values := make([]interface{}, 0, len(list))
+		for _, resource := range list {
+			values = append(values, resource)
+		}
+		// a failure to hash will surface as a change of the resource type, which is the safe default
+		hash, _ := hashutils.HashAllSafe(nil, values...)
+		hashes[resourceType.name] = hash
+	}
+	return hashes
+}
+
+// resyncReason describes the types of resources that changed between two snapshots
+func resyncReason(previous, current map[string]uint64) string {
+	if previous == nil {
+		return InitialSnapshotReason
+	}
+
+	var changed []string
+	for _, resourceType := range apiSnapshotResourceTypes {
+		if previous[resourceType.name] != current[resourceType.name] {
+			changed = append(changed, resourceType.name)
+		}
+	}
+	if len(changed) == 0 {
+		return NoChangesReason
+	}
+	return strings.Join(changed, ", ") + " changed"
+}
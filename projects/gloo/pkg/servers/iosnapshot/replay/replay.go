@@ -0,0 +1,216 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	"github.com/solo-io/gloo/projects/gateway/pkg/reporting"
+	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Result is the outcome of translating a single recorded input snapshot
+type Result struct {
+	Index     int
+	Timestamp time.Time
+	Reason    string
+	// Proxies are sorted by name
+	Proxies []ProxyResult
+}
+
+// ProxyResult is the outcome of translating a single Proxy
+type ProxyResult struct {
+	// Name is the key of the Proxy, ie <namespace>.<name>
+	Name string `json:"name"`
+	// Xds is the xDS snapshot that the Proxy was translated into
+	Xds *iosnapshot.XdsNodeSnapshot `json:"xds"`
+	// Errors and Warnings that were reported on resources during translation,
+	// formatted as "<kind> <namespace>.<name>: <message>"
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Replayer translates recorded input snapshots offline, using the same translators as the Control Plane:
+//  1. Edge Gateways are translated into Proxies by the gateway translator
+//  2. Proxies are translated into xDS snapshots by the gloo translator
+//
+// Plugins which depend on external state (ie EC2 and Consul) are not available. Kubernetes upstreams are
+// translated as if the Service they reference exists, since the Services are not part of the input snapshot.
+type Replayer struct {
+	settings       *gloov1.Settings
+	writeNamespace string
+}
+
+// NewReplayer returns a Replayer that translates snapshots with the provided Settings
+func NewReplayer(settings *gloov1.Settings) *Replayer {
+	writeNamespace := settings.GetDiscoveryNamespace()
+	if writeNamespace == "" {
+		writeNamespace = defaults.GlooSystem
+	}
+	return &Replayer{
+		settings:       settings,
+		writeNamespace: writeNamespace,
+	}
+}
+
+// ReplayArchive translates every record in the archive, from oldest to newest
+func ReplayArchive(ctx context.Context, archive *iosnapshot.SnapshotArchive) ([]*Result, error) {
+	replayer := NewReplayer(archive.Settings)
+	results := make([]*Result, 0, len(archive.Records))
+	for i, record := range archive.Records {
+		result, err := replayer.Replay(ctx, i, record)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Replay translates a single recorded snapshot. The index identifies the record in the results.
+func (r *Replayer) Replay(ctx context.Context, index int, record *iosnapshot.SnapshotRecord) (*Result, error) {
+	ctx = settingsutil.WithSettings(ctx, r.settings)
+	ctx = contextutils.WithLogger(ctx, "replay")
+
+	// Translation mutates the snapshot (ie to store the generated Proxies), so we never modify the record
+	snap := record.Snapshot.Clone()
+	var gatewayReports map[string]reporter.ResourceReports
+	snap.Proxies, gatewayReports = r.translateGateways(ctx, &snap)
+
+	// Plugins are created for each snapshot, since some plugins hold state between translation runs
+	pluginRegistry := registry.NewPluginRegistry(registry.Plugins(registry.PluginOpts{
+		Ctx:           ctx,
+		SvcCollection: kubeServicesForUpstreams(snap.Upstreams),
+	}))
+	glooTranslator := translator.NewDefaultTranslator(r.settings, pluginRegistry)
+
+	result := &Result{
+		Index:     index,
+		Timestamp: record.Timestamp,
+		Reason:    record.Reason,
+	}
+	for _, proxy := range snap.Proxies {
+		xdsSnapshot, reports, proxyReport := glooTranslator.Translate(plugins.Params{
+			Ctx:      ctx,
+			Snapshot: &snap,
+		}, proxy)
+
+		// Errors on the Proxy are reported on the resources it was generated from, as the Control Plane does
+		if proxyGatewayReports, ok := gatewayReports[proxy.GetMetadata().Ref().Key()]; ok {
+			if err := reporting.AddProxyValidationResult(proxyGatewayReports, proxy, proxyReport); err != nil {
+				return nil, err
+			}
+			reports.Merge(proxyGatewayReports)
+		} else {
+			if err := validation.GetProxyError(proxyReport); err != nil {
+				reports.AddError(proxy, err)
+			}
+			reports.AddWarnings(proxy, validation.GetProxyWarning(proxyReport)...)
+		}
+
+		nodeSnapshot, err := iosnapshot.NewXdsNodeSnapshot(xdsSnapshot)
+		if err != nil {
+			return nil, err
+		}
+		proxyResult := ProxyResult{
+			Name: proxy.GetMetadata().Ref().Key(),
+			Xds:  nodeSnapshot,
+		}
+		proxyResult.Errors, proxyResult.Warnings = formatReports(reports)
+		result.Proxies = append(result.Proxies, proxyResult)
+	}
+	sort.Slice(result.Proxies, func(i, j int) bool {
+		return result.Proxies[i].Name < result.Proxies[j].Name
+	})
+	return result, nil
+}
+
+// translateGateways returns the Proxies that the Control Plane would translate for Edge, and the reports of the
+// gateway translation for each generated Proxy, keyed by the Proxy.
+// Proxies generated from Gateways replace the recorded Proxies with the same name, and recorded Proxies that
+// were not generated from Gateways (ie Proxies written directly) are translated as they were recorded.
+func (r *Replayer) translateGateways(ctx context.Context, snap *v1snap.ApiSnapshot) (gloov1.ProxyList, map[string]reporter.ResourceReports) {
+	gatewayTranslator := gwtranslator.NewDefaultTranslator(gwtranslator.Opts{
+		WriteNamespace:                 r.writeNamespace,
+		ReadGatewaysFromAllNamespaces:  r.settings.GetGateway().GetReadGatewaysFromAllNamespaces(),
+		IsolateVirtualHostsBySslConfig: r.settings.GetGateway().GetIsolateVirtualHostsBySslConfig().GetValue(),
+		Validation: &gwtranslator.ValidationOpts{
+			WarnOnRouteShortCircuiting: r.settings.GetGateway().GetValidation().GetWarnRouteShortCircuiting().GetValue(),
+		},
+	})
+
+	proxiesByKey := map[string]*gloov1.Proxy{}
+	for _, proxy := range snap.Proxies {
+		if utils.GetTranslatorValue(proxy.GetMetadata()) == utils.GatewayApiProxyValue {
+			// Kubernetes Gateway Proxies are not translated by the Edge translators
+			continue
+		}
+		proxiesByKey[proxy.GetMetadata().Ref().Key()] = proxy
+	}
+	reportsByKey := map[string]reporter.ResourceReports{}
+	for proxyName, gateways := range gwutils.GatewaysByProxyName(snap.Gateways) {
+		proxy, reports := gatewayTranslator.Translate(ctx, proxyName, snap, gateways)
+		if proxy != nil {
+			proxiesByKey[proxy.GetMetadata().Ref().Key()] = proxy
+			reportsByKey[proxy.GetMetadata().Ref().Key()] = reports
+		}
+	}
+
+	proxies := make(gloov1.ProxyList, 0, len(proxiesByKey))
+	for _, proxy := range proxiesByKey {
+		proxies = append(proxies, proxy)
+	}
+	return proxies.Sort(), reportsByKey
+}
+
+// kubeServicesForUpstreams returns a stub of each Service referenced by a Kubernetes Upstream
+func kubeServicesForUpstreams(upstreams gloov1.UpstreamList) krt.Collection[*corev1.Service] {
+	var services []*corev1.Service
+	for _, upstream := range upstreams {
+		kube := upstream.GetKube()
+		if kube == nil {
+			continue
+		}
+		services = append(services, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      kube.GetServiceName(),
+				Namespace: kube.GetServiceNamespace(),
+			},
+		})
+	}
+	return krt.NewStaticCollection(nil, services)
+}
+
+// formatReports returns the errors and warnings of each resource, sorted so that results can be compared
+func formatReports(reports reporter.ResourceReports) ([]string, []string) {
+	var errs, warnings []string
+	for resource, report := range reports {
+		id := fmt.Sprintf("%T %s", resource, resource.GetMetadata().Ref().Key())
+		if report.Errors != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", id, report.Errors))
+		}
+		for _, warning := range report.Warnings {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", id, warning))
+		}
+	}
+	sort.Strings(errs)
+	sort.Strings(warnings)
+	return errs, warnings
+}
@@ -0,0 +1,134 @@
+package replay_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gatewaydefaults "github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot/replay"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Replayer", func() {
+
+	var (
+		ctx      context.Context
+		replayer *replay.Replayer
+	)
+
+	routeToUpstream := func(upstream string) *gatewayv1.VirtualService {
+		return &gatewayv1.VirtualService{
+			Metadata: &core.Metadata{Name: "vs", Namespace: defaults.GlooSystem},
+			VirtualHost: &gatewayv1.VirtualHost{
+				Domains: []string{"*"},
+				Routes: []*gatewayv1.Route{{
+					Matchers: []*matchers.Matcher{gatewaydefaults.DefaultMatcher()},
+					Action: &gatewayv1.Route_RouteAction{RouteAction: &gloov1.RouteAction{
+						Destination: &gloov1.RouteAction_Single{Single: &gloov1.Destination{
+							DestinationType: &gloov1.Destination_Upstream{
+								Upstream: &core.ResourceRef{Name: upstream, Namespace: defaults.GlooSystem},
+							},
+						}},
+					}},
+				}},
+			},
+		}
+	}
+
+	staticUpstream := &gloov1.Upstream{
+		Metadata: &core.Metadata{Name: "static", Namespace: defaults.GlooSystem},
+		UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{
+			Hosts: []*static.Host{{Addr: "example.com", Port: 80}},
+		}},
+	}
+
+	kubeUpstream := &gloov1.Upstream{
+		Metadata: &core.Metadata{Name: "kube", Namespace: defaults.GlooSystem},
+		UpstreamType: &gloov1.Upstream_Kube{Kube: &kubernetes.UpstreamSpec{
+			ServiceName:      "svc",
+			ServiceNamespace: "default",
+			ServicePort:      8080,
+		}},
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		replayer = replay.NewReplayer(&gloov1.Settings{
+			Metadata:           &core.Metadata{Name: "default", Namespace: defaults.GlooSystem},
+			DiscoveryNamespace: defaults.GlooSystem,
+		})
+	})
+
+	It("translates Gateways into Proxies, and Proxies into xDS", func() {
+		result, err := replayer.Replay(ctx, 3, &iosnapshot.SnapshotRecord{
+			Timestamp: time.Unix(0, 0),
+			Reason:    iosnapshot.InitialSnapshotReason,
+			Snapshot: &v1snap.ApiSnapshot{
+				Gateways:        gatewayv1.GatewayList{gatewaydefaults.DefaultGateway(defaults.GlooSystem)},
+				VirtualServices: gatewayv1.VirtualServiceList{routeToUpstream("kube")},
+				Upstreams:       gloov1.UpstreamList{staticUpstream, kubeUpstream},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.Index).To(Equal(3))
+		Expect(result.Reason).To(Equal(iosnapshot.InitialSnapshotReason))
+		Expect(result.Proxies).To(HaveLen(1))
+
+		proxy := result.Proxies[0]
+		Expect(proxy.Name).To(Equal("gloo-system.gateway-proxy"))
+		Expect(proxy.Errors).To(BeEmpty())
+		Expect(proxy.Xds.Listeners.Resources).To(HaveLen(1))
+		Expect(proxy.Xds.Routes.Resources).To(HaveLen(1))
+		Expect(proxy.Xds.Clusters.Resources).To(And(
+			HaveKey("static_gloo-system"),
+			HaveKey("kube_gloo-system"),
+		))
+		Expect(proxy.Xds.Endpoints.Resources).To(HaveLen(1), "kube upstreams should be translated with EDS")
+	})
+
+	It("reports translation warnings on the resources they originate from", func() {
+		result, err := replayer.Replay(ctx, 0, &iosnapshot.SnapshotRecord{
+			Snapshot: &v1snap.ApiSnapshot{
+				Gateways:        gatewayv1.GatewayList{gatewaydefaults.DefaultGateway(defaults.GlooSystem)},
+				VirtualServices: gatewayv1.VirtualServiceList{routeToUpstream("missing")},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.Proxies).To(HaveLen(1))
+		Expect(result.Proxies[0].Warnings).To(ContainElement(And(
+			HavePrefix("*v1.VirtualService gloo-system.vs"),
+			ContainSubstring("gloo-system.missing } not found"),
+		)))
+	})
+
+	It("produces the same output when replaying the same snapshot", func() {
+		record := &iosnapshot.SnapshotRecord{
+			Snapshot: &v1snap.ApiSnapshot{
+				Gateways:        gatewayv1.GatewayList{gatewaydefaults.DefaultGateway(defaults.GlooSystem)},
+				VirtualServices: gatewayv1.VirtualServiceList{routeToUpstream("static")},
+				Upstreams:       gloov1.UpstreamList{staticUpstream},
+			},
+		}
+		results, err := replay.ReplayArchive(ctx, &iosnapshot.SnapshotArchive{
+			Settings: &gloov1.Settings{},
+			Records:  []*iosnapshot.SnapshotRecord{record, record},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(results).To(HaveLen(2))
+		Expect(results[1].Proxies).To(Equal(results[0].Proxies))
+		Expect(record.Snapshot.Proxies).To(BeEmpty(), "the recorded snapshot should not be modified")
+	})
+})
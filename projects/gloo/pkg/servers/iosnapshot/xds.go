@@ -0,0 +1,83 @@
+package iosnapshot
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// XdsResources are the resources of one type in the xDS snapshot of a node
+type XdsResources struct {
+	Version string `json:"version"`
+	// Resources are keyed by name, and marshalled with protojson so that clients can unmarshal them into the Envoy types
+	Resources map[string]json.RawMessage `json:"resources"`
+}
+
+// XdsNodeSnapshot is the xDS snapshot that the Control Plane serves to a single node
+type XdsNodeSnapshot struct {
+	Listeners XdsResources `json:"listeners"`
+	Routes    XdsResources `json:"routes"`
+	Clusters  XdsResources `json:"clusters"`
+	Endpoints XdsResources `json:"endpoints"`
+}
+
+// GetXdsNodeSnapshotFromCache returns the xDS snapshot of the node with the given cache key
+func GetXdsNodeSnapshotFromCache(xdsCache cache.SnapshotCache, node string) SnapshotResponseData {
+	snap, err := getXdsSnapshot(xdsCache, node)
+	if err != nil {
+		return errorSnapshotResponse(eris.Wrapf(err, "getting xds snapshot for node %s", node))
+	}
+
+	nodeSnapshot, err := NewXdsNodeSnapshot(snap)
+	return SnapshotResponseData{
+		Data:  nodeSnapshot,
+		Error: err,
+	}
+}
+
+// NewXdsNodeSnapshot converts an xDS snapshot into an XdsNodeSnapshot.
+// Resources that cannot be marshalled are excluded, and the aggregated error is returned with the snapshot.
+func NewXdsNodeSnapshot(snap cache.Snapshot) (*XdsNodeSnapshot, error) {
+	var errs *multierror.Error
+	toXdsResources := func(typeUrl string) XdsResources {
+		resources, err := marshalXdsResources(snap.GetResources(typeUrl))
+		errs = multierror.Append(errs, err)
+		return resources
+	}
+	nodeSnapshot := &XdsNodeSnapshot{
+		Listeners: toXdsResources(types.ListenerTypeV3),
+		Routes:    toXdsResources(types.RouteTypeV3),
+		Clusters:  toXdsResources(types.ClusterTypeV3),
+		Endpoints: toXdsResources(types.EndpointTypeV3),
+	}
+	return nodeSnapshot, errs.ErrorOrNil()
+}
+
+func marshalXdsResources(resources cache.Resources) (XdsResources, error) {
+	names := make([]string, 0, len(resources.Items))
+	for name := range resources.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs *multierror.Error
+	xdsResources := XdsResources{
+		Version:   resources.Version,
+		Resources: make(map[string]json.RawMessage, len(resources.Items)),
+	}
+	for _, name := range names {
+		data, err := protojson.Marshal(proto.MessageV2(resources.Items[name].ResourceProto()))
+		if err != nil {
+			errs = multierror.Append(errs, eris.Wrapf(err, "marshalling xds resource %s", name))
+			continue
+		}
+		xdsResources.Resources[name] = data
+	}
+	return xdsResources, errs.ErrorOrNil()
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/envutils"
 	"github.com/solo-io/gloo/pkg/utils/namespaces"
 	"github.com/solo-io/gloo/pkg/utils/setuputils"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	"github.com/solo-io/gloo/pkg/version"
 	"github.com/solo-io/gloo/projects/gateway2/extensions"
 	"github.com/solo-io/gloo/projects/gateway2/krtcollections"
@@ -28,6 +29,7 @@ const (
 
 func Main(customCtx context.Context) error {
 	setuputils.SetupLogging(customCtx, glooComponentName)
+	telemetry.Init(glooComponentName)
 	return startSetupLoop(customCtx)
 }
 
@@ -5,12 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/gorilla/mux"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
-	"go.opencensus.io/trace"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
@@ -23,10 +24,12 @@ import (
 
 	"github.com/solo-io/gloo/pkg/utils/statsutils"
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 )
@@ -79,7 +82,7 @@ func measureResource(ctx context.Context, resource string, length int) {
 // syncEnvoy will translate, sanitize, and set the xds snapshot for each of the proxies in the provided api snapshot.
 // Reports from translation attempts on every Proxy will be merged into allReports.
 func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapshot, allReports reporter.ResourceReports) {
-	ctx, span := trace.StartSpan(ctx, "gloo.syncer.Sync")
+	ctx, span := telemetry.StartSpan(ctx, "gloo.syncer.SyncEnvoy")
 	defer span.End()
 	stopwatch := statsutils.NewTranslatorStopWatch("EnvoySyncer")
 	stopwatch.Start()
@@ -137,7 +140,7 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 		// preserve keys from the current list of proxies, set previous invalid snapshots to empty snapshot
 		for key, valid := range allKeys {
 			if !valid {
-				s.xdsCache.SetSnapshot(key, emptySnapshot)
+				xds.PushSnapshot(ctx, s.xdsCache, key, emptySnapshot)
 			}
 		}
 	}
@@ -154,43 +157,32 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 	}
 
 	// sync non-kube gw proxies
-	for _, proxy := range nonKubeProxies {
-		proxyCtx := ctx
+	// proxies are translated and sanitized concurrently, as far as the translator allows it,
+	// while their snapshots are set and their reports merged in order
+	translator.DefaultUpstreamGroupNamespaces(snap.UpstreamGroups)
+	translations := make([]proxyTranslation, len(nonKubeProxies))
+	var wg sync.WaitGroup
+	for i, proxy := range nonKubeProxies {
+		proxyCtx, proxySpan := telemetry.StartSpan(ctx, "gloo.syncer.SyncProxy", telemetry.ProxyKey.String(proxy.GetMetadata().Ref().Key()))
 		metaKey := xds.SnapshotCacheKey(proxy)
 		if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, metaKey)); err == nil {
 			proxyCtx = ctxWithTags
 		}
+		translations[i] = proxyTranslation{ctx: proxyCtx, span: proxySpan}
+		wg.Go(func() {
+			s.translateProxy(snap, proxy, &translations[i])
+		})
+	}
+	wg.Wait()
 
-		params := plugins.Params{
-			Ctx:      proxyCtx,
-			Settings: s.settings,
-			Snapshot: snap,
-			Messages: map[*core.ResourceRef][]string{},
-		}
-
-		xdsSnapshot, reports, _ := s.translator.Translate(params, proxy)
-
-		// Messages are aggregated during translation, and need to be added to reports
-		for _, messages := range params.Messages {
-			reports.AddMessages(proxy, messages...)
-		}
-
-		if validateErr := reports.ValidateStrict(); validateErr != nil {
-			logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
-		}
-
-		sanitizedSnapshot := s.sanitizer.SanitizeSnapshot(ctx, snap, xdsSnapshot, reports)
-		// if the snapshot is not consistent, make it so
-		xdsSnapshot.MakeConsistent()
-
-		if validateErr := reports.ValidateStrict(); validateErr != nil {
-			logger.Warnw("Proxy had invalid config after xds sanitization", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
-		}
+	for i, proxy := range nonKubeProxies {
+		proxyCtx, proxySpan := translations[i].ctx, translations[i].span
+		xdsSnapshot, sanitizedSnapshot, reports := translations[i].xdsSnapshot, translations[i].sanitizedSnapshot, translations[i].reports
 
 		// Merge reports after sanitization to capture changes made by the sanitizers
 		allReports.Merge(reports)
 		key := xds.SnapshotCacheKey(proxy)
-		s.xdsCache.SetSnapshot(key, sanitizedSnapshot)
+		xds.PushSnapshot(proxyCtx, s.xdsCache, key, sanitizedSnapshot)
 
 		// Record some metrics
 		clustersLen := len(xdsSnapshot.GetResources(types.ClusterTypeV3).Items)
@@ -210,11 +202,57 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 			"endpoints", endpointsLen)
 
 		logger.Debugf("Full snapshot for proxy %v: %+v", proxy.GetMetadata().GetName(), xdsSnapshot)
+		proxySpan.End()
 	}
 
 	logger.Debugf("gloo reports to be written: %v", allReports)
 }
 
+// proxyTranslation is the outcome of the translation of a proxy during syncEnvoy
+type proxyTranslation struct {
+	ctx               context.Context
+	span              trace.Span
+	xdsSnapshot       envoycache.Snapshot
+	sanitizedSnapshot envoycache.Snapshot
+	reports           reporter.ResourceReports
+}
+
+// translateProxy translates the proxy and sanitizes its snapshot, within the context of the translation.
+// It may be called concurrently for distinct proxies.
+func (s *translatorSyncer) translateProxy(snap *v1snap.ApiSnapshot, proxy *v1.Proxy, translation *proxyTranslation) {
+	ctx := translation.ctx
+	logger := contextutils.LoggerFrom(ctx)
+	params := plugins.Params{
+		Ctx:      ctx,
+		Settings: s.settings,
+		Snapshot: snap,
+		Messages: map[*core.ResourceRef][]string{},
+	}
+
+	xdsSnapshot, reports, _ := s.translator.Translate(params, proxy)
+
+	// Messages are aggregated during translation, and need to be added to reports
+	for _, messages := range params.Messages {
+		reports.AddMessages(proxy, messages...)
+	}
+
+	if validateErr := reports.ValidateStrict(); validateErr != nil {
+		logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
+	}
+
+	sanitizedSnapshot := s.sanitizer.SanitizeSnapshot(ctx, snap, xdsSnapshot, reports)
+	// if the snapshot is not consistent, make it so
+	xdsSnapshot.MakeConsistent()
+
+	if validateErr := reports.ValidateStrict(); validateErr != nil {
+		logger.Warnw("Proxy had invalid config after xds sanitization", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
+	}
+
+	translation.xdsSnapshot = xdsSnapshot
+	translation.sanitizedSnapshot = sanitizedSnapshot
+	translation.reports = reports
+}
+
 // ServeXdsSnapshots exposes Gloo configuration as an API when `devMode` in Settings is True.
 // Deprecated: https://github.com/solo-io/gloo/issues/6494
 // Prefer to use the iosnapshot.History and pkg/servers/admin
@@ -73,11 +73,6 @@ func (s *translatorSyncerExtension) Sync(
 					reports.AddError(proxy, enterpriseOnlyError("RateLimitConfig"))
 				}
 
-				// ratelimitBasic is an enterprise feature https://docs.solo.io/gloo-edge/latest/guides/security/rate_limiting/simple/
-				if virtualHost.GetOptions().GetRatelimitBasic() != nil {
-					reports.AddError(proxy, enterpriseOnlyError("ratelimitBasic"))
-				}
-
 				// check setActions on vhost
 				rlactionsVhost := virtualHost.GetOptions().GetRatelimit().GetRateLimits()
 				for _, rlaction := range rlactionsVhost {
@@ -99,10 +94,6 @@ func (s *translatorSyncerExtension) Sync(
 						reports.AddError(proxy, enterpriseOnlyError("RateLimitConfig"))
 					}
 
-					if route.GetOptions().GetRatelimitBasic() != nil {
-						reports.AddError(proxy, enterpriseOnlyError("ratelimitBasic"))
-					}
-
 					// check setActions on route
 					rlactionsRoute := route.GetOptions().GetRatelimit().GetRateLimits()
 					for _, rlaction := range rlactionsRoute {
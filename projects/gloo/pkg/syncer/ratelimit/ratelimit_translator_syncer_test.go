@@ -56,6 +56,16 @@ var _ = Describe("RatelimitTranslatorSyncer", func() {
 			ExpectWithOffset(1, multiErr.WrappedErrors()).To(ContainElement(testutils.HaveInErrorChain(eris.New(expectedErrorMessage))))
 		}
 
+		// ratelimitBasic is served by the open source rate limit server (projects/ratelimit)
+		ExpectSyncSucceeds := func() {
+			apiSnapshot := &gloov1snap.ApiSnapshot{
+				Proxies: []*gloov1.Proxy{proxy},
+			}
+			reports := make(reporter.ResourceReports)
+			translator.Sync(ctx, apiSnapshot, &gloov1.Settings{}, &syncer.MockXdsCache{}, reports)
+			ExpectWithOffset(1, reports.ValidateStrict()).NotTo(HaveOccurred())
+		}
+
 		Context("config ratelimitBasic", func() {
 
 			BeforeEach(func() {
@@ -87,8 +97,8 @@ var _ = Describe("RatelimitTranslatorSyncer", func() {
 				}
 			})
 
-			It("should error when enterprise ratelimitBasic config is set", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError("ratelimitBasic")
+			It("should not error when ratelimitBasic config is set", func() {
+				ExpectSyncSucceeds()
 			})
 		})
 
@@ -127,8 +137,8 @@ var _ = Describe("RatelimitTranslatorSyncer", func() {
 				}
 			})
 
-			It("should error when enterprise ratelimitBasic config is set", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError("ratelimitBasic")
+			It("should not error when ratelimitBasic config is set", func() {
+				ExpectSyncSucceeds()
 			})
 		})
 
@@ -171,8 +181,8 @@ var _ = Describe("RatelimitTranslatorSyncer", func() {
 				}
 			})
 
-			It("should error when enterprise ratelimitBasic config is set", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError("ratelimitBasic")
+			It("should not error when ratelimitBasic config is set", func() {
+				ExpectSyncSucceeds()
 			})
 		})
 
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"runtime"
 	"slices"
 	"sort"
 	"strconv"
@@ -79,6 +80,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer"
 	extauthExt "github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
 	ratelimitExt "github.com/solo-io/gloo/projects/gloo/pkg/syncer/ratelimit"
@@ -89,6 +91,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds/xdsauth"
 )
 
 // TODO: (copied from gateway) switch AcceptAllResourcesByDefault to false after validation has been tested in user environments
@@ -161,6 +164,7 @@ type setupSyncer struct {
 	setupOpts                *bootstrap.SetupOpts
 	makeGrpcServer           func(ctx context.Context, options ...grpc.ServerOption) *grpc.Server
 	previousXdsServer        grpcServer
+	previousXdsAuthOptions   *v1.GlooOptions_XdsAuthOptions
 	previousControlPlane     bootstrap.ControlPlane
 	previousValidationServer grpcServer
 	previousProxyDebugServer grpcServer
@@ -173,6 +177,7 @@ type setupSyncer struct {
 func NewControlPlane(ctx context.Context, snapshotCache cache.SnapshotCache, grpcServer *grpc.Server, bindAddr net.Addr, kubeControlPlaneCfg bootstrap.KubernetesControlPlaneConfig,
 	callbacks xdsserver.Callbacks, start bool) bootstrap.ControlPlane {
 	xdsServer := server.NewServer(ctx, snapshotCache, callbacks)
+	deltaXdsServer := xds.NewDeltaServer(ctx, snapshotCache, callbacks)
 	reflection.Register(grpcServer)
 
 	return bootstrap.ControlPlane{
@@ -182,9 +187,10 @@ func NewControlPlane(ctx context.Context, snapshotCache cache.SnapshotCache, grp
 			BindAddr:        bindAddr,
 			Ctx:             ctx,
 		},
-		SnapshotCache: snapshotCache,
-		XDSServer:     xdsServer,
-		Kube:          kubeControlPlaneCfg,
+		SnapshotCache:  snapshotCache,
+		XDSServer:      xdsServer,
+		DeltaXDSServer: deltaXdsServer,
+		Kube:           kubeControlPlaneCfg,
 	}
 }
 
@@ -241,6 +247,22 @@ func GetWriteNamespace(settings *v1.Settings) string {
 	return writeNamespace
 }
 
+// maxRecordedSnapshots returns the number of input snapshots that the admin server records, as defined by the
+// SNAPSHOT_HISTORY_SIZE environment variable. Recording is disabled by default, since each recorded snapshot
+// is a copy of every resource that the Control Plane watches.
+func maxRecordedSnapshots(ctx context.Context) int {
+	historySize := os.Getenv(iosnapshot.SnapshotHistorySizeEnv)
+	if historySize == "" {
+		return 0
+	}
+	size, err := strconv.Atoi(historySize)
+	if err != nil || size < 0 {
+		contextutils.LoggerFrom(ctx).Warnf("invalid value %q for %s, input snapshots will not be recorded", historySize, iosnapshot.SnapshotHistorySizeEnv)
+		return 0
+	}
+	return size
+}
+
 // Setup constructs bootstrap options based on settings and other input, and calls the runFunc with these options.
 func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, memCache memory.InMemoryResourceCache, settings *v1.Settings, identity leaderelector.Identity) error {
 	xdsBindAddr := settings.GetGloo().GetXdsBindAddr()
@@ -356,6 +378,12 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 		logger.Infof("using xds host %v and xds port %v", xdsHost, xdsPort)
 	}
 
+	xdsAuthOptions := settings.GetGloo().GetXdsAuthOptions()
+	xdsAuthorizer, err := xdsauth.NewAuthorizer(ctx, xdsAuthOptions, clientset)
+	if err != nil {
+		return errors.Wrapf(err, "configuring xds authorization")
+	}
+
 	// process grpcserver options to understand if any servers will need a restart
 
 	maxGrpcRecvSize := -1
@@ -374,7 +402,8 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 	// check if we need to restart the control plane
 	if xdsBindAddr != s.previousXdsServer.addr ||
 		xdsHost != s.previousControlPlane.Kube.XdsHost ||
-		xdsPort != s.previousControlPlane.Kube.XdsPort {
+		xdsPort != s.previousControlPlane.Kube.XdsPort ||
+		!xdsAuthOptions.Equal(s.previousXdsAuthOptions) {
 		if s.previousXdsServer.cancel != nil {
 			s.previousXdsServer.cancel()
 			s.previousXdsServer.cancel = nil
@@ -408,13 +437,15 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 		if s.extensions != nil {
 			callbacks = s.extensions.XdsCallbacks
 		}
-		s.controlPlane = NewControlPlane(ctx, s.setupOpts.Cache, s.makeGrpcServer(ctx), xdsTcpAddress,
+		s.controlPlane = NewControlPlane(ctx, s.setupOpts.Cache, s.makeGrpcServer(ctx, xdsAuthorizer.GrpcServerOptions()...), xdsTcpAddress,
 			bootstrap.KubernetesControlPlaneConfig{XdsHost: xdsHost, XdsPort: xdsPort}, multiCallbacks(s.setupOpts.ExtraCallbacks, callbacks), true)
+		s.controlPlane.XdsAuthorizer = xdsAuthorizer
 
 		s.setupOpts.SetXdsAddress(xdsHost, xdsPort)
 
 		s.previousXdsServer.cancel = cancel
 		s.previousXdsServer.addr = xdsBindAddr
+		s.previousXdsAuthOptions = xdsAuthOptions
 		s.previousControlPlane.Kube.XdsHost = xdsHost
 		s.previousControlPlane.Kube.XdsPort = xdsPort
 	}
@@ -653,7 +684,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	statusClient := gloostatusutils.GetStatusClientForNamespace(opts.StatusReporterNamespace)
 
 	// Register grpc endpoints to the grpc server
-	xds.SetupEnvoyXds(opts.ControlPlane.GrpcServer, opts.ControlPlane.XDSServer, opts.ControlPlane.SnapshotCache, opts.Settings.GetIpV4Only())
+	xds.SetupEnvoyXds(opts.ControlPlane.GrpcServer, opts.ControlPlane.XDSServer, opts.ControlPlane.DeltaXDSServer, opts.ControlPlane.SnapshotCache, opts.Settings.GetIpV4Only())
 
 	pluginRegistry := extensions.PluginRegistryFactory(watchOpts.Ctx)
 	var discoveryPlugins []discovery.DiscoveryPlugin
@@ -718,6 +749,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		routeOptionClient.BaseClient(),
 		rlReporterClient,
 	)
+	rpt = gloostatusutils.NewTracingReporter(defaults.GlooReporter, rpt)
 	statusMetrics, err := metrics.NewConfigStatusMetrics(opts.Settings.GetObservabilityOptions().GetConfigStatusMetricLabels())
 	if err != nil {
 		return err
@@ -801,8 +833,8 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	}
 
 	// MARK: build gloo translator
-	sharedTranslator := TranslatorFactory{PluginRegistry: extensions.PluginRegistryFactory}.NewTranslator(watchOpts.Ctx,
-		opts.Settings)
+	sharedTranslator := TranslatorFactory{PluginRegistry: extensions.PluginRegistryFactory}.NewShardedTranslator(watchOpts.Ctx,
+		opts.Settings, runtime.GOMAXPROCS(0))
 	routeReplacingSanitizer, err := sanitizer.NewRouteReplacingSanitizer(opts.Settings.GetGloo().GetInvalidConfigPolicy())
 	if err != nil {
 		return err
@@ -906,6 +938,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		Settings:                    opts.Settings,
 		Cache:                       opts.ControlPlane.SnapshotCache,
 		EnableK8sGatewayIntegration: opts.GlooGateway.EnableK8sGatewayController,
+		MaxRecordedSnapshots:        maxRecordedSnapshots(watchOpts.Ctx),
 	})
 
 	startFuncs["admin-server"] = AdminServerStartFunc(snapshotHistory, opts.KrtDebugger)
@@ -970,6 +1003,11 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	}
 	go errutils.AggregateErrs(watchOpts.Ctx, errs, apiEventLoopErrs, "event_loop.gloo")
 
+	if opts.WasmModules != nil {
+		// translate the proxies again once the Wasm modules they use are fetched, since they are not part of the snapshot
+		go emitOnUpdates(watchOpts.Ctx, opts.WasmModules.Updates(), extensions.ApiEmitterChannel)
+	}
+
 	go func() {
 		for {
 			select {
@@ -1089,6 +1127,22 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	return nil
 }
 
+// emitOnUpdates forces the API emitter to emit a snapshot whenever an update is received
+func emitOnUpdates(ctx context.Context, updates <-chan struct{}, emit chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-updates:
+			select {
+			case <-ctx.Done():
+				return
+			case emit <- struct{}{}:
+			}
+		}
+	}
+}
+
 func startRestXdsServer(opts bootstrap.Opts) {
 	restClient := server.NewHTTPGateway(
 		contextutils.LoggerFrom(opts.WatchOpts.Ctx),
@@ -1101,12 +1155,25 @@ func startRestXdsServer(opts bootstrap.Opts) {
 	if restXdsAddr == "" {
 		restXdsAddr = DefaultRestXdsBindAddr
 	}
+	mux := http.NewServeMux()
+	mux.Handle("/", opts.ControlPlane.XdsAuthorizer.HttpHandler(restClient))
+	if opts.WasmModules != nil {
+		// the modules are served by their sha256, which is only sent to the proxies whose filters use them,
+		// and their requests do not identify a node, so their clients are only authenticated
+		mux.Handle(wasm.ModulesPath, opts.ControlPlane.XdsAuthorizer.AuthenticatingHttpHandler(opts.WasmModules.HttpHandler()))
+	}
 	srv := &http.Server{
-		Addr:    restXdsAddr,
-		Handler: restClient,
+		Addr:      restXdsAddr,
+		Handler:   mux,
+		TLSConfig: opts.ControlPlane.XdsAuthorizer.TLSConfig(),
 	}
 	go func() {
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		listenAndServe := srv.ListenAndServe
+		if srv.TLSConfig != nil {
+			// the certificates are provided by the TLS config
+			listenAndServe = func() error { return srv.ListenAndServeTLS("", "") }
+		}
+		if err := listenAndServe(); err != nil && err != http.ErrServerClosed {
 			// TODO: Add metrics for rest xds server
 			contextutils.LoggerFrom(opts.WatchOpts.Ctx).Warnf("error while running REST xDS server", zap.Error(err))
 		}
@@ -1343,6 +1410,7 @@ func constructOpts(ctx context.Context, setup *bootstrap.SetupOpts, params const
 		ProxyCleanup:                 proxyCleanup,
 		GlooGateway:                  constructGlooGatewayBootstrapOpts(params.settings),
 		ProxyReconcileQueue:          setup.ProxyReconcileQueue,
+		WasmModules:                  wasm.NewModuleCache(new(wasm.ImageFetcher).Fetch),
 	}, nil
 }
 
@@ -22,6 +22,21 @@ func (tf TranslatorFactory) NewTranslator(ctx context.Context, settings *v1.Sett
 	)
 }
 
+// NewShardedTranslator returns a translator of the Edge proxies, which translates up to `concurrency` proxies at a time
+// and memoizes their translation. Each shard gets plugins of its own.
+func (tf TranslatorFactory) NewShardedTranslator(ctx context.Context, settings *v1.Settings, concurrency int) translator.Translator {
+	translators := make([]translator.Translator, max(concurrency, 1))
+	for i := range translators {
+		translators[i] = translator.NewMemoizingTranslator(
+			sslutils.NewSslConfigTranslator(),
+			settings,
+			tf.PluginRegistry(ctx),
+			translator.EnvoyCacheResourcesListToFnvHash,
+		)
+	}
+	return translator.NewShardedTranslator(translators...)
+}
+
 func (tf TranslatorFactory) NewClusterTranslator(ctx context.Context, settings *v1.Settings) translator.ClusterTranslator {
 	return translator.NewTranslatorWithHasher(
 		sslutils.NewSslConfigTranslator(),
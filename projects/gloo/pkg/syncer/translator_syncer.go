@@ -6,11 +6,13 @@ import (
 	"time"
 
 	"github.com/solo-io/gloo/pkg/utils/statsutils/metrics"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	"github.com/solo-io/gloo/projects/gloo/pkg/servers/iosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
@@ -109,7 +111,14 @@ func NewTranslatorSyncer(
 	return s
 }
 
-func (s *translatorSyncer) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) error {
+func (s *translatorSyncer) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "gloo.syncer.Sync",
+		attribute.Int("gloo.snapshot.proxies", len(snap.Proxies)),
+		attribute.Int("gloo.snapshot.upstreams", len(snap.Upstreams)),
+		attribute.Int("gloo.snapshot.endpoints", len(snap.Endpoints)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	logger := contextutils.LoggerFrom(ctx)
 	var multiErr *multierror.Error
 
@@ -178,6 +187,7 @@ func (s *translatorSyncer) syncExtensions(ctx context.Context, snap *v1snap.ApiS
 // translateProxies will call the gatewaySyncer to translate Proxies for the Gateways in the provided snapshot.
 // It will then use the proxyClient to List() Proxies and *mutate the snapshot* to add those Proxies.
 func (s *translatorSyncer) translateProxies(ctx context.Context, snap *v1snap.ApiSnapshot) error {
+	ctx, span := telemetry.StartSpan(ctx, "gloo.syncer.TranslateProxies")
 	var multiErr *multierror.Error
 	err := s.gatewaySyncer.Sync(ctx, snap)
 	if err != nil {
@@ -188,6 +198,8 @@ func (s *translatorSyncer) translateProxies(ctx context.Context, snap *v1snap.Ap
 		multiErr = multierror.Append(multiErr, err)
 	}
 	snap.Proxies = proxyList
+	span.SetAttributes(attribute.Int("gloo.snapshot.proxies", len(proxyList)))
+	telemetry.EndSpan(span, multiErr.ErrorOrNil())
 	return multiErr.ErrorOrNil()
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/api_conversion"
 	"github.com/solo-io/gloo/pkg/utils/envutils"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	"github.com/solo-io/gloo/projects/gloo/constants"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1_circuitbreaker "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/circuit_breaker"
@@ -29,7 +30,6 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
-	"go.opencensus.io/trace"
 	"go.uber.org/zap"
 	_structpb "google.golang.org/protobuf/types/known/structpb"
 )
@@ -39,8 +39,9 @@ func (t *translatorInstance) computeClusters(
 	reports reporter.ResourceReports,
 	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
 	proxy *v1.Proxy,
+	memo *translationMemo,
 ) ([]*envoy_config_cluster_v3.Cluster, map[*envoy_config_cluster_v3.Cluster]*v1.Upstream) {
-	ctx, span := trace.StartSpan(params.Ctx, "gloo.translator.computeClusters")
+	ctx, span := telemetry.StartSpan(params.Ctx, "gloo.translator.computeClusters")
 	defer span.End()
 	params.Ctx = contextutils.WithLogger(ctx, "compute_clusters")
 
@@ -56,7 +57,9 @@ func (t *translatorInstance) computeClusters(
 		if eps, ok := upstreamRefKeyToEndpoints[upstream.GetMetadata().Ref().Key()]; ok && len(eps) > 0 {
 			eds = true
 		}
-		cluster, errs := t.computeCluster(params, upstream, eds)
+		cluster, errs := memo.cluster(upstream, eds, func() (*envoy_config_cluster_v3.Cluster, []error) {
+			return t.computeCluster(params, upstream, eds)
+		})
 		for _, err := range errs {
 			var warning *Warning
 			if errors.As(err, &warning) {
@@ -4,10 +4,10 @@ import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 	"github.com/solo-io/gloo/projects/gloo/constants"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
-	"go.opencensus.io/trace"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 )
@@ -27,7 +27,7 @@ func (t *translatorInstance) computeClusterEndpoints(
 	reports reporter.ResourceReports,
 ) []*envoy_config_endpoint_v3.ClusterLoadAssignment {
 
-	_, span := trace.StartSpan(params.Ctx, "gloo.translator.computeClusterEndpoints")
+	_, span := telemetry.StartSpan(params.Ctx, "gloo.translator.computeClusterEndpoints")
 	defer span.End()
 
 	var clusterEndpointAssignments []*envoy_config_endpoint_v3.ClusterLoadAssignment
@@ -53,7 +53,9 @@ func loadAssignmentForUpstream(
 	enableAutoMtls bool,
 ) *envoy_config_endpoint_v3.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.GetMetadata().Ref())
-	var endpoints []*envoy_config_endpoint_v3.LbEndpoint
+	// endpoints are grouped by the zone they run in, in the order the zones are first seen
+	var localities []*envoy_config_endpoint_v3.LocalityLbEndpoints
+	localityByZone := map[string]*envoy_config_endpoint_v3.LocalityLbEndpoints{}
 	for _, addr := range clusterEndpoints {
 		// Get the metadata labels and filter metadata for the envoy load balancer based on the upstream
 		metadata := getLbMetadata(upstream, addr.GetMetadata().GetLabels(), "")
@@ -68,7 +70,8 @@ func loadAssignmentForUpstream(
 			}
 		}
 		lbEndpoint := envoy_config_endpoint_v3.LbEndpoint{
-			Metadata: metadata,
+			Metadata:     metadata,
+			HealthStatus: endpointHealthStatus(addr),
 			HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
 				Endpoint: &envoy_config_endpoint_v3.Endpoint{
 					Address: &envoy_config_core_v3.Address{
@@ -87,15 +90,35 @@ func loadAssignmentForUpstream(
 				},
 			},
 		}
-		endpoints = append(endpoints, &lbEndpoint)
+
+		zone := addr.GetMetadata().GetAnnotations()[constants.EndpointZoneAnnotation]
+		locality, ok := localityByZone[zone]
+		if !ok {
+			locality = &envoy_config_endpoint_v3.LocalityLbEndpoints{}
+			if zone != "" {
+				locality.Locality = &envoy_config_core_v3.Locality{Zone: zone}
+			}
+			localityByZone[zone] = locality
+			localities = append(localities, locality)
+		}
+		locality.LbEndpoints = append(locality.GetLbEndpoints(), &lbEndpoint)
+	}
+	if len(localities) == 0 {
+		localities = []*envoy_config_endpoint_v3.LocalityLbEndpoints{{}}
 	}
 
 	return &envoy_config_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   localities,
+	}
+}
+
+// endpointHealthStatus returns the health status of the endpoint, which is unknown unless it is draining.
+func endpointHealthStatus(endpoint *v1.Endpoint) envoy_config_core_v3.HealthStatus {
+	if endpoint.GetMetadata().GetAnnotations()[constants.EndpointHealthStatusAnnotation] == constants.EndpointHealthStatusDraining {
+		return envoy_config_core_v3.HealthStatus_DRAINING
 	}
+	return envoy_config_core_v3.HealthStatus_UNKNOWN
 }
 
 func createUpstreamToEndpointsMap(upstreams []*v1.Upstream, endpoints []*v1.Endpoint) map[string][]*v1.Endpoint {
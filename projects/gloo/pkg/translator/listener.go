@@ -58,7 +58,7 @@ func (l *listenerTranslatorInstance) ComputeListener(params plugins.Params) *env
 	// This is upstream envoy definition we cannot mutate this struct
 	out := &envoy_config_listener_v3.Listener{
 		Name:         l.listener.GetName(),
-		Address:      l.computeListenerAddress(),
+		Address:      computeListenerAddress(l.listener, envoy_config_core_v3.SocketAddress_TCP, l.listener.GetBindPort(), l.report),
 		FilterChains: filterChains,
 	}
 
@@ -89,11 +89,16 @@ func (l *listenerTranslatorInstance) ComputeListener(params plugins.Params) *env
 	return out
 }
 
-// computeListenerAddress returns the Address that this listener will listen for traffic
-func (l *listenerTranslatorInstance) computeListenerAddress() *envoy_config_core_v3.Address {
-	_, isIpv4Address, err := IsIpv4Address(l.listener.GetBindAddress())
+// computeListenerAddress returns the Address that a listener will listen for traffic on, using the given transport protocol and port
+func computeListenerAddress(
+	listener *v1.Listener,
+	protocol envoy_config_core_v3.SocketAddress_Protocol,
+	port uint32,
+	report *validationapi.ListenerReport,
+) *envoy_config_core_v3.Address {
+	_, isIpv4Address, err := IsIpv4Address(listener.GetBindAddress())
 	if err != nil {
-		validation.AppendListenerError(l.report,
+		validation.AppendListenerError(report,
 			validationapi.ListenerReport_Error_ProcessingError,
 			err.Error(),
 		)
@@ -102,10 +107,10 @@ func (l *listenerTranslatorInstance) computeListenerAddress() *envoy_config_core
 	return &envoy_config_core_v3.Address{
 		Address: &envoy_config_core_v3.Address_SocketAddress{
 			SocketAddress: &envoy_config_core_v3.SocketAddress{
-				Protocol: envoy_config_core_v3.SocketAddress_TCP,
-				Address:  l.listener.GetBindAddress(),
+				Protocol: protocol,
+				Address:  listener.GetBindAddress(),
 				PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
-					PortValue: l.listener.GetBindPort(),
+					PortValue: port,
 				},
 				// As of Envoy 1.22: https://www.envoyproxy.io/docs/envoy/latest/version_history/v1.22/v1.22.0.html
 				// the Ipv4Compat flag can only be set on Ipv6 address and Ipv4-mapped Ipv6 address.
@@ -116,10 +121,19 @@ func (l *listenerTranslatorInstance) computeListenerAddress() *envoy_config_core
 	}
 }
 
+// listenerPort is a port that a listener binds on, for a given transport protocol
+type listenerPort struct {
+	protocol envoy_config_core_v3.SocketAddress_Protocol
+	port     uint32
+}
+
 func validateListenerPorts(proxy *v1.Proxy, listenerReport *validationapi.ListenerReport) {
-	listenersByPort := make(map[uint32][]int)
+	// TCP and UDP sockets may share a port number, so ports are only required to be unique per protocol
+	listenersByPort := make(map[listenerPort][]int)
 	for i, listener := range proxy.GetListeners() {
-		listenersByPort[listener.GetBindPort()] = append(listenersByPort[listener.GetBindPort()], i)
+		for _, port := range getListenerPorts(listener) {
+			listenersByPort[port] = append(listenersByPort[port], i)
+		}
 	}
 	for port, listeners := range listenersByPort {
 		if len(listeners) == 1 {
@@ -131,11 +145,23 @@ func validateListenerPorts(proxy *v1.Proxy, listenerReport *validationapi.Listen
 		}
 		validation.AppendListenerError(listenerReport,
 			validationapi.ListenerReport_Error_BindPortNotUniqueError,
-			fmt.Sprintf("port %v is shared by listeners %v", port, listeners),
+			fmt.Sprintf("port %v is shared by listeners %v", port.port, listeners),
 		)
 	}
 }
 
+// getListenerPorts returns the ports that a listener binds on
+func getListenerPorts(listener *v1.Listener) []listenerPort {
+	if listener.GetUdpListener() != nil {
+		return []listenerPort{{protocol: envoy_config_core_v3.SocketAddress_UDP, port: listener.GetBindPort()}}
+	}
+	ports := []listenerPort{{protocol: envoy_config_core_v3.SocketAddress_TCP, port: listener.GetBindPort()}}
+	if quicSettings, err := GetQuicSettings(listener); err == nil && quicSettings != nil {
+		ports = append(ports, listenerPort{protocol: envoy_config_core_v3.SocketAddress_UDP, port: quicPort(listener, quicSettings)})
+	}
+	return ports
+}
+
 // CheckForFilterChainConsistency to avoid the envoy error that occurs here:
 // https://github.com/envoyproxy/envoy/blob/v1.15.0/source/server/filter_chain_manager_impl.cc#L162-L166
 // Note: this is NOT address non-equal but overlapping FilterChainMatches, which is a separate check here:
@@ -17,13 +17,15 @@ import (
 // Gloo sends resources to Envoy via xDS. The components of the Listener subsystem that Gloo configures are:
 // 1. Listeners
 // 2. RouteConfiguration
-// Given that Gloo exposes a variety of ListenerTypes (HttpListener, TcpListener, HybridListener, AggregateListener), and each of these types
+// Given that Gloo exposes a variety of ListenerTypes (HttpListener, TcpListener, HybridListener, AggregateListener, UdpListener), and each of these types
 // affect how resources are generated, we abstract those implementation details behind abstract translators.
 // The ListenerSubsystemTranslatorFactory returns a ListenerTranslator and RouteConfigurationTranslator for a given Gloo Listener
 type ListenerSubsystemTranslatorFactory struct {
 	pluginRegistry      plugins.PluginRegistry
 	sslConfigTranslator utils.SslConfigTranslator
 	settings            *v1.Settings
+	// memo is set by the translator for the duration of a translation which reuses virtual hosts, it is nil otherwise
+	memo *translationMemo
 }
 
 func NewListenerSubsystemTranslatorFactory(
@@ -54,6 +56,9 @@ func (l *ListenerSubsystemTranslatorFactory) GetTranslators(ctx context.Context,
 
 	case *v1.Listener_AggregateListener:
 		return l.GetAggregateListenerTranslators(ctx, proxy, listener, listenerReport)
+
+	case *v1.Listener_UdpListener:
+		return l.GetUdpListenerTranslators(ctx, listener, listenerReport)
 	default:
 		// This case should never occur
 		return &emptyListenerTranslator{}, &emptyRouteConfigurationTranslator{}
@@ -124,6 +129,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 		report:                   httpListenerReport,
 		routeConfigName:          routeConfigurationName,
 		requireTlsOnVirtualHosts: len(listener.GetSslConfigurations()) > 0,
+		memo:                     l.memo,
 	}
 
 	return listenerTranslator, routeConfigurationTranslator
@@ -164,6 +170,27 @@ func (l *ListenerSubsystemTranslatorFactory) GetTcpListenerTranslators(ctx conte
 	return listenerTranslator, routeConfigurationTranslator
 }
 
+func (l *ListenerSubsystemTranslatorFactory) GetUdpListenerTranslators(ctx context.Context, listener *v1.Listener, listenerReport *validationapi.ListenerReport) (
+	ListenerTranslator,
+	RouteConfigurationTranslator,
+) {
+	if listener.GetUdpListener() == nil {
+		contextutils.LoggerFrom(ctx).DPanic("internal error: listener was not udp type")
+	}
+
+	// This translator produces a single Listener, bound to a UDP socket
+	// UDP datagrams are proxied by a ListenerFilter, so there are no FilterChains
+	listenerTranslator := &udpListenerTranslator{
+		listener: listener,
+		report:   listenerReport,
+	}
+
+	// A UdpListener does not produce any RouteConfiguration
+	routeConfigurationTranslator := &emptyRouteConfigurationTranslator{}
+
+	return listenerTranslator, routeConfigurationTranslator
+}
+
 func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx context.Context, proxy *v1.Proxy, listener *v1.Listener, listenerReport *validationapi.ListenerReport) (
 	ListenerTranslator,
 	RouteConfigurationTranslator,
@@ -238,6 +265,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx co
 				report:                   httpListenerReport,
 				routeConfigName:          routeConfigurationName,
 				requireTlsOnVirtualHosts: matcher.GetSslConfig() != nil,
+				memo:                     l.memo,
 			}
 
 		case *v1.MatchedListener_TcpListener:
@@ -372,6 +400,7 @@ func (l *ListenerSubsystemTranslatorFactory) GetAggregateListenerTranslators(ctx
 			report:                   httpListenerReport,
 			routeConfigName:          routeConfigurationName,
 			requireTlsOnVirtualHosts: httpFilterChain.GetMatcher().GetSslConfig() != nil,
+			memo:                     l.memo,
 		}
 
 		filterChainTranslators = append(filterChainTranslators, filterChainTranslator)
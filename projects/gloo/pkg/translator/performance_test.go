@@ -3,7 +3,11 @@ package translator_test
 import (
 	"context"
 	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"github.com/solo-io/gloo/test/ginkgo/decorators"
 	"go.uber.org/zap"
 
@@ -24,6 +28,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	envoytypes "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"go.uber.org/mock/gomock"
 
@@ -45,9 +50,10 @@ import (
 // Results can then be found in the logs for that instance of the action
 var _ = Describe("Translation - Benchmarking Tests", decorators.Performance, Label(labels.Performance), func() {
 	var (
-		ctrl       *gomock.Controller
-		settings   *v1.Settings
-		translator Translator
+		ctrl              *gomock.Controller
+		settings          *v1.Settings
+		translator        Translator
+		newPluginRegistry func() plugins.PluginRegistry
 	)
 
 	BeforeEach(func() {
@@ -65,10 +71,12 @@ var _ = Describe("Translation - Benchmarking Tests", decorators.Performance, Lab
 				ConsulWatcher: mock_consul.NewMockConsulWatcher(ctrl), // just needed to activate the consul plugin
 			},
 		}
-		registeredPlugins := registry.Plugins(registry.FromBootstrap(opts))
-		pluginRegistry := registry.NewPluginRegistry(registeredPlugins)
+		// plugins hold the state of a translation, so every translator gets plugins of its own
+		newPluginRegistry = func() plugins.PluginRegistry {
+			return registry.NewPluginRegistry(registry.Plugins(registry.FromBootstrap(opts)))
+		}
 
-		translator = NewTranslatorWithHasher(glooutils.NewSslConfigTranslator(), settings, pluginRegistry, EnvoyCacheResourcesListToFnvHash)
+		translator = NewTranslatorWithHasher(glooutils.NewSslConfigTranslator(), settings, newPluginRegistry(), EnvoyCacheResourcesListToFnvHash)
 	})
 
 	// The Benchmark table takes entries consisting of an ApiSnapshot, benchmarkConfig, and labels
@@ -157,8 +165,148 @@ var _ = Describe("Translation - Benchmarking Tests", decorators.Performance, Lab
 		Entry(nil, gloohelpers.NewScaledSnapshotBuilder().WithUpstreamCount(1).WithEndpointCount(1).WithSecretCount(10), basicConfig, "secret scale"),
 		Entry(nil, gloohelpers.NewScaledSnapshotBuilder().WithUpstreamCount(1).WithEndpointCount(1).WithSecretCount(1000), basicConfig, "secret scale"),
 	)
+
+	// The memoizing translator only computes the endpoints of a proxy again when nothing but the endpoints changed,
+	// which is the most frequent update of a snapshot, and translates distinct proxies concurrently when sharded.
+	// Both are compared with the translator above, and must produce the same snapshots.
+	Context("Memoized translation", func() {
+
+		var (
+			params           plugins.Params
+			upstreamsCounter *upstreamCountingPlugin
+		)
+
+		BeforeEach(func() {
+			// Translating logs at info level, which is very noisy when running repeatedly
+			originalLogLevel := contextutils.GetLogLevel()
+			contextutils.SetLogLevel(zap.ErrorLevel)
+			DeferCleanup(contextutils.SetLogLevel, originalLogLevel)
+
+			params = plugins.Params{
+				Ctx:      context.Background(),
+				Snapshot: gloohelpers.NewScaledSnapshotBuilder().WithUpstreamCount(1000).WithEndpointCount(1000).WithSecretCount(1).Build(),
+			}
+			upstreamsCounter = &upstreamCountingPlugin{}
+		})
+
+		newMemoizingTranslator := func() Translator {
+			pluginRegistry := registry.NewPluginRegistry(append(newPluginRegistry().GetPlugins(), upstreamsCounter))
+			return NewMemoizingTranslator(glooutils.NewSslConfigTranslator(), settings, pluginRegistry, EnvoyCacheResourcesListToFnvHash)
+		}
+
+		// flapEndpoint moves one of the endpoints to another address, as happens when a pod is replaced
+		flapEndpoint := func(idx int) {
+			endpoint := params.Snapshot.Endpoints[idx%len(params.Snapshot.Endpoints)]
+			endpoint.Address = fmt.Sprintf("10.0.%d.%d", (idx/256)%256, idx%256)
+		}
+
+		It("translates an endpoint update without the upstream plugins and without changing the snapshot", func() {
+			memoizingTranslator := newMemoizingTranslator()
+			proxy := params.Snapshot.Proxies[0]
+			// the first translation is memoized
+			memoizingTranslator.Translate(params, proxy)
+			Expect(upstreamsCounter.calls.Load()).To(BeNumerically(">", 0))
+
+			experiment := gmeasure.NewExperiment("Memoized translation of an endpoint update")
+			AddReportEntry(experiment.Name, experiment)
+
+			experiment.Sample(func(idx int) {
+				flapEndpoint(idx)
+
+				var snap, memoizedSnap cache.Snapshot
+				experiment.MeasureDuration("translation", func() {
+					snap, _, _ = translator.Translate(params, proxy)
+				})
+				upstreamsCounter.calls.Store(0)
+				experiment.MeasureDuration("memoized translation", func() {
+					memoizedSnap, _, _ = memoizingTranslator.Translate(params, proxy)
+				})
+				expectSameSnapshot(memoizedSnap, snap)
+				// the clusters are reused, rather than the upstreams being processed again
+				Expect(upstreamsCounter.calls.Load()).To(BeZero())
+			}, gmeasure.SamplingConfig{N: 20, Duration: time.Minute})
+		})
+
+		It("translates distinct proxies concurrently without changing the snapshots", func() {
+			const proxyCount = 4
+			proxies := make(v1.ProxyList, proxyCount)
+			for i := range proxies {
+				proxies[i] = gloohelpers.Proxy(len(params.Snapshot.Upstreams))
+				proxies[i].GetMetadata().Name = fmt.Sprintf("proxy-%d", i)
+			}
+			params.Snapshot.Proxies = proxies
+
+			shards := make([]Translator, proxyCount)
+			for i := range shards {
+				shards[i] = newMemoizingTranslator()
+			}
+			shardedTranslator := NewShardedTranslator(shards...)
+
+			experiment := gmeasure.NewExperiment("Concurrent translation of proxies")
+			AddReportEntry(experiment.Name, experiment)
+
+			experiment.Sample(func(idx int) {
+				// the proxies change, so that they are translated rather than reused from memory
+				for _, proxy := range proxies {
+					proxy.GetMetadata().Labels = map[string]string{"sample": fmt.Sprint(idx)}
+				}
+
+				snaps := make([]cache.Snapshot, proxyCount)
+				experiment.MeasureDuration("serial translation", func() {
+					for i, proxy := range proxies {
+						snaps[i], _, _ = translator.Translate(params, proxy)
+					}
+				})
+
+				concurrentSnaps := make([]cache.Snapshot, proxyCount)
+				experiment.MeasureDuration("concurrent translation", func() {
+					var wg sync.WaitGroup
+					for i, proxy := range proxies {
+						wg.Go(func() {
+							concurrentSnaps[i], _, _ = shardedTranslator.Translate(params, proxy)
+						})
+					}
+					wg.Wait()
+				})
+
+				for i := range proxies {
+					expectSameSnapshot(concurrentSnaps[i], snaps[i])
+				}
+			}, gmeasure.SamplingConfig{N: 10, Duration: time.Minute})
+
+			if runtime.GOMAXPROCS(0) < 2 {
+				Skip("proxies cannot be translated in parallel with a single CPU")
+			}
+			serialTranslation := experiment.GetStats("serial translation").DurationFor(gmeasure.StatMedian)
+			concurrentTranslation := experiment.GetStats("concurrent translation").DurationFor(gmeasure.StatMedian)
+			Expect(concurrentTranslation).To(BeNumerically("<", serialTranslation))
+		})
+	})
 })
 
+// expectSameSnapshot compares the versions of the resources of the snapshots, which are the hashes of the resources
+func expectSameSnapshot(actual, expected cache.Snapshot) {
+	for _, typeUrl := range []string{envoytypes.EndpointTypeV3, envoytypes.ClusterTypeV3, envoytypes.RouteTypeV3, envoytypes.ListenerTypeV3} {
+		ExpectWithOffset(1, actual.GetResources(typeUrl).Version).To(Equal(expected.GetResources(typeUrl).Version), typeUrl)
+	}
+}
+
+// upstreamCountingPlugin counts the upstreams it processes
+type upstreamCountingPlugin struct {
+	calls atomic.Int64
+}
+
+func (p *upstreamCountingPlugin) Name() string {
+	return "upstream-counting"
+}
+
+func (p *upstreamCountingPlugin) Init(_ plugins.InitParams) {}
+
+func (p *upstreamCountingPlugin) ProcessUpstream(_ plugins.Params, _ *v1.Upstream, _ *envoy_config_cluster_v3.Cluster) error {
+	p.calls.Add(1)
+	return nil
+}
+
 // Test assets: Add blocks for logical groupings of tests, including:
 // - in-line snapshot definitions for tests that require granularly-configured/heterogeneous resources (ie testing a particular field or feature)
 // - benchmarkConfigs for particular groups of use cases depending on processing time requirements/expectations
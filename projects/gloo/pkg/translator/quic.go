@@ -0,0 +1,243 @@
+package translator
+
+import (
+	"fmt"
+	"sort"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoyquic "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quic"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+)
+
+const (
+	// QuicListenerSuffix is appended to the name of an HttpListener to name the UDP listener that serves HTTP/3 for it
+	QuicListenerSuffix = "-quic"
+
+	AltSvcHeader        = "alt-svc"
+	DefaultAltSvcMaxAge = 86400
+)
+
+var (
+	QuicSettingsConflictError = func(listener *v1.Listener) error {
+		return eris.Errorf("the HttpListeners of listener %s share a single UDP listener for HTTP/3, "+
+			"so they must use the same quic settings", listener.GetName())
+	}
+)
+
+// GetQuicSettings returns the QuicSettings of a listener, or nil if HTTP/3 should not be served for it.
+// HTTP/3 is only supported on HttpListeners that terminate TLS. The HttpListeners of a HybridListener or an
+// AggregateListener are served by a single UDP listener, so an error is returned if their QuicSettings differ.
+func GetQuicSettings(listener *v1.Listener) (*quic.QuicSettings, error) {
+	quicHttpListeners := getQuicHttpListeners(listener)
+	routeConfigNames := make([]string, 0, len(quicHttpListeners))
+	for routeConfigName := range quicHttpListeners {
+		routeConfigNames = append(routeConfigNames, routeConfigName)
+	}
+	sort.Strings(routeConfigNames)
+
+	var quicSettings *quic.QuicSettings
+	for _, routeConfigName := range routeConfigNames {
+		if quicSettings != nil && !proto.Equal(quicSettings, quicHttpListeners[routeConfigName]) {
+			return nil, QuicSettingsConflictError(listener)
+		}
+		quicSettings = quicHttpListeners[routeConfigName]
+	}
+	return quicSettings, nil
+}
+
+// getQuicRouteConfigSettings returns the QuicSettings of the HttpListener which owns the RouteConfiguration,
+// or nil if HTTP/3 is not served for it
+func getQuicRouteConfigSettings(listener *v1.Listener, routeConfigName string) *quic.QuicSettings {
+	if _, err := GetQuicSettings(listener); err != nil {
+		return nil
+	}
+	return getQuicHttpListeners(listener)[routeConfigName]
+}
+
+// getQuicHttpListeners returns the QuicSettings of the HttpListeners of a listener that serve HTTP/3,
+// keyed by the name of their RouteConfiguration
+func getQuicHttpListeners(listener *v1.Listener) map[string]*quic.QuicSettings {
+	quicHttpListeners := map[string]*quic.QuicSettings{}
+	switch listenerType := listener.GetListenerType().(type) {
+	case *v1.Listener_HttpListener:
+		quicSettings := listenerType.HttpListener.GetOptions().GetQuic()
+		if quicSettings != nil && len(listener.GetSslConfigurations()) > 0 {
+			quicHttpListeners[utils.RouteConfigName(listener)] = quicSettings
+		}
+	case *v1.Listener_HybridListener:
+		for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+			quicSettings := matchedListener.GetHttpListener().GetOptions().GetQuic()
+			// the SslConfig of the matcher only provides defaults for the SslConfigurations of the MatchedListener
+			if quicSettings != nil && len(matchedListener.GetSslConfigurations()) > 0 {
+				quicHttpListeners[utils.MatchedRouteConfigName(listener, matchedListener.GetMatcher())] = quicSettings
+			}
+		}
+	case *v1.Listener_AggregateListener:
+		httpOptions := listenerType.AggregateListener.GetHttpResources().GetHttpOptions()
+		for _, httpFilterChain := range listenerType.AggregateListener.GetHttpFilterChains() {
+			quicSettings := httpOptions[httpFilterChain.GetHttpOptionsRef()].GetQuic()
+			if quicSettings != nil && httpFilterChain.GetMatcher().GetSslConfig() != nil {
+				quicHttpListeners[utils.MatchedRouteConfigName(listener, httpFilterChain.GetMatcher())] = quicSettings
+			}
+		}
+	}
+	return quicHttpListeners
+}
+
+// QuicListenerName returns the name of the UDP listener that serves HTTP/3 for a listener
+func QuicListenerName(listener *v1.Listener) string {
+	return listener.GetName() + QuicListenerSuffix
+}
+
+// quicPort returns the UDP port that QUIC connections are accepted on
+func quicPort(listener *v1.Listener, quicSettings *quic.QuicSettings) uint32 {
+	if port := quicSettings.GetPort(); port != nil {
+		return port.GetValue()
+	}
+	return listener.GetBindPort()
+}
+
+// altSvcHeaderValue returns the value of the alt-svc header which advertises HTTP/3 support to clients
+// https://www.rfc-editor.org/rfc/rfc7838
+func altSvcHeaderValue(listener *v1.Listener, quicSettings *quic.QuicSettings) string {
+	port := quicPort(listener, quicSettings)
+	if advertisedPort := quicSettings.GetAdvertisedPort(); advertisedPort != nil {
+		port = advertisedPort.GetValue()
+	}
+	maxAge := uint32(DefaultAltSvcMaxAge)
+	if quicSettings.GetAltSvcMaxAge() != nil {
+		maxAge = quicSettings.GetAltSvcMaxAge().GetValue()
+	}
+	return fmt.Sprintf(`h3=":%d"; ma=%d`, port, maxAge)
+}
+
+// computeQuicListener derives the UDP listener that serves HTTP/3 from the TCP listener computed for the same Listener.
+// Each TLS FilterChain of an HttpListener with QuicSettings is copied with its DownstreamTlsContext wrapped in a QUIC transport socket and its
+// HttpConnectionManager switched to the HTTP/3 codec, so both listeners share the same TLS and HTTP configuration.
+// Returns nil if HTTP/3 is not enabled for the listener.
+func computeQuicListener(
+	listener *v1.Listener,
+	tcpListener *envoy_config_listener_v3.Listener,
+	report *validationapi.ListenerReport,
+) *envoy_config_listener_v3.Listener {
+	quicSettings, err := GetQuicSettings(listener)
+	if err != nil {
+		validation.AppendListenerError(report,
+			validationapi.ListenerReport_Error_ProcessingError,
+			err.Error())
+		return nil
+	}
+	if quicSettings == nil || tcpListener == nil {
+		return nil
+	}
+	quicHttpListeners := getQuicHttpListeners(listener)
+
+	var filterChains []*envoy_config_listener_v3.FilterChain
+	for _, filterChain := range tcpListener.GetFilterChains() {
+		quicFilterChain, err := toQuicFilterChain(filterChain, quicHttpListeners)
+		if err != nil {
+			validation.AppendListenerError(report,
+				validationapi.ListenerReport_Error_ProcessingError,
+				err.Error())
+			continue
+		}
+		if quicFilterChain != nil {
+			filterChains = append(filterChains, quicFilterChain)
+		}
+	}
+	if len(filterChains) == 0 {
+		return nil
+	}
+
+	return &envoy_config_listener_v3.Listener{
+		Name:         QuicListenerName(listener),
+		Address:      computeListenerAddress(listener, envoy_config_core_v3.SocketAddress_UDP, quicPort(listener, quicSettings), report),
+		FilterChains: filterChains,
+		UdpListenerConfig: &envoy_config_listener_v3.UdpListenerConfig{
+			QuicOptions: &envoy_config_listener_v3.QuicProtocolOptions{
+				QuicProtocolOptions: &envoy_config_core_v3.QuicProtocolOptions{
+					MaxConcurrentStreams: quicSettings.GetMaxConcurrentStreams(),
+				},
+				IdleTimeout: quicSettings.GetIdleTimeout(),
+			},
+		},
+	}
+}
+
+// toQuicFilterChain converts a TLS FilterChain into one which terminates QUIC.
+// Plaintext FilterChains cannot be served over QUIC, and nil is returned for them, as well as for the FilterChains of
+// HttpListeners that do not serve HTTP/3.
+// Network filters other than the HttpConnectionManager do not apply to QUIC connections and are dropped.
+func toQuicFilterChain(
+	filterChain *envoy_config_listener_v3.FilterChain,
+	quicHttpListeners map[string]*quic.QuicSettings,
+) (*envoy_config_listener_v3.FilterChain, error) {
+	if filterChain.GetTransportSocket().GetName() != wellknown.TransportSocketTls {
+		return nil, nil
+	}
+
+	downstreamTlsContext := &envoyauth.DownstreamTlsContext{}
+	if err := ParseTypedConfig(filterChain.GetTransportSocket(), downstreamTlsContext); err != nil {
+		return nil, err
+	}
+	quicTransport, err := utils.MessageToAny(&envoyquic.QuicDownstreamTransport{
+		DownstreamTlsContext: downstreamTlsContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []*envoy_config_listener_v3.Filter
+	for _, filter := range filterChain.GetFilters() {
+		if filter.GetName() != wellknown.HTTPConnectionManager {
+			continue
+		}
+		hcm := &envoyhttp.HttpConnectionManager{}
+		if err := ParseTypedConfig(filter, hcm); err != nil {
+			return nil, err
+		}
+		if _, ok := quicHttpListeners[hcm.GetRds().GetRouteConfigName()]; !ok {
+			continue
+		}
+		hcm.CodecType = envoyhttp.HttpConnectionManager_HTTP3
+		if hcm.GetHttp3ProtocolOptions() == nil {
+			hcm.Http3ProtocolOptions = &envoy_config_core_v3.Http3ProtocolOptions{}
+		}
+		quicFilter, err := NewFilterWithTypedConfig(wellknown.HTTPConnectionManager, hcm)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, quicFilter)
+	}
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	var filterChainMatch *envoy_config_listener_v3.FilterChainMatch
+	if filterChain.GetFilterChainMatch() != nil {
+		filterChainMatch = proto.Clone(filterChain.GetFilterChainMatch()).(*envoy_config_listener_v3.FilterChainMatch)
+		// QUIC connections are not matched on the TLS transport protocol or ALPN
+		filterChainMatch.TransportProtocol = ""
+		filterChainMatch.ApplicationProtocols = nil
+	}
+
+	return &envoy_config_listener_v3.FilterChain{
+		Name:             filterChain.GetName(),
+		FilterChainMatch: filterChainMatch,
+		Filters:          filters,
+		TransportSocket: &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketQuic,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: quicTransport},
+		},
+	}, nil
+}
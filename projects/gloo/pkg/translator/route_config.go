@@ -85,6 +85,8 @@ type httpRouteConfigurationTranslator struct {
 	report                   *validationapi.HttpListenerReport
 	routeConfigName          string
 	requireTlsOnVirtualHosts bool
+	// memo reuses the virtual hosts of previous translations, it is nil if they are not memoized
+	memo *translationMemo
 }
 
 func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(params plugins.Params) []*envoy_config_route_v3.RouteConfiguration {
@@ -104,6 +106,17 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(params plug
 		cfg.MostSpecificHeaderMutationsWins = mostSpecificVal.GetValue()
 	}
 
+	if quicSettings := getQuicRouteConfigSettings(h.parentListener, h.routeConfigName); quicSettings != nil {
+		// advertise the HTTP/3 listener to clients, so they can upgrade from HTTP/1.1 or HTTP/2
+		cfg.ResponseHeadersToAdd = append(cfg.GetResponseHeadersToAdd(), &envoy_config_core_v3.HeaderValueOption{
+			Header: &envoy_config_core_v3.HeaderValue{
+				Key:   AltSvcHeader,
+				Value: altSvcHeaderValue(h.parentListener, quicSettings),
+			},
+			AppendAction: envoy_config_core_v3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
+
 	return []*envoy_config_route_v3.RouteConfiguration{cfg}
 }
 
@@ -111,6 +124,7 @@ func (h *httpRouteConfigurationTranslator) computeVirtualHosts(params plugins.Pa
 	virtualHosts := h.listener.GetVirtualHosts()
 	ValidateVirtualHostDomains(virtualHosts, h.report)
 
+	memo := h.memo.listener(params.Ctx, h)
 	var envoyVirtualHosts []*envoy_config_route_v3.VirtualHost
 	for i, virtualHost := range virtualHosts {
 		vhostParams := plugins.VirtualHostParams{
@@ -120,7 +134,9 @@ func (h *httpRouteConfigurationTranslator) computeVirtualHosts(params plugins.Pa
 			Proxy:        h.proxy,
 		}
 		vhostReport := h.report.GetVirtualHostReports()[i]
-		envoyVirtualHosts = append(envoyVirtualHosts, h.computeVirtualHost(vhostParams, virtualHost, vhostReport))
+		envoyVirtualHosts = append(envoyVirtualHosts, memo.virtualHost(virtualHost, vhostReport, func(report *validationapi.VirtualHostReport) *envoy_config_route_v3.VirtualHost {
+			return h.computeVirtualHost(vhostParams, virtualHost, report)
+		}))
 	}
 	return envoyVirtualHosts
 }
@@ -141,6 +157,7 @@ func (h *httpRouteConfigurationTranslator) computeVirtualHost(
 		routeParams := plugins.RouteParams{
 			VirtualHostParams: params,
 			VirtualHost:       virtualHost,
+			RouteIndex:        i,
 		}
 		routeReport := vhostReport.GetRouteReports()[i]
 		generatedName := fmt.Sprintf("%s-route-%d", virtualHost.GetName(), i)
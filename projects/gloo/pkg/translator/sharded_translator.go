@@ -0,0 +1,45 @@
+package translator
+
+import (
+	"hash/fnv"
+
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+var (
+	_ Translator = new(shardedTranslator)
+)
+
+// shardedTranslator spreads the proxies over a set of translators, so that distinct proxies can be translated concurrently.
+// A proxy is always translated by the same translator, which lets that translator reuse its memoized translation.
+type shardedTranslator struct {
+	shards []Translator
+}
+
+// NewShardedTranslator returns a Translator which translates up to len(translators) proxies at a time.
+// Each translator must own its plugins, since plugins hold the state of the translation in progress.
+func NewShardedTranslator(translators ...Translator) Translator {
+	if len(translators) == 1 {
+		return translators[0]
+	}
+	return &shardedTranslator{
+		shards: translators,
+	}
+}
+
+func (s *shardedTranslator) Translate(
+	params plugins.Params,
+	proxy *v1.Proxy,
+) (envoycache.Snapshot, reporter.ResourceReports, *validationapi.ProxyReport) {
+	return s.shards[s.shardOf(proxy)].Translate(params, proxy)
+}
+
+func (s *shardedTranslator) shardOf(proxy *v1.Proxy) int {
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(proxy.GetMetadata().Ref().Key()))
+	return int(hasher.Sum32() % uint32(len(s.shards)))
+}
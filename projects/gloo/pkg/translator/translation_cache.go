@@ -0,0 +1,602 @@
+package translator
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"slices"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	proto2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// proxyTranslation holds the resources translated from a proxy, apart from the endpoints of its clusters.
+// They only change with the proxy and the resources it refers to, whereas endpoints churn with the
+// workloads behind the upstreams, so the translation can be memoized across endpoint updates.
+type proxyTranslation struct {
+	clusters     []*envoy_config_cluster_v3.Cluster
+	edsClusters  []edsCluster
+	routeConfigs []*envoy_config_route_v3.RouteConfiguration
+	listeners    []*envoy_config_listener_v3.Listener
+	// endpoints generated by the resource generator plugins, which are added after the computed ones
+	generatedEndpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment
+
+	reports     reporter.ResourceReports
+	proxyReport *validationapi.ProxyReport
+
+	// versions of the clusters and listeners, which are only set once the translation is memoized
+	versions *xdsVersions
+}
+
+// xdsVersions are the versions that generateXDSSnapshot gives to the clusters and listeners of a translation
+type xdsVersions struct {
+	clusters  uint64
+	listeners uint64
+}
+
+// edsCluster is a cluster which gets the endpoints of its upstream over EDS
+type edsCluster struct {
+	name                string
+	endpointClusterName string
+	upstream            *core.ResourceRef
+}
+
+// reuse returns a copy of the translation which can be handed out alongside the original one, with the reports
+// attributed to the resources of the given snapshot. The clusters, listeners and endpoints are shared, since they are
+// only read once translated, whereas the route configurations are copied, since the sanitizers replace their routes.
+func (p *proxyTranslation) reuse(snap *v1snap.ApiSnapshot, proxy *v1.Proxy) *proxyTranslation {
+	return &proxyTranslation{
+		clusters:           p.clusters,
+		edsClusters:        p.edsClusters,
+		routeConfigs:       cloneAll(p.routeConfigs),
+		listeners:          p.listeners,
+		generatedEndpoints: p.generatedEndpoints,
+		reports:            rekeyReports(p.reports, snap, proxy),
+		proxyReport:        proto.Clone(p.proxyReport).(*validationapi.ProxyReport),
+		versions:           p.versions,
+	}
+}
+
+func cloneAll[T proto.Message](messages []T) []T {
+	if messages == nil {
+		return nil
+	}
+	out := make([]T, len(messages))
+	for i, message := range messages {
+		out[i] = proto.Clone(message).(T)
+	}
+	return out
+}
+
+// rekeyReports copies the reports, keyed on the resources of the snapshot that have the same kind and ref as the
+// resources the reports were made for. Reports are keyed on resource pointers, so the reports of a memoized
+// translation would otherwise not be attributed to the resources of the snapshot being translated.
+func rekeyReports(reports reporter.ResourceReports, snap *v1snap.ApiSnapshot, proxy *v1.Proxy) reporter.ResourceReports {
+	out := make(reporter.ResourceReports, len(reports))
+	resourcesByKey := map[string]resources.InputResource{}
+	indexedKinds := map[string]bool{}
+	for res, report := range reports {
+		key := reportKey(res)
+		if _, ok := res.(*v1.Proxy); ok && res.GetMetadata().Ref().Equal(proxy.GetMetadata().Ref()) {
+			res = proxy
+		} else {
+			kind := resources.Kind(res)
+			if !indexedKinds[kind] {
+				indexedKinds[kind] = true
+				list, _ := snap.GetResourcesList(res)
+				for _, candidate := range list {
+					if inputResource, ok := candidate.(resources.InputResource); ok {
+						resourcesByKey[reportKey(inputResource)] = inputResource
+					}
+				}
+			}
+			if current, ok := resourcesByKey[key]; ok {
+				res = current
+			}
+		}
+		out[res] = reporter.Report{
+			Warnings: slices.Clone(report.Warnings),
+			Errors:   report.Errors,
+			Messages: slices.Clone(report.Messages),
+		}
+	}
+	return out
+}
+
+func reportKey(res resources.InputResource) string {
+	return fmt.Sprintf("%s/%s", resources.Kind(res), res.GetMetadata().Ref().Key())
+}
+
+// translationInputs are the hashes of the inputs of a translation, apart from the proxy and the endpoints.
+type translationInputs struct {
+	// clusters hashes the inputs that every cluster is translated from, apart from its upstream
+	clusters uint64
+	// routes hashes the inputs that every virtual host is translated from, apart from its listener
+	routes uint64
+	// upstreams holds the hash of each upstream, by ref key
+	upstreams map[string]uint64
+}
+
+// hashInputs hashes the settings and the resources of the snapshot that plugins refer to, alongside the versions of
+// the state that plugins fetch from outside the snapshot.
+// The gateway resources are left out, since they are only translated into the proxies.
+func hashInputs(params plugins.Params, externalVersions []string) (*translationInputs, error) {
+	snap := params.Snapshot
+	hasher := fnv.New64()
+	if _, err := params.Settings.Hash(hasher); err != nil {
+		return nil, err
+	}
+	if err := hashAll(hasher, snap.Secrets); err != nil {
+		return nil, err
+	}
+	if err := hashAll(hasher, snap.Artifacts); err != nil {
+		return nil, err
+	}
+	for _, version := range externalVersions {
+		if err := hashString(hasher, version); err != nil {
+			return nil, err
+		}
+	}
+	inputs := &translationInputs{
+		clusters:  hasher.Sum64(),
+		upstreams: make(map[string]uint64, len(snap.Upstreams)),
+	}
+
+	// routes are translated from the upstreams they refer to
+	if err := binary.Write(hasher, binary.LittleEndian, uint64(len(snap.Upstreams))); err != nil {
+		return nil, err
+	}
+	for _, upstream := range snap.Upstreams {
+		upstreamHash, err := upstream.Hash(nil)
+		if err != nil {
+			return nil, err
+		}
+		inputs.upstreams[upstream.GetMetadata().Ref().Key()] = upstreamHash
+		if err := binary.Write(hasher, binary.LittleEndian, upstreamHash); err != nil {
+			return nil, err
+		}
+	}
+	if err := hashAll(hasher, snap.UpstreamGroups); err != nil {
+		return nil, err
+	}
+	if err := hashAll(hasher, snap.AuthConfigs); err != nil {
+		return nil, err
+	}
+	if err := hashAll(hasher, snap.Ratelimitconfigs); err != nil {
+		return nil, err
+	}
+	inputs.routes = hasher.Sum64()
+	return inputs, nil
+}
+
+// translationKey hashes the inputs of the memoized translation of the proxy: the proxy and the other inputs,
+// apart from the endpoints, of which it only matters whether an upstream has any.
+func translationKey(inputs *translationInputs, params plugins.Params, proxy *v1.Proxy, upstreamRefKeyToEndpoints map[string][]*v1.Endpoint) (uint64, error) {
+	hasher := fnv.New64()
+	if err := binary.Write(hasher, binary.LittleEndian, inputs.routes); err != nil {
+		return 0, err
+	}
+	for _, upstream := range params.Snapshot.Upstreams {
+		// clusters are only served over EDS if their upstream has endpoints
+		hasEndpoints := len(upstreamRefKeyToEndpoints[upstream.GetMetadata().Ref().Key()]) > 0
+		if err := binary.Write(hasher, binary.LittleEndian, hasEndpoints); err != nil {
+			return 0, err
+		}
+	}
+	if err := hashProxy(hasher, proxy); err != nil {
+		return 0, err
+	}
+	return hasher.Sum64(), nil
+}
+
+// hashProxy hashes the proxy apart from its statuses. The listeners are hashed from their serialization, since the
+// metadata of their sources, which is translated, is skipped by their hash functions.
+func hashProxy(hasher hash.Hash64, proxy *v1.Proxy) error {
+	if _, err := proxy.GetMetadata().Hash(hasher); err != nil {
+		return err
+	}
+	if _, err := hasher.Write([]byte(proxy.GetCompressedSpec())); err != nil {
+		return err
+	}
+	mo := proto2.MarshalOptions{Deterministic: true}
+	var buf []byte
+	for _, listener := range proxy.GetListeners() {
+		var err error
+		buf, err = mo.MarshalAppend(buf[:0], listener)
+		if err != nil {
+			return err
+		}
+		if err := binary.Write(hasher, binary.LittleEndian, uint64(len(buf))); err != nil {
+			return err
+		}
+		if _, err := hasher.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hashAll[T interface {
+	Hash(hasher hash.Hash64) (uint64, error)
+}](hasher hash.Hash64, list []T) error {
+	// delimit the lists, so that resources are not attributed to the neighbouring list
+	if err := binary.Write(hasher, binary.LittleEndian, uint64(len(list))); err != nil {
+		return err
+	}
+	for _, res := range list {
+		if _, err := res.Hash(hasher); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hashString(hasher hash.Hash64, s string) error {
+	if err := binary.Write(hasher, binary.LittleEndian, uint64(len(s))); err != nil {
+		return err
+	}
+	_, err := hasher.Write([]byte(s))
+	return err
+}
+
+// hashMessage hashes the deterministic serialization of the message, without the fields of the given names
+// nor the fields of the oneofs of the given names.
+func hashMessage(hasher hash.Hash64, message proto2.Message, skipped ...protoreflect.Name) error {
+	in := message.ProtoReflect()
+	out := in.New()
+	in.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if slices.Contains(skipped, field.Name()) {
+			return true
+		}
+		if oneof := field.ContainingOneof(); oneof != nil && slices.Contains(skipped, oneof.Name()) {
+			return true
+		}
+		out.Set(field, value)
+		return true
+	})
+	buf, err := proto2.MarshalOptions{Deterministic: true}.Marshal(out.Interface())
+	if err != nil {
+		return err
+	}
+	if err := binary.Write(hasher, binary.LittleEndian, uint64(len(buf))); err != nil {
+		return err
+	}
+	_, err = hasher.Write(buf)
+	return err
+}
+
+func hashUint64s(values ...uint64) uint64 {
+	hasher := fnv.New64()
+	var buf []byte
+	for _, value := range values {
+		buf = binary.LittleEndian.AppendUint64(buf, value)
+	}
+	_, _ = hasher.Write(buf)
+	return hasher.Sum64()
+}
+
+// translationCache holds the latest memoized translation of each proxy, along with the clusters of the upstreams
+// and the virtual hosts of each proxy, which are reused when a translation of the proxy is not.
+// It is guarded by the lock of the translator it belongs to.
+type translationCache struct {
+	translations map[string]memoizedTranslation
+	// clusters by upstream ref key, which are shared between the proxies
+	clusters map[string]*memoizedCluster
+	// virtual hosts by proxy ref key, then by key
+	virtualHosts map[string]map[uint64]*memoizedVirtualHost
+
+	upstreamStatePlugins []plugins.UpstreamStatePlugin
+	routeStatePlugins    []plugins.RouteStatePlugin
+	externalStatePlugins []plugins.ExternalStatePlugin
+}
+
+type memoizedTranslation struct {
+	key         uint64
+	translation *proxyTranslation
+}
+
+type memoizedCluster struct {
+	key     uint64
+	cluster *envoy_config_cluster_v3.Cluster
+	errs    []error
+	// state of each of the upstream state plugins
+	state []any
+}
+
+type memoizedVirtualHost struct {
+	virtualHost *envoy_config_route_v3.VirtualHost
+	report      *validationapi.VirtualHostReport
+	// flags set by each of the route state plugins
+	routeState [][]string
+}
+
+func newTranslationCache(pluginRegistry plugins.PluginRegistry) *translationCache {
+	c := &translationCache{
+		translations: map[string]memoizedTranslation{},
+		clusters:     map[string]*memoizedCluster{},
+		virtualHosts: map[string]map[uint64]*memoizedVirtualHost{},
+	}
+	for _, plugin := range pluginRegistry.GetUpstreamPlugins() {
+		if statePlugin, ok := plugin.(plugins.UpstreamStatePlugin); ok {
+			c.upstreamStatePlugins = append(c.upstreamStatePlugins, statePlugin)
+		}
+	}
+	for _, plugin := range pluginRegistry.GetPlugins() {
+		if statePlugin, ok := plugin.(plugins.RouteStatePlugin); ok {
+			c.routeStatePlugins = append(c.routeStatePlugins, statePlugin)
+		}
+		if statePlugin, ok := plugin.(plugins.ExternalStatePlugin); ok {
+			c.externalStatePlugins = append(c.externalStatePlugins, statePlugin)
+		}
+	}
+	return c
+}
+
+// get returns the translation of the proxy memoized under the given key, or nil.
+func (c *translationCache) get(proxy *v1.Proxy, key uint64) *proxyTranslation {
+	memoized, ok := c.translations[proxy.GetMetadata().Ref().Key()]
+	if !ok || memoized.key != key {
+		return nil
+	}
+	return memoized.translation
+}
+
+// set memoizes the translation of the proxy under the given key, replacing the previous one.
+func (c *translationCache) set(proxy *v1.Proxy, key uint64, translation *proxyTranslation) {
+	c.translations[proxy.GetMetadata().Ref().Key()] = memoizedTranslation{
+		key:         key,
+		translation: translation,
+	}
+}
+
+// prune drops the translations of the proxies which are no longer in the snapshot, apart from the given one,
+// which may be translated without being part of the snapshot, e.g. during validation.
+func (c *translationCache) prune(proxy *v1.Proxy, proxies v1.ProxyList) {
+	if len(c.translations) == 0 && len(c.virtualHosts) == 0 {
+		return
+	}
+	current := make(map[string]struct{}, len(proxies)+1)
+	current[proxy.GetMetadata().Ref().Key()] = struct{}{}
+	for _, p := range proxies {
+		current[p.GetMetadata().Ref().Key()] = struct{}{}
+	}
+	for key := range c.translations {
+		if _, ok := current[key]; !ok {
+			delete(c.translations, key)
+		}
+	}
+	for key := range c.virtualHosts {
+		if _, ok := current[key]; !ok {
+			delete(c.virtualHosts, key)
+		}
+	}
+}
+
+// begin starts a translation of the proxy, returning the memo its resources are reused from and memoized in.
+// It returns nil if a plugin opts the proxy out of memoization.
+func (c *translationCache) begin(params plugins.Params, proxy *v1.Proxy) (*translationMemo, error) {
+	var externalVersions []string
+	for _, plugin := range c.externalStatePlugins {
+		version, ok := plugin.ExternalStateVersion(params, proxy)
+		if !ok {
+			contextutils.LoggerFrom(params.Ctx).Debugf("plugin %v opted proxy %v out of memoization", plugin.Name(), proxy.GetMetadata().Ref().Key())
+			return nil, nil
+		}
+		externalVersions = append(externalVersions, version)
+	}
+	inputs, err := hashInputs(params, externalVersions)
+	if err != nil {
+		return nil, err
+	}
+	return &translationMemo{
+		cache:        c,
+		proxy:        proxy,
+		inputs:       inputs,
+		clusters:     map[string]*memoizedCluster{},
+		virtualHosts: map[uint64]*memoizedVirtualHost{},
+	}, nil
+}
+
+// translationMemo reuses the clusters and virtual hosts of previous translations during a translation of a proxy,
+// and collects the ones it translates. Its methods translate every resource when it is nil.
+type translationMemo struct {
+	cache  *translationCache
+	proxy  *v1.Proxy
+	inputs *translationInputs
+
+	clusters     map[string]*memoizedCluster
+	virtualHosts map[uint64]*memoizedVirtualHost
+}
+
+// commit replaces the clusters and virtual hosts of the cache with the ones of the translation.
+func (m *translationMemo) commit() {
+	m.cache.clusters = m.clusters
+	m.cache.virtualHosts[m.proxy.GetMetadata().Ref().Key()] = m.virtualHosts
+}
+
+// cluster returns the cluster of the upstream, which is reused if it was translated from the same inputs,
+// in which case the upstream state plugins are given back the state they recorded for it,
+// or translated with compute otherwise.
+func (m *translationMemo) cluster(
+	upstream *v1.Upstream,
+	eds bool,
+	compute func() (*envoy_config_cluster_v3.Cluster, []error),
+) (*envoy_config_cluster_v3.Cluster, []error) {
+	if m == nil {
+		return compute()
+	}
+	refKey := upstream.GetMetadata().Ref().Key()
+	var edsBit uint64
+	if eds {
+		edsBit = 1
+	}
+	key := hashUint64s(m.inputs.clusters, m.inputs.upstreams[refKey], edsBit)
+
+	if memoized, ok := m.cache.clusters[refKey]; ok && memoized.key == key {
+		for i, plugin := range m.cache.upstreamStatePlugins {
+			if memoized.state[i] != nil {
+				plugin.SetUpstreamState(upstream, memoized.state[i])
+			}
+		}
+		m.clusters[refKey] = memoized
+		// clusters are modified once translated, e.g. by the resource generator plugins
+		return proto.Clone(memoized.cluster).(*envoy_config_cluster_v3.Cluster), memoized.errs
+	}
+
+	cluster, errs := compute()
+	state := make([]any, len(m.cache.upstreamStatePlugins))
+	for i, plugin := range m.cache.upstreamStatePlugins {
+		state[i] = plugin.UpstreamState(upstream)
+	}
+	m.clusters[refKey] = &memoizedCluster{
+		key:     key,
+		cluster: proto.Clone(cluster).(*envoy_config_cluster_v3.Cluster),
+		errs:    errs,
+		state:   state,
+	}
+	return cluster, errs
+}
+
+// listener returns the memo of the virtual hosts of the listener the route configuration is translated for,
+// or nil if they cannot be memoized.
+func (m *translationMemo) listener(ctx context.Context, h *httpRouteConfigurationTranslator) *listenerMemo {
+	if m == nil {
+		return nil
+	}
+	key, err := m.listenerKey(h)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnf("not memoizing the virtual hosts of route configuration %v: %v", h.routeConfigName, err)
+		return nil
+	}
+	return &listenerMemo{
+		memo:     m,
+		listener: h.listener,
+		key:      key,
+	}
+}
+
+// listenerKey hashes the inputs that the virtual hosts of the route configuration are translated from,
+// apart from the virtual hosts themselves.
+func (m *translationMemo) listenerKey(h *httpRouteConfigurationTranslator) (uint64, error) {
+	hasher := fnv.New64()
+	if err := binary.Write(hasher, binary.LittleEndian, m.inputs.routes); err != nil {
+		return 0, err
+	}
+	if _, err := m.proxy.GetMetadata().Hash(hasher); err != nil {
+		return 0, err
+	}
+	if err := hashString(hasher, h.translatorName); err != nil {
+		return 0, err
+	}
+	if err := hashString(hasher, h.routeConfigName); err != nil {
+		return 0, err
+	}
+	if err := binary.Write(hasher, binary.LittleEndian, h.requireTlsOnVirtualHosts); err != nil {
+		return 0, err
+	}
+	// the virtual hosts of the listener are hashed on their own
+	if err := hashMessage(hasher, h.parentListener, "ListenerType"); err != nil {
+		return 0, err
+	}
+	if err := hashMessage(hasher, h.listener, "virtual_hosts"); err != nil {
+		return 0, err
+	}
+	return hasher.Sum64(), nil
+}
+
+// listenerMemo memoizes the virtual hosts of an HttpListener. Its methods translate every virtual host when it is nil.
+type listenerMemo struct {
+	memo     *translationMemo
+	listener *v1.HttpListener
+	key      uint64
+}
+
+// virtualHost returns the translation of the virtual host, which is reused if it was translated from the same inputs,
+// or translated with compute otherwise. Either way, the errors and warnings of the translation are added to the
+// report, and the route state plugins are given the flags set while translating it.
+func (l *listenerMemo) virtualHost(
+	in *v1.VirtualHost,
+	report *validationapi.VirtualHostReport,
+	compute func(report *validationapi.VirtualHostReport) *envoy_config_route_v3.VirtualHost,
+) *envoy_config_route_v3.VirtualHost {
+	if l == nil {
+		return compute(report)
+	}
+	hasher := fnv.New64()
+	if err := binary.Write(hasher, binary.LittleEndian, l.key); err != nil {
+		return compute(report)
+	}
+	if err := hashMessage(hasher, in); err != nil {
+		return compute(report)
+	}
+	key := hasher.Sum64()
+	routeStatePlugins := l.memo.cache.routeStatePlugins
+
+	memoized, ok := l.memo.cache.virtualHosts[l.memo.proxy.GetMetadata().Ref().Key()][key]
+	if !ok {
+		// translate the virtual host on its own, to record what it adds to the report and to the route state
+		previousState := make([][]string, len(routeStatePlugins))
+		for i, plugin := range routeStatePlugins {
+			previousState[i] = plugin.RouteState(l.listener)
+			plugin.SetRouteState(l.listener, nil)
+		}
+		memoized = &memoizedVirtualHost{
+			report:     &validationapi.VirtualHostReport{RouteReports: make([]*validationapi.RouteReport, len(report.GetRouteReports()))},
+			routeState: make([][]string, len(routeStatePlugins)),
+		}
+		for i := range memoized.report.GetRouteReports() {
+			memoized.report.GetRouteReports()[i] = &validationapi.RouteReport{}
+		}
+		virtualHost := compute(memoized.report)
+		memoized.virtualHost = proto.Clone(virtualHost).(*envoy_config_route_v3.VirtualHost)
+		for i, plugin := range routeStatePlugins {
+			memoized.routeState[i] = plugin.RouteState(l.listener)
+			plugin.SetRouteState(l.listener, previousState[i])
+		}
+	}
+	l.memo.virtualHosts[key] = memoized
+
+	for i, plugin := range routeStatePlugins {
+		if len(memoized.routeState[i]) == 0 {
+			continue
+		}
+		flags := plugin.RouteState(l.listener)
+		for _, flag := range memoized.routeState[i] {
+			if !slices.Contains(flags, flag) {
+				flags = append(flags, flag)
+			}
+		}
+		plugin.SetRouteState(l.listener, flags)
+	}
+	mergeVirtualHostReport(report, memoized.report)
+	// the sanitizers replace the routes of the route configurations
+	return proto.Clone(memoized.virtualHost).(*envoy_config_route_v3.VirtualHost)
+}
+
+// mergeVirtualHostReport adds copies of the errors and warnings of the report to the other report
+func mergeVirtualHostReport(dst, src *validationapi.VirtualHostReport) {
+	src = proto.Clone(src).(*validationapi.VirtualHostReport)
+	dst.Errors = append(dst.GetErrors(), src.GetErrors()...)
+	for i, routeReport := range src.GetRouteReports() {
+		if i >= len(dst.GetRouteReports()) {
+			break
+		}
+		dst.GetRouteReports()[i].Errors = append(dst.GetRouteReports()[i].GetErrors(), routeReport.GetErrors()...)
+		dst.GetRouteReports()[i].Warnings = append(dst.GetRouteReports()[i].GetWarnings(), routeReport.GetWarnings()...)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/solo-io/gloo/pkg/utils/api_conversion"
 	"github.com/solo-io/gloo/pkg/utils/statsutils"
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
@@ -30,7 +31,6 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
-	"go.opencensus.io/trace"
 	proto2 "google.golang.org/protobuf/proto"
 )
 
@@ -65,6 +65,8 @@ type translatorInstance struct {
 	hasher                      func(resources []envoycache.Resource) (uint64, error)
 	listenerTranslatorFactory   *ListenerSubsystemTranslatorFactory
 	shouldEnforceNamespaceMatch bool
+	// translations memoizes the translation of each proxy, it is nil if translations are not memoized
+	translations *translationCache
 }
 
 func NewDefaultTranslator(settings *v1.Settings, pluginRegistry plugins.PluginRegistry) *translatorInstance {
@@ -96,6 +98,21 @@ func NewTranslatorWithHasher(
 	}
 }
 
+// NewMemoizingTranslator returns a translator which memoizes the translation of each proxy, apart from its endpoints,
+// and reuses it for as long as neither the proxy nor the other resources it is translated from change.
+// Otherwise, the clusters of the upstreams and the virtual hosts which did not change are reused.
+// The translator owns the plugins of the registry, which must not be shared with another translator.
+func NewMemoizingTranslator(
+	sslConfigTranslator utils.SslConfigTranslator,
+	settings *v1.Settings,
+	pluginRegistry plugins.PluginRegistry,
+	hasher func(resources []envoycache.Resource) (uint64, error),
+) *translatorInstance {
+	t := NewTranslatorWithHasher(sslConfigTranslator, settings, pluginRegistry, hasher)
+	t.translations = newTranslationCache(pluginRegistry)
+	return t
+}
+
 func (t *translatorInstance) Translate(
 	params plugins.Params,
 	proxy *v1.Proxy,
@@ -103,7 +120,7 @@ func (t *translatorInstance) Translate(
 	// setup tracing, logging
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	ctx, span := trace.StartSpan(params.Ctx, "gloo.translator.Translate")
+	ctx, span := telemetry.StartSpan(params.Ctx, "gloo.translator.Translate", telemetry.ProxyKey.String(proxy.GetMetadata().Ref().Key()))
 	defer span.End()
 	stopwatch := statsutils.NewTranslatorStopWatch("EdgeSnapshotTranslator")
 	stopwatch.Start()
@@ -122,32 +139,19 @@ func (t *translatorInstance) Translate(
 
 	// prepare reports used to aggregate Warnings/Errors encountered during translation
 	reports := make(reporter.ResourceReports)
-	proxyReport := validation.MakeReport(proxy)
+
+	contextutils.LoggerFrom(params.Ctx).Debugf("verifying upstream groups: %v", proxy.GetMetadata().GetName())
+	t.verifyUpstreamGroups(params, reports)
+
+	upstreamRefKeyToEndpoints := createUpstreamToEndpointsMap(params.Snapshot.Upstreams, params.Snapshot.Endpoints)
 
 	// execute translation of listener and cluster subsystems
 	// during these translations, params.messages is side effected for the reports to use later in this loop
-	var clusters []*envoy_config_cluster_v3.Cluster
-	var endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment
-	clusters, endpoints = t.translateClusterSubsystemComponents(params, proxy, reports)
-	routeConfigs, listeners := t.translateListenerSubsystemComponents(params, proxy, proxyReport)
+	translation, endpoints := t.translateProxy(params, proxy, upstreamRefKeyToEndpoints, reports)
+	proxyReport := translation.proxyReport
+	reports.Merge(translation.reports)
 
-	// run Resource Generator Plugins
-	for _, plugin := range t.pluginRegistry.GetResourceGeneratorPlugins() {
-		// GeneratedResources is deprecated and being replaced by UpstreamGeneratedResources
-		// The plan is to move the few remaining plugins in Gloo/EE to use the new
-		// interface. Once that is done and the new interface is called in the Gloo translation
-		// path, we can remove this code.
-		// During this transition period, gateway2 will call both interfaces and it's translator
-		// has (must have) checks that prevent duplicate resources from being added.
-		newClusters, newEndpoints, newRouteConfigs, newListeners := plugin.GeneratedResources(params, proxy,
-			clusters, endpoints, routeConfigs, listeners, reports)
-		clusters = append(clusters, newClusters...)
-		endpoints = append(endpoints, newEndpoints...)
-		routeConfigs = append(routeConfigs, newRouteConfigs...)
-		listeners = append(listeners, newListeners...)
-	}
-
-	xdsSnapshot := t.generateXDSSnapshot(params, clusters, endpoints, routeConfigs, listeners)
+	xdsSnapshot := t.generateXDSSnapshot(params, translation.clusters, endpoints, translation.routeConfigs, translation.listeners, translation.versions)
 
 	if err := validation.GetProxyError(proxyReport); err != nil {
 		reports.AddError(proxy, err)
@@ -172,25 +176,162 @@ func (t *translatorInstance) Translate(
 	return xdsSnapshot, reports, proxyReport
 }
 
-func (t *translatorInstance) translateClusterSubsystemComponents(params plugins.Params, proxy *v1.Proxy, reports reporter.ResourceReports) (
-	[]*envoy_config_cluster_v3.Cluster,
-	[]*envoy_config_endpoint_v3.ClusterLoadAssignment,
-) {
+// translateProxy returns the translation of the proxy and the endpoints of its clusters.
+// The translation is reused from the previous call if it is memoized and none of its inputs changed,
+// in which case only the endpoints are computed. Errors on the endpoints are added to the reports.
+func (t *translatorInstance) translateProxy(
+	params plugins.Params,
+	proxy *v1.Proxy,
+	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
+	reports reporter.ResourceReports,
+) (*proxyTranslation, []*envoy_config_endpoint_v3.ClusterLoadAssignment) {
+	if t.translations == nil {
+		return t.translateProxyResources(params, proxy, upstreamRefKeyToEndpoints, reports, nil)
+	}
+
 	logger := contextutils.LoggerFrom(params.Ctx)
+	t.translations.prune(proxy, params.Snapshot.Proxies)
 
-	logger.Debugf("verifying upstream groups: %v", proxy.GetMetadata().GetName())
-	t.verifyUpstreamGroups(params, reports)
+	memo, err := t.translations.begin(params, proxy)
+	if err != nil {
+		logger.Warnf("not memoizing the translation of proxy %v: %v", proxy.GetMetadata().Ref().Key(), err)
+	}
+	if memo == nil {
+		return t.translateProxyResources(params, proxy, upstreamRefKeyToEndpoints, reports, nil)
+	}
 
-	upstreamRefKeyToEndpoints := createUpstreamToEndpointsMap(params.Snapshot.Upstreams, params.Snapshot.Endpoints)
+	key, err := translationKey(memo.inputs, params, proxy, upstreamRefKeyToEndpoints)
+	if err != nil {
+		logger.Warnf("not memoizing the translation of proxy %v: %v", proxy.GetMetadata().Ref().Key(), err)
+		return t.translateProxyResources(params, proxy, upstreamRefKeyToEndpoints, reports, nil)
+	}
+
+	if cached := t.translations.get(proxy, key); cached != nil {
+		logger.Debugf("reusing the translation of proxy %v", proxy.GetMetadata().Ref().Key())
+		translation := cached.reuse(params.Snapshot, proxy)
+		endpoints := t.computeEdsEndpoints(params, translation.edsClusters, upstreamRefKeyToEndpoints, reports)
+		return translation, append(endpoints, translation.generatedEndpoints...)
+	}
+
+	// the clusters and virtual hosts which did not change are reused from the previous translations
+	translation, endpoints := t.translateProxyResources(params, proxy, upstreamRefKeyToEndpoints, reports, memo)
+	memo.commit()
+	versions, err := t.hashVersions(translation)
+	if err != nil {
+		logger.Warnf("not memoizing the translation of proxy %v: %v", proxy.GetMetadata().Ref().Key(), err)
+		return translation, endpoints
+	}
+	translation.versions = versions
+	t.translations.set(proxy, key, translation.reuse(params.Snapshot, proxy))
+	return translation, endpoints
+}
+
+// hashVersions hashes the clusters and listeners of the translation, as generateXDSSnapshot does
+func (t *translatorInstance) hashVersions(translation *proxyTranslation) (*xdsVersions, error) {
+	clustersVersion, err := t.hasher(clusterResources(translation.clusters))
+	if err != nil {
+		return nil, err
+	}
+	listenersVersion, err := t.hasher(listenerResources(translation.listeners))
+	if err != nil {
+		return nil, err
+	}
+	return &xdsVersions{
+		clusters:  clustersVersion,
+		listeners: listenersVersion,
+	}, nil
+}
+
+// translateProxyResources translates the resources of the proxy. Errors on the endpoints are added to the given reports,
+// while the others are added to the reports of the translation. Clusters and virtual hosts are reused from the memo,
+// unless it is nil.
+func (t *translatorInstance) translateProxyResources(
+	params plugins.Params,
+	proxy *v1.Proxy,
+	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
+	endpointReports reporter.ResourceReports,
+	memo *translationMemo,
+) (*proxyTranslation, []*envoy_config_endpoint_v3.ClusterLoadAssignment) {
+	translation := &proxyTranslation{
+		reports:     make(reporter.ResourceReports),
+		proxyReport: validation.MakeReport(proxy),
+	}
+
+	translation.clusters, translation.edsClusters = t.translateClusterSubsystemComponents(params, proxy, upstreamRefKeyToEndpoints, translation.reports, memo)
+	endpoints := t.computeEdsEndpoints(params, translation.edsClusters, upstreamRefKeyToEndpoints, endpointReports)
+	translation.routeConfigs, translation.listeners = t.translateListenerSubsystemComponents(params, proxy, translation.proxyReport, memo)
+
+	// run Resource Generator Plugins
+	for _, plugin := range t.pluginRegistry.GetResourceGeneratorPlugins() {
+		// GeneratedResources is deprecated and being replaced by UpstreamGeneratedResources
+		// The plan is to move the few remaining plugins in Gloo/EE to use the new
+		// interface. Once that is done and the new interface is called in the Gloo translation
+		// path, we can remove this code.
+		// During this transition period, gateway2 will call both interfaces and it's translator
+		// has (must have) checks that prevent duplicate resources from being added.
+		newClusters, newEndpoints, newRouteConfigs, newListeners := plugin.GeneratedResources(params, proxy,
+			translation.clusters, endpoints, translation.routeConfigs, translation.listeners, translation.reports)
+		translation.clusters = append(translation.clusters, newClusters...)
+		endpoints = append(endpoints, newEndpoints...)
+		translation.generatedEndpoints = append(translation.generatedEndpoints, newEndpoints...)
+		translation.routeConfigs = append(translation.routeConfigs, newRouteConfigs...)
+		translation.listeners = append(translation.listeners, newListeners...)
+	}
+
+	return translation, endpoints
+}
+
+func (t *translatorInstance) translateClusterSubsystemComponents(
+	params plugins.Params,
+	proxy *v1.Proxy,
+	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
+	reports reporter.ResourceReports,
+	memo *translationMemo,
+) ([]*envoy_config_cluster_v3.Cluster, []edsCluster) {
+	logger := contextutils.LoggerFrom(params.Ctx)
 
 	// endpoints and clusters are shared between listeners
 	logger.Debugf("computing envoy clusters for proxy: %v", proxy.GetMetadata().GetName())
-	clusters, clusterToUpstreamMap := t.computeClusters(params, reports, upstreamRefKeyToEndpoints, proxy)
-	logger.Debugf("computing envoy endpoints for proxy: %v", proxy.GetMetadata().GetName())
+	clusters, clusterToUpstreamMap := t.computeClusters(params, reports, upstreamRefKeyToEndpoints, proxy, memo)
+
+	var edsClusters []edsCluster
+	for _, c := range clusters {
+		if c.GetType() != envoy_config_cluster_v3.Cluster_EDS {
+			continue
+		}
+		// get upstream that generated this cluster
+		upstream := clusterToUpstreamMap[c]
+		endpointClusterName, err := GetEndpointClusterName(c.GetName(), upstream)
+		if err != nil {
+			reports.AddError(upstream, errors.Wrapf(err, "could not marshal upstream to JSON"))
+		}
+		// Workaround for envoy bug: https://github.com/envoyproxy/envoy/issues/13009
+		// Change the cluster eds config, forcing envoy to re-request latest EDS config
+		c.GetEdsClusterConfig().ServiceName = endpointClusterName
+		edsClusters = append(edsClusters, edsCluster{
+			name:                c.GetName(),
+			endpointClusterName: endpointClusterName,
+			upstream:            upstream.GetMetadata().Ref(),
+		})
+	}
+
+	return clusters, edsClusters
+}
+
+// computeEdsEndpoints computes the load assignments of the upstreams, named after the EDS clusters they are served to.
+func (t *translatorInstance) computeEdsEndpoints(
+	params plugins.Params,
+	edsClusters []edsCluster,
+	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
+	reports reporter.ResourceReports,
+) []*envoy_config_endpoint_v3.ClusterLoadAssignment {
+	logger := contextutils.LoggerFrom(params.Ctx)
+	logger.Debugf("computing envoy endpoints")
 
 	endpoints := t.computeClusterEndpoints(params, upstreamRefKeyToEndpoints, reports)
 
 	upstreamMap := make(map[string]struct{}, len(params.Snapshot.Upstreams))
+	upstreamsByRefKey := make(map[string]*v1.Upstream, len(params.Snapshot.Upstreams))
 	// make sure to call EndpointPlugin with empty endpoint
 	for _, upstream := range params.Snapshot.Upstreams {
 		key := UpstreamToClusterName(&core.ResourceRef{
@@ -198,6 +339,7 @@ func (t *translatorInstance) translateClusterSubsystemComponents(params plugins.
 			Namespace: upstream.GetMetadata().GetNamespace(),
 		})
 		upstreamMap[key] = struct{}{}
+		upstreamsByRefKey[upstream.GetMetadata().Ref().Key()] = upstream
 	}
 	endpointMap := make(map[string][]*envoy_config_endpoint_v3.ClusterLoadAssignment, len(endpoints))
 	for _, ep := range endpoints {
@@ -210,32 +352,20 @@ func (t *translatorInstance) translateClusterSubsystemComponents(params plugins.
 	}
 	// Find all the EDS clusters without endpoints (can happen with kube service that have no endpoints), and create a zero sized load assignment
 	// this is important as otherwise envoy will wait for them forever wondering their fate and not doing much else.
-ClusterLoop:
-	for _, c := range clusters {
-		if c.GetType() != envoy_config_cluster_v3.Cluster_EDS {
-			continue
-		}
-		// get upstream that generated this cluster
-		upstream := clusterToUpstreamMap[c]
-		endpointClusterName, err := GetEndpointClusterName(c.GetName(), upstream)
-		if err != nil {
-			reports.AddError(upstream, errors.Wrapf(err, "could not marshal upstream to JSON"))
-		}
-		// Workaround for envoy bug: https://github.com/envoyproxy/envoy/issues/13009
-		// Change the cluster eds config, forcing envoy to re-request latest EDS config
-		c.GetEdsClusterConfig().ServiceName = endpointClusterName
-		if eList, ok := endpointMap[c.GetName()]; ok {
+	for _, c := range edsClusters {
+		if eList, ok := endpointMap[c.name]; ok {
 			for _, ep := range eList {
 				// the endpoint ClusterName needs to match the cluster's EdsClusterConfig ServiceName
-				ep.ClusterName = endpointClusterName
+				ep.ClusterName = c.endpointClusterName
 			}
-			continue ClusterLoop
+			continue
 		}
 		emptyEndpointList := &envoy_config_endpoint_v3.ClusterLoadAssignment{
-			ClusterName: endpointClusterName,
+			ClusterName: c.endpointClusterName,
 		}
 		// make sure to call EndpointPlugin with empty endpoint
-		if _, ok := upstreamMap[c.GetName()]; ok {
+		if _, ok := upstreamMap[c.name]; ok {
+			upstream := upstreamsByRefKey[c.upstream.Key()]
 			for _, plugin := range t.pluginRegistry.GetEndpointPlugins() {
 				if err := plugin.ProcessEndpoints(params, upstream, emptyEndpointList); err != nil {
 					reports.AddError(upstream, err)
@@ -250,10 +380,10 @@ ClusterLoop:
 		endpoints = append(endpoints, emptyEndpointList)
 	}
 
-	return clusters, endpoints
+	return endpoints
 }
 
-func (t *translatorInstance) translateListenerSubsystemComponents(params plugins.Params, proxy *v1.Proxy, proxyReport *validationapi.ProxyReport) (
+func (t *translatorInstance) translateListenerSubsystemComponents(params plugins.Params, proxy *v1.Proxy, proxyReport *validationapi.ProxyReport, memo *translationMemo) (
 	[]*envoy_config_route_v3.RouteConfiguration,
 	[]*envoy_config_listener_v3.Listener,
 ) {
@@ -264,6 +394,10 @@ func (t *translatorInstance) translateListenerSubsystemComponents(params plugins
 
 	logger := contextutils.LoggerFrom(params.Ctx)
 
+	// the route configuration translators reuse the virtual hosts from the memo
+	t.listenerTranslatorFactory.memo = memo
+	defer func() { t.listenerTranslatorFactory.memo = nil }()
+
 	for i, listener := range proxy.GetListeners() {
 		logger.Infof("computing envoy resources for listener: %v", listener.GetName())
 
@@ -289,6 +423,12 @@ func (t *translatorInstance) translateListenerSubsystemComponents(params plugins
 			if len(envoyRouteConfiguration) > 0 {
 				routeConfigs = append(routeConfigs, envoyRouteConfiguration...)
 			}
+
+			// 3. Compute the UDP Listener serving HTTP/3, if enabled
+			// It is derived from the TCP Listener, so that both share the same TLS and HTTP configuration
+			if quicListener := computeQuicListener(listener, envoyListener, listenerReport); quicListener != nil {
+				listeners = append(listeners, quicListener)
+			}
 		}
 	}
 
@@ -301,35 +441,37 @@ func (t *translatorInstance) generateXDSSnapshot(
 	endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment,
 	routeConfigs []*envoy_config_route_v3.RouteConfiguration,
 	listeners []*envoy_config_listener_v3.Listener,
+	versions *xdsVersions,
 ) envoycache.Snapshot {
-	var endpointsProto, clustersProto, listenersProto []envoycache.Resource
+	var endpointsProto []envoycache.Resource
 
 	for _, ep := range endpoints {
 		endpointsProto = append(endpointsProto, resource.NewEnvoyResource(ep))
 	}
-	for _, cluster := range clusters {
-		clustersProto = append(clustersProto, resource.NewEnvoyResource(cluster))
-	}
-	for _, listener := range listeners {
-		// don't add empty listeners, envoy will complain
-		if len(listener.GetFilterChains()) < 1 {
-			continue
-		}
-		listenersProto = append(listenersProto, resource.NewEnvoyResource(listener))
-	}
+	clustersProto := clusterResources(clusters)
+	listenersProto := listenerResources(listeners)
 	// construct version
 	// TODO: investigate whether we need a more sophisticated versioning algorithm
 	endpointsVersion, endpointsErr := t.hasher(endpointsProto)
 	if endpointsErr != nil {
 		contextutils.LoggerFrom(params.Ctx).DPanic(fmt.Sprintf("error trying to hash endpointsProto: %v", endpointsErr))
 	}
-	clustersVersion, clustersErr := t.hasher(clustersProto)
-	if clustersErr != nil {
-		contextutils.LoggerFrom(params.Ctx).DPanic(fmt.Sprintf("error trying to hash clustersProto: %v", clustersErr))
-	}
-	listenersVersion, listenersErr := t.hasher(listenersProto)
-	if listenersErr != nil {
-		contextutils.LoggerFrom(params.Ctx).DPanic(fmt.Sprintf("error trying to hash listenersProto: %v", listenersErr))
+	var (
+		clustersVersion, listenersVersion uint64
+		clustersErr, listenersErr         error
+	)
+	if versions != nil {
+		// the versions of a memoized translation were hashed when it was memoized
+		clustersVersion, listenersVersion = versions.clusters, versions.listeners
+	} else {
+		clustersVersion, clustersErr = t.hasher(clustersProto)
+		if clustersErr != nil {
+			contextutils.LoggerFrom(params.Ctx).DPanic(fmt.Sprintf("error trying to hash clustersProto: %v", clustersErr))
+		}
+		listenersVersion, listenersErr = t.hasher(listenersProto)
+		if listenersErr != nil {
+			contextutils.LoggerFrom(params.Ctx).DPanic(fmt.Sprintf("error trying to hash listenersProto: %v", listenersErr))
+		}
 	}
 
 	// if clusters are updated, provider a new version of the endpoints,
@@ -353,6 +495,27 @@ func (t *translatorInstance) generateXDSSnapshot(
 		listenersNew)
 }
 
+func clusterResources(clusters []*envoy_config_cluster_v3.Cluster) []envoycache.Resource {
+	var clustersProto []envoycache.Resource
+	for _, cluster := range clusters {
+		clustersProto = append(clustersProto, resource.NewEnvoyResource(cluster))
+	}
+	return clustersProto
+}
+
+func listenerResources(listeners []*envoy_config_listener_v3.Listener) []envoycache.Resource {
+	var listenersProto []envoycache.Resource
+	for _, listener := range listeners {
+		// don't add empty listeners, envoy will complain
+		// UDP listeners proxy datagrams with a listener filter, and do not need filter chains
+		if len(listener.GetFilterChains()) < 1 && !isUdpProxyListener(listener) {
+			continue
+		}
+		listenersProto = append(listenersProto, resource.NewEnvoyResource(listener))
+	}
+	return listenersProto
+}
+
 // deprecated, use EnvoyCacheResourcesListToFnvHash
 func MustEnvoyCacheResourcesListToFnvHash(resources []envoycache.Resource) uint64 {
 	out, err := EnvoyCacheResourcesListToFnvHash(resources)
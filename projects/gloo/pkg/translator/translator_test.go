@@ -15,6 +15,8 @@ import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	envoyquic "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
@@ -42,6 +44,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	. "github.com/solo-io/solo-kit/test/matchers"
 
 	"github.com/solo-io/gloo/pkg/utils/api_conversion"
@@ -69,6 +72,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	v1kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/quic"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
@@ -1853,6 +1857,234 @@ var _ = Describe("Translator", func() {
 			Expect(filterMetadata[SoloAnnotations].Fields).To(HaveKey("testkey"))
 			Expect(filterMetadata[SoloAnnotations].Fields["testkey"].GetStringValue()).To(Equal("testvalue"))
 		})
+
+		It("should group endpoints by zone and mark draining endpoints", func() {
+			ref := upstream.Metadata.Ref()
+			newEndpoint := func(name, address string, annotations map[string]string) *v1.Endpoint {
+				return &v1.Endpoint{
+					Metadata: &core.Metadata{
+						Name:        name,
+						Namespace:   "gloo-system",
+						Annotations: annotations,
+					},
+					Upstreams: []*core.ResourceRef{ref},
+					Address:   address,
+					Port:      1234,
+				}
+			}
+			params.Snapshot.Endpoints = v1.EndpointList{
+				newEndpoint("zone-a-1", "1.2.3.1", map[string]string{constants.EndpointZoneAnnotation: "zone-a"}),
+				newEndpoint("no-zone", "1.2.3.2", nil),
+				newEndpoint("zone-b", "1.2.3.3", map[string]string{
+					constants.EndpointZoneAnnotation:         "zone-b",
+					constants.EndpointHealthStatusAnnotation: constants.EndpointHealthStatusDraining,
+				}),
+				newEndpoint("zone-a-2", "1.2.3.4", map[string]string{constants.EndpointZoneAnnotation: "zone-a"}),
+			}
+			translate()
+
+			clusterName := getEndpointClusterName(upstream)
+			endpoints := snapshot.GetResources(types.EndpointTypeV3)
+			Expect(endpoints.Items).To(HaveKey(clusterName))
+			claConfiguration = endpoints.Items[clusterName].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+
+			Expect(claConfiguration.Endpoints).To(HaveLen(3))
+			Expect(claConfiguration.Endpoints[0].GetLocality().GetZone()).To(Equal("zone-a"))
+			Expect(claConfiguration.Endpoints[0].GetLbEndpoints()).To(HaveLen(2))
+			Expect(claConfiguration.Endpoints[1].GetLocality()).To(BeNil())
+			Expect(claConfiguration.Endpoints[1].GetLbEndpoints()).To(HaveLen(1))
+			Expect(claConfiguration.Endpoints[2].GetLocality().GetZone()).To(Equal("zone-b"))
+			Expect(claConfiguration.Endpoints[2].GetLbEndpoints()).To(HaveLen(1))
+			Expect(claConfiguration.Endpoints[2].GetLbEndpoints()[0].GetHealthStatus()).To(Equal(envoy_config_core_v3.HealthStatus_DRAINING))
+			Expect(claConfiguration.Endpoints[0].GetLbEndpoints()[0].GetHealthStatus()).To(Equal(envoy_config_core_v3.HealthStatus_UNKNOWN))
+		})
+	})
+
+	Context("when memoizing translations", func() {
+		var (
+			memoizingTranslator Translator
+			externalState       *externalStatePluginMock
+		)
+
+		BeforeEach(func() {
+			upstream.UpstreamType = &v1.Upstream_Kube{
+				Kube: &v1kubernetes.UpstreamSpec{},
+			}
+			externalState = &externalStatePluginMock{memoize: true}
+		})
+
+		JustBeforeEach(func() {
+			memoizingTranslator = NewMemoizingTranslator(
+				glooutils.NewSslConfigTranslator(),
+				settings,
+				registry.NewPluginRegistry(append(registry.Plugins(registry.FromBootstrap(bootstrap.Opts{
+					Settings:  settings,
+					Secrets:   memoryClientFactory,
+					Upstreams: memoryClientFactory,
+					Consul: bootstrap.Consul{
+						ConsulWatcher: mock_consul.NewMockConsulWatcher(ctrl),
+					},
+				})), externalState)),
+				EnvoyCacheResourcesListToFnvHash)
+		})
+
+		// expectSameTranslation translates the proxy with the memoizing translator and checks that it translates it
+		// the way the translator which does not memoize does
+		expectSameTranslation := func() reporter.ResourceReports {
+			memoizedSnap, memoizedErrs, memoizedReport := memoizingTranslator.Translate(params, proxy)
+			snap, errs, report := translator.Translate(params, proxy)
+			for _, typeUrl := range []string{types.EndpointTypeV3, types.ClusterTypeV3, types.RouteTypeV3, types.ListenerTypeV3} {
+				ExpectWithOffset(1, memoizedSnap.GetResources(typeUrl).Version).To(Equal(snap.GetResources(typeUrl).Version), typeUrl)
+			}
+			ExpectWithOffset(1, memoizedErrs).To(HaveLen(len(errs)))
+			for res, resReport := range errs {
+				ExpectWithOffset(1, memoizedErrs).To(HaveKey(BeIdenticalTo(res)))
+				ExpectWithOffset(1, fmt.Sprint(memoizedErrs[res].Errors)).To(Equal(fmt.Sprint(resReport.Errors)))
+				ExpectWithOffset(1, memoizedErrs[res].Warnings).To(Equal(resReport.Warnings))
+			}
+			ExpectWithOffset(1, memoizedReport).To(MatchProto(report))
+			return memoizedErrs
+		}
+
+		It("recomputes the endpoints of a memoized translation", func() {
+			expectSameTranslation()
+
+			params.Snapshot.Endpoints[0].Address = "5.6.7.8"
+			expectSameTranslation()
+
+			params.Snapshot.Endpoints = nil
+			expectSameTranslation()
+		})
+
+		It("does not memoize the changes made to the route configurations it returns", func() {
+			memoizedSnap, _, _ := memoizingTranslator.Translate(params, proxy)
+			// the route replacing sanitizer replaces the routes of the route configurations it is given
+			for _, res := range memoizedSnap.GetResources(types.RouteTypeV3).Items {
+				res.ResourceProto().(*envoy_config_route_v3.RouteConfiguration).VirtualHosts = nil
+			}
+			expectSameTranslation()
+		})
+
+		It("translates the proxy again when it changes", func() {
+			expectSameTranslation()
+
+			proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()[0].GetMatchers()[0].PathSpecifier = &matchers.Matcher_Prefix{
+				Prefix: "/changed",
+			}
+			expectSameTranslation()
+		})
+
+		It("translates the proxy again when an upstream changes", func() {
+			expectSameTranslation()
+
+			upstream.GetKube().ServiceName = "changed"
+			expectSameTranslation()
+		})
+
+		It("attributes the reports of a memoized translation to the resources of the snapshot", func() {
+			upstream.SslConfig = &ssl.UpstreamSslConfig{
+				SslSecrets: &ssl.UpstreamSslConfig_SecretRef{
+					SecretRef: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+				},
+			}
+			errs := expectSameTranslation()
+			Expect(errs).To(HaveKey(BeIdenticalTo(upstream)))
+
+			snapshotClone := params.Snapshot.Clone()
+			params.Snapshot = &snapshotClone
+			errs = expectSameTranslation()
+			Expect(errs).To(HaveKey(BeIdenticalTo(params.Snapshot.Upstreams[0])))
+			Expect(errs).NotTo(HaveKey(BeIdenticalTo(upstream)))
+			Expect(errs.ValidateStrict()).To(MatchError(ContainSubstring("SSL secret not found")))
+		})
+
+		It("reuses the clusters of the upstreams which did not change", func() {
+			other := createStaticUpstream("other", "gloo-system")
+			params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, other)
+			expectSameTranslation()
+
+			other.GetStatic().GetHosts()[0].Port = 9090
+			expectSameTranslation()
+
+			upstream.GetKube().ServiceName = "changed"
+			expectSameTranslation()
+		})
+
+		Context("with several virtual hosts", func() {
+			BeforeEach(func() {
+				settings.Gloo = &v1.GlooOptions{RemoveUnusedFilters: &wrappers.BoolValue{Value: true}}
+			})
+
+			JustBeforeEach(func() {
+				// the fault injection filter is only added to the listener if a route uses it
+				faultRoute := proto.Clone(routes[0]).(*v1.Route)
+				faultRoute.Options = &v1.RouteOptions{
+					Faults: &faultinjection.RouteFaults{
+						Abort: &faultinjection.RouteAbort{Percentage: 50, HttpStatus: 503},
+					},
+				}
+				httpListener := proxy.GetListeners()[0].GetHttpListener()
+				httpListener.VirtualHosts = append(httpListener.GetVirtualHosts(), &v1.VirtualHost{
+					Name:    "faults",
+					Domains: []string{"faults.example.com"},
+					Routes:  []*v1.Route{faultRoute},
+				})
+			})
+
+			It("reuses the virtual hosts which did not change, along with the filters they require", func() {
+				expectSameTranslation()
+
+				proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()[0].GetMatchers()[0].PathSpecifier = &matchers.Matcher_Prefix{
+					Prefix: "/changed",
+				}
+				expectSameTranslation()
+
+				proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[1].GetRoutes()[0].Options = nil
+				expectSameTranslation()
+			})
+
+			It("reports the errors of the virtual hosts it reuses", func() {
+				proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[1].GetRoutes()[0].GetMatchers()[0].PathSpecifier = &matchers.Matcher_Prefix{
+					Prefix: "/../invalid",
+				}
+				expectSameTranslation()
+
+				proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()[0].GetMatchers()[0].PathSpecifier = &matchers.Matcher_Prefix{
+					Prefix: "/changed",
+				}
+				errs := expectSameTranslation()
+				Expect(errs.ValidateStrict()).To(MatchError(ContainSubstring("cannot contain [/../]")))
+			})
+		})
+
+		Context("with plugins which depend on external state", func() {
+			clusterStatName := func() string {
+				snap, _, _ := memoizingTranslator.Translate(params, proxy)
+				clusterResource := snap.GetResources(types.ClusterTypeV3).Items[UpstreamToClusterName(upstream.Metadata.Ref())]
+				return clusterResource.ResourceProto().(*envoy_config_cluster_v3.Cluster).GetAltStatName()
+			}
+
+			It("translates the proxy again when the version of the external state changes", func() {
+				externalState.statName = "first"
+				externalState.version = "1"
+				Expect(clusterStatName()).To(Equal("first"))
+
+				externalState.statName = "second"
+				Expect(clusterStatName()).To(Equal("first"))
+
+				externalState.version = "2"
+				Expect(clusterStatName()).To(Equal("second"))
+			})
+
+			It("does not memoize the translations that plugins opt out of", func() {
+				externalState.memoize = false
+				externalState.statName = "first"
+				Expect(clusterStatName()).To(Equal("first"))
+
+				externalState.statName = "second"
+				Expect(clusterStatName()).To(Equal("second"))
+			})
+		})
 	})
 
 	Context("when handling subsets", func() {
@@ -2773,6 +3005,83 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("UDP", func() {
+		var udpListener *v1.Listener
+
+		BeforeEach(func() {
+			udpListener = &v1.Listener{
+				Name:        "udp-listener",
+				BindAddress: "127.0.0.1",
+				// share the port of the tcp listener, which binds a TCP socket
+				BindPort: 8080,
+				ListenerType: &v1.Listener_UdpListener{
+					UdpListener: &v1.UdpListener{
+						UdpProxy: &v1.UdpProxyAction{
+							Destination: &v1.Destination{
+								DestinationType: &v1.Destination_Upstream{
+									Upstream: upName.Ref(),
+								},
+							},
+							IdleTimeout:               &duration.Duration{Seconds: 5},
+							UsePerPacketLoadBalancing: &wrappers.BoolValue{Value: true},
+						},
+						StatPrefix: "dns",
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			proxy.Listeners = append(proxy.GetListeners(), udpListener)
+		})
+
+		It("can properly create a udp listener", func() {
+			translate()
+			listeners := snapshot.GetResources(types.ListenerTypeV3).Items
+			val, found := listeners["udp-listener"]
+			Expect(found).To(BeTrue())
+			listener, ok := val.ResourceProto().(*envoy_config_listener_v3.Listener)
+			Expect(ok).To(BeTrue())
+			Expect(listener.GetFilterChains()).To(BeEmpty())
+			Expect(listener.GetUdpListenerConfig()).NotTo(BeNil())
+			Expect(listener.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoy_config_core_v3.SocketAddress_UDP))
+			Expect(listener.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(uint32(8080)))
+
+			Expect(listener.GetListenerFilters()).To(HaveLen(1))
+			udpProxyFilter := listener.GetListenerFilters()[0]
+			Expect(udpProxyFilter.GetName()).To(Equal(UdpProxyFilterName))
+			var udpProxy envoyudp.UdpProxyConfig
+			Expect(ParseTypedConfig(udpProxyFilter, &udpProxy)).NotTo(HaveOccurred())
+			Expect(udpProxy.GetStatPrefix()).To(Equal("dns"))
+			Expect(udpProxy.GetIdleTimeout()).To(MatchProto(&duration.Duration{Seconds: 5}))
+			Expect(udpProxy.GetUsePerPacketLoadBalancing()).To(BeTrue())
+
+			var route envoyudp.Route
+			Expect(udpProxy.GetMatcher().GetOnNoMatch().GetAction().GetTypedConfig().UnmarshalTo(&route)).NotTo(HaveOccurred())
+			Expect(route.GetCluster()).To(Equal("test_gloo-system"))
+		})
+
+		It("reports an error when the upstream does not exist", func() {
+			udpListener.GetUdpListener().GetUdpProxy().Destination = &v1.Destination{
+				DestinationType: &v1.Destination_Upstream{
+					Upstream: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+				},
+			}
+			report := translateWithError()
+			Expect(report.GetListenerReports()[3].GetErrors()).To(HaveLen(1))
+			Expect(report.GetListenerReports()[3].GetErrors()[0].GetType()).To(Equal(validation.ListenerReport_Error_ProcessingError))
+		})
+
+		It("reports an error when two udp listeners share a port", func() {
+			otherUdpListener := proto.Clone(udpListener).(*v1.Listener)
+			otherUdpListener.Name = "other-udp-listener"
+			proxy.Listeners = append(proxy.GetListeners(), otherUdpListener)
+
+			report := translateWithError()
+			Expect(report.GetListenerReports()[3].GetErrors()).To(ContainElement(HaveField("Type", validation.ListenerReport_Error_BindPortNotUniqueError)))
+		})
+	})
+
 	Context("Hybrid", func() {
 		// The number of HttpFilters that we expect to be generated on the HttpConnectionManager by default
 		defaultHttpFilters := 7
@@ -3350,6 +3659,89 @@ var _ = Describe("Translator", func() {
 				Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(wellknown.TlsInspector))
 			})
 		})
+		Context("quic", func() {
+			var sslConfig *ssl.SslConfig
+
+			BeforeEach(func() {
+				sslConfig = &ssl.SslConfig{
+					SslSecrets: &ssl.SslConfig_SslFiles{
+						SslFiles: &ssl.SSLFiles{
+							TlsCert: gloohelpers.Certificate(),
+							TlsKey:  gloohelpers.PrivateKey(),
+						},
+					},
+					SniDomains: []string{"example.com"},
+				}
+			})
+
+			prepQuic := func(quicSettings *quic.QuicSettings) {
+				prepSsl([]*ssl.SslConfig{sslConfig})
+				proxy.GetListeners()[0].GetHttpListener().Options = &v1.HttpListenerOptions{
+					Quic: quicSettings,
+				}
+				translate()
+			}
+
+			It("creates a udp listener which shares the tls configuration", func() {
+				prepQuic(&quic.QuicSettings{
+					MaxConcurrentStreams: &wrappers.UInt32Value{Value: 50},
+				})
+
+				listeners := snapshot.GetResources(types.ListenerTypeV3).Items
+				Expect(listeners).To(HaveLen(2))
+				tcpListener := listeners["http-listener"].ResourceProto().(*envoy_config_listener_v3.Listener)
+				val, found := listeners["http-listener"+QuicListenerSuffix]
+				Expect(found).To(BeTrue())
+				quicListener := val.ResourceProto().(*envoy_config_listener_v3.Listener)
+
+				Expect(quicListener.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoy_config_core_v3.SocketAddress_UDP))
+				Expect(quicListener.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(uint32(80)))
+				Expect(quicListener.GetUdpListenerConfig().GetQuicOptions().GetQuicProtocolOptions().GetMaxConcurrentStreams()).To(MatchProto(&wrappers.UInt32Value{Value: 50}))
+
+				Expect(quicListener.GetFilterChains()).To(HaveLen(1))
+				fc := quicListener.GetFilterChains()[0]
+				Expect(fc.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"example.com"}))
+				Expect(fc.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketQuic))
+				var quicTransport envoyquic.QuicDownstreamTransport
+				Expect(ParseTypedConfig(fc.GetTransportSocket(), &quicTransport)).NotTo(HaveOccurred())
+				Expect(quicTransport.GetDownstreamTlsContext()).To(MatchProto(tlsContext(tcpListener.GetFilterChains()[0])))
+
+				Expect(fc.GetFilters()).To(HaveLen(1))
+				var quicHcm envoyhttp.HttpConnectionManager
+				Expect(ParseTypedConfig(fc.GetFilters()[0], &quicHcm)).NotTo(HaveOccurred())
+				Expect(quicHcm.GetCodecType()).To(Equal(envoyhttp.HttpConnectionManager_HTTP3))
+				Expect(quicHcm.GetRds().GetRouteConfigName()).To(Equal("http-listener-routes"))
+			})
+
+			It("advertises http/3 with the alt-svc header", func() {
+				prepQuic(&quic.QuicSettings{
+					Port:           &wrappers.UInt32Value{Value: 8443},
+					AdvertisedPort: &wrappers.UInt32Value{Value: 443},
+					AltSvcMaxAge:   &wrappers.UInt32Value{Value: 3600},
+				})
+
+				listeners := snapshot.GetResources(types.ListenerTypeV3).Items
+				quicListener := listeners["http-listener"+QuicListenerSuffix].ResourceProto().(*envoy_config_listener_v3.Listener)
+				Expect(quicListener.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(uint32(8443)))
+
+				Expect(routeConfiguration.GetResponseHeadersToAdd()).To(HaveLen(1))
+				Expect(routeConfiguration.GetResponseHeadersToAdd()[0].GetHeader().GetKey()).To(Equal(AltSvcHeader))
+				Expect(routeConfiguration.GetResponseHeadersToAdd()[0].GetHeader().GetValue()).To(Equal(`h3=":443"; ma=3600`))
+			})
+
+			It("does not create a udp listener without ssl configuration", func() {
+				prepSsl(nil)
+				proxy.GetListeners()[0].GetHttpListener().Options = &v1.HttpListenerOptions{
+					Quic: &quic.QuicSettings{},
+				}
+				translate()
+
+				listeners := snapshot.GetResources(types.ListenerTypeV3).Items
+				Expect(listeners).To(HaveLen(1))
+				Expect(routeConfiguration.GetResponseHeadersToAdd()).To(BeEmpty())
+			})
+		})
+
 		Context("secret refs", func() {
 			It("should combine sni matches ", func() {
 				params.Snapshot.Secrets = append(params.Snapshot.Secrets, &v1.Secret{
@@ -3973,6 +4365,125 @@ var _ = Describe("Translator", func() {
 		})
 
 	})
+	Context("quic on hybrid and aggregate listeners", func() {
+		sslMatcher := func(sniDomain string) *v1.Matcher {
+			return &v1.Matcher{
+				SslConfig: &ssl.SslConfig{
+					SslSecrets: &ssl.SslConfig_SslFiles{
+						SslFiles: &ssl.SSLFiles{
+							TlsCert: gloohelpers.Certificate(),
+							TlsKey:  gloohelpers.PrivateKey(),
+						},
+					},
+					SniDomains: []string{sniDomain},
+				},
+			}
+		}
+
+		aggregateListener := func(httpOptions map[string]*v1.HttpListenerOptions) *v1.Listener {
+			listener := &v1.Listener{
+				Name:        "aggregate-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    8443,
+				ListenerType: &v1.Listener_AggregateListener{
+					AggregateListener: &v1.AggregateListener{
+						HttpResources: &v1.AggregateListener_HttpResources{
+							VirtualHosts: map[string]*v1.VirtualHost{
+								"vhost": {
+									Name:    virtualHostName,
+									Domains: []string{"*"},
+									Routes:  routes,
+								},
+							},
+							HttpOptions: httpOptions,
+						},
+					},
+				},
+			}
+			for _, name := range []string{"a", "b"} {
+				listener.GetAggregateListener().HttpFilterChains = append(listener.GetAggregateListener().GetHttpFilterChains(), &v1.AggregateListener_HttpFilterChain{
+					Matcher:         sslMatcher(name + ".com"),
+					HttpOptionsRef:  name,
+					VirtualHostRefs: []string{"vhost"},
+				})
+			}
+			return listener
+		}
+
+		quicRouteConfigs := func(quicListener *envoy_config_listener_v3.Listener) []string {
+			var routeConfigNames []string
+			for _, fc := range quicListener.GetFilterChains() {
+				Expect(fc.GetFilters()).To(HaveLen(1))
+				var quicHcm envoyhttp.HttpConnectionManager
+				Expect(ParseTypedConfig(fc.GetFilters()[0], &quicHcm)).NotTo(HaveOccurred())
+				Expect(quicHcm.GetCodecType()).To(Equal(envoyhttp.HttpConnectionManager_HTTP3))
+				routeConfigNames = append(routeConfigNames, quicHcm.GetRds().GetRouteConfigName())
+			}
+			return routeConfigNames
+		}
+
+		routeConfig := func(name string) *envoy_config_route_v3.RouteConfiguration {
+			routes := snapshot.GetResources(types.RouteTypeV3).Items
+			ExpectWithOffset(1, routes).To(HaveKey(name))
+			return routes[name].ResourceProto().(*envoy_config_route_v3.RouteConfiguration)
+		}
+
+		It("serves the http listeners of a hybrid listener with quic settings over http/3", func() {
+			hybridListener := proxy.Listeners[2]
+			matchedListener := hybridListener.GetHybridListener().GetMatchedListeners()[1]
+			matchedListener.SslConfigurations = []*ssl.SslConfig{matchedListener.GetMatcher().GetSslConfig()}
+			matchedListener.GetHttpListener().Options = &v1.HttpListenerOptions{
+				Quic: &quic.QuicSettings{},
+			}
+			translate()
+
+			listeners := snapshot.GetResources(types.ListenerTypeV3).Items
+			Expect(listeners).To(HaveKey("hybrid-listener" + QuicListenerSuffix))
+			quicListener := listeners["hybrid-listener"+QuicListenerSuffix].ResourceProto().(*envoy_config_listener_v3.Listener)
+			Expect(quicListener.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(uint32(8888)))
+
+			routeConfigName := glooutils.MatchedRouteConfigName(hybridListener, matchedListener.GetMatcher())
+			Expect(quicRouteConfigs(quicListener)).To(Equal([]string{routeConfigName}))
+			Expect(routeConfig(routeConfigName).GetResponseHeadersToAdd()).To(HaveLen(1))
+			Expect(routeConfig(routeConfigName).GetResponseHeadersToAdd()[0].GetHeader().GetValue()).To(Equal(`h3=":8888"; ma=86400`))
+		})
+
+		It("only serves the http filter chains of an aggregate listener with quic settings over http/3", func() {
+			listener := aggregateListener(map[string]*v1.HttpListenerOptions{
+				"a": {Quic: &quic.QuicSettings{}},
+				"b": {},
+			})
+			proxy.Listeners = append(proxy.Listeners, listener)
+			translate()
+
+			listeners := snapshot.GetResources(types.ListenerTypeV3).Items
+			Expect(listeners).To(HaveKey("aggregate-listener" + QuicListenerSuffix))
+			quicListener := listeners["aggregate-listener"+QuicListenerSuffix].ResourceProto().(*envoy_config_listener_v3.Listener)
+
+			quicChains := listener.GetAggregateListener().GetHttpFilterChains()
+			routeConfigName := glooutils.MatchedRouteConfigName(listener, quicChains[0].GetMatcher())
+			Expect(quicRouteConfigs(quicListener)).To(Equal([]string{routeConfigName}))
+			Expect(routeConfig(routeConfigName).GetResponseHeadersToAdd()).To(HaveLen(1))
+			Expect(routeConfig(glooutils.MatchedRouteConfigName(listener, quicChains[1].GetMatcher())).GetResponseHeadersToAdd()).To(BeEmpty())
+		})
+
+		It("reports an error when the http filter chains of an aggregate listener use different quic settings", func() {
+			listener := aggregateListener(map[string]*v1.HttpListenerOptions{
+				"a": {Quic: &quic.QuicSettings{}},
+				"b": {Quic: &quic.QuicSettings{Port: &wrappers.UInt32Value{Value: 9443}}},
+			})
+			proxy.Listeners = append(proxy.Listeners, listener)
+
+			var errs reporter.ResourceReports
+			snapshot, errs, _ = translator.Translate(params, proxy)
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(QuicSettingsConflictError(listener).Error())))
+			Expect(snapshot.GetResources(types.ListenerTypeV3).Items).NotTo(HaveKey("aggregate-listener" + QuicListenerSuffix))
+			for _, chain := range listener.GetAggregateListener().GetHttpFilterChains() {
+				Expect(routeConfig(glooutils.MatchedRouteConfigName(listener, chain.GetMatcher())).GetResponseHeadersToAdd()).To(BeEmpty())
+			}
+		})
+	})
+
 	Context("Custom filters", func() {
 		It("http", func() {
 			hybridListener := proxy.Listeners[2]
@@ -4241,6 +4752,31 @@ func (e *endpointPluginMock) Name() string {
 func (e *endpointPluginMock) Init(params plugins.InitParams) {
 }
 
+type externalStatePluginMock struct {
+	// statName is set on the clusters, unless it is empty
+	statName string
+	version  string
+	memoize  bool
+}
+
+func (p *externalStatePluginMock) Name() string {
+	return "external_state_plugin_mock"
+}
+
+func (p *externalStatePluginMock) Init(_ plugins.InitParams) {
+}
+
+func (p *externalStatePluginMock) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	if p.statName != "" {
+		out.AltStatName = p.statName
+	}
+	return nil
+}
+
+func (p *externalStatePluginMock) ExternalStateVersion(params plugins.Params, proxy *v1.Proxy) (string, bool) {
+	return p.version, p.memoize
+}
+
 func createStaticUpstream(name, namespace string) *v1.Upstream {
 	return &v1.Upstream{
 		Metadata: &core.Metadata{
@@ -0,0 +1,123 @@
+package translator
+
+import (
+	xds_core_v3 "github.com/cncf/xds/go/xds/core/v3"
+	xds_matcher_v3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	"github.com/rotisserie/eris"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const (
+	UdpProxyFilterName    = "envoy.filters.udp_listener.udp_proxy"
+	UdpProxyRouteName     = "envoy.extensions.filters.udp.udp_proxy.v3.Route"
+	DefaultUdpStatPrefix  = "udp"
+	noUdpDestinationError = "no destination was specified for udp listener"
+)
+
+var _ ListenerTranslator = new(udpListenerTranslator)
+
+// A udpListenerTranslator produces a single Envoy Listener bound to a UDP socket.
+// UDP listeners have no FilterChains, datagrams are handled by the udp_proxy listener filter.
+type udpListenerTranslator struct {
+	listener *v1.Listener
+	report   *validationapi.ListenerReport
+}
+
+func (u *udpListenerTranslator) ComputeListener(params plugins.Params) *envoy_config_listener_v3.Listener {
+	params.Ctx = contextutils.WithLogger(params.Ctx, "compute_listener."+u.listener.GetName())
+
+	udpProxyFilter, err := u.computeUdpProxyFilter(params.Snapshot)
+	if err != nil {
+		validation.AppendListenerError(u.report,
+			validationapi.ListenerReport_Error_ProcessingError,
+			err.Error())
+		return nil
+	}
+
+	return &envoy_config_listener_v3.Listener{
+		Name:              u.listener.GetName(),
+		Address:           computeListenerAddress(u.listener, envoy_config_core_v3.SocketAddress_UDP, u.listener.GetBindPort(), u.report),
+		ListenerFilters:   []*envoy_config_listener_v3.ListenerFilter{udpProxyFilter},
+		UdpListenerConfig: &envoy_config_listener_v3.UdpListenerConfig{},
+	}
+}
+
+func (u *udpListenerTranslator) computeUdpProxyFilter(snap *v1snap.ApiSnapshot) (*envoy_config_listener_v3.ListenerFilter, error) {
+	udpListener := u.listener.GetUdpListener()
+	udpProxy := udpListener.GetUdpProxy()
+
+	destination := udpProxy.GetDestination()
+	if destination == nil {
+		return nil, eris.New(noUdpDestinationError)
+	}
+	if err := validateSingleDestination(snap.Upstreams, destination); err != nil {
+		return nil, err
+	}
+	usRef, err := usconversion.DestinationToUpstreamRef(destination)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cluster is selected by a match tree with no matchers, so every session is routed to it
+	route, err := utils.MessageToAny(&envoyudp.Route{
+		Cluster: UpstreamToClusterName(usRef),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statPrefix := udpListener.GetStatPrefix()
+	if statPrefix == "" {
+		statPrefix = DefaultUdpStatPrefix
+	}
+	udpProxyConfig, err := utils.MessageToAny(&envoyudp.UdpProxyConfig{
+		StatPrefix: statPrefix,
+		RouteSpecifier: &envoyudp.UdpProxyConfig_Matcher{
+			Matcher: &xds_matcher_v3.Matcher{
+				OnNoMatch: &xds_matcher_v3.Matcher_OnMatch{
+					OnMatch: &xds_matcher_v3.Matcher_OnMatch_Action{
+						Action: &xds_core_v3.TypedExtensionConfig{
+							Name:        UdpProxyRouteName,
+							TypedConfig: route,
+						},
+					},
+				},
+			},
+		},
+		IdleTimeout:               udpProxy.GetIdleTimeout(),
+		UsePerPacketLoadBalancing: udpProxy.GetUsePerPacketLoadBalancing().GetValue(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_config_listener_v3.ListenerFilter{
+		Name: UdpProxyFilterName,
+		ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
+			TypedConfig: udpProxyConfig,
+		},
+	}, nil
+}
+
+// isUdpProxyListener returns true if the Envoy Listener proxies UDP datagrams with the udp_proxy listener filter
+func isUdpProxyListener(listener *envoy_config_listener_v3.Listener) bool {
+	if listener.GetUdpListenerConfig() == nil {
+		return false
+	}
+	for _, listenerFilter := range listener.GetListenerFilters() {
+		if listenerFilter.GetName() == UdpProxyFilterName {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package translator
 
 import (
 	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	usconversions "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -11,6 +12,7 @@ func (t *translatorInstance) verifyUpstreamGroups(params plugins.Params, reports
 
 	upstreams := params.Snapshot.Upstreams
 	upstreamGroups := params.Snapshot.UpstreamGroups
+	DefaultUpstreamGroupNamespaces(upstreamGroups)
 
 	for _, ug := range upstreamGroups {
 		for i, dest := range ug.GetDestinations() {
@@ -19,11 +21,6 @@ func (t *translatorInstance) verifyUpstreamGroups(params plugins.Params, reports
 				continue
 			}
 
-			if upstream := dest.GetDestination().GetUpstream(); upstream != nil && upstream.GetNamespace() == "" {
-				parentMetadata := ug.GetMetadata()
-				upstream.Namespace = parentMetadata.GetNamespace()
-			}
-
 			upRef, err := usconversions.DestinationToUpstreamRef(dest.GetDestination())
 			if err != nil {
 				reports.AddError(ug, err)
@@ -39,3 +36,16 @@ func (t *translatorInstance) verifyUpstreamGroups(params plugins.Params, reports
 	}
 
 }
+
+// DefaultUpstreamGroupNamespaces sets the namespace of the upstreams of the upstream groups which have none
+// to the namespace of their group, in place.
+// It is done when translating a proxy, so it must be done beforehand if proxies of the same snapshot are translated concurrently.
+func DefaultUpstreamGroupNamespaces(upstreamGroups v1.UpstreamGroupList) {
+	for _, ug := range upstreamGroups {
+		for _, dest := range ug.GetDestinations() {
+			if upstream := dest.GetDestination().GetUpstream(); upstream != nil && upstream.GetNamespace() == "" {
+				upstream.Namespace = ug.GetMetadata().GetNamespace()
+			}
+		}
+	}
+}
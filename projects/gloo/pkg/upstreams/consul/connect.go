@@ -0,0 +1,194 @@
+package consul
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/avast/retry-go"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/rotisserie/eris"
+	"golang.org/x/sync/errgroup"
+)
+
+// ConnectProxySuffix is the suffix Consul appends to the name of a service to name its Connect sidecar proxy
+const ConnectProxySuffix = "-sidecar-proxy"
+
+// ConnectCertificates are the certificates of the Consul Connect identity of a service
+type ConnectCertificates struct {
+	// the SPIFFE trust domain of the Consul cluster
+	TrustDomain string
+	// the ids of the Connect CA roots
+	RootIds []string
+	// the PEM encoded certificates of the Connect CA roots
+	RootCertificates string
+	// the PEM encoded leaf certificate of the service, followed by the intermediate certificates of the CA, if any
+	CertificateChain string
+	// the PEM encoded private key of the leaf certificate
+	PrivateKey string
+	// the serial number of the leaf certificate
+	SerialNumber string
+}
+
+// Version identifies the certificates; it changes whenever the leaf certificate or the CA roots are rotated
+func (c *ConnectCertificates) Version() string {
+	return c.SerialNumber + "/" + strings.Join(c.RootIds, ",")
+}
+
+// FetchConnectCertificates returns the current Connect certificates of the given service.
+// Both the leaf certificate and the CA roots are served from the cache of the local agent.
+func FetchConnectCertificates(ctx context.Context, client ClientWrapper, service string) (*ConnectCertificates, error) {
+	queryOpts := (&consulapi.QueryOptions{UseCache: true}).WithContext(ctx)
+	roots, _, err := client.ConnectCARoots(queryOpts)
+	if err != nil {
+		return nil, eris.Wrapf(err, "getting Connect CA roots")
+	}
+	leaf, _, err := client.ConnectCALeaf(service, queryOpts)
+	if err != nil {
+		return nil, eris.Wrapf(err, "getting Connect leaf certificate of service %s", service)
+	}
+	return toConnectCertificates(roots, leaf), nil
+}
+
+func toConnectCertificates(roots *consulapi.CARootList, leaf *consulapi.LeafCert) *ConnectCertificates {
+	certs := &ConnectCertificates{
+		TrustDomain:      roots.TrustDomain,
+		CertificateChain: leaf.CertPEM,
+		PrivateKey:       leaf.PrivateKeyPEM,
+		SerialNumber:     leaf.SerialNumber,
+	}
+	// all the roots are trusted, so that peers keep being verified while the CA is rotated
+	var pems []string
+	for _, root := range roots.Roots {
+		certs.RootIds = append(certs.RootIds, root.ID)
+		pems = append(pems, strings.TrimSpace(root.RootCertPEM))
+	}
+	sort.Strings(certs.RootIds)
+	certs.RootCertificates = strings.Join(pems, "\n")
+	return certs
+}
+
+// Honors the contract of Watch functions to open with an initial read.
+func (c *consulWatcher) WatchConnectCertificates(ctx context.Context, service string) (<-chan *ConnectCertificates, <-chan error) {
+	var (
+		eg        errgroup.Group
+		certsChan = make(chan *ConnectCertificates)
+		errsChan  = make(chan error)
+		rootsChan = make(chan *consulapi.CARootList)
+		leafChan  = make(chan *consulapi.LeafCert)
+	)
+
+	eg.Go(func() error {
+		watchBlockingQuery(ctx, rootsChan, errsChan, func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+			return c.ConnectCARoots(q)
+		})
+		return nil
+	})
+	eg.Go(func() error {
+		watchBlockingQuery(ctx, leafChan, errsChan, func(q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+			return c.ConnectCALeaf(service, q)
+		})
+		return nil
+	})
+
+	go func() {
+		// Wait for the query routines to shut down to avoid writing to closed channels
+		_ = eg.Wait() // will never error
+		close(errsChan)
+	}()
+
+	go func() {
+		defer close(certsChan)
+		var (
+			roots *consulapi.CARootList
+			leaf  *consulapi.LeafCert
+		)
+		for {
+			select {
+			case roots = <-rootsChan:
+			case leaf = <-leafChan:
+			case <-ctx.Done():
+				return
+			}
+			if roots == nil || leaf == nil {
+				// wait for the initial read of both
+				continue
+			}
+			select {
+			case certsChan <- toConnectCertificates(roots, leaf):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return certsChan, errsChan
+}
+
+// watchBlockingQuery runs the given blocking query until the context is done, and sends its result whenever the
+// index of the query changes. The first invocation (with lastIndex equal to zero) returns immediately.
+func watchBlockingQuery[T any](ctx context.Context, results chan<- T, errs chan<- error, query func(q *consulapi.QueryOptions) (T, *consulapi.QueryMeta, error)) {
+	lastIndex := uint64(0)
+	for {
+		var (
+			result    T
+			queryMeta *consulapi.QueryMeta
+		)
+		queryOpts := &consulapi.QueryOptions{WaitIndex: lastIndex}
+
+		ctxDead := false
+
+		// Use a back-off retry strategy to avoid flooding the error channel
+		err := retry.Do(
+			func() error {
+				var err error
+				if ctx.Err() != nil {
+					// intentionally return early if context is already done
+					// this is a backoff loop; by the time we get here ctx may be done
+					ctxDead = true
+					return nil
+				}
+				result, queryMeta, err = query(queryOpts.WithContext(ctx))
+				return err
+			},
+			retry.Attempts(6),
+			//  Last delay is 2^6 * 100ms = 3.2s
+			retry.Delay(100*time.Millisecond),
+			retry.DelayType(retry.BackOffDelay),
+		)
+
+		if ctxDead {
+			return
+		}
+
+		if err != nil {
+			select {
+			case errs <- err:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		// If index is the same, there have been no changes since last query
+		if queryMeta.LastIndex == lastIndex {
+			continue
+		}
+
+		// Update the last index
+		if queryMeta.LastIndex < lastIndex {
+			// update if index goes backwards per consul blocking query docs
+			// for more, see https://www.consul.io/api-docs/features/blocking#implementation-details
+			lastIndex = 0
+		} else {
+			lastIndex = queryMeta.LastIndex
+		}
+
+		select {
+		case results <- result:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package consul_test
+
+import (
+	"context"
+
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Connect certificates", func() {
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		ctrl       *gomock.Controller
+		mockClient *mock_consul.MockClientWrapper
+
+		roots = &consulapi.CARootList{
+			TrustDomain: "consul.test",
+			Roots: []*consulapi.CARoot{
+				{ID: "root-2", RootCertPEM: "root-cert-2\n"},
+				{ID: "root-1", RootCertPEM: "root-cert-1\n", Active: true},
+			},
+		}
+		leaf = &consulapi.LeafCert{
+			SerialNumber:  "0a:0b",
+			CertPEM:       "leaf-cert",
+			PrivateKeyPEM: "leaf-key",
+		}
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctrl = gomock.NewController(T)
+		mockClient = mock_consul.NewMockClientWrapper(ctrl)
+	})
+
+	AfterEach(func() {
+		cancel()
+		ctrl.Finish()
+	})
+
+	It("fetches the leaf certificate and the CA roots from the cache of the agent", func() {
+		mockClient.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+			Expect(q.UseCache).To(BeTrue())
+			return roots, &consulapi.QueryMeta{}, nil
+		})
+		mockClient.EXPECT().ConnectCALeaf("gloo", gomock.Any()).Return(leaf, &consulapi.QueryMeta{}, nil)
+
+		certs, err := FetchConnectCertificates(ctx, mockClient, "gloo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(certs).To(Equal(&ConnectCertificates{
+			TrustDomain:      "consul.test",
+			RootIds:          []string{"root-1", "root-2"},
+			RootCertificates: "root-cert-2\nroot-cert-1",
+			CertificateChain: "leaf-cert",
+			PrivateKey:       "leaf-key",
+			SerialNumber:     "0a:0b",
+		}))
+		Expect(certs.Version()).To(Equal("0a:0b/root-1,root-2"))
+	})
+
+	It("watches the rotations of the leaf certificate", func() {
+		rotatedLeaf := &consulapi.LeafCert{SerialNumber: "0c:0d", CertPEM: "rotated-cert", PrivateKeyPEM: "rotated-key"}
+		blockUntilDone := func(q *consulapi.QueryOptions) {
+			<-q.Context().Done()
+		}
+
+		mockClient.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+			if q.WaitIndex == 0 {
+				return roots, &consulapi.QueryMeta{LastIndex: 1}, nil
+			}
+			blockUntilDone(q)
+			return nil, nil, q.Context().Err()
+		}).AnyTimes()
+		mockClient.EXPECT().ConnectCALeaf("gloo", gomock.Any()).DoAndReturn(func(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+			switch q.WaitIndex {
+			case 0:
+				return leaf, &consulapi.QueryMeta{LastIndex: 1}, nil
+			case 1:
+				return rotatedLeaf, &consulapi.QueryMeta{LastIndex: 2}, nil
+			}
+			blockUntilDone(q)
+			return nil, nil, q.Context().Err()
+		}).AnyTimes()
+
+		certsChan, errChan := NewConsulWatcherFromClient(mockClient).WatchConnectCertificates(ctx, "gloo")
+
+		var certs *ConnectCertificates
+		Eventually(certsChan).Should(Receive(&certs))
+		Expect(certs.CertificateChain).To(Equal("leaf-cert"))
+		Eventually(certsChan).Should(Receive(&certs))
+		Expect(certs.CertificateChain).To(Equal("rotated-cert"))
+		Expect(certs.RootIds).To(Equal([]string{"root-1", "root-2"}))
+
+		cancel()
+		Eventually(certsChan).Should(BeClosed())
+		Eventually(errChan).Should(BeClosed())
+	})
+})
@@ -114,6 +114,8 @@ func CreateUpstreamsFromService(service *ServiceMeta, consulConfig *v1.Settings_
 				InstanceBlacklistTags: tlsInstanceTags, // Set blacklist on non-tls upstreams to the tls tag.
 				ConsistencyMode:       consulConfig.GetConsistencyMode(),
 				QueryOptions:          consulConfig.GetQueryOptions(),
+				// services with a sidecar proxy are reached through it when a Connect identity is configured for Gloo
+				ConnectEnabled: service.ConnectEnabled && consulConfig.GetConnectServiceName() != "",
 			},
 		},
 	})
@@ -140,6 +142,7 @@ func toServiceMetaSlice(dcToSvcMap []*dataCenterServicesTuple) []*ServiceMeta {
 
 	var result []*ServiceMeta
 	for _, serviceMeta := range serviceMap {
+		_, serviceMeta.ConnectEnabled = serviceMap[serviceMeta.Name+ConnectProxySuffix]
 		sort.Strings(serviceMeta.DataCenters)
 		sort.Strings(serviceMeta.Tags)
 
@@ -192,4 +192,39 @@ var _ = Describe("Conversions", func() {
 		))
 
 	})
+
+	It("marks the services with a sidecar proxy as Connect-enabled", func() {
+		input := []*dataCenterServicesTuple{
+			{
+				dataCenter: "dc-1",
+				services: map[string][]string{
+					"svc-1":               nil,
+					"svc-1-sidecar-proxy": nil,
+					"svc-2":               nil,
+				},
+			},
+		}
+
+		result := toServiceMetaSlice(input)
+
+		Expect(result).To(ConsistOf(
+			[]*ServiceMeta{
+				{Name: "svc-1", DataCenters: []string{"dc-1"}, ConnectEnabled: true},
+				{Name: "svc-1-sidecar-proxy", DataCenters: []string{"dc-1"}},
+				{Name: "svc-2", DataCenters: []string{"dc-1"}},
+			},
+		))
+	})
+
+	It("enables Connect on the upstreams of services with a sidecar proxy when a Connect identity is configured", func() {
+		service := &ServiceMeta{Name: "svc-1", DataCenters: []string{"dc1"}, ConnectEnabled: true}
+
+		upstreams := CreateUpstreamsFromService(service, nil)
+		Expect(upstreams).To(HaveLen(1))
+		Expect(upstreams[0].GetConsul().GetConnectEnabled()).To(BeFalse())
+
+		upstreams = CreateUpstreamsFromService(service, &v1.Settings_ConsulUpstreamDiscoveryConfiguration{ConnectServiceName: "gloo"})
+		Expect(upstreams).To(HaveLen(1))
+		Expect(upstreams[0].GetConsul().GetConnectEnabled()).To(BeTrue())
+	})
 })
@@ -56,6 +56,38 @@ func (mr *MockClientWrapperMockRecorder) Connect(service, tag, q any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockClientWrapper)(nil).Connect), service, tag, q)
 }
 
+// ConnectCALeaf mocks base method.
+func (m *MockClientWrapper) ConnectCALeaf(service string, q *api.QueryOptions) (*api.LeafCert, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCALeaf", service, q)
+	ret0, _ := ret[0].(*api.LeafCert)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCALeaf indicates an expected call of ConnectCALeaf.
+func (mr *MockClientWrapperMockRecorder) ConnectCALeaf(service, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCALeaf", reflect.TypeOf((*MockClientWrapper)(nil).ConnectCALeaf), service, q)
+}
+
+// ConnectCARoots mocks base method.
+func (m *MockClientWrapper) ConnectCARoots(q *api.QueryOptions) (*api.CARootList, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCARoots", q)
+	ret0, _ := ret[0].(*api.CARootList)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCARoots indicates an expected call of ConnectCARoots.
+func (mr *MockClientWrapperMockRecorder) ConnectCARoots(q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCARoots", reflect.TypeOf((*MockClientWrapper)(nil).ConnectCARoots), q)
+}
+
 // DataCenters mocks base method.
 func (m *MockClientWrapper) DataCenters() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -59,6 +59,38 @@ func (mr *MockConsulWatcherMockRecorder) Connect(service, tag, q any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockConsulWatcher)(nil).Connect), service, tag, q)
 }
 
+// ConnectCALeaf mocks base method.
+func (m *MockConsulWatcher) ConnectCALeaf(service string, q *api.QueryOptions) (*api.LeafCert, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCALeaf", service, q)
+	ret0, _ := ret[0].(*api.LeafCert)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCALeaf indicates an expected call of ConnectCALeaf.
+func (mr *MockConsulWatcherMockRecorder) ConnectCALeaf(service, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCALeaf", reflect.TypeOf((*MockConsulWatcher)(nil).ConnectCALeaf), service, q)
+}
+
+// ConnectCARoots mocks base method.
+func (m *MockConsulWatcher) ConnectCARoots(q *api.QueryOptions) (*api.CARootList, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCARoots", q)
+	ret0, _ := ret[0].(*api.CARootList)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCARoots indicates an expected call of ConnectCARoots.
+func (mr *MockConsulWatcherMockRecorder) ConnectCARoots(q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCARoots", reflect.TypeOf((*MockConsulWatcher)(nil).ConnectCARoots), q)
+}
+
 // DataCenters mocks base method.
 func (m *MockConsulWatcher) DataCenters() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -106,6 +138,21 @@ func (mr *MockConsulWatcherMockRecorder) Services(q any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Services", reflect.TypeOf((*MockConsulWatcher)(nil).Services), q)
 }
 
+// WatchConnectCertificates mocks base method.
+func (m *MockConsulWatcher) WatchConnectCertificates(ctx context.Context, service string) (<-chan *consul0.ConnectCertificates, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchConnectCertificates", ctx, service)
+	ret0, _ := ret[0].(<-chan *consul0.ConnectCertificates)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// WatchConnectCertificates indicates an expected call of WatchConnectCertificates.
+func (mr *MockConsulWatcherMockRecorder) WatchConnectCertificates(ctx, service any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchConnectCertificates", reflect.TypeOf((*MockConsulWatcher)(nil).WatchConnectCertificates), ctx, service)
+}
+
 // WatchServices mocks base method.
 func (m *MockConsulWatcher) WatchServices(ctx context.Context, dataCenters []string, cm consul.ConsulConsistencyModes, queryOpts *consul.QueryOptions) (<-chan []*consul0.ServiceMeta, <-chan error) {
 	m.ctrl.T.Helper()
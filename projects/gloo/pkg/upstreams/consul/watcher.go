@@ -18,11 +18,16 @@ type ServiceMeta struct {
 	Name        string
 	DataCenters []string
 	Tags        []string
+	// true if the service has a Connect sidecar proxy
+	ConnectEnabled bool
 }
 
 type ConsulWatcher interface {
 	ClientWrapper
 	WatchServices(ctx context.Context, dataCenters []string, cm glooconsul.ConsulConsistencyModes, queryOpts *glooconsul.QueryOptions) (<-chan []*ServiceMeta, <-chan error)
+	// WatchConnectCertificates watches the Connect leaf certificate of the given service and the Connect CA roots,
+	// and sends the certificates whenever either of them is rotated
+	WatchConnectCertificates(ctx context.Context, service string) (<-chan *ConnectCertificates, <-chan error)
 }
 
 func NewConsulWatcher(client *consulapi.Client, dataCenters []string, serviceTagsAllowlist []string) (ConsulWatcher, error) {
@@ -151,6 +151,9 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 					},
 				},
 			}
+		case *v1.Listener_UdpListener:
+			// UdpListeners have no sub-resources, so errors are reported on the listener itself
+			listenerReports[i] = &validation.ListenerReport{}
 
 		}
 	}
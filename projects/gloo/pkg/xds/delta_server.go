@@ -0,0 +1,547 @@
+package xds
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/solo-io/go-utils/contextutils"
+	sk_discovery "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	proto2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// wildcardResourceName is the resource name a client uses to explicitly subscribe to every resource of a type
+const wildcardResourceName = "*"
+
+type DeltaStreamEnvoyV3 interface {
+	Send(response *envoy_service_discovery_v3.DeltaDiscoveryResponse) error
+	Recv() (*envoy_service_discovery_v3.DeltaDiscoveryRequest, error)
+	grpc.ServerStream
+}
+
+type DeltaStreamSolo interface {
+	Send(response *sk_discovery.DeltaDiscoveryResponse) error
+	Recv() (*sk_discovery.DeltaDiscoveryRequest, error)
+	grpc.ServerStream
+}
+
+// DeltaServer handles incremental (delta) xDS streams.
+//
+// It is built on top of the same snapshot cache that serves state-of-the-world requests: for every type a
+// stream subscribes to, a regular watch is kept open on the cache, and each new set of resources is diffed
+// against the per-resource versions previously sent on that stream. Only added or modified resources are sent,
+// and resources that disappeared from the snapshot are reported as removed.
+type DeltaServer interface {
+	// DeltaEnvoyV3 is the streaming method for incremental Envoy V3 xDS
+	DeltaEnvoyV3(stream DeltaStreamEnvoyV3, defaultTypeURL string) error
+	// DeltaSolo is the streaming method for incremental Solo discovery
+	DeltaSolo(stream DeltaStreamSolo, defaultTypeURL string) error
+}
+
+// NewDeltaServer creates delta handlers from a config watcher and optional callbacks.
+// The callbacks are the same ones given to the state-of-the-world server, so that per-client logic
+// (such as the role rewrite done for Kubernetes Gateway clients) applies to delta streams as well.
+func NewDeltaServer(ctx context.Context, config cache.ConfigWatcher, callbacks server.Callbacks) DeltaServer {
+	return &deltaServer{ctx: ctx, cache: config, callbacks: callbacks}
+}
+
+type deltaServer struct {
+	ctx       context.Context
+	cache     cache.ConfigWatcher
+	callbacks server.Callbacks
+
+	// streamCount for counting delta streams
+	streamCount int64
+}
+
+type deltaSendFunc func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error
+
+func (s *deltaServer) DeltaEnvoyV3(stream DeltaStreamEnvoyV3, defaultTypeURL string) error {
+	reqCh := make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				close(reqCh)
+				return
+			}
+			reqCh <- req
+		}
+	}()
+
+	err := s.process(stream.Context(), stream.Send, reqCh, defaultTypeURL)
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+func (s *deltaServer) DeltaSolo(stream DeltaStreamSolo, defaultTypeURL string) error {
+	reqCh := make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				close(reqCh)
+				return
+			}
+			reqCh <- upgradeDeltaDiscoveryRequest(req)
+		}
+	}()
+
+	send := func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error {
+		return stream.Send(downgradeDeltaDiscoveryResponse(resp))
+	}
+	err := s.process(stream.Context(), send, reqCh, defaultTypeURL)
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+// typedDeltaResponse is a response from a cache watch, tagged with the type it was opened for
+type typedDeltaResponse struct {
+	typeURL  string
+	response *cache.Response
+}
+
+// process handles a bi-di delta stream
+func (s *deltaServer) process(
+	ctx context.Context,
+	send deltaSendFunc,
+	reqCh <-chan *envoy_service_discovery_v3.DeltaDiscoveryRequest,
+	defaultTypeURL string,
+) error {
+	// delta stream IDs are negative so they never collide with the IDs that the state-of-the-world
+	// server hands to the same callbacks
+	streamID := -atomic.AddInt64(&s.streamCount, 1)
+	logger := contextutils.LoggerFrom(ctx)
+
+	// unique nonce generator for req-resp pairs per delta stream
+	var streamNonce int64
+
+	watches := map[string]*deltaWatch{}
+	defer func() {
+		for _, w := range watches {
+			w.cancelWatch()
+		}
+		if s.callbacks != nil {
+			s.callbacks.OnStreamClosed(streamID)
+		}
+	}()
+
+	if s.callbacks != nil {
+		if err := s.callbacks.OnStreamOpen(ctx, streamID, defaultTypeURL); err != nil {
+			return err
+		}
+	}
+
+	responses := make(chan typedDeltaResponse)
+
+	respond := func(typeURL string, w *deltaWatch, force bool) error {
+		out := w.nextResponse(typeURL, force)
+		if out == nil {
+			return nil
+		}
+		streamNonce++
+		out.Nonce = strconv.FormatInt(streamNonce, 10)
+		if s.callbacks != nil {
+			s.callbacks.OnStreamResponse(streamID, w.lastRequest, toDiscoveryResponse(out))
+		}
+		return send(out)
+	}
+
+	// node may only be set on the first discovery request
+	var node = &envoy_config_core_v3.Node{}
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case resp := <-responses:
+			if resp.response == nil {
+				return status.Error(codes.Unavailable, "watching failed for "+resp.typeURL)
+			}
+			w, ok := watches[resp.typeURL]
+			if !ok {
+				// the type was unsubscribed while the response was in flight
+				continue
+			}
+			w.setResources(resp.response)
+			if err := respond(resp.typeURL, w, !w.responded); err != nil {
+				return err
+			}
+			// re-arm the watch with the version we just consumed, so the cache only notifies us of newer snapshots
+			w.cancelWatch()
+			s.createWatch(responses, w, resp.typeURL, node)
+
+		case req, more := <-reqCh:
+			// input stream ended or errored out
+			if !more {
+				return nil
+			}
+			if req == nil {
+				return status.Errorf(codes.Unavailable, "empty request")
+			}
+
+			// node field in discovery request is delta-compressed
+			if req.GetNode() != nil {
+				node = req.GetNode()
+			} else {
+				req.Node = node
+			}
+
+			// type URL is required for ADS but is implicit for xDS
+			if defaultTypeURL == types.AnyType {
+				if req.GetTypeUrl() == "" {
+					return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
+				}
+			} else if req.GetTypeUrl() == "" {
+				req.TypeUrl = defaultTypeURL
+			}
+
+			sotwReq := toDiscoveryRequest(req)
+			if s.callbacks != nil {
+				if err := s.callbacks.OnStreamRequest(streamID, sotwReq); err != nil {
+					return err
+				}
+			}
+
+			if req.GetErrorDetail() != nil {
+				logger.Warnf("delta xDS stream %d: type %s NACKed nonce %q: %s",
+					streamID, req.GetTypeUrl(), req.GetResponseNonce(), req.GetErrorDetail().GetMessage())
+			}
+
+			typeURL := req.GetTypeUrl()
+			w, ok := watches[typeURL]
+			if !ok {
+				w = newDeltaWatch(req)
+				watches[typeURL] = w
+				w.lastRequest = sotwReq
+				s.createWatch(responses, w, typeURL, node)
+				continue
+			}
+			w.lastRequest = sotwReq
+
+			if len(req.GetResourceNamesSubscribe()) == 0 && len(req.GetResourceNamesUnsubscribe()) == 0 {
+				// plain ACK or NACK; nothing new to send
+				continue
+			}
+			w.subscribe(req.GetResourceNamesSubscribe())
+			w.unsubscribe(req.GetResourceNamesUnsubscribe())
+			if w.resources != nil {
+				// respond right away with anything the new subscriptions made visible
+				if err := respond(typeURL, w, false); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// createWatch opens a state-of-the-world watch on the cache for all resources of the given type
+func (s *deltaServer) createWatch(responses chan<- typedDeltaResponse, w *deltaWatch, typeURL string, node *envoy_config_core_v3.Node) {
+	watchReq := cache.Request{
+		Node:        node,
+		TypeUrl:     typeURL,
+		VersionInfo: w.cacheVersion,
+	}
+	watchedResource, cancelWatch := s.cache.CreateWatch(watchReq)
+
+	canceled := make(chan struct{})
+	var isCanceled int32
+	w.cancel = func() {
+		if atomic.CompareAndSwapInt32(&isCanceled, 0, 1) {
+			close(canceled)
+		}
+		if cancelWatch != nil {
+			cancelWatch()
+		}
+	}
+
+	go func() {
+		select {
+		case <-canceled:
+			return
+		case response, ok := <-watchedResource:
+			if !ok {
+				if atomic.LoadInt32(&isCanceled) == 0 {
+					// the cancel function was not called - this is an error
+					select {
+					case responses <- typedDeltaResponse{typeURL: typeURL}:
+					case <-canceled:
+					}
+				}
+				return
+			}
+			select {
+			case responses <- typedDeltaResponse{typeURL: typeURL, response: &response}:
+			case <-canceled:
+			}
+		}
+	}()
+}
+
+// deltaWatch is the state kept for a single resource type on a delta stream
+type deltaWatch struct {
+	// wildcard is true when the client subscribed to every resource of this type
+	wildcard bool
+	// subscribed contains the names the client explicitly subscribed to
+	subscribed map[string]struct{}
+	// sent contains the version of every resource the client currently has, by name
+	sent map[string]string
+
+	// cacheVersion is the snapshot version of the last cache response for this type
+	cacheVersion string
+	// resources are the resources of the last cache response, nil until the first one arrives
+	resources map[string]cache.Resource
+	// versions are the per-resource versions of resources, computed lazily and reused across
+	// snapshots as long as the resource itself is unchanged
+	versions map[string]resourceVersion
+
+	// responded is true once a response has been sent for this type
+	responded   bool
+	lastRequest *envoy_service_discovery_v3.DiscoveryRequest
+	cancel      func()
+}
+
+type resourceVersion struct {
+	resource cache.Resource
+	version  string
+}
+
+func newDeltaWatch(req *envoy_service_discovery_v3.DeltaDiscoveryRequest) *deltaWatch {
+	w := &deltaWatch{
+		subscribed: map[string]struct{}{},
+		sent:       map[string]string{},
+		versions:   map[string]resourceVersion{},
+	}
+	// an initial request without names is a legacy wildcard subscription
+	if len(req.GetResourceNamesSubscribe()) == 0 {
+		w.wildcard = true
+	}
+	w.subscribe(req.GetResourceNamesSubscribe())
+	// resources the client already has (e.g. after a reconnect) do not need to be sent again
+	for name, version := range req.GetInitialResourceVersions() {
+		w.sent[name] = version
+	}
+	return w
+}
+
+func (w *deltaWatch) cancelWatch() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+}
+
+func (w *deltaWatch) subscribe(names []string) {
+	for _, name := range names {
+		if name == wildcardResourceName {
+			w.wildcard = true
+			continue
+		}
+		w.subscribed[name] = struct{}{}
+	}
+}
+
+func (w *deltaWatch) unsubscribe(names []string) {
+	for _, name := range names {
+		if name == wildcardResourceName {
+			w.wildcard = false
+			continue
+		}
+		delete(w.subscribed, name)
+		// with a wildcard subscription the client keeps receiving the resource
+		if !w.wildcard {
+			delete(w.sent, name)
+		}
+	}
+	if !w.wildcard {
+		// drop anything that is no longer covered by an explicit subscription
+		for name := range w.sent {
+			if _, ok := w.subscribed[name]; !ok {
+				delete(w.sent, name)
+			}
+		}
+	}
+}
+
+func (w *deltaWatch) isSubscribed(name string) bool {
+	if w.wildcard {
+		return true
+	}
+	_, ok := w.subscribed[name]
+	return ok
+}
+
+func (w *deltaWatch) setResources(resp *cache.Response) {
+	w.cacheVersion = resp.Version
+	w.resources = make(map[string]cache.Resource, len(resp.Resources))
+	for _, res := range resp.Resources {
+		w.resources[res.Self().Name] = res
+	}
+	for name := range w.versions {
+		if _, ok := w.resources[name]; !ok {
+			delete(w.versions, name)
+		}
+	}
+}
+
+// versionOf returns the version of the named resource in the last cache response.
+// The hash is only recomputed when the resource object differs from the one seen previously.
+func (w *deltaWatch) versionOf(name string, res cache.Resource) string {
+	if cached, ok := w.versions[name]; ok && cached.resource == res {
+		return cached.version
+	}
+	version := hashResource(res)
+	w.versions[name] = resourceVersion{resource: res, version: version}
+	return version
+}
+
+// nextResponse computes the resources to send and remove, and records them as sent.
+// It returns nil if there is nothing to send, unless force is set.
+func (w *deltaWatch) nextResponse(typeURL string, force bool) *envoy_service_discovery_v3.DeltaDiscoveryResponse {
+	out := &envoy_service_discovery_v3.DeltaDiscoveryResponse{
+		SystemVersionInfo: w.cacheVersion,
+		TypeUrl:           typeURL,
+	}
+
+	names := make([]string, 0, len(w.resources))
+	for name := range w.resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !w.isSubscribed(name) {
+			continue
+		}
+		res := w.resources[name]
+		version := w.versionOf(name, res)
+		if sentVersion, ok := w.sent[name]; ok && sentVersion == version {
+			continue
+		}
+		data, err := proto.Marshal(res.ResourceProto())
+		if err != nil {
+			// skip this resource; the client keeps its previous version
+			continue
+		}
+		out.Resources = append(out.Resources, &envoy_service_discovery_v3.Resource{
+			Name:    name,
+			Version: version,
+			Resource: &anypb.Any{
+				TypeUrl: typeURL,
+				Value:   data,
+			},
+		})
+		w.sent[name] = version
+	}
+
+	for name := range w.sent {
+		if _, ok := w.resources[name]; !ok {
+			out.RemovedResources = append(out.RemovedResources, name)
+			delete(w.sent, name)
+		}
+	}
+	sort.Strings(out.GetRemovedResources())
+
+	if !force && len(out.GetResources()) == 0 && len(out.GetRemovedResources()) == 0 {
+		return nil
+	}
+	w.responded = true
+	return out
+}
+
+// hashResource computes the per-resource version sent to delta clients
+func hashResource(res cache.Resource) string {
+	data, err := proto2.MarshalOptions{Deterministic: true}.Marshal(proto.MessageV2(res.ResourceProto()))
+	if err != nil {
+		return ""
+	}
+	hasher := fnv.New64a()
+	hasher.Write(data)
+	return strconv.FormatUint(hasher.Sum64(), 10)
+}
+
+// toDiscoveryRequest converts a delta request to the state-of-the-world request passed to server.Callbacks
+func toDiscoveryRequest(req *envoy_service_discovery_v3.DeltaDiscoveryRequest) *envoy_service_discovery_v3.DiscoveryRequest {
+	return &envoy_service_discovery_v3.DiscoveryRequest{
+		Node:          req.GetNode(),
+		TypeUrl:       req.GetTypeUrl(),
+		ResourceNames: req.GetResourceNamesSubscribe(),
+		ResponseNonce: req.GetResponseNonce(),
+		ErrorDetail:   req.GetErrorDetail(),
+	}
+}
+
+// toDiscoveryResponse converts a delta response to the state-of-the-world response passed to server.Callbacks
+func toDiscoveryResponse(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) *envoy_service_discovery_v3.DiscoveryResponse {
+	resources := make([]*anypb.Any, 0, len(resp.GetResources()))
+	for _, res := range resp.GetResources() {
+		resources = append(resources, res.GetResource())
+	}
+	return &envoy_service_discovery_v3.DiscoveryResponse{
+		VersionInfo: resp.GetSystemVersionInfo(),
+		Resources:   resources,
+		TypeUrl:     resp.GetTypeUrl(),
+		Nonce:       resp.GetNonce(),
+	}
+}
+
+func upgradeDeltaDiscoveryRequest(req *sk_discovery.DeltaDiscoveryRequest) *envoy_service_discovery_v3.DeltaDiscoveryRequest {
+	if req == nil {
+		return nil
+	}
+	return &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+		Node:                     util.UpgradeNode(req.GetNode()),
+		TypeUrl:                  req.GetTypeUrl(),
+		ResourceNamesSubscribe:   req.GetResourceNamesSubscribe(),
+		ResourceNamesUnsubscribe: req.GetResourceNamesUnsubscribe(),
+		InitialResourceVersions:  req.GetInitialResourceVersions(),
+		ResponseNonce:            req.GetResponseNonce(),
+		ErrorDetail:              req.GetErrorDetail(),
+	}
+}
+
+func downgradeDeltaDiscoveryResponse(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) *sk_discovery.DeltaDiscoveryResponse {
+	if resp == nil {
+		return nil
+	}
+	resources := make([]*sk_discovery.Resource, 0, len(resp.GetResources()))
+	for _, res := range resp.GetResources() {
+		resources = append(resources, &sk_discovery.Resource{
+			Name:     res.GetName(),
+			Aliases:  res.GetAliases(),
+			Version:  res.GetVersion(),
+			Resource: res.GetResource(),
+		})
+	}
+	return &sk_discovery.DeltaDiscoveryResponse{
+		SystemVersionInfo: resp.GetSystemVersionInfo(),
+		Resources:         resources,
+		TypeUrl:           resp.GetTypeUrl(),
+		RemovedResources:  resp.GetRemovedResources(),
+		Nonce:             resp.GetNonce(),
+	}
+}
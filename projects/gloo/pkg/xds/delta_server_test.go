@@ -0,0 +1,197 @@
+package xds_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("DeltaServer", func() {
+
+	const role = "test~proxy"
+
+	var (
+		ctx         context.Context
+		cancel      context.CancelFunc
+		snapCache   cache.SnapshotCache
+		deltaServer xds.DeltaServer
+		stream      *fakeDeltaStream
+		node        *envoy_config_core_v3.Node
+	)
+
+	cluster := func(name string, timeout time.Duration) cache.Resource {
+		return resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{
+			Name:           name,
+			ConnectTimeout: durationpb.New(timeout),
+		})
+	}
+	endpoints := func(name string) cache.Resource {
+		return resource.NewEnvoyResource(&envoy_config_endpoint_v3.ClusterLoadAssignment{ClusterName: name})
+	}
+	names := func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) []string {
+		var out []string
+		for _, r := range resp.GetResources() {
+			out = append(out, r.GetName())
+		}
+		return out
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		snapCache = xds.NewAdsSnapshotCache(ctx)
+		deltaServer = xds.NewDeltaServer(ctx, snapCache, nil)
+		stream = newFakeDeltaStream(ctx)
+		node = &envoy_config_core_v3.Node{
+			Id: "test",
+			Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+				xds.RoleKey: structpb.NewStringValue(role),
+			}},
+		}
+
+		snapCache.SetSnapshot(role, xds.NewSnapshot("1",
+			[]cache.Resource{endpoints("a"), endpoints("b")},
+			[]cache.Resource{cluster("a", time.Second), cluster("b", time.Second)},
+			nil,
+			nil,
+		))
+
+		go func() {
+			defer GinkgoRecover()
+			_ = deltaServer.DeltaEnvoyV3(stream, types.AnyType)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("sends only changed and removed resources to wildcard subscribers", func() {
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:    node,
+			TypeUrl: types.ClusterTypeV3,
+		}
+
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("a", "b"))
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+
+		// ACK
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:       types.ClusterTypeV3,
+			ResponseNonce: resp.GetNonce(),
+		}
+
+		snapCache.SetSnapshot(role, xds.NewSnapshot("2",
+			[]cache.Resource{endpoints("a"), endpoints("c")},
+			[]cache.Resource{cluster("a", time.Second), cluster("c", 2*time.Second)},
+			nil,
+			nil,
+		))
+
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("c"))
+		Expect(resp.GetRemovedResources()).To(ConsistOf("b"))
+		Expect(resp.GetSystemVersionInfo()).To(Equal("2"))
+	})
+
+	It("respects explicit subscriptions and unsubscriptions", func() {
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:                   node,
+			TypeUrl:                types.EndpointTypeV3,
+			ResourceNamesSubscribe: []string{"a"},
+		}
+
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("a"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:                  types.EndpointTypeV3,
+			ResponseNonce:            resp.GetNonce(),
+			ResourceNamesSubscribe:   []string{"b"},
+			ResourceNamesUnsubscribe: []string{"a"},
+		}
+
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(ConsistOf("b"))
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+	})
+
+	It("does not resend resources the client already has", func() {
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:    node,
+			TypeUrl: types.ClusterTypeV3,
+		}
+		var first *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&first))
+
+		initialVersions := map[string]string{}
+		for _, r := range first.GetResources() {
+			initialVersions[r.GetName()] = r.GetVersion()
+		}
+
+		// reconnect with the versions the client already has
+		reconnected := newFakeDeltaStream(ctx)
+		go func() {
+			defer GinkgoRecover()
+			_ = deltaServer.DeltaEnvoyV3(reconnected, types.AnyType)
+		}()
+		reconnected.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:                    node,
+			TypeUrl:                 types.ClusterTypeV3,
+			InitialResourceVersions: initialVersions,
+		}
+
+		var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+		Eventually(reconnected.responses).Should(Receive(&resp))
+		Expect(resp.GetResources()).To(BeEmpty())
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+	})
+})
+
+type fakeDeltaStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *envoy_service_discovery_v3.DeltaDiscoveryRequest
+	responses chan *envoy_service_discovery_v3.DeltaDiscoveryResponse
+}
+
+func newFakeDeltaStream(ctx context.Context) *fakeDeltaStream {
+	return &fakeDeltaStream{
+		ctx:       ctx,
+		requests:  make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest, 10),
+		responses: make(chan *envoy_service_discovery_v3.DeltaDiscoveryResponse, 10),
+	}
+}
+
+func (f *fakeDeltaStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeDeltaStream) Send(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error {
+	f.responses <- resp
+	return nil
+}
+
+func (f *fakeDeltaStream) Recv() (*envoy_service_discovery_v3.DeltaDiscoveryRequest, error) {
+	select {
+	case req := <-f.requests:
+		return req, nil
+	case <-f.ctx.Done():
+		return nil, f.ctx.Err()
+	}
+}
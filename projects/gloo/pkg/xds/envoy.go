@@ -14,7 +14,7 @@ import (
 )
 
 // register xDS methods with GRPC server
-func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyCache envoycache.SnapshotCache, ipV4Only bool) {
+func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, deltaServer DeltaServer, envoyCache envoycache.SnapshotCache, ipV4Only bool) {
 
 	// check if we need to register
 	if _, ok := grpcServer.GetServiceInfo()["solo.io.xds.SoloDiscoveryService"]; ok {
@@ -24,10 +24,10 @@ func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyC
 	// The Gloo Server is an xDS server that accepts v2 Envoy ADS requests. The Envoy v2 API has been
 	// deprecated but the ADS api has been preserved internally to support discovery of
 	// ext-auth and rate-limit configurations.
-	glooServer := NewGlooXdsServer(xdsServer)
+	glooServer := NewGlooXdsServer(xdsServer, deltaServer)
 	solo_xds.RegisterSoloDiscoveryServiceServer(grpcServer, glooServer)
 
-	envoyServer := NewEnvoyServerV3(xdsServer)
+	envoyServer := NewEnvoyServerV3(xdsServer, deltaServer)
 	envoy_service_endpoint_v3.RegisterEndpointDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_cluster_v3.RegisterClusterDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_route_v3.RegisterRouteDiscoveryServiceServer(grpcServer, envoyServer)
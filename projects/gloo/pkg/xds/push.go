@@ -0,0 +1,28 @@
+package xds
+
+import (
+	"context"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/solo-io/gloo/pkg/utils/telemetry"
+)
+
+// SnapshotSetter is the part of a SnapshotCache that pushes snapshots to the xDS clients of a node.
+type SnapshotSetter interface {
+	SetSnapshot(node string, snapshot cache.Snapshot)
+}
+
+// PushSnapshot sets the snapshot of the given node in the cache, which pushes it to the
+// connected Envoys, and records the push in a span.
+func PushSnapshot(ctx context.Context, setter SnapshotSetter, node string, snapshot cache.Snapshot) {
+	_, span := telemetry.StartSpan(ctx, "gloo.xds.PushSnapshot",
+		telemetry.NodeKey.String(node),
+		attribute.String("gloo.xds.listeners.version", snapshot.GetResources(types.ListenerTypeV3).Version),
+		attribute.String("gloo.xds.clusters.version", snapshot.GetResources(types.ClusterTypeV3).Version),
+	)
+	defer span.End()
+	setter.SetSnapshot(node, snapshot)
+}
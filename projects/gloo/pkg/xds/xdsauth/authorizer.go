@@ -0,0 +1,307 @@
+package xdsauth
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	authorizationHeader = "authorization"
+
+	serverGrpc = "grpc"
+	serverRest = "rest"
+
+	reasonUnauthenticated = "unauthenticated"
+	reasonUnauthorized    = "unauthorized"
+)
+
+var (
+	NoAuthenticationError = eris.New("xdsAuthOptions require mtls or serviceAccountTokens")
+
+	NoKubeClientError = eris.New("serviceAccountTokens require Gloo to run in Kubernetes")
+
+	NoNodeError = eris.New("the request does not identify its node, which must be sent with the first request of a stream")
+
+	UnauthorizedError = func(serviceAccount ServiceAccount, role string) error {
+		return eris.Errorf("service account %v is not allowed to request the configuration of %v", serviceAccount, role)
+	}
+
+	mRequestsDenied = stats.Int64("xds.gloo.solo.io/requests_denied", "The number of xDS requests denied", "1")
+	serverKey, _    = tag.NewKey("server")
+	reasonKey, _    = tag.NewKey("reason")
+
+	requestsDeniedView = &view.View{
+		Name:        "xds.gloo.solo.io/requests_denied",
+		Measure:     mRequestsDenied,
+		Description: "The number of xDS requests denied, because the client was not authenticated or not allowed to request the proxy",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{serverKey, reasonKey},
+	}
+)
+
+func init() {
+	_ = view.Register(requestsDeniedView)
+}
+
+// nodeRequest is implemented by the requests of the xDS APIs
+type nodeRequest interface {
+	GetNode() *envoy_config_core_v3.Node
+}
+
+// Authorizer authenticates the clients of the xDS servers as Kubernetes service accounts, and denies their requests
+// for the configuration of proxies which are not bound to their service account.
+// A nil Authorizer serves every client.
+type Authorizer struct {
+	ctx           context.Context
+	authenticator *authenticator
+	policy        *policy
+	tls           *tlsFiles
+	roleHasher    interface {
+		ID(node *envoy_config_core_v3.Node) string
+	}
+}
+
+// NewAuthorizer returns the Authorizer configured by the options, or nil if they are unset.
+// kubeClient is used to review service account tokens, and may be nil if tokens are not accepted.
+func NewAuthorizer(ctx context.Context, options *v1.GlooOptions_XdsAuthOptions, kubeClient kubernetes.Interface) (*Authorizer, error) {
+	if options == nil {
+		return nil, nil
+	}
+	if options.GetMtls() == nil && options.GetServiceAccountTokens() == nil {
+		return nil, NoAuthenticationError
+	}
+
+	a := &Authorizer{
+		ctx:           ctx,
+		authenticator: &authenticator{},
+		roleHasher:    xds.NewNodeRoleHasher(),
+	}
+	if mtls := options.GetMtls(); mtls != nil {
+		files, err := newTlsFiles(mtls)
+		if err != nil {
+			return nil, err
+		}
+		a.tls = files
+		a.authenticator.certificates = true
+	}
+	if tokens := options.GetServiceAccountTokens(); tokens != nil {
+		if kubeClient == nil {
+			return nil, NoKubeClientError
+		}
+		a.authenticator.tokens = newTokenReviewer(kubeClient.AuthenticationV1().TokenReviews(), tokens.GetAudiences())
+	}
+	policy, err := newPolicy(options)
+	if err != nil {
+		return nil, err
+	}
+	a.policy = policy
+	return a, nil
+}
+
+// GrpcServerOptions returns the options of the xDS gRPC server which enforce the authorization of its clients.
+func (a *Authorizer) GrpcServerOptions() []grpc.ServerOption {
+	if a == nil {
+		return nil
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainStreamInterceptor(a.streamInterceptor),
+		grpc.ChainUnaryInterceptor(a.unaryInterceptor),
+	}
+	if a.tls != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(a.tls.serverConfig())))
+	}
+	return opts
+}
+
+// TLSConfig returns the TLS configuration of the REST xDS server, or nil if it serves plain HTTP.
+func (a *Authorizer) TLSConfig() *tls.Config {
+	if a == nil || a.tls == nil {
+		return nil
+	}
+	return a.tls.serverConfig()
+}
+
+// HttpHandler wraps the handler of the REST xDS server, so that it only handles the requests that are authorized.
+func (a *Authorizer) HttpHandler(handler http.Handler) http.Handler {
+	if a == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serviceAccount, ok := a.authenticateHttp(w, r)
+		if !ok {
+			return
+		}
+
+		// the node of the request is read here, and the request is handed to the handler as it was read
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "cannot read body", http.StatusBadRequest)
+			return
+		}
+		req := &envoy_service_discovery_v3.DiscoveryRequest{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, req); err != nil {
+			http.Error(w, "cannot parse JSON body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if role, err := a.authorize(serviceAccount, req.GetNode()); err != nil {
+			a.deny(serverRest, reasonUnauthorized, role, err)
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// AuthenticatingHttpHandler wraps a handler of the REST xDS server whose requests do not identify a node, such as
+// the requests for Wasm modules, so that it only handles the requests of authenticated clients.
+func (a *Authorizer) AuthenticatingHttpHandler(handler http.Handler) http.Handler {
+	if a == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := a.authenticateHttp(w, r); ok {
+			handler.ServeHTTP(w, r)
+		}
+	})
+}
+
+// authenticateHttp returns the service account of the client of the request, or denies the request
+func (a *Authorizer) authenticateHttp(w http.ResponseWriter, r *http.Request) (ServiceAccount, bool) {
+	serviceAccount, err := a.authenticator.authenticate(r.Context(), r.Header.Get(authorizationHeader), r.TLS)
+	if err != nil {
+		a.deny(serverRest, reasonUnauthenticated, "", err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return ServiceAccount{}, false
+	}
+	return serviceAccount, true
+}
+
+func (a *Authorizer) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	serviceAccount, err := a.authenticateGrpc(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nodeReq, ok := req.(nodeRequest); ok {
+		if err := a.authorizeGrpc(serviceAccount, nodeReq.GetNode()); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+func (a *Authorizer) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	serviceAccount, err := a.authenticateGrpc(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedStream{
+		ServerStream:   ss,
+		authorizer:     a,
+		serviceAccount: serviceAccount,
+	})
+}
+
+// authorizedStream authorizes the requests received on an xDS stream.
+// Clients may only send their node with the first request of a stream, which the following requests are made for,
+// so the first request is rejected if it has no node.
+type authorizedStream struct {
+	grpc.ServerStream
+	authorizer     *Authorizer
+	serviceAccount ServiceAccount
+	// whether the node of the stream was authorized
+	authorized bool
+}
+
+func (s *authorizedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	req, ok := m.(nodeRequest)
+	if !ok || (s.authorized && req.GetNode() == nil) {
+		return nil
+	}
+	if err := s.authorizer.authorizeGrpc(s.serviceAccount, req.GetNode()); err != nil {
+		return err
+	}
+	s.authorized = true
+	return nil
+}
+
+func (a *Authorizer) authenticateGrpc(ctx context.Context) (ServiceAccount, error) {
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &info.State
+		}
+	}
+
+	serviceAccount, err := a.authenticator.authenticate(ctx, authorization, state)
+	if err != nil {
+		a.deny(serverGrpc, reasonUnauthenticated, "", err)
+		return ServiceAccount{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return serviceAccount, nil
+}
+
+func (a *Authorizer) authorizeGrpc(serviceAccount ServiceAccount, node *envoy_config_core_v3.Node) error {
+	if role, err := a.authorize(serviceAccount, node); err != nil {
+		a.deny(serverGrpc, reasonUnauthorized, role, err)
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+// authorize returns the role requested by the node, and an error if the service account may not request it
+func (a *Authorizer) authorize(serviceAccount ServiceAccount, node *envoy_config_core_v3.Node) (string, error) {
+	if node == nil {
+		return "", NoNodeError
+	}
+	role := a.roleHasher.ID(node)
+	if !a.policy.allowed(serviceAccount, role) {
+		return role, UnauthorizedError(serviceAccount, role)
+	}
+	return role, nil
+}
+
+// deny logs and counts a denied request
+func (a *Authorizer) deny(server, reason, role string, err error) {
+	contextutils.LoggerFrom(a.ctx).Warnw("denied xDS request",
+		zap.String("server", server),
+		zap.String("reason", reason),
+		zap.String("role", role),
+		zap.Error(err))
+	_ = stats.RecordWithTags(a.ctx, []tag.Mutator{
+		tag.Upsert(serverKey, server),
+		tag.Upsert(reasonKey, reason),
+	}, mRequestsDenied.M(1))
+}
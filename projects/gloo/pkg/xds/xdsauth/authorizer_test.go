@@ -0,0 +1,547 @@
+package xdsauth_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_service_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/service/endpoint/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opencensus.io/stats/view"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/xds/xdsauth"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	proxyRole   = "gloo-system~gateway-proxy"
+	gatewayRole = "gloo-kube-gateway-api~default~default-http"
+	otherRole   = "gloo-system~other-proxy"
+)
+
+var _ = Describe("Authorizer", func() {
+
+	var (
+		ctx     context.Context
+		options *v1.GlooOptions_XdsAuthOptions
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		options = &v1.GlooOptions_XdsAuthOptions{
+			ProxyBindings: []*v1.GlooOptions_XdsAuthOptions_ProxyBinding{
+				{
+					Proxy: &v1.GlooOptions_XdsAuthOptions_ProxyBinding_Role{Role: proxyRole},
+					ServiceAccounts: []*core.ResourceRef{
+						{Name: "gateway-proxy", Namespace: "gloo-system"},
+					},
+				},
+				{
+					Proxy: &v1.GlooOptions_XdsAuthOptions_ProxyBinding_Gateway{
+						Gateway: &core.ResourceRef{Name: "http", Namespace: "default"},
+					},
+					ServiceAccounts: []*core.ResourceRef{
+						{Name: "http", Namespace: "default"},
+					},
+				},
+			},
+		}
+	})
+
+	Context("configuration", func() {
+
+		It("serves every client when unset", func() {
+			authorizer, err := NewAuthorizer(ctx, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authorizer).To(BeNil())
+			Expect(authorizer.GrpcServerOptions()).To(BeEmpty())
+			Expect(authorizer.TLSConfig()).To(BeNil())
+		})
+
+		It("requires a way to authenticate clients", func() {
+			_, err := NewAuthorizer(ctx, options, nil)
+			Expect(err).To(MatchError(NoAuthenticationError))
+		})
+
+		It("requires a kubernetes client to review tokens", func() {
+			options.ServiceAccountTokens = &v1.GlooOptions_XdsAuthOptions_ServiceAccountTokens{}
+			_, err := NewAuthorizer(ctx, options, nil)
+			Expect(err).To(MatchError(NoKubeClientError))
+		})
+
+		It("rejects bindings without a proxy", func() {
+			options.ServiceAccountTokens = &v1.GlooOptions_XdsAuthOptions_ServiceAccountTokens{}
+			options.ProxyBindings = append(options.ProxyBindings, &v1.GlooOptions_XdsAuthOptions_ProxyBinding{})
+			_, err := NewAuthorizer(ctx, options, fake.NewSimpleClientset())
+			Expect(err).To(MatchError(ContainSubstring("invalid proxy binding 2")))
+		})
+
+		It("rejects missing mtls files", func() {
+			options.Mtls = &v1.GlooOptions_XdsAuthOptions_Mtls{CertFile: "/missing/tls.crt", KeyFile: "/missing/tls.key"}
+			_, err := NewAuthorizer(ctx, options, nil)
+			Expect(err).To(MatchError(MissingMtlsFileError))
+		})
+	})
+
+	Context("with service account tokens", func() {
+
+		var (
+			authorizer *Authorizer
+			reviews    atomic.Int32
+		)
+
+		BeforeEach(func() {
+			reviews.Store(0)
+			options.ServiceAccountTokens = &v1.GlooOptions_XdsAuthOptions_ServiceAccountTokens{
+				Audiences: []string{"gloo"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			kubeClient := fake.NewSimpleClientset()
+			// tokens are the usernames of the service accounts they were issued to
+			kubeClient.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				reviews.Add(1)
+				tokenReview := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+				Expect(tokenReview.Spec.Audiences).To(ConsistOf("gloo"))
+				if tokenReview.Spec.Token == "invalid" {
+					tokenReview.Status = authenticationv1.TokenReviewStatus{Error: "token expired"}
+				} else {
+					tokenReview.Status = authenticationv1.TokenReviewStatus{
+						Authenticated: true,
+						User:          authenticationv1.UserInfo{Username: tokenReview.Spec.Token},
+					}
+				}
+				return true, tokenReview, nil
+			})
+
+			var err error
+			authorizer, err = NewAuthorizer(ctx, options, kubeClient)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("REST xDS", func() {
+
+			var server *httptest.Server
+
+			JustBeforeEach(func() {
+				// the handler echoes the request, to check that it is handed the request as it was sent
+				server = httptest.NewServer(authorizer.HttpHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_, _ = io.Copy(w, r.Body)
+				})))
+				DeferCleanup(server.Close)
+			})
+
+			fetch := func(token, role string) (int, string) {
+				body, err := protojson.Marshal(discoveryRequest(role))
+				Expect(err).NotTo(HaveOccurred())
+				req, err := http.NewRequest(http.MethodPost, server.URL+"/v3/discovery:endpoints", bytes.NewReader(body))
+				Expect(err).NotTo(HaveOccurred())
+				if token != "" {
+					req.Header.Set("Authorization", "Bearer "+token)
+				}
+				resp, err := http.DefaultClient.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+				defer resp.Body.Close()
+				respBody, err := io.ReadAll(resp.Body)
+				Expect(err).NotTo(HaveOccurred())
+				return resp.StatusCode, string(respBody)
+			}
+
+			It("serves the proxies bound to the service account of the token", func() {
+				code, body := fetch("system:serviceaccount:gloo-system:gateway-proxy", proxyRole)
+				Expect(code).To(Equal(http.StatusOK))
+				Expect(body).To(ContainSubstring(proxyRole))
+
+				code, _ = fetch("system:serviceaccount:default:http", gatewayRole)
+				Expect(code).To(Equal(http.StatusOK))
+			})
+
+			It("denies the proxies that are not bound to the service account of the token", func() {
+				deniedBefore := requestsDenied("rest", "unauthorized")
+
+				code, body := fetch("system:serviceaccount:default:http", proxyRole)
+				Expect(code).To(Equal(http.StatusForbidden))
+				Expect(body).To(ContainSubstring("service account default/http is not allowed to request the configuration of " + proxyRole))
+
+				code, _ = fetch("system:serviceaccount:gloo-system:gateway-proxy", otherRole)
+				Expect(code).To(Equal(http.StatusForbidden))
+
+				Expect(requestsDenied("rest", "unauthorized")).To(Equal(deniedBefore + 2))
+			})
+
+			It("denies unauthenticated clients", func() {
+				code, _ := fetch("", proxyRole)
+				Expect(code).To(Equal(http.StatusUnauthorized))
+
+				code, body := fetch("invalid", proxyRole)
+				Expect(code).To(Equal(http.StatusUnauthorized))
+				Expect(body).To(ContainSubstring("token expired"))
+
+				code, _ = fetch("system:node:worker", proxyRole)
+				Expect(code).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("serves the fallback configuration to nodes without a role", func() {
+				code, _ := fetch("system:serviceaccount:default:http", "")
+				Expect(code).To(Equal(http.StatusOK))
+			})
+
+			It("reviews each token once", func() {
+				fetch("system:serviceaccount:gloo-system:gateway-proxy", proxyRole)
+				fetch("system:serviceaccount:gloo-system:gateway-proxy", proxyRole)
+				Expect(reviews.Load()).To(BeEquivalentTo(1))
+			})
+
+			It("only authenticates the clients of the handlers whose requests do not identify a node", func() {
+				modules := httptest.NewServer(authorizer.AuthenticatingHttpHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte("module"))
+				})))
+				DeferCleanup(modules.Close)
+
+				get := func(token string) int {
+					req, err := http.NewRequest(http.MethodGet, modules.URL+"/wasm/modules/sha256", nil)
+					Expect(err).NotTo(HaveOccurred())
+					if token != "" {
+						req.Header.Set("Authorization", "Bearer "+token)
+					}
+					resp, err := http.DefaultClient.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					defer resp.Body.Close()
+					return resp.StatusCode
+				}
+
+				Expect(get("system:serviceaccount:default:http")).To(Equal(http.StatusOK))
+				Expect(get("")).To(Equal(http.StatusUnauthorized))
+				Expect(get("invalid")).To(Equal(http.StatusUnauthorized))
+			})
+
+			When("unbound proxies are allowed", func() {
+
+				BeforeEach(func() {
+					options.AllowUnboundProxies = true
+				})
+
+				It("serves the unbound proxies to any service account", func() {
+					code, _ := fetch("system:serviceaccount:default:http", otherRole)
+					Expect(code).To(Equal(http.StatusOK))
+
+					code, _ = fetch("system:serviceaccount:default:http", proxyRole)
+					Expect(code).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("gRPC xDS", func() {
+
+			var client envoy_service_endpoint_v3.EndpointDiscoveryServiceClient
+
+			JustBeforeEach(func() {
+				listener := bufconn.Listen(1024 * 1024)
+				server := grpc.NewServer(authorizer.GrpcServerOptions()...)
+				envoy_service_endpoint_v3.RegisterEndpointDiscoveryServiceServer(server, &echoEndpointServer{})
+				go func() {
+					defer GinkgoRecover()
+					Expect(server.Serve(listener)).To(Succeed())
+				}()
+				DeferCleanup(server.Stop)
+
+				conn, err := grpc.NewClient("passthrough:///bufnet",
+					grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+						return listener.DialContext(ctx)
+					}),
+					grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).NotTo(HaveOccurred())
+				DeferCleanup(conn.Close)
+				client = envoy_service_endpoint_v3.NewEndpointDiscoveryServiceClient(conn)
+			})
+
+			withToken := func(token string) context.Context {
+				return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+			}
+
+			It("authorizes fetch requests", func() {
+				_, err := client.FetchEndpoints(withToken("system:serviceaccount:gloo-system:gateway-proxy"), discoveryRequest(proxyRole))
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = client.FetchEndpoints(withToken("system:serviceaccount:gloo-system:gateway-proxy"), discoveryRequest(gatewayRole))
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+				_, err = client.FetchEndpoints(ctx, discoveryRequest(proxyRole))
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			})
+
+			It("authorizes every node sent on a stream", func() {
+				deniedBefore := requestsDenied("grpc", "unauthorized")
+
+				stream, err := client.StreamEndpoints(withToken("system:serviceaccount:gloo-system:gateway-proxy"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stream.Send(discoveryRequest(proxyRole))).To(Succeed())
+				_, err = stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+
+				// the node is only sent with the first request of a stream
+				Expect(stream.Send(&envoy_service_discovery_v3.DiscoveryRequest{VersionInfo: "1"})).To(Succeed())
+				_, err = stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stream.Send(discoveryRequest(otherRole))).To(Succeed())
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+				Expect(requestsDenied("grpc", "unauthorized")).To(Equal(deniedBefore + 1))
+			})
+
+			It("denies streams whose first request has no node", func() {
+				stream, err := client.StreamEndpoints(withToken("system:serviceaccount:gloo-system:gateway-proxy"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stream.Send(&envoy_service_discovery_v3.DiscoveryRequest{VersionInfo: "1"})).To(Succeed())
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(err.Error()).To(ContainSubstring(NoNodeError.Error()))
+			})
+
+			It("denies streams of unauthenticated clients", func() {
+				stream, err := client.StreamEndpoints(withToken("invalid"))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			})
+		})
+	})
+
+	Context("with mtls", func() {
+
+		var (
+			certs      *testCerts
+			serverAddr string
+		)
+
+		BeforeEach(func() {
+			certs = newTestCerts()
+			dir := GinkgoT().TempDir()
+			options.Mtls = &v1.GlooOptions_XdsAuthOptions_Mtls{
+				CertFile: filepath.Join(dir, "tls.crt"),
+				KeyFile:  filepath.Join(dir, "tls.key"),
+				CaFile:   filepath.Join(dir, "ca.crt"),
+			}
+			serverCert, serverKey := certs.issue(&x509.Certificate{
+				DNSNames:    []string{"localhost"},
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			})
+			Expect(os.WriteFile(options.Mtls.GetCertFile(), serverCert, 0o600)).To(Succeed())
+			Expect(os.WriteFile(options.Mtls.GetKeyFile(), serverKey, 0o600)).To(Succeed())
+			Expect(os.WriteFile(options.Mtls.GetCaFile(), certs.caPem, 0o600)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			authorizer, err := NewAuthorizer(ctx, options, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			serverAddr = listener.Addr().String()
+			server := grpc.NewServer(authorizer.GrpcServerOptions()...)
+			envoy_service_endpoint_v3.RegisterEndpointDiscoveryServiceServer(server, &echoEndpointServer{})
+			go func() {
+				defer GinkgoRecover()
+				Expect(server.Serve(listener)).To(Succeed())
+			}()
+			DeferCleanup(server.Stop)
+		})
+
+		// fetch requests the role with a client certificate that has the given SPIFFE ID
+		fetch := func(spiffeId, role string) error {
+			clientCert := &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}
+			if spiffeId != "" {
+				uri, err := url.Parse(spiffeId)
+				Expect(err).NotTo(HaveOccurred())
+				clientCert.URIs = []*url.URL{uri}
+			}
+			certPem, keyPem := certs.issue(clientCert)
+			keyPair, err := tls.X509KeyPair(certPem, keyPem)
+			Expect(err).NotTo(HaveOccurred())
+			rootCAs := x509.NewCertPool()
+			rootCAs.AppendCertsFromPEM(certs.caPem)
+
+			conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+				Certificates: []tls.Certificate{keyPair},
+				RootCAs:      rootCAs,
+				ServerName:   "localhost",
+			})))
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+			_, err = envoy_service_endpoint_v3.NewEndpointDiscoveryServiceClient(conn).FetchEndpoints(ctx, discoveryRequest(role))
+			return err
+		}
+
+		It("serves the proxies bound to the service account of the client certificate", func() {
+			Expect(fetch("spiffe://cluster.local/ns/gloo-system/sa/gateway-proxy", proxyRole)).To(Succeed())
+
+			err := fetch("spiffe://cluster.local/ns/default/sa/http", proxyRole)
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		})
+
+		It("denies certificates without the SPIFFE ID of a service account", func() {
+			err := fetch("", proxyRole)
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+			err = fetch("spiffe://cluster.local/workload/gateway-proxy", proxyRole)
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("serves the certificate that is currently mounted", func() {
+			Expect(fetch("spiffe://cluster.local/ns/gloo-system/sa/gateway-proxy", proxyRole)).To(Succeed())
+
+			// rotate the CA, so that the previous client certificates are no longer trusted
+			previousCerts := certs
+			certs = newTestCerts()
+			serverCert, serverKey := certs.issue(&x509.Certificate{
+				DNSNames:    []string{"localhost"},
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			})
+			// make sure that the modification times of the files change
+			later := time.Now().Add(time.Minute)
+			for file, content := range map[string][]byte{
+				options.Mtls.GetCertFile(): serverCert,
+				options.Mtls.GetKeyFile():  serverKey,
+				options.Mtls.GetCaFile():   certs.caPem,
+			} {
+				Expect(os.WriteFile(file, content, 0o600)).To(Succeed())
+				Expect(os.Chtimes(file, later, later)).To(Succeed())
+			}
+
+			Expect(fetch("spiffe://cluster.local/ns/gloo-system/sa/gateway-proxy", proxyRole)).To(Succeed())
+			certs = previousCerts
+			Expect(fetch("spiffe://cluster.local/ns/gloo-system/sa/gateway-proxy", proxyRole)).NotTo(Succeed())
+		})
+	})
+})
+
+func discoveryRequest(role string) *envoy_service_discovery_v3.DiscoveryRequest {
+	node := &envoy_config_core_v3.Node{Id: "envoy"}
+	if role != "" {
+		node.Metadata = &structpb.Struct{Fields: map[string]*structpb.Value{
+			"role": structpb.NewStringValue(role),
+		}}
+	}
+	return &envoy_service_discovery_v3.DiscoveryRequest{Node: node}
+}
+
+// requestsDenied returns the number of denied requests recorded for the server and reason
+func requestsDenied(server, reason string) int64 {
+	rows, err := view.RetrieveData("xds.gloo.solo.io/requests_denied")
+	Expect(err).NotTo(HaveOccurred())
+	for _, row := range rows {
+		tags := map[string]string{}
+		for _, t := range row.Tags {
+			tags[t.Key.Name()] = t.Value
+		}
+		if tags["server"] == server && tags["reason"] == reason {
+			return row.Data.(*view.CountData).Value
+		}
+	}
+	return 0
+}
+
+// echoEndpointServer answers every request with a response of the same version
+type echoEndpointServer struct {
+	envoy_service_endpoint_v3.UnimplementedEndpointDiscoveryServiceServer
+}
+
+func (s *echoEndpointServer) FetchEndpoints(_ context.Context, req *envoy_service_discovery_v3.DiscoveryRequest) (*envoy_service_discovery_v3.DiscoveryResponse, error) {
+	return &envoy_service_discovery_v3.DiscoveryResponse{VersionInfo: req.GetVersionInfo()}, nil
+}
+
+func (s *echoEndpointServer) StreamEndpoints(stream envoy_service_endpoint_v3.EndpointDiscoveryService_StreamEndpointsServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&envoy_service_discovery_v3.DiscoveryResponse{VersionInfo: req.GetVersionInfo()}); err != nil {
+			return err
+		}
+	}
+}
+
+// testCerts is a CA which issues the certificates of the servers and clients of a test
+type testCerts struct {
+	ca    *x509.Certificate
+	caKey *ecdsa.PrivateKey
+	caPem []byte
+}
+
+func newTestCerts() *testCerts {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "xds-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	Expect(err).NotTo(HaveOccurred())
+	ca, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	return &testCerts{
+		ca:    ca,
+		caKey: caKey,
+		caPem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns the PEM-encoded certificate and key issued by the CA for the template
+func (c *testCerts) issue(template *x509.Certificate) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(time.Hour)
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	der, err := x509.CreateCertificate(rand.Reader, template, c.ca, &key.PublicKey, c.caKey)
+	Expect(err).NotTo(HaveOccurred())
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
@@ -0,0 +1,164 @@
+package xdsauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rotisserie/eris"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
+)
+
+const (
+	serviceAccountUsernamePrefix = "system:serviceaccount:"
+	bearerPrefix                 = "Bearer "
+
+	// how long a reviewed token is trusted for before it is reviewed again
+	tokenReviewTTL = time.Minute
+)
+
+var (
+	NoCredentialsError = eris.New("no service account token or client certificate")
+
+	InvalidTokenError = func(reason string) error {
+		return eris.Errorf("invalid service account token: %v", reason)
+	}
+
+	InvalidCertificateError = func(reason string) error {
+		return eris.Errorf("invalid client certificate: %v", reason)
+	}
+)
+
+// ServiceAccount is the identity of an xDS client: the Kubernetes service account it runs as.
+type ServiceAccount struct {
+	Namespace string
+	Name      string
+}
+
+func (s ServiceAccount) String() string {
+	return s.Namespace + "/" + s.Name
+}
+
+// authenticator establishes the service account of a client from its bearer token, or else from its client certificate.
+type authenticator struct {
+	// nil if tokens are not accepted
+	tokens *tokenReviewer
+	// whether the service account of a client can be read from its client certificate
+	certificates bool
+}
+
+// authenticate returns the service account of a client, given the value of its authorization header and the state
+// of its TLS connection, if any.
+func (a *authenticator) authenticate(ctx context.Context, authorization string, state *tls.ConnectionState) (ServiceAccount, error) {
+	if a.tokens != nil && authorization != "" {
+		token, ok := strings.CutPrefix(authorization, bearerPrefix)
+		if !ok {
+			return ServiceAccount{}, InvalidTokenError("not a bearer token")
+		}
+		return a.tokens.review(ctx, token)
+	}
+	if a.certificates && state != nil && len(state.VerifiedChains) > 0 {
+		return serviceAccountFromCertificate(state)
+	}
+	return ServiceAccount{}, NoCredentialsError
+}
+
+// serviceAccountFromCertificate reads the service account from the SPIFFE ID of a verified client certificate,
+// of the form spiffe://<trust-domain>/ns/<namespace>/sa/<name>
+func serviceAccountFromCertificate(state *tls.ConnectionState) (ServiceAccount, error) {
+	leaf := state.VerifiedChains[0][0]
+	for _, uri := range leaf.URIs {
+		if uri.Scheme != "spiffe" {
+			continue
+		}
+		segments := strings.Split(strings.Trim(uri.Path, "/"), "/")
+		if len(segments) != 4 || segments[0] != "ns" || segments[2] != "sa" || segments[1] == "" || segments[3] == "" {
+			return ServiceAccount{}, InvalidCertificateError("SPIFFE ID " + uri.String() + " does not name a service account")
+		}
+		return ServiceAccount{Namespace: segments[1], Name: segments[3]}, nil
+	}
+	return ServiceAccount{}, InvalidCertificateError("no SPIFFE ID")
+}
+
+// tokenReviewer verifies service account tokens with the TokenReview API.
+// Reviewed tokens are remembered for a minute, since REST xDS clients send a request every few seconds.
+type tokenReviewer struct {
+	client    authenticationv1client.TokenReviewInterface
+	audiences []string
+
+	lock     sync.Mutex
+	reviewed map[[sha256.Size]byte]reviewedToken
+}
+
+type reviewedToken struct {
+	serviceAccount ServiceAccount
+	expires        time.Time
+}
+
+func newTokenReviewer(client authenticationv1client.TokenReviewInterface, audiences []string) *tokenReviewer {
+	return &tokenReviewer{
+		client:    client,
+		audiences: audiences,
+		reviewed:  map[[sha256.Size]byte]reviewedToken{},
+	}
+}
+
+func (r *tokenReviewer) review(ctx context.Context, token string) (ServiceAccount, error) {
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+
+	r.lock.Lock()
+	reviewed, ok := r.reviewed[key]
+	r.lock.Unlock()
+	if ok && now.Before(reviewed.expires) {
+		return reviewed.serviceAccount, nil
+	}
+
+	tokenReview, err := r.client.Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: r.audiences,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return ServiceAccount{}, eris.Wrap(err, "reviewing service account token")
+	}
+	if !tokenReview.Status.Authenticated {
+		return ServiceAccount{}, InvalidTokenError(tokenReview.Status.Error)
+	}
+	serviceAccount, err := serviceAccountFromUsername(tokenReview.Status.User.Username)
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for k, v := range r.reviewed {
+		if !now.Before(v.expires) {
+			delete(r.reviewed, k)
+		}
+	}
+	r.reviewed[key] = reviewedToken{
+		serviceAccount: serviceAccount,
+		expires:        now.Add(tokenReviewTTL),
+	}
+	return serviceAccount, nil
+}
+
+// serviceAccountFromUsername parses the username of a service account, of the form system:serviceaccount:<namespace>:<name>
+func serviceAccountFromUsername(username string) (ServiceAccount, error) {
+	namespaceAndName, ok := strings.CutPrefix(username, serviceAccountUsernamePrefix)
+	if !ok {
+		return ServiceAccount{}, InvalidTokenError(username + " is not a service account")
+	}
+	namespace, name, ok := strings.Cut(namespaceAndName, ":")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, ":") {
+		return ServiceAccount{}, InvalidTokenError(username + " is not a service account")
+	}
+	return ServiceAccount{Namespace: namespace, Name: name}, nil
+}
@@ -0,0 +1,77 @@
+package xdsauth
+
+import (
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+)
+
+var (
+	InvalidProxyBindingError = func(idx int, reason string) error {
+		return eris.Errorf("invalid proxy binding %d: %v", idx, reason)
+	}
+)
+
+// policy binds the role of each proxy to the service accounts that are allowed to request its configuration.
+type policy struct {
+	bindings            map[string]map[ServiceAccount]struct{}
+	allowUnboundProxies bool
+}
+
+func newPolicy(options *v1.GlooOptions_XdsAuthOptions) (*policy, error) {
+	p := &policy{
+		bindings:            map[string]map[ServiceAccount]struct{}{},
+		allowUnboundProxies: options.GetAllowUnboundProxies(),
+	}
+	for i, binding := range options.GetProxyBindings() {
+		role, err := bindingRole(binding)
+		if err != nil {
+			return nil, InvalidProxyBindingError(i, err.Error())
+		}
+		if p.bindings[role] == nil {
+			p.bindings[role] = map[ServiceAccount]struct{}{}
+		}
+		for _, ref := range binding.GetServiceAccounts() {
+			if ref.GetNamespace() == "" || ref.GetName() == "" {
+				return nil, InvalidProxyBindingError(i, "service accounts must have a name and a namespace")
+			}
+			p.bindings[role][ServiceAccount{Namespace: ref.GetNamespace(), Name: ref.GetName()}] = struct{}{}
+		}
+	}
+	return p, nil
+}
+
+// bindingRole returns the role of the proxy that the binding applies to
+func bindingRole(binding *v1.GlooOptions_XdsAuthOptions_ProxyBinding) (string, error) {
+	switch proxy := binding.GetProxy().(type) {
+	case *v1.GlooOptions_XdsAuthOptions_ProxyBinding_Role:
+		if proxy.Role == "" {
+			return "", eris.New("role must not be empty")
+		}
+		return proxy.Role, nil
+	case *v1.GlooOptions_XdsAuthOptions_ProxyBinding_Gateway:
+		namespace, name := proxy.Gateway.GetNamespace(), proxy.Gateway.GetName()
+		if namespace == "" || name == "" {
+			return "", eris.New("gateway must have a name and a namespace")
+		}
+		// the proxy of a Gateway is named after the namespace and the name of the Gateway, see the gateway2 translator
+		return xds.OwnerNamespaceNameID(utils.GatewayApiProxyValue, namespace, namespace+"-"+name), nil
+	default:
+		return "", eris.New("either a role or a gateway is required")
+	}
+}
+
+// allowed returns whether the service account may request the configuration of the proxy with the given role.
+func (p *policy) allowed(serviceAccount ServiceAccount, role string) bool {
+	// nodes without a role are served the fallback snapshot, which tells them that they are misconfigured
+	if role == xds.FallbackNodeCacheKey {
+		return true
+	}
+	serviceAccounts, ok := p.bindings[role]
+	if !ok {
+		return p.allowUnboundProxies
+	}
+	_, ok = serviceAccounts[serviceAccount]
+	return ok
+}
@@ -0,0 +1,96 @@
+package xdsauth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var (
+	MissingMtlsFileError = eris.New("mtls requires a certFile, a keyFile and a caFile")
+
+	NoCaCertificatesError = func(caFile string) error {
+		return eris.Errorf("no CA certificates found in %v", caFile)
+	}
+)
+
+// tlsFiles loads the TLS configuration of the servers from files, and loads it again whenever one of the files changes,
+// so that certificates which are mounted from a Secret are rotated without restarting the servers.
+type tlsFiles struct {
+	certFile, keyFile, caFile string
+
+	lock    sync.Mutex
+	modTime [3]time.Time
+	config  *tls.Config
+}
+
+func newTlsFiles(mtls *v1.GlooOptions_XdsAuthOptions_Mtls) (*tlsFiles, error) {
+	if mtls.GetCertFile() == "" || mtls.GetKeyFile() == "" || mtls.GetCaFile() == "" {
+		return nil, MissingMtlsFileError
+	}
+	files := &tlsFiles{
+		certFile: mtls.GetCertFile(),
+		keyFile:  mtls.GetKeyFile(),
+		caFile:   mtls.GetCaFile(),
+	}
+	// fail fast on invalid files
+	if _, err := files.load(); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// serverConfig returns a TLS configuration which requires clients to present a certificate signed by the CA
+func (f *tlsFiles) serverConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return f.load()
+		},
+	}
+}
+
+func (f *tlsFiles) load() (*tls.Config, error) {
+	var modTime [3]time.Time
+	for i, file := range []string{f.certFile, f.keyFile, f.caFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, eris.Wrap(err, "reading xds mtls files")
+		}
+		modTime[i] = info.ModTime()
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.config != nil && modTime == f.modTime {
+		return f.config, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+	if err != nil {
+		return nil, eris.Wrap(err, "loading xds server certificate")
+	}
+	caPem, err := os.ReadFile(f.caFile)
+	if err != nil {
+		return nil, eris.Wrap(err, "reading xds client CA")
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPem) {
+		return nil, NoCaCertificatesError(f.caFile)
+	}
+
+	f.config = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	f.modTime = modTime
+	return f.config, nil
+}
@@ -0,0 +1,13 @@
+package xdsauth_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestXdsAuth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Xds Auth Suite")
+}
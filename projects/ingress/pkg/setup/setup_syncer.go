@@ -200,7 +200,15 @@ func RunIngress(opts Opts) error {
 		baseKubeServiceClient := service.NewResourceClient(kube, &v1.KubeService{})
 		kubeServiceClient := v1.NewKubeServiceClientWithBase(baseKubeServiceClient)
 
-		translatorEmitter := v1.NewTranslatorEmitter(upstreamClient, kubeServiceClient, ingressClient)
+		// IngressClasses are not part of the snapshot, a sync is forced when they change
+		ingressClasses, err := translator.NewIngressClassCache(opts.WatchOpts.Ctx, kube)
+		if err != nil {
+			return errors.Wrapf(err, "watching ingress classes")
+		}
+		forceEmit := make(chan struct{})
+		go emitOnUpdates(opts.WatchOpts.Ctx, ingressClasses.Updates(), forceEmit)
+
+		translatorEmitter := v1.NewTranslatorEmitterWithEmit(upstreamClient, kubeServiceClient, ingressClient, forceEmit)
 		statusClient := statusutils.GetStatusClientForNamespace(opts.StatusReporterNamespace)
 		translatorSync := translator.NewSyncer(
 			opts.WriteNamespace,
@@ -209,7 +217,9 @@ func RunIngress(opts Opts) error {
 			writeErrs,
 			opts.RequireIngressClass,
 			opts.CustomIngressClass,
-			statusClient)
+			statusClient,
+			kube,
+			ingressClasses)
 		translatorEventLoop := v1.NewTranslatorEventLoop(translatorEmitter, translatorSync)
 		translatorEventLoopErrs, err := translatorEventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
 		if err != nil {
@@ -336,3 +346,19 @@ func pre080knativeVersion(version string) bool {
 	}
 	return true
 }
+
+// emitOnUpdates forces the emitter to send its snapshot again whenever an update is received, until the context ends
+func emitOnUpdates(ctx context.Context, updates <-chan struct{}, forceEmit chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-updates:
+			select {
+			case forceEmit <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package translator
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	buffer "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/buffer/v3"
+	matcherv3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// GlooAnnotationPrefix is the prefix of the annotations which configure the routes of an Ingress
+	GlooAnnotationPrefix = "ingress.gloo.solo.io/"
+	// NginxAnnotationPrefix is the prefix of the equivalent nginx-ingress annotations, which are supported to ease
+	// migrations. The annotations with the Gloo prefix take precedence over them.
+	NginxAnnotationPrefix = "nginx.ingress.kubernetes.io/"
+)
+
+const (
+	rewriteTargetAnnotation        = "rewrite-target"
+	useRegexAnnotation             = "use-regex"
+	sslRedirectAnnotation          = "ssl-redirect"
+	proxyReadTimeoutAnnotation     = "proxy-read-timeout"
+	enableCorsAnnotation           = "enable-cors"
+	corsAllowOriginAnnotation      = "cors-allow-origin"
+	corsAllowMethodsAnnotation     = "cors-allow-methods"
+	corsAllowHeadersAnnotation     = "cors-allow-headers"
+	corsExposeHeadersAnnotation    = "cors-expose-headers"
+	corsAllowCredentialsAnnotation = "cors-allow-credentials"
+	corsMaxAgeAnnotation           = "cors-max-age"
+	proxyBodySizeAnnotation        = "proxy-body-size"
+	backendProtocolAnnotation      = "backend-protocol"
+	canaryAnnotation               = "canary"
+	canaryWeightAnnotation         = "canary-weight"
+	canaryWeightTotalAnnotation    = "canary-weight-total"
+)
+
+var supportedAnnotations = map[string]bool{
+	rewriteTargetAnnotation:        true,
+	useRegexAnnotation:             true,
+	sslRedirectAnnotation:          true,
+	proxyReadTimeoutAnnotation:     true,
+	enableCorsAnnotation:           true,
+	corsAllowOriginAnnotation:      true,
+	corsAllowMethodsAnnotation:     true,
+	corsAllowHeadersAnnotation:     true,
+	corsExposeHeadersAnnotation:    true,
+	corsAllowCredentialsAnnotation: true,
+	corsMaxAgeAnnotation:           true,
+	proxyBodySizeAnnotation:        true,
+	backendProtocolAnnotation:      true,
+	canaryAnnotation:               true,
+	canaryWeightAnnotation:         true,
+	canaryWeightTotalAnnotation:    true,
+}
+
+// the defaults of nginx-ingress when CORS is enabled
+const (
+	defaultCorsAllowOrigin  = "*"
+	defaultCorsAllowMethods = "GET, PUT, POST, DELETE, PATCH, OPTIONS"
+	defaultCorsAllowHeaders = "DNT,Keep-Alive,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization"
+	defaultCorsMaxAge       = "1728000"
+)
+
+const defaultCanaryWeightTotal = 100
+
+type backendProtocol string
+
+const (
+	backendProtocolHttp  backendProtocol = "HTTP"
+	backendProtocolHttps backendProtocol = "HTTPS"
+	backendProtocolGrpc  backendProtocol = "GRPC"
+	backendProtocolGrpcs backendProtocol = "GRPCS"
+)
+
+func (p backendProtocol) http2() bool {
+	return p == backendProtocolGrpc || p == backendProtocolGrpcs
+}
+
+func (p backendProtocol) tls() bool {
+	return p == backendProtocolHttps || p == backendProtocolGrpcs
+}
+
+// ingressOptions are the options of the routes of an Ingress, read from its annotations
+type ingressOptions struct {
+	rewriteTarget   string
+	sslRedirect     bool
+	timeout         *durationpb.Duration
+	cors            *cors.CorsPolicy
+	bufferPerRoute  *buffer.BufferPerRoute
+	backendProtocol backendProtocol
+
+	canary            bool
+	canaryWeight      uint32
+	canaryWeightTotal uint32
+}
+
+// optionsForIngress reads the options of an Ingress from its annotations, and from the defaults of its IngressClass
+// for the annotations it does not set. The Ingress is translated with the valid options when some are invalid.
+func optionsForIngress(annotations, classDefaults map[string]string) (*ingressOptions, []error) {
+	values := map[string]string{}
+	for name, value := range classDefaults {
+		values[name] = value
+	}
+	var errs []error
+	for _, prefix := range []string{NginxAnnotationPrefix, GlooAnnotationPrefix} {
+		for key, value := range annotations {
+			name, ok := strings.CutPrefix(key, prefix)
+			if !ok {
+				continue
+			}
+			if !supportedAnnotations[name] {
+				errs = append(errs, errors.Errorf("annotation %v is not supported", key))
+				continue
+			}
+			values[name] = value
+		}
+	}
+
+	opts, parseErrs := parseIngressOptions(values)
+	errs = append(errs, parseErrs...)
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Error() < errs[j].Error()
+	})
+	return opts, errs
+}
+
+func parseIngressOptions(values map[string]string) (*ingressOptions, []error) {
+	opts := &ingressOptions{
+		backendProtocol:   backendProtocolHttp,
+		canaryWeightTotal: defaultCanaryWeightTotal,
+	}
+	var errs []error
+	invalid := func(name, value, format string, args ...any) {
+		errs = append(errs, errors.Errorf("invalid value %q for annotation %v: "+format, append([]any{value, name}, args...)...))
+	}
+	parseBool := func(name string) bool {
+		value, ok := values[name]
+		if !ok {
+			return false
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			invalid(name, value, "must be true or false")
+		}
+		return b
+	}
+	parseUint := func(name string) (uint32, bool) {
+		value, ok := values[name]
+		if !ok {
+			return 0, false
+		}
+		u, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			invalid(name, value, "must be a non-negative integer")
+			return 0, false
+		}
+		return uint32(u), true
+	}
+
+	opts.rewriteTarget = values[rewriteTargetAnnotation]
+	// paths are always matched as regular expressions, so use-regex has no effect
+	parseBool(useRegexAnnotation)
+	// as with nginx, the http requests of the hosts with tls are redirected to https unless it is disabled
+	opts.sslRedirect = true
+	if value, ok := values[sslRedirectAnnotation]; ok {
+		if b, err := strconv.ParseBool(value); err == nil {
+			opts.sslRedirect = b
+		} else {
+			invalid(sslRedirectAnnotation, value, "must be true or false")
+		}
+	}
+
+	if seconds, ok := parseUint(proxyReadTimeoutAnnotation); ok {
+		// Envoy has no timeout between two reads of the upstream response, so it bounds the whole response instead
+		opts.timeout = durationpb.New(time.Duration(seconds) * time.Second)
+	}
+
+	if parseBool(enableCorsAnnotation) {
+		opts.cors = corsPolicy(values, parseBool)
+	}
+
+	if value, ok := values[proxyBodySizeAnnotation]; ok {
+		size, err := parseBodySize(value)
+		switch {
+		case err != nil:
+			invalid(proxyBodySizeAnnotation, value, "%v", err)
+		case size == 0:
+			// as with nginx, a size of 0 disables the limit
+			opts.bufferPerRoute = &buffer.BufferPerRoute{
+				Override: &buffer.BufferPerRoute_Disabled{Disabled: true},
+			}
+		default:
+			opts.bufferPerRoute = &buffer.BufferPerRoute{
+				Override: &buffer.BufferPerRoute_Buffer{
+					Buffer: &buffer.Buffer{MaxRequestBytes: wrapperspb.UInt32(size)},
+				},
+			}
+		}
+	}
+
+	if value, ok := values[backendProtocolAnnotation]; ok {
+		switch protocol := backendProtocol(strings.ToUpper(value)); protocol {
+		case backendProtocolHttp, backendProtocolHttps, backendProtocolGrpc, backendProtocolGrpcs:
+			opts.backendProtocol = protocol
+		default:
+			invalid(backendProtocolAnnotation, value, "must be one of HTTP, HTTPS, GRPC or GRPCS")
+		}
+	}
+
+	opts.canary = parseBool(canaryAnnotation)
+	if total, ok := parseUint(canaryWeightTotalAnnotation); ok {
+		if total == 0 {
+			invalid(canaryWeightTotalAnnotation, values[canaryWeightTotalAnnotation], "must be greater than 0")
+		} else {
+			opts.canaryWeightTotal = total
+		}
+	}
+	if weight, ok := parseUint(canaryWeightAnnotation); ok {
+		if weight > opts.canaryWeightTotal {
+			invalid(canaryWeightAnnotation, values[canaryWeightAnnotation], "must not be greater than %v", opts.canaryWeightTotal)
+		} else {
+			opts.canaryWeight = weight
+		}
+	}
+
+	return opts, errs
+}
+
+func corsPolicy(values map[string]string, parseBool func(name string) bool) *cors.CorsPolicy {
+	valueOrDefault := func(name, defaultValue string) string {
+		if value, ok := values[name]; ok {
+			return value
+		}
+		return defaultValue
+	}
+	policy := &cors.CorsPolicy{
+		AllowOrigin:   splitList(valueOrDefault(corsAllowOriginAnnotation, defaultCorsAllowOrigin)),
+		AllowMethods:  splitList(valueOrDefault(corsAllowMethodsAnnotation, defaultCorsAllowMethods)),
+		AllowHeaders:  splitList(valueOrDefault(corsAllowHeadersAnnotation, defaultCorsAllowHeaders)),
+		ExposeHeaders: splitList(values[corsExposeHeadersAnnotation]),
+		MaxAge:        valueOrDefault(corsMaxAgeAnnotation, defaultCorsMaxAge),
+		// credentials are allowed unless disabled, as with nginx
+		AllowCredentials: true,
+	}
+	if _, ok := values[corsAllowCredentialsAnnotation]; ok {
+		policy.AllowCredentials = parseBool(corsAllowCredentialsAnnotation)
+	}
+	return policy
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// parseBodySize parses a size in the nginx format, i.e. a number of bytes with an optional k, m or g unit suffix
+func parseBodySize(value string) (uint32, error) {
+	multiplier := uint64(1)
+	number := value
+	if n := len(value); n > 0 {
+		switch value[n-1] {
+		case 'k', 'K':
+			multiplier = 1 << 10
+		case 'm', 'M':
+			multiplier = 1 << 20
+		case 'g', 'G':
+			multiplier = 1 << 30
+		}
+		if multiplier > 1 {
+			number = value[:n-1]
+		}
+	}
+	size, err := strconv.ParseUint(number, 10, 32)
+	if err != nil {
+		return 0, errors.New("must be a number of bytes with an optional k, m or g unit")
+	}
+	if size*multiplier > uint64(^uint32(0)) {
+		return 0, errors.New("must not exceed 4g")
+	}
+	return uint32(size * multiplier), nil
+}
+
+// routeOptions returns the options of a route of the Ingress matching the given path regex
+func (o *ingressOptions) routeOptions(pathRegex string) *gloov1.RouteOptions {
+	options := &gloov1.RouteOptions{
+		Timeout:        o.timeout,
+		Cors:           o.cors,
+		BufferPerRoute: o.bufferPerRoute,
+	}
+	if o.rewriteTarget != "" {
+		options.RegexRewrite = &matcherv3.RegexMatchAndSubstitute{
+			// the non-capturing group keeps the numbering of the capture groups of the path
+			Pattern: &matcherv3.RegexMatcher{
+				Regex: "^(?:" + pathRegex + ")",
+			},
+			Substitution: rewriteSubstitution(o.rewriteTarget),
+		}
+	}
+	if options.GetTimeout() == nil && options.GetCors() == nil && options.GetBufferPerRoute() == nil &&
+		options.GetRegexRewrite() == nil {
+		return nil
+	}
+	return options
+}
+
+// rewriteSubstitution converts the $n references to capture groups of the nginx rewrite target
+// into the \n references of Envoy
+func rewriteSubstitution(target string) string {
+	var sb strings.Builder
+	for i := 0; i < len(target); i++ {
+		if target[i] == '$' && i+1 < len(target) && target[i+1] >= '0' && target[i+1] <= '9' {
+			sb.WriteByte('\\')
+			continue
+		}
+		sb.WriteByte(target[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,152 @@
+package translator
+
+import (
+	"sort"
+
+	errors "github.com/rotisserie/eris"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// IngressControllerName is the controller of the IngressClasses handled by the Gloo ingress controller
+const IngressControllerName = "solo.io/gloo-ingress"
+
+// IsDefaultIngressClassKey is the annotation which marks the IngressClass of the Ingresses which do not set one
+const IsDefaultIngressClassKey = networkingv1.AnnotationIsDefaultIngressClass
+
+// ingressClassSelector selects the Ingresses handled by the controller, and provides the annotation defaults of
+// their IngressClass
+type ingressClassSelector struct {
+	// when false, all the Ingresses are handled
+	requireIngressClass bool
+	// the value of the legacy ingress class annotation of the Ingresses handled by the controller
+	ingressClass string
+	// the names of all the IngressClasses, including the ones of other controllers
+	allClasses map[string]bool
+	// the IngressClasses of the controller, by name
+	classes map[string]*ingressClassConfig
+	// the name of the default IngressClass of the controller, if any
+	defaultClass string
+}
+
+// ingressClassConfig holds the annotation defaults read from the parameters of an IngressClass
+type ingressClassConfig struct {
+	defaults map[string]string
+	err      error
+}
+
+func newIngressClassSelector(requireIngressClass bool, ingressClass string) *ingressClassSelector {
+	if ingressClass == "" {
+		ingressClass = defaultIngressClass
+	}
+	return &ingressClassSelector{
+		requireIngressClass: requireIngressClass,
+		ingressClass:        ingressClass,
+		allClasses:          map[string]bool{},
+		classes:             map[string]*ingressClassConfig{},
+	}
+}
+
+// addIngressClass adds an IngressClass of the cluster to the selector.
+// parameters returns the annotation defaults referenced by the parameters of the IngressClass.
+func (s *ingressClassSelector) addIngressClass(class *networkingv1.IngressClass, parameters func(*networkingv1.IngressClassParametersReference) (map[string]string, error)) {
+	s.allClasses[class.Name] = true
+	if class.Spec.Controller != IngressControllerName {
+		return
+	}
+	config := &ingressClassConfig{}
+	if class.Spec.Parameters != nil {
+		config.defaults, config.err = parameters(class.Spec.Parameters)
+		if config.err != nil {
+			config.err = errors.Wrapf(config.err, "reading the parameters of IngressClass %v", class.Name)
+		}
+	}
+	s.classes[class.Name] = config
+	// as with the other controllers, the oldest default IngressClass wins when several are marked as default
+	if class.Annotations[IsDefaultIngressClassKey] == "true" && s.defaultClass == "" {
+		s.defaultClass = class.Name
+	}
+}
+
+// selectIngress returns whether the Ingress is handled by the controller, and the config of its IngressClass, if any.
+//
+// The legacy ingress class annotation takes precedence over spec.ingressClassName, as it does in the other
+// controllers. An ingressClassName which does not name an existing IngressClass is handled when it is the ingress
+// class of the controller, so that the IngressClass does not have to be created. Ingresses without class are
+// handled when the controller owns the default IngressClass.
+func (s *ingressClassSelector) selectIngress(ing *networkingv1.Ingress) (bool, *ingressClassConfig) {
+	className, hasAnnotation := ing.Annotations[IngressClassKey]
+	switch {
+	case hasAnnotation:
+	case ing.Spec.IngressClassName != nil:
+		className = *ing.Spec.IngressClassName
+	default:
+		className = s.defaultClass
+	}
+	config := s.classes[className]
+
+	if !s.requireIngressClass {
+		return true, config
+	}
+	switch {
+	case hasAnnotation:
+		return className == s.ingressClass, config
+	case config != nil:
+		return true, config
+	default:
+		return !s.allClasses[className] && className == s.ingressClass, nil
+	}
+}
+
+// ingressClassSelectorForCluster reads the IngressClasses of the cluster, and the ConfigMaps referenced by their
+// parameters, from the cache. Each key of such ConfigMap is an annotation name without prefix, and its value is the
+// default value of the annotation for the Ingresses of the class.
+func ingressClassSelectorForCluster(classCache *IngressClassCache, requireIngressClass bool, ingressClass string) (*ingressClassSelector, error) {
+	selector := newIngressClassSelector(requireIngressClass, ingressClass)
+	if classCache == nil {
+		return selector, nil
+	}
+	classList, err := classCache.ingressClasses.List(labels.Everything())
+	if err != nil {
+		return selector, errors.Wrapf(err, "listing ingress classes")
+	}
+	classes := make([]*networkingv1.IngressClass, len(classList))
+	copy(classes, classList)
+	sort.SliceStable(classes, func(i, j int) bool {
+		if !classes[i].CreationTimestamp.Equal(&classes[j].CreationTimestamp) {
+			return classes[i].CreationTimestamp.Before(&classes[j].CreationTimestamp)
+		}
+		return classes[i].Name < classes[j].Name
+	})
+	for _, class := range classes {
+		selector.addIngressClass(class, func(params *networkingv1.IngressClassParametersReference) (map[string]string, error) {
+			return configMapParameters(classCache.configMaps, params)
+		})
+	}
+	return selector, nil
+}
+
+func configMapParameters(configMaps corelisters.ConfigMapLister, params *networkingv1.IngressClassParametersReference) (map[string]string, error) {
+	if params.APIGroup != nil && *params.APIGroup != "" || params.Kind != "ConfigMap" {
+		return nil, errors.Errorf("unsupported parameters kind %v, only ConfigMaps are supported", params.Kind)
+	}
+	if params.Namespace == nil || *params.Namespace == "" {
+		return nil, errors.Errorf("the namespace of ConfigMap %v must be set", params.Name)
+	}
+	cm, err := configMaps.ConfigMaps(*params.Namespace).Get(params.Name)
+	if err != nil {
+		return nil, err
+	}
+	return configMapDefaults(cm)
+}
+
+func configMapDefaults(cm *corev1.ConfigMap) (map[string]string, error) {
+	for name := range cm.Data {
+		if !supportedAnnotations[name] {
+			return nil, errors.Errorf("annotation %v of ConfigMap %v.%v is not supported", name, cm.Name, cm.Namespace)
+		}
+	}
+	return cm.Data, nil
+}
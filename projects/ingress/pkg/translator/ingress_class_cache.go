@@ -0,0 +1,108 @@
+package translator
+
+import (
+	"context"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	networkinglisters "k8s.io/client-go/listers/networking/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IngressClassCache watches the IngressClasses of the cluster and the ConfigMaps referenced by their parameters,
+// so that the Ingresses are translated again when they change. IngressClasses and ConfigMaps are not part of the
+// translator snapshot, as they are cluster scoped or not owned by the ingress controller.
+type IngressClassCache struct {
+	ingressClasses networkinglisters.IngressClassLister
+	configMaps     corelisters.ConfigMapLister
+
+	updates chan struct{}
+}
+
+// NewIngressClassCache starts the watches, which live as long as the context, and waits for their initial list.
+func NewIngressClassCache(ctx context.Context, kube kubernetes.Interface) (*IngressClassCache, error) {
+	resyncDuration := 12 * time.Hour
+	factory := informers.NewSharedInformerFactory(kube, resyncDuration)
+	ingressClassInformer := factory.Networking().V1().IngressClasses()
+	configMapInformer := factory.Core().V1().ConfigMaps()
+
+	c := &IngressClassCache{
+		ingressClasses: ingressClassInformer.Lister(),
+		configMaps:     configMapInformer.Lister(),
+		updates:        make(chan struct{}, 1),
+	}
+
+	if _, err := ingressClassInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { c.updated() },
+		UpdateFunc: func(interface{}, interface{}) { c.updated() },
+		DeleteFunc: func(interface{}) { c.updated() },
+	}); err != nil {
+		return nil, err
+	}
+	// ConfigMaps change often, only the ones which hold the parameters of an IngressClass trigger a translation
+	if _, err := configMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: c.isParameters,
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(interface{}) { c.updated() },
+			UpdateFunc: func(interface{}, interface{}) { c.updated() },
+			DeleteFunc: func(interface{}) { c.updated() },
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	factory.Start(ctx.Done())
+	for informerType, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, errors.Errorf("failed to sync the cache of %v", informerType)
+		}
+	}
+	return c, nil
+}
+
+// Updates receives a signal when an IngressClass or the ConfigMap of its parameters changes.
+// Signals are coalesced while they are not received.
+func (c *IngressClassCache) Updates() <-chan struct{} {
+	return c.updates
+}
+
+func (c *IngressClassCache) updated() {
+	select {
+	case c.updates <- struct{}{}:
+	default:
+		// an update is already pending
+	}
+}
+
+// isParameters returns whether the object is a ConfigMap referenced by the parameters of an IngressClass
+func (c *IngressClassCache) isParameters(obj interface{}) bool {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return false
+	}
+	classes, err := c.ingressClasses.List(labels.Everything())
+	if err != nil {
+		return false
+	}
+	for _, class := range classes {
+		if isConfigMapParameters(class, cm.Namespace, cm.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func isConfigMapParameters(class *networkingv1.IngressClass, namespace, name string) bool {
+	params := class.Spec.Parameters
+	return params != nil && params.Kind == "ConfigMap" && (params.APIGroup == nil || *params.APIGroup == "") &&
+		params.Namespace != nil && *params.Namespace == namespace && params.Name == name
+}
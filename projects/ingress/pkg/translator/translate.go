@@ -4,8 +4,10 @@ import (
 	"context"
 	"sort"
 
+	buffer "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/buffer/v3"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	"github.com/solo-io/go-utils/contextutils"
 	corev1 "k8s.io/api/core/v1"
@@ -17,19 +19,27 @@ import (
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const defaultIngressClass = "gloo"
 
 const IngressClassKey = "kubernetes.io/ingress.class"
 
-func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, requireIngressClass bool, ingressClass string) *gloov1.Proxy {
+// ingressErrors holds the errors found while translating each Ingress
+type ingressErrors map[types.NamespacedName][]error
 
-	if ingressClass == "" {
-		ingressClass = defaultIngressClass
+func (e ingressErrors) add(ing *networkingv1.Ingress, errs ...error) {
+	if len(errs) == 0 {
+		return
 	}
+	key := types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}
+	e[key] = append(e[key], errs...)
+}
 
+func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, classSelector *ingressClassSelector) (*gloov1.Proxy, ingressErrors) {
 	var ingresses []*networkingv1.Ingress
 	for _, ig := range snap.Ingresses {
 		kubeIngress, err := ingress.ToKube(ig)
@@ -52,7 +62,7 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 
 	upstreams := snap.Upstreams
 
-	virtualHostsHttp, secureVirtualHosts := virtualHosts(ctx, ingresses, upstreams, services, requireIngressClass, ingressClass)
+	virtualHostsHttp, secureVirtualHosts, errs := virtualHosts(ctx, ingresses, upstreams, services, classSelector)
 
 	var virtualHostsHttps []*gloov1.VirtualHost
 	var sslConfigs []*ssl.SslConfig
@@ -75,6 +85,7 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 			ListenerType: &gloov1.Listener_HttpListener{
 				HttpListener: &gloov1.HttpListener{
 					VirtualHosts: virtualHostsHttp,
+					Options:      bodySizeListenerOptions(virtualHostsHttp),
 				},
 			},
 		})
@@ -87,6 +98,7 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 			ListenerType: &gloov1.Listener_HttpListener{
 				HttpListener: &gloov1.HttpListener{
 					VirtualHosts: virtualHostsHttps,
+					Options:      bodySizeListenerOptions(virtualHostsHttps),
 				},
 			},
 			SslConfigurations: sslConfigs,
@@ -98,10 +110,41 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 			Namespace: namespace,
 		},
 		Listeners: listeners,
+	}, errs
+}
+
+// bodySizeListenerOptions enables the buffer filter on the listener when the body size of some of its routes
+// is limited, and disables it on its other routes
+func bodySizeListenerOptions(virtualHosts []*gloov1.VirtualHost) *gloov1.HttpListenerOptions {
+	var maxRequestBytes uint32
+	for _, vh := range virtualHosts {
+		for _, route := range vh.GetRoutes() {
+			maxRequestBytes = max(maxRequestBytes, route.GetOptions().GetBufferPerRoute().GetBuffer().GetMaxRequestBytes().GetValue())
+		}
+	}
+	if maxRequestBytes == 0 {
+		return nil
+	}
+	for _, vh := range virtualHosts {
+		for _, route := range vh.GetRoutes() {
+			if route.GetOptions().GetBufferPerRoute() != nil {
+				continue
+			}
+			if route.GetOptions() == nil {
+				route.Options = &gloov1.RouteOptions{}
+			}
+			route.GetOptions().BufferPerRoute = &buffer.BufferPerRoute{
+				Override: &buffer.BufferPerRoute_Disabled{Disabled: true},
+			}
+		}
+	}
+	return &gloov1.HttpListenerOptions{
+		Buffer: &buffer.Buffer{MaxRequestBytes: wrapperspb.UInt32(maxRequestBytes)},
 	}
 }
 
-func upstreamForBackend(upstreams gloov1.UpstreamList, services []*corev1.Service, ingressNamespace string, backend networkingv1.IngressBackend) (*gloov1.Upstream, error) {
+// upstreamForBackend returns the upstream of the service of the backend which is configured for the given protocol
+func upstreamForBackend(upstreams gloov1.UpstreamList, services []*corev1.Service, ingressNamespace string, backend networkingv1.IngressBackend, protocol backendProtocol) (*gloov1.Upstream, error) {
 	serviceName, servicePort, err := getServiceNameAndPort(services, ingressNamespace, backend.Service)
 	if err != nil {
 		return nil, err
@@ -110,12 +153,17 @@ func upstreamForBackend(upstreams gloov1.UpstreamList, services []*corev1.Servic
 	// find the upstream with the smallest matching selector
 	// longer selectors represent subsets of pods for a service
 	var matchingUpstream *gloov1.Upstream
+	foundForService := false
 	for _, us := range upstreams {
 		switch spec := us.GetUpstreamType().(type) {
 		case *gloov1.Upstream_Kube:
 			if spec.Kube.GetServiceNamespace() == ingressNamespace &&
 				spec.Kube.GetServiceName() == serviceName &&
 				spec.Kube.GetServicePort() == uint32(servicePort) {
+				foundForService = true
+				if protocol.http2() && !us.GetUseHttp2().GetValue() || protocol.tls() && us.GetSslConfig() == nil {
+					continue
+				}
 				if matchingUpstream != nil {
 					originalSelectorLength := len(matchingUpstream.GetUpstreamType().(*gloov1.Upstream_Kube).Kube.GetSelector())
 					newSelectorLength := len(spec.Kube.GetSelector())
@@ -127,6 +175,11 @@ func upstreamForBackend(upstreams gloov1.UpstreamList, services []*corev1.Servic
 			}
 		}
 	}
+	if matchingUpstream == nil && foundForService {
+		return nil, errors.Errorf("no upstream for kube service %v with port %v uses backend protocol %v: "+
+			"annotate the service with %v or %v, or create an upstream for it with useHttp2 or sslConfig set",
+			serviceName, servicePort, protocol, serviceconverter.GlooH2Annotation, serviceconverter.GlooSslSecretAnnotation)
+	}
 	if matchingUpstream == nil {
 		return nil, errors.Errorf("discovery failure: upstream not found for kube service %v with port %v", serviceName, servicePort)
 	}
@@ -166,15 +219,57 @@ type secureVirtualHost struct {
 	secret core.ResourceRef
 }
 
-func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstreams gloov1.UpstreamList, services []*corev1.Service, requireIngressClass bool, ingressClass string) ([]*gloov1.VirtualHost, []secureVirtualHost) {
+// routeKey identifies the routes of the Ingresses by host and path
+type routeKey struct {
+	host string
+	path string
+}
+
+// canaryBackend is the backend of a canary Ingress, which receives a share of the traffic of the route of another
+// Ingress with the same host and path
+type canaryBackend struct {
+	ingress  *networkingv1.Ingress
+	upstream *core.ResourceRef
+	weight   uint32
+	total    uint32
+	used     bool
+}
+
+func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstreams gloov1.UpstreamList, services []*corev1.Service, classSelector *ingressClassSelector) ([]*gloov1.VirtualHost, []secureVirtualHost, ingressErrors) {
 	routesByHostHttp := make(map[string][]*gloov1.Route)
 	routesByHostHttps := make(map[string][]*gloov1.Route)
 	secretsByHost := make(map[string]*core.ResourceRef)
-	var defaultBackend *networkingv1.IngressBackend
+	errs := ingressErrors{}
+
+	// read the options of the selected ingresses, and the backends of the canary ones
+	var selectedIngresses []*networkingv1.Ingress
+	optionsByIngress := make(map[*networkingv1.Ingress]*ingressOptions)
+	canaries := make(map[routeKey]*canaryBackend)
 	for _, ing := range ingresses {
-		if requireIngressClass && !isOurIngress(ing, ingressClass) {
+		selected, classConfig := classSelector.selectIngress(ing)
+		if !selected {
 			continue
 		}
+		var classDefaults map[string]string
+		if classConfig != nil {
+			classDefaults = classConfig.defaults
+			if classConfig.err != nil {
+				errs.add(ing, classConfig.err)
+			}
+		}
+		opts, optionErrs := optionsForIngress(ing.Annotations, classDefaults)
+		errs.add(ing, optionErrs...)
+		if opts.canary {
+			addCanaryBackends(canaries, ing, opts, upstreams, services, errs)
+			continue
+		}
+		selectedIngresses = append(selectedIngresses, ing)
+		optionsByIngress[ing] = opts
+	}
+
+	var defaultBackend *networkingv1.IngressBackend
+	for _, ing := range selectedIngresses {
+		opts := optionsByIngress[ing]
 		spec := ing.Spec
 		if spec.DefaultBackend != nil {
 			if defaultBackend != nil {
@@ -211,9 +306,9 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 				continue
 			}
 			for _, route := range rule.HTTP.Paths {
-				upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, route.Backend)
+				upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, route.Backend, opts.backendProtocol)
 				if err != nil {
-					contextutils.LoggerFrom(ctx).Errorf("lookup upstream for ingress %v: %v", ing.Name, err)
+					errs.add(ing, errors.Wrapf(err, "lookup upstream for path %v of host %v", route.Path, host))
 					continue
 				}
 
@@ -221,26 +316,30 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 				if pathRegex == "" {
 					pathRegex = ".*"
 				}
+				routeMatchers := []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Regex{
+						Regex: pathRegex,
+					},
+				}}
 				route := &gloov1.Route{
-					Matchers: []*matchers.Matcher{{
-						PathSpecifier: &matchers.Matcher_Regex{
-							Regex: pathRegex,
-						},
-					}},
+					Matchers: routeMatchers,
 					Action: &gloov1.Route_RouteAction{
-						RouteAction: &gloov1.RouteAction{
-							Destination: &gloov1.RouteAction_Single{
-								Single: &gloov1.Destination{
-									DestinationType: &gloov1.Destination_Upstream{
-										Upstream: upstream.GetMetadata().Ref(),
-									},
-								},
-							},
-						},
+						RouteAction: routeAction(upstream.GetMetadata().Ref(), canaries[routeKey{host: host, path: pathRegex}]),
 					},
+					Options: opts.routeOptions(pathRegex),
 				}
 				if _, useTls := secretsByHost[host]; useTls {
 					routesByHostHttps[host] = append(routesByHostHttps[host], route)
+					if opts.sslRedirect {
+						routesByHostHttp[host] = append(routesByHostHttp[host], &gloov1.Route{
+							Matchers: routeMatchers,
+							Action: &gloov1.Route_RedirectAction{
+								RedirectAction: &gloov1.RedirectAction{
+									HttpsRedirect: true,
+								},
+							},
+						})
+					}
 				} else {
 					routesByHostHttp[host] = append(routesByHostHttp[host], route)
 				}
@@ -248,6 +347,12 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 		}
 	}
 
+	for key, canary := range canaries {
+		if !canary.used {
+			errs.add(canary.ingress, errors.Errorf("no ingress without the canary annotation routes path %v of host %v", key.path, key.host))
+		}
+	}
+
 	var virtualHostsHttp []*gloov1.VirtualHost
 	var virtualHostsHttps []secureVirtualHost
 
@@ -283,9 +388,76 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 	sort.SliceStable(virtualHostsHttps, func(i, j int) bool {
 		return virtualHostsHttps[i].vh.GetName() < virtualHostsHttps[j].vh.GetName()
 	})
-	return virtualHostsHttp, virtualHostsHttps
+	return virtualHostsHttp, virtualHostsHttps, errs
+}
+
+// addCanaryBackends adds the backends of the paths of a canary Ingress
+func addCanaryBackends(canaries map[routeKey]*canaryBackend, ing *networkingv1.Ingress, opts *ingressOptions, upstreams gloov1.UpstreamList, services []*corev1.Service, errs ingressErrors) {
+	for _, rule := range ing.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, path.Backend, opts.backendProtocol)
+			if err != nil {
+				errs.add(ing, errors.Wrapf(err, "lookup upstream for path %v of host %v", path.Path, host))
+				continue
+			}
+			pathRegex := path.Path
+			if pathRegex == "" {
+				pathRegex = ".*"
+			}
+			key := routeKey{host: host, path: pathRegex}
+			if existing, ok := canaries[key]; ok {
+				errs.add(ing, errors.Errorf("path %v of host %v is already routed by canary ingress %v.%v, ignoring",
+					pathRegex, host, existing.ingress.Name, existing.ingress.Namespace))
+				continue
+			}
+			canaries[key] = &canaryBackend{
+				ingress:  ing,
+				upstream: upstream.GetMetadata().Ref(),
+				weight:   opts.canaryWeight,
+				total:    opts.canaryWeightTotal,
+			}
+		}
+	}
 }
 
-func isOurIngress(ingress *networkingv1.Ingress, ingressClassToUse string) bool {
-	return ingress.Annotations[IngressClassKey] == ingressClassToUse
+// routeAction returns the action of a route to the upstream, whose traffic is split with the canary backend
+// of the route, if any
+func routeAction(upstream *core.ResourceRef, canary *canaryBackend) *gloov1.RouteAction {
+	if canary == nil {
+		return &gloov1.RouteAction{
+			Destination: &gloov1.RouteAction_Single{
+				Single: &gloov1.Destination{
+					DestinationType: &gloov1.Destination_Upstream{
+						Upstream: upstream,
+					},
+				},
+			},
+		}
+	}
+	canary.used = true
+	return &gloov1.RouteAction{Destination: &gloov1.RouteAction_Multi{
+		Multi: &gloov1.MultiDestination{
+			Destinations: []*gloov1.WeightedDestination{
+				{
+					Destination: &gloov1.Destination{
+						DestinationType: &gloov1.Destination_Upstream{Upstream: upstream},
+					},
+					Weight: wrapperspb.UInt32(canary.total - canary.weight),
+				},
+				{
+					Destination: &gloov1.Destination{
+						DestinationType: &gloov1.Destination_Upstream{Upstream: canary.upstream},
+					},
+					Weight: wrapperspb.UInt32(canary.weight),
+				},
+			},
+		},
+	}}
 }
@@ -2,6 +2,7 @@ package translator
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -13,11 +14,15 @@ import (
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 )
 
@@ -195,7 +200,7 @@ var _ = Describe("Translate", func() {
 				Ingresses: v1.IngressList{ingressRes, ingressResTls, ingressResTls2},
 				Upstreams: gloov1.UpstreamList{us, usSubset},
 			}
-			proxy := translateProxy(ctx, namespace, snap, requireIngressClass, "")
+			proxy, _ := translateProxy(ctx, namespace, snap, newIngressClassSelector(requireIngressClass, ""))
 
 			Expect(proxy.String()).To(Equal((&gloov1.Proxy{
 				Listeners: []*gloov1.Listener{
@@ -213,6 +218,31 @@ var _ = Describe("Translate", func() {
 											"wow.com:8080",
 										},
 										Routes: []*gloov1.Route{
+											// the http requests of the paths of the tls ingresses are redirected to https by default
+											{
+												Matchers: []*matchers.Matcher{{
+													PathSpecifier: &matchers.Matcher_Regex{
+														Regex: "/longestpathshouldcomesecond",
+													},
+												}},
+												Action: &gloov1.Route_RedirectAction{
+													RedirectAction: &gloov1.RedirectAction{
+														HttpsRedirect: true,
+													},
+												},
+											},
+											{
+												Matchers: []*matchers.Matcher{{
+													PathSpecifier: &matchers.Matcher_Regex{
+														Regex: "/basic",
+													},
+												}},
+												Action: &gloov1.Route_RedirectAction{
+													RedirectAction: &gloov1.RedirectAction{
+														HttpsRedirect: true,
+													},
+												},
+											},
 											{
 												Matchers: []*matchers.Matcher{{
 													PathSpecifier: &matchers.Matcher_Regex{
@@ -365,10 +395,11 @@ var _ = Describe("Translate", func() {
 			Upstreams: gloov1.UpstreamList{us1, us2},
 		}
 
-		proxy := translateProxy(ctx, "gloo-system", snap, false, "")
+		proxy, _ := translateProxy(ctx, "gloo-system", snap, newIngressClassSelector(false, ""))
 
-		Expect(proxy.Listeners).To(HaveLen(1))
-		Expect(proxy.Listeners[0].SslConfigurations).To(Equal([]*ssl.SslConfig{
+		// the http listener redirects to https
+		Expect(proxy.Listeners).To(HaveLen(2))
+		Expect(proxy.Listeners[1].SslConfigurations).To(Equal([]*ssl.SslConfig{
 			{
 				SslSecrets: &ssl.SslConfig_SecretRef{
 					SecretRef: &core.ResourceRef{
@@ -410,11 +441,11 @@ var _ = Describe("Translate", func() {
 		ing1 := makeIng("ing1", namespace, "", host1, "svc", port)
 		ing2 := makeIng("invalid-svc", namespace, "", "host2", "svc-that-doesnt-exist", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1, ing2},
-		}, false, "")
+		}, newIngressClassSelector(false, ""))
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
@@ -440,11 +471,11 @@ var _ = Describe("Translate", func() {
 		ing1 := makeIng("ing1", namespace, customClass1, host1, "svc", port)
 		ing2 := makeIng("ing2", namespace, customClass2, "host2", "svc", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1, ing2},
-		}, true, customClass1)
+		}, newIngressClassSelector(true, customClass1))
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
@@ -464,19 +495,365 @@ var _ = Describe("Translate", func() {
 
 		ing1 := makeIng("ing1", namespace, "", "host", "svc", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1},
-		}, false, "")
+		}, newIngressClassSelector(false, ""))
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
 		// successful translation
 		Expect(vhosts).To(HaveLen(1))
 	})
+
+	Context("ingress classes", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+			port      = intstr.IntOrString{Type: intstr.Int, IntVal: 8081}
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		ingressClass := func(name, controller string, isDefault bool) *networkingv1.IngressClass {
+			class := &networkingv1.IngressClass{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: networkingv1.IngressClassSpec{
+					Controller: controller,
+				},
+			}
+			if isDefault {
+				class.Annotations = map[string]string{IsDefaultIngressClassKey: "true"}
+			}
+			return class
+		}
+		noParameters := func(*networkingv1.IngressClassParametersReference) (map[string]string, error) {
+			return nil, nil
+		}
+		translatedHosts := func(selector *ingressClassSelector, ingresses ...*v1.Ingress) []string {
+			proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: ingresses,
+			}, selector)
+			var hosts []string
+			for _, vh := range proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts() {
+				hosts = append(hosts, vh.GetDomains()[0])
+			}
+			return hosts
+		}
+
+		It("selects the ingresses by ingress class name", func() {
+			selector := newIngressClassSelector(true, "")
+			selector.addIngressClass(ingressClass("gloo-class", IngressControllerName, false), noParameters)
+			selector.addIngressClass(ingressClass("gloo", "k8s.io/ingress-nginx", false), noParameters)
+
+			hosts := translatedHosts(selector,
+				withIngressClassName(makeIng("ours", namespace, "", "ours", "svc", port), "gloo-class"),
+				// the class named after the ingress class of the controller belongs to another controller
+				withIngressClassName(makeIng("theirs", namespace, "", "theirs", "svc", port), "gloo"),
+				// the annotation takes precedence over the class name
+				withIngressClassName(makeIng("annotated", namespace, "gloo", "annotated", "svc", port), "other"),
+				withIngressClassName(makeIng("no-class", namespace, "", "no-class", "svc", port), ""),
+			)
+			Expect(hosts).To(ConsistOf("ours", "annotated"))
+		})
+
+		It("selects the ingresses named after the ingress class of the controller when the IngressClass does not exist", func() {
+			hosts := translatedHosts(newIngressClassSelector(true, "fancy"),
+				withIngressClassName(makeIng("fancy", namespace, "", "fancy", "svc", port), "fancy"),
+				withIngressClassName(makeIng("pants", namespace, "", "pants", "svc", port), "pants"),
+			)
+			Expect(hosts).To(ConsistOf("fancy"))
+		})
+
+		It("selects the ingresses without class when the controller owns the default IngressClass", func() {
+			selector := newIngressClassSelector(true, "")
+			selector.addIngressClass(ingressClass("gloo-class", IngressControllerName, true), noParameters)
+
+			hosts := translatedHosts(selector,
+				withIngressClassName(makeIng("no-class", namespace, "", "no-class", "svc", port), ""),
+				withIngressClassName(makeIng("other", namespace, "", "other", "svc", port), "other"),
+			)
+			Expect(hosts).To(ConsistOf("no-class"))
+		})
+
+		It("applies the defaults of the parameters of the IngressClass", func() {
+			class := ingressClass("gloo-class", IngressControllerName, false)
+			class.Spec.Parameters = &networkingv1.IngressClassParametersReference{
+				Kind:      "ConfigMap",
+				Name:      "defaults",
+				Namespace: ptr.To(namespace),
+			}
+			selector := newIngressClassSelector(true, "")
+			selector.addIngressClass(class, func(params *networkingv1.IngressClassParametersReference) (map[string]string, error) {
+				Expect(params.Name).To(Equal("defaults"))
+				return configMapDefaults(&corev1.ConfigMap{Data: map[string]string{proxyReadTimeoutAnnotation: "10"}})
+			})
+
+			ing1 := withIngressClassName(makeIng("ing1", namespace, "", "host1", "svc", port), "gloo-class")
+			ing2 := annotate(withIngressClassName(makeIng("ing2", namespace, "", "host2", "svc", port), "gloo-class"),
+				map[string]string{GlooAnnotationPrefix + proxyReadTimeoutAnnotation: "20"})
+			proxy, errs := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: []*v1.Ingress{ing1, ing2},
+			}, selector)
+			Expect(errs).To(BeEmpty())
+
+			vhosts := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(2))
+			Expect(vhosts[0].GetRoutes()[0].GetOptions().GetTimeout().AsDuration()).To(Equal(10 * time.Second))
+			Expect(vhosts[1].GetRoutes()[0].GetOptions().GetTimeout().AsDuration()).To(Equal(20 * time.Second))
+		})
+
+		It("watches the IngressClasses and the ConfigMaps of their parameters", func() {
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			class := ingressClass("gloo-class", IngressControllerName, false)
+			class.Spec.Parameters = &networkingv1.IngressClassParametersReference{
+				Kind:      "ConfigMap",
+				Name:      "defaults",
+				Namespace: ptr.To(namespace),
+			}
+			defaults := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: namespace},
+				Data:       map[string]string{proxyReadTimeoutAnnotation: "10"},
+			}
+			other := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace},
+			}
+			kube := kubefake.NewSimpleClientset(class, defaults, other)
+			classCache, err := NewIngressClassCache(watchCtx, kube)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(classCache.Updates()).Should(Receive())
+
+			timeout := func() string {
+				selector, err := ingressClassSelectorForCluster(classCache, true, "")
+				Expect(err).NotTo(HaveOccurred())
+				kubeIng, err := ingresstype.ToKube(withIngressClassName(makeIng("ing", namespace, "", "host", "svc", port), "gloo-class"))
+				Expect(err).NotTo(HaveOccurred())
+				_, config := selector.selectIngress(kubeIng)
+				Expect(config).NotTo(BeNil())
+				Expect(config.err).NotTo(HaveOccurred())
+				return config.defaults[proxyReadTimeoutAnnotation]
+			}
+			Expect(timeout()).To(Equal("10"))
+
+			// ConfigMaps which are not parameters do not trigger a sync
+			other.Data = map[string]string{"key": "value"}
+			_, err = kube.CoreV1().ConfigMaps(namespace).Update(watchCtx, other, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(classCache.Updates(), 200*time.Millisecond).ShouldNot(Receive())
+
+			defaults.Data = map[string]string{proxyReadTimeoutAnnotation: "20"}
+			_, err = kube.CoreV1().ConfigMaps(namespace).Update(watchCtx, defaults, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(classCache.Updates()).Should(Receive())
+			Expect(timeout()).To(Equal("20"))
+
+			Expect(kube.NetworkingV1().IngressClasses().Delete(watchCtx, class.Name, metav1.DeleteOptions{})).To(Succeed())
+			Eventually(classCache.Updates()).Should(Receive())
+		})
+
+		It("rejects unsupported annotations in the parameters of the IngressClass", func() {
+			_, err := configMapDefaults(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: namespace},
+				Data:       map[string]string{"auth-url": "http://auth"},
+			})
+			Expect(err).To(MatchError("annotation auth-url of ConfigMap defaults.ns is not supported"))
+		})
+	})
+
+	Context("annotations", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+			port      = intstr.IntOrString{Type: intstr.Int, IntVal: 8081}
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		translate := func(upstreams []*gloov1.Upstream, ingresses ...*v1.Ingress) (*gloov1.Proxy, ingressErrors) {
+			return translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: upstreams,
+				Services:  []*v1.KubeService{svc},
+				Ingresses: ingresses,
+			}, newIngressClassSelector(false, ""))
+		}
+
+		It("translates the annotations into route options", func() {
+			ing := annotate(makeIng("ing", namespace, "", "host", "svc", port), map[string]string{
+				NginxAnnotationPrefix + rewriteTargetAnnotation:    "/$1",
+				NginxAnnotationPrefix + proxyReadTimeoutAnnotation: "30",
+				NginxAnnotationPrefix + enableCorsAnnotation:       "true",
+				NginxAnnotationPrefix + corsAllowOriginAnnotation:  "https://a.com, https://b.com",
+				NginxAnnotationPrefix + proxyBodySizeAnnotation:    "8m",
+				// the gloo annotations take precedence over the nginx ones
+				GlooAnnotationPrefix + proxyBodySizeAnnotation: "1k",
+			})
+			other := makeIng("other", namespace, "", "other", "svc", port)
+
+			proxy, errs := translate([]*gloov1.Upstream{us}, ing, other)
+			Expect(errs).To(BeEmpty())
+
+			listener := proxy.GetListeners()[0].GetHttpListener()
+			Expect(listener.GetOptions().GetBuffer().GetMaxRequestBytes().GetValue()).To(BeEquivalentTo(1024))
+			Expect(listener.GetVirtualHosts()).To(HaveLen(2))
+
+			options := listener.GetVirtualHosts()[0].GetRoutes()[0].GetOptions()
+			Expect(options.GetRegexRewrite().GetPattern().GetRegex()).To(Equal("^(?:/)"))
+			Expect(options.GetRegexRewrite().GetSubstitution()).To(Equal(`/\1`))
+			Expect(options.GetTimeout().AsDuration()).To(Equal(30 * time.Second))
+			Expect(options.GetCors().GetAllowOrigin()).To(Equal([]string{"https://a.com", "https://b.com"}))
+			Expect(options.GetCors().GetAllowMethods()).To(ContainElements("GET", "OPTIONS"))
+			Expect(options.GetCors().GetAllowCredentials()).To(BeTrue())
+			Expect(options.GetBufferPerRoute().GetBuffer().GetMaxRequestBytes().GetValue()).To(BeEquivalentTo(1024))
+
+			// the body size of the routes of the other ingress is not limited
+			otherOptions := listener.GetVirtualHosts()[1].GetRoutes()[0].GetOptions()
+			Expect(otherOptions.GetBufferPerRoute().GetDisabled()).To(BeTrue())
+		})
+
+		It("reports the unsupported and invalid annotations", func() {
+			ing := annotate(makeIng("ing", namespace, "", "host", "svc", port), map[string]string{
+				NginxAnnotationPrefix + "auth-url":                 "http://auth",
+				NginxAnnotationPrefix + proxyReadTimeoutAnnotation: "1m",
+				NginxAnnotationPrefix + enableCorsAnnotation:       "true",
+				"unrelated.io/annotation":                          "value",
+			})
+
+			proxy, errs := translate([]*gloov1.Upstream{us}, ing)
+			Expect(errs).To(HaveKey(types.NamespacedName{Namespace: namespace, Name: "ing"}))
+			Expect(errs[types.NamespacedName{Namespace: namespace, Name: "ing"}]).To(ConsistOf(
+				MatchError("annotation nginx.ingress.kubernetes.io/auth-url is not supported"),
+				MatchError(`invalid value "1m" for annotation proxy-read-timeout: must be a non-negative integer`),
+			))
+
+			// the ingress is translated with its valid options
+			options := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()[0].GetOptions()
+			Expect(options.GetCors()).NotTo(BeNil())
+			Expect(options.GetTimeout()).To(BeNil())
+		})
+
+		It("selects the upstream of the backend protocol", func() {
+			ing := annotate(makeIng("ing", namespace, "", "host", "svc", port), map[string]string{
+				NginxAnnotationPrefix + backendProtocolAnnotation: "GRPC",
+			})
+
+			_, errs := translate([]*gloov1.Upstream{us}, ing)
+			Expect(errs[types.NamespacedName{Namespace: namespace, Name: "ing"}]).To(ConsistOf(
+				MatchError(ContainSubstring("no upstream for kube service svc with port 8081 uses backend protocol GRPC")),
+			))
+
+			h2Upstream := makeUpstream("us-h2", namespace, svc)
+			h2Upstream.UseHttp2 = wrapperspb.Bool(true)
+			proxy, errs := translate([]*gloov1.Upstream{us, h2Upstream}, ing)
+			Expect(errs).To(BeEmpty())
+			route := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()[0]
+			Expect(route.GetRouteAction().GetSingle().GetUpstream().GetName()).To(Equal("us-h2"))
+		})
+
+		It("splits the traffic of a route with its canary ingress", func() {
+			canarySvc := makeService("canary-svc", namespace, "http", 8081)
+			canaryUs := makeUpstream("canary-us", namespace, canarySvc)
+			canary := annotate(makeIng("canary", namespace, "", "host", "canary-svc", port), map[string]string{
+				NginxAnnotationPrefix + canaryAnnotation:       "true",
+				NginxAnnotationPrefix + canaryWeightAnnotation: "20",
+			})
+			orphanCanary := annotate(makeIng("orphan", namespace, "", "other-host", "canary-svc", port), map[string]string{
+				NginxAnnotationPrefix + canaryAnnotation: "true",
+			})
+
+			proxy, errs := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us, canaryUs},
+				Services:  []*v1.KubeService{svc, canarySvc},
+				Ingresses: []*v1.Ingress{canary, makeIng("ing", namespace, "", "host", "svc", port), orphanCanary},
+			}, newIngressClassSelector(false, ""))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[types.NamespacedName{Namespace: namespace, Name: "orphan"}]).To(ConsistOf(
+				MatchError("no ingress without the canary annotation routes path / of host other-host"),
+			))
+
+			vhosts := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(1))
+			destinations := vhosts[0].GetRoutes()[0].GetRouteAction().GetMulti().GetDestinations()
+			Expect(destinations).To(HaveLen(2))
+			Expect(destinations[0].GetDestination().GetUpstream().GetName()).To(Equal("us"))
+			Expect(destinations[0].GetWeight().GetValue()).To(BeEquivalentTo(80))
+			Expect(destinations[1].GetDestination().GetUpstream().GetName()).To(Equal("canary-us"))
+			Expect(destinations[1].GetWeight().GetValue()).To(BeEquivalentTo(20))
+		})
+
+		withTls := func(ing *v1.Ingress) *v1.Ingress {
+			kubeIng, err := ingresstype.ToKube(ing)
+			Expect(err).NotTo(HaveOccurred())
+			kubeIng.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{"host"}, SecretName: "secret"}}
+			ing, err = ingresstype.FromKube(kubeIng)
+			Expect(err).NotTo(HaveOccurred())
+			return ing
+		}
+
+		It("redirects the http requests of the tls hosts to https by default", func() {
+			proxy, errs := translate([]*gloov1.Upstream{us}, withTls(makeIng("ing", namespace, "", "host", "svc", port)))
+			Expect(errs).To(BeEmpty())
+			Expect(proxy.GetListeners()).To(HaveLen(2))
+
+			httpRoutes := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()
+			Expect(httpRoutes).To(HaveLen(1))
+			Expect(httpRoutes[0].GetRedirectAction().GetHttpsRedirect()).To(BeTrue())
+			httpsRoutes := proxy.GetListeners()[1].GetHttpListener().GetVirtualHosts()[0].GetRoutes()
+			Expect(httpsRoutes[0].GetRouteAction().GetSingle().GetUpstream().GetName()).To(Equal("us"))
+		})
+
+		It("does not redirect the http requests of the tls hosts when ssl-redirect is disabled", func() {
+			ing := annotate(withTls(makeIng("ing", namespace, "", "host", "svc", port)), map[string]string{
+				NginxAnnotationPrefix + sslRedirectAnnotation: "false",
+			})
+
+			proxy, errs := translate([]*gloov1.Upstream{us}, ing)
+			Expect(errs).To(BeEmpty())
+			Expect(proxy.GetListeners()).To(HaveLen(1))
+			Expect(proxy.GetListeners()[0].GetSslConfigurations()).To(HaveLen(1))
+		})
+	})
 })
 
+// withIngressClassName sets the ingress class name of the ingress, which is left unset when empty.
+// The ingress class annotation is removed when empty.
+func withIngressClassName(ing *v1.Ingress, className string) *v1.Ingress {
+	kubeIng, _ := ingresstype.ToKube(ing)
+	if kubeIng.Annotations[IngressClassKey] == "" {
+		delete(kubeIng.Annotations, IngressClassKey)
+	}
+	if className != "" {
+		kubeIng.Spec.IngressClassName = ptr.To(className)
+	}
+	ing, _ = ingresstype.FromKube(kubeIng)
+	return ing
+}
+
+func annotate(ing *v1.Ingress, annotations map[string]string) *v1.Ingress {
+	kubeIng, _ := ingresstype.ToKube(ing)
+	for k, v := range annotations {
+		kubeIng.Annotations[k] = v
+	}
+	ing, _ = ingresstype.FromKube(kubeIng)
+	return ing
+}
+
 func getFirstPort(svc *corev1.Service) int32 {
 	return svc.Spec.Ports[0].Port
 }
@@ -2,6 +2,7 @@ package translator
 
 import (
 	"context"
+	"strings"
 
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	"github.com/solo-io/go-utils/hashutils"
@@ -14,6 +15,13 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 )
 
 type translatorSyncer struct {
@@ -30,6 +38,15 @@ type translatorSyncer struct {
 	customIngressClass string
 
 	statusClient resources.StatusClient
+
+	// kube is used to record the errors of the Ingresses as events.
+	kube kubernetes.Interface
+	// ingressClasses holds the IngressClasses of the cluster.
+	// When nil, only the ingress class annotation is used to select the Ingresses.
+	ingressClasses *IngressClassCache
+	eventRecorder  record.EventRecorder
+	// the errors last recorded for each Ingress
+	reportedErrors map[types.NamespacedName]string
 }
 
 var (
@@ -56,7 +73,13 @@ var (
 	}
 )
 
-func NewSyncer(writeNamespace string, proxyClient gloov1.ProxyClient, ingressClient v1.IngressClient, writeErrs chan error, requireIngressClass bool, customIngressClass string, statusClient resources.StatusClient) v1.TranslatorSyncer {
+func NewSyncer(writeNamespace string, proxyClient gloov1.ProxyClient, ingressClient v1.IngressClient, writeErrs chan error, requireIngressClass bool, customIngressClass string, statusClient resources.StatusClient, kube kubernetes.Interface, ingressClasses *IngressClassCache) v1.TranslatorSyncer {
+	var eventRecorder record.EventRecorder
+	if kube != nil {
+		broadcaster := record.NewBroadcaster()
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kube.CoreV1().Events("")})
+		eventRecorder = broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: eventSourceComponent})
+	}
 	return &translatorSyncer{
 		writeNamespace:      writeNamespace,
 		writeErrs:           writeErrs,
@@ -66,6 +89,10 @@ func NewSyncer(writeNamespace string, proxyClient gloov1.ProxyClient, ingressCli
 		requireIngressClass: requireIngressClass,
 		customIngressClass:  customIngressClass,
 		statusClient:        statusClient,
+		kube:                kube,
+		ingressClasses:      ingressClasses,
+		eventRecorder:       eventRecorder,
+		reportedErrors:      map[types.NamespacedName]string{},
 	}
 }
 
@@ -85,7 +112,13 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 		logger.Debug(syncutil.StringifySnapshot(snap))
 	}
 
-	proxy := translateProxy(ctx, s.writeNamespace, snap, s.requireIngressClass, s.customIngressClass)
+	// IngressClasses are not part of the snapshot, a sync is forced when they change
+	classSelector, err := ingressClassSelectorForCluster(s.ingressClasses, s.requireIngressClass, s.customIngressClass)
+	if err != nil {
+		logger.Warnf("selecting ingresses by the ingress class annotation only: %v", err)
+	}
+	proxy, ingressErrs := translateProxy(ctx, s.writeNamespace, snap, classSelector)
+	s.reportIngressErrors(ctx, ingressErrs)
 
 	var desiredResources gloov1.ProxyList
 	if proxy != nil {
@@ -106,3 +139,43 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 
 	return nil
 }
+
+const (
+	eventSourceComponent = "gloo-ingress"
+	// ingressErrorsEventReason is the reason of the events which report the errors of an Ingress
+	ingressErrorsEventReason = "TranslationErrors"
+)
+
+// reportIngressErrors logs the errors of the Ingresses, and records them as warning events of the Ingresses.
+// The errors of an Ingress are only reported when they change.
+func (s *translatorSyncer) reportIngressErrors(ctx context.Context, errs ingressErrors) {
+	logger := contextutils.LoggerFrom(ctx)
+	for key := range s.reportedErrors {
+		if _, ok := errs[key]; !ok {
+			delete(s.reportedErrors, key)
+		}
+	}
+	for key, ingErrs := range errs {
+		messages := make([]string, 0, len(ingErrs))
+		for _, err := range ingErrs {
+			messages = append(messages, err.Error())
+		}
+		message := strings.Join(messages, "; ")
+		if s.reportedErrors[key] == message {
+			continue
+		}
+		logger.Warnf("ingress %v: %v", key, message)
+		if s.eventRecorder == nil {
+			s.reportedErrors[key] = message
+			continue
+		}
+		// the events must reference the uid of the Ingress, which is not part of the snapshot
+		ing, err := s.kube.NetworkingV1().Ingresses(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+		if err != nil {
+			logger.Warnf("getting ingress %v to record its errors: %v", key, err)
+			continue
+		}
+		s.eventRecorder.Event(ing, corev1.EventTypeWarning, ingressErrorsEventReason, message)
+		s.reportedErrors[key] = message
+	}
+}
@@ -0,0 +1,303 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+
+	envoy_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+)
+
+var (
+	MissingDescriptorKeyError = eris.New("descriptor has no key")
+
+	InvalidRateLimitError = func(path string) error {
+		return eris.Errorf("descriptor %s has an invalid rate limit: a unit and a positive number of requests per unit are required", path)
+	}
+
+	DuplicateDescriptorError = func(path string) error {
+		return eris.Errorf("descriptor %s is defined more than once", path)
+	}
+
+	EmptySetDescriptorError = func(idx int) error {
+		return eris.Errorf("set descriptor %d has no simple descriptors", idx)
+	}
+)
+
+// Rule is a rate limit rule of a descriptor or of a set descriptor
+type Rule struct {
+	// Name identifies the rule in logs and metrics, e.g. `generic_key=foo|userid`, or `set[0]`
+	Name        string
+	Limit       *solo_rl.RateLimit
+	Weight      uint32
+	AlwaysApply bool
+}
+
+// Match is a rule matched by a descriptor of a request
+type Match struct {
+	Rule *Rule
+	// CounterKey identifies the counter of the rule for the values of the descriptor, in any window of time
+	CounterKey string
+}
+
+// Config holds the rate limit rules of each domain
+type Config struct {
+	domains map[string]*domainConfig
+}
+
+type domainConfig struct {
+	tree node
+	sets []*setRule
+}
+
+type node struct {
+	children map[entry]*node
+	rule     *Rule
+}
+
+// an entry of a descriptor; an empty value matches any value of the key
+type entry struct {
+	key   string
+	value string
+}
+
+type setRule struct {
+	rule    *Rule
+	entries []entry
+}
+
+// New returns a Config without any rules
+func New() *Config {
+	return &Config{domains: map[string]*domainConfig{}}
+}
+
+func (c *Config) domain(domain string) *domainConfig {
+	dc, ok := c.domains[domain]
+	if !ok {
+		dc = &domainConfig{}
+		c.domains[domain] = dc
+	}
+	return dc
+}
+
+// AddDescriptors adds the rules of the descriptor trees to the domain.
+// Invalid descriptors are skipped, and reported in the returned error.
+func (c *Config) AddDescriptors(domain string, descriptors []*solo_rl.Descriptor) error {
+	var errs error
+	dc := c.domain(domain)
+	for _, descriptor := range descriptors {
+		if err := dc.tree.add(descriptor, nil, 0, false); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (n *node) add(descriptor *solo_rl.Descriptor, path []string, weight uint32, alwaysApply bool) error {
+	if descriptor.GetKey() == "" {
+		return MissingDescriptorKeyError
+	}
+	e := entry{key: descriptor.GetKey(), value: descriptor.GetValue()}
+	path = append(path, e.String())
+	if _, ok := n.children[e]; ok {
+		return DuplicateDescriptorError(strings.Join(path, "|"))
+	}
+
+	// the weight and the alwaysApply flag of a rule are the ones of its top-level descriptor
+	if len(path) == 1 {
+		weight, alwaysApply = descriptor.GetWeight(), descriptor.GetAlwaysApply()
+	}
+
+	child := &node{}
+	if limit := descriptor.GetRateLimit(); limit != nil {
+		if !validLimit(limit) {
+			return InvalidRateLimitError(strings.Join(path, "|"))
+		}
+		child.rule = &Rule{
+			Name:        strings.Join(path, "|"),
+			Limit:       limit,
+			Weight:      weight,
+			AlwaysApply: alwaysApply,
+		}
+	}
+	var errs error
+	for _, nested := range descriptor.GetDescriptors() {
+		if err := child.add(nested, path, weight, alwaysApply); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if n.children == nil {
+		n.children = map[entry]*node{}
+	}
+	n.children[e] = child
+	return errs
+}
+
+// AddSetDescriptors adds the rules of the set descriptors to the domain, after the ones it already has.
+// Invalid set descriptors are skipped, and reported in the returned error.
+func (c *Config) AddSetDescriptors(domain string, setDescriptors []*solo_rl.SetDescriptor) error {
+	var errs error
+	dc := c.domain(domain)
+	for _, setDescriptor := range setDescriptors {
+		idx := len(dc.sets)
+		if len(setDescriptor.GetSimpleDescriptors()) == 0 {
+			errs = multierror.Append(errs, EmptySetDescriptorError(idx))
+			continue
+		}
+		name := "set[" + strconv.Itoa(idx) + "]"
+		if !validLimit(setDescriptor.GetRateLimit()) {
+			errs = multierror.Append(errs, InvalidRateLimitError(name))
+			continue
+		}
+		var entries []entry
+		for _, simple := range setDescriptor.GetSimpleDescriptors() {
+			if simple.GetKey() == "" {
+				errs = multierror.Append(errs, MissingDescriptorKeyError)
+				entries = nil
+				break
+			}
+			entries = append(entries, entry{key: simple.GetKey(), value: simple.GetValue()})
+		}
+		if entries == nil {
+			continue
+		}
+		dc.sets = append(dc.sets, &setRule{
+			rule: &Rule{
+				Name:        name,
+				Limit:       setDescriptor.GetRateLimit(),
+				AlwaysApply: setDescriptor.GetAlwaysApply(),
+			},
+			entries: entries,
+		})
+	}
+	return errs
+}
+
+func validLimit(limit *solo_rl.RateLimit) bool {
+	return limit.GetUnit() != solo_rl.RateLimit_UNKNOWN && limit.GetRequestsPerUnit() > 0
+}
+
+// Match returns the rules the descriptors of a request match, in the order of the descriptors.
+//
+// A descriptor matches the rule of the node of the descriptor trees its entries lead to, where a value in the tree
+// matches the same value of the entry, and an empty value in the tree matches any value. Only the matched rules
+// of the highest weight are returned, along with the ones which always apply.
+//
+// The descriptors of set-style actions (starting with the ratelimit.SetDescriptorValue generic key) match the first set
+// descriptor whose simple descriptors they all have, in any order, along with the later ones which always apply.
+func (c *Config) Match(domain string, descriptors []*envoy_ratelimit_v3.RateLimitDescriptor) [][]*Match {
+	matches := make([][]*Match, len(descriptors))
+	dc, ok := c.domains[domain]
+	if !ok {
+		return matches
+	}
+
+	var maxWeight uint32
+	for i, descriptor := range descriptors {
+		entries := descriptor.GetEntries()
+		if isSetStyle(entries) {
+			matches[i] = dc.matchSets(domain, entries[1:])
+			continue
+		}
+		if match := dc.tree.match(domain, entries); match != nil {
+			matches[i] = []*Match{match}
+			if match.Rule.Weight > maxWeight {
+				maxWeight = match.Rule.Weight
+			}
+		}
+	}
+
+	// weights only apply to the rules of the descriptor trees
+	for i, descriptor := range descriptors {
+		if isSetStyle(descriptor.GetEntries()) {
+			continue
+		}
+		if len(matches[i]) == 1 && matches[i][0].Rule.Weight < maxWeight && !matches[i][0].Rule.AlwaysApply {
+			matches[i] = nil
+		}
+	}
+	return matches
+}
+
+func isSetStyle(entries []*envoy_ratelimit_v3.RateLimitDescriptor_Entry) bool {
+	return len(entries) > 0 && entries[0].GetKey() == "generic_key" && entries[0].GetValue() == ratelimit.SetDescriptorValue
+}
+
+func (n *node) match(domain string, entries []*envoy_ratelimit_v3.RateLimitDescriptor_Entry) *Match {
+	if len(entries) == 0 {
+		return nil
+	}
+	current := n
+	for _, e := range entries {
+		next, ok := current.children[entry{key: e.GetKey(), value: e.GetValue()}]
+		if !ok {
+			next, ok = current.children[entry{key: e.GetKey()}]
+		}
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+	if current.rule == nil {
+		return nil
+	}
+	return &Match{
+		Rule:       current.rule,
+		CounterKey: counterKey(domain, current.rule.Name, entries),
+	}
+}
+
+func (dc *domainConfig) matchSets(domain string, entries []*envoy_ratelimit_v3.RateLimitDescriptor_Entry) []*Match {
+	values := map[string]string{}
+	for _, e := range entries {
+		values[e.GetKey()] = e.GetValue()
+	}
+
+	var (
+		matches    []*Match
+		firstFound bool
+	)
+	for _, set := range dc.sets {
+		if firstFound && !set.rule.AlwaysApply {
+			continue
+		}
+		var matched []*envoy_ratelimit_v3.RateLimitDescriptor_Entry
+		for _, e := range set.entries {
+			value, ok := values[e.key]
+			if !ok || (e.value != "" && e.value != value) {
+				matched = nil
+				break
+			}
+			matched = append(matched, &envoy_ratelimit_v3.RateLimitDescriptor_Entry{Key: e.key, Value: value})
+		}
+		if matched == nil {
+			continue
+		}
+		firstFound = true
+		matches = append(matches, &Match{
+			Rule:       set.rule,
+			CounterKey: counterKey(domain, set.rule.Name, matched),
+		})
+	}
+	return matches
+}
+
+// the counters of a rule are distinct for each value of the entries it matched
+func counterKey(domain, ruleName string, entries []*envoy_ratelimit_v3.RateLimitDescriptor_Entry) string {
+	parts := []string{domain, ruleName}
+	for _, e := range entries {
+		parts = append(parts, e.GetKey()+"="+e.GetValue())
+	}
+	return strings.Join(parts, "|")
+}
+
+func (e entry) String() string {
+	if e.value == "" {
+		return e.key
+	}
+	return e.key + "=" + e.value
+}
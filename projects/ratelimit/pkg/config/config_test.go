@@ -0,0 +1,244 @@
+package config_test
+
+import (
+	"context"
+
+	envoy_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	ratelimitpb "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/config"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const domain = "custom"
+
+func descriptor(entries ...string) *envoy_ratelimit_v3.RateLimitDescriptor {
+	d := &envoy_ratelimit_v3.RateLimitDescriptor{}
+	for i := 0; i < len(entries); i += 2 {
+		d.Entries = append(d.GetEntries(), &envoy_ratelimit_v3.RateLimitDescriptor_Entry{Key: entries[i], Value: entries[i+1]})
+	}
+	return d
+}
+
+func limit(requests uint32, unit solo_rl.RateLimit_Unit) *solo_rl.RateLimit {
+	return &solo_rl.RateLimit{RequestsPerUnit: requests, Unit: unit}
+}
+
+// ruleNames returns the names of the rules matched by each descriptor
+func ruleNames(matches [][]*config.Match) [][]string {
+	names := make([][]string, len(matches))
+	for i, descriptorMatches := range matches {
+		names[i] = []string{}
+		for _, match := range descriptorMatches {
+			names[i] = append(names[i], match.Rule.Name)
+		}
+	}
+	return names
+}
+
+var _ = Describe("Config", func() {
+
+	var cfg *config.Config
+
+	BeforeEach(func() {
+		cfg = config.New()
+	})
+
+	Context("descriptors", func() {
+
+		BeforeEach(func() {
+			err := cfg.AddDescriptors(domain, []*solo_rl.Descriptor{
+				{
+					Key:       "generic_key",
+					Value:     "per-second",
+					RateLimit: limit(1, solo_rl.RateLimit_SECOND),
+				},
+				{
+					Key: "remote_address",
+					Descriptors: []*solo_rl.Descriptor{
+						{Key: "path", Value: "/login", RateLimit: limit(5, solo_rl.RateLimit_MINUTE)},
+						{Key: "path", RateLimit: limit(100, solo_rl.RateLimit_MINUTE)},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("matches exact values before any value", func() {
+			matches := cfg.Match(domain, []*envoy_ratelimit_v3.RateLimitDescriptor{
+				descriptor("remote_address", "1.2.3.4", "path", "/login"),
+				descriptor("remote_address", "1.2.3.4", "path", "/home"),
+				descriptor("generic_key", "per-second"),
+			})
+			Expect(ruleNames(matches)).To(Equal([][]string{
+				{"remote_address|path=/login"},
+				{"remote_address|path"},
+				{"generic_key=per-second"},
+			}))
+		})
+
+		It("counts each value of the entries which match any value separately", func() {
+			matches := cfg.Match(domain, []*envoy_ratelimit_v3.RateLimitDescriptor{
+				descriptor("remote_address", "1.2.3.4", "path", "/home"),
+				descriptor("remote_address", "5.6.7.8", "path", "/home"),
+			})
+			Expect(matches[0][0].Rule).To(BeIdenticalTo(matches[1][0].Rule))
+			Expect(matches[0][0].CounterKey).NotTo(Equal(matches[1][0].CounterKey))
+		})
+
+		It("does not match partial or unknown descriptors, nor other domains", func() {
+			matches := cfg.Match(domain, []*envoy_ratelimit_v3.RateLimitDescriptor{
+				descriptor("remote_address", "1.2.3.4"),
+				descriptor("remote_address", "1.2.3.4", "path", "/", "method", "GET"),
+				descriptor("generic_key", "other"),
+				descriptor(),
+			})
+			Expect(ruleNames(matches)).To(Equal([][]string{{}, {}, {}, {}}))
+
+			matches = cfg.Match("other", []*envoy_ratelimit_v3.RateLimitDescriptor{descriptor("generic_key", "per-second")})
+			Expect(ruleNames(matches)).To(Equal([][]string{{}}))
+		})
+
+		It("only applies the rules of the highest weight, and the ones which always apply", func() {
+			err := cfg.AddDescriptors(domain, []*solo_rl.Descriptor{
+				{Key: "heavy", RateLimit: limit(1, solo_rl.RateLimit_SECOND), Weight: 2},
+				{
+					Key:         "always",
+					AlwaysApply: true,
+					Descriptors: []*solo_rl.Descriptor{{Key: "nested", RateLimit: limit(1, solo_rl.RateLimit_SECOND)}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			matches := cfg.Match(domain, []*envoy_ratelimit_v3.RateLimitDescriptor{
+				descriptor("generic_key", "per-second"),
+				descriptor("heavy", "x"),
+				descriptor("always", "y", "nested", "z"),
+			})
+			Expect(ruleNames(matches)).To(Equal([][]string{{}, {"heavy"}, {"always|nested"}}))
+		})
+
+		It("reports invalid descriptors and keeps the valid ones", func() {
+			err := cfg.AddDescriptors(domain, []*solo_rl.Descriptor{
+				{Key: "generic_key", Value: "per-second", RateLimit: limit(1, solo_rl.RateLimit_SECOND)},
+				{Key: "no-unit", RateLimit: limit(1, solo_rl.RateLimit_UNKNOWN)},
+				{Value: "no-key"},
+				{Key: "valid", RateLimit: limit(1, solo_rl.RateLimit_HOUR)},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(config.DuplicateDescriptorError("generic_key=per-second").Error()))
+			Expect(err.Error()).To(ContainSubstring(config.InvalidRateLimitError("no-unit").Error()))
+			Expect(err.Error()).To(ContainSubstring(config.MissingDescriptorKeyError.Error()))
+
+			matches := cfg.Match(domain, []*envoy_ratelimit_v3.RateLimitDescriptor{descriptor("valid", "v")})
+			Expect(ruleNames(matches)).To(Equal([][]string{{"valid"}}))
+		})
+	})
+
+	Context("set descriptors", func() {
+
+		setDescriptor := func(entries ...string) *envoy_ratelimit_v3.RateLimitDescriptor {
+			return descriptor(append([]string{"generic_key", ratelimit.SetDescriptorValue}, entries...)...)
+		}
+
+		BeforeEach(func() {
+			err := cfg.AddSetDescriptors(domain, []*solo_rl.SetDescriptor{
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "type", Value: "premium"}, {Key: "user"}},
+					RateLimit:         limit(100, solo_rl.RateLimit_MINUTE),
+				},
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "user"}},
+					RateLimit:         limit(10, solo_rl.RateLimit_MINUTE),
+				},
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "region"}},
+					RateLimit:         limit(1000, solo_rl.RateLimit_MINUTE),
+					AlwaysApply:       true,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("matches the first set descriptor the entries have in any order, and the ones which always apply", func() {
+			matches := cfg.Match(domain, []*envoy_ratelimit_v3.RateLimitDescriptor{
+				setDescriptor("user", "alice", "type", "premium", "region", "eu"),
+				setDescriptor("type", "basic", "user", "bob"),
+				setDescriptor("region", "eu"),
+				setDescriptor("type", "premium"),
+			})
+			Expect(ruleNames(matches)).To(Equal([][]string{
+				{"set[0]", "set[2]"},
+				{"set[1]"},
+				{"set[2]"},
+				{},
+			}))
+		})
+
+		It("only uses the entries of the set descriptor in the counter key", func() {
+			matches := cfg.Match(domain, []*envoy_ratelimit_v3.RateLimitDescriptor{
+				setDescriptor("user", "bob", "type", "basic"),
+				setDescriptor("user", "bob", "type", "other"),
+			})
+			Expect(matches[0][0].CounterKey).To(Equal(matches[1][0].CounterKey))
+		})
+
+		It("is not matched by descriptors of regular actions", func() {
+			matches := cfg.Match(domain, []*envoy_ratelimit_v3.RateLimitDescriptor{descriptor("user", "bob")})
+			Expect(ruleNames(matches)).To(Equal([][]string{{}}))
+		})
+	})
+
+	Context("FromSettings", func() {
+
+		It("has the descriptors of the settings, and the basic rate limits of the proxies", func() {
+			basic := &ratelimitpb.IngressRateLimit{
+				AuthorizedLimits: limit(100, solo_rl.RateLimit_MINUTE),
+				AnonymousLimits:  limit(5, solo_rl.RateLimit_MINUTE),
+			}
+			proxy := &gloov1.Proxy{
+				Metadata: &core.Metadata{Name: "gateway-proxy", Namespace: "gloo-system"},
+				Listeners: []*gloov1.Listener{{
+					Name: "listener-::-8080",
+					ListenerType: &gloov1.Listener_HttpListener{HttpListener: &gloov1.HttpListener{
+						VirtualHosts: []*gloov1.VirtualHost{{
+							Name:    "gloo-system.default",
+							Options: &gloov1.VirtualHostOptions{RatelimitBasic: basic},
+							Routes: []*gloov1.Route{
+								{},
+								{Options: &gloov1.RouteOptions{RatelimitBasic: basic}},
+							},
+						}},
+					}},
+				}},
+			}
+			settings := &gloov1.Settings{
+				Ratelimit: &ratelimitpb.ServiceSettings{
+					Descriptors: []*solo_rl.Descriptor{{Key: "generic_key", Value: "global", RateLimit: limit(1, solo_rl.RateLimit_SECOND)}},
+				},
+			}
+
+			cfg, err := config.FromSettings(context.Background(), settings, gloov1.ProxyList{proxy})
+			Expect(err).NotTo(HaveOccurred())
+
+			// the virtual host name is sanitized like the translator does
+			vhKey := ratelimit.BasicVirtualHostKey(proxy.GetMetadata().Ref(), "listener-::-8080", "gloo-system_default")
+			routeKey := ratelimit.BasicRouteKey(proxy.GetMetadata().Ref(), "listener-::-8080", "gloo-system_default", 1)
+			matches := cfg.Match(ratelimit.CustomDomain, []*envoy_ratelimit_v3.RateLimitDescriptor{
+				descriptor("generic_key", "global"),
+				descriptor("generic_key", vhKey, "header_match", ratelimit.BasicAuthorizedValue, "userid", "alice"),
+				descriptor("generic_key", vhKey, "header_match", ratelimit.BasicAnonymousValue, "remote_address", "1.2.3.4"),
+				descriptor("generic_key", routeKey, "header_match", ratelimit.BasicAnonymousValue, "remote_address", "1.2.3.4"),
+			})
+			Expect(matches[0][0].Rule.Limit).To(Equal(limit(1, solo_rl.RateLimit_SECOND)))
+			Expect(matches[1][0].Rule.Limit).To(Equal(basic.GetAuthorizedLimits()))
+			Expect(matches[2][0].Rule.Limit).To(Equal(basic.GetAnonymousLimits()))
+			Expect(matches[3][0].Rule.Limit).To(Equal(basic.GetAnonymousLimits()))
+			Expect(matches[2][0].CounterKey).NotTo(Equal(matches[3][0].CounterKey))
+		})
+	})
+})
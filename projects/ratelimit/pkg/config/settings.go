@@ -0,0 +1,59 @@
+package config
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+)
+
+// FromSettings returns the Config of the rate limit rules Gloo sends to the rate limit server: the descriptors and
+// the set descriptors of `Settings.ratelimit`, and the `ratelimitBasic` options of the virtual hosts and routes of
+// the proxies. All of them are in the custom domain of the rate limit filter.
+//
+// The Config has all the valid rules even when an error is returned, so that a single invalid rule does not
+// disable rate limiting altogether.
+func FromSettings(ctx context.Context, settings *gloov1.Settings, proxies gloov1.ProxyList) (*Config, error) {
+	var errs error
+	cfg := New()
+
+	if err := cfg.AddDescriptors(ratelimit.CustomDomain, settings.GetRatelimit().GetDescriptors()); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := cfg.AddDescriptors(ratelimit.CustomDomain, basicDescriptors(ctx, proxies)); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := cfg.AddSetDescriptors(ratelimit.CustomDomain, settings.GetRatelimit().GetSetDescriptors()); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	return cfg, errs
+}
+
+// basicDescriptors returns the descriptors of the `ratelimitBasic` options, keyed like the rate limit actions the
+// ratelimit plugin generates for them
+func basicDescriptors(ctx context.Context, proxies gloov1.ProxyList) []*solo_rl.Descriptor {
+	var descriptors []*solo_rl.Descriptor
+	for _, proxy := range proxies {
+		proxyRef := proxy.GetMetadata().Ref()
+		for _, listener := range proxy.GetListeners() {
+			for _, virtualHost := range utils.GetVirtualHostsForListener(listener) {
+				// the translator sanitizes the names of the virtual hosts before the plugins process them
+				virtualHostName := utils.SanitizeForEnvoy(ctx, virtualHost.GetName(), "virtual host")
+				if basic := virtualHost.GetOptions().GetRatelimitBasic(); basic != nil {
+					key := ratelimit.BasicVirtualHostKey(proxyRef, listener.GetName(), virtualHostName)
+					descriptors = append(descriptors, ratelimit.GenerateBasicDescriptor(key, basic))
+				}
+				for i, route := range virtualHost.GetRoutes() {
+					if basic := route.GetOptions().GetRatelimitBasic(); basic != nil {
+						key := ratelimit.BasicRouteKey(proxyRef, listener.GetName(), virtualHostName, i)
+						descriptors = append(descriptors, ratelimit.GenerateBasicDescriptor(key, basic))
+					}
+				}
+			}
+		}
+	}
+	return descriptors
+}
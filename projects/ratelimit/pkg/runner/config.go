@@ -0,0 +1,132 @@
+package runner
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/debug"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/config"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// ProxyLister lists the current Proxies of Gloo
+type ProxyLister func(ctx context.Context) (gloov1.ProxyList, error)
+
+// NewProxyEndpointLister returns a ProxyLister which requests the Proxies from the proxy debug endpoint of Gloo,
+// as they are not persisted by default
+func NewProxyEndpointLister(cc grpc.ClientConnInterface) ProxyLister {
+	client := debug.NewProxyEndpointServiceClient(cc)
+	return func(ctx context.Context) (gloov1.ProxyList, error) {
+		// Some proxies can become very large and exceed the default limit of the size of messages
+		resp, err := client.GetProxies(ctx, &debug.ProxyEndpointRequest{}, grpc.MaxCallRecvMsgSize(math.MaxInt32))
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetProxies(), nil
+	}
+}
+
+// NewProxyClientLister returns a ProxyLister which lists the Proxies of all namespaces with the given client
+func NewProxyClientLister(proxyClient gloov1.ProxyClient) ProxyLister {
+	return func(ctx context.Context) (gloov1.ProxyList, error) {
+		return proxyClient.List("", clients.ListOpts{Ctx: ctx})
+	}
+}
+
+// WatchConfig calls onConfig with the rate limit rules of the Settings and the Proxies once both have been read,
+// and then whenever either of them changes, until the context is done. The Proxies are listed every refreshInterval.
+func WatchConfig(
+	ctx context.Context,
+	settingsClient gloov1.SettingsClient,
+	settingsRef *core.ResourceRef,
+	listProxies ProxyLister,
+	refreshInterval time.Duration,
+	onConfig func(cfg *config.Config),
+) error {
+	logger := contextutils.LoggerFrom(ctx)
+
+	settingsChan, settingsErrs, err := settingsClient.Watch(settingsRef.GetNamespace(), clients.WatchOpts{Ctx: ctx})
+	if err != nil {
+		return eris.Wrapf(err, "watching settings")
+	}
+
+	var (
+		settings       *gloov1.Settings
+		settingsSynced bool
+		proxies        gloov1.ProxyList
+		proxiesHash    uint64
+		proxiesSynced  bool
+	)
+	refreshProxies := func() bool {
+		list, err := listProxies(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warnw("failed to list proxies", zap.Error(err))
+			}
+			return false
+		}
+		hash := hashProxies(list)
+		if proxiesSynced && hash == proxiesHash {
+			return false
+		}
+		proxies, proxiesHash, proxiesSynced = list, hash, true
+		return true
+	}
+	refreshProxies()
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		changed := false
+		select {
+		case <-ctx.Done():
+			return nil
+		case list, ok := <-settingsChan:
+			if !ok {
+				return nil
+			}
+			found, err := list.Find(settingsRef.GetNamespace(), settingsRef.GetName())
+			if err != nil {
+				logger.Warnw("settings not found, only the rate limits of the proxies apply", zap.Error(err))
+				found = nil
+			}
+			changed = !settingsSynced || !found.Equal(settings)
+			settings, settingsSynced = found, true
+		case err, ok := <-settingsErrs:
+			if !ok {
+				return nil
+			}
+			logger.Warnw("error watching settings", zap.Error(err))
+		case <-ticker.C:
+			changed = refreshProxies()
+		}
+
+		if !changed || !settingsSynced || !proxiesSynced {
+			continue
+		}
+		cfg, err := config.FromSettings(ctx, settings, proxies)
+		if err != nil {
+			logger.Errorw("skipped invalid rate limit rules", zap.Error(err))
+		}
+		onConfig(cfg)
+	}
+}
+
+func hashProxies(proxies gloov1.ProxyList) uint64 {
+	hasher := fnv.New64()
+	for _, proxy := range proxies {
+		// hashing a proxy does not fail
+		hash, _ := proxy.Hash(nil)
+		_ = binary.Write(hasher, binary.LittleEndian, hash)
+	}
+	return hasher.Sum64()
+}
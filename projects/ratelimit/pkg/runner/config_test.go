@@ -0,0 +1,147 @@
+package runner_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	envoy_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	ratelimitpb "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/config"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/runner"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("WatchConfig", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		settingsClient gloov1.SettingsClient
+		settingsRef    *core.ResourceRef
+
+		proxiesLock sync.Mutex
+		proxies     gloov1.ProxyList
+
+		configs chan *config.Config
+	)
+
+	listProxies := func(_ context.Context) (gloov1.ProxyList, error) {
+		proxiesLock.Lock()
+		defer proxiesLock.Unlock()
+		return proxies.Clone(), nil
+	}
+
+	setProxies := func(list gloov1.ProxyList) {
+		proxiesLock.Lock()
+		defer proxiesLock.Unlock()
+		proxies = list
+	}
+
+	settingsWithLimit := func(requests uint32) *gloov1.Settings {
+		return &gloov1.Settings{
+			Metadata: &core.Metadata{Namespace: settingsRef.GetNamespace(), Name: settingsRef.GetName()},
+			Ratelimit: &ratelimitpb.ServiceSettings{
+				Descriptors: []*solo_rl.Descriptor{{
+					Key:       "generic_key",
+					Value:     "global",
+					RateLimit: &solo_rl.RateLimit{RequestsPerUnit: requests, Unit: solo_rl.RateLimit_SECOND},
+				}},
+			},
+		}
+	}
+
+	// limitOf returns the limit of the given descriptor in the config
+	limitOf := func(cfg *config.Config, key, value string) *solo_rl.RateLimit {
+		matches := cfg.Match(ratelimit.CustomDomain, []*envoy_ratelimit_v3.RateLimitDescriptor{{
+			Entries: []*envoy_ratelimit_v3.RateLimitDescriptor_Entry{{Key: key, Value: value}},
+		}})
+		if len(matches[0]) == 0 {
+			return nil
+		}
+		return matches[0][0].Rule.Limit
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		var err error
+		settingsClient, err = gloov1.NewSettingsClient(ctx, &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		settingsRef = &core.ResourceRef{Namespace: "gloo-system", Name: "default"}
+		setProxies(nil)
+		configs = make(chan *config.Config, 10)
+
+		go func() {
+			defer GinkgoRecover()
+			err := runner.WatchConfig(ctx, settingsClient, settingsRef, listProxies, 10*time.Millisecond, func(cfg *config.Config) {
+				configs <- cfg
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}()
+	})
+
+	It("has the rules of the settings and updates them", func() {
+		written, err := settingsClient.Write(settingsWithLimit(1), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(configs).Should(Receive(WithTransform(func(cfg *config.Config) uint32 {
+			return limitOf(cfg, "generic_key", "global").GetRequestsPerUnit()
+		}, Equal(uint32(1)))))
+
+		updated := settingsWithLimit(5)
+		updated.Metadata = written.GetMetadata()
+		_, err = settingsClient.Write(updated, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(configs).Should(Receive(WithTransform(func(cfg *config.Config) uint32 {
+			return limitOf(cfg, "generic_key", "global").GetRequestsPerUnit()
+		}, Equal(uint32(5)))))
+	})
+
+	It("has the basic rate limits of the proxies and updates them only when they change", func() {
+		Eventually(configs).Should(Receive())
+
+		basic := &ratelimitpb.IngressRateLimit{
+			AnonymousLimits: &solo_rl.RateLimit{RequestsPerUnit: 3, Unit: solo_rl.RateLimit_MINUTE},
+		}
+		proxy := &gloov1.Proxy{
+			Metadata: &core.Metadata{Name: "gateway-proxy", Namespace: "gloo-system"},
+			Listeners: []*gloov1.Listener{{
+				Name: "listener",
+				ListenerType: &gloov1.Listener_HttpListener{HttpListener: &gloov1.HttpListener{
+					VirtualHosts: []*gloov1.VirtualHost{{
+						Name:    "vhost",
+						Options: &gloov1.VirtualHostOptions{RatelimitBasic: basic},
+					}},
+				}},
+			}},
+		}
+		setProxies(gloov1.ProxyList{proxy})
+
+		var cfg *config.Config
+		Eventually(configs).Should(Receive(&cfg))
+		key := ratelimit.BasicVirtualHostKey(proxy.GetMetadata().Ref(), "listener", "vhost")
+		matches := cfg.Match(ratelimit.CustomDomain, []*envoy_ratelimit_v3.RateLimitDescriptor{{
+			Entries: []*envoy_ratelimit_v3.RateLimitDescriptor_Entry{
+				{Key: ratelimit.BasicGenericKey, Value: key},
+				{Key: ratelimit.BasicHeaderMatchKey, Value: ratelimit.BasicAnonymousValue},
+				{Key: ratelimit.BasicRemoteAddressKey, Value: "1.2.3.4"},
+			},
+		}})
+		Expect(matches[0]).To(HaveLen(1))
+		Expect(matches[0][0].Rule.Limit).To(Equal(basic.GetAnonymousLimits()))
+
+		Consistently(configs, 100*time.Millisecond).ShouldNot(Receive())
+	})
+})
@@ -0,0 +1,223 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/kubeutils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/config"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/service"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/store"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/healthchecker"
+	"github.com/solo-io/go-utils/stats"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.opencensus.io/plugin/ocgrpc"
+	"go.opencensus.io/stats/view"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+var (
+	UnknownStoreError = func(name string) error {
+		return eris.Errorf("unknown rate limit store %q, must be one of %q or %q", name, MemoryStore, RedisStore)
+	}
+)
+
+const ReadyPath = "/ready"
+
+func init() {
+	view.Register(ocgrpc.DefaultServerViews...)
+}
+
+func Run() {
+	settings := NewSettings()
+	ctx := contextutils.WithLogger(context.Background(), "ratelimit")
+
+	if settings.DebugPort != 0 {
+		// serves the metrics on /metrics
+		stats.StartStatsServerWithPort(stats.StartupOptions{Port: settings.DebugPort})
+	}
+
+	rlStore, err := NewStore(settings)
+	if err != nil {
+		panic(err)
+	}
+	defer rlStore.Close()
+
+	settingsClient, listProxies, err := NewConfigSources(ctx, settings)
+	if err != nil {
+		panic(err)
+	}
+
+	err = RunWithSettings(ctx, settings, rlStore, settingsClient, listProxies)
+	if err != nil {
+		if ctx.Err() == nil {
+			// not a context error - panic
+			panic(err)
+		}
+	}
+}
+
+// NewStore returns the Store of the counters configured by the settings
+func NewStore(settings Settings) (store.Store, error) {
+	switch settings.Store {
+	case MemoryStore:
+		return store.NewMemoryStore(), nil
+	case RedisStore:
+		return store.NewRedisStore(store.RedisOptions{
+			Address:  settings.RedisAddress,
+			Password: settings.RedisPassword,
+			DB:       settings.RedisDB,
+			TLS:      settings.RedisTLS,
+			PoolSize: settings.RedisPoolSize,
+		}), nil
+	default:
+		return nil, UnknownStoreError(settings.Store)
+	}
+}
+
+// NewConfigSources returns the client of the Settings, and the lister of the Proxies, configured by the settings
+func NewConfigSources(ctx context.Context, settings Settings) (gloov1.SettingsClient, ProxyLister, error) {
+	if settings.ConfigDir != "" {
+		settingsClient, err := gloov1.NewSettingsClient(ctx, &factory.FileResourceClientFactory{
+			RootDir: filepath.Join(settings.ConfigDir, gloov1.SettingsCrd.Plural),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		proxyClient, err := gloov1.NewProxyClient(ctx, &factory.FileResourceClientFactory{
+			RootDir: filepath.Join(settings.ConfigDir, gloov1.ProxyCrd.Plural),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return settingsClient, NewProxyClientLister(proxyClient), nil
+	}
+
+	cfg, err := kubeutils.GetRestConfigWithKubeContext("")
+	if err != nil {
+		return nil, nil, eris.Wrapf(err, "getting kube config")
+	}
+	settingsClient, err := gloov1.NewSettingsClient(ctx, &factory.KubeResourceClientFactory{
+		Crd:                gloov1.SettingsCrd,
+		Cfg:                cfg,
+		SharedCache:        kube.NewKubeCache(ctx),
+		NamespaceWhitelist: []string{settings.SettingsNamespace},
+	})
+	if err != nil {
+		return nil, nil, eris.Wrapf(err, "creating settings client")
+	}
+	if err := settingsClient.Register(); err != nil {
+		return nil, nil, err
+	}
+
+	cc, err := grpc.NewClient(settings.GlooAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, eris.Wrapf(err, "connecting to the proxy endpoint of gloo at %s", settings.GlooAddress)
+	}
+	go func() {
+		<-ctx.Done()
+		_ = cc.Close()
+	}()
+	return settingsClient, NewProxyEndpointLister(cc), nil
+}
+
+func RunWithSettings(
+	ctx context.Context,
+	settings Settings,
+	rlStore store.Store,
+	settingsClient gloov1.SettingsClient,
+	listProxies ProxyLister,
+) error {
+	server := service.NewServer(service.Options{Ctx: ctx, Store: rlStore})
+	// the server is not ready until it has its rules, so that it does not allow requests it should limit
+	hc := healthchecker.NewGrpc(settings.ServiceName, health.NewServer(), false, healthpb.HealthCheckResponse_NOT_SERVING)
+	var configured atomic.Bool
+
+	go func() {
+		settingsRef := &core.ResourceRef{Namespace: settings.SettingsNamespace, Name: settings.SettingsName}
+		err := WatchConfig(ctx, settingsClient, settingsRef, listProxies, settings.ProxyRefreshInterval, func(cfg *config.Config) {
+			server.SetConfig(cfg)
+			if !configured.Swap(true) {
+				contextutils.LoggerFrom(ctx).Infow("loaded rate limit rules")
+				hc.Ok()
+			}
+		})
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Errorw("failed to watch rate limit rules", zap.Error(err))
+		}
+	}()
+
+	if settings.ReadyPort != 0 {
+		go startReadyServer(ctx, settings.ReadyPort, &configured, rlStore)
+	}
+
+	return StartRateLimit(ctx, settings, server, hc.GetServer())
+}
+
+func StartRateLimit(ctx context.Context, settings Settings, server pb.RateLimitServiceServer, healthServer healthpb.HealthServer) error {
+	srv := grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+
+	pb.RegisterRateLimitServiceServer(srv, server)
+	healthpb.RegisterHealthServer(srv, healthServer)
+	reflection.Register(srv)
+
+	logger := contextutils.LoggerFrom(ctx)
+	logger.Infow("Starting rate limit server")
+
+	addr := fmt.Sprintf(":%d", settings.ServerPort)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		logger.Errorw("Failed to announce on network", zap.Any("address", addr), zap.Any("error", err))
+		return err
+	}
+	logger.Infof("rate limit server listening at [%s]", addr)
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+		_ = lis.Close()
+	}()
+
+	return srv.Serve(lis)
+}
+
+// startReadyServer serves ReadyPath, which succeeds once the server has its rules while its store is available
+func startReadyServer(ctx context.Context, port int, configured *atomic.Bool, rlStore store.Store) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ReadyPath, func(w http.ResponseWriter, r *http.Request) {
+		if !configured.Load() {
+			http.Error(w, "rate limit rules not loaded yet", http.StatusServiceUnavailable)
+			return
+		}
+		pingCtx, cancel := context.WithTimeout(r.Context(), time.Second)
+		defer cancel()
+		if err := rlStore.Ping(pingCtx); err != nil {
+			http.Error(w, "rate limit store unavailable: "+err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	srv := &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: mux}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		contextutils.LoggerFrom(ctx).Errorw("ready server failed", zap.Error(err))
+	}
+}
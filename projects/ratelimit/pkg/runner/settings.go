@@ -0,0 +1,48 @@
+package runner
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+const (
+	MemoryStore = "memory"
+	RedisStore  = "redis"
+)
+
+type Settings struct {
+	DebugPort   int    `envconfig:"DEBUG_PORT" default:"9091"`
+	ServerPort  int    `envconfig:"SERVER_PORT" default:"18081"`
+	ReadyPort   int    `envconfig:"READY_PORT" default:"18080"`
+	ServiceName string `envconfig:"SERVICE_NAME" default:"ratelimit"`
+
+	// The rate limit rules are read from the Settings resource of Gloo, and from the Proxies Gloo serves on its
+	// proxy debug endpoint, at GlooAddress
+	SettingsNamespace    string        `envconfig:"POD_NAMESPACE" default:"gloo-system"`
+	SettingsName         string        `envconfig:"SETTINGS_NAME" default:"default"`
+	GlooAddress          string        `envconfig:"GLOO_ADDRESS" default:"gloo:9966"`
+	ProxyRefreshInterval time.Duration `envconfig:"PROXY_REFRESH_INTERVAL" default:"5s"`
+	// ConfigDir is a directory both the Settings and the Proxies are read from instead, laid out as
+	// <ConfigDir>/<settings|proxies>/<namespace>/<name>.yaml
+	ConfigDir string `envconfig:"CONFIG_DIR"`
+
+	// Store is where the counters are kept: `memory`, for a single replica, or `redis`, to share them between replicas
+	Store         string `envconfig:"STORE" default:"memory"`
+	RedisAddress  string `envconfig:"REDIS_ADDRESS" default:"redis:6379"`
+	RedisPassword string `envconfig:"REDIS_PASSWORD"`
+	RedisDB       int    `envconfig:"REDIS_DB"`
+	RedisTLS      bool   `envconfig:"REDIS_TLS"`
+	RedisPoolSize int    `envconfig:"REDIS_POOL_SIZE" default:"10"`
+}
+
+func NewSettings() Settings {
+	var s Settings
+
+	err := envconfig.Process("", &s)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
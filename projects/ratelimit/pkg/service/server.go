@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"github.com/solo-io/gloo/pkg/utils/statsutils"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/config"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/store"
+	"github.com/solo-io/go-utils/contextutils"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ pb.RateLimitServiceServer = new(Server)
+
+var (
+	domainKey, _ = tag.NewKey("domain")
+	ruleKey, _   = tag.NewKey("rule")
+
+	mRequests    = statsutils.MakeSumCounter("gloo.solo.io/ratelimit/requests", "The number of rate limit requests", domainKey)
+	mOverLimit   = statsutils.MakeSumCounter("gloo.solo.io/ratelimit/over_limit", "The number of descriptors over the limit of a rule", domainKey, ruleKey)
+	mStoreErrors = statsutils.MakeSumCounter("gloo.solo.io/ratelimit/store_errors", "The number of failed increments of the counters of rules", domainKey)
+)
+
+// the length in seconds of the windows of each unit, as in the Envoy rate limit server
+var unitSeconds = map[solo_rl.RateLimit_Unit]int64{
+	solo_rl.RateLimit_SECOND: 1,
+	solo_rl.RateLimit_MINUTE: 60,
+	solo_rl.RateLimit_HOUR:   60 * 60,
+	solo_rl.RateLimit_DAY:    24 * 60 * 60,
+	solo_rl.RateLimit_WEEK:   7 * 24 * 60 * 60,
+	solo_rl.RateLimit_MONTH:  30 * 24 * 60 * 60,
+	solo_rl.RateLimit_YEAR:   365 * 24 * 60 * 60,
+}
+
+// Server implements the Envoy rate limit service. Each rule counts the hits of each set of descriptor values in
+// fixed windows of time, aligned on the unit of its limit.
+type Server struct {
+	pb.UnimplementedRateLimitServiceServer
+
+	ctx    context.Context
+	store  store.Store
+	now    func() time.Time
+	config atomic.Pointer[config.Config]
+}
+
+type Options struct {
+	Ctx   context.Context
+	Store store.Store
+	// Now is the clock of the windows; defaults to time.Now
+	Now func() time.Time
+}
+
+// NewServer returns a Server without rules; all the requests are allowed until SetConfig is called
+func NewServer(opts Options) *Server {
+	if opts.Ctx == nil {
+		opts.Ctx = context.Background()
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	s := &Server{
+		ctx:   opts.Ctx,
+		store: opts.Store,
+		now:   opts.Now,
+	}
+	s.config.Store(config.New())
+	return s
+}
+
+// SetConfig replaces the rules of the server
+func (s *Server) SetConfig(cfg *config.Config) {
+	s.config.Store(cfg)
+}
+
+func (s *Server) ShouldRateLimit(ctx context.Context, req *pb.RateLimitRequest) (*pb.RateLimitResponse, error) {
+	if req.GetDomain() == "" {
+		return nil, status.Error(codes.InvalidArgument, "rate limit domain must not be empty")
+	}
+	if len(req.GetDescriptors()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "rate limit descriptor list must not be empty")
+	}
+	domainTag := tag.Insert(domainKey, req.GetDomain())
+	statsutils.MeasureOne(ctx, mRequests, domainTag)
+
+	hits := uint64(req.GetHitsAddend())
+	if hits == 0 {
+		hits = 1
+	}
+	now := s.now()
+
+	response := &pb.RateLimitResponse{OverallCode: pb.RateLimitResponse_OK}
+	matches := s.config.Load().Match(req.GetDomain(), req.GetDescriptors())
+	for _, descriptorMatches := range matches {
+		descriptorStatus := &pb.RateLimitResponse_DescriptorStatus{Code: pb.RateLimitResponse_OK}
+		for _, match := range descriptorMatches {
+			ruleStatus, err := s.apply(ctx, match, hits, now)
+			if err != nil {
+				statsutils.MeasureOne(ctx, mStoreErrors, domainTag)
+				contextutils.LoggerFrom(s.ctx).Errorw("failed to increment rate limit counter",
+					zap.String("domain", req.GetDomain()), zap.String("rule", match.Rule.Name), zap.Error(err))
+				return nil, status.Errorf(codes.Unavailable, "incrementing rate limit counter: %v", err)
+			}
+			if ruleStatus.GetCode() == pb.RateLimitResponse_OVER_LIMIT {
+				statsutils.MeasureOne(ctx, mOverLimit, domainTag, tag.Insert(ruleKey, match.Rule.Name))
+			}
+			descriptorStatus = mostRestrictive(descriptorStatus, ruleStatus)
+		}
+		if descriptorStatus.GetCode() == pb.RateLimitResponse_OVER_LIMIT {
+			response.OverallCode = pb.RateLimitResponse_OVER_LIMIT
+		}
+		response.Statuses = append(response.GetStatuses(), descriptorStatus)
+	}
+	return response, nil
+}
+
+// apply counts the hits in the current window of the rule
+func (s *Server) apply(ctx context.Context, match *config.Match, hits uint64, now time.Time) (*pb.RateLimitResponse_DescriptorStatus, error) {
+	limit := match.Rule.Limit
+	window := unitSeconds[limit.GetUnit()]
+	windowStart := now.Unix() / window * window
+	untilReset := time.Unix(windowStart+window, 0).Sub(now)
+
+	key := match.CounterKey + "|" + strconv.FormatInt(windowStart, 10)
+	count, err := s.store.Increment(ctx, key, hits, untilReset)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleStatus := &pb.RateLimitResponse_DescriptorStatus{
+		Code: pb.RateLimitResponse_OK,
+		CurrentLimit: &pb.RateLimitResponse_RateLimit{
+			Name:            match.Rule.Name,
+			RequestsPerUnit: limit.GetRequestsPerUnit(),
+			Unit:            pb.RateLimitResponse_RateLimit_Unit(limit.GetUnit()),
+		},
+		DurationUntilReset: durationpb.New(untilReset),
+	}
+	if count > uint64(limit.GetRequestsPerUnit()) {
+		ruleStatus.Code = pb.RateLimitResponse_OVER_LIMIT
+	} else {
+		ruleStatus.LimitRemaining = limit.GetRequestsPerUnit() - uint32(count)
+	}
+	return ruleStatus, nil
+}
+
+// mostRestrictive returns the status of the rule a descriptor is the closest to exceed
+func mostRestrictive(current, candidate *pb.RateLimitResponse_DescriptorStatus) *pb.RateLimitResponse_DescriptorStatus {
+	switch {
+	case current.GetCurrentLimit() == nil:
+		return candidate
+	case current.GetCode() != candidate.GetCode():
+		if candidate.GetCode() == pb.RateLimitResponse_OVER_LIMIT {
+			return candidate
+		}
+		return current
+	case candidate.GetLimitRemaining() < current.GetLimitRemaining():
+		return candidate
+	default:
+		return current
+	}
+}
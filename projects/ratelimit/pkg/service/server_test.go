@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	envoy_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/config"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/service"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/store"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const domain = "custom"
+
+func request(hits uint32, descriptors ...*envoy_ratelimit_v3.RateLimitDescriptor) *pb.RateLimitRequest {
+	return &pb.RateLimitRequest{Domain: domain, Descriptors: descriptors, HitsAddend: hits}
+}
+
+func descriptor(key, value string) *envoy_ratelimit_v3.RateLimitDescriptor {
+	return &envoy_ratelimit_v3.RateLimitDescriptor{
+		Entries: []*envoy_ratelimit_v3.RateLimitDescriptor_Entry{{Key: key, Value: value}},
+	}
+}
+
+var _ = Describe("Server", func() {
+
+	var (
+		ctx    context.Context
+		now    time.Time
+		server *service.Server
+	)
+
+	clock := func() time.Time {
+		return now
+	}
+
+	newServer := func(rlStore store.Store) {
+		server = service.NewServer(service.Options{Ctx: ctx, Store: rlStore, Now: clock})
+		cfg := config.New()
+		err := cfg.AddDescriptors(domain, []*solo_rl.Descriptor{
+			{Key: "user", RateLimit: &solo_rl.RateLimit{RequestsPerUnit: 2, Unit: solo_rl.RateLimit_MINUTE}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		err = cfg.AddSetDescriptors(domain, []*solo_rl.SetDescriptor{
+			{
+				SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "user"}},
+				RateLimit:         &solo_rl.RateLimit{RequestsPerUnit: 5, Unit: solo_rl.RateLimit_SECOND},
+			},
+			{
+				SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "user"}},
+				RateLimit:         &solo_rl.RateLimit{RequestsPerUnit: 2, Unit: solo_rl.RateLimit_HOUR},
+				AlwaysApply:       true,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		server.SetConfig(cfg)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		// 10 seconds into a minute
+		now = time.Unix(1700000000/60*60+10, 0)
+	})
+
+	// the behavior is the same whichever store keeps the counters
+	behavesLikeARateLimitServer := func() {
+
+		It("limits the requests of each descriptor value in each window", func() {
+			resp, err := server.ShouldRateLimit(ctx, request(0, descriptor("user", "alice")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+			Expect(resp.GetStatuses()).To(HaveLen(1))
+			Expect(resp.GetStatuses()[0].GetCurrentLimit().GetName()).To(Equal("user"))
+			Expect(resp.GetStatuses()[0].GetCurrentLimit().GetUnit()).To(Equal(pb.RateLimitResponse_RateLimit_MINUTE))
+			Expect(resp.GetStatuses()[0].GetLimitRemaining()).To(Equal(uint32(1)))
+			Expect(resp.GetStatuses()[0].GetDurationUntilReset().AsDuration()).To(Equal(50 * time.Second))
+
+			resp, err = server.ShouldRateLimit(ctx, request(0, descriptor("user", "alice")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+			Expect(resp.GetStatuses()[0].GetLimitRemaining()).To(Equal(uint32(0)))
+
+			resp, err = server.ShouldRateLimit(ctx, request(0, descriptor("user", "alice"), descriptor("user", "bob")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OVER_LIMIT))
+			Expect(resp.GetStatuses()[0].GetCode()).To(Equal(pb.RateLimitResponse_OVER_LIMIT))
+			Expect(resp.GetStatuses()[1].GetCode()).To(Equal(pb.RateLimitResponse_OK))
+
+			now = now.Add(50 * time.Second)
+			resp, err = server.ShouldRateLimit(ctx, request(0, descriptor("user", "alice")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+		})
+
+		It("counts the hits addend of the request", func() {
+			resp, err := server.ShouldRateLimit(ctx, request(3, descriptor("user", "alice")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OVER_LIMIT))
+		})
+
+		It("reports the most restrictive of the rules of a descriptor", func() {
+			setDescriptor := &envoy_ratelimit_v3.RateLimitDescriptor{
+				Entries: []*envoy_ratelimit_v3.RateLimitDescriptor_Entry{
+					{Key: "generic_key", Value: ratelimit.SetDescriptorValue},
+					{Key: "user", Value: "alice"},
+				},
+			}
+			resp, err := server.ShouldRateLimit(ctx, request(0, setDescriptor))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetStatuses()[0].GetCurrentLimit().GetName()).To(Equal("set[1]"))
+			Expect(resp.GetStatuses()[0].GetLimitRemaining()).To(Equal(uint32(1)))
+		})
+
+		It("allows descriptors without rules", func() {
+			resp, err := server.ShouldRateLimit(ctx, request(0, descriptor("other", "value")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+			Expect(resp.GetStatuses()[0].GetCurrentLimit()).To(BeNil())
+		})
+	}
+
+	Context("memory store", func() {
+
+		BeforeEach(func() {
+			newServer(store.NewMemoryStoreWithClock(clock))
+		})
+
+		behavesLikeARateLimitServer()
+
+		It("rejects invalid requests", func() {
+			_, err := server.ShouldRateLimit(ctx, &pb.RateLimitRequest{Descriptors: []*envoy_ratelimit_v3.RateLimitDescriptor{descriptor("user", "alice")}})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			_, err = server.ShouldRateLimit(ctx, &pb.RateLimitRequest{Domain: domain})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("allows all the requests until it has rules", func() {
+			server = service.NewServer(service.Options{Ctx: ctx, Store: store.NewMemoryStoreWithClock(clock), Now: clock})
+			for i := 0; i < 3; i++ {
+				resp, err := server.ShouldRateLimit(ctx, request(0, descriptor("user", "alice")))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+			}
+		})
+	})
+
+	Context("redis store", func() {
+
+		var redisServer *miniredis.Miniredis
+
+		BeforeEach(func() {
+			redisServer = miniredis.RunT(GinkgoT())
+
+			rlStore := store.NewRedisStore(store.RedisOptions{Address: redisServer.Addr(), DialTimeout: 100 * time.Millisecond})
+			DeferCleanup(rlStore.Close)
+			newServer(rlStore)
+		})
+
+		behavesLikeARateLimitServer()
+
+		It("is unavailable when the store is", func() {
+			redisServer.Close()
+
+			_, err := server.ShouldRateLimit(ctx, request(0, descriptor("user", "alice")))
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+	})
+})
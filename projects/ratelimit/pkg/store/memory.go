@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ Store = new(memoryStore)
+
+// expired counters are removed at most once per sweepInterval
+const sweepInterval = time.Minute
+
+type memoryStore struct {
+	now func() time.Time
+
+	lock      sync.Mutex
+	counters  map[string]*counter
+	lastSweep time.Time
+}
+
+type counter struct {
+	value     uint64
+	expiresAt time.Time
+}
+
+// NewMemoryStore returns a Store which keeps the counters in memory. The counters are not shared, so it is only
+// suitable for a single replica of the rate limit server.
+func NewMemoryStore() Store {
+	return NewMemoryStoreWithClock(time.Now)
+}
+
+// NewMemoryStoreWithClock returns a memory Store which uses the given clock to expire its counters
+func NewMemoryStoreWithClock(now func() time.Time) Store {
+	return &memoryStore{
+		now:       now,
+		counters:  map[string]*counter{},
+		lastSweep: now(),
+	}
+}
+
+func (m *memoryStore) Increment(_ context.Context, key string, hits uint64, ttl time.Duration) (uint64, error) {
+	now := m.now()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if now.Sub(m.lastSweep) >= sweepInterval {
+		m.sweep(now)
+	}
+
+	c, ok := m.counters[key]
+	if !ok || !now.Before(c.expiresAt) {
+		c = &counter{expiresAt: now.Add(ttl)}
+		m.counters[key] = c
+	}
+	c.value += hits
+	return c.value, nil
+}
+
+func (m *memoryStore) sweep(now time.Time) {
+	for key, c := range m.counters {
+		if !now.Before(c.expiresAt) {
+			delete(m.counters, key)
+		}
+	}
+	m.lastSweep = now
+}
+
+func (m *memoryStore) Ping(_ context.Context) error {
+	return nil
+}
+
+func (m *memoryStore) Close() error {
+	return nil
+}
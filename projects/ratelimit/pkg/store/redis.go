@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rotisserie/eris"
+)
+
+var _ Store = new(redisStore)
+
+var (
+	NegativeCounterError = func(key string, value int64) error {
+		return eris.Errorf("redis counter %s has a negative value %d", key, value)
+	}
+)
+
+// RedisOptions configure the connections to a Redis server
+type RedisOptions struct {
+	Address  string
+	Password string
+	DB       int
+	// TLS enables TLS on the connections, verifying the certificate of the server
+	TLS bool
+	// PoolSize is the maximum number of connections, which defaults to 10 per CPU
+	PoolSize    int
+	DialTimeout time.Duration
+	// IoTimeout bounds the reads and writes of each command
+	IoTimeout time.Duration
+}
+
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore returns a Store which keeps the counters in a Redis server, so that they are shared by all the
+// replicas of the rate limit server. A counter is incremented with INCRBY, and expired with PEXPIRE, in a single
+// round trip.
+func NewRedisStore(opts RedisOptions) Store {
+	redisOpts := &redis.Options{
+		Addr:         opts.Address,
+		Password:     opts.Password,
+		DB:           opts.DB,
+		PoolSize:     opts.PoolSize,
+		DialTimeout:  opts.DialTimeout,
+		ReadTimeout:  opts.IoTimeout,
+		WriteTimeout: opts.IoTimeout,
+		// a command which failed on a broken connection may have been applied, and a hit must not be counted twice
+		MaxRetries: -1,
+	}
+	if opts.TLS {
+		host, _, _ := net.SplitHostPort(opts.Address)
+		redisOpts.TLSConfig = &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+	}
+	return &redisStore{client: redis.NewClient(redisOpts)}
+}
+
+func (s *redisStore) Increment(ctx context.Context, key string, hits uint64, ttl time.Duration) (uint64, error) {
+	var incr *redis.IntCmd
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.IncrBy(ctx, key, int64(hits))
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, eris.Wrapf(err, "incrementing redis counter %s", key)
+	}
+	value := incr.Val()
+	if value < 0 {
+		return 0, NegativeCounterError(key, value)
+	}
+	return uint64(value), nil
+}
+
+func (s *redisStore) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+func (s *redisStore) Close() error {
+	return s.client.Close()
+}
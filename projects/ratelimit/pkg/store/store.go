@@ -0,0 +1,16 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// Store keeps the counters of the rate limit rules
+type Store interface {
+	// Increment adds hits to the counter with the given key and returns its new value.
+	// A counter which does not exist starts at zero, and expires after the given ttl.
+	Increment(ctx context.Context, key string, hits uint64, ttl time.Duration) (uint64, error)
+	// Ping returns an error when the store is unavailable
+	Ping(ctx context.Context) error
+	Close() error
+}
@@ -0,0 +1,126 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/store"
+)
+
+var _ = Describe("Store", func() {
+
+	var (
+		ctx context.Context
+		now time.Time
+	)
+
+	clock := func() time.Time {
+		return now
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Unix(1700000000, 0)
+	})
+
+	Context("memory", func() {
+
+		var s store.Store
+
+		BeforeEach(func() {
+			s = store.NewMemoryStoreWithClock(clock)
+		})
+
+		It("increments the counters until they expire", func() {
+			Expect(s.Increment(ctx, "a", 1, time.Second)).To(Equal(uint64(1)))
+			Expect(s.Increment(ctx, "a", 2, time.Second)).To(Equal(uint64(3)))
+			Expect(s.Increment(ctx, "b", 1, time.Second)).To(Equal(uint64(1)))
+
+			now = now.Add(time.Second)
+			Expect(s.Increment(ctx, "a", 1, time.Second)).To(Equal(uint64(1)))
+		})
+
+		It("is always available", func() {
+			Expect(s.Ping(ctx)).To(Succeed())
+			Expect(s.Close()).To(Succeed())
+		})
+	})
+
+	Context("redis", func() {
+
+		var (
+			server *miniredis.Miniredis
+			s      store.Store
+		)
+
+		BeforeEach(func() {
+			server = miniredis.RunT(GinkgoT())
+		})
+
+		newStore := func(opts store.RedisOptions) {
+			opts.Address = server.Addr()
+			s = store.NewRedisStore(opts)
+			DeferCleanup(s.Close)
+		}
+
+		It("increments the counters and sets their expiry", func() {
+			newStore(store.RedisOptions{})
+
+			Expect(s.Increment(ctx, "a", 1, time.Minute)).To(Equal(uint64(1)))
+			Expect(s.Increment(ctx, "a", 4, 30*time.Second)).To(Equal(uint64(5)))
+
+			Expect(server.Get("a")).To(Equal("5"))
+			Expect(server.TTL("a")).To(Equal(30 * time.Second))
+
+			server.FastForward(30 * time.Second)
+			Expect(s.Increment(ctx, "a", 1, time.Minute)).To(Equal(uint64(1)))
+		})
+
+		It("authenticates and selects the database", func() {
+			server.RequireAuth("secret")
+			newStore(store.RedisOptions{Password: "secret", DB: 2})
+
+			Expect(s.Ping(ctx)).To(Succeed())
+			Expect(s.Increment(ctx, "a", 1, time.Minute)).To(Equal(uint64(1)))
+			Expect(server.DB(2).Get("a")).To(Equal("1"))
+			Expect(server.Exists("a")).To(BeFalse())
+
+			// the connection is reused
+			Expect(server.TotalConnectionCount()).To(Equal(1))
+		})
+
+		It("fails when the password is wrong", func() {
+			server.RequireAuth("secret")
+			newStore(store.RedisOptions{Password: "wrong"})
+
+			Expect(s.Ping(ctx)).NotTo(Succeed())
+			_, err := s.Increment(ctx, "a", 1, time.Minute)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("reconnects after the connections are closed by the server", func() {
+			newStore(store.RedisOptions{})
+
+			Expect(s.Increment(ctx, "a", 1, time.Minute)).To(Equal(uint64(1)))
+			server.Close()
+			Expect(server.Restart()).To(Succeed())
+
+			// the request in flight on the broken connection fails, as it is not safe to increment twice
+			Eventually(func() (uint64, error) {
+				return s.Increment(ctx, "a", 1, time.Minute)
+			}).Should(BeNumerically(">=", 2))
+		})
+
+		It("fails when the server is unavailable", func() {
+			newStore(store.RedisOptions{DialTimeout: 100 * time.Millisecond})
+			server.Close()
+
+			Expect(s.Ping(ctx)).NotTo(Succeed())
+			_, err := s.Increment(ctx, "a", 1, time.Minute)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"github.com/fsnotify/fsnotify"
 	"go.uber.org/zap"
 
+	sdssecrets "github.com/solo-io/gloo/projects/sds/pkg/secrets"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
 	"github.com/solo-io/go-utils/contextutils"
 )
@@ -18,7 +19,20 @@ import (
 // certs from disk, so writers (e.g. Istio) can finish updating key and cert files.
 const sdsUpdateDebounce = 500 * time.Millisecond
 
+// Run serves the given secrets until the context is cancelled or a termination signal is received
 func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddress string) error {
+	return RunWithSources(ctx, sdssecrets.Sources{Static: secrets}, sdsClient, sdsServerAddress)
+}
+
+// RunWithSources serves the secrets of the given sources until the context is cancelled or a
+// termination signal is received. Secrets added to or removed from a dynamic source are served
+// without a restart.
+func RunWithSources(ctx context.Context, sources sdssecrets.Sources, sdsClient, sdsServerAddress string) error {
+	secrets, err := sources.Load()
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 
 	// Set up the gRPC server
@@ -30,11 +44,9 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 		return err
 	}
 
-	// Initialize the SDS config
-	err = sdsServer.UpdateSDSConfig(ctx)
-	if err != nil {
-		cancel()
-		return err
+	// Initialize the SDS config. A secret which cannot be read is skipped so that the others are served.
+	if err := sdsServer.UpdateSDSConfig(ctx); err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("failed to serve some SDS secrets", zap.Error(err))
 	}
 
 	// create a new file watcher
@@ -54,16 +66,21 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 	// goroutine, otherwise the two calls may race when adding
 	// watches to `watcher`
 	watchFiles(ctx, watcher, secrets)
+	watchDirs(ctx, watcher, sources.WatchPaths())
 
 	go func() {
 		runWatcherLoop(ctx, watcher, func(ctx context.Context) {
+			if sources.Dynamic() {
+				reloadSecrets(ctx, sdsServer, sources)
+			}
 			if err := sdsServer.UpdateSDSConfig(ctx); err != nil {
 				contextutils.LoggerFrom(ctx).Warnw("failed to update SDS config after cert file change", zap.Error(err))
 			}
 			if ctx.Err() != nil {
 				return
 			}
-			watchFiles(ctx, watcher, secrets)
+			watchFiles(ctx, watcher, sdsServer.Secrets())
+			watchDirs(ctx, watcher, sources.WatchPaths())
 		})
 	}()
 
@@ -124,17 +141,34 @@ func stopAndDrainTimer(timer *time.Timer) {
 	}
 }
 
+// reloadSecrets replaces the secrets served by the server with the secrets of the sources.
+// The served secrets are kept if the sources cannot be loaded, e.g. while a config file is being written.
+func reloadSecrets(ctx context.Context, sdsServer *server.Server, sources sdssecrets.Sources) {
+	secrets, err := sources.Load()
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("failed to reload secrets, serving the previous secrets", zap.Error(err))
+		return
+	}
+	contextutils.LoggerFrom(ctx).Infow("reloaded secrets", zap.Int("secrets", len(secrets)))
+	sdsServer.SetSecrets(secrets)
+}
+
 func watchFiles(ctx context.Context, watcher *fsnotify.Watcher, secrets []server.Secret) {
 	for _, s := range secrets {
 		contextutils.LoggerFrom(ctx).Infow("watcher started", zap.String("sslKeyFile", s.SslKeyFile), zap.String("sshCertFile", s.SslCertFile), zap.String("sslCaFile", s.SslCaFile))
-		if err := watcher.Add(s.SslKeyFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
-		}
-		if err := watcher.Add(s.SslCertFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
+		for _, file := range s.Files() {
+			if err := watcher.Add(file); err != nil {
+				contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
+			}
 		}
-		if err := watcher.Add(s.SslCaFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
+	}
+}
+
+// watchDirs watches the directories of the dynamic sources, to be notified of secrets being added or removed
+func watchDirs(ctx context.Context, watcher *fsnotify.Watcher, dirs []string) {
+	for _, dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			contextutils.LoggerFrom(ctx).Warnw("failed to watch directory", zap.String("dir", dir), zap.Error(err))
 		}
 	}
 }
@@ -11,6 +11,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/solo-io/gloo/pkg/version"
+	sdssecrets "github.com/solo-io/gloo/projects/sds/pkg/secrets"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/stats"
@@ -38,6 +39,11 @@ type Config struct {
 	IstioCertDir           string `split_words:"true" default:"/etc/istio-certs/"`
 	IstioServerCert        string `split_words:"true" default:"istio_server_cert"`
 	IstioValidationContext string `split_words:"true" default:"istio_validation_context"`
+
+	// SecretsDir holds one directory per named secret, see secrets.LoadDir
+	SecretsDir string `split_words:"true"`
+	// SecretsConfigFile lists named secrets, see secrets.LoadConfigFile
+	SecretsConfigFile string `split_words:"true"`
 }
 
 func RunMain() {
@@ -53,6 +59,8 @@ func RunMain() {
 		"config loaded",
 		zap.Bool("glooMtlsSdsEnabled", c.GlooMtlsSdsEnabled),
 		zap.Bool("istioMtlsSdsEnabled", c.IstioMtlsSdsEnabled),
+		zap.String("secretsDir", c.SecretsDir),
+		zap.String("secretsConfigFile", c.SecretsConfigFile),
 	)
 
 	secrets := []server.Secret{}
@@ -86,10 +94,18 @@ func RunMain() {
 			contextutils.LoggerFrom(ctx).Fatal(err)
 		}
 	}
+	sources := sdssecrets.Sources{
+		Static:     secrets,
+		Dir:        c.SecretsDir,
+		ConfigFile: c.SecretsConfigFile,
+	}
+	if err := checkFilesExist(nonEmpty(c.SecretsDir, c.SecretsConfigFile)); err != nil {
+		contextutils.LoggerFrom(ctx).Fatal(err)
+	}
 
 	contextutils.LoggerFrom(ctx).Info("secrets confirmed present, proceeding to start SDS server")
 
-	if err := Run(ctx, secrets, c.SdsClient, c.SdsServerAddress); err != nil {
+	if err := RunWithSources(ctx, sources, c.SdsClient, c.SdsServerAddress); err != nil {
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
 }
@@ -107,8 +123,8 @@ func setup(ctx context.Context) Config {
 	}
 
 	// At least one must be enabled, otherwise we have nothing to do.
-	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled {
-		err := fmt.Errorf("at least one of Istio Cert rotation or Gloo Cert rotation must be enabled, using env vars GLOO_MTLS_SDS_ENABLED or ISTIO_MTLS_SDS_ENABLED")
+	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled && c.SecretsDir == "" && c.SecretsConfigFile == "" {
+		err := fmt.Errorf("at least one of Istio Cert rotation, Gloo Cert rotation or named secrets must be enabled, using env vars GLOO_MTLS_SDS_ENABLED, ISTIO_MTLS_SDS_ENABLED, SECRETS_DIR or SECRETS_CONFIG_FILE")
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
 	return c
@@ -135,6 +151,16 @@ func checkFilesExist(filePaths []string) error {
 	return nil
 }
 
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 // fileExists checks to see if a file exists
 func fileExists(filePath string) bool {
 	err := retry.Do(
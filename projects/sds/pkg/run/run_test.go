@@ -2,14 +2,23 @@ package run_test
 
 import (
 	"context"
+	"net"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_service_secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 	"github.com/solo-io/gloo/projects/sds/pkg/run"
+	"github.com/solo-io/gloo/projects/sds/pkg/secrets"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
 	"github.com/solo-io/gloo/projects/sds/pkg/testutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func TestRun_returnsWhenContextCanceled(t *testing.T) {
@@ -50,6 +59,98 @@ func TestRun_returnsWhenContextCanceled(t *testing.T) {
 	}
 }
 
+func TestRunWithSources_servesSecretsAddedAndRemovedFromDir(t *testing.T) {
+	dir := t.TempDir()
+	writeSecretDir(t, filepath.Join(dir, "gateway"), testutils.MustSelfSignedPEM)
+
+	address := freeAddress(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- run.RunWithSources(ctx, secrets.Sources{Dir: dir}, "test-client", address)
+	}()
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := envoy_service_secret_v3.NewSecretDiscoveryServiceClient(conn)
+
+	waitForSecrets(t, ctx, client, "gateway", "gateway-validation-context")
+
+	writeSecretDir(t, filepath.Join(dir, "mesh"), testutils.MustSelfSignedPEMRotation1)
+	waitForSecrets(t, ctx, client, "gateway", "gateway-validation-context", "mesh", "mesh-validation-context")
+
+	if err := os.RemoveAll(filepath.Join(dir, "gateway")); err != nil {
+		t.Fatal(err)
+	}
+	waitForSecrets(t, ctx, client, "mesh", "mesh-validation-context")
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run returned unexpected error after context cancel: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after context cancellation")
+	}
+}
+
+func writeSecretDir(t *testing.T, dir string, pems func() ([]byte, []byte, []byte)) {
+	t.Helper()
+	keyPEM, certPEM, caPEM := pems()
+	// write to a temporary directory first, so that the secret is added atomically
+	tmpDir := filepath.Join(filepath.Dir(dir), ".tmp-"+filepath.Base(dir))
+	if err := os.Mkdir(tmpDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(tmpDir, "tls.key"), keyPEM)
+	writeFile(t, filepath.Join(tmpDir, "tls.crt"), certPEM)
+	writeFile(t, filepath.Join(tmpDir, "ca.crt"), caPEM)
+	if err := os.Rename(tmpDir, dir); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func waitForSecrets(t *testing.T, ctx context.Context, client envoy_service_secret_v3.SecretDiscoveryServiceClient, names ...string) {
+	t.Helper()
+	sort.Strings(names)
+	var got []string
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		got = nil
+		resp, err := client.FetchSecrets(ctx, &envoy_service_discovery_v3.DiscoveryRequest{})
+		if err == nil {
+			for _, resource := range resp.GetResources() {
+				secret := &envoy_extensions_transport_sockets_tls_v3.Secret{}
+				if err := resource.UnmarshalTo(secret); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, secret.GetName())
+			}
+			sort.Strings(got)
+			if reflect.DeepEqual(got, names) {
+				return
+			}
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+	t.Fatalf("expected secrets %v, got %v", names, got)
+}
+
+func freeAddress(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().String()
+}
+
 func writeFile(t *testing.T, path string, contents []byte) {
 	t.Helper()
 	if err := os.WriteFile(path, contents, 0o600); err != nil {
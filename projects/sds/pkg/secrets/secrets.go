@@ -0,0 +1,270 @@
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+)
+
+const (
+	// OcspStapleKey is the file of a secret directory holding the OCSP staple of its certificate.
+	OcspStapleKey = "tls.ocsp-staple"
+	// TrustDomainsDir is the directory of a secret directory holding one trust bundle file per SPIFFE trust domain.
+	TrustDomainsDir = "trust-domains"
+	// ValidationContextSuffix is appended to the name of a secret directory to name its validation context.
+	ValidationContextSuffix = "-validation-context"
+)
+
+// Sources are the places the SDS server reads the secrets to serve from.
+type Sources struct {
+	// Static secrets, configured on the command line.
+	Static []server.Secret
+	// Dir holds one directory per secret, laid out as described by LoadDir.
+	Dir string
+	// ConfigFile lists the secrets to serve, in the format described by LoadConfigFile.
+	ConfigFile string
+}
+
+// Dynamic returns true if the set of secrets can change while the SDS server runs.
+func (s Sources) Dynamic() bool {
+	return s.Dir != "" || s.ConfigFile != ""
+}
+
+// Load returns the secrets of every source. The names of the served secrets must be unique across sources.
+func (s Sources) Load() ([]server.Secret, error) {
+	secrets := append([]server.Secret{}, s.Static...)
+	if s.Dir != "" {
+		dirSecrets, err := LoadDir(s.Dir)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, dirSecrets...)
+	}
+	if s.ConfigFile != "" {
+		fileSecrets, err := LoadConfigFile(s.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, fileSecrets...)
+	}
+
+	names := map[string]bool{}
+	for _, sec := range secrets {
+		for _, name := range []string{sec.ServerCert, sec.ValidationContext} {
+			if name == "" {
+				continue
+			}
+			if names[name] {
+				return nil, fmt.Errorf("secret %q is configured more than once", name)
+			}
+			names[name] = true
+		}
+	}
+	return secrets, nil
+}
+
+// WatchPaths returns the directories to watch for secrets being added or removed.
+// Directories are watched rather than files, as Kubernetes updates mounted volumes by swapping a symlink.
+func (s Sources) WatchPaths() []string {
+	var paths []string
+	if s.Dir != "" {
+		paths = append(paths, s.Dir)
+		entries, _ := os.ReadDir(s.Dir)
+		for _, entry := range entries {
+			path := filepath.Join(s.Dir, entry.Name())
+			if !isHidden(entry.Name()) && isDir(path) {
+				paths = append(paths, path)
+				if isDir(filepath.Join(path, TrustDomainsDir)) {
+					paths = append(paths, filepath.Join(path, TrustDomainsDir))
+				}
+			}
+		}
+	}
+	if s.ConfigFile != "" {
+		paths = append(paths, filepath.Dir(s.ConfigFile))
+	}
+	return paths
+}
+
+// LoadDir returns a secret for every directory in dir. Every directory is named after its secret,
+// and holds the files of a Kubernetes TLS secret:
+//
+//   - tls.crt and tls.key: served as a TLS certificate named after the directory.
+//   - tls.ocsp-staple: optional OCSP staple of the certificate.
+//   - ca.crt: served as a validation context named after the directory, with the -validation-context suffix.
+//   - trust-domains/<trust domain>.crt: trust bundles of a SPIFFE validation context, served instead of ca.crt.
+//
+// A directory only needs the files of the secrets it serves, e.g. only ca.crt for a validation context.
+// Directories without any of these files are ignored, so that secrets can be added by creating their files.
+func LoadDir(dir string) ([]server.Secret, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading secrets directory %q: %w", dir, err)
+	}
+	var secrets []server.Secret
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		// skip the ..data directories of Kubernetes volumes
+		if isHidden(entry.Name()) || !isDir(path) {
+			continue
+		}
+		sec, err := loadSecretDir(entry.Name(), path)
+		if err != nil {
+			return nil, err
+		}
+		if sec.ServerCert != "" || sec.ValidationContext != "" {
+			secrets = append(secrets, sec)
+		}
+	}
+	return secrets, nil
+}
+
+func loadSecretDir(name, path string) (server.Secret, error) {
+	sec := server.Secret{}
+	certFile := filepath.Join(path, corev1.TLSCertKey)
+	keyFile := filepath.Join(path, corev1.TLSPrivateKeyKey)
+	if fileExists(certFile) && fileExists(keyFile) {
+		sec.ServerCert = name
+		sec.SslCertFile = certFile
+		sec.SslKeyFile = keyFile
+		if ocspFile := filepath.Join(path, OcspStapleKey); fileExists(ocspFile) {
+			sec.SslOcspFile = ocspFile
+		}
+	}
+
+	trustDomains, err := loadTrustDomains(filepath.Join(path, TrustDomainsDir))
+	if err != nil {
+		return server.Secret{}, err
+	}
+	caFile := filepath.Join(path, corev1.ServiceAccountRootCAKey)
+	switch {
+	case len(trustDomains) > 0:
+		sec.ValidationContext = name + ValidationContextSuffix
+		sec.TrustDomains = trustDomains
+	case fileExists(caFile):
+		sec.ValidationContext = name + ValidationContextSuffix
+		sec.SslCaFile = caFile
+	}
+	return sec, nil
+}
+
+func loadTrustDomains(dir string) ([]server.TrustDomain, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading trust domains directory %q: %w", dir, err)
+	}
+	var trustDomains []server.TrustDomain
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if isHidden(entry.Name()) || filepath.Ext(entry.Name()) != ".crt" || !fileExists(path) {
+			continue
+		}
+		trustDomains = append(trustDomains, server.TrustDomain{
+			Name:       strings.TrimSuffix(entry.Name(), ".crt"),
+			BundleFile: path,
+		})
+	}
+	return trustDomains, nil
+}
+
+// ConfigFile lists the secrets to serve.
+type ConfigFile struct {
+	Secrets []SecretConfig `json:"secrets"`
+}
+
+// SecretConfig configures a TLS certificate, a validation context, or both.
+type SecretConfig struct {
+	// Name of the TLS certificate secret. Requires CertFile and KeyFile.
+	Name     string `json:"name,omitempty"`
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	OcspFile string `json:"ocspFile,omitempty"`
+
+	// ValidationContext is the name of the validation context secret. Requires CaFile or TrustDomains.
+	ValidationContext string `json:"validationContext,omitempty"`
+	CaFile            string `json:"caFile,omitempty"`
+	// TrustDomains maps SPIFFE trust domains to the file holding their trust bundle.
+	TrustDomains map[string]string `json:"trustDomains,omitempty"`
+}
+
+// LoadConfigFile returns the secrets listed in a YAML config file, e.g.
+//
+//	secrets:
+//	- name: server_cert
+//	  certFile: /etc/certs/tls.crt
+//	  keyFile: /etc/certs/tls.key
+//	- validationContext: spiffe_validation_context
+//	  trustDomains:
+//	    cluster.local: /etc/bundles/cluster.local.pem
+//	    partner.example.com: /etc/bundles/partner.pem
+func LoadConfigFile(path string) ([]server.Secret, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading secrets config file %q: %w", path, err)
+	}
+	var cfg ConfigFile
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing secrets config file %q: %w", path, err)
+	}
+	secrets := make([]server.Secret, 0, len(cfg.Secrets))
+	for i, secretCfg := range cfg.Secrets {
+		sec, err := secretCfg.toSecret()
+		if err != nil {
+			return nil, fmt.Errorf("secret %d of %q: %w", i, path, err)
+		}
+		secrets = append(secrets, sec)
+	}
+	return secrets, nil
+}
+
+func (c SecretConfig) toSecret() (server.Secret, error) {
+	if c.Name == "" && c.ValidationContext == "" {
+		return server.Secret{}, fmt.Errorf("one of name and validationContext must be set")
+	}
+	if c.Name != "" && (c.CertFile == "" || c.KeyFile == "") {
+		return server.Secret{}, fmt.Errorf("certFile and keyFile must be set for %q", c.Name)
+	}
+	if c.ValidationContext != "" && (c.CaFile == "") == (len(c.TrustDomains) == 0) {
+		return server.Secret{}, fmt.Errorf("exactly one of caFile and trustDomains must be set for %q", c.ValidationContext)
+	}
+	sec := server.Secret{
+		ServerCert:        c.Name,
+		SslCertFile:       c.CertFile,
+		SslKeyFile:        c.KeyFile,
+		SslOcspFile:       c.OcspFile,
+		ValidationContext: c.ValidationContext,
+		SslCaFile:         c.CaFile,
+	}
+	for name, bundleFile := range c.TrustDomains {
+		sec.TrustDomains = append(sec.TrustDomains, server.TrustDomain{Name: name, BundleFile: bundleFile})
+	}
+	// sort for a stable snapshot version
+	sort.Slice(sec.TrustDomains, func(i, j int) bool {
+		return sec.TrustDomains[i].Name < sec.TrustDomains[j].Name
+	})
+	return sec, nil
+}
+
+func isHidden(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
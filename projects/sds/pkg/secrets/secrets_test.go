@@ -0,0 +1,169 @@
+package secrets_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/solo-io/gloo/projects/sds/pkg/secrets"
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+)
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir,
+		"gateway/tls.crt", "gateway/tls.key", "gateway/tls.ocsp-staple", "gateway/ca.crt",
+		"client/tls.crt", "client/tls.key",
+		"mesh/trust-domains/cluster.local.crt", "mesh/trust-domains/partner.example.com.crt", "mesh/trust-domains/README",
+		"empty/README",
+		"..data/tls.crt",
+	)
+
+	got, err := secrets.LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []server.Secret{
+		{
+			ServerCert:  "client",
+			SslCertFile: filepath.Join(dir, "client/tls.crt"),
+			SslKeyFile:  filepath.Join(dir, "client/tls.key"),
+		},
+		{
+			ServerCert:        "gateway",
+			SslCertFile:       filepath.Join(dir, "gateway/tls.crt"),
+			SslKeyFile:        filepath.Join(dir, "gateway/tls.key"),
+			SslOcspFile:       filepath.Join(dir, "gateway/tls.ocsp-staple"),
+			ValidationContext: "gateway-validation-context",
+			SslCaFile:         filepath.Join(dir, "gateway/ca.crt"),
+		},
+		{
+			ValidationContext: "mesh-validation-context",
+			TrustDomains: []server.TrustDomain{
+				{Name: "cluster.local", BundleFile: filepath.Join(dir, "mesh/trust-domains/cluster.local.crt")},
+				{Name: "partner.example.com", BundleFile: filepath.Join(dir, "mesh/trust-domains/partner.example.com.crt")},
+			},
+		},
+	}
+	assertSecrets(t, got, want)
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "secrets.yaml")
+	writeFile(t, configFile, `
+secrets:
+- name: server_cert
+  certFile: /certs/tls.crt
+  keyFile: /certs/tls.key
+  validationContext: validation_context
+  caFile: /certs/ca.crt
+- validationContext: spiffe_validation_context
+  trustDomains:
+    partner.example.com: /bundles/partner.pem
+    cluster.local: /bundles/cluster.local.pem
+`)
+
+	got, err := secrets.LoadConfigFile(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []server.Secret{
+		{
+			ServerCert:        "server_cert",
+			SslCertFile:       "/certs/tls.crt",
+			SslKeyFile:        "/certs/tls.key",
+			ValidationContext: "validation_context",
+			SslCaFile:         "/certs/ca.crt",
+		},
+		{
+			ValidationContext: "spiffe_validation_context",
+			TrustDomains: []server.TrustDomain{
+				{Name: "cluster.local", BundleFile: "/bundles/cluster.local.pem"},
+				{Name: "partner.example.com", BundleFile: "/bundles/partner.pem"},
+			},
+		},
+	}
+	assertSecrets(t, got, want)
+}
+
+func TestLoadConfigFile_invalid(t *testing.T) {
+	for name, config := range map[string]string{
+		"unknown field":           "secrets:\n- name: a\n  certFile: a\n  keyFile: a\n  unknown: a\n",
+		"no name":                 "secrets:\n- certFile: a\n  keyFile: a\n",
+		"missing key":             "secrets:\n- name: a\n  certFile: a\n",
+		"missing ca":              "secrets:\n- validationContext: a\n",
+		"ca and trust domains":    "secrets:\n- validationContext: a\n  caFile: a\n  trustDomains:\n    cluster.local: a\n",
+		"not a list of secrets":   "secrets: a\n",
+		"validation without name": "secrets:\n- caFile: a\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			configFile := filepath.Join(t.TempDir(), "secrets.yaml")
+			writeFile(t, configFile, config)
+			if _, err := secrets.LoadConfigFile(configFile); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestSourcesLoad_duplicateNames(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, "server_cert/tls.crt", "server_cert/tls.key")
+
+	sources := secrets.Sources{
+		Static: []server.Secret{{ServerCert: "server_cert", ValidationContext: "validation_context"}},
+		Dir:    dir,
+	}
+	_, err := sources.Load()
+	if err == nil || !strings.Contains(err.Error(), `"server_cert"`) {
+		t.Fatalf("expected a duplicate secret error, got %v", err)
+	}
+}
+
+func TestSourcesWatchPaths(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, "gateway/tls.crt", "mesh/trust-domains/cluster.local.crt", "..data/tls.crt")
+
+	sources := secrets.Sources{
+		Dir:        dir,
+		ConfigFile: "/etc/sds/secrets.yaml",
+	}
+	got := sources.WatchPaths()
+	want := []string{
+		dir,
+		filepath.Join(dir, "gateway"),
+		filepath.Join(dir, "mesh"),
+		filepath.Join(dir, "mesh/trust-domains"),
+		"/etc/sds",
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected watch paths %v, got %v", want, got)
+	}
+}
+
+func assertSecrets(t *testing.T, got, want []server.Secret) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected secrets %+v, got %+v", want, got)
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files ...string) {
+	t.Helper()
+	for _, file := range files {
+		writeFile(t, filepath.Join(dir, file), "")
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -36,7 +36,7 @@ func TestReadAndValidateSecret_mismatchedKeyAndCert(t *testing.T) {
 		ServerCert:        "server",
 		ValidationContext: "vc",
 	}
-	_, _, err := readAndValidateSecret(context.Background(), sec)
+	_, err := readAndValidateSecret(context.Background(), sec)
 	if err == nil {
 		t.Fatal("expected error for mismatched key and certificate")
 	}
@@ -76,7 +76,7 @@ func TestReadAndValidateSecret_eventuallyConsistentAfterRotation(t *testing.T) {
 		}
 	}()
 
-	_, _, err := readAndValidateSecret(context.Background(), sec)
+	_, err := readAndValidateSecret(context.Background(), sec)
 	if err != nil {
 		t.Fatalf("expected success after key rotated to match cert: %v", err)
 	}
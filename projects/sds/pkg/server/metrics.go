@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"sync"
+	"time"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
+	"go.uber.org/zap"
+)
+
+const (
+	certTypeTlsCertificate    = "tls_certificate"
+	certTypeValidationContext = "validation_context"
+
+	certExpiryMetricName = "gloo.solo.io/sds/certificate_expiry"
+	secretNameLabel      = "secret_name"
+	certTypeLabel        = "type"
+)
+
+// certExpiries reports the expiry of the certificates of the secrets that are currently served.
+// The expiries are replaced on every update, so a removed secret stops being reported.
+var certExpiries = newCertExpiryProducer()
+
+func init() {
+	metricproducer.GlobalManager().AddProducer(certExpiries)
+}
+
+// certExpiry is the expiry time of the certificates served in one SDS secret
+type certExpiry struct {
+	secretName string
+	certType   string
+	notAfter   time.Time
+}
+
+// leafExpiry returns the expiry of the first certificate in the chain
+func leafExpiry(secretName string, certChain []byte) certExpiry {
+	expiry := certExpiry{secretName: secretName, certType: certTypeTlsCertificate}
+	if certs := parseCertificates(certChain); len(certs) > 0 {
+		expiry.notAfter = certs[0].NotAfter
+	}
+	return expiry
+}
+
+// bundleExpiry returns the earliest expiry of the certificates in the bundles
+func bundleExpiry(secretName string, bundles ...[]byte) certExpiry {
+	expiry := certExpiry{secretName: secretName, certType: certTypeValidationContext}
+	for _, bundle := range bundles {
+		for _, cert := range parseCertificates(bundle) {
+			if expiry.notAfter.IsZero() || cert.NotAfter.Before(expiry.notAfter) {
+				expiry.notAfter = cert.NotAfter
+			}
+		}
+	}
+	return expiry
+}
+
+// parseCertificates returns the certificates in the PEM bundle, skipping any block that is not a valid certificate
+func parseCertificates(bundle []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return certs
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			certs = append(certs, cert)
+		}
+	}
+}
+
+// certExpiryProducer is a metric producer for the expiry of the served certificates
+type certExpiryProducer struct {
+	lock     sync.Mutex
+	expiries []certExpiry
+}
+
+var _ metricproducer.Producer = new(certExpiryProducer)
+
+func newCertExpiryProducer() *certExpiryProducer {
+	return &certExpiryProducer{}
+}
+
+// set replaces the reported expiries with the ones of the served secrets
+func (p *certExpiryProducer) set(ctx context.Context, expiries []certExpiry) {
+	reported := make([]certExpiry, 0, len(expiries))
+	for _, expiry := range expiries {
+		if expiry.notAfter.IsZero() {
+			contextutils.LoggerFrom(ctx).Debugw("no certificate found to report the expiry of", zap.String("secret", expiry.secretName))
+			continue
+		}
+		reported = append(reported, expiry)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.expiries = reported
+}
+
+// Read returns the certificate expiry gauge, with one time series per served secret
+func (p *certExpiryProducer) Read() []*metricdata.Metric {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now()
+	metric := &metricdata.Metric{
+		Descriptor: metricdata.Descriptor{
+			Name:        certExpiryMetricName,
+			Description: "Unix time in seconds at which the certificate of a served secret expires. For a validation context, the earliest expiry of its CA certificates.",
+			Unit:        metricdata.UnitDimensionless,
+			Type:        metricdata.TypeGaugeInt64,
+			LabelKeys:   []metricdata.LabelKey{{Key: secretNameLabel}, {Key: certTypeLabel}},
+		},
+	}
+	for _, expiry := range p.expiries {
+		metric.TimeSeries = append(metric.TimeSeries, &metricdata.TimeSeries{
+			LabelValues: []metricdata.LabelValue{
+				metricdata.NewLabelValue(expiry.secretName),
+				metricdata.NewLabelValue(expiry.certType),
+			},
+			Points: []metricdata.Point{metricdata.NewInt64Point(now, expiry.notAfter.Unix())},
+		})
+	}
+	return []*metricdata.Metric{metric}
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	rsrc "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/solo-io/gloo/projects/sds/pkg/testutils"
+)
+
+func TestReadAndValidateSecret_spiffeTrustDomains(t *testing.T) {
+	_, _, caA := testutils.MustSelfSignedPEM()
+	_, _, caB := testutils.MustSelfSignedPEMRotation1()
+	_, _, caC := testutils.MustSelfSignedPEMRotation2()
+
+	dir := t.TempDir()
+	localBundle := writeTestFile(t, dir, "cluster.local.crt", bytes.Join([][]byte{caA, caB}, nil))
+	partnerBundle := writeTestFile(t, dir, "partner.crt", caC)
+
+	material, err := readAndValidateSecret(context.Background(), Secret{
+		ValidationContext: "mesh",
+		TrustDomains: []TrustDomain{
+			{Name: "cluster.local", BundleFile: localBundle},
+			{Name: "partner.example.com", BundleFile: partnerBundle},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(material.items) != 1 {
+		t.Fatalf("expected only a validation context, got %d secrets", len(material.items))
+	}
+	secret := material.items[0].(*envoy_extensions_transport_sockets_tls_v3.Secret)
+	if secret.GetName() != "mesh" {
+		t.Fatalf("expected the validation context to be named mesh, got %q", secret.GetName())
+	}
+	validatorConfig := secret.GetValidationContext().GetCustomValidatorConfig()
+	if validatorConfig.GetName() != spiffeCertValidatorName {
+		t.Fatalf("expected the spiffe cert validator, got %q", validatorConfig.GetName())
+	}
+	spiffeConfig := &envoy_extensions_transport_sockets_tls_v3.SPIFFECertValidatorConfig{}
+	if err := validatorConfig.GetTypedConfig().UnmarshalTo(spiffeConfig); err != nil {
+		t.Fatal(err)
+	}
+	trustDomains := spiffeConfig.GetTrustDomains()
+	if len(trustDomains) != 2 || trustDomains[0].GetName() != "cluster.local" || trustDomains[1].GetName() != "partner.example.com" {
+		t.Fatalf("unexpected trust domains %v", trustDomains)
+	}
+	if got := len(parseCertificates(trustDomains[0].GetTrustBundle().GetInlineBytes())); got != 2 {
+		t.Fatalf("expected both CAs in the cluster.local trust bundle, got %d", got)
+	}
+}
+
+func TestUpdateSDSConfig_recordsCertificateExpiry(t *testing.T) {
+	keyPEM, certPEM, caPEM := testutils.MustSelfSignedPEM()
+	dir := t.TempDir()
+	srv := SetupEnvoySDS([]Secret{
+		{
+			SslKeyFile:  writeTestFile(t, dir, "key.pem", keyPEM),
+			SslCertFile: writeTestFile(t, dir, "cert.pem", certPEM),
+			ServerCert:  "expiry-server",
+		},
+		{
+			SslCaFile:         writeTestFile(t, dir, "ca.pem", caPEM),
+			ValidationContext: "expiry-vc",
+		},
+	}, "client", "127.0.0.1:0")
+	if err := srv.UpdateSDSConfig(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	block, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordedExpiries := func() map[string]int64 {
+		t.Helper()
+		metrics := certExpiries.Read()
+		if len(metrics) != 1 || metrics[0].Descriptor.Name != certExpiryMetricName {
+			t.Fatalf("expected the certificate expiry metric, got %v", metrics)
+		}
+		recorded := map[string]int64{}
+		for _, ts := range metrics[0].TimeSeries {
+			name, certType := ts.LabelValues[0].Value, ts.LabelValues[1].Value
+			recorded[certType+"/"+name] = ts.Points[0].Value.(int64)
+		}
+		return recorded
+	}
+	want := cert.NotAfter.Unix()
+	recorded := recordedExpiries()
+	if len(recorded) != 2 || recorded["tls_certificate/expiry-server"] != want || recorded["validation_context/expiry-vc"] != want {
+		t.Fatalf("expected both secrets to expire at %v, got %v", want, recorded)
+	}
+
+	// a removed secret is no longer reported
+	srv.SetSecrets(srv.Secrets()[1:])
+	if err := srv.UpdateSDSConfig(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	recorded = recordedExpiries()
+	if len(recorded) != 1 || recorded["validation_context/expiry-vc"] != want {
+		t.Fatalf("expected only the validation context to be reported, got %v", recorded)
+	}
+}
+
+func TestUpdateSDSConfig_servesTheSecretsWhichCanBeRead(t *testing.T) {
+	keyA, certA, _ := testutils.MustSelfSignedPEM()
+	keyB, certB, _ := testutils.MustSelfSignedPEMRotation1()
+	dir := t.TempDir()
+	goodCert := writeTestFile(t, dir, "good.crt", certA)
+	badKey := filepath.Join(dir, "bad.key")
+	srv := SetupEnvoySDS([]Secret{
+		{
+			SslKeyFile:  writeTestFile(t, dir, "good.key", keyA),
+			SslCertFile: goodCert,
+			ServerCert:  "good",
+		},
+		{
+			SslKeyFile:  badKey,
+			SslCertFile: writeTestFile(t, dir, "bad.crt", certB),
+			ServerCert:  "bad",
+		},
+	}, "client", "127.0.0.1:0")
+	servedSecrets := func() map[string]bool {
+		t.Helper()
+		snapshot, err := srv.snapshotCache.GetSnapshot("client")
+		if err != nil {
+			t.Fatal(err)
+		}
+		served := map[string]bool{}
+		for name := range snapshot.GetResources(rsrc.SecretType) {
+			served[name] = true
+		}
+		return served
+	}
+
+	// the key of the bad secret is missing, it is skipped
+	if err := srv.UpdateSDSConfig(context.Background()); err == nil || !strings.Contains(err.Error(), `"bad"`) {
+		t.Fatalf("expected an error for the bad secret, got %v", err)
+	}
+	if served := servedSecrets(); !served["good"] || served["bad"] {
+		t.Fatalf("expected only the good secret to be served, got %v", served)
+	}
+
+	// the key of the bad secret is written, both secrets are served
+	writeTestFile(t, dir, "bad.key", keyB)
+	if err := srv.UpdateSDSConfig(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if served := servedSecrets(); !served["good"] || !served["bad"] {
+		t.Fatalf("expected both secrets to be served, got %v", served)
+	}
+
+	// the cert of the good secret no longer matches its key, its previous material keeps being served
+	writeTestFile(t, dir, "good.crt", certB)
+	if err := srv.UpdateSDSConfig(context.Background()); err == nil || !strings.Contains(err.Error(), `"good"`) {
+		t.Fatalf("expected an error for the good secret, got %v", err)
+	}
+	if served := servedSecrets(); !served["good"] || !served["bad"] {
+		t.Fatalf("expected both secrets to still be served, got %v", served)
+	}
+}
+
+func writeTestFile(t *testing.T, dir, name string, contents []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, contents, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"net"
 	"os"
+	"sync"
 	"time"
 
 	retry "github.com/avast/retry-go/v4"
@@ -21,6 +23,7 @@ import (
 	"github.com/solo-io/go-utils/hashutils"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 var (
@@ -34,9 +37,12 @@ const (
 	// torn write before surfacing a real error.
 	sdsKeyPairValidationAttempts = 15
 	sdsKeyPairValidationDelay    = 100 * time.Millisecond
+
+	spiffeCertValidatorName = "envoy.tls.cert_validator.spiffe"
 )
 
-// Secret represents an envoy auth secret
+// Secret represents an envoy auth secret. Either of ServerCert and ValidationContext may be
+// left empty to only serve the other one.
 type Secret struct {
 	SslCaFile         string
 	SslKeyFile        string
@@ -44,15 +50,45 @@ type Secret struct {
 	SslOcspFile       string
 	ServerCert        string // name of a tls_certificate_sds_secret_config
 	ValidationContext string // name of the validation_context_sds_secret_config
+	// TrustDomains validates peers with the SPIFFE certificate validator, using a separate
+	// trust bundle for every trust domain. Mutually exclusive with SslCaFile.
+	TrustDomains []TrustDomain
+}
+
+// TrustDomain is a SPIFFE trust domain and the file holding its trust bundle.
+// The bundle may hold several CA certificates, e.g. while a CA is being rotated.
+type TrustDomain struct {
+	Name       string
+	BundleFile string
+}
+
+// Files returns the paths of every file the secret is read from
+func (s Secret) Files() []string {
+	var files []string
+	for _, file := range []string{s.SslKeyFile, s.SslCertFile, s.SslCaFile, s.SslOcspFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	for _, td := range s.TrustDomains {
+		files = append(files, td.BundleFile)
+	}
+	return files
 }
 
 // Server is the SDS server. Holds config & secrets.
 type Server struct {
+	secretsLock   sync.RWMutex
 	secrets       []Secret
 	sdsClient     string
 	grpcServer    *grpc.Server
 	address       string
 	snapshotCache cache.SnapshotCache
+
+	// updateLock serializes the updates, which read and replace served
+	updateLock sync.Mutex
+	// served holds the material of every secret in the last snapshot, by secretKey
+	served map[string]*secretMaterial
 }
 
 // ID needed for snapshotCache
@@ -101,17 +137,53 @@ func (s *Server) Run(ctx context.Context) (<-chan struct{}, error) {
 	return serverStopped, nil
 }
 
-// UpdateSDSConfig updates with the current certs
+// Secrets returns the secrets served by the server
+func (s *Server) Secrets() []Secret {
+	s.secretsLock.RLock()
+	defer s.secretsLock.RUnlock()
+	return s.secrets
+}
+
+// SetSecrets replaces the secrets served by the server. The change is served on the next UpdateSDSConfig.
+func (s *Server) SetSecrets(secrets []Secret) {
+	s.secretsLock.Lock()
+	defer s.secretsLock.Unlock()
+	s.secrets = secrets
+}
+
+// UpdateSDSConfig updates with the current certs. A secret which cannot be read does not prevent
+// the others from being served: it keeps being served with the material of the last snapshot,
+// or is skipped if it was not in it. The returned error lists the secrets which could not be read.
 func (s *Server) UpdateSDSConfig(ctx context.Context) error {
+	s.updateLock.Lock()
+	defer s.updateLock.Unlock()
+
 	var certs [][]byte
 	var items []cache_types.Resource
-	for _, sec := range s.secrets {
-		secretCerts, secretItems, err := readAndValidateSecret(ctx, sec)
+	var expiries []certExpiry
+	var readErrs error
+	served := make(map[string]*secretMaterial)
+	for _, sec := range s.Secrets() {
+		key := secretKey(sec)
+		material, err := readAndValidateSecret(ctx, sec)
 		if err != nil {
-			return err
+			if ctx.Err() != nil {
+				return err
+			}
+			readErrs = errors.Join(readErrs, err)
+			material = s.served[key]
+			if material == nil {
+				contextutils.LoggerFrom(ctx).Errorw("skipping SDS secret which cannot be read",
+					zap.String("secret", secretName(sec)), zap.Error(err))
+				continue
+			}
+			contextutils.LoggerFrom(ctx).Errorw("serving the previous material of SDS secret which cannot be read",
+				zap.String("secret", secretName(sec)), zap.Error(err))
 		}
-		certs = append(certs, secretCerts...)
-		items = append(items, secretItems...)
+		served[key] = material
+		certs = append(certs, material.certs...)
+		items = append(items, material.items...)
+		expiries = append(expiries, material.expiries...)
 	}
 
 	snapshotVersion, err := GetSnapshotVersion(certs)
@@ -123,15 +195,19 @@ func (s *Server) UpdateSDSConfig(ctx context.Context) error {
 
 	secretSnapshot := &cache.Snapshot{}
 	secretSnapshot.Resources[cache_types.Secret] = cache.NewResources(snapshotVersion, items)
-	return s.snapshotCache.SetSnapshot(ctx, s.sdsClient, secretSnapshot)
+	if err := s.snapshotCache.SetSnapshot(ctx, s.sdsClient, secretSnapshot); err != nil {
+		return err
+	}
+	s.served = served
+	certExpiries.set(ctx, expiries)
+	return readErrs
 }
 
 // readAndValidateSecret reads TLS material for one Secret, re-reading until the cert and key
 // form a matching pair (or attempts are exhausted). That avoids pushing a mismatched pair to
 // Envoy when a writer updates key and cert files non-atomically (e.g. Istio rotation).
-func readAndValidateSecret(ctx context.Context, sec Secret) ([][]byte, []cache_types.Resource, error) {
-	var certs [][]byte
-	var items []cache_types.Resource
+func readAndValidateSecret(ctx context.Context, sec Secret) (*secretMaterial, error) {
+	var material *secretMaterial
 	attempts := 0
 	err := retry.Do(
 		func() error {
@@ -142,37 +218,9 @@ func readAndValidateSecret(ctx context.Context, sec Secret) ([][]byte, []cache_t
 			default:
 			}
 
-			key, err := readAndVerifyCert(ctx, sec.SslKeyFile)
-			if err != nil {
-				return fmt.Errorf("reading private key %q: %w", sec.SslKeyFile, err)
-			}
-			certChain, err := readAndVerifyCert(ctx, sec.SslCertFile)
-			if err != nil {
-				return fmt.Errorf("reading certificate chain %q: %w", sec.SslCertFile, err)
-			}
-			if _, err := tls.X509KeyPair(certChain, key); err != nil {
-				return fmt.Errorf("validating certificate chain %q with private key %q: %w", sec.SslCertFile, sec.SslKeyFile, err)
-			}
-			ca, err := readAndVerifyCert(ctx, sec.SslCaFile)
-			if err != nil {
-				return fmt.Errorf("reading CA bundle %q: %w", sec.SslCaFile, err)
-			}
-			var ocspStaple []byte
-			if sec.SslOcspFile != "" {
-				ocspStaple, err = readFile(ctx, sec.SslOcspFile)
-				if err != nil {
-					return fmt.Errorf("reading OCSP staple %q: %w", sec.SslOcspFile, err)
-				}
-			}
-			certs = [][]byte{key, certChain, ca}
-			if sec.SslOcspFile != "" {
-				certs = append(certs, ocspStaple)
-			}
-			items = []cache_types.Resource{
-				serverCertSecret(key, certChain, ocspStaple, sec.ServerCert),
-				validationContextSecret(ca, sec.ValidationContext),
-			}
-			return nil
+			var err error
+			material, err = readSecret(ctx, sec)
+			return err
 		},
 		retry.Attempts(sdsKeyPairValidationAttempts),
 		retry.Context(ctx),
@@ -180,12 +228,13 @@ func readAndValidateSecret(ctx context.Context, sec Secret) ([][]byte, []cache_t
 		retry.DelayType(retry.FixedDelay),
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("building SDS secret %q after %d attempts: %w", sec.ServerCert, attempts, err)
+		return nil, fmt.Errorf("building SDS secret %q after %d attempts: %w", secretName(sec), attempts, err)
 	}
 	if attempts > 1 {
 		contextutils.LoggerFrom(ctx).Infow(
 			"recovered SDS secret after retrying torn cert rotation",
 			zap.String("serverCert", sec.ServerCert),
+			zap.String("validationContext", sec.ValidationContext),
 			zap.Int("attempts", attempts),
 			zap.String("sslKeyFile", sec.SslKeyFile),
 			zap.String("sslCertFile", sec.SslCertFile),
@@ -193,7 +242,94 @@ func readAndValidateSecret(ctx context.Context, sec Secret) ([][]byte, []cache_t
 			zap.String("sslOcspFile", sec.SslOcspFile),
 		)
 	}
-	return certs, items, nil
+	return material, nil
+}
+
+// secretMaterial is the TLS material read for one Secret
+type secretMaterial struct {
+	certs [][]byte
+	items []cache_types.Resource
+	// expiries of the served certificates, recorded once the snapshot is set
+	expiries []certExpiry
+}
+
+// readSecret reads the TLS material for one Secret once. The certs are hashed in the order
+// key, cert chain, CA, OCSP staple, so that the snapshot version of the existing Secrets
+// is not changed by the addition of trust bundles.
+func readSecret(ctx context.Context, sec Secret) (*secretMaterial, error) {
+	material := &secretMaterial{}
+	var key, certChain, ca, ocspStaple []byte
+	var err error
+	if sec.ServerCert != "" {
+		key, err = readAndVerifyCert(ctx, sec.SslKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading private key %q: %w", sec.SslKeyFile, err)
+		}
+		certChain, err = readAndVerifyCert(ctx, sec.SslCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading certificate chain %q: %w", sec.SslCertFile, err)
+		}
+		if _, err := tls.X509KeyPair(certChain, key); err != nil {
+			return nil, fmt.Errorf("validating certificate chain %q with private key %q: %w", sec.SslCertFile, sec.SslKeyFile, err)
+		}
+		material.certs = append(material.certs, key, certChain)
+		material.expiries = append(material.expiries, leafExpiry(sec.ServerCert, certChain))
+	}
+	if sec.ValidationContext != "" && sec.SslCaFile != "" {
+		ca, err = readAndVerifyCert(ctx, sec.SslCaFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA bundle %q: %w", sec.SslCaFile, err)
+		}
+		material.certs = append(material.certs, ca)
+	}
+	if sec.ServerCert != "" && sec.SslOcspFile != "" {
+		ocspStaple, err = readFile(ctx, sec.SslOcspFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading OCSP staple %q: %w", sec.SslOcspFile, err)
+		}
+		material.certs = append(material.certs, ocspStaple)
+	}
+
+	if sec.ServerCert != "" {
+		material.items = append(material.items, serverCertSecret(key, certChain, ocspStaple, sec.ServerCert))
+	}
+	if sec.ValidationContext == "" {
+		return material, nil
+	}
+	if len(sec.TrustDomains) == 0 {
+		material.items = append(material.items, validationContextSecret(ca, sec.ValidationContext))
+		material.expiries = append(material.expiries, bundleExpiry(sec.ValidationContext, ca))
+		return material, nil
+	}
+
+	bundles := make([][]byte, 0, len(sec.TrustDomains))
+	for _, td := range sec.TrustDomains {
+		bundle, err := readAndVerifyCert(ctx, td.BundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading trust bundle %q of trust domain %q: %w", td.BundleFile, td.Name, err)
+		}
+		bundles = append(bundles, bundle)
+	}
+	material.certs = append(material.certs, bundles...)
+	validationContext, err := spiffeValidationContextSecret(sec.TrustDomains, bundles, sec.ValidationContext)
+	if err != nil {
+		return nil, err
+	}
+	material.items = append(material.items, validationContext)
+	material.expiries = append(material.expiries, bundleExpiry(sec.ValidationContext, bundles...))
+	return material, nil
+}
+
+// secretKey identifies a secret by the names it is served with
+func secretKey(sec Secret) string {
+	return sec.ServerCert + "/" + sec.ValidationContext
+}
+
+func secretName(sec Secret) string {
+	if sec.ServerCert != "" {
+		return sec.ServerCert
+	}
+	return sec.ValidationContext
 }
 
 // GetSnapshotVersion generates a version string by hashing the certs
@@ -273,6 +409,33 @@ func validationContextSecret(caCert []byte, validationContext string) cache_type
 	}
 }
 
+// spiffeValidationContextSecret validates peers with the SPIFFE certificate validator, which
+// picks the trust bundle to verify a peer with from the trust domain of its SPIFFE ID.
+func spiffeValidationContextSecret(trustDomains []TrustDomain, bundles [][]byte, validationContext string) (cache_types.Resource, error) {
+	validatorConfig := &envoy_extensions_transport_sockets_tls_v3.SPIFFECertValidatorConfig{}
+	for i, td := range trustDomains {
+		validatorConfig.TrustDomains = append(validatorConfig.GetTrustDomains(), &envoy_extensions_transport_sockets_tls_v3.SPIFFECertValidatorConfig_TrustDomain{
+			Name:        td.Name,
+			TrustBundle: inlineBytesDataSource(bundles[i]),
+		})
+	}
+	typedConfig, err := anypb.New(validatorConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_extensions_transport_sockets_tls_v3.Secret{
+		Name: validationContext,
+		Type: &envoy_extensions_transport_sockets_tls_v3.Secret_ValidationContext{
+			ValidationContext: &envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext{
+				CustomValidatorConfig: &envoy_config_core_v3.TypedExtensionConfig{
+					Name:        spiffeCertValidatorName,
+					TypedConfig: typedConfig,
+				},
+			},
+		},
+	}, nil
+}
+
 func inlineBytesDataSource(b []byte) *envoy_config_core_v3.DataSource {
 	return &envoy_config_core_v3.DataSource{
 		Specifier: &envoy_config_core_v3.DataSource_InlineBytes{